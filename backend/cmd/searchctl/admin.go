@@ -0,0 +1,63 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/spf13/cobra"
+)
+
+// newCacheCommand cache komutlarını oluşturur
+func newCacheCommand(opts *options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Manage the search result cache",
+	}
+	cmd.AddCommand(&cobra.Command{
+		Use:   "invalidate",
+		Short: "Drop cached search and similar-content results",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAndPrint(cmd, opts, http.MethodPost, "/api/v1/admin/cache/invalidate", nil)
+		},
+	})
+	return cmd
+}
+
+// newScoresCommand skorlama komutlarını oluşturur
+func newScoresCommand(opts *options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scores",
+		Short: "Inspect and recompute content scores",
+	}
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "rules",
+			Short: "Show the active scoring rules",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return runAndPrint(cmd, opts, http.MethodGet, "/api/v1/admin/scoring", nil)
+			},
+		},
+		&cobra.Command{
+			Use:   "recalculate",
+			Short: "Recompute scores of all contents in the background",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return runAndPrint(cmd, opts, http.MethodPost, "/api/v1/admin/scoring/recalculate", nil)
+			},
+		},
+	)
+	return cmd
+}
+
+// newStatsCommand yönetim paneli özetini gösteren komutu oluşturur
+func newStatsCommand(opts *options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "stats",
+		Short: "Show content counts, last syncs, cache hit ratio and index freshness",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAndPrint(cmd, opts, http.MethodGet, "/api/v1/admin/stats", nil)
+		},
+	}
+}
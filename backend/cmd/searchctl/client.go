@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Client admin API'si ile konuşan küçük HTTP istemcisi
+type Client struct {
+	baseURL    string
+	token      string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// NewClient yeni bir API istemcisi oluşturur
+// token boş değilse Authorization: Bearer, apiKey boş değilse X-API-Key header'ı gönderilir
+func NewClient(baseURL, token, apiKey string, timeout time.Duration) *Client {
+	return &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		token:      token,
+		apiKey:     apiKey,
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+// APIError sunucunun döndüğü hata zarfını taşır
+type APIError struct {
+	StatusCode int
+	Code       string `json:"code"`
+	Message    string `json:"message"`
+	Field      string `json:"field,omitempty"`
+	RequestID  string `json:"-"`
+}
+
+// Error hata mesajını döner
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("%d %s: %s", e.StatusCode, e.Code, e.Message)
+	if e.Field != "" {
+		msg += fmt.Sprintf(" (field: %s)", e.Field)
+	}
+	if e.RequestID != "" {
+		msg += fmt.Sprintf(" [request_id: %s]", e.RequestID)
+	}
+	return msg
+}
+
+// Do isteği gönderir ve 2xx yanıt gövdesini döner
+// body nil değilse JSON olarak gönderilir; 2xx dışı yanıtlar *APIError olarak döner
+func (c *Client) Do(ctx context.Context, method, path string, query url.Values, body interface{}) ([]byte, error) {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode request body: %w", err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	endpoint := c.baseURL + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	if c.apiKey != "" {
+		req.Header.Set("X-API-Key", c.apiKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, decodeAPIError(resp.StatusCode, data)
+	}
+	return data, nil
+}
+
+// DoJSON isteği gönderir ve yanıtı out'a decode eder
+func (c *Client) DoJSON(ctx context.Context, method, path string, query url.Values, body, out interface{}) error {
+	data, err := c.Do(ctx, method, path, query, body)
+	if err != nil {
+		return err
+	}
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// decodeAPIError hata zarfını çözer; zarf yoksa gövdenin kendisi mesaj olarak kullanılır
+func decodeAPIError(status int, data []byte) error {
+	var envelope struct {
+		Error     *APIError `json:"error"`
+		RequestID string    `json:"request_id"`
+	}
+	if err := json.Unmarshal(data, &envelope); err == nil && envelope.Error != nil {
+		envelope.Error.StatusCode = status
+		envelope.Error.RequestID = envelope.RequestID
+		return envelope.Error
+	}
+
+	return &APIError{
+		StatusCode: status,
+		Code:       http.StatusText(status),
+		Message:    strings.TrimSpace(string(data)),
+	}
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+)
+
+// exportPageSize arama endpoint'inin izin verdiği en büyük sayfa boyutu
+const exportPageSize = 50
+
+// csvHeader CSV dışa aktarımının kolonları
+var csvHeader = []string{"id", "provider_id", "title", "content_type", "language", "url", "published_at", "final_score", "tags"}
+
+// searchPage GET /api/v1/search yanıtının dışa aktarım için gereken kısmı
+type searchPage struct {
+	Items      []*entity.Content `json:"items"`
+	Pagination struct {
+		NextCursor string `json:"next_cursor"`
+	} `json:"pagination"`
+}
+
+// exportOptions export search komutunun parametreleri
+type exportOptions struct {
+	query       string
+	contentType string
+	tags        string
+	providerID  int64
+	format      string
+	out         string
+	max         int
+}
+
+// newExportCommand dışa aktarım komutlarını oluşturur
+func newExportCommand(opts *options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export data from the API",
+	}
+
+	exp := &exportOptions{}
+	search := &cobra.Command{
+		Use:   "search",
+		Short: "Export all search results as NDJSON or CSV",
+		Long: "Pages through GET /api/v1/search with popularity sort and keyset cursors\n" +
+			"and writes every result, so large result sets are exported without page limits.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if exp.format != "ndjson" && exp.format != "csv" {
+				return fmt.Errorf("unsupported format %q, use ndjson or csv", exp.format)
+			}
+
+			w := cmd.OutOrStdout()
+			if exp.out != "" && exp.out != "-" {
+				f, err := os.Create(exp.out)
+				if err != nil {
+					return fmt.Errorf("failed to create output file: %w", err)
+				}
+				defer f.Close()
+				w = f
+			}
+
+			count, err := exportSearch(cmd.Context(), opts.client(), exp, w)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.ErrOrStderr(), "exported %d contents\n", count)
+			return nil
+		},
+	}
+
+	flags := search.Flags()
+	flags.StringVarP(&exp.query, "query", "q", "", "search query, empty exports all contents")
+	flags.StringVar(&exp.contentType, "type", "", "content type filter (video, article)")
+	flags.StringVar(&exp.tags, "tags", "", "comma separated tag filter")
+	flags.Int64Var(&exp.providerID, "provider", 0, "provider ID filter")
+	flags.StringVar(&exp.format, "format", "ndjson", "output format: ndjson or csv")
+	flags.StringVarP(&exp.out, "out", "o", "", "output file, stdout if empty")
+	flags.IntVar(&exp.max, "max", 0, "stop after this many contents, 0 exports everything")
+
+	cmd.AddCommand(search)
+	return cmd
+}
+
+// exportSearch sonuç sayfalarını cursor ile dolaşıp her içeriği w'ye yazar
+// Yazılan içerik sayısını döner
+func exportSearch(ctx context.Context, client *Client, exp *exportOptions, w io.Writer) (int, error) {
+	write, flush := newExportWriter(exp.format, w)
+
+	query := url.Values{}
+	query.Set("query", exp.query)
+	query.Set("sort", "popularity")
+	query.Set("page_size", strconv.Itoa(exportPageSize))
+	if exp.contentType != "" {
+		query.Set("type", exp.contentType)
+	}
+	if exp.tags != "" {
+		query.Set("tags", exp.tags)
+	}
+	if exp.providerID > 0 {
+		query.Set("provider_id", strconv.FormatInt(exp.providerID, 10))
+	}
+
+	count := 0
+	for {
+		var page searchPage
+		if err := client.DoJSON(ctx, http.MethodGet, "/api/v1/search", query, nil, &page); err != nil {
+			return count, err
+		}
+
+		for _, content := range page.Items {
+			if err := write(content); err != nil {
+				return count, fmt.Errorf("failed to write content %d: %w", content.ID, err)
+			}
+			count++
+			if exp.max > 0 && count >= exp.max {
+				return count, flush()
+			}
+		}
+
+		if page.Pagination.NextCursor == "" || len(page.Items) == 0 {
+			return count, flush()
+		}
+		query.Set("cursor", page.Pagination.NextCursor)
+	}
+}
+
+// newExportWriter formata göre tek içerik yazan ve sonda buffer'ı boşaltan fonksiyonları döner
+func newExportWriter(format string, w io.Writer) (func(*entity.Content) error, func() error) {
+	if format == "csv" {
+		cw := csv.NewWriter(w)
+		headerWritten := false
+		write := func(c *entity.Content) error {
+			if !headerWritten {
+				if err := cw.Write(csvHeader); err != nil {
+					return err
+				}
+				headerWritten = true
+			}
+			return cw.Write(csvRecord(c))
+		}
+		flush := func() error {
+			if !headerWritten {
+				if err := cw.Write(csvHeader); err != nil {
+					return err
+				}
+			}
+			cw.Flush()
+			return cw.Error()
+		}
+		return write, flush
+	}
+
+	enc := json.NewEncoder(w)
+	return func(c *entity.Content) error { return enc.Encode(c) }, func() error { return nil }
+}
+
+// csvRecord içeriği csvHeader sırasıyla tek satıra çevirir
+func csvRecord(c *entity.Content) []string {
+	score := ""
+	if c.Score != nil {
+		score = strconv.FormatFloat(c.Score.FinalScore, 'f', -1, 64)
+	}
+
+	tags := make([]string, 0, len(c.Tags))
+	for _, tag := range c.Tags {
+		tags = append(tags, tag.Name)
+	}
+
+	return []string{
+		strconv.FormatInt(c.ID, 10),
+		strconv.FormatInt(c.ProviderID, 10),
+		c.Title,
+		string(c.ContentType),
+		c.Language,
+		c.URL,
+		c.PublishedAt.UTC().Format(time.RFC3339),
+		score,
+		strings.Join(tags, ","),
+	}
+}
@@ -0,0 +1,92 @@
+// searchctl search-engine admin API'si için komut satırı aracıdır
+// Senkronizasyon, provider yönetimi, cache temizleme, skor yeniden hesaplama
+// ve arama sonuçlarının dışa aktarımı curl yazmadan script/CI içinden yapılabilir
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// options tüm komutların paylaştığı global flag değerleri
+type options struct {
+	server  string
+	token   string
+	apiKey  string
+	timeout time.Duration
+}
+
+func main() {
+	if err := newRootCommand().Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+// newRootCommand kök komutu ve alt komutları oluşturur
+func newRootCommand() *cobra.Command {
+	opts := &options{}
+
+	root := &cobra.Command{
+		Use:           "searchctl",
+		Short:         "Command line client for the search-engine admin API",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+
+	flags := root.PersistentFlags()
+	flags.StringVar(&opts.server, "server", envOrDefault("SEARCHCTL_SERVER", "http://localhost:8080"), "API base URL (env SEARCHCTL_SERVER)")
+	flags.StringVar(&opts.token, "token", os.Getenv("SEARCHCTL_TOKEN"), "admin bearer token (env SEARCHCTL_TOKEN)")
+	flags.StringVar(&opts.apiKey, "api-key", os.Getenv("SEARCHCTL_API_KEY"), "API key sent as X-API-Key (env SEARCHCTL_API_KEY)")
+	flags.DurationVar(&opts.timeout, "timeout", 30*time.Second, "per-request timeout")
+
+	root.AddCommand(
+		newSyncCommand(opts),
+		newProvidersCommand(opts),
+		newCacheCommand(opts),
+		newScoresCommand(opts),
+		newStatsCommand(opts),
+		newExportCommand(opts),
+	)
+	return root
+}
+
+// client global flag'lerden API istemcisi oluşturur
+func (o *options) client() *Client {
+	return NewClient(o.server, o.token, o.apiKey, o.timeout)
+}
+
+// envOrDefault ortam değişkeni tanımlıysa onu, değilse varsayılanı döner
+func envOrDefault(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+// printJSON yanıt gövdesini girintili JSON olarak yazar
+func printJSON(w io.Writer, data []byte) error {
+	var out bytes.Buffer
+	if err := json.Indent(&out, data, "", "  "); err != nil {
+		// JSON değilse olduğu gibi yaz
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	}
+	out.WriteByte('\n')
+	_, err := out.WriteTo(w)
+	return err
+}
+
+// readInput dosyadan veya "-" ise stdin'den okur
+func readInput(cmd *cobra.Command, path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(cmd.InOrStdin())
+	}
+	return os.ReadFile(path)
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runCLI komutu verilen sunucuya karşı çalıştırıp stdout'u döner
+func runCLI(t *testing.T, server *httptest.Server, args ...string) (string, error) {
+	t.Helper()
+
+	var stdout, stderr bytes.Buffer
+	root := newRootCommand()
+	root.SetOut(&stdout)
+	root.SetErr(&stderr)
+	root.SetIn(strings.NewReader(""))
+	root.SetArgs(append([]string{"--server", server.URL, "--token", "secret"}, args...))
+
+	err := root.Execute()
+	return stdout.String(), err
+}
+
+func TestSearchctl(t *testing.T) {
+	t.Run("export search follows cursors", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/v1/search", r.URL.Path)
+			assert.Equal(t, "popularity", r.URL.Query().Get("sort"))
+			assert.Equal(t, "golang", r.URL.Query().Get("query"))
+
+			w.Header().Set("Content-Type", "application/json")
+			switch r.URL.Query().Get("cursor") {
+			case "":
+				w.Write([]byte(`{"items": [{"id": 1, "provider_id": 1, "title": "Go, intro", "content_type": "video", "score": {"final_score": 9.5}, "tags": [{"name": "go"}, {"name": "intro"}]}], "pagination": {"next_cursor": "c1"}}`))
+			case "c1":
+				w.Write([]byte(`{"items": [{"id": 2, "provider_id": 2, "title": "Go generics", "content_type": "article"}], "pagination": {}}`))
+			default:
+				t.Errorf("unexpected cursor %q", r.URL.Query().Get("cursor"))
+			}
+		}))
+		defer server.Close()
+
+		out, err := runCLI(t, server, "export", "search", "--query", "golang")
+		require.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(out), "\n")
+		require.Len(t, lines, 2)
+		assert.Contains(t, lines[0], `"id":1`)
+		assert.Contains(t, lines[1], `"id":2`)
+
+		out, err = runCLI(t, server, "export", "search", "--query", "golang", "--format", "csv")
+		require.NoError(t, err)
+		assert.Equal(t, "id,provider_id,title,content_type,language,url,published_at,final_score,tags\n"+
+			"1,1,\"Go, intro\",video,,,0001-01-01T00:00:00Z,9.5,\"go,intro\"\n"+
+			"2,2,Go generics,article,,,0001-01-01T00:00:00Z,,\n", out)
+
+		out, err = runCLI(t, server, "export", "search", "--query", "golang", "--max", "1")
+		require.NoError(t, err)
+		assert.Equal(t, 1, strings.Count(out, "\n"))
+	})
+
+	t.Run("sync run waits for job", func(t *testing.T) {
+		polls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+			switch {
+			case r.Method == http.MethodPost && r.URL.Path == "/api/v1/admin/sync/2":
+				w.WriteHeader(http.StatusAccepted)
+				w.Write([]byte(`{"status": "running", "job_id": "job-1"}`))
+			case r.Method == http.MethodGet && r.URL.Path == "/api/v1/admin/sync/job-1":
+				polls++
+				if polls < 2 {
+					w.Write([]byte(`{"id": "job-1", "status": "running"}`))
+					return
+				}
+				w.Write([]byte(`{"id": "job-1", "status": "failed"}`))
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+		}))
+		defer server.Close()
+
+		out, err := runCLI(t, server, "sync", "run", "--provider", "2", "--wait", "--poll-interval", "1ms")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `status "failed"`)
+		assert.Contains(t, out, `"status": "failed"`)
+		assert.Equal(t, 2, polls)
+	})
+
+	t.Run("api error envelope", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error": {"code": "validation_failed", "message": "format must be json or xml", "field": "format"}, "request_id": "req-1"}`))
+		}))
+		defer server.Close()
+
+		_, err := runCLI(t, server, "providers", "update", "3", "--file", "-")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not valid JSON")
+
+		root := newRootCommand()
+		root.SetIn(strings.NewReader(`{"name": "P", "format": "csv"}`))
+		root.SetOut(&bytes.Buffer{})
+		root.SetArgs([]string{"--server", server.URL, "providers", "create"})
+		err = root.Execute()
+
+		var apiErr *APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+		assert.Equal(t, "format", apiErr.Field)
+		assert.Equal(t, "400 validation_failed: format must be json or xml (field: format) [request_id: req-1]", apiErr.Error())
+	})
+}
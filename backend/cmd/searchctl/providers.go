@@ -0,0 +1,106 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/spf13/cobra"
+)
+
+// newProvidersCommand provider yönetimi komutlarını oluşturur
+func newProvidersCommand(opts *options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "providers",
+		Aliases: []string{"provider"},
+		Short:   "Manage content providers",
+	}
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "list",
+			Short: "List active providers",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return runAndPrint(cmd, opts, http.MethodGet, "/api/v1/admin/providers", nil)
+			},
+		},
+		newProviderWriteCommand(opts, "create", http.MethodPost),
+		newProviderWriteCommand(opts, "update ID", http.MethodPut),
+		&cobra.Command{
+			Use:   "delete ID",
+			Short: "Delete a provider",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				path, err := providerPath(args[0])
+				if err != nil {
+					return err
+				}
+				if _, err := opts.client().Do(cmd.Context(), http.MethodDelete, path, nil, nil); err != nil {
+					return err
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "provider %s deleted\n", args[0])
+				return nil
+			},
+		},
+	)
+	return cmd
+}
+
+// newProviderWriteCommand --file ile verilen provider JSON'unu gönderen create/update komutunu oluşturur
+// Dosya formatı: {"name": "...", "url": "http://...", "format": "json", "is_active": true}
+func newProviderWriteCommand(opts *options, use, method string) *cobra.Command {
+	var file string
+
+	cmd := &cobra.Command{
+		Use: use,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := "/api/v1/admin/providers"
+			if method == http.MethodPut {
+				var err error
+				if path, err = providerPath(args[0]); err != nil {
+					return err
+				}
+			}
+
+			payload, err := readInput(cmd, file)
+			if err != nil {
+				return fmt.Errorf("failed to read provider file: %w", err)
+			}
+			if !json.Valid(payload) {
+				return fmt.Errorf("provider file %s is not valid JSON", file)
+			}
+
+			return runAndPrint(cmd, opts, method, path, json.RawMessage(payload))
+		},
+	}
+
+	if method == http.MethodPut {
+		cmd.Short = "Update a provider from a JSON file"
+		cmd.Args = cobra.ExactArgs(1)
+	} else {
+		cmd.Short = "Create a provider from a JSON file"
+		cmd.Args = cobra.NoArgs
+	}
+	cmd.Flags().StringVarP(&file, "file", "f", "-", `provider JSON file, "-" reads from stdin`)
+	return cmd
+}
+
+// providerPath provider ID'sini doğrulayıp endpoint yolunu döner
+func providerPath(rawID string) (string, error) {
+	id, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil || id < 1 {
+		return "", fmt.Errorf("provider ID must be a positive integer: %q", rawID)
+	}
+	return "/api/v1/admin/providers/" + url.PathEscape(rawID), nil
+}
+
+// runAndPrint isteği gönderip yanıtı girintili JSON olarak yazar
+func runAndPrint(cmd *cobra.Command, opts *options, method, path string, body interface{}) error {
+	data, err := opts.client().Do(cmd.Context(), method, path, nil, body)
+	if err != nil {
+		return err
+	}
+	return printJSON(cmd.OutOrStdout(), data)
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// syncJob GET /api/v1/admin/sync/{jobID} yanıtının CLI'ın ihtiyaç duyduğu kısmı
+type syncJob struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
+
+// newSyncCommand senkronizasyon komutlarını oluşturur
+func newSyncCommand(opts *options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Trigger and inspect provider synchronization",
+	}
+	cmd.AddCommand(newSyncRunCommand(opts), newSyncStatusCommand(opts), newSyncHistoryCommand(opts))
+	return cmd
+}
+
+func newSyncRunCommand(opts *options) *cobra.Command {
+	var (
+		providerID   int64
+		dryRun       bool
+		wait         bool
+		pollInterval time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Start a sync for all providers or a single provider",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := "/api/v1/admin/sync"
+			if providerID > 0 {
+				path += "/" + strconv.FormatInt(providerID, 10)
+			}
+			query := url.Values{}
+			if dryRun {
+				query.Set("dry_run", "true")
+			}
+
+			client := opts.client()
+			data, err := client.Do(cmd.Context(), http.MethodPost, path, query, nil)
+			if err != nil {
+				return err
+			}
+			if dryRun || !wait {
+				return printJSON(cmd.OutOrStdout(), data)
+			}
+
+			var started struct {
+				JobID string `json:"job_id"`
+			}
+			if err := json.Unmarshal(data, &started); err != nil || started.JobID == "" {
+				return fmt.Errorf("sync started but response has no job_id: %s", data)
+			}
+			return waitForSyncJob(cmd, client, started.JobID, pollInterval)
+		},
+	}
+
+	cmd.Flags().Int64Var(&providerID, "provider", 0, "sync only this provider ID")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "report changes without writing to the database")
+	cmd.Flags().BoolVar(&wait, "wait", false, "wait until the job finishes and fail if it fails")
+	cmd.Flags().DurationVar(&pollInterval, "poll-interval", 2*time.Second, "job status polling interval with --wait")
+	return cmd
+}
+
+// waitForSyncJob job bitene kadar durumunu sorgular; job başarısızsa hata döner
+func waitForSyncJob(cmd *cobra.Command, client *Client, jobID string, interval time.Duration) error {
+	path := "/api/v1/admin/sync/" + url.PathEscape(jobID)
+	for {
+		data, err := client.Do(cmd.Context(), http.MethodGet, path, nil, nil)
+		if err != nil {
+			return err
+		}
+
+		var job syncJob
+		if err := json.Unmarshal(data, &job); err != nil {
+			return fmt.Errorf("failed to decode sync job: %w", err)
+		}
+		if job.Status != "running" {
+			if err := printJSON(cmd.OutOrStdout(), data); err != nil {
+				return err
+			}
+			if job.Status != "success" {
+				return fmt.Errorf("sync job %s finished with status %q", jobID, job.Status)
+			}
+			return nil
+		}
+
+		select {
+		case <-cmd.Context().Done():
+			return cmd.Context().Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+func newSyncStatusCommand(opts *options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "status JOB_ID",
+		Short: "Show the status of a sync job",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			data, err := opts.client().Do(cmd.Context(), http.MethodGet, "/api/v1/admin/sync/"+url.PathEscape(args[0]), nil, nil)
+			if err != nil {
+				return err
+			}
+			return printJSON(cmd.OutOrStdout(), data)
+		},
+	}
+}
+
+func newSyncHistoryCommand(opts *options) *cobra.Command {
+	var (
+		providerID int64
+		page       int
+		pageSize   int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "List past sync runs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			query := url.Values{}
+			if providerID > 0 {
+				query.Set("provider_id", strconv.FormatInt(providerID, 10))
+			}
+			query.Set("page", strconv.Itoa(page))
+			query.Set("page_size", strconv.Itoa(pageSize))
+
+			data, err := opts.client().Do(cmd.Context(), http.MethodGet, "/api/v1/admin/sync/history", query, nil)
+			if err != nil {
+				return err
+			}
+			return printJSON(cmd.OutOrStdout(), data)
+		},
+	}
+
+	cmd.Flags().Int64Var(&providerID, "provider", 0, "filter by provider ID")
+	cmd.Flags().IntVar(&page, "page", 1, "page number")
+	cmd.Flags().IntVar(&pageSize, "page-size", 20, "items per page")
+	return cmd
+}
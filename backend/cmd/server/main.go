@@ -4,15 +4,20 @@ import (
 	"context"
 	"database/sql"
 	"log"
+	"net"
 	"net/http"
 	_ "net/http/pprof"
+	"os/signal"
+	"syscall"
 	"time"
 
 	_ "github.com/lib/pq"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 
 	"github.com/onurerdog4n/search-engine/internal/application/usecase"
 	"github.com/onurerdog4n/search-engine/internal/domain/entity"
@@ -20,9 +25,18 @@ import (
 	"github.com/onurerdog4n/search-engine/internal/domain/service"
 	"github.com/onurerdog4n/search-engine/internal/infrastructure/cache"
 	"github.com/onurerdog4n/search-engine/internal/infrastructure/config"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/eventbus"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/flags"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/ingest"
 	"github.com/onurerdog4n/search-engine/internal/infrastructure/logger"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/metrics"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/notify"
 	"github.com/onurerdog4n/search-engine/internal/infrastructure/provider"
 	"github.com/onurerdog4n/search-engine/internal/infrastructure/repository"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/snapshot"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/tracing"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/webhook"
+	transportGrpc "github.com/onurerdog4n/search-engine/internal/transport/grpc"
 	transportHttp "github.com/onurerdog4n/search-engine/internal/transport/http"
 	"github.com/onurerdog4n/search-engine/internal/transport/middleware"
 )
@@ -39,6 +53,11 @@ func main() {
 		Level:      cfg.Logger.Level,
 		Encoding:   cfg.Logger.Encoding,
 		OutputPath: cfg.Logger.OutputPath,
+		Rotation: logger.RotationConfig{
+			MaxSizeMB:  cfg.Logger.MaxSizeMB,
+			MaxBackups: cfg.Logger.MaxBackups,
+			MaxAgeDays: cfg.Logger.MaxAgeDays,
+		},
 	})
 	if err != nil {
 		log.Fatalf("Failed to initialize logger: %v", err)
@@ -47,164 +66,1041 @@ func main() {
 
 	logger.Info("Starting search engine server", zap.String("version", "1.0.0"))
 
-	// 3. Database connection with pooling
-	db, err := sql.Open("postgres", cfg.Database.URL)
+	// OpenTelemetry tracing (OTEL_EXPORTER_OTLP_ENDPOINT boşsa span'ler export edilmez)
+	shutdownTracing, err := tracing.Setup(context.Background(), tracing.Config{
+		Endpoint:    cfg.Tracing.Endpoint,
+		ServiceName: cfg.Tracing.ServiceName,
+		SampleRatio: cfg.Tracing.SampleRatio,
+	})
+	if err != nil {
+		logger.Fatal("Tracing could not be initialized", zap.Error(err))
+	}
+	if cfg.Tracing.Endpoint != "" {
+		logger.Info("Tracing enabled", zap.String("endpoint", cfg.Tracing.Endpoint), zap.Float64("sample_ratio", cfg.Tracing.SampleRatio))
+	}
+
+	// 3. Database connection with pooling (TLS, statement_timeout ve application_name bağlantı URL'ine eklenir)
+	poolOptions := postgresPoolOptions(cfg.Database)
+	db, err := repository.OpenPostgres(cfg.Database.URL, poolOptions)
 	if err != nil {
 		logger.Fatal("Database connection failed", zap.Error(err))
 	}
 	defer db.Close()
 
-	// Configure connection pool
-	db.SetMaxOpenConns(cfg.Database.MaxOpenConns)
-	db.SetMaxIdleConns(cfg.Database.MaxIdleConns)
-	db.SetConnMaxLifetime(time.Duration(cfg.Database.ConnMaxLifetime) * time.Second)
-
 	// Test database connection
 	if err := db.Ping(); err != nil {
 		logger.Fatal("Database ping failed", zap.Error(err))
 	}
 	logger.Info("Database connection established")
 
-	// 4. Redis connection
-	rdb := redis.NewClient(&redis.Options{
-		Addr: cfg.Redis.URL,
-	})
-	defer rdb.Close()
+	// Opsiyonel read replica: arama okumaları buradan yapılır, erişilemezse primary'ye düşülür
+	var readDB *sql.DB
+	if cfg.Database.ReadURL != "" {
+		readDB, err = repository.OpenPostgres(cfg.Database.ReadURL, poolOptions)
+		if err != nil {
+			logger.Fatal("Read replica connection failed", zap.Error(err))
+		}
+		defer readDB.Close()
+
+		if err := readDB.Ping(); err != nil {
+			logger.Warn("Read replica ping failed, reads will fall back to primary", zap.Error(err))
+		} else {
+			logger.Info("Read replica connection established")
+		}
+	}
 
-	// Test Redis connection
+	// 4. Cache backend (Redis sadece redis backend'inde gerekir)
 	ctx := context.Background()
-	if err := rdb.Ping(ctx).Err(); err != nil {
-		logger.Fatal("Redis connection failed", zap.Error(err))
+	var (
+		rdb       *redis.Client
+		cacheRepo port.CacheRepository
+	)
+	switch cfg.Cache.Backend {
+	case "redis":
+		rdb = redis.NewClient(&redis.Options{
+			Addr: cfg.Redis.URL,
+		})
+		defer rdb.Close()
+
+		// Test Redis connection
+		if err := rdb.Ping(ctx).Err(); err != nil {
+			logger.Fatal("Redis connection failed", zap.Error(err))
+		}
+		logger.Info("Redis connection established")
+
+		// Sık sorgular için Redis önünde kısa ömürlü süreç içi LRU
+		cacheRepo = cache.NewTieredCache(
+			cache.NewRedisCache(rdb),
+			cfg.Cache.L1Size,
+			time.Duration(cfg.Cache.L1TTLSeconds)*time.Second,
+		)
+	case "memory":
+		cacheRepo = cache.NewMemoryCache(cfg.Cache.MemorySize)
+		logger.Info("Using in-memory cache", zap.Int("size", cfg.Cache.MemorySize))
+	default:
+		cacheRepo = cache.NewNoopCache()
+		logger.Warn("Cache disabled")
+	}
+	cacheRepo = cache.NewTracedCache(cacheRepo, cfg.Cache.Backend)
+	// Okuma isabet oranı /api/v1/admin/stats özetinde gösterilir
+	cacheCounter := cache.NewCountingCache(cacheRepo)
+	cacheRepo = cacheCounter
+
+	// Feature flag'ler: varsayılanlar config'ten, override'lar Redis'ten (Redis yoksa sadece bu instance'ta)
+	flagStore := flags.NewMemoryStore()
+	if rdb != nil {
+		flagStore = flags.NewRedisStore(rdb)
 	}
-	logger.Info("Redis connection established")
+	featureFlags := flags.New(featureFlagDefaults(cfg.Flags), flagStore, time.Duration(cfg.Flags.RefreshSeconds)*time.Second)
 
 	// 5. Repositories oluştur
-	contentRepo := repository.NewPostgresContentRepository(db)
-	cacheRepo := cache.NewRedisCache(rdb)
+	contentRepo := repository.NewPostgresContentRepositoryWithOptions(db, repository.PostgresContentRepositoryOptions{
+		CountStrategy: cfg.Search.CountStrategy,
+		ReadReplica:   readDB,
 
-	// 6. Services
-	scoringService := service.NewScoringService(service.ScoringRules{
-		VideoTypeWeight:   1.5,
-		ArticleTypeWeight: 1.0,
+		SlowQueryThreshold: time.Duration(cfg.Database.SlowQueryThresholdMs) * time.Millisecond,
 	})
+	providerRepo := repository.NewPostgresProviderRepository(db)
+	scoringRulesRepo := repository.NewPostgresScoringRulesRepository(db)
+	boostRuleRepo := repository.NewPostgresBoostRuleRepository(db)
+	apiKeyRepo := repository.NewPostgresAPIKeyRepository(db)
+	webhookRepo := repository.NewPostgresWebhookRepository(db)
+	savedSearchRepo := repository.NewPostgresSavedSearchRepository(db)
+	searchEventRepo := repository.NewPostgresSearchEventRepository(db)
+	ctrSignalRepo := repository.NewPostgresCTRSignalRepository(db)
+	zeroResultRepo := repository.NewPostgresZeroResultQueryRepository(db)
+	adminStatsRepo := repository.NewPostgresAdminStatsRepository(db)
+	promotionRepo := repository.NewPostgresPromotionRepository(db)
+	contentAuditRepo := repository.NewPostgresContentAuditRepository(db)
+	contentVersionRepo := repository.NewPostgresContentVersionRepository(db)
+	authorRepo := repository.NewPostgresAuthorRepository(db)
+	categoryRepo := repository.NewPostgresCategoryRepository(db)
+	moderationRepo := repository.NewPostgresContentModerationRepository(db)
+	transactor := repository.NewPostgresTransactor(db)
 
-	// 7. Provider clients
-	providerClients := createProviderClients(db)
-	logger.Info("Provider clients created", zap.Int("count", len(providerClients)))
+	// Arama harici bir indeksten yapılacaksa Search indeksle değiştirilir; yazma/okuma Postgres'te kalır
+	var (
+		searchIndex  port.SearchIndex
+		trackedIndex *repository.TrackedSearchIndex
+	)
+	switch cfg.Search.Backend {
+	case "elasticsearch":
+		searchIndex, err = repository.NewElasticsearchIndex(
+			db,
+			cfg.Search.ElasticsearchURL,
+			cfg.Search.ElasticsearchIndex,
+			&http.Client{Timeout: 30 * time.Second},
+		)
+		if err != nil {
+			logger.Fatal("Failed to create search index", zap.Error(err))
+		}
+	case "embedded":
+		searchIndex = repository.NewEmbeddedIndex(db)
+	}
+	if searchIndex != nil {
+		// Son yeniden oluşturma zamanı indeks güncelliği için tutulur
+		trackedIndex = repository.NewTrackedSearchIndex(searchIndex, cfg.Search.Backend)
+		searchIndex = trackedIndex
+
+		// İlk aramalar boş indekse düşmesin diye açılışta indeks Postgres'ten doldurulur
+		indexed, err := searchIndex.Reindex(ctx)
+		if err != nil {
+			logger.Fatal("Failed to build search index", zap.Error(err))
+		}
+		contentRepo = repository.NewIndexedContentRepository(contentRepo, searchIndex)
+		logger.Info("Using search index",
+			zap.String("backend", cfg.Search.Backend),
+			zap.Int("indexed", indexed),
+		)
+	}
+	contentRepo = repository.NewTracedContentRepository(contentRepo)
 
-	// 8. Use cases
-	// 8. Use cases
+	// 6. Services
+	// Config'deki güncellik fonksiyonu, etkileşim normalizasyonu, video süresi, yorum ve CTR ağırlıkları
+	// scoring_rules tablosunda seçilmediyse kullanılır
+	scoringService := service.NewScoringService(scoringDefaults(cfg.Scoring))
+	dedupService := service.NewDedupService(service.DedupRules{
+		TitleSimilarity: 0.85,
+		PublishedWindow: 48 * time.Hour,
+	})
+
+	// 7. Use cases
 	searchUseCase := usecase.NewSearchContentsUseCase(
 		contentRepo,
 		cacheRepo,
 		time.Duration(cfg.Cache.TTLSeconds)*time.Second,
 	)
+	searchUseCase.SetFuzzyThreshold(cfg.Search.FuzzyThreshold)
+	searchUseCase.SetHybridRelevanceWeight(cfg.Search.HybridRelevanceWeight)
+	searchUseCase.SetExportMaxRows(cfg.Search.ExportMaxRows)
+	searchUseCase.SetScoringService(scoringService)
+	searchUseCase.SetPromotionRepository(promotionRepo)
+	searchUseCase.SetZeroResultQueries(zeroResultRepo)
+	searchUseCase.SetFeatureFlags(featureFlags)
+	if rdb != nil {
+		// Sorgu sıklıkları (cache warm-up için) Redis'te tutulur
+		searchUseCase.SetQueryStats(cache.NewRedisQueryStats(rdb))
+	}
+
+	contentUseCase := usecase.NewGetContentUseCase(contentRepo, scoringService)
+	contentVersionsUseCase := usecase.NewContentVersionsUseCase(contentRepo, contentVersionRepo)
+	listAuthorsUseCase := usecase.NewListAuthorsUseCase(authorRepo)
+	listCategoriesUseCase := usecase.NewListCategoriesUseCase(categoryRepo)
+
+	similarUseCase := usecase.NewSimilarContentsUseCase(
+		contentRepo,
+		cacheRepo,
+		time.Duration(cfg.Cache.TTLSeconds)*time.Second,
+	)
+
+	// Skorlama kuralları veritabanından yüklenir, yüklenemezse varsayılanlarla devam edilir
+	scoringUseCase := usecase.NewManageScoringRulesUseCase(scoringRulesRepo, scoringService)
+	if err := scoringUseCase.Load(ctx); err != nil {
+		logger.Error("Scoring rules could not be loaded, using defaults", zap.Error(err))
+	}
+	boostUseCase := usecase.NewManageBoostRulesUseCase(boostRuleRepo, scoringService, cacheRepo)
+	if err := boostUseCase.Load(ctx); err != nil {
+		logger.Error("Boost rules could not be loaded, scoring without boosts", zap.Error(err))
+	}
 
 	syncUseCase := usecase.NewSyncProviderContentsUseCase(
-		providerClients,
+		nil,
 		contentRepo,
 		scoringService,
 		cacheRepo,
 	)
 
-	// 9. İlk senkronizasyonu başlat
+	// Tüm provider'lar connection pool'u paylaşan tek bir HTTP client kullanır
+	providerHTTPClient, err := provider.NewHTTPClient(provider.HTTPClientOptions{
+		Timeout:             time.Duration(cfg.Provider.TimeoutSeconds) * time.Second,
+		DialTimeout:         time.Duration(cfg.Provider.DialTimeoutSeconds) * time.Second,
+		MaxIdleConnsPerHost: cfg.Provider.MaxIdleConnsPerHost,
+		IdleConnTimeout:     time.Duration(cfg.Provider.IdleConnTimeoutSeconds) * time.Second,
+		ProxyURL:            cfg.Provider.ProxyURL,
+	})
+	if err != nil {
+		logger.Fatal("Provider HTTP client could not be created", zap.Error(err))
+	}
+
+	// Provider client'ları veritabanından yüklenir, admin API değişikliklerinde yeniden yüklenir
+	providerHTTPClient = provider.WithTracing(providerHTTPClient)
+	syncUseCase.SetProviderSource(providerRepo, provider.NewProviderClientFactory(providerHTTPClient))
+	syncUseCase.SetSyncLogRepository(providerRepo)
+	syncUseCase.SetTransactor(transactor)
+	syncUseCase.SetDedupService(dedupService)
+	syncUseCase.SetAuthorRepository(authorRepo)
+	syncUseCase.SetCategoryRepository(categoryRepo)
+	syncUseCase.SetCTRSignalRepository(ctrSignalRepo)
+	syncUseCase.SetConcurrency(cfg.Sync.MaxConcurrentProviders, cfg.Sync.Workers, cfg.Sync.WorkersPerProvider)
+	if cfg.Sync.ParallelBatchCommits && cfg.Sync.Workers > 1 {
+		syncUseCase.SetParallelBatchCommits(true)
+		logger.Warn("Sync batches are committed in parallel; a failed provider sync keeps the batches written before the failure",
+			zap.Int("workers", cfg.Sync.Workers),
+			zap.Int("workers_per_provider", cfg.Sync.WorkersPerProvider),
+		)
+	}
+	syncUseCase.SetTimeouts(
+		time.Duration(cfg.Sync.TimeoutSeconds)*time.Second,
+		time.Duration(cfg.Sync.ProviderTimeoutSeconds)*time.Second,
+	)
+	if searchIndex != nil {
+		syncUseCase.SetSearchIndexer(searchIndex)
+	}
+	syncUseCase.SetCacheWarmer(searchUseCase, cfg.Cache.WarmupQueries)
+
+	// Commit edilen içerik değişiklikleri /api/v1/stream abonelerine (instance içi),
+	// webhook aboneliklerine ve kayıtlı arama bildirimlerine iletilir
+	contentBus := eventbus.NewContentBus()
+	// Kayıtlı arama notify_url'leri API anahtarı olan herkes tarafından girilebildiğinden iç ağ adresleri engellenir
+	webhookSender := webhook.NewHTTPSender(cfg.Webhook.AllowPrivateNetworks)
+	webhookDispatcher := usecase.NewWebhookDispatcher(webhookRepo, webhookSender,
+		cfg.Webhook.MaxAttempts, time.Duration(cfg.Webhook.TimeoutSeconds)*time.Second)
+	savedSearchAlerter := usecase.NewSavedSearchAlerter(savedSearchRepo, webhookSender, time.Duration(cfg.Webhook.TimeoutSeconds)*time.Second)
+	if cfg.Alert.SMTPHost != "" {
+		savedSearchAlerter.SetMailer(notify.NewSMTPMailer(notify.SMTPConfig{
+			Host:     cfg.Alert.SMTPHost,
+			Port:     cfg.Alert.SMTPPort,
+			Username: cfg.Alert.SMTPUsername,
+			Password: cfg.Alert.SMTPPassword,
+			From:     cfg.Alert.EmailFrom,
+		}))
+	}
+	syncUseCase.SetChangePublisher(eventbus.FanOut{contentBus, webhookDispatcher, savedSearchAlerter})
+	contentStreamUseCase := usecase.NewContentStreamUseCase(contentBus, cfg.Stream.MaxClients)
+	if err := syncUseCase.ReloadProviderClients(ctx); err != nil {
+		logger.Error("Provider clients could not be loaded", zap.Error(err))
+	}
+	logger.Info("Provider clients created", zap.Int("count", len(syncUseCase.ProviderClients())))
+
+	providerUseCase := usecase.NewManageProvidersUseCase(providerRepo, cacheRepo)
+	providerUseCase.SetReloader(syncUseCase)
+
+	syncHistoryUseCase := usecase.NewSyncHistoryUseCase(providerRepo)
+	contentAuditUseCase := usecase.NewContentAuditUseCase(contentAuditRepo)
+	promotionUseCase := usecase.NewManagePromotionsUseCase(promotionRepo, contentRepo, cacheRepo)
+	apiKeyUseCase := usecase.NewManageAPIKeysUseCase(apiKeyRepo)
+	webhookUseCase := usecase.NewManageWebhooksUseCase(webhookRepo)
+	savedSearchUseCase := usecase.NewManageSavedSearchesUseCase(savedSearchRepo)
+	savedSearchUseCase.SetEmailAlertsEnabled(cfg.Alert.SMTPHost != "")
+	searchEventsUseCase := usecase.NewSearchEventsUseCase(searchEventRepo)
+	searchEventsUseCase.SetImpressionSampleRate(cfg.Events.ImpressionSampleRate)
+	searchEventsUseCase.SetBotUserAgents(cfg.Events.BotUserAgents)
+	zeroResultUseCase := usecase.NewZeroResultQueriesUseCase(zeroResultRepo)
+	adminStatsUseCase := usecase.NewAdminStatsUseCase(adminStatsRepo)
+	adminStatsUseCase.SetCacheStats(cacheCounter)
+	if trackedIndex != nil {
+		adminStatsUseCase.SetSearchIndex(trackedIndex)
+	}
+	moderationUseCase := usecase.NewContentModerationUseCase(moderationRepo, cacheRepo)
+	if searchIndex != nil {
+		moderationUseCase.SetSearchIndexer(searchIndex)
+	}
+
+	recalculateScoresUseCase := usecase.NewRecalculateScoresUseCase(contentRepo, scoringService, cacheRepo)
+	recalculateScoresUseCase.SetBatchSize(cfg.Scoring.RecalcBatchSize)
+	recalculateScoresUseCase.SetTransactor(transactor)
+	invalidateCacheUseCase := usecase.NewInvalidateCacheUseCase(cacheRepo)
+	featureFlagsUseCase := usecase.NewManageFeatureFlagsUseCase(featureFlags, cacheRepo)
+	boostUseCase.SetRecalculator(recalculateScoresUseCase)
+
+	// Arama olaylarından içerik CTR sinyali, gece skorlar yeniden hesaplanmadan hemen önce yenilenir
+	refreshCTRUseCase := usecase.NewRefreshCTRSignalsUseCase(ctrSignalRepo)
+	refreshCTRUseCase.SetWindowDays(cfg.Scoring.CTRWindowDays)
+	refreshCTRUseCase.SetMinImpressions(cfg.Scoring.CTRMinImpressions)
+	refreshCTRUseCase.SetPriorImpressions(cfg.Scoring.CTRPriorImpressions)
+	refreshCTRUseCase.SetTransactor(transactor)
+
+	// İçerik yedekleri yerel dizine veya S3/GCS bucket'ına yazılır
+	snapshotUseCase := usecase.NewSnapshotUseCase(
+		repository.NewPostgresSnapshotRepository(db),
+		providerRepo,
+		categoryRepo,
+		authorRepo,
+		contentRepo,
+		newSnapshotStore(cfg.Snapshot),
+		cacheRepo,
+	)
+	snapshotUseCase.SetTransactor(transactor)
+	if searchIndex != nil {
+		snapshotUseCase.SetSearchIndexer(searchIndex)
+	}
+
+	// Provider sağlık kontrolleri sync'in güncel client listesini kullanır
+	providerHealthUseCase := usecase.NewProviderHealthUseCase(syncUseCase, providerRepo)
+	providerHealthUseCase.SetTimeout(time.Duration(cfg.Health.ProviderCheckTimeoutSeconds) * time.Second)
+	providerHealthUseCase.SetStaleAfter(time.Duration(cfg.Health.ProviderStaleAfterSeconds) * time.Second)
+
+	// SIGINT/SIGTERM geldiğinde scheduler durur ve graceful shutdown başlar
+	shutdownCtx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// 8. İlk senkronizasyonu başlat
 	log.Println("İlk provider senkronizasyonu başlatılıyor...")
-	go syncUseCase.Execute(ctx)
+	if _, err := syncUseCase.ExecuteAsync(); err != nil {
+		log.Printf("İlk senkronizasyon başlatılamadı: %v", err)
+	}
+
+	// 9. Periyodik senkronizasyon scheduler'ı başlat
+	schedulerDone := startSyncScheduler(shutdownCtx, syncUseCase, cfg.Sync.IntervalSeconds)
+
+	// Provider'ların erişilebilirliği sync'ten bağımsız olarak periyodik probe edilir
+	healthMonitorDone := startProviderHealthMonitor(shutdownCtx, providerHealthUseCase, cfg.Health.ProviderCheckIntervalSeconds)
 
-	// 10. Periyodik senkronizasyon scheduler'ı başlat
-	startSyncScheduler(syncUseCase, cfg.Sync.IntervalSeconds)
+	// Senkronizasyonda güncellenmeyen içeriklerin güncellik skorları ve CTR sinyalleri her gece yeniden hesaplanır
+	recalcDone := startScoreRecalculationScheduler(shutdownCtx, refreshCTRUseCase, recalculateScoresUseCase, cfg.Scoring.RecalcHour)
 
-	// 11. HTTP handlers oluştur
+	// Değişiklik akışı yayınlayan provider'lar için olay consumer'ı (opsiyonel)
+	ingestDone := startIngestConsumer(shutdownCtx, syncUseCase, cfg.Ingest)
+
+	// Eşleşen içerikler sync biter bitmez, başarısız gönderimler backoff ile tekrar gönderilir
+	webhookDone := startWebhookWorker(shutdownCtx, webhookDispatcher, cfg.Webhook.PollIntervalSeconds)
+	savedSearchAlertDone := startSavedSearchAlerter(shutdownCtx, savedSearchAlerter)
+
+	// Zamanlanmış içerik yedeği (opsiyonel)
+	snapshotDone := startSnapshotScheduler(shutdownCtx, snapshotUseCase, cfg.Snapshot.IntervalHours)
+
+	// Bağlantı havuzu istatistikleri Prometheus'a aktarılır
+	dbPools := map[string]*sql.DB{"primary": db}
+	if readDB != nil {
+		dbPools["replica"] = readDB
+	}
+	dbStatsDone := startDBStatsExporter(shutdownCtx, dbPools, cfg.Database.StatsIntervalSeconds)
+
+	// 10. HTTP handlers oluştur
 	searchHandler := transportHttp.NewSearchHandler(searchUseCase)
+	contentHandler := transportHttp.NewContentHandler(contentUseCase)
+	contentVersionsHandler := transportHttp.NewContentVersionsHandler(contentVersionsUseCase)
+	authorsHandler := transportHttp.NewAuthorsHandler(listAuthorsUseCase)
+	categoriesHandler := transportHttp.NewCategoriesHandler(listCategoriesUseCase)
+	similarHandler := transportHttp.NewSimilarHandler(similarUseCase)
 	syncHandler := transportHttp.NewSyncHandler(syncUseCase)
+	syncHistoryHandler := transportHttp.NewSyncHistoryHandler(syncHistoryUseCase)
+	contentAuditHandler := transportHttp.NewContentAuditHandler(contentAuditUseCase)
+	moderationHandler := transportHttp.NewModerationHandler(moderationUseCase)
+	providerHandler := transportHttp.NewProviderHandler(providerUseCase)
+	providerHealthHandler := transportHttp.NewProviderHealthHandler(providerHealthUseCase)
+	scoringHandler := transportHttp.NewScoringHandler(scoringUseCase)
+	scoringHandler.SetRecalculator(recalculateScoresUseCase)
+	boostHandler := transportHttp.NewBoostHandler(boostUseCase)
+	apiKeyHandler := transportHttp.NewAPIKeyHandler(apiKeyUseCase)
+	webhookHandler := transportHttp.NewWebhookHandler(webhookUseCase)
+	savedSearchHandler := transportHttp.NewSavedSearchHandler(savedSearchUseCase)
+	searchEventsHandler := transportHttp.NewSearchEventsHandler(searchEventsUseCase)
+	zeroResultHandler := transportHttp.NewZeroResultQueriesHandler(zeroResultUseCase)
+	adminStatsHandler := transportHttp.NewAdminStatsHandler(adminStatsUseCase)
+	cacheHandler := transportHttp.NewCacheHandler(invalidateCacheUseCase)
+	featureFlagHandler := transportHttp.NewFeatureFlagHandler(featureFlagsUseCase)
+	snapshotHandler := transportHttp.NewSnapshotHandler(snapshotUseCase)
+	promotionHandler := transportHttp.NewPromotionHandler(promotionUseCase)
+	streamHandler := transportHttp.NewStreamHandler(contentStreamUseCase, time.Duration(cfg.Stream.HeartbeatSeconds)*time.Second)
 	healthHandler := transportHttp.NewHealthHandler(db, rdb)
+	healthHandler.SetProviderHealth(providerHealthUseCase)
+	healthHandler.SetSyncUseCase(syncUseCase)
 
-	// 12. Router setup
+	// 11. Router setup
 	r := mux.NewRouter()
 
 	// Global middleware'ler
 	r.Use(middleware.CORS)
+	r.Use(middleware.RequestID)
+	r.Use(middleware.Tracing)
+	r.Use(middleware.Metrics)
 	r.Use(middleware.Logging)
+	// POST/PUT/PATCH gövdeleri route bazında sınırlanır (varsayılan MAX_BODY_BYTES)
+	r.Use(middleware.NewBodyLimiter(cfg.Server.MaxBodyBytes, cfg.Server.BodyLimitRoutes).Middleware)
+
+	// Orchestrator probe'ları: liveness sadece process'i, readiness DB/Redis ve ilk sync'i kontrol eder
+	r.HandleFunc("/healthz", healthHandler.HandleLiveness).Methods("GET")
+	r.HandleFunc("/readyz", healthHandler.HandleReadiness).Methods("GET")
 
 	// API routes
 	api := r.PathPrefix("/api/v1").Subrouter()
 
 	// Rate limiter (search endpoint için)
+	// X-API-Key gönderen istemciler anahtarın kendi limitiyle, diğerleri IP bazında sınırlanır
 	rateLimiter := middleware.NewRateLimiter(cfg.Server.RateLimitPerMinute)
+	rateLimiter.SetKeyResolver(apiKeyUseCase)
 	rateLimiter.CleanupOldLimiters()
 
+	// Dışa aktarma tüm sonuçları okuduğundan ayrı ve daha düşük bir limitle sınırlanır
+	exportRateLimiter := middleware.NewRateLimiter(cfg.Search.ExportRateLimit)
+	exportRateLimiter.SetKeyResolver(apiKeyUseCase)
+	exportRateLimiter.CleanupOldLimiters()
+
 	// Public endpoints
-	api.HandleFunc("/search", searchHandler.HandleSearch).Methods("GET", "OPTIONS")
+	registerSearchRoute(api, searchHandler.HandleSearch, rateLimiter)
+	api.Handle("/search/export", exportRateLimiter.Middleware(http.HandlerFunc(searchHandler.HandleExport))).Methods("GET")
+	api.HandleFunc("/contents/{id}", contentHandler.HandleGet).Methods("GET")
+	api.HandleFunc("/contents/{id}/versions", contentVersionsHandler.HandleVersions).Methods("GET")
+	api.HandleFunc("/authors", authorsHandler.HandleList).Methods("GET")
+	api.HandleFunc("/categories", categoriesHandler.HandleList).Methods("GET")
+	api.Handle("/contents/{id}/similar", rateLimiter.Middleware(http.HandlerFunc(similarHandler.HandleSimilar))).Methods("GET")
 	api.HandleFunc("/health", healthHandler.HandleHealth).Methods("GET")
+	api.Handle("/stream", rateLimiter.Middleware(http.HandlerFunc(streamHandler.HandleStream))).Methods("GET")
+	api.Handle("/events", rateLimiter.Middleware(http.HandlerFunc(searchEventsHandler.HandleRecord))).Methods("POST", "OPTIONS")
+	// Kayıtlı aramalar anahtara bağlıdır; X-API-Key rate limiter'da çözülür
+	api.Handle("/saved-searches", rateLimiter.Middleware(http.HandlerFunc(savedSearchHandler.HandleList))).Methods("GET")
+	api.Handle("/saved-searches", rateLimiter.Middleware(http.HandlerFunc(savedSearchHandler.HandleCreate))).Methods("POST", "OPTIONS")
+	api.Handle("/saved-searches/{id}", rateLimiter.Middleware(http.HandlerFunc(savedSearchHandler.HandleDelete))).Methods("DELETE")
+
+	// Admin endpoints (rate limit yok, bearer token zorunlu)
+	admin := api.PathPrefix("/admin").Subrouter()
+	var authenticator *middleware.Authenticator // nil: admin kimlik doğrulaması kapalı (HTTP ve gRPC)
+	if cfg.Auth.Disabled {
+		logger.Warn("Admin authentication disabled, admin endpoints are open")
+	} else {
+		authenticator = middleware.NewAuthenticator(middleware.AuthConfig{
+			JWTSecrets:   cfg.Auth.JWTSecrets,
+			JWTIssuer:    cfg.Auth.JWTIssuer,
+			JWTAudience:  cfg.Auth.JWTAudience,
+			StaticTokens: cfg.Auth.StaticTokens,
+			ClockSkew:    time.Duration(cfg.Auth.ClockSkewSeconds) * time.Second,
+		})
+		if !authenticator.Configured() {
+			logger.Warn("No admin JWT secret or static token configured, all admin requests will be rejected")
+		}
+		admin.Use(authenticator.Middleware)
+	}
+	admin.HandleFunc("/stats", adminStatsHandler.HandleStats).Methods("GET")
+	admin.HandleFunc("/cache/invalidate", cacheHandler.HandleInvalidate).Methods("POST", "OPTIONS")
+	admin.HandleFunc("/flags", featureFlagHandler.HandleList).Methods("GET")
+	admin.HandleFunc("/flags/{name}", featureFlagHandler.HandleSet).Methods("PUT", "OPTIONS")
+	admin.HandleFunc("/flags/{name}", featureFlagHandler.HandleReset).Methods("DELETE")
+	admin.HandleFunc("/snapshots", snapshotHandler.HandleList).Methods("GET")
+	admin.HandleFunc("/snapshots", snapshotHandler.HandleCreate).Methods("POST", "OPTIONS")
+	admin.HandleFunc("/snapshots/{name}/restore", snapshotHandler.HandleRestore).Methods("POST", "OPTIONS")
+	admin.HandleFunc("/import", syncHandler.HandleImport).Methods("POST", "OPTIONS")
+	admin.HandleFunc("/sync", syncHandler.HandleSync).Methods("POST", "OPTIONS")
+	admin.HandleFunc("/sync/history", syncHistoryHandler.HandleHistory).Methods("GET")
+	admin.HandleFunc("/sync/errors", syncHistoryHandler.HandleErrors).Methods("GET")
+	admin.HandleFunc("/sync/{providerID:[0-9]+}", syncHandler.HandleSyncProvider).Methods("POST", "OPTIONS")
+	admin.HandleFunc("/sync/{jobID}", syncHandler.HandleSyncStatus).Methods("GET")
+	admin.HandleFunc("/contents/{id}/audit", contentAuditHandler.HandleAudit).Methods("GET")
+	admin.HandleFunc("/moderation", moderationHandler.HandleList).Methods("GET")
+	admin.HandleFunc("/moderation", moderationHandler.HandleReview).Methods("POST", "OPTIONS")
+	admin.HandleFunc("/providers", providerHandler.HandleList).Methods("GET")
+	admin.HandleFunc("/providers", providerHandler.HandleCreate).Methods("POST", "OPTIONS")
+	admin.HandleFunc("/providers/{id}", providerHandler.HandleUpdate).Methods("PUT", "OPTIONS")
+	admin.HandleFunc("/providers/{id}", providerHandler.HandleDelete).Methods("DELETE")
+	admin.HandleFunc("/providers/{id}/health", providerHealthHandler.HandleProviderHealth).Methods("GET")
+	admin.HandleFunc("/scoring", scoringHandler.HandleGet).Methods("GET")
+	admin.HandleFunc("/scoring", scoringHandler.HandleUpdate).Methods("PUT", "OPTIONS")
+	admin.HandleFunc("/scoring/recalculate", scoringHandler.HandleRecalculate).Methods("POST", "OPTIONS")
+	admin.HandleFunc("/boosts", boostHandler.HandleList).Methods("GET")
+	admin.HandleFunc("/boosts/{tag}", boostHandler.HandleSet).Methods("PUT", "OPTIONS")
+	admin.HandleFunc("/boosts/{tag}", boostHandler.HandleDelete).Methods("DELETE")
+	admin.HandleFunc("/promotions", promotionHandler.HandleList).Methods("GET")
+	admin.HandleFunc("/promotions", promotionHandler.HandleSave).Methods("PUT", "OPTIONS")
+	admin.HandleFunc("/promotions/{id}", promotionHandler.HandleDelete).Methods("DELETE")
+	admin.HandleFunc("/api-keys", apiKeyHandler.HandleList).Methods("GET")
+	admin.HandleFunc("/api-keys", apiKeyHandler.HandleCreate).Methods("POST", "OPTIONS")
+	admin.HandleFunc("/api-keys/{id}", apiKeyHandler.HandleRevoke).Methods("DELETE")
+	admin.HandleFunc("/analytics/ctr", searchEventsHandler.HandleCTR).Methods("GET")
+	admin.HandleFunc("/analytics/zero-results", zeroResultHandler.HandleList).Methods("GET")
+	admin.HandleFunc("/webhooks", webhookHandler.HandleList).Methods("GET")
+	admin.HandleFunc("/webhooks", webhookHandler.HandleCreate).Methods("POST", "OPTIONS")
+	admin.HandleFunc("/webhooks/{id}", webhookHandler.HandleUpdate).Methods("PUT", "OPTIONS")
+	admin.HandleFunc("/webhooks/{id}", webhookHandler.HandleDelete).Methods("DELETE")
+	admin.HandleFunc("/webhooks/{id}/deliveries", webhookHandler.HandleDeliveries).Methods("GET")
+
+	// OpenAPI dokümanı kayıtlı route'lardan üretilir; bu yüzden tüm API route'larından sonra eklenir
+	if cfg.Server.DocsEnabled {
+		apiDocs, undocumented, err := transportHttp.BuildAPIDocs(r)
+		if err != nil {
+			logger.Fatal("OpenAPI document could not be generated", zap.Error(err))
+		}
+		if len(undocumented) > 0 {
+			logger.Warn("Routes missing from the OpenAPI registry", zap.Strings("routes", undocumented))
+		}
+		docsHandler, err := transportHttp.NewDocsHandler(apiDocs, "/api/v1/docs/openapi.json")
+		if err != nil {
+			logger.Fatal("OpenAPI document could not be encoded", zap.Error(err))
+		}
+		api.HandleFunc("/docs", docsHandler.HandleUI).Methods("GET")
+		api.HandleFunc("/docs/openapi.json", docsHandler.HandleSpec).Methods("GET")
+	}
 
-	// Admin endpoints (rate limit yok)
-	api.HandleFunc("/admin/sync", syncHandler.HandleSync).Methods("POST", "OPTIONS")
+	// Prometheus metrics: METRICS_PORT verilmişse ayrı listener'da, yoksa API router'ında sunulur
+	var metricsServer *http.Server
+	if cfg.Metrics.Enabled {
+		if cfg.Metrics.Port == "" || cfg.Metrics.Port == cfg.Server.Port {
+			r.Handle(cfg.Metrics.Path, promhttp.Handler()).Methods("GET")
+			log.Printf("   - Metrics: http://localhost:%s%s", cfg.Server.Port, cfg.Metrics.Path)
+		} else {
+			metricsMux := http.NewServeMux()
+			metricsMux.Handle(cfg.Metrics.Path, promhttp.Handler())
+			metricsServer = &http.Server{
+				Addr:         ":" + cfg.Metrics.Port,
+				Handler:      metricsMux,
+				ReadTimeout:  time.Duration(cfg.Server.ReadTimeout) * time.Second,
+				WriteTimeout: time.Duration(cfg.Server.WriteTimeout) * time.Second,
+			}
+			go func() {
+				if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+					logger.Error("Metrics server hatası", zap.Error(err))
+				}
+			}()
+			log.Printf("   - Metrics: http://localhost:%s%s", cfg.Metrics.Port, cfg.Metrics.Path)
+		}
+	}
 
-	// Rate limiter'ı search endpoint'ine ekle
-	searchRoute := api.NewRoute().Path("/search").Methods("GET")
-	searchRoute.Handler(rateLimiter.Middleware(http.HandlerFunc(searchHandler.HandleSearch)))
+	// gRPC: iç servisler için aynı use case'ler üzerinden Search/GetContent/TriggerSync
+	var grpcServer *grpc.Server
+	if cfg.GRPC.Port != "" {
+		grpcListener, err := net.Listen("tcp", ":"+cfg.GRPC.Port)
+		if err != nil {
+			logger.Fatal("gRPC listener could not be opened", zap.Error(err))
+		}
+		grpcServer = transportGrpc.NewServer(
+			transportGrpc.NewSearchServer(searchUseCase, contentUseCase, syncUseCase),
+			authenticator,
+		)
+		go func() {
+			if err := grpcServer.Serve(grpcListener); err != nil {
+				logger.Error("gRPC server hatası", zap.Error(err))
+			}
+		}()
+		log.Printf("   - gRPC: localhost:%s (search.v1.SearchService)", cfg.GRPC.Port)
+	}
+
+	// Config hot reload: SIGHUP veya CONFIG_FILE değişikliğinde log seviyesi, rate limit'ler,
+	// cache TTL, varsayılan skorlama ağırlıkları ve feature flag varsayılanları yeniden başlatmadan uygulanır
+	configWatcher := config.NewWatcher(cfg)
+	configWatcher.Subscribe(func(c *config.Config) {
+		logger.SetLevel(c.Logger.Level)
+		rateLimiter.SetLimit(c.Server.RateLimitPerMinute)
+		exportRateLimiter.SetLimit(c.Search.ExportRateLimit)
+		searchUseCase.SetCacheTTL(time.Duration(c.Cache.TTLSeconds) * time.Second)
+		similarUseCase.SetCacheTTL(time.Duration(c.Cache.TTLSeconds) * time.Second)
+		scoringService.SetDefaults(scoringDefaults(c.Scoring))
+		featureFlags.SetDefaults(featureFlagDefaults(c.Flags))
+	})
+	go configWatcher.Run(shutdownCtx)
 
-	// 13. Server'ı başlat
+	// 12. Server'ı başlat
 	addr := ":" + cfg.Server.Port
 	log.Printf("🚀 Server başlatılıyor: http://localhost%s", addr)
 	log.Printf("   - Health check: http://localhost%s/api/v1/health", addr)
+	log.Printf("   - Liveness/Readiness: http://localhost%s/healthz, /readyz", addr)
 	log.Printf("   - Search: http://localhost%s/api/v1/search?query=go", addr)
+	log.Printf("   - Similar: http://localhost%s/api/v1/contents/1/similar", addr)
 	log.Printf("   - Admin sync: http://localhost%s/api/v1/admin/sync", addr)
+	log.Printf("   - Admin providers: http://localhost%s/api/v1/admin/providers", addr)
+	if cfg.Server.DocsEnabled {
+		log.Printf("   - API docs: http://localhost%s/api/v1/docs", addr)
+	}
 
-	if err := http.ListenAndServe(addr, r); err != nil {
-		log.Fatalf("Server başlatma hatası: %v", err)
+	server := &http.Server{
+		Addr:         addr,
+		Handler:      r,
+		ReadTimeout:  time.Duration(cfg.Server.ReadTimeout) * time.Second,
+		WriteTimeout: time.Duration(cfg.Server.WriteTimeout) * time.Second,
 	}
-}
+	// Shutdown açık SSE bağlantılarının kendiliğinden bitmesini beklemesin
+	server.RegisterOnShutdown(contentStreamUseCase.Close)
 
-// createProviderClients database'den provider'ları okuyup client'ları oluşturur
-func createProviderClients(db *sql.DB) []port.ProviderClient {
-	// Provider'ları database'den oku
-	rows, err := db.Query("SELECT id, name, url, format FROM providers WHERE is_active = true")
-	if err != nil {
-		log.Printf("Provider'lar okunamadı: %v", err)
-		return nil
+	serverErr := make(chan error, 1)
+	go func() {
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			serverErr <- err
+		}
+	}()
+
+	select {
+	case err := <-serverErr:
+		logger.Error("Server başlatma hatası", zap.Error(err))
+	case <-shutdownCtx.Done():
+		logger.Info("Shutdown sinyali alındı")
 	}
-	defer rows.Close()
+	stop()
 
-	var clients []port.ProviderClient
+	// 13. Graceful shutdown: önce yeni istekler kesilir, sonra devam eden sync'ler ve scheduler'lar beklenir
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), time.Duration(cfg.Server.ShutdownTimeout)*time.Second)
+	defer cancel()
 
-	for rows.Next() {
-		var p entity.Provider
-		if err := rows.Scan(&p.ID, &p.Name, &p.URL, &p.Format); err != nil {
-			log.Printf("Provider scan hatası: %v", err)
-			continue
+	if err := server.Shutdown(timeoutCtx); err != nil {
+		logger.Error("HTTP server shutdown hatası", zap.Error(err))
+	}
+	if grpcServer != nil {
+		stopGRPCServer(timeoutCtx, grpcServer)
+	}
+	if metricsServer != nil {
+		if err := metricsServer.Shutdown(timeoutCtx); err != nil {
+			logger.Error("Metrics server shutdown hatası", zap.Error(err))
 		}
+	}
+	// Sync'ler SERVER_SHUTDOWN_TIMEOUT kadar beklenir, süre dolarsa iptal edilir; scheduler'ın çalıştırdığı
+	// sync de bunlara dahildir, bu yüzden scheduler Shutdown'dan sonra beklenir
+	if err := syncUseCase.Shutdown(timeoutCtx); err != nil {
+		logger.Warn("Devam eden senkronizasyonlar iptal edildi", zap.Error(err))
+	}
+	<-schedulerDone
+	<-healthMonitorDone
+	<-recalcDone
+	<-ingestDone
+	<-webhookDone
+	<-savedSearchAlertDone
+	<-snapshotDone
+	<-dbStatsDone
+	// Son sync'lerin eşleşmeleri kaybolmasın; gönderim sonraki açılışta yapılır
+	enqueueWebhookChanges(timeoutCtx, webhookDispatcher)
+	// Kayıtlı arama bildirimlerinin kalıcı kuyruğu yok, kalanlar kapanmadan gönderilir
+	evaluateSavedSearches(timeoutCtx, savedSearchAlerter)
+	// Kuyruktaki span'ler collector'a gönderilir
+	if err := shutdownTracing(timeoutCtx); err != nil {
+		logger.Warn("Tracing shutdown hatası", zap.Error(err))
+	}
 
-		// Format'a göre uygun client oluştur
-		var client port.ProviderClient
+	logger.Info("Server durduruldu")
+}
 
-		switch p.Format {
-		case "json":
-			client = provider.NewJSONProvider(&p, p.URL)
-		case "xml":
-			client = provider.NewXMLProvider(&p, p.URL)
-		default:
-			log.Printf("Bilinmeyen provider formatı: %s", p.Format)
-			continue
-		}
+// scoringDefaults config'deki güncellik fonksiyonu, etkileşim normalizasyonu, video süresi, yorum ve CTR
+// ağırlıklarını scoring service varsayılanlarına çevirir
+func scoringDefaults(cfg config.ScoringConfig) service.ScoringRules {
+	return service.ScoringRules{
+		RecencyDecay:        entity.RecencyDecay(cfg.RecencyDecay),
+		RecencyHalfLifeDays: cfg.RecencyHalfLifeDays,
+		EngagementScaling:   entity.EngagementScaling(cfg.EngagementScaling),
+		EngagementCap:       cfg.EngagementCap,
+		VideoDurationWeight: cfg.VideoDurationWeight,
+		CommentWeight:       cfg.CommentWeight,
+		CTRWeight:           cfg.CTRWeight,
+	}
+}
 
-		clients = append(clients, client)
+// postgresPoolOptions config'teki bağlantı havuzu, TLS ve oturum ayarlarını primary ve replika için eşler
+func postgresPoolOptions(cfg config.DatabaseConfig) repository.PostgresPoolOptions {
+	return repository.PostgresPoolOptions{
+		MaxOpenConns:     cfg.MaxOpenConns,
+		MaxIdleConns:     cfg.MaxIdleConns,
+		ConnMaxLifetime:  time.Duration(cfg.ConnMaxLifetime) * time.Second,
+		ConnMaxIdleTime:  time.Duration(cfg.ConnMaxIdleTime) * time.Second,
+		SSLMode:          cfg.SSLMode,
+		SSLRootCert:      cfg.SSLRootCert,
+		SSLCert:          cfg.SSLCert,
+		SSLKey:           cfg.SSLKey,
+		StatementTimeout: time.Duration(cfg.StatementTimeoutMs) * time.Millisecond,
+		ApplicationName:  cfg.ApplicationName,
 	}
+}
 
-	return clients
+// featureFlagDefaults config'teki bayrak varsayılanlarını ada göre eşler
+func featureFlagDefaults(cfg config.FlagsConfig) map[string]bool {
+	return map[string]bool{
+		entity.FlagFuzzyFallback:   cfg.FuzzyFallback,
+		entity.FlagHybridRanking:   cfg.HybridRanking,
+		entity.FlagNegativeCaching: cfg.NegativeCaching,
+	}
+}
+
+// registerSearchRoute GET /search'ü rate limiter arkasında kaydeder
+// mux ilk eşleşen route'u kullandığından /search için limitersiz bir GET route'u olmamalıdır;
+// OPTIONS (CORS preflight) ayrı route'tur ve limite sayılmaz
+func registerSearchRoute(api *mux.Router, search http.HandlerFunc, limiter *middleware.RateLimiter) {
+	api.Handle("/search", limiter.Middleware(search)).Methods("GET")
+	api.HandleFunc("/search", search).Methods("OPTIONS")
+}
+
+// stopGRPCServer devam eden RPC'lerin bitmesini bekler; ctx süresi dolarsa bağlantıları zorla kapatır
+func stopGRPCServer(ctx context.Context, server *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		logger.Warn("gRPC graceful stop timed out, closing connections")
+		server.Stop()
+	}
 }
 
 // startSyncScheduler periyodik senkronizasyon scheduler'ını başlatır
-func startSyncScheduler(syncUseCase *usecase.SyncProviderContentsUseCase, intervalSeconds int) {
+// ctx iptal edildiğinde yeni senkronizasyon başlatılmaz; dönen kanal scheduler durunca kapanır
+func startSyncScheduler(ctx context.Context, syncUseCase *usecase.SyncProviderContentsUseCase, intervalSeconds int) <-chan struct{} {
+	done := make(chan struct{})
 	ticker := time.NewTicker(time.Duration(intervalSeconds) * time.Second)
 	go func() {
-		for range ticker.C {
-			log.Println("Periyodik senkronizasyon başlatılıyor...")
-			ctx := context.Background()
-			if err := syncUseCase.Execute(ctx); err != nil {
-				log.Printf("Periyodik senkronizasyon hatası: %v", err)
+		defer close(done)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Println("Periyodik senkronizasyon scheduler durduruldu")
+				return
+			case <-ticker.C:
+				log.Println("Periyodik senkronizasyon başlatılıyor...")
+				// Sync sinyalden bağımsız çalışır; shutdown'da syncUseCase.Shutdown bitmesini
+				// SERVER_SHUTDOWN_TIMEOUT kadar bekler, süre dolarsa iptal eder
+				// Süre sınırları (SYNC_TIMEOUT, SYNC_PROVIDER_TIMEOUT) use case içinde uygulanır
+				if err := syncUseCase.Execute(context.Background()); err != nil {
+					log.Printf("Periyodik senkronizasyon hatası: %v", err)
+				}
 			}
 		}
 	}()
 	log.Printf("✓ Periyodik senkronizasyon scheduler başlatıldı (%d saniye aralıkla)", intervalSeconds)
+	return done
+}
+
+// startProviderHealthMonitor aktif provider'ları başlangıçta ve periyodik olarak probe eder
+// ctx iptal edildiğinde devam eden probe'lar iptal edilir; dönen kanal monitor durunca kapanır
+func startProviderHealthMonitor(ctx context.Context, healthUseCase *usecase.ProviderHealthUseCase, intervalSeconds int) <-chan struct{} {
+	done := make(chan struct{})
+	ticker := time.NewTicker(time.Duration(intervalSeconds) * time.Second)
+	go func() {
+		defer close(done)
+		defer ticker.Stop()
+		for {
+			results := healthUseCase.CheckAll(ctx)
+			down := 0
+			for _, result := range results {
+				if result.Status == entity.ProviderHealthDown {
+					down++
+				}
+			}
+			if down > 0 {
+				logger.Warn("Erişilemeyen provider'lar var", zap.Int("down", down), zap.Int("checked", len(results)))
+			}
+
+			select {
+			case <-ctx.Done():
+				log.Println("Provider sağlık kontrolü durduruldu")
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	log.Printf("✓ Provider sağlık kontrolü başlatıldı (%d saniye aralıkla)", intervalSeconds)
+	return done
+}
+
+// startScoreRecalculationScheduler her gün hour saatinde CTR sinyallerini yeniler ve tüm içeriklerin skorlarını yeniden hesaplar
+// CTR yenilemesi başarısız olursa skorlar kayıtlı sinyallerle hesaplanır
+// ctx iptal edildiğinde devam eden hesaplama iptal edilir; dönen kanal scheduler durunca kapanır
+func startScoreRecalculationScheduler(ctx context.Context, ctrUseCase *usecase.RefreshCTRSignalsUseCase, recalcUseCase *usecase.RecalculateScoresUseCase, hour int) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			next := nextDailyRun(time.Now(), hour)
+			timer := time.NewTimer(time.Until(next))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				log.Println("Skor yeniden hesaplama scheduler durduruldu")
+				return
+			case <-timer.C:
+			}
+
+			if stats, err := ctrUseCase.Execute(ctx); err != nil {
+				logger.Error("CTR sinyali yenileme hatası", zap.Error(err))
+			} else {
+				logger.Info("CTR signals refreshed",
+					zap.Int("contents", stats.Contents),
+					zap.Int("signals", stats.Signals),
+					zap.Int("updated", stats.Updated),
+					zap.Float64("global_ctr", stats.GlobalCTR),
+				)
+			}
+
+			if _, err := recalcUseCase.Execute(ctx); err != nil {
+				logger.Error("Skor yeniden hesaplama hatası", zap.Error(err))
+			}
+		}
+	}()
+	log.Printf("✓ Skor yeniden hesaplama scheduler başlatıldı (her gün %02d:00)", hour)
+	return done
+}
+
+// startDBStatsExporter her intervalSeconds saniyede bir bağlantı havuzlarının istatistiklerini Prometheus'a yazar
+// intervalSeconds 0 ise exporter başlatılmaz; dönen kanal exporter durunca kapanır
+func startDBStatsExporter(ctx context.Context, pools map[string]*sql.DB, intervalSeconds int) <-chan struct{} {
+	done := make(chan struct{})
+	if intervalSeconds <= 0 {
+		close(done)
+		return done
+	}
+
+	ticker := time.NewTicker(time.Duration(intervalSeconds) * time.Second)
+	go func() {
+		defer close(done)
+		defer ticker.Stop()
+		previous := make(map[string]sql.DBStats, len(pools))
+		for {
+			for name, pool := range pools {
+				stats := pool.Stats()
+				metrics.RecordDBStats(name, stats, previous[name])
+				previous[name] = stats
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return done
+}
+
+// startSnapshotScheduler her intervalHours saatte bir içerik yedeği alır; intervalHours 0 ise scheduler başlatılmaz
+// ctx iptal edildiğinde devam eden yedek iptal edilir; dönen kanal scheduler durunca kapanır
+func startSnapshotScheduler(ctx context.Context, snapshotUseCase *usecase.SnapshotUseCase, intervalHours int) <-chan struct{} {
+	done := make(chan struct{})
+	if intervalHours <= 0 {
+		close(done)
+		return done
+	}
+
+	ticker := time.NewTicker(time.Duration(intervalHours) * time.Hour)
+	go func() {
+		defer close(done)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Println("Yedekleme scheduler durduruldu")
+				return
+			case <-ticker.C:
+			}
+
+			run, err := snapshotUseCase.Export(ctx)
+			if err != nil {
+				logger.Error("Zamanlanmış yedek alma hatası", zap.Error(err))
+				continue
+			}
+			logger.Info("Snapshot exported",
+				zap.String("name", run.Name),
+				zap.Int("providers", run.Providers),
+				zap.Int("contents", run.Contents),
+			)
+		}
+	}()
+	log.Printf("✓ Yedekleme scheduler başlatıldı (%d saat aralıkla)", intervalHours)
+	return done
+}
+
+// newSnapshotStore yapılandırmaya göre yedek depolama alanını oluşturur
+// GCS, HMAC anahtarlarıyla S3 uyumlu XML API üzerinden kullanılır
+func newSnapshotStore(cfg config.SnapshotConfig) port.SnapshotStore {
+	switch cfg.Store {
+	case "s3", "gcs":
+		endpoint, region := cfg.Endpoint, cfg.Region
+		if cfg.Store == "gcs" {
+			region = "auto"
+			if endpoint == "" {
+				endpoint = "https://storage.googleapis.com"
+			}
+		} else if endpoint == "" {
+			endpoint = "https://s3." + region + ".amazonaws.com"
+		}
+		return snapshot.NewS3Store(snapshot.S3Config{
+			Endpoint:  endpoint,
+			Region:    region,
+			Bucket:    cfg.Bucket,
+			Prefix:    cfg.Prefix,
+			AccessKey: cfg.AccessKey,
+			SecretKey: cfg.SecretKey,
+		}, &http.Client{Timeout: 30 * time.Minute})
+	default:
+		return snapshot.NewLocalStore(cfg.LocalDir)
+	}
+}
+
+// nextDailyRun now'dan sonraki ilk hour:00 zamanını döner
+func nextDailyRun(now time.Time, hour int) time.Time {
+	next := time.Date(now.Year(), now.Month(), now.Day(), hour, 0, 0, 0, now.Location())
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
+
+// startIngestConsumer config'de broker tanımlıysa içerik olay consumer'ını başlatır
+// Consumer oluşturulamazsa açılış durdurulur. ctx iptal edildiğinde consumer durur;
+// dönen kanal consumer durunca (veya broker tanımlı değilse hemen) kapanır
+func startIngestConsumer(ctx context.Context, syncUseCase *usecase.SyncProviderContentsUseCase, cfg config.IngestConfig) <-chan struct{} {
+	done := make(chan struct{})
+	if cfg.Broker == "" {
+		close(done)
+		return done
+	}
+
+	consumer, err := ingest.NewConsumer(ingest.Config{
+		Broker:  cfg.Broker,
+		URL:     cfg.URL,
+		Subject: cfg.Subject,
+		Group:   cfg.Group,
+		Stream:  cfg.Stream,
+
+		CredsFile:    cfg.CredsFile,
+		NKeySeedFile: cfg.NKeySeedFile,
+		TLSCAFile:    cfg.TLSCAFile,
+		TLSCertFile:  cfg.TLSCertFile,
+		TLSKeyFile:   cfg.TLSKeyFile,
+
+		MaxDeliver: cfg.MaxDeliver,
+		AckWait:    time.Duration(cfg.AckWaitSeconds) * time.Second,
+	})
+	if err != nil {
+		// Yapılandırılmış ama çalışamayan bir consumer olayları sessizce kaçırır, açılış durdurulur
+		logger.Fatal("Ingest consumer could not be created", zap.Error(err))
+	}
+
+	go func() {
+		defer close(done)
+		if err := consumer.Consume(ctx, syncUseCase.IngestEvent); err != nil {
+			logger.Error("Ingest consumer stopped", zap.Error(err))
+		}
+	}()
+	logger.Info("Ingest consumer started", zap.String("broker", cfg.Broker), zap.String("subject", cfg.Subject))
+	return done
+}
+
+// startWebhookWorker sync'te yazılan içerikleri webhook abonelikleriyle eşleştirip kuyruğa alır ve
+// zamanı gelen gönderimleri yapar. Sync sonrası hemen, tekrar denemeler için intervalSeconds aralıkla çalışır
+// ctx iptal edildiğinde devam eden gönderimler iptal edilir; dönen kanal worker durunca kapanır
+func startWebhookWorker(ctx context.Context, dispatcher *usecase.WebhookDispatcher, intervalSeconds int) <-chan struct{} {
+	done := make(chan struct{})
+	ticker := time.NewTicker(time.Duration(intervalSeconds) * time.Second)
+	go func() {
+		defer close(done)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Println("Webhook worker durduruldu")
+				return
+			case <-dispatcher.Ready():
+			case <-ticker.C:
+			}
+
+			enqueueWebhookChanges(ctx, dispatcher)
+
+			stats, err := dispatcher.DeliverDue(ctx)
+			metrics.RecordWebhookDeliveries("delivered", stats.Delivered)
+			metrics.RecordWebhookDeliveries("retried", stats.Retried)
+			metrics.RecordWebhookDeliveries("failed", stats.Failed)
+			if err != nil {
+				logger.Error("Webhook deliveries failed", zap.Error(err))
+			}
+			if stats.Failed > 0 {
+				logger.Warn("Webhook deliveries gave up", zap.Int("failed", stats.Failed))
+			}
+		}
+	}()
+	log.Printf("✓ Webhook worker başlatıldı (%d saniye aralıkla)", intervalSeconds)
+	return done
+}
+
+// startSavedSearchAlerter sync'te eklenen içerikler için kayıtlı arama bildirimlerini gönderir
+// Sadece Publish sinyaliyle çalışır; dönen kanal worker durunca kapanır
+func startSavedSearchAlerter(ctx context.Context, alerter *usecase.SavedSearchAlerter) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-ctx.Done():
+				log.Println("Kayıtlı arama bildirimleri durduruldu")
+				return
+			case <-alerter.Ready():
+			}
+			evaluateSavedSearches(ctx, alerter)
+		}
+	}()
+	log.Println("✓ Kayıtlı arama bildirimleri başlatıldı")
+	return done
+}
+
+// evaluateSavedSearches bekleyen yeni içerikleri kayıtlı aramalarla eşleştirip bildirimleri gönderir
+func evaluateSavedSearches(ctx context.Context, alerter *usecase.SavedSearchAlerter) {
+	stats, err := alerter.Evaluate(ctx)
+	metrics.RecordSavedSearchAlerts("notified", stats.Notified)
+	metrics.RecordSavedSearchAlerts("failed", stats.Failed)
+	metrics.RecordSavedSearchChangesDropped(stats.Dropped)
+	if err != nil {
+		logger.Error("Saved search alerts could not be evaluated", zap.Error(err))
+	}
+	if stats.Failed > 0 {
+		logger.Warn("Saved search alerts failed", zap.Int("failed", stats.Failed))
+	}
+	if stats.Dropped > 0 {
+		logger.Warn("Saved search changes dropped, pending queue is full", zap.Int("dropped", stats.Dropped))
+	}
+}
+
+// enqueueWebhookChanges bekleyen içerik değişikliklerini webhook gönderim kuyruğuna alır
+func enqueueWebhookChanges(ctx context.Context, dispatcher *usecase.WebhookDispatcher) {
+	enqueued, dropped, err := dispatcher.EnqueuePending(ctx)
+	metrics.RecordWebhookDeliveries("enqueued", enqueued)
+	metrics.RecordWebhookChangesDropped(dropped)
+	if err != nil {
+		logger.Error("Webhook deliveries could not be enqueued", zap.Error(err))
+	}
+	if dropped > 0 {
+		logger.Warn("Webhook changes dropped, pending queue is full", zap.Int("dropped", dropped))
+	}
 }
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/onurerdog4n/search-engine/internal/transport/middleware"
+)
+
+func TestRegisterSearchRoute(t *testing.T) {
+	r := mux.NewRouter()
+	r.Use(middleware.CORS)
+	api := r.PathPrefix("/api/v1").Subrouter()
+	registerSearchRoute(api, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}, middleware.NewRateLimiter(1))
+
+	search := func(method string) int {
+		req := httptest.NewRequest(method, "/api/v1/search?q=go", nil)
+		req.RemoteAddr = "203.0.113.7:1234"
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusOK, search(http.MethodGet))
+	assert.Equal(t, http.StatusTooManyRequests, search(http.MethodGet))
+	// Preflight istekleri limite takılmaz
+	assert.Equal(t, http.StatusOK, search(http.MethodOptions))
+}
@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// CacheStatsSource süreç başlangıcından beri cache okuma sayılarını sağlar
+type CacheStatsSource interface {
+	CacheStats() entity.CacheStats
+}
+
+// SearchIndexStatusSource harici arama indeksinin son yeniden oluşturma durumunu sağlar
+type SearchIndexStatusSource interface {
+	IndexStatus() entity.SearchIndexStatus
+}
+
+// AdminStatsUseCase yönetim paneli için içerik, senkronizasyon, cache ve indeks özetini tek seferde üretir
+type AdminStatsUseCase struct {
+	statsRepo port.AdminStatsRepository
+	now       func() time.Time
+
+	cacheStats  CacheStatsSource        // nil ise cache sayıları sıfır döner
+	indexStatus SearchIndexStatusSource // nil ise arama Postgres'ten yapılır, indeks her zaman günceldir
+}
+
+// NewAdminStatsUseCase yeni bir yönetim paneli özeti use case oluşturur
+func NewAdminStatsUseCase(statsRepo port.AdminStatsRepository) *AdminStatsUseCase {
+	return &AdminStatsUseCase{
+		statsRepo: statsRepo,
+		now:       time.Now,
+	}
+}
+
+// SetCacheStats cache isabet oranının okunacağı kaynağı ayarlar
+func (uc *AdminStatsUseCase) SetCacheStats(source CacheStatsSource) {
+	uc.cacheStats = source
+}
+
+// SetSearchIndex harici arama indeksinin durum kaynağını ayarlar
+func (uc *AdminStatsUseCase) SetSearchIndex(source SearchIndexStatusSource) {
+	uc.indexStatus = source
+}
+
+// Execute özeti üretir; toplam içerik sayıları provider'ların toplamıdır
+func (uc *AdminStatsUseCase) Execute(ctx context.Context) (*entity.AdminStats, error) {
+	providers, err := uc.statsRepo.ListProviderStats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("provider istatistikleri okunamadı: %w", err)
+	}
+
+	stats := &entity.AdminStats{
+		GeneratedAt: uc.now(),
+		Contents:    entity.ContentCountStats{ByType: make(map[string]int64)},
+		Providers:   providers,
+	}
+	for _, provider := range providers {
+		stats.Contents.Active += provider.Active
+		stats.Contents.Deleted += provider.Deleted
+		for contentType, count := range provider.ByType {
+			stats.Contents.ByType[contentType] += count
+		}
+	}
+
+	if uc.cacheStats != nil {
+		stats.Cache = uc.cacheStats.CacheStats()
+	}
+
+	stats.Index, err = uc.index(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// index arama indeksinin durumunu en son değişen içerikle karşılaştırır
+func (uc *AdminStatsUseCase) index(ctx context.Context) (entity.SearchIndexStatus, error) {
+	status := entity.SearchIndexStatus{Backend: "postgres"}
+	if uc.indexStatus != nil {
+		status = uc.indexStatus.IndexStatus()
+	}
+
+	latest, err := uc.statsRepo.LatestContentUpdate(ctx)
+	if err != nil {
+		return status, fmt.Errorf("son içerik güncellemesi okunamadı: %w", err)
+	}
+	status.LatestContentUpdateAt = latest
+
+	if uc.indexStatus != nil {
+		// Hiç oluşturulmamış indeks veya oluşturulduktan sonra değişen içerik
+		status.Stale = status.LastReindexAt == nil || (latest != nil && latest.After(*status.LastReindexAt))
+	}
+	return status, nil
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+)
+
+// mockAdminStatsRepository sabit provider istatistikleri ve son güncelleme zamanı döner
+type mockAdminStatsRepository struct {
+	providers []*entity.ProviderStats
+	latest    *time.Time
+}
+
+func (m *mockAdminStatsRepository) ListProviderStats(ctx context.Context) ([]*entity.ProviderStats, error) {
+	return m.providers, nil
+}
+
+func (m *mockAdminStatsRepository) LatestContentUpdate(ctx context.Context) (*time.Time, error) {
+	return m.latest, nil
+}
+
+type stubCacheStats entity.CacheStats
+
+func (s stubCacheStats) CacheStats() entity.CacheStats { return entity.CacheStats(s) }
+
+type stubIndexStatus entity.SearchIndexStatus
+
+func (s stubIndexStatus) IndexStatus() entity.SearchIndexStatus { return entity.SearchIndexStatus(s) }
+
+func TestAdminStatsUseCase_Execute(t *testing.T) {
+	now := time.Date(2024, 1, 20, 12, 0, 0, 0, time.UTC)
+	latest := now.Add(-time.Hour)
+	repo := &mockAdminStatsRepository{
+		providers: []*entity.ProviderStats{
+			{ProviderID: 1, ContentCountStats: entity.ContentCountStats{Active: 3, Deleted: 1, ByType: map[string]int64{"video": 2, "article": 1}}},
+			{ProviderID: 2, ContentCountStats: entity.ContentCountStats{Active: 2, ByType: map[string]int64{"video": 2}}},
+		},
+		latest: &latest,
+	}
+
+	t.Run("totals and postgres index", func(t *testing.T) {
+		useCase := NewAdminStatsUseCase(repo)
+		useCase.now = func() time.Time { return now }
+
+		stats, err := useCase.Execute(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, now, stats.GeneratedAt)
+		assert.Equal(t, entity.ContentCountStats{Active: 5, Deleted: 1, ByType: map[string]int64{"video": 4, "article": 1}}, stats.Contents)
+		assert.Len(t, stats.Providers, 2)
+		assert.Zero(t, stats.Cache)
+		assert.Equal(t, "postgres", stats.Index.Backend)
+		assert.False(t, stats.Index.Stale)
+		assert.Equal(t, &latest, stats.Index.LatestContentUpdateAt)
+	})
+
+	t.Run("cache and external index", func(t *testing.T) {
+		reindexedAt := latest.Add(-time.Minute)
+		useCase := NewAdminStatsUseCase(repo)
+		useCase.SetCacheStats(stubCacheStats{Hits: 3, Misses: 1, HitRatio: 0.75})
+		useCase.SetSearchIndex(stubIndexStatus{Backend: "elasticsearch", LastReindexAt: &reindexedAt, IndexedContents: 5})
+
+		stats, err := useCase.Execute(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 0.75, stats.Cache.HitRatio)
+		assert.Equal(t, "elasticsearch", stats.Index.Backend)
+		assert.True(t, stats.Index.Stale)
+
+		// İçerik indeks oluşturulmadan önce değiştiyse indeks günceldir
+		reindexedAt = latest.Add(time.Minute)
+		useCase.SetSearchIndex(stubIndexStatus{Backend: "elasticsearch", LastReindexAt: &reindexedAt})
+		stats, err = useCase.Execute(context.Background())
+		require.NoError(t, err)
+		assert.False(t, stats.Index.Stale)
+	})
+}
@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// API anahtarı sınırları
+const (
+	apiKeyPrefix          = "sek_"
+	apiKeyRandomBytes     = 24
+	apiKeyDisplayLen      = 12 // Listede gösterilen ön ek uzunluğu ("sek_" + 8 karakter)
+	maxAPIKeyNameLen      = 100
+	maxAPIKeyRateLimit    = 100000
+	apiKeyCacheTTL        = time.Minute
+	maxAPIKeyCacheEntries = 10000
+)
+
+// ManageAPIKeysUseCase public API anahtarları yönetimi (admin) ve istek anında çözümleme use case'i
+// Çözümlenen anahtarlar kısa süre bellekte tutulur; iptal başka bir instance'a en geç apiKeyCacheTTL içinde yansır
+type ManageAPIKeysUseCase struct {
+	apiKeyRepo port.APIKeyRepository
+	now        func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cachedAPIKey
+}
+
+// cachedAPIKey hash için çözümleme sonucu; key nil ise anahtar bulunamadı/iptal edilmiş demektir
+type cachedAPIKey struct {
+	key       *entity.APIKey
+	expiresAt time.Time
+}
+
+// APIKeyInput anahtar oluşturma isteği
+type APIKeyInput struct {
+	Name               string `json:"name"`
+	RateLimitPerMinute int    `json:"rate_limit_per_minute"` // 0: varsayılan limit
+}
+
+// CreatedAPIKey yeni oluşturulan anahtar; Key sadece bu yanıtta döner, sonradan okunamaz
+type CreatedAPIKey struct {
+	*entity.APIKey
+	Key string `json:"key"`
+}
+
+// NewManageAPIKeysUseCase yeni bir API anahtarı yönetim use case oluşturur
+func NewManageAPIKeysUseCase(apiKeyRepo port.APIKeyRepository) *ManageAPIKeysUseCase {
+	return &ManageAPIKeysUseCase{
+		apiKeyRepo: apiKeyRepo,
+		now:        time.Now,
+		cache:      make(map[string]cachedAPIKey),
+	}
+}
+
+// Create yeni bir anahtar üretir ve hash'ini kaydeder
+func (uc *ManageAPIKeysUseCase) Create(ctx context.Context, input APIKeyInput) (*CreatedAPIKey, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" || len(name) > maxAPIKeyNameLen {
+		return nil, apperrors.NewValidationError("name", fmt.Sprintf("name must be between 1 and %d characters", maxAPIKeyNameLen), input.Name)
+	}
+	if input.RateLimitPerMinute < 0 || input.RateLimitPerMinute > maxAPIKeyRateLimit {
+		return nil, apperrors.NewValidationError("rate_limit_per_minute", fmt.Sprintf("rate_limit_per_minute must be between 0 and %d", maxAPIKeyRateLimit), input.RateLimitPerMinute)
+	}
+
+	raw, err := generateAPIKey()
+	if err != nil {
+		return nil, fmt.Errorf("API anahtarı üretilemedi: %w", err)
+	}
+
+	key := &entity.APIKey{
+		Name:               name,
+		Prefix:             raw[:apiKeyDisplayLen],
+		KeyHash:            hashAPIKey(raw),
+		RateLimitPerMinute: input.RateLimitPerMinute,
+	}
+	if err := uc.apiKeyRepo.CreateAPIKey(ctx, key); err != nil {
+		return nil, fmt.Errorf("API anahtarı kaydedilemedi: %w", err)
+	}
+
+	return &CreatedAPIKey{APIKey: key, Key: raw}, nil
+}
+
+// List tüm anahtarları döner (anahtarların kendisi olmadan)
+func (uc *ManageAPIKeysUseCase) List(ctx context.Context) ([]*entity.APIKey, error) {
+	keys, err := uc.apiKeyRepo.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("API anahtarları okunamadı: %w", err)
+	}
+	return keys, nil
+}
+
+// Revoke anahtarı iptal eder
+// Anahtar bulunamazsa port.ErrAPIKeyNotFound döner
+func (uc *ManageAPIKeysUseCase) Revoke(ctx context.Context, id int64) (*entity.APIKey, error) {
+	key, err := uc.apiKeyRepo.RevokeAPIKey(ctx, id)
+	if err != nil {
+		if err == port.ErrAPIKeyNotFound {
+			return nil, err
+		}
+		return nil, fmt.Errorf("API anahtarı iptal edilemedi: %w", err)
+	}
+
+	uc.mu.Lock()
+	delete(uc.cache, key.KeyHash)
+	uc.mu.Unlock()
+
+	return key, nil
+}
+
+// ResolveAPIKey istekte gönderilen anahtarı aktif bir kayda çözümler
+// Bilinmeyen veya iptal edilmiş anahtarlar için port.ErrAPIKeyNotFound döner
+func (uc *ManageAPIKeysUseCase) ResolveAPIKey(ctx context.Context, raw string) (*entity.APIKey, error) {
+	hash := hashAPIKey(raw)
+	now := uc.now()
+
+	uc.mu.Lock()
+	cached, ok := uc.cache[hash]
+	uc.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		if cached.key == nil {
+			return nil, port.ErrAPIKeyNotFound
+		}
+		return cached.key, nil
+	}
+
+	key, err := uc.apiKeyRepo.FindAPIKeyByHash(ctx, hash)
+	if err != nil && err != port.ErrAPIKeyNotFound {
+		return nil, fmt.Errorf("API anahtarı okunamadı: %w", err)
+	}
+	if key != nil && !key.IsActive() {
+		key = nil
+	}
+
+	uc.mu.Lock()
+	if len(uc.cache) >= maxAPIKeyCacheEntries {
+		// Rastgele anahtarlarla belleğin şişmesini önlemek için basitçe sıfırlanır
+		uc.cache = make(map[string]cachedAPIKey)
+	}
+	uc.cache[hash] = cachedAPIKey{key: key, expiresAt: now.Add(apiKeyCacheTTL)}
+	uc.mu.Unlock()
+
+	if key == nil {
+		return nil, port.ErrAPIKeyNotFound
+	}
+	return key, nil
+}
+
+// generateAPIKey "sek_" ön ekli rastgele bir anahtar üretir
+func generateAPIKey() (string, error) {
+	buf := make([]byte, apiKeyRandomBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return apiKeyPrefix + hex.EncodeToString(buf), nil
+}
+
+// hashAPIKey anahtarın saklanan SHA-256 hash'ini döner
+func hashAPIKey(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
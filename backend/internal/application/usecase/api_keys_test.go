@@ -0,0 +1,159 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+type mockAPIKeyRepository struct {
+	keys    []*entity.APIKey
+	lookups int
+	findErr error
+}
+
+func (m *mockAPIKeyRepository) CreateAPIKey(ctx context.Context, key *entity.APIKey) error {
+	key.ID = int64(len(m.keys) + 1)
+	m.keys = append(m.keys, key)
+	return nil
+}
+
+func (m *mockAPIKeyRepository) FindAPIKeyByHash(ctx context.Context, keyHash string) (*entity.APIKey, error) {
+	m.lookups++
+	if m.findErr != nil {
+		return nil, m.findErr
+	}
+	for _, key := range m.keys {
+		if key.KeyHash == keyHash {
+			return key, nil
+		}
+	}
+	return nil, port.ErrAPIKeyNotFound
+}
+
+func (m *mockAPIKeyRepository) ListAPIKeys(ctx context.Context) ([]*entity.APIKey, error) {
+	return m.keys, nil
+}
+
+func (m *mockAPIKeyRepository) RevokeAPIKey(ctx context.Context, id int64) (*entity.APIKey, error) {
+	for _, key := range m.keys {
+		if key.ID == id {
+			now := time.Now()
+			key.RevokedAt = &now
+			return key, nil
+		}
+	}
+	return nil, port.ErrAPIKeyNotFound
+}
+
+func TestManageAPIKeysUseCase_Create(t *testing.T) {
+	repo := &mockAPIKeyRepository{}
+	useCase := NewManageAPIKeysUseCase(repo)
+
+	created, err := useCase.Create(context.Background(), APIKeyInput{Name: "  partner-a ", RateLimitPerMinute: 600})
+	require.NoError(t, err)
+	assert.Equal(t, "partner-a", created.Name)
+	assert.Equal(t, 600, created.RateLimitPerMinute)
+	assert.True(t, strings.HasPrefix(created.Key, "sek_"))
+	assert.Equal(t, created.Key[:12], created.Prefix)
+	assert.Equal(t, hashAPIKey(created.Key), repo.keys[0].KeyHash)
+	assert.NotContains(t, repo.keys[0].KeyHash, created.Key)
+
+	other, err := useCase.Create(context.Background(), APIKeyInput{Name: "partner-b"})
+	require.NoError(t, err)
+	assert.NotEqual(t, created.Key, other.Key)
+
+	inputs := []APIKeyInput{
+		{Name: ""},
+		{Name: strings.Repeat("a", maxAPIKeyNameLen+1)},
+		{Name: "negative", RateLimitPerMinute: -1},
+		{Name: "huge", RateLimitPerMinute: maxAPIKeyRateLimit + 1},
+	}
+	for _, input := range inputs {
+		_, err := useCase.Create(context.Background(), input)
+		var validationErr *apperrors.ValidationError
+		assert.ErrorAs(t, err, &validationErr, "input: %+v", input)
+	}
+}
+
+func TestManageAPIKeysUseCase_ResolveAPIKey(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("resolves and caches active keys", func(t *testing.T) {
+		repo := &mockAPIKeyRepository{}
+		useCase := NewManageAPIKeysUseCase(repo)
+		created, err := useCase.Create(ctx, APIKeyInput{Name: "partner-a", RateLimitPerMinute: 600})
+		require.NoError(t, err)
+
+		for i := 0; i < 3; i++ {
+			key, err := useCase.ResolveAPIKey(ctx, created.Key)
+			require.NoError(t, err)
+			assert.Equal(t, created.ID, key.ID)
+		}
+		assert.Equal(t, 1, repo.lookups)
+	})
+
+	t.Run("unknown keys are rejected and cached", func(t *testing.T) {
+		repo := &mockAPIKeyRepository{}
+		useCase := NewManageAPIKeysUseCase(repo)
+
+		for i := 0; i < 2; i++ {
+			_, err := useCase.ResolveAPIKey(ctx, "sek_unknown")
+			assert.ErrorIs(t, err, port.ErrAPIKeyNotFound)
+		}
+		assert.Equal(t, 1, repo.lookups)
+	})
+
+	t.Run("revoked keys are rejected immediately", func(t *testing.T) {
+		repo := &mockAPIKeyRepository{}
+		useCase := NewManageAPIKeysUseCase(repo)
+		created, err := useCase.Create(ctx, APIKeyInput{Name: "partner-a"})
+		require.NoError(t, err)
+
+		_, err = useCase.ResolveAPIKey(ctx, created.Key)
+		require.NoError(t, err)
+
+		_, err = useCase.Revoke(ctx, created.ID)
+		require.NoError(t, err)
+
+		_, err = useCase.ResolveAPIKey(ctx, created.Key)
+		assert.ErrorIs(t, err, port.ErrAPIKeyNotFound)
+	})
+
+	t.Run("cache entries expire", func(t *testing.T) {
+		repo := &mockAPIKeyRepository{}
+		useCase := NewManageAPIKeysUseCase(repo)
+		now := time.Now()
+		useCase.now = func() time.Time { return now }
+
+		_, err := useCase.ResolveAPIKey(ctx, "sek_unknown")
+		assert.ErrorIs(t, err, port.ErrAPIKeyNotFound)
+
+		now = now.Add(apiKeyCacheTTL + time.Second)
+		_, err = useCase.ResolveAPIKey(ctx, "sek_unknown")
+		assert.ErrorIs(t, err, port.ErrAPIKeyNotFound)
+		assert.Equal(t, 2, repo.lookups)
+	})
+
+	t.Run("repository errors are not cached", func(t *testing.T) {
+		repo := &mockAPIKeyRepository{findErr: errors.New("connection refused")}
+		useCase := NewManageAPIKeysUseCase(repo)
+
+		_, err := useCase.ResolveAPIKey(ctx, "sek_any")
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, port.ErrAPIKeyNotFound)
+
+		_, err = useCase.ResolveAPIKey(ctx, "sek_any")
+		require.Error(t, err)
+		assert.Equal(t, 2, repo.lookups)
+	})
+}
@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/domain/service"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/logger"
+)
+
+// Boost kuralı sınırları
+const (
+	minBoostPercent = -100.0
+	maxBoostPercent = 1000.0
+	maxBoostTagLen  = 100
+)
+
+// ManageBoostRulesUseCase tag boost kuralları yönetimi (admin) use case'i
+// Kurallar veritabanında saklanır ve scoring service'e yüklenir; boost skor hesaplanırken uygulanır
+type ManageBoostRulesUseCase struct {
+	boostRepo      port.BoostRuleRepository
+	scoringService service.ScoringService
+	cache          port.CacheRepository
+	recalculator   ScoreRecalculator // nil ise kayıtlı skorlar bir sonraki senkronizasyona kadar değişmez
+}
+
+// ScoreRecalculator boost değişikliklerinden sonra kayıtlı skorları yeniden hesaplar
+type ScoreRecalculator interface {
+	Execute(ctx context.Context) (int, error)
+}
+
+// NewManageBoostRulesUseCase yeni bir boost kuralları yönetim use case oluşturur
+func NewManageBoostRulesUseCase(
+	boostRepo port.BoostRuleRepository,
+	scoringService service.ScoringService,
+	cache port.CacheRepository,
+) *ManageBoostRulesUseCase {
+	return &ManageBoostRulesUseCase{
+		boostRepo:      boostRepo,
+		scoringService: scoringService,
+		cache:          cache,
+	}
+}
+
+// SetRecalculator boost değişikliklerinden sonra arka planda çalıştırılacak skor yeniden hesaplamayı ayarlar
+func (uc *ManageBoostRulesUseCase) SetRecalculator(recalculator ScoreRecalculator) {
+	uc.recalculator = recalculator
+}
+
+// Load kayıtlı boost kurallarını scoring service'e yükler (önceki boost'ların yerini alır)
+func (uc *ManageBoostRulesUseCase) Load(ctx context.Context) error {
+	rules, err := uc.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	boosts := make([]entity.BoostRule, len(rules))
+	for i, rule := range rules {
+		boosts[i] = *rule
+	}
+	uc.scoringService.SetBoosts(boosts)
+
+	return nil
+}
+
+// List kayıtlı boost kurallarını tag sırasıyla döner
+func (uc *ManageBoostRulesUseCase) List(ctx context.Context) ([]*entity.BoostRule, error) {
+	rules, err := uc.boostRepo.ListBoostRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("boost kuralları okunamadı: %w", err)
+	}
+	return rules, nil
+}
+
+// Set tag için boost kuralını oluşturur veya günceller
+func (uc *ManageBoostRulesUseCase) Set(ctx context.Context, tag string, percent float64) (*entity.BoostRule, error) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if tag == "" || len(tag) > maxBoostTagLen {
+		return nil, apperrors.NewValidationError("tag", fmt.Sprintf("tag must be between 1 and %d characters", maxBoostTagLen), tag)
+	}
+	if percent < minBoostPercent || percent > maxBoostPercent {
+		return nil, apperrors.NewValidationError("percent", fmt.Sprintf("percent must be between %g and %g", minBoostPercent, maxBoostPercent), percent)
+	}
+
+	rule := &entity.BoostRule{Tag: tag, Percent: percent}
+	if err := uc.boostRepo.SaveBoostRule(ctx, rule); err != nil {
+		return nil, fmt.Errorf("boost kuralı kaydedilemedi: %w", err)
+	}
+
+	uc.applyChange(ctx)
+
+	return rule, nil
+}
+
+// Delete tag'in boost kuralını siler
+// Kural bulunamazsa port.ErrBoostRuleNotFound döner
+func (uc *ManageBoostRulesUseCase) Delete(ctx context.Context, tag string) error {
+	if err := uc.boostRepo.DeleteBoostRule(ctx, strings.ToLower(strings.TrimSpace(tag))); err != nil {
+		if err == port.ErrBoostRuleNotFound {
+			return err
+		}
+		return fmt.Errorf("boost kuralı silinemedi: %w", err)
+	}
+
+	uc.applyChange(ctx)
+
+	return nil
+}
+
+// applyChange kayıt değişikliğinden sonra boost'ları yeniden yükler, cache'i temizler
+// ve varsa kayıtlı skorların yeniden hesaplanmasını arka planda başlatır
+// Hatalar kritik değil: kayıt başarılı, boost'lar en geç bir sonraki restart/senkronizasyonda uygulanır
+func (uc *ManageBoostRulesUseCase) applyChange(ctx context.Context) {
+	if err := uc.Load(ctx); err != nil {
+		logger.FromContext(ctx).Error("boost rules could not be reloaded", zap.Error(err))
+		return
+	}
+
+	_ = invalidateContentCache(ctx, uc.cache)
+
+	if uc.recalculator == nil {
+		return
+	}
+	background := logger.NewContext(context.Background(), logger.FromContext(ctx))
+	go func() {
+		// İstek bittikten sonra da devam etmeli; skorlar yazıldıktan sonra cache tekrar temizlenir
+		if _, err := uc.recalculator.Execute(background); err != nil {
+			logger.FromContext(background).Error("score recalculation after boost change failed", zap.Error(err))
+		}
+	}()
+}
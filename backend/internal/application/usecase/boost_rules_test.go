@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/domain/service"
+)
+
+// mockBoostRuleRepository boost kurallarını tag'e göre bellekte tutar
+type mockBoostRuleRepository struct {
+	rules map[string]float64
+}
+
+func (m *mockBoostRuleRepository) ListBoostRules(ctx context.Context) ([]*entity.BoostRule, error) {
+	rules := make([]*entity.BoostRule, 0, len(m.rules))
+	for tag, percent := range m.rules {
+		rules = append(rules, &entity.BoostRule{Tag: tag, Percent: percent})
+	}
+	return rules, nil
+}
+
+func (m *mockBoostRuleRepository) SaveBoostRule(ctx context.Context, rule *entity.BoostRule) error {
+	if m.rules == nil {
+		m.rules = make(map[string]float64)
+	}
+	m.rules[rule.Tag] = rule.Percent
+	return nil
+}
+
+func (m *mockBoostRuleRepository) DeleteBoostRule(ctx context.Context, tag string) error {
+	if _, ok := m.rules[tag]; !ok {
+		return port.ErrBoostRuleNotFound
+	}
+	delete(m.rules, tag)
+	return nil
+}
+
+// mockScoreRecalculator çağrıldığında kanala sinyal gönderir
+type mockScoreRecalculator struct {
+	called chan struct{}
+}
+
+func (m *mockScoreRecalculator) Execute(ctx context.Context) (int, error) {
+	m.called <- struct{}{}
+	return 0, nil
+}
+
+func TestManageBoostRulesUseCase(t *testing.T) {
+	scoring := service.NewScoringService(service.DefaultScoringRules())
+	repo := &mockBoostRuleRepository{}
+	cache := &mockCacheRepository{}
+	recalculator := &mockScoreRecalculator{called: make(chan struct{}, 1)}
+	useCase := NewManageBoostRulesUseCase(repo, scoring, cache)
+	useCase.SetRecalculator(recalculator)
+
+	content := &entity.Content{
+		ContentType: entity.ContentTypeArticle,
+		PublishedAt: time.Now().Add(-365 * 24 * time.Hour),
+		Stats:       &entity.ContentStats{ReadingTime: 10},
+		Tags:        []entity.Tag{{Name: "golang"}},
+	}
+
+	t.Run("sets boost and applies it", func(t *testing.T) {
+		rule, err := useCase.Set(context.Background(), "  GoLang ", 20)
+		require.NoError(t, err)
+		assert.Equal(t, "golang", rule.Tag)
+		assert.True(t, cache.invalidated)
+
+		select {
+		case <-recalculator.called:
+		case <-time.After(time.Second):
+			t.Fatal("scores should be recalculated after a boost change")
+		}
+
+		score, err := scoring.CalculateScore(content)
+		require.NoError(t, err)
+		assert.Equal(t, 12.0, score.FinalScore)
+	})
+
+	t.Run("rejects invalid boosts", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			tag     string
+			percent float64
+			field   string
+		}{
+			{"empty tag", " ", 10, "tag"},
+			{"below -100%", "clickbait", -150, "percent"},
+			{"too large", "golang", 5000, "percent"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := useCase.Set(context.Background(), tt.tag, tt.percent)
+				var validationErr *apperrors.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, tt.field, validationErr.Field)
+			})
+		}
+	})
+
+	t.Run("deletes boost", func(t *testing.T) {
+		require.NoError(t, useCase.Delete(context.Background(), "golang"))
+		<-recalculator.called
+
+		score, err := scoring.CalculateScore(content)
+		require.NoError(t, err)
+		assert.Equal(t, 10.0, score.FinalScore)
+
+		assert.ErrorIs(t, useCase.Delete(context.Background(), "golang"), port.ErrBoostRuleNotFound)
+	})
+}
@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// InvalidateCacheUseCase arama ve benzer içerik cache'ini elle temizleme (admin) use case'i
+// Veritabanı dışarıdan değiştirildiğinde (migration, elle düzeltme) eski sonuçların TTL'i beklemeden silinmesi için kullanılır
+type InvalidateCacheUseCase struct {
+	cache port.CacheRepository
+}
+
+// NewInvalidateCacheUseCase yeni bir cache temizleme use case oluşturur
+func NewInvalidateCacheUseCase(cache port.CacheRepository) *InvalidateCacheUseCase {
+	return &InvalidateCacheUseCase{
+		cache: cache,
+	}
+}
+
+// Execute içerik değişikliklerinde temizlenen key desenlerini siler; diğer key'lere dokunmaz
+func (uc *InvalidateCacheUseCase) Execute(ctx context.Context) error {
+	if err := invalidateContentCache(ctx, uc.cache); err != nil {
+		return fmt.Errorf("cache temizleme hatası: %w", err)
+	}
+	return nil
+}
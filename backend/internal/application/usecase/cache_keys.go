@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// Cache key önekleri
+// Sadece bu önekler altındaki key'ler içerik değişikliklerinde temizlenir;
+// aynı Redis veritabanındaki diğer key'ler korunur
+const (
+	searchCacheKeyPrefix  = "search:"
+	similarCacheKeyPrefix = "similar:"
+)
+
+// cacheKeyVersion cache'lenen yanıtların şema sürümü, key'lerin öneğinden hemen sonra yer alır
+// SearchResult/Content JSON yapısı veya skorlama mantığı değiştiğinde artırılmalıdır; böylece yeni deploy
+// eski sürümün yazdığı (yeni frontend'in okuyamayacağı) kayıtları okumaz, eski kayıtlar TTL ile silinir
+const cacheKeyVersion = 3
+
+// versionedCacheKey öneğe sürümü ekleyerek key'in başını oluşturur (örn. "search:v1:")
+func versionedCacheKey(prefix string) string {
+	return fmt.Sprintf("%sv%d:", prefix, cacheKeyVersion)
+}
+
+// contentCachePatterns içerik, skor veya sıralama değiştiğinde geçersiz olan cache key desenleri
+var contentCachePatterns = []string{
+	searchCacheKeyPrefix + "*",
+	similarCacheKeyPrefix + "*",
+}
+
+// invalidateContentCache arama ve benzer içerik sonuçlarının cache'ini temizler
+func invalidateContentCache(ctx context.Context, cache port.CacheRepository) error {
+	for _, pattern := range contentCachePatterns {
+		if err := cache.InvalidatePattern(ctx, pattern); err != nil {
+			return err
+		}
+	}
+	return nil
+}
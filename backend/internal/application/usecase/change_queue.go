@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"sync"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+)
+
+// changeQueue sync'te commit edilen değişiklikleri arka plan worker'ı işleyene kadar bellekte tutar
+// push sync'i bloklamaz; limit aşılırsa yeni değişiklikler düşürülür ve sayılır
+type changeQueue struct {
+	limit int
+
+	mu      sync.Mutex
+	pending []entity.ContentChange
+	dropped int
+	ready   chan struct{}
+}
+
+// newChangeQueue en fazla limit değişiklik tutan bir kuyruk oluşturur
+func newChangeQueue(limit int) *changeQueue {
+	return &changeQueue{
+		limit: limit,
+		ready: make(chan struct{}, 1),
+	}
+}
+
+// push değişiklikleri kuyruğa ekler ve ready kanalını tetikler
+func (q *changeQueue) push(changes []entity.ContentChange) {
+	q.mu.Lock()
+	room := q.limit - len(q.pending)
+	if room < len(changes) {
+		if room < 0 {
+			room = 0
+		}
+		q.dropped += len(changes) - room
+		changes = changes[:room]
+	}
+	q.pending = append(q.pending, changes...)
+	q.mu.Unlock()
+
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
+
+// take bekleyen değişiklikleri ve son çağrıdan beri düşürülen değişiklik sayısını alır
+func (q *changeQueue) take() ([]entity.ContentChange, int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	changes, dropped := q.pending, q.dropped
+	q.pending = nil
+	q.dropped = 0
+	return changes, dropped
+}
+
+// requeue işlenemeyen değişiklikleri kuyruğun başına geri koyar
+func (q *changeQueue) requeue(changes []entity.ContentChange) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	merged := append(changes, q.pending...)
+	if len(merged) > q.limit {
+		q.dropped += len(merged) - q.limit
+		merged = merged[:q.limit]
+	}
+	q.pending = merged
+}
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// ContentAuditUseCase içerik değişiklik geçmişi use case'i
+type ContentAuditUseCase struct {
+	auditRepo port.ContentAuditRepository
+}
+
+// ContentAuditResult içerik değişiklik geçmişi sonucu yapısı
+type ContentAuditResult struct {
+	Items      []*entity.ContentAuditEntry `json:"items"`
+	Pagination Pagination                  `json:"pagination"`
+}
+
+// NewContentAuditUseCase yeni bir içerik değişiklik geçmişi use case oluşturur
+func NewContentAuditUseCase(auditRepo port.ContentAuditRepository) *ContentAuditUseCase {
+	return &ContentAuditUseCase{
+		auditRepo: auditRepo,
+	}
+}
+
+// Execute içeriğin değişiklik kayıtlarını en yeniden eskiye sayfalı getirir
+// Geçmiş, içerik silinmiş olsa da döner; kaydı olmayan içerik için boş liste döner
+func (uc *ContentAuditUseCase) Execute(ctx context.Context, contentID int64, page, pageSize int) (*ContentAuditResult, error) {
+	if contentID < 1 {
+		return nil, apperrors.NewValidationError("id", "id must be a positive integer", contentID)
+	}
+
+	page, pageSize = normalizeHistoryPage(page, pageSize)
+
+	entries, total, err := uc.auditRepo.ListContentAudit(ctx, contentID, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("içerik geçmişi hatası: %w", err)
+	}
+
+	if entries == nil {
+		entries = make([]*entity.ContentAuditEntry, 0)
+	}
+
+	return &ContentAuditResult{
+		Items:      entries,
+		Pagination: historyPagination(page, pageSize, total),
+	}, nil
+}
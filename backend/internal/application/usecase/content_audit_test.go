@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+)
+
+type mockContentAuditRepository struct {
+	entries []*entity.ContentAuditEntry
+}
+
+func (m *mockContentAuditRepository) ListContentAudit(ctx context.Context, contentID int64, limit, offset int) ([]*entity.ContentAuditEntry, int64, error) {
+	var matched []*entity.ContentAuditEntry
+	for _, e := range m.entries {
+		if e.ContentID == contentID {
+			matched = append(matched, e)
+		}
+	}
+	total := int64(len(matched))
+	if offset >= len(matched) {
+		return nil, total, nil
+	}
+	end := offset + limit
+	if end > len(matched) {
+		end = len(matched)
+	}
+	return matched[offset:end], total, nil
+}
+
+func TestContentAuditUseCase_Execute(t *testing.T) {
+	repo := &mockContentAuditRepository{}
+	for i := 0; i < 5; i++ {
+		repo.entries = append(repo.entries, &entity.ContentAuditEntry{ID: int64(i + 1), ContentID: 7, Table: "contents", Operation: "update"})
+	}
+	repo.entries = append(repo.entries, &entity.ContentAuditEntry{ID: 6, ContentID: 8, Table: "contents", Operation: "insert"})
+	useCase := NewContentAuditUseCase(repo)
+
+	t.Run("paginates content history", func(t *testing.T) {
+		result, err := useCase.Execute(context.Background(), 7, 2, 2)
+		require.NoError(t, err)
+		assert.Len(t, result.Items, 2)
+		assert.Equal(t, int64(5), result.Pagination.TotalItems)
+		assert.Equal(t, int64(3), result.Pagination.TotalPages)
+	})
+
+	t.Run("content without history returns empty slice", func(t *testing.T) {
+		result, err := useCase.Execute(context.Background(), 99, 0, 0)
+		require.NoError(t, err)
+		assert.NotNil(t, result.Items)
+		assert.Empty(t, result.Items)
+		assert.Equal(t, 20, result.Pagination.PageSize)
+	})
+
+	t.Run("rejects invalid content id", func(t *testing.T) {
+		_, err := useCase.Execute(context.Background(), 0, 1, 20)
+		var validationErr *apperrors.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
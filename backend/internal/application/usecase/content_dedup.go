@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/service"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/logger"
+)
+
+// SetDedupService farklı provider'lardan gelen kopyaları tespit edecek servisi ayarlar
+// nil ise kopya tespiti yapılmaz
+func (uc *SyncProviderContentsUseCase) SetDedupService(dedup service.DedupService) {
+	uc.dedup = dedup
+}
+
+// linkDuplicates provider'ın since'ten sonra yazılan içeriklerini diğer provider'lardaki kopyalarına bağlar
+// Hata kritik değil: içerikler yazılmıştır, bağlantılar sonraki senkronizasyonda düzelir
+func (uc *SyncProviderContentsUseCase) linkDuplicates(ctx context.Context, providerID int64, since time.Time) {
+	if uc.dedup == nil {
+		return
+	}
+
+	candidates, err := uc.contentRepo.FindDuplicateCandidates(ctx, providerID, since, uc.dedup.PublishedWindow())
+	if err != nil {
+		logger.FromContext(ctx).Error("duplicate candidates could not be found",
+			zap.Int64("provider_id", providerID),
+			zap.Error(err),
+		)
+		return
+	}
+
+	links := uc.dedup.ResolveCanonical(candidates)
+	err = uc.withinTx(ctx, func(ctx context.Context) error {
+		return uc.contentRepo.LinkDuplicates(ctx, links)
+	})
+	if err != nil {
+		logger.FromContext(ctx).Error("duplicate contents could not be linked",
+			zap.Int64("provider_id", providerID),
+			zap.Error(err),
+		)
+		return
+	}
+
+	duplicates := 0
+	for id, canonicalID := range links {
+		if id != canonicalID {
+			duplicates++
+		}
+	}
+	if duplicates > 0 {
+		logger.FromContext(ctx).Info("contents linked to duplicates from other providers",
+			zap.Int64("provider_id", providerID),
+			zap.Int("count", duplicates),
+		)
+	}
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/domain/service"
+)
+
+func TestSyncProviderContentsUseCase_LinkDuplicates(t *testing.T) {
+	newClient := func() *mockProviderClient {
+		return &mockProviderClient{contents: []*entity.NormalizedContent{
+			{ExternalID: "v1", Title: "Docker Basics", ContentType: entity.ContentTypeVideo, PublishedAt: testPublishedAt},
+		}}
+	}
+	candidates := []*entity.DuplicateCandidate{{
+		ContentID: 10, Title: "Docker Basics", PublishedAt: testPublishedAt,
+		CandidateID: 3, CandidateTitle: "Docker basics!", CandidatePublishedAt: testPublishedAt.Add(time.Hour),
+		CandidateCanonicalID: 3,
+	}}
+
+	t.Run("links synced contents to their canonical content", func(t *testing.T) {
+		repo := &mockContentRepository{duplicateCandidates: candidates}
+		useCase := NewSyncProviderContentsUseCase([]port.ProviderClient{newClient()}, repo, &mockScoringService{}, &mockCacheRepository{})
+		useCase.SetDedupService(service.NewDedupService(service.DedupRules{}))
+
+		require.NoError(t, useCase.ExecuteProvider(context.Background(), 1))
+		assert.Equal(t, map[int64]int64{10: 3, 3: 3}, repo.links)
+	})
+
+	t.Run("skipped without dedup service", func(t *testing.T) {
+		repo := &mockContentRepository{duplicateCandidates: candidates}
+		useCase := NewSyncProviderContentsUseCase([]port.ProviderClient{newClient()}, repo, &mockScoringService{}, &mockCacheRepository{})
+
+		require.NoError(t, useCase.ExecuteProvider(context.Background(), 1))
+		assert.Nil(t, repo.links)
+	})
+
+	t.Run("skipped when nothing was synced", func(t *testing.T) {
+		repo := &mockContentRepository{duplicateCandidates: candidates}
+		client := &mockProviderClient{err: errors.New("provider down")}
+		useCase := NewSyncProviderContentsUseCase([]port.ProviderClient{client}, repo, &mockScoringService{}, &mockCacheRepository{})
+		useCase.SetDedupService(service.NewDedupService(service.DedupRules{}))
+
+		assert.Error(t, useCase.ExecuteProvider(context.Background(), 1))
+		assert.Nil(t, repo.links)
+	})
+}
@@ -0,0 +1,432 @@
+package usecase
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/logger"
+)
+
+// İçe aktarma dosya biçimleri
+const (
+	ContentImportFormatNDJSON = "ndjson"
+	ContentImportFormatCSV    = "csv"
+)
+
+// maxImportErrors yanıtta dönen en fazla satır hatası; sayaçlar tüm hataları kapsar
+const maxImportErrors = 100
+
+// CSV içe aktarmada liste sütunlarının ayırıcıları
+const (
+	csvTagSeparator      = "|" // tags: "go|concurrency"
+	csvCategorySeparator = ">" // category: "Programming > Go"
+)
+
+// csvImportColumns CSV içe aktarmada tanınan sütunlar; ilk satır bu adlardan oluşan header olmalıdır
+var csvImportColumns = []string{
+	"external_id", "title", "description", "content_type", "language", "published_at",
+	"url", "thumbnail_url", "views", "likes", "reading_time", "reactions", "duration_seconds", "comments",
+	"tags", "category", "author_id", "author_name", "author_url",
+}
+
+// csvRequiredColumns header'da bulunması zorunlu sütunlar
+var csvRequiredColumns = []string{"external_id", "title", "content_type", "published_at"}
+
+// ContentImportResult toplu içe aktarmanın sonucu
+type ContentImportResult struct {
+	ProviderID int64                `json:"provider_id"`
+	Read       int                  `json:"read"`     // Okunan kayıt sayısı (boş satırlar hariç)
+	Imported   int                  `json:"imported"` // Yazılan (eklenen veya güncellenen) içerik sayısı
+	Rejected   int                  `json:"rejected"` // Çözümlenemeyen veya doğrulamadan geçemeyen kayıtlar
+	Failed     int                  `json:"failed"`   // Doğrulamadan geçip veritabanına yazılamayan kayıtlar
+	Errors     []ContentImportError `json:"errors,omitempty"`
+	DurationMs int64                `json:"duration_ms"`
+}
+
+// ContentImportError içe aktarılamayan tek bir kaydın hatası
+type ContentImportError struct {
+	Line       int    `json:"line"`
+	ExternalID string `json:"external_id,omitempty"`
+	Error      string `json:"error"`
+}
+
+// addError hatayı yanıt listesine ekler (ilk maxImportErrors hata)
+func (r *ContentImportResult) addError(line int, externalID string, err error) {
+	if len(r.Errors) < maxImportErrors {
+		r.Errors = append(r.Errors, ContentImportError{Line: line, ExternalID: externalID, Error: err.Error()})
+	}
+}
+
+// importedRecord batch'te bekleyen, doğrulanmış kayıt
+type importedRecord struct {
+	line    int
+	content *entity.NormalizedContent
+}
+
+// ImportContents NDJSON veya CSV biçimindeki normalize edilmiş içerikleri provider'a aktarır
+// Kayıtlar akış halinde okunur ve periyodik sync ile aynı upsert + stats + score + tags adımlarından
+// batch'ler halinde geçer; her batch kendi transaction'ında commit edilir. Upsert kullanıldığından
+// yarıda kesilen bir içe aktarma aynı dosyayla tekrar çalıştırılabilir.
+// Çözümlenemeyen veya geçersiz kayıtlar atlanır ve sonuçta raporlanır; sync'in aksine
+// dosyada olmayan içerikler silinmez. Aktif olmayan provider'lara da aktarılabilir
+func (uc *SyncProviderContentsUseCase) ImportContents(ctx context.Context, providerID int64, format string, r io.Reader) (*ContentImportResult, error) {
+	provider, err := uc.importProvider(ctx, providerID)
+	if err != nil {
+		return nil, err
+	}
+	decoder, err := newContentDecoder(format, r)
+	if err != nil {
+		return nil, err
+	}
+
+	if !uc.acquire() {
+		return nil, ErrSyncShutdown
+	}
+	defer uc.inflight.Done()
+
+	// Shutdown süresi dolduğunda devam eden içe aktarma da iptal edilir
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stop := context.AfterFunc(uc.baseCtx, cancel)
+	defer stop()
+
+	ctx = port.WithAuditInfo(ctx, port.AuditInfo{Actor: port.AuditActorImport})
+
+	log := logger.FromContext(ctx).WithFields(
+		zap.String("provider", provider.Name),
+		zap.Int64("provider_id", provider.ID),
+	)
+	ctx = logger.NewContext(ctx, log)
+	log.Info("content import starting", zap.String("format", string(format)))
+	startTime := time.Now()
+	result := &ContentImportResult{ProviderID: provider.ID}
+
+	batch := make([]importedRecord, 0, syncBatchSize)
+	flush := func() {
+		uc.importBatch(ctx, provider, batch, result)
+		batch = batch[:0]
+	}
+
+	var readErr error
+	for {
+		nc, line, err := decoder.Next()
+		if err == io.EOF {
+			break
+		}
+		var recordErr *importRecordError
+		if errors.As(err, &recordErr) {
+			result.Read++
+			result.Rejected++
+			result.addError(line, "", recordErr.err)
+			continue
+		}
+		if err != nil {
+			readErr = fmt.Errorf("içe aktarma %d. satırda kesildi: %w", line, err)
+			break
+		}
+
+		result.Read++
+		if err := validateNormalizedContent(nc, startTime); err != nil {
+			result.Rejected++
+			result.addError(line, nc.ExternalID, err)
+			continue
+		}
+
+		batch = append(batch, importedRecord{line: line, content: nc})
+		if len(batch) >= syncBatchSize {
+			flush()
+			if err := ctx.Err(); err != nil {
+				readErr = err
+				break
+			}
+		}
+	}
+	if readErr == nil && len(batch) > 0 {
+		flush()
+	}
+
+	// Kesilen içe aktarmada da commit edilmiş batch'ler aramada görünmeli
+	if result.Imported > 0 {
+		uc.linkDuplicates(ctx, provider.ID, startTime)
+		uc.reindex(ctx)
+		_ = invalidateContentCache(ctx, uc.cache)
+	}
+	result.DurationMs = time.Since(startTime).Milliseconds()
+
+	log.Info("content import completed",
+		zap.Int("read", result.Read),
+		zap.Int("imported", result.Imported),
+		zap.Int("rejected", result.Rejected),
+		zap.Int("failed", result.Failed),
+		zap.Duration("duration", time.Since(startTime)),
+	)
+
+	if readErr != nil {
+		return nil, readErr
+	}
+	return result, nil
+}
+
+// importBatch batch'i sync pipeline'ı ile yazar, yazılamayan kayıtları sonuca ekler ve değişiklikleri yayınlar
+func (uc *SyncProviderContentsUseCase) importBatch(ctx context.Context, provider *entity.Provider, batch []importedRecord, result *ContentImportResult) {
+	contents := make([]*entity.NormalizedContent, len(batch))
+	for i, record := range batch {
+		contents[i] = record.content
+	}
+
+	written := uc.processBatch(ctx, provider.ID, contents)
+	result.Imported += len(written)
+
+	if len(written) < len(batch) {
+		writtenIDs := make(map[string]bool, len(written))
+		for _, content := range written {
+			writtenIDs[content.ProviderContentID] = true
+		}
+		for _, record := range batch {
+			if !writtenIDs[record.content.ExternalID] {
+				result.Failed++
+				result.addError(record.line, record.content.ExternalID, errors.New("içerik yazılamadı"))
+			}
+		}
+	}
+
+	uc.publishChanges(provider, written)
+}
+
+// importProvider içe aktarılacak provider'ı bulur
+// Aktif provider'lar client listesinden, diğerleri (provider kaynağı ayarlıysa) repository'den okunur
+func (uc *SyncProviderContentsUseCase) importProvider(ctx context.Context, providerID int64) (*entity.Provider, error) {
+	if providerID <= 0 {
+		return nil, apperrors.NewValidationError("provider_id", "provider_id is required", providerID)
+	}
+	if client := uc.findClient(providerID); client != nil {
+		return client.GetProviderInfo(), nil
+	}
+
+	uc.mu.RLock()
+	providerRepo := uc.providerRepo
+	uc.mu.RUnlock()
+	if providerRepo == nil {
+		return nil, port.ErrProviderNotFound
+	}
+	return providerRepo.FindByID(ctx, providerID)
+}
+
+// importRecordError sadece tek bir kaydı etkileyen çözümleme hatası; içe aktarma sonraki kayıtla devam eder
+type importRecordError struct {
+	err error
+}
+
+func (e *importRecordError) Error() string {
+	return e.err.Error()
+}
+
+func (e *importRecordError) Unwrap() error {
+	return e.err
+}
+
+// contentDecoder içe aktarma dosyasını kayıt kayıt okur
+type contentDecoder interface {
+	// Next sonraki kaydı ve satır numarasını döner; kayıtlar bitince io.EOF döner
+	// Tek kaydı etkileyen hatalar *importRecordError, diğerleri okumayı sonlandıran hatalardır
+	Next() (*entity.NormalizedContent, int, error)
+}
+
+// newContentDecoder biçime göre decoder oluşturur
+func newContentDecoder(format string, r io.Reader) (contentDecoder, error) {
+	switch format {
+	case ContentImportFormatNDJSON:
+		return &ndjsonContentDecoder{r: bufio.NewReader(r)}, nil
+	case ContentImportFormatCSV:
+		return newCSVContentDecoder(r)
+	default:
+		return nil, apperrors.NewValidationError("format", "invalid format (must be 'ndjson' or 'csv')", format)
+	}
+}
+
+// ndjsonContentDecoder her satırı bir entity.NormalizedContent JSON nesnesi olarak okur; boş satırlar atlanır
+type ndjsonContentDecoder struct {
+	r    *bufio.Reader
+	line int
+}
+
+func (d *ndjsonContentDecoder) Next() (*entity.NormalizedContent, int, error) {
+	for {
+		raw, err := d.r.ReadBytes('\n')
+		if len(raw) == 0 && err != nil {
+			return nil, d.line, err
+		}
+		d.line++
+
+		raw = bytes.TrimSpace(raw)
+		if len(raw) == 0 {
+			continue
+		}
+
+		var nc entity.NormalizedContent
+		if err := json.Unmarshal(raw, &nc); err != nil {
+			return nil, d.line, &importRecordError{err: fmt.Errorf("geçersiz JSON: %w", err)}
+		}
+		return &nc, d.line, nil
+	}
+}
+
+// csvContentDecoder header satırındaki sütun adlarına göre kayıtları okur
+// Sütun sırası serbesttir; tanınmayan sütunlar reddedilir
+type csvContentDecoder struct {
+	r       *csv.Reader
+	columns map[string]int
+}
+
+// newCSVContentDecoder header satırını okuyup sütunları doğrular
+func newCSVContentDecoder(r io.Reader) (*csvContentDecoder, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, apperrors.NewValidationError("body", "CSV header row is required", nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("CSV header okunamadı: %w", err)
+	}
+
+	known := make(map[string]bool, len(csvImportColumns))
+	for _, name := range csvImportColumns {
+		known[name] = true
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff") // Excel'in eklediği UTF-8 BOM
+		}
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !known[name] {
+			return nil, apperrors.NewValidationError("columns",
+				"unknown CSV column (must be one of: "+strings.Join(csvImportColumns, ", ")+")", name)
+		}
+		columns[name] = i
+	}
+	for _, name := range csvRequiredColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, apperrors.NewValidationError("columns", "required CSV column missing", name)
+		}
+	}
+
+	return &csvContentDecoder{r: reader, columns: columns}, nil
+}
+
+func (d *csvContentDecoder) Next() (*entity.NormalizedContent, int, error) {
+	record, err := d.r.Read()
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return nil, parseErr.StartLine, &importRecordError{err: err}
+		}
+		line, _ := d.r.FieldPos(0)
+		return nil, line, err
+	}
+
+	line, _ := d.r.FieldPos(0)
+	nc, err := d.parse(record)
+	if err != nil {
+		return nil, line, &importRecordError{err: err}
+	}
+	return nc, line, nil
+}
+
+// parse CSV kaydını normalize edilmiş içeriğe çevirir; sayı ve tarih biçim hataları doğrulama hatası olarak döner
+func (d *csvContentDecoder) parse(record []string) (*entity.NormalizedContent, error) {
+	get := func(name string) string {
+		if i, ok := d.columns[name]; ok {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	nc := &entity.NormalizedContent{
+		ExternalID:   get("external_id"),
+		Title:        get("title"),
+		Description:  get("description"),
+		ContentType:  entity.ContentType(get("content_type")),
+		Language:     get("language"),
+		URL:          get("url"),
+		ThumbnailURL: get("thumbnail_url"),
+		Tags:         splitImportList(get("tags"), csvTagSeparator),
+		Category:     splitImportList(get("category"), csvCategorySeparator),
+	}
+
+	if value := get("published_at"); value != "" {
+		publishedAt, err := parseImportTime(value)
+		if err != nil {
+			return nil, apperrors.NewValidationError("published_at", "published_at must be RFC 3339 or YYYY-MM-DD", value)
+		}
+		nc.PublishedAt = publishedAt
+	}
+
+	var err error
+	intColumn := func(name string, bits int) int64 {
+		value := get(name)
+		if value == "" || err != nil {
+			return 0
+		}
+		n, parseErr := strconv.ParseInt(value, 10, bits)
+		if parseErr != nil {
+			err = apperrors.NewValidationError(name, name+" must be an integer", value)
+		}
+		return n
+	}
+	nc.Stats.Views = intColumn("views", 64)
+	nc.Stats.Likes = int32(intColumn("likes", 32))
+	nc.Stats.ReadingTime = int32(intColumn("reading_time", 32))
+	nc.Stats.Reactions = int32(intColumn("reactions", 32))
+	nc.Stats.DurationSeconds = int32(intColumn("duration_seconds", 32))
+	nc.Stats.Comments = int32(intColumn("comments", 32))
+	if err != nil {
+		return nil, err
+	}
+
+	if authorID, authorName := get("author_id"), get("author_name"); authorID != "" || authorName != "" {
+		nc.Author = &entity.NormalizedAuthor{ExternalID: authorID, Name: authorName, URL: get("author_url")}
+	}
+
+	return nc, nil
+}
+
+// parseImportTime RFC 3339 zaman damgasını veya sadece tarihi (UTC gece yarısı) çözümler
+func parseImportTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02", value)
+}
+
+// splitImportList ayırıcıyla bölünmüş listeyi boş elemanları atlayarak döner
+func splitImportList(value, separator string) []string {
+	if value == "" {
+		return nil
+	}
+	var items []string
+	for _, item := range strings.Split(value, separator) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,171 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+func TestSyncProviderContentsUseCase_ImportContents_NDJSON(t *testing.T) {
+	mockRepo := &mockContentRepository{}
+	mockCache := &mockCacheRepository{}
+	useCase := NewSyncProviderContentsUseCase(
+		[]port.ProviderClient{&mockProviderClient{}},
+		mockRepo, &mockScoringService{}, mockCache,
+	)
+
+	input := strings.Join([]string{
+		`{"external_id": "v1", "title": "Go Concurrency", "content_type": "video", "published_at": "2024-01-15T10:00:00Z", "stats": {"views": 1000}, "tags": ["go"]}`,
+		`{"external_id": "v2", "title": `,
+		`{"external_id": "v3", "content_type": "video", "published_at": "2024-01-15T10:00:00Z"}`,
+		``,
+		`{"external_id": "a1", "title": "Generics", "content_type": "article", "published_at": "2024-01-16T10:00:00Z"}`,
+	}, "\n")
+
+	result, err := useCase.ImportContents(context.Background(), 1, ContentImportFormatNDJSON, strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ImportContents failed: %v", err)
+	}
+
+	if result.Read != 4 || result.Imported != 2 || result.Rejected != 2 || result.Failed != 0 {
+		t.Errorf("Unexpected counts: %+v", result)
+	}
+	if len(result.Errors) != 2 || result.Errors[0].Line != 2 || result.Errors[1].Line != 3 || result.Errors[1].ExternalID != "v3" {
+		t.Errorf("Expected errors on lines 2 and 3, got %+v", result.Errors)
+	}
+	if len(mockRepo.bulkContents) != 2 || mockRepo.bulkContents[0].Stats.Views != 1000 {
+		t.Errorf("Expected 2 contents written through the sync pipeline, got %d", len(mockRepo.bulkContents))
+	}
+	if mockRepo.markedDeleted {
+		t.Error("Import must not soft delete contents missing from the file")
+	}
+	if !mockCache.invalidated {
+		t.Error("Search cache was NOT invalidated")
+	}
+}
+
+func TestSyncProviderContentsUseCase_ImportContents_CSV(t *testing.T) {
+	mockRepo := &mockContentRepository{}
+	useCase := NewSyncProviderContentsUseCase(
+		[]port.ProviderClient{&mockProviderClient{}},
+		mockRepo, &mockScoringService{}, &mockCacheRepository{},
+	)
+
+	input := "\ufefftitle,external_id,content_type,published_at,views,tags,category,author_name\n" +
+		"\"Go, Concurrency\",v1,video,2024-01-15,1000,go|concurrency,Programming > Go,Gopher\n" +
+		"Generics,a1,article,2024-01-16T10:00:00Z,many,,,\n"
+
+	result, err := useCase.ImportContents(context.Background(), 1, ContentImportFormatCSV, strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ImportContents failed: %v", err)
+	}
+
+	if result.Read != 2 || result.Imported != 1 || result.Rejected != 1 {
+		t.Errorf("Unexpected counts: %+v", result)
+	}
+	var validationErr *apperrors.ValidationError
+	if len(result.Errors) != 1 || result.Errors[0].Line != 3 || !strings.Contains(result.Errors[0].Error, "views") {
+		t.Errorf("Expected views error on line 3, got %+v", result.Errors)
+	}
+
+	content := mockRepo.bulkContents[0]
+	if content.Title != "Go, Concurrency" || content.Stats.Views != 1000 {
+		t.Errorf("Unexpected content: %+v", content)
+	}
+	if !content.PublishedAt.Equal(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected date-only published_at, got %v", content.PublishedAt)
+	}
+	if len(content.Tags) != 2 || content.Tags[0].Name != "concurrency" {
+		t.Errorf("Expected tags split on '|', got %+v", content.Tags)
+	}
+
+	t.Run("rejects unknown and missing columns", func(t *testing.T) {
+		_, err := useCase.ImportContents(context.Background(), 1, ContentImportFormatCSV,
+			strings.NewReader("external_id,title,content_type,published_at,viewz\n"))
+		if !errors.As(err, &validationErr) || validationErr.Field != "columns" {
+			t.Errorf("Expected columns validation error, got %v", err)
+		}
+
+		_, err = useCase.ImportContents(context.Background(), 1, ContentImportFormatCSV,
+			strings.NewReader("external_id,title\n"))
+		if !errors.As(err, &validationErr) || validationErr.Value != "content_type" {
+			t.Errorf("Expected missing content_type column error, got %v", err)
+		}
+	})
+}
+
+// failingReader ilk okumada data'yı, sonrakilerde err'i döner
+type failingReader struct {
+	data string
+	err  error
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if r.data == "" {
+		return 0, r.err
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+func TestSyncProviderContentsUseCase_ImportContents_Errors(t *testing.T) {
+	line := `{"external_id": "v1", "title": "Video", "content_type": "video", "published_at": "2024-01-15T10:00:00Z"}` + "\n"
+
+	t.Run("unknown provider and format", func(t *testing.T) {
+		useCase := NewSyncProviderContentsUseCase(
+			[]port.ProviderClient{&mockProviderClient{}},
+			&mockContentRepository{}, &mockScoringService{}, &mockCacheRepository{},
+		)
+
+		_, err := useCase.ImportContents(context.Background(), 2, ContentImportFormatNDJSON, strings.NewReader(line))
+		if !errors.Is(err, port.ErrProviderNotFound) {
+			t.Errorf("Expected ErrProviderNotFound, got %v", err)
+		}
+
+		var validationErr *apperrors.ValidationError
+		_, err = useCase.ImportContents(context.Background(), 1, "xml", strings.NewReader(line))
+		if !errors.As(err, &validationErr) || validationErr.Field != "format" {
+			t.Errorf("Expected format validation error, got %v", err)
+		}
+	})
+
+	t.Run("inactive provider from repository", func(t *testing.T) {
+		providerRepo := newMockProviderRepository()
+		providerRepo.providers[2] = &entity.Provider{ID: 2, Name: "Archive"}
+		mockRepo := &mockContentRepository{}
+		useCase := NewSyncProviderContentsUseCase(
+			[]port.ProviderClient{&mockProviderClient{}},
+			mockRepo, &mockScoringService{}, &mockCacheRepository{},
+		)
+		useCase.SetProviderSource(providerRepo, nil)
+
+		result, err := useCase.ImportContents(context.Background(), 2, ContentImportFormatNDJSON, strings.NewReader(line))
+		if err != nil {
+			t.Fatalf("ImportContents failed: %v", err)
+		}
+		if result.Imported != 1 || mockRepo.bulkContents[0].ProviderID != 2 {
+			t.Errorf("Expected content imported into provider 2, got %+v", result)
+		}
+	})
+
+	t.Run("read error stops the import", func(t *testing.T) {
+		useCase := NewSyncProviderContentsUseCase(
+			[]port.ProviderClient{&mockProviderClient{}},
+			&mockContentRepository{}, &mockScoringService{}, &mockCacheRepository{},
+		)
+
+		_, err := useCase.ImportContents(context.Background(), 1, ContentImportFormatNDJSON,
+			&failingReader{data: line, err: io.ErrUnexpectedEOF})
+		if !errors.Is(err, io.ErrUnexpectedEOF) {
+			t.Errorf("Expected read error, got %v", err)
+		}
+	})
+}
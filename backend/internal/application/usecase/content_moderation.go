@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/logger"
+)
+
+// maxModerationContentIDs tek istekte durumu değiştirilebilecek en fazla içerik sayısı
+const maxModerationContentIDs = 100
+
+// ContentModerationUseCase güvenilmeyen provider'lardan gelen içeriklerin incelenmesi (admin) use case'i
+type ContentModerationUseCase struct {
+	moderationRepo port.ContentModerationRepository
+	cache          port.CacheRepository
+	searchIndexer  SearchIndexer // nil ise arama Postgres'ten yapılır, indeks güncellenmez
+}
+
+// ContentModerationResult moderasyon kuyruğu sonucu yapısı
+type ContentModerationResult struct {
+	Items      []*entity.Content `json:"items"`
+	Pagination Pagination        `json:"pagination"`
+}
+
+// ContentReviewInput içerik moderasyon kararı isteği
+type ContentReviewInput struct {
+	ContentIDs []int64              `json:"content_ids"`
+	Status     entity.ContentStatus `json:"status"` // "approved", "rejected" veya tekrar incelemeye almak için "pending"
+}
+
+// ContentReviewResult moderasyon kararı sonucu
+type ContentReviewResult struct {
+	Status  entity.ContentStatus `json:"status"`
+	Updated int64                `json:"updated"` // Silinmiş veya bulunamayan içerikler sayılmaz
+}
+
+// NewContentModerationUseCase yeni bir içerik moderasyon use case oluşturur
+func NewContentModerationUseCase(
+	moderationRepo port.ContentModerationRepository,
+	cache port.CacheRepository,
+) *ContentModerationUseCase {
+	return &ContentModerationUseCase{
+		moderationRepo: moderationRepo,
+		cache:          cache,
+	}
+}
+
+// SetSearchIndexer moderasyon kararlarından sonra yeniden oluşturulacak arama indeksini ayarlar
+func (uc *ContentModerationUseCase) SetSearchIndexer(indexer SearchIndexer) {
+	uc.searchIndexer = indexer
+}
+
+// List verilen durumdaki içerikleri en eskiden yeniye sayfalı getirir
+// Durum verilmezse incelemeyi bekleyen (pending) içerikler döner
+func (uc *ContentModerationUseCase) List(ctx context.Context, status entity.ContentStatus, providerID int64, page, pageSize int) (*ContentModerationResult, error) {
+	if status == "" {
+		status = entity.ContentStatusPending
+	}
+	if !entity.IsValidContentStatus(status) {
+		return nil, apperrors.NewValidationError("status", "invalid status (must be 'pending', 'approved' or 'rejected')", status)
+	}
+
+	page, pageSize = normalizeHistoryPage(page, pageSize)
+
+	filter := port.ContentModerationFilter{Status: status, ProviderID: providerID}
+	contents, total, err := uc.moderationRepo.ListContentsByStatus(ctx, filter, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("moderasyon kuyruğu hatası: %w", err)
+	}
+
+	if contents == nil {
+		contents = make([]*entity.Content, 0)
+	}
+
+	return &ContentModerationResult{
+		Items:      contents,
+		Pagination: historyPagination(page, pageSize, total),
+	}, nil
+}
+
+// Review içeriklerin moderasyon durumunu değiştirir
+// Onaylanan içerikler aramada görünür hale gelir, reddedilenler aramadan çıkar
+func (uc *ContentModerationUseCase) Review(ctx context.Context, input ContentReviewInput) (*ContentReviewResult, error) {
+	if !entity.IsValidContentStatus(input.Status) {
+		return nil, apperrors.NewValidationError("status", "invalid status (must be 'pending', 'approved' or 'rejected')", input.Status)
+	}
+	if len(input.ContentIDs) == 0 || len(input.ContentIDs) > maxModerationContentIDs {
+		return nil, apperrors.NewValidationError("content_ids", fmt.Sprintf("content_ids must contain between 1 and %d ids", maxModerationContentIDs), len(input.ContentIDs))
+	}
+	for i, id := range input.ContentIDs {
+		if id < 1 {
+			return nil, apperrors.NewValidationError(fmt.Sprintf("content_ids[%d]", i), "content id must be a positive integer", id)
+		}
+	}
+
+	updated, err := uc.moderationRepo.UpdateContentStatus(ctx, input.ContentIDs, input.Status)
+	if err != nil {
+		return nil, fmt.Errorf("moderasyon durumu güncellenemedi: %w", err)
+	}
+
+	if updated > 0 {
+		uc.reindex(ctx)
+		// Arama sonuçları ve içerik detayları yeni durumu yansıtsın (hata kritik değil)
+		_ = invalidateContentCache(ctx, uc.cache)
+	}
+
+	return &ContentReviewResult{Status: input.Status, Updated: updated}, nil
+}
+
+// reindex varsa arama indeksini yeniden oluşturur (hata kritik değil, bir sonraki sync'te düzelir)
+func (uc *ContentModerationUseCase) reindex(ctx context.Context) {
+	if uc.searchIndexer == nil {
+		return
+	}
+	if _, err := uc.searchIndexer.Reindex(ctx); err != nil {
+		logger.FromContext(ctx).Error("search index could not be updated after moderation", zap.Error(err))
+	}
+}
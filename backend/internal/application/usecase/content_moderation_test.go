@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+type mockContentModerationRepository struct {
+	contents []*entity.Content
+	filter   port.ContentModerationFilter
+}
+
+func (m *mockContentModerationRepository) ListContentsByStatus(ctx context.Context, filter port.ContentModerationFilter, limit, offset int) ([]*entity.Content, int64, error) {
+	m.filter = filter
+	var matched []*entity.Content
+	for _, c := range m.contents {
+		if c.Status == filter.Status && (filter.ProviderID == 0 || c.ProviderID == filter.ProviderID) {
+			matched = append(matched, c)
+		}
+	}
+	total := int64(len(matched))
+	if offset >= len(matched) {
+		return nil, total, nil
+	}
+	end := offset + limit
+	if end > len(matched) {
+		end = len(matched)
+	}
+	return matched[offset:end], total, nil
+}
+
+func (m *mockContentModerationRepository) UpdateContentStatus(ctx context.Context, contentIDs []int64, status entity.ContentStatus) (int64, error) {
+	var updated int64
+	for _, id := range contentIDs {
+		for _, c := range m.contents {
+			if c.ID == id {
+				c.Status = status
+				updated++
+			}
+		}
+	}
+	return updated, nil
+}
+
+func TestContentModerationUseCase_List(t *testing.T) {
+	repo := &mockContentModerationRepository{contents: []*entity.Content{
+		{ID: 1, ProviderID: 1, Status: entity.ContentStatusPending},
+		{ID: 2, ProviderID: 2, Status: entity.ContentStatusPending},
+		{ID: 3, ProviderID: 1, Status: entity.ContentStatusRejected},
+	}}
+	useCase := NewContentModerationUseCase(repo, &mockCacheRepository{})
+
+	t.Run("defaults to pending queue", func(t *testing.T) {
+		result, err := useCase.List(context.Background(), "", 0, 0, 0)
+		require.NoError(t, err)
+		assert.Equal(t, entity.ContentStatusPending, repo.filter.Status)
+		assert.Len(t, result.Items, 2)
+		assert.Equal(t, int64(2), result.Pagination.TotalItems)
+	})
+
+	t.Run("filters by status and provider", func(t *testing.T) {
+		result, err := useCase.List(context.Background(), entity.ContentStatusRejected, 1, 1, 20)
+		require.NoError(t, err)
+		require.Len(t, result.Items, 1)
+		assert.Equal(t, int64(3), result.Items[0].ID)
+	})
+
+	t.Run("empty queue returns empty slice", func(t *testing.T) {
+		result, err := useCase.List(context.Background(), entity.ContentStatusApproved, 0, 1, 20)
+		require.NoError(t, err)
+		assert.NotNil(t, result.Items)
+		assert.Empty(t, result.Items)
+	})
+
+	t.Run("rejects invalid status", func(t *testing.T) {
+		_, err := useCase.List(context.Background(), "published", 0, 1, 20)
+		var validationErr *apperrors.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
+
+func TestContentModerationUseCase_Review(t *testing.T) {
+	t.Run("approves contents, reindexes and invalidates cache", func(t *testing.T) {
+		repo := &mockContentModerationRepository{contents: []*entity.Content{
+			{ID: 1, Status: entity.ContentStatusPending},
+			{ID: 2, Status: entity.ContentStatusPending},
+		}}
+		cache := &mockCacheRepository{}
+		indexer := &mockSearchIndexer{cache: cache}
+		useCase := NewContentModerationUseCase(repo, cache)
+		useCase.SetSearchIndexer(indexer)
+
+		result, err := useCase.Review(context.Background(), ContentReviewInput{ContentIDs: []int64{1, 99}, Status: entity.ContentStatusApproved})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), result.Updated)
+		assert.Equal(t, entity.ContentStatusApproved, repo.contents[0].Status)
+		assert.Equal(t, entity.ContentStatusPending, repo.contents[1].Status)
+		assert.Equal(t, 1, indexer.calls)
+		assert.True(t, cache.invalidated)
+	})
+
+	t.Run("nothing updated leaves cache and index untouched", func(t *testing.T) {
+		cache := &mockCacheRepository{}
+		indexer := &mockSearchIndexer{cache: cache}
+		useCase := NewContentModerationUseCase(&mockContentModerationRepository{}, cache)
+		useCase.SetSearchIndexer(indexer)
+
+		result, err := useCase.Review(context.Background(), ContentReviewInput{ContentIDs: []int64{5}, Status: entity.ContentStatusRejected})
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), result.Updated)
+		assert.Equal(t, 0, indexer.calls)
+		assert.False(t, cache.invalidated)
+	})
+
+	t.Run("validates input", func(t *testing.T) {
+		useCase := NewContentModerationUseCase(&mockContentModerationRepository{}, &mockCacheRepository{})
+		inputs := []ContentReviewInput{
+			{ContentIDs: []int64{1}, Status: ""},
+			{ContentIDs: []int64{1}, Status: "deleted"},
+			{ContentIDs: nil, Status: entity.ContentStatusApproved},
+			{ContentIDs: []int64{1, 0}, Status: entity.ContentStatusApproved},
+			{ContentIDs: make([]int64, maxModerationContentIDs+1), Status: entity.ContentStatusApproved},
+		}
+		for _, input := range inputs {
+			_, err := useCase.Review(context.Background(), input)
+			var validationErr *apperrors.ValidationError
+			assert.ErrorAs(t, err, &validationErr, "input: %+v", input)
+		}
+	})
+}
@@ -0,0 +1,218 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// contentStreamBuffer abone başına bekleyebilecek olay sayısı; dolduğunda yeni olaylar düşürülür
+const contentStreamBuffer = 256
+
+var (
+	// ErrStreamLimitReached eşzamanlı yayın akışı bağlantı limiti dolduğunda döner
+	ErrStreamLimitReached = errors.New("content stream client limit reached")
+
+	// ErrStreamClosed sunucu kapanırken yeni abonelik istendiğinde döner
+	ErrStreamClosed = errors.New("content stream is shutting down")
+)
+
+// ContentStreamFilter yayın akışı aboneliğinin filtreleri; boş alanlar filtre uygulamaz
+type ContentStreamFilter struct {
+	Query       string             // Tüm terimler başlık, açıklama veya tag'lerde geçmeli (büyük/küçük harf duyarsız)
+	Tags        []string           // İçerik bu tag'lerden en az birine sahip olmalı
+	ContentType entity.ContentType // İçerik türü
+	ProviderID  int64              // Provider ID
+	Changes     []string           // Değişiklik türleri: "created", "updated"
+}
+
+// ContentStreamUseCase senkronizasyonda yazılan içerikleri filtreleyerek canlı akış abonelerine iletir
+type ContentStreamUseCase struct {
+	subscriber port.ContentChangeSubscriber
+	maxClients int // 0 ise limit yok
+
+	mu      sync.Mutex
+	clients int
+	closed  bool
+
+	// Close çağrıldığında tüm abonelikler sonlandırılır
+	baseCtx context.Context
+	cancel  context.CancelFunc
+}
+
+// NewContentStreamUseCase yeni bir yayın akışı use case'i oluşturur
+func NewContentStreamUseCase(subscriber port.ContentChangeSubscriber, maxClients int) *ContentStreamUseCase {
+	baseCtx, cancel := context.WithCancel(context.Background())
+	return &ContentStreamUseCase{
+		subscriber: subscriber,
+		maxClients: maxClients,
+		baseCtx:    baseCtx,
+		cancel:     cancel,
+	}
+}
+
+// Subscribe filtreye uyan, onaylı içeriklerin değişikliklerini döner
+// Kanal ctx iptal edildiğinde veya Close çağrıldığında kapanır
+func (uc *ContentStreamUseCase) Subscribe(ctx context.Context, filter ContentStreamFilter) (<-chan entity.ContentChange, error) {
+	filter, err := normalizeStreamFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.acquire(); err != nil {
+		return nil, err
+	}
+
+	source, unsubscribe := uc.subscriber.Subscribe(contentStreamBuffer)
+	out := make(chan entity.ContentChange)
+
+	go func() {
+		defer uc.release()
+		defer close(out)
+		defer unsubscribe()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-uc.baseCtx.Done():
+				return
+			case change, ok := <-source:
+				if !ok {
+					return
+				}
+				if !filter.matches(change) {
+					continue
+				}
+				select {
+				case out <- change:
+				case <-ctx.Done():
+					return
+				case <-uc.baseCtx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// Close açık tüm abonelikleri sonlandırır ve yenilerini reddeder
+// HTTP sunucusu kapanırken uzun süreli bağlantıların beklenmemesi için çağrılır
+func (uc *ContentStreamUseCase) Close() {
+	uc.mu.Lock()
+	uc.closed = true
+	uc.mu.Unlock()
+	uc.cancel()
+}
+
+// Clients bağlı abone sayısını döner
+func (uc *ContentStreamUseCase) Clients() int {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	return uc.clients
+}
+
+// acquire limit dolmamışsa yeni abone için yer ayırır
+func (uc *ContentStreamUseCase) acquire() error {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	if uc.closed {
+		return ErrStreamClosed
+	}
+	if uc.maxClients > 0 && uc.clients >= uc.maxClients {
+		return ErrStreamLimitReached
+	}
+	uc.clients++
+	return nil
+}
+
+// release abonenin yerini bırakır
+func (uc *ContentStreamUseCase) release() {
+	uc.mu.Lock()
+	uc.clients--
+	uc.mu.Unlock()
+}
+
+// normalizeStreamFilter filtreyi doğrular; terimleri ve tag'leri küçük harfe çevirir
+func normalizeStreamFilter(filter ContentStreamFilter) (ContentStreamFilter, error) {
+	if filter.ContentType != "" && !entity.IsValidContentType(filter.ContentType) {
+		return filter, apperrors.NewValidationError("type",
+			"invalid content type (must be one of: "+strings.Join(entity.ContentTypeNames(), ", ")+")", filter.ContentType)
+	}
+	if filter.ProviderID < 0 {
+		return filter, apperrors.NewValidationError("provider_id", "provider_id must be a positive integer", filter.ProviderID)
+	}
+	for _, change := range filter.Changes {
+		if change != entity.ContentChangeCreated && change != entity.ContentChangeUpdated {
+			return filter, apperrors.NewValidationError("changes", "invalid change type (must be 'created' or 'updated')", change)
+		}
+	}
+
+	filter.Query = strings.Join(strings.Fields(strings.ToLower(filter.Query)), " ")
+	filter.Tags = normalizeTags(filter.Tags)
+	return filter, nil
+}
+
+// matches değişikliğin filtreye uyup uymadığını döner
+// Moderasyon bekleyen veya reddedilen içerikler aramada olduğu gibi akışta da görünmez
+func (f ContentStreamFilter) matches(change entity.ContentChange) bool {
+	content := change.Content
+	if content == nil || !content.IsApproved() {
+		return false
+	}
+
+	if len(f.Changes) > 0 && !containsString(f.Changes, change.Type) {
+		return false
+	}
+	if f.ContentType != "" && content.ContentType != f.ContentType {
+		return false
+	}
+	if f.ProviderID > 0 && content.ProviderID != f.ProviderID {
+		return false
+	}
+
+	if len(f.Tags) > 0 {
+		found := false
+		for _, tag := range content.Tags {
+			if containsString(f.Tags, strings.ToLower(tag.Name)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if f.Query != "" {
+		text := strings.ToLower(content.Title + " " + content.Description)
+		for _, tag := range content.Tags {
+			text += " " + strings.ToLower(tag.Name)
+		}
+		for _, term := range strings.Fields(f.Query) {
+			if !strings.Contains(text, term) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// containsString values içinde value olup olmadığını döner
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+)
+
+// mockChangeSubscriber testin doğrudan yazabildiği tek bir kanal döner
+type mockChangeSubscriber struct {
+	source       chan entity.ContentChange
+	unsubscribed chan struct{}
+}
+
+func newMockChangeSubscriber() *mockChangeSubscriber {
+	return &mockChangeSubscriber{
+		source:       make(chan entity.ContentChange, 10),
+		unsubscribed: make(chan struct{}),
+	}
+}
+
+func (m *mockChangeSubscriber) Subscribe(buffer int) (<-chan entity.ContentChange, func()) {
+	return m.source, func() { close(m.unsubscribed) }
+}
+
+func streamChange(changeType, title string, tags ...string) entity.ContentChange {
+	content := &entity.Content{ID: 1, ProviderID: 1, Title: title, ContentType: entity.ContentTypeVideo}
+	for _, tag := range tags {
+		content.Tags = append(content.Tags, entity.Tag{Name: tag})
+	}
+	return entity.ContentChange{Type: changeType, Content: content, OccurredAt: time.Now()}
+}
+
+func TestContentStreamFilter_Matches(t *testing.T) {
+	pending := streamChange(entity.ContentChangeCreated, "Go Concurrency")
+	pending.Content.Status = entity.ContentStatusPending
+
+	tests := []struct {
+		name   string
+		filter ContentStreamFilter
+		change entity.ContentChange
+		want   bool
+	}{
+		{"empty filter matches everything", ContentStreamFilter{}, streamChange(entity.ContentChangeUpdated, "Anything"), true},
+		{"all query terms must match", ContentStreamFilter{Query: "GO  concurrency"}, streamChange(entity.ContentChangeCreated, "Go Concurrency Patterns"), true},
+		{"missing query term", ContentStreamFilter{Query: "go generics"}, streamChange(entity.ContentChangeCreated, "Go Concurrency"), false},
+		{"query matches tags", ContentStreamFilter{Query: "golang"}, streamChange(entity.ContentChangeCreated, "Channels", "golang"), true},
+		{"any tag matches", ContentStreamFilter{Tags: []string{"Rust", "golang"}}, streamChange(entity.ContentChangeCreated, "Channels", "golang"), true},
+		{"no tag matches", ContentStreamFilter{Tags: []string{"rust"}}, streamChange(entity.ContentChangeCreated, "Channels", "golang"), false},
+		{"change type filter", ContentStreamFilter{Changes: []string{entity.ContentChangeCreated}}, streamChange(entity.ContentChangeUpdated, "Channels"), false},
+		{"content type filter", ContentStreamFilter{ContentType: entity.ContentTypeArticle}, streamChange(entity.ContentChangeCreated, "Channels"), false},
+		{"provider filter", ContentStreamFilter{ProviderID: 2}, streamChange(entity.ContentChangeCreated, "Channels"), false},
+		{"unapproved contents are hidden", ContentStreamFilter{}, pending, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := normalizeStreamFilter(tt.filter)
+			if err != nil {
+				t.Fatalf("normalizeStreamFilter failed: %v", err)
+			}
+			if got := filter.matches(tt.change); got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestContentStreamUseCase_Subscribe(t *testing.T) {
+	t.Run("forwards matching changes until the client leaves", func(t *testing.T) {
+		subscriber := newMockChangeSubscriber()
+		useCase := NewContentStreamUseCase(subscriber, 0)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		changes, err := useCase.Subscribe(ctx, ContentStreamFilter{Tags: []string{"golang"}})
+		if err != nil {
+			t.Fatalf("Subscribe failed: %v", err)
+		}
+
+		subscriber.source <- streamChange(entity.ContentChangeCreated, "Rust", "rust")
+		subscriber.source <- streamChange(entity.ContentChangeCreated, "Go", "golang")
+
+		got := <-changes
+		if got.Content.Title != "Go" {
+			t.Errorf("Expected the golang content, got %q", got.Content.Title)
+		}
+
+		cancel()
+		if _, ok := <-changes; ok {
+			t.Error("Expected the channel to be closed")
+		}
+		<-subscriber.unsubscribed
+	})
+
+	t.Run("rejects invalid filters", func(t *testing.T) {
+		useCase := NewContentStreamUseCase(newMockChangeSubscriber(), 0)
+
+		_, err := useCase.Subscribe(context.Background(), ContentStreamFilter{Changes: []string{"deleted"}})
+		var validationErr *apperrors.ValidationError
+		if !errors.As(err, &validationErr) || validationErr.Field != "changes" {
+			t.Errorf("Expected changes validation error, got %v", err)
+		}
+	})
+
+	t.Run("limits concurrent clients", func(t *testing.T) {
+		useCase := NewContentStreamUseCase(newMockChangeSubscriber(), 1)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		if _, err := useCase.Subscribe(ctx, ContentStreamFilter{}); err != nil {
+			t.Fatalf("Subscribe failed: %v", err)
+		}
+		if _, err := useCase.Subscribe(ctx, ContentStreamFilter{}); !errors.Is(err, ErrStreamLimitReached) {
+			t.Errorf("Expected ErrStreamLimitReached, got %v", err)
+		}
+	})
+
+	t.Run("close ends open streams and rejects new ones", func(t *testing.T) {
+		useCase := NewContentStreamUseCase(newMockChangeSubscriber(), 0)
+
+		changes, err := useCase.Subscribe(context.Background(), ContentStreamFilter{})
+		if err != nil {
+			t.Fatalf("Subscribe failed: %v", err)
+		}
+
+		useCase.Close()
+		if _, ok := <-changes; ok {
+			t.Error("Expected the channel to be closed")
+		}
+		if _, err := useCase.Subscribe(context.Background(), ContentStreamFilter{}); !errors.Is(err, ErrStreamClosed) {
+			t.Errorf("Expected ErrStreamClosed, got %v", err)
+		}
+	})
+}
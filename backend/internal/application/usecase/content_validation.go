@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/logger"
+)
+
+// Normalize edilmiş içerik doğrulama sınırları
+const (
+	maxExternalIDLength = 100              // contents.provider_content_id VARCHAR(100)
+	maxReadingTime      = 24 * 60          // dakika
+	maxDurationSeconds  = 7 * 24 * 60 * 60 // saniye (canlı yayın kayıtları dahil)
+	maxFutureSkew       = 10 * time.Minute // provider saat farkı toleransı
+	maxCategoryName     = 100              // categories.name VARCHAR(100)
+)
+
+// validateNormalizedContent içeriğin zorunlu alanlarını, metrik aralıklarını ve yayın tarihini doğrular
+func validateNormalizedContent(content *entity.NormalizedContent, now time.Time) error {
+	if content.ExternalID == "" {
+		return apperrors.NewValidationError("external_id", "external_id is required", nil)
+	}
+	if len(content.ExternalID) > maxExternalIDLength {
+		return apperrors.NewValidationError("external_id", "external_id too long (max 100 characters)", content.ExternalID)
+	}
+	if strings.TrimSpace(content.Title) == "" {
+		return apperrors.NewValidationError("title", "title is required", content.Title)
+	}
+	if !entity.IsValidContentType(content.ContentType) {
+		return apperrors.NewValidationError("content_type",
+			"invalid content type (must be one of: "+strings.Join(entity.ContentTypeNames(), ", ")+")", content.ContentType)
+	}
+
+	if content.PublishedAt.IsZero() {
+		return apperrors.NewValidationError("published_at", "published_at is required", nil)
+	}
+	if content.PublishedAt.After(now.Add(maxFutureSkew)) {
+		return apperrors.NewValidationError("published_at", "published_at is in the future", content.PublishedAt)
+	}
+
+	stats := content.Stats
+	if stats.Views < 0 {
+		return apperrors.NewValidationError("stats.views", "views must not be negative", stats.Views)
+	}
+	if stats.Likes < 0 {
+		return apperrors.NewValidationError("stats.likes", "likes must not be negative", stats.Likes)
+	}
+	if stats.Reactions < 0 {
+		return apperrors.NewValidationError("stats.reactions", "reactions must not be negative", stats.Reactions)
+	}
+	if stats.ReadingTime < 0 || stats.ReadingTime > maxReadingTime {
+		return apperrors.NewValidationError("stats.reading_time", "reading_time must be between 0 and 1440 minutes", stats.ReadingTime)
+	}
+	if stats.DurationSeconds < 0 || stats.DurationSeconds > maxDurationSeconds {
+		return apperrors.NewValidationError("stats.duration_seconds", "duration_seconds must be between 0 and 604800 seconds", stats.DurationSeconds)
+	}
+	if stats.Comments < 0 {
+		return apperrors.NewValidationError("stats.comments", "comments must not be negative", stats.Comments)
+	}
+
+	if len(content.Category) > entity.MaxCategoryDepth {
+		return apperrors.NewValidationError("category", "category must have at most 5 levels", content.Category)
+	}
+	for _, name := range content.Category {
+		if len(name) > maxCategoryName {
+			return apperrors.NewValidationError("category", "category name too long (max 100 characters)", name)
+		}
+	}
+
+	return nil
+}
+
+// filterValidContents geçersiz içerikleri ayıklar
+// Provider'ın normalize edemeyip atladığı içerikler de reddedilenlere eklenir
+func filterValidContents(client port.ProviderClient, contents []*entity.NormalizedContent) ([]*entity.NormalizedContent, []*entity.ProviderSyncError) {
+	var rejected []*entity.ProviderSyncError
+	if reporter, ok := client.(port.RejectionReporter); ok {
+		rejected = append(rejected, reporter.Rejections()...)
+	}
+
+	now := time.Now()
+	valid := make([]*entity.NormalizedContent, 0, len(contents))
+	for _, content := range contents {
+		if err := validateNormalizedContent(content, now); err != nil {
+			rejected = append(rejected, &entity.ProviderSyncError{
+				ExternalID: content.ExternalID,
+				Reason:     err.Error(),
+				RawData:    content.RawData,
+			})
+			continue
+		}
+		valid = append(valid, content)
+	}
+
+	return valid, rejected
+}
+
+// quarantineRejected reddedilen içerikleri provider'ın karantinasına yazar
+// Hata kritik değil: senkronizasyon geçerli içeriklerle devam eder
+func (uc *SyncProviderContentsUseCase) quarantineRejected(ctx context.Context, provider *entity.Provider, rejected []*entity.ProviderSyncError) {
+	if len(rejected) > 0 {
+		logger.FromContext(ctx).Warn("invalid contents quarantined",
+			zap.String("provider", provider.Name),
+			zap.Int("count", len(rejected)),
+		)
+	}
+	if uc.syncLogRepo == nil {
+		return
+	}
+	if err := uc.syncLogRepo.ReplaceSyncErrors(ctx, provider.ID, rejected); err != nil {
+		logger.FromContext(ctx).Error("quarantine records could not be written",
+			zap.String("provider", provider.Name),
+			zap.Error(err),
+		)
+	}
+}
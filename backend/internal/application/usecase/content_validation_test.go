@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// mockRejectingClient normalize edemediği içerikleri raporlayan bir provider client'ı taklit eder
+type mockRejectingClient struct {
+	mockProviderClient
+	rejections []*entity.ProviderSyncError
+}
+
+func (m *mockRejectingClient) Rejections() []*entity.ProviderSyncError {
+	return m.rejections
+}
+
+func TestValidateNormalizedContent(t *testing.T) {
+	now := time.Now()
+	valid := func() *entity.NormalizedContent {
+		return &entity.NormalizedContent{
+			ExternalID:  "v1",
+			Title:       "Video",
+			ContentType: entity.ContentTypeVideo,
+			PublishedAt: now.Add(-time.Hour),
+			Stats:       entity.ContentStats{Views: 10, Likes: 1},
+		}
+	}
+
+	require.NoError(t, validateNormalizedContent(valid(), now))
+
+	cases := map[string]func(c *entity.NormalizedContent){
+		"external_id":            func(c *entity.NormalizedContent) { c.ExternalID = strings.Repeat("x", 101) },
+		"title":                  func(c *entity.NormalizedContent) { c.Title = "  " },
+		"content_type":           func(c *entity.NormalizedContent) { c.ContentType = "hologram" },
+		"published_at":           func(c *entity.NormalizedContent) { c.PublishedAt = now.Add(24 * time.Hour) },
+		"stats.views":            func(c *entity.NormalizedContent) { c.Stats.Views = -1 },
+		"stats.likes":            func(c *entity.NormalizedContent) { c.Stats.Likes = -5 },
+		"stats.reactions":        func(c *entity.NormalizedContent) { c.Stats.Reactions = -1 },
+		"stats.reading_time":     func(c *entity.NormalizedContent) { c.Stats.ReadingTime = 5000 },
+		"stats.duration_seconds": func(c *entity.NormalizedContent) { c.Stats.DurationSeconds = -1 },
+		"stats.comments":         func(c *entity.NormalizedContent) { c.Stats.Comments = -3 },
+		"category":               func(c *entity.NormalizedContent) { c.Category = []string{"a", "b", "c", "d", "e", "f"} },
+	}
+	for field, mutate := range cases {
+		content := valid()
+		mutate(content)
+
+		var validationErr *apperrors.ValidationError
+		require.ErrorAs(t, validateNormalizedContent(content, now), &validationErr, field)
+		assert.Equal(t, field, validationErr.Field)
+	}
+
+	// Saat farkı toleransı içindeki tarihler kabul edilir
+	content := valid()
+	content.PublishedAt = now.Add(time.Minute)
+	assert.NoError(t, validateNormalizedContent(content, now))
+}
+
+func TestSyncProviderContentsUseCase_Quarantine(t *testing.T) {
+	newClient := func() *mockRejectingClient {
+		return &mockRejectingClient{
+			mockProviderClient: mockProviderClient{contents: []*entity.NormalizedContent{
+				{ExternalID: "ok", Title: "OK", ContentType: entity.ContentTypeVideo, PublishedAt: testPublishedAt},
+				{ExternalID: "future", Title: "Future", ContentType: entity.ContentTypeVideo, PublishedAt: time.Now().Add(48 * time.Hour), RawData: `{"id":"future"}`},
+			}},
+			rejections: []*entity.ProviderSyncError{{ExternalID: "broken", Reason: "tarih parse hatası", RawData: `{"id":"broken"}`}},
+		}
+	}
+
+	t.Run("quarantines invalid items and syncs the rest", func(t *testing.T) {
+		logRepo := newMockProviderRepository()
+		useCase := NewSyncProviderContentsUseCase([]port.ProviderClient{newClient()}, &mockContentRepository{}, &mockScoringService{}, &mockCacheRepository{})
+		useCase.SetSyncLogRepository(logRepo)
+
+		require.NoError(t, useCase.ExecuteProvider(context.Background(), 1))
+
+		assert.Equal(t, int32(1), logRepo.syncLogs[0].ItemsSynced)
+		quarantined := logRepo.syncErrors[1]
+		require.Len(t, quarantined, 2)
+		assert.Equal(t, "broken", quarantined[0].ExternalID)
+		assert.Equal(t, "future", quarantined[1].ExternalID)
+		assert.Equal(t, `{"id":"future"}`, quarantined[1].RawData)
+		assert.Contains(t, quarantined[1].Reason, "published_at")
+	})
+
+	t.Run("keeps quarantine when fetch fails", func(t *testing.T) {
+		logRepo := newMockProviderRepository()
+		logRepo.syncErrors = map[int64][]*entity.ProviderSyncError{1: {{ExternalID: "old"}}}
+		client := &mockProviderClient{err: errors.New("provider down")}
+		useCase := NewSyncProviderContentsUseCase([]port.ProviderClient{client}, &mockContentRepository{}, &mockScoringService{}, &mockCacheRepository{})
+		useCase.SetSyncLogRepository(logRepo)
+
+		assert.Error(t, useCase.ExecuteProvider(context.Background(), 1))
+		assert.Len(t, logRepo.syncErrors[1], 1)
+	})
+
+	t.Run("dry run reports rejected items", func(t *testing.T) {
+		useCase := NewSyncProviderContentsUseCase([]port.ProviderClient{newClient()}, &mockContentRepository{}, &mockScoringService{}, &mockCacheRepository{})
+
+		result, err := useCase.DryRun(context.Background(), 1)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"broken", "future"}, result.Providers[0].Rejected)
+		assert.Equal(t, []string{"ok"}, result.Providers[0].Insert)
+	})
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// ContentVersionsUseCase içeriğin önceki hallerini listeleme use case'i
+type ContentVersionsUseCase struct {
+	contentRepo port.ContentRepository
+	versionRepo port.ContentVersionRepository
+}
+
+// ContentVersionsResult içerik versiyonları sonucu yapısı
+type ContentVersionsResult struct {
+	Items      []*entity.ContentVersion `json:"items"`
+	Pagination Pagination               `json:"pagination"`
+}
+
+// NewContentVersionsUseCase yeni bir içerik versiyonları use case oluşturur
+func NewContentVersionsUseCase(contentRepo port.ContentRepository, versionRepo port.ContentVersionRepository) *ContentVersionsUseCase {
+	return &ContentVersionsUseCase{
+		contentRepo: contentRepo,
+		versionRepo: versionRepo,
+	}
+}
+
+// Execute içeriğin önceki hallerini en yeniden eskiye sayfalı getirir
+// İçerik yoksa veya silinmişse port.ErrContentNotFound döner
+func (uc *ContentVersionsUseCase) Execute(ctx context.Context, contentID int64, page, pageSize int) (*ContentVersionsResult, error) {
+	if contentID < 1 {
+		return nil, apperrors.NewValidationError("id", "id must be a positive integer", contentID)
+	}
+
+	if _, err := uc.contentRepo.FindByID(ctx, contentID); err != nil {
+		if err == port.ErrContentNotFound {
+			return nil, err
+		}
+		return nil, fmt.Errorf("içerik getirme hatası: %w", err)
+	}
+
+	page, pageSize = normalizeHistoryPage(page, pageSize)
+
+	versions, total, err := uc.versionRepo.ListContentVersions(ctx, contentID, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("içerik versiyonları hatası: %w", err)
+	}
+
+	if versions == nil {
+		versions = make([]*entity.ContentVersion, 0)
+	}
+
+	return &ContentVersionsResult{
+		Items:      versions,
+		Pagination: historyPagination(page, pageSize, total),
+	}, nil
+}
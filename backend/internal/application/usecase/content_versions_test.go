@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+type mockContentVersionRepository struct {
+	versions []*entity.ContentVersion
+}
+
+func (m *mockContentVersionRepository) ListContentVersions(ctx context.Context, contentID int64, limit, offset int) ([]*entity.ContentVersion, int64, error) {
+	var matched []*entity.ContentVersion
+	for _, v := range m.versions {
+		if v.ContentID == contentID {
+			matched = append(matched, v)
+		}
+	}
+	total := int64(len(matched))
+	if offset >= len(matched) {
+		return nil, total, nil
+	}
+	end := offset + limit
+	if end > len(matched) {
+		end = len(matched)
+	}
+	return matched[offset:end], total, nil
+}
+
+func TestContentVersionsUseCase_Execute(t *testing.T) {
+	contentRepo := &mockSearchRepository{
+		findByID: func(ctx context.Context, id int64) (*entity.Content, error) {
+			if id > 100 {
+				return nil, port.ErrContentNotFound
+			}
+			return &entity.Content{ID: id}, nil
+		},
+	}
+	versionRepo := &mockContentVersionRepository{}
+	for i := 0; i < 3; i++ {
+		versionRepo.versions = append(versionRepo.versions, &entity.ContentVersion{
+			ID:        int64(i + 1),
+			ContentID: 7,
+			Title:     "Old Title",
+			Stats:     &entity.ContentVersionStats{Views: int64(i * 100)},
+		})
+	}
+	useCase := NewContentVersionsUseCase(contentRepo, versionRepo)
+
+	t.Run("paginates previous versions", func(t *testing.T) {
+		result, err := useCase.Execute(context.Background(), 7, 1, 2)
+		require.NoError(t, err)
+		assert.Len(t, result.Items, 2)
+		assert.Equal(t, int64(3), result.Pagination.TotalItems)
+		assert.Equal(t, int64(2), result.Pagination.TotalPages)
+	})
+
+	t.Run("content without versions returns empty slice", func(t *testing.T) {
+		result, err := useCase.Execute(context.Background(), 8, 0, 0)
+		require.NoError(t, err)
+		assert.NotNil(t, result.Items)
+		assert.Empty(t, result.Items)
+	})
+
+	t.Run("missing content", func(t *testing.T) {
+		_, err := useCase.Execute(context.Background(), 101, 1, 20)
+		assert.ErrorIs(t, err, port.ErrContentNotFound)
+	})
+
+	t.Run("rejects invalid content id", func(t *testing.T) {
+		_, err := useCase.Execute(context.Background(), -1, 1, 20)
+		var validationErr *apperrors.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
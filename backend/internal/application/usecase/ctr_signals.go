@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// CTR sinyali varsayılanları
+const (
+	defaultCTRWindowDays       = 30
+	defaultCTRMinImpressions   = 100
+	defaultCTRPriorImpressions = 200.0
+	minCTRLift                 = 0.0001 // Hiç tıklanmayan içerik de sinyal alır; 0 "sinyal yok" demektir
+)
+
+// CTRSignalStats bir CTR sinyali yenilemesinin sonucu
+type CTRSignalStats struct {
+	Contents  int     // Pencerede olayı olan içerikler
+	Signals   int     // Yeterli gösterimi olduğu için sinyal alan içerikler
+	Updated   int     // Sinyali değişen skor kayıtları
+	GlobalCTR float64 // Penceredeki tüm gösterimlerin tıklanma oranı
+}
+
+// RefreshCTRSignalsUseCase arama olaylarından içerik başına CTR sinyalini hesaplayıp skor kayıtlarına yazar
+// Sinyal (lift) içeriğin yumuşatılmış CTR'ının global CTR'a oranıdır; 1 ortalama, 1'den büyük ortalamadan çok tıklanan.
+// Az gösterimli içerikler için iki koruma vardır: minImpressions altındaki içerikler sinyal almaz ve her içeriğin
+// CTR'ına global CTR'da priorImpressions kadar sanal gösterim eklenir (Bayes yumuşatma), böylece birkaç tesadüfi
+// tıklama sıralamayı değiştirmez. Yeni sinyaller skorlara bir sonraki yeniden hesaplamada (veya sync'te) yansır
+type RefreshCTRSignalsUseCase struct {
+	ctrRepo    port.CTRSignalRepository
+	transactor port.Transactor // nil ise sinyaller transaction'sız yazılır ve audit kaydında actor boş kalır
+	now        func() time.Time
+
+	windowDays       int
+	minImpressions   int64
+	priorImpressions float64
+}
+
+// NewRefreshCTRSignalsUseCase yeni bir CTR sinyali yenileme use case oluşturur
+func NewRefreshCTRSignalsUseCase(ctrRepo port.CTRSignalRepository) *RefreshCTRSignalsUseCase {
+	return &RefreshCTRSignalsUseCase{
+		ctrRepo:          ctrRepo,
+		now:              time.Now,
+		windowDays:       defaultCTRWindowDays,
+		minImpressions:   defaultCTRMinImpressions,
+		priorImpressions: defaultCTRPriorImpressions,
+	}
+}
+
+// SetWindowDays sinyalin hesaplandığı olay penceresini gün olarak ayarlar (0 veya negatifse varsayılan kullanılır)
+func (uc *RefreshCTRSignalsUseCase) SetWindowDays(days int) {
+	if days <= 0 {
+		days = defaultCTRWindowDays
+	}
+	uc.windowDays = days
+}
+
+// SetMinImpressions sinyal için gereken en az gösterim sayısını ayarlar (0 veya negatifse varsayılan kullanılır)
+func (uc *RefreshCTRSignalsUseCase) SetMinImpressions(impressions int) {
+	if impressions <= 0 {
+		impressions = defaultCTRMinImpressions
+	}
+	uc.minImpressions = int64(impressions)
+}
+
+// SetPriorImpressions yumuşatmada eklenen sanal gösterim sayısını ayarlar (0 yumuşatmayı kapatır)
+func (uc *RefreshCTRSignalsUseCase) SetPriorImpressions(impressions float64) {
+	uc.priorImpressions = math.Max(0, impressions)
+}
+
+// SetTransactor sinyalleri transaction içinde yazacak transactor'ı ayarlar
+func (uc *RefreshCTRSignalsUseCase) SetTransactor(transactor port.Transactor) {
+	uc.transactor = transactor
+}
+
+// Execute penceredeki olaylardan sinyalleri hesaplar ve tüm kayıtlı sinyalleri bunlarla değiştirir
+// Pencerede hiç tıklama yoksa tüm sinyaller sıfırlanır
+func (uc *RefreshCTRSignalsUseCase) Execute(ctx context.Context) (CTRSignalStats, error) {
+	var stats CTRSignalStats
+
+	since := uc.now().AddDate(0, 0, -uc.windowDays)
+	contents, err := uc.ctrRepo.AggregateContentCTR(ctx, since)
+	if err != nil {
+		return stats, fmt.Errorf("arama olayları okunamadı: %w", err)
+	}
+	stats.Contents = len(contents)
+
+	var impressions, clicks int64
+	for _, c := range contents {
+		impressions += c.Impressions
+		clicks += c.Clicks
+	}
+
+	lifts := make(map[int64]float64)
+	if impressions > 0 && clicks > 0 {
+		stats.GlobalCTR = float64(clicks) / float64(impressions)
+		for _, c := range contents {
+			if c.Impressions < uc.minImpressions {
+				continue
+			}
+			smoothed := (float64(c.Clicks) + uc.priorImpressions*stats.GlobalCTR) / (float64(c.Impressions) + uc.priorImpressions)
+			lift := math.Round(smoothed/stats.GlobalCTR*10000) / 10000
+			lifts[c.ContentID] = math.Max(lift, minCTRLift)
+		}
+	}
+	stats.Signals = len(lifts)
+
+	ctx = port.WithAuditInfo(ctx, port.AuditInfo{Actor: port.AuditActorScoring})
+	write := func(ctx context.Context) error {
+		updated, err := uc.ctrRepo.ReplaceCTRLifts(ctx, lifts)
+		stats.Updated = updated
+		return err
+	}
+	if uc.transactor == nil {
+		err = write(ctx)
+	} else {
+		err = uc.transactor.WithinTransaction(ctx, write)
+	}
+	if err != nil {
+		return stats, fmt.Errorf("CTR sinyalleri yazılamadı: %w", err)
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// mockCTRSignalRepository toplanan olayları döner, yazılan sinyalleri kaydeder
+type mockCTRSignalRepository struct {
+	contents []*entity.ContentCTR
+	since    time.Time
+	lifts    map[int64]float64
+	aggErr   error
+}
+
+func (m *mockCTRSignalRepository) AggregateContentCTR(ctx context.Context, since time.Time) ([]*entity.ContentCTR, error) {
+	m.since = since
+	return m.contents, m.aggErr
+}
+
+func (m *mockCTRSignalRepository) ReplaceCTRLifts(ctx context.Context, lifts map[int64]float64) (int, error) {
+	m.lifts = lifts
+	return len(lifts), nil
+}
+
+func (m *mockCTRSignalRepository) FindCTRLifts(ctx context.Context, contentIDs []int64) (map[int64]float64, error) {
+	lifts := make(map[int64]float64)
+	for _, id := range contentIDs {
+		if lift, ok := m.lifts[id]; ok {
+			lifts[id] = lift
+		}
+	}
+	return lifts, nil
+}
+
+func TestRefreshCTRSignalsUseCase_Execute(t *testing.T) {
+	now := time.Date(2026, 3, 10, 3, 0, 0, 0, time.UTC)
+
+	t.Run("smooths toward global CTR and skips low-impression contents", func(t *testing.T) {
+		repo := &mockCTRSignalRepository{contents: []*entity.ContentCTR{
+			{ContentID: 1, Impressions: 990, Clicks: 195}, // Ortalamanın üstünde
+			{ContentID: 2, Impressions: 1000, Clicks: 0},  // Hiç tıklanmamış
+			{ContentID: 3, Impressions: 10, Clicks: 5},    // Az gösterim, sinyal yok
+		}}
+		transactor := &mockTransactor{}
+		useCase := NewRefreshCTRSignalsUseCase(repo)
+		useCase.now = func() time.Time { return now }
+		useCase.SetMinImpressions(100)
+		useCase.SetPriorImpressions(1010)
+		useCase.SetTransactor(transactor)
+
+		stats, err := useCase.Execute(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, now.AddDate(0, 0, -defaultCTRWindowDays), repo.since)
+		assert.Equal(t, 3, stats.Contents)
+		assert.Equal(t, 2, stats.Signals)
+		assert.InDelta(t, 0.1, stats.GlobalCTR, 1e-9)
+
+		// (195 + 1010 × 0.1) / (990 + 1010) = 0.148, lift 1.48
+		assert.InDelta(t, 1.48, repo.lifts[1], 1e-9)
+		// (0 + 101) / (1000 + 1010) = 0.050249, lift 0.5025
+		assert.InDelta(t, 0.5025, repo.lifts[2], 1e-9)
+		assert.NotContains(t, repo.lifts, int64(3))
+
+		require.Len(t, transactor.audit, 1)
+		assert.Equal(t, port.AuditActorScoring, transactor.audit[0].Actor)
+	})
+
+	t.Run("unsmoothed zero CTR still gets a signal", func(t *testing.T) {
+		repo := &mockCTRSignalRepository{contents: []*entity.ContentCTR{
+			{ContentID: 1, Impressions: 100, Clicks: 10},
+			{ContentID: 2, Impressions: 100, Clicks: 0},
+		}}
+		useCase := NewRefreshCTRSignalsUseCase(repo)
+		useCase.SetPriorImpressions(0)
+
+		_, err := useCase.Execute(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 2.0, repo.lifts[1])
+		assert.Equal(t, minCTRLift, repo.lifts[2])
+	})
+
+	t.Run("no clicks clears all signals", func(t *testing.T) {
+		repo := &mockCTRSignalRepository{contents: []*entity.ContentCTR{{ContentID: 1, Impressions: 500}}}
+		useCase := NewRefreshCTRSignalsUseCase(repo)
+
+		stats, err := useCase.Execute(context.Background())
+		require.NoError(t, err)
+		assert.Zero(t, stats.Signals)
+		assert.NotNil(t, repo.lifts)
+		assert.Empty(t, repo.lifts)
+	})
+
+	t.Run("aggregation error", func(t *testing.T) {
+		repo := &mockCTRSignalRepository{aggErr: errors.New("db down")}
+		useCase := NewRefreshCTRSignalsUseCase(repo)
+
+		_, err := useCase.Execute(context.Background())
+		require.Error(t, err)
+		assert.Nil(t, repo.lifts, "signals must not be cleared when events cannot be read")
+	})
+}
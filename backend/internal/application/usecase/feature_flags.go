@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// ManageFeatureFlagsUseCase feature flag yönetimi (admin) use case'i
+// Override'lar tüm instance'larda geçerli olur; kapatılan bir davranış yeniden başlatmadan geri alınır
+type ManageFeatureFlagsUseCase struct {
+	flags port.FeatureFlags
+	cache port.CacheRepository
+}
+
+// NewManageFeatureFlagsUseCase yeni bir feature flag yönetim use case oluşturur
+func NewManageFeatureFlagsUseCase(flags port.FeatureFlags, cache port.CacheRepository) *ManageFeatureFlagsUseCase {
+	return &ManageFeatureFlagsUseCase{
+		flags: flags,
+		cache: cache,
+	}
+}
+
+// List tanımlı bayrakları geçerli değerleriyle döner
+func (uc *ManageFeatureFlagsUseCase) List(ctx context.Context) ([]entity.FeatureFlag, error) {
+	flags, err := uc.flags.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("feature flag'ler okunamadı: %w", err)
+	}
+	return flags, nil
+}
+
+// Set bayrağı açar veya kapatır
+// Bayrak tanımlı değilse port.ErrFeatureFlagNotFound döner
+func (uc *ManageFeatureFlagsUseCase) Set(ctx context.Context, name string, enabled bool) (*entity.FeatureFlag, error) {
+	if err := uc.flags.SetOverride(ctx, name, enabled); err != nil {
+		if errors.Is(err, port.ErrFeatureFlagNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("feature flag kaydedilemedi: %w", err)
+	}
+
+	return uc.applyChange(ctx, name)
+}
+
+// Reset bayrağın override'ını kaldırıp config'teki varsayılanına döndürür
+// Bayrak tanımlı değilse port.ErrFeatureFlagNotFound döner
+func (uc *ManageFeatureFlagsUseCase) Reset(ctx context.Context, name string) (*entity.FeatureFlag, error) {
+	if err := uc.flags.ClearOverride(ctx, name); err != nil {
+		if errors.Is(err, port.ErrFeatureFlagNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("feature flag sıfırlanamadı: %w", err)
+	}
+
+	return uc.applyChange(ctx, name)
+}
+
+// applyChange değişiklikten sonra arama cache'ini temizler ve bayrağın güncel halini döner
+// Bayraklar arama sonuçlarını değiştirdiği için eski davranışla üretilmiş sonuçlar cache'den dönmemeli
+func (uc *ManageFeatureFlagsUseCase) applyChange(ctx context.Context, name string) (*entity.FeatureFlag, error) {
+	_ = invalidateContentCache(ctx, uc.cache)
+
+	flags, err := uc.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range flags {
+		if flags[i].Name == name {
+			return &flags[i], nil
+		}
+	}
+	return nil, port.ErrFeatureFlagNotFound
+}
+
+// featureEnabled flags nil ise (bayrak sistemi kurulmamışsa) davranışı açık kabul eder
+func featureEnabled(ctx context.Context, flags port.FeatureFlags, name string) bool {
+	return flags == nil || flags.Enabled(ctx, name)
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// mockFeatureFlags tanımlı bayrakları varsayılan olarak açık kabul eden port.FeatureFlags
+type mockFeatureFlags struct {
+	overrides map[string]bool
+	setErr    error
+}
+
+func newMockFeatureFlags(overrides map[string]bool) *mockFeatureFlags {
+	if overrides == nil {
+		overrides = make(map[string]bool)
+	}
+	return &mockFeatureFlags{overrides: overrides}
+}
+
+func (m *mockFeatureFlags) Enabled(ctx context.Context, name string) bool {
+	if enabled, ok := m.overrides[name]; ok {
+		return enabled
+	}
+	return true
+}
+
+func (m *mockFeatureFlags) List(ctx context.Context) ([]entity.FeatureFlag, error) {
+	flags := make([]entity.FeatureFlag, 0, len(entity.FeatureFlagNames))
+	for _, name := range entity.FeatureFlagNames {
+		_, overridden := m.overrides[name]
+		flags = append(flags, entity.FeatureFlag{Name: name, Enabled: m.Enabled(ctx, name), Default: true, Overridden: overridden})
+	}
+	sort.Slice(flags, func(i, j int) bool { return flags[i].Name < flags[j].Name })
+	return flags, nil
+}
+
+func (m *mockFeatureFlags) known(name string) bool {
+	for _, known := range entity.FeatureFlagNames {
+		if known == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *mockFeatureFlags) SetOverride(ctx context.Context, name string, enabled bool) error {
+	if !m.known(name) {
+		return port.ErrFeatureFlagNotFound
+	}
+	if m.setErr != nil {
+		return m.setErr
+	}
+	m.overrides[name] = enabled
+	return nil
+}
+
+func (m *mockFeatureFlags) ClearOverride(ctx context.Context, name string) error {
+	if !m.known(name) {
+		return port.ErrFeatureFlagNotFound
+	}
+	delete(m.overrides, name)
+	return nil
+}
+
+// invalidatingCache InvalidatePattern çağrılarını sayan cache
+type invalidatingCache struct {
+	*mockSearchCache
+	invalidated int
+}
+
+func (c *invalidatingCache) InvalidatePattern(ctx context.Context, pattern string) error {
+	c.invalidated++
+	return nil
+}
+
+func TestManageFeatureFlagsUseCase(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("set and reset override", func(t *testing.T) {
+		flags := newMockFeatureFlags(nil)
+		cache := &invalidatingCache{mockSearchCache: newMockSearchCache()}
+		uc := NewManageFeatureFlagsUseCase(flags, cache)
+
+		flag, err := uc.Set(ctx, entity.FlagFuzzyFallback, false)
+		require.NoError(t, err)
+		assert.Equal(t, entity.FeatureFlag{Name: entity.FlagFuzzyFallback, Enabled: false, Default: true, Overridden: true}, *flag)
+		assert.Positive(t, cache.invalidated, "bayrak değişikliğinden sonra arama cache'i temizlenmeli")
+
+		flag, err = uc.Reset(ctx, entity.FlagFuzzyFallback)
+		require.NoError(t, err)
+		assert.True(t, flag.Enabled)
+		assert.False(t, flag.Overridden)
+	})
+
+	t.Run("unknown flag", func(t *testing.T) {
+		uc := NewManageFeatureFlagsUseCase(newMockFeatureFlags(nil), newMockSearchCache())
+
+		_, err := uc.Set(ctx, "teleport", true)
+		assert.ErrorIs(t, err, port.ErrFeatureFlagNotFound)
+		_, err = uc.Reset(ctx, "teleport")
+		assert.ErrorIs(t, err, port.ErrFeatureFlagNotFound)
+	})
+
+	t.Run("store error is wrapped", func(t *testing.T) {
+		flags := newMockFeatureFlags(nil)
+		flags.setErr = errors.New("redis down")
+		uc := NewManageFeatureFlagsUseCase(flags, newMockSearchCache())
+
+		_, err := uc.Set(ctx, entity.FlagHybridRanking, false)
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, port.ErrFeatureFlagNotFound)
+		assert.Contains(t, err.Error(), "redis down")
+	})
+}
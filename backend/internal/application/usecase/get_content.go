@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/domain/service"
+)
+
+// GetContentUseCase tek içerik detayı use case'i
+type GetContentUseCase struct {
+	contentRepo    port.ContentRepository
+	scoringService service.ScoringService
+}
+
+// NewGetContentUseCase yeni bir içerik detayı use case oluşturur
+func NewGetContentUseCase(contentRepo port.ContentRepository, scoringService service.ScoringService) *GetContentUseCase {
+	return &GetContentUseCase{
+		contentRepo:    contentRepo,
+		scoringService: scoringService,
+	}
+}
+
+// Execute ID'ye göre içeriği getirir; explain true ise skor açıklaması eklenir
+// İçerik bulunamazsa veya henüz onaylanmamışsa (moderasyonda) port.ErrContentNotFound döner
+func (uc *GetContentUseCase) Execute(ctx context.Context, contentID int64, explain bool) (*entity.Content, error) {
+	content, err := uc.contentRepo.FindByID(ctx, contentID)
+	if err != nil {
+		if err == port.ErrContentNotFound {
+			return nil, err
+		}
+		return nil, fmt.Errorf("içerik getirme hatası: %w", err)
+	}
+	if !content.IsApproved() {
+		return nil, port.ErrContentNotFound
+	}
+
+	if explain {
+		content.Explanation = uc.scoringService.Explain(content)
+	}
+
+	return content, nil
+}
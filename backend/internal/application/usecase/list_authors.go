@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// ListAuthorsUseCase yazar/kanal listeleme use case'i
+type ListAuthorsUseCase struct {
+	authorRepo port.AuthorRepository
+}
+
+// ListAuthorsResult yazar listeleme sonucu yapısı
+type ListAuthorsResult struct {
+	Items      []*entity.Author `json:"items"`
+	Pagination Pagination       `json:"pagination"`
+}
+
+// NewListAuthorsUseCase yeni bir yazar listeleme use case oluşturur
+func NewListAuthorsUseCase(authorRepo port.AuthorRepository) *ListAuthorsUseCase {
+	return &ListAuthorsUseCase{
+		authorRepo: authorRepo,
+	}
+}
+
+// Execute yazarları içerik sayısına göre azalan sırada sayfalı getirir
+// providerID 0 ise tüm provider'ların yazarları, query boş değilse adında query geçenler döner
+func (uc *ListAuthorsUseCase) Execute(ctx context.Context, providerID int64, query string, page, pageSize int) (*ListAuthorsResult, error) {
+	if providerID < 0 {
+		return nil, apperrors.NewValidationError("provider_id", "provider_id must be a positive integer", providerID)
+	}
+
+	page, pageSize = normalizeHistoryPage(page, pageSize)
+
+	filter := port.AuthorFilter{ProviderID: providerID, Query: strings.TrimSpace(query)}
+	authors, total, err := uc.authorRepo.ListAuthors(ctx, filter, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("yazar listeleme hatası: %w", err)
+	}
+
+	if authors == nil {
+		authors = make([]*entity.Author, 0)
+	}
+
+	return &ListAuthorsResult{
+		Items:      authors,
+		Pagination: historyPagination(page, pageSize, total),
+	}, nil
+}
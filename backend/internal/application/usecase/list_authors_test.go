@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+type mockAuthorListRepository struct {
+	port.AuthorRepository
+	authors []*entity.Author
+	filter  port.AuthorFilter
+	limit   int
+	offset  int
+}
+
+func (m *mockAuthorListRepository) ListAuthors(ctx context.Context, filter port.AuthorFilter, limit, offset int) ([]*entity.Author, int64, error) {
+	m.filter, m.limit, m.offset = filter, limit, offset
+	return m.authors, int64(len(m.authors)), nil
+}
+
+func TestListAuthorsUseCase_Execute(t *testing.T) {
+	t.Run("passes filter and pagination to repository", func(t *testing.T) {
+		repo := &mockAuthorListRepository{authors: []*entity.Author{{ID: 1, Name: "Go Channel", ContentCount: 3}}}
+		useCase := NewListAuthorsUseCase(repo)
+
+		result, err := useCase.Execute(context.Background(), 2, "  go ", 3, 10)
+		require.NoError(t, err)
+		assert.Equal(t, port.AuthorFilter{ProviderID: 2, Query: "go"}, repo.filter)
+		assert.Equal(t, 10, repo.limit)
+		assert.Equal(t, 20, repo.offset)
+		assert.Len(t, result.Items, 1)
+		assert.Equal(t, int64(1), result.Pagination.TotalItems)
+	})
+
+	t.Run("no authors returns empty slice", func(t *testing.T) {
+		result, err := NewListAuthorsUseCase(&mockAuthorListRepository{}).Execute(context.Background(), 0, "", 0, 0)
+		require.NoError(t, err)
+		assert.NotNil(t, result.Items)
+		assert.Equal(t, 20, result.Pagination.PageSize)
+	})
+
+	t.Run("rejects negative provider id", func(t *testing.T) {
+		_, err := NewListAuthorsUseCase(&mockAuthorListRepository{}).Execute(context.Background(), -1, "", 1, 20)
+		var validationErr *apperrors.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
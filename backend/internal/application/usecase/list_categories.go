@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// ListCategoriesUseCase kategori ağacı listeleme use case'i
+type ListCategoriesUseCase struct {
+	categoryRepo port.CategoryRepository
+}
+
+// ListCategoriesResult kategori listeleme sonucu yapısı
+type ListCategoriesResult struct {
+	Items []*entity.Category `json:"items"`
+}
+
+// NewListCategoriesUseCase yeni bir kategori listeleme use case oluşturur
+func NewListCategoriesUseCase(categoryRepo port.CategoryRepository) *ListCategoriesUseCase {
+	return &ListCategoriesUseCase{
+		categoryRepo: categoryRepo,
+	}
+}
+
+// Execute tüm kategorileri path sırasıyla (her kategori alt kategorilerinden hemen önce) getirir
+func (uc *ListCategoriesUseCase) Execute(ctx context.Context) (*ListCategoriesResult, error) {
+	categories, err := uc.categoryRepo.ListCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("kategori listeleme hatası: %w", err)
+	}
+
+	if categories == nil {
+		categories = make([]*entity.Category, 0)
+	}
+
+	return &ListCategoriesResult{Items: categories}, nil
+}
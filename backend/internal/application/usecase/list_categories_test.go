@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+type mockCategoryListRepository struct {
+	port.CategoryRepository
+	categories []*entity.Category
+	err        error
+}
+
+func (m *mockCategoryListRepository) ListCategories(ctx context.Context) ([]*entity.Category, error) {
+	return m.categories, m.err
+}
+
+func TestListCategoriesUseCase_Execute(t *testing.T) {
+	t.Run("returns categories from repository", func(t *testing.T) {
+		repo := &mockCategoryListRepository{categories: []*entity.Category{
+			{ID: 1, Name: "Programming", Slug: "programming", Path: "programming", ContentCount: 3},
+			{ID: 2, Name: "Go", Slug: "go", Path: "programming/go", ContentCount: 2},
+		}}
+
+		result, err := NewListCategoriesUseCase(repo).Execute(context.Background())
+		require.NoError(t, err)
+		assert.Len(t, result.Items, 2)
+		assert.Equal(t, "programming/go", result.Items[1].Path)
+	})
+
+	t.Run("no categories returns empty slice", func(t *testing.T) {
+		result, err := NewListCategoriesUseCase(&mockCategoryListRepository{}).Execute(context.Background())
+		require.NoError(t, err)
+		assert.NotNil(t, result.Items)
+	})
+
+	t.Run("repository error is wrapped", func(t *testing.T) {
+		_, err := NewListCategoriesUseCase(&mockCategoryListRepository{err: errors.New("db down")}).Execute(context.Background())
+		assert.Error(t, err)
+	})
+}
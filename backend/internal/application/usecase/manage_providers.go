@@ -0,0 +1,342 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/logger"
+)
+
+// ManageProvidersUseCase provider yönetimi (admin CRUD) use case'i
+type ManageProvidersUseCase struct {
+	providerRepo port.ProviderRepository
+	cache        port.CacheRepository
+	reloader     ProviderClientReloader // nil ise değişiklikler restart'a kadar sync'e yansımaz
+}
+
+// ProviderClientReloader provider değişikliklerinden sonra client listesini yeniler
+type ProviderClientReloader interface {
+	ReloadProviderClients(ctx context.Context) error
+}
+
+// ProviderInput provider oluşturma/güncelleme isteği
+type ProviderInput struct {
+	Name     string                      `json:"name"`
+	URL      string                      `json:"url"`
+	Format   string                      `json:"format"`              // "json", "xml", "rss" veya "rest"
+	Mapping  *entity.ProviderMapping     `json:"mapping,omitempty"`   // Sadece "rest" formatında, zorunlu
+	Auth     *entity.ProviderAuth        `json:"auth,omitempty"`      // Verilmezse kimlik doğrulama yapılmaz
+	Retry    *entity.ProviderRetryPolicy `json:"retry,omitempty"`     // Verilmezse varsayılan retry politikası kullanılır
+	Language string                      `json:"language,omitempty"`  // Verilmezse dil her içerik için tespit edilir
+	IsActive *bool                       `json:"is_active,omitempty"` // Verilmezse true kabul edilir
+
+	// AutoApprove false ise provider'ın yeni içerikleri admin onaylayana kadar aramada görünmez
+	// Verilmezse true kabul edilir
+	AutoApprove *bool `json:"auto_approve,omitempty"`
+}
+
+// NewManageProvidersUseCase yeni bir provider yönetim use case oluşturur
+func NewManageProvidersUseCase(
+	providerRepo port.ProviderRepository,
+	cache port.CacheRepository,
+) *ManageProvidersUseCase {
+	return &ManageProvidersUseCase{
+		providerRepo: providerRepo,
+		cache:        cache,
+	}
+}
+
+// SetReloader provider değişikliklerinden sonra çağrılacak client reloader'ı ayarlar
+func (uc *ManageProvidersUseCase) SetReloader(reloader ProviderClientReloader) {
+	uc.reloader = reloader
+}
+
+// List aktif provider'ları getirir; pasife alınmış provider'lar listelenmez
+func (uc *ManageProvidersUseCase) List(ctx context.Context) ([]*entity.Provider, error) {
+	providers, err := uc.providerRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("provider listeleme hatası: %w", err)
+	}
+	if providers == nil {
+		providers = make([]*entity.Provider, 0)
+	}
+	return providers, nil
+}
+
+// Create yeni bir provider kaydeder
+func (uc *ManageProvidersUseCase) Create(ctx context.Context, input ProviderInput) (*entity.Provider, error) {
+	provider, err := uc.buildProvider(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.providerRepo.Create(ctx, provider); err != nil {
+		return nil, fmt.Errorf("provider oluşturma hatası: %w", err)
+	}
+
+	uc.reloadClients(ctx)
+
+	return provider, nil
+}
+
+// Update mevcut bir provider'ı günceller
+// Provider bulunamazsa port.ErrProviderNotFound döner
+func (uc *ManageProvidersUseCase) Update(ctx context.Context, id int64, input ProviderInput) (*entity.Provider, error) {
+	provider, err := uc.buildProvider(input)
+	if err != nil {
+		return nil, err
+	}
+	provider.ID = id
+
+	if err := uc.providerRepo.Update(ctx, provider); err != nil {
+		if err == port.ErrProviderNotFound {
+			return nil, err
+		}
+		return nil, fmt.Errorf("provider güncelleme hatası: %w", err)
+	}
+
+	// Pasife alınan provider'ın içerikleri arama sonuçlarında değişebilir
+	_ = invalidateContentCache(ctx, uc.cache)
+
+	uc.reloadClients(ctx)
+
+	return provider, nil
+}
+
+// Delete provider'ı ve içeriklerini siler
+// Provider bulunamazsa port.ErrProviderNotFound döner
+func (uc *ManageProvidersUseCase) Delete(ctx context.Context, id int64) error {
+	if err := uc.providerRepo.Delete(ctx, id); err != nil {
+		if err == port.ErrProviderNotFound {
+			return err
+		}
+		return fmt.Errorf("provider silme hatası: %w", err)
+	}
+
+	// Silinen içerikler cache'de kalmasın (hata kritik değil)
+	_ = invalidateContentCache(ctx, uc.cache)
+
+	uc.reloadClients(ctx)
+
+	return nil
+}
+
+// reloadClients sync client listesini yeniler
+// Hata kritik değil: kayıt başarılı, client listesi bir sonraki reload'da düzelir
+func (uc *ManageProvidersUseCase) reloadClients(ctx context.Context) {
+	if uc.reloader == nil {
+		return
+	}
+	if err := uc.reloader.ReloadProviderClients(ctx); err != nil {
+		logger.FromContext(ctx).Error("provider clients could not be reloaded", zap.Error(err))
+	}
+}
+
+// buildProvider input'u doğrular ve normalize edilmiş provider entity'sine çevirir
+func (uc *ManageProvidersUseCase) buildProvider(input ProviderInput) (*entity.Provider, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return nil, apperrors.NewValidationError("name", "name is required", input.Name)
+	}
+	if len(name) > 100 {
+		return nil, apperrors.NewValidationError("name", "name too long (max 100 characters)", input.Name)
+	}
+
+	rawURL := strings.TrimSpace(input.URL)
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, apperrors.NewValidationError("url", "url must be an absolute http(s) URL", input.URL)
+	}
+	if len(rawURL) > 500 {
+		return nil, apperrors.NewValidationError("url", "url too long (max 500 characters)", input.URL)
+	}
+
+	format := strings.ToLower(strings.TrimSpace(input.Format))
+	if format != "json" && format != "xml" && format != "rss" && format != "rest" {
+		return nil, apperrors.NewValidationError("format", "invalid format (must be 'json', 'xml', 'rss' or 'rest')", input.Format)
+	}
+
+	if format == "rest" {
+		if err := validateMapping(input.Mapping); err != nil {
+			return nil, err
+		}
+	} else if input.Mapping != nil {
+		return nil, apperrors.NewValidationError("mapping", "mapping is only supported for format 'rest'", input.Format)
+	}
+
+	if err := validateAuth(input.Auth); err != nil {
+		return nil, err
+	}
+
+	if err := validateRetryPolicy(input.Retry); err != nil {
+		return nil, err
+	}
+
+	language := strings.ToLower(strings.TrimSpace(input.Language))
+	if language != "" && !entity.IsSupportedLanguage(language) {
+		return nil, apperrors.NewValidationError("language",
+			"invalid language (must be one of: "+strings.Join(entity.SupportedLanguages, ", ")+")", input.Language)
+	}
+
+	isActive := true
+	if input.IsActive != nil {
+		isActive = *input.IsActive
+	}
+
+	autoApprove := true
+	if input.AutoApprove != nil {
+		autoApprove = *input.AutoApprove
+	}
+
+	return &entity.Provider{
+		Name:        name,
+		URL:         rawURL,
+		Format:      format,
+		Mapping:     input.Mapping,
+		Auth:        input.Auth,
+		Retry:       input.Retry,
+		Language:    language,
+		IsActive:    isActive,
+		AutoApprove: autoApprove,
+	}, nil
+}
+
+// validateMapping rest provider mapping'inin zorunlu alanlarını doğrular
+func validateMapping(mapping *entity.ProviderMapping) error {
+	if mapping == nil {
+		return apperrors.NewValidationError("mapping", "mapping is required for format 'rest'", nil)
+	}
+
+	required := []struct{ field, path string }{
+		{"mapping.id", mapping.ID},
+		{"mapping.title", mapping.Title},
+		{"mapping.published_at", mapping.PublishedAt},
+	}
+	for _, r := range required {
+		if strings.TrimSpace(r.path) == "" {
+			return apperrors.NewValidationError(r.field, r.field+" path is required", r.path)
+		}
+	}
+
+	// Yollar gjson veya "$" ile başlayan JSONPath ifadeleridir; çevrilemeyen JSONPath burada
+	// reddedilmezse senkronizasyonda sessizce boş değer döner
+	paths := []struct{ field, path string }{
+		{"mapping.items_path", mapping.ItemsPath},
+		{"mapping.id", mapping.ID},
+		{"mapping.title", mapping.Title},
+		{"mapping.description", mapping.Description},
+		{"mapping.type", mapping.Type},
+		{"mapping.published_at", mapping.PublishedAt},
+		{"mapping.views", mapping.Views},
+		{"mapping.likes", mapping.Likes},
+		{"mapping.reading_time", mapping.ReadingTime},
+		{"mapping.reactions", mapping.Reactions},
+		{"mapping.duration", mapping.Duration},
+		{"mapping.tags", mapping.Tags},
+		{"mapping.category", mapping.Category},
+		{"mapping.author_id", mapping.AuthorID},
+		{"mapping.author_name", mapping.AuthorName},
+		{"mapping.author_url", mapping.AuthorURL},
+		{"mapping.url", mapping.URL},
+		{"mapping.thumbnail_url", mapping.ThumbnailURL},
+	}
+	for _, p := range paths {
+		if p.path == "" {
+			continue
+		}
+		if _, err := entity.MappingPathToGJSON(p.path); err != nil {
+			return apperrors.NewValidationError(p.field, p.field+" must be a gjson path or a JSONPath expression starting with $: "+err.Error(), p.path)
+		}
+	}
+
+	if mapping.Type == "" && mapping.DefaultType == "" {
+		return apperrors.NewValidationError("mapping.type", "mapping.type or mapping.default_type is required", nil)
+	}
+	if _, ok := entity.ParseContentType(mapping.DefaultType); mapping.DefaultType != "" && !ok {
+		return apperrors.NewValidationError("mapping.default_type",
+			"invalid default type (must be one of: "+strings.Join(entity.ContentTypeNames(), ", ")+")", mapping.DefaultType)
+	}
+
+	return nil
+}
+
+// validateAuth provider auth ayarının türe göre zorunlu alanlarını doğrular
+// Secret'ın kendisi değil, onu tutan environment değişkeninin adı (secret_env) istenir
+func validateAuth(auth *entity.ProviderAuth) error {
+	if auth == nil {
+		return nil
+	}
+
+	if strings.TrimSpace(auth.SecretEnv) == "" {
+		return apperrors.NewValidationError("auth.secret_env", "auth.secret_env is required", auth.SecretEnv)
+	}
+	if !entity.IsProviderSecretEnv(auth.SecretEnv) {
+		return apperrors.NewValidationError("auth.secret_env",
+			"auth.secret_env must be an environment variable named "+entity.ProviderSecretEnvPrefix+"<NAME> (A-Z, 0-9, _)", auth.SecretEnv)
+	}
+
+	switch auth.Type {
+	case entity.ProviderAuthAPIKey, entity.ProviderAuthBearer:
+	case entity.ProviderAuthBasic:
+		if auth.Username == "" {
+			return apperrors.NewValidationError("auth.username", "auth.username is required for basic auth", nil)
+		}
+	case entity.ProviderAuthOAuth2:
+		if auth.ClientID == "" {
+			return apperrors.NewValidationError("auth.client_id", "auth.client_id is required for oauth2", nil)
+		}
+		parsed, err := url.Parse(auth.TokenURL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return apperrors.NewValidationError("auth.token_url", "auth.token_url must be an absolute http(s) URL", auth.TokenURL)
+		}
+	default:
+		return apperrors.NewValidationError("auth.type", "invalid auth type (must be 'api_key', 'basic', 'bearer' or 'oauth2')", auth.Type)
+	}
+
+	return nil
+}
+
+// Retry politikası sınırları
+const (
+	maxRetryAttempts   = 10
+	maxRetryIntervalMs = 5 * 60 * 1000 // 5 dakika
+)
+
+// validateRetryPolicy provider retry ayarının sınırlarını doğrular
+// Sıfır değerli alanlar için varsayılan kullanıldığından sadece negatif ve aşırı değerler reddedilir
+func validateRetryPolicy(policy *entity.ProviderRetryPolicy) error {
+	if policy == nil {
+		return nil
+	}
+
+	if policy.MaxAttempts < 0 || policy.MaxAttempts > maxRetryAttempts {
+		return apperrors.NewValidationError("retry.max_attempts", fmt.Sprintf("retry.max_attempts must be between 0 and %d", maxRetryAttempts), policy.MaxAttempts)
+	}
+
+	intervals := []struct {
+		field string
+		value int
+	}{
+		{"retry.initial_interval_ms", policy.InitialIntervalMs},
+		{"retry.max_interval_ms", policy.MaxIntervalMs},
+		{"retry.max_elapsed_ms", policy.MaxElapsedMs},
+	}
+	for _, i := range intervals {
+		if i.value < 0 || i.value > maxRetryIntervalMs {
+			return apperrors.NewValidationError(i.field, fmt.Sprintf("%s must be between 0 and %d", i.field, maxRetryIntervalMs), i.value)
+		}
+	}
+
+	if policy.InitialIntervalMs > 0 && policy.MaxIntervalMs > 0 && policy.InitialIntervalMs > policy.MaxIntervalMs {
+		return apperrors.NewValidationError("retry.initial_interval_ms", "retry.initial_interval_ms must not exceed retry.max_interval_ms", policy.InitialIntervalMs)
+	}
+
+	return nil
+}
@@ -0,0 +1,450 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// MockProviderRepository
+type mockProviderRepository struct {
+	port.ProviderRepository
+	providers map[int64]*entity.Provider
+	nextID    int64
+	syncLogs  []*entity.ProviderSyncLog
+	// UpdateFetchValidators ile kaydedilen son değerler
+	etag         string
+	lastModified string
+	syncErrors   map[int64][]*entity.ProviderSyncError
+	// SaveHealth paralel probe'lardan çağrılır
+	healthMu sync.Mutex
+	health   map[int64]*entity.ProviderHealth
+}
+
+func newMockProviderRepository() *mockProviderRepository {
+	return &mockProviderRepository{providers: make(map[int64]*entity.Provider)}
+}
+
+func (m *mockProviderRepository) Create(ctx context.Context, provider *entity.Provider) error {
+	m.nextID++
+	provider.ID = m.nextID
+	m.providers[provider.ID] = provider
+	return nil
+}
+
+func (m *mockProviderRepository) Update(ctx context.Context, provider *entity.Provider) error {
+	if _, ok := m.providers[provider.ID]; !ok {
+		return port.ErrProviderNotFound
+	}
+	m.providers[provider.ID] = provider
+	return nil
+}
+
+func (m *mockProviderRepository) Delete(ctx context.Context, id int64) error {
+	if _, ok := m.providers[id]; !ok {
+		return port.ErrProviderNotFound
+	}
+	delete(m.providers, id)
+	return nil
+}
+
+func (m *mockProviderRepository) FindAll(ctx context.Context) ([]*entity.Provider, error) {
+	var providers []*entity.Provider
+	for id := int64(1); id <= m.nextID; id++ {
+		if p, ok := m.providers[id]; ok && p.IsActive {
+			providers = append(providers, p)
+		}
+	}
+	return providers, nil
+}
+
+func (m *mockProviderRepository) CreateSyncLog(ctx context.Context, log *entity.ProviderSyncLog) error {
+	log.ID = int64(len(m.syncLogs) + 1)
+	copied := *log
+	m.syncLogs = append(m.syncLogs, &copied)
+	return nil
+}
+
+func (m *mockProviderRepository) UpdateSyncLog(ctx context.Context, log *entity.ProviderSyncLog) error {
+	copied := *log
+	m.syncLogs[log.ID-1] = &copied
+	return nil
+}
+
+func (m *mockProviderRepository) ListSyncLogs(ctx context.Context, providerID int64, limit, offset int) ([]*entity.ProviderSyncLog, int64, error) {
+	var filtered []*entity.ProviderSyncLog
+	for _, l := range m.syncLogs {
+		if providerID == 0 || l.ProviderID == providerID {
+			filtered = append(filtered, l)
+		}
+	}
+	total := int64(len(filtered))
+	if offset >= len(filtered) {
+		return nil, total, nil
+	}
+	end := offset + limit
+	if end > len(filtered) {
+		end = len(filtered)
+	}
+	return filtered[offset:end], total, nil
+}
+
+func (m *mockProviderRepository) UpdateFetchValidators(ctx context.Context, providerID int64, etag, lastModified string) error {
+	m.etag, m.lastModified = etag, lastModified
+	return nil
+}
+
+func (m *mockProviderRepository) ReplaceSyncErrors(ctx context.Context, providerID int64, syncErrors []*entity.ProviderSyncError) error {
+	if m.syncErrors == nil {
+		m.syncErrors = make(map[int64][]*entity.ProviderSyncError)
+	}
+	m.syncErrors[providerID] = syncErrors
+	return nil
+}
+
+func (m *mockProviderRepository) AddSyncError(ctx context.Context, providerID int64, syncError *entity.ProviderSyncError) error {
+	if m.syncErrors == nil {
+		m.syncErrors = make(map[int64][]*entity.ProviderSyncError)
+	}
+	m.syncErrors[providerID] = append(m.syncErrors[providerID], syncError)
+	return nil
+}
+
+func (m *mockProviderRepository) ListSyncErrors(ctx context.Context, providerID int64, limit, offset int) ([]*entity.ProviderSyncError, int64, error) {
+	var filtered []*entity.ProviderSyncError
+	for id, errs := range m.syncErrors {
+		if providerID == 0 || id == providerID {
+			filtered = append(filtered, errs...)
+		}
+	}
+	total := int64(len(filtered))
+	if offset >= len(filtered) {
+		return nil, total, nil
+	}
+	end := offset + limit
+	if end > len(filtered) {
+		end = len(filtered)
+	}
+	return filtered[offset:end], total, nil
+}
+
+func (m *mockProviderRepository) FindByID(ctx context.Context, id int64) (*entity.Provider, error) {
+	provider, ok := m.providers[id]
+	if !ok {
+		return nil, port.ErrProviderNotFound
+	}
+	return provider, nil
+}
+
+func (m *mockProviderRepository) SaveHealth(ctx context.Context, health *entity.ProviderHealth) error {
+	m.healthMu.Lock()
+	defer m.healthMu.Unlock()
+	if m.health == nil {
+		m.health = make(map[int64]*entity.ProviderHealth)
+	}
+	if previous, ok := m.health[health.ProviderID]; ok && health.Status == entity.ProviderHealthDown {
+		health.ConsecutiveFailures = previous.ConsecutiveFailures + 1
+	} else if health.Status == entity.ProviderHealthDown {
+		health.ConsecutiveFailures = 1
+	}
+	m.health[health.ProviderID] = health
+	return nil
+}
+
+func (m *mockProviderRepository) FindHealth(ctx context.Context, providerID int64) (*entity.ProviderHealth, error) {
+	m.healthMu.Lock()
+	defer m.healthMu.Unlock()
+	return m.health[providerID], nil
+}
+
+func (m *mockProviderRepository) ListHealth(ctx context.Context) ([]*entity.ProviderHealth, error) {
+	m.healthMu.Lock()
+	defer m.healthMu.Unlock()
+	var results []*entity.ProviderHealth
+	for _, health := range m.health {
+		results = append(results, health)
+	}
+	return results, nil
+}
+
+func (m *mockProviderRepository) ListSyncFreshness(ctx context.Context) ([]*entity.ProviderFreshness, error) {
+	var results []*entity.ProviderFreshness
+	for id := int64(1); id <= m.nextID; id++ {
+		provider, ok := m.providers[id]
+		if !ok || !provider.IsActive {
+			continue
+		}
+		freshness := &entity.ProviderFreshness{ProviderID: id, ProviderName: provider.Name}
+		// Loglar oluşturulma sırasıyla tutulur, sonuncusu en yenisidir
+		for _, l := range m.syncLogs {
+			if l.ProviderID != id {
+				continue
+			}
+			freshness.LastAttemptAt = &l.StartedAt
+			freshness.LastAttemptStatus = l.Status
+			if l.Status == SyncStatusSuccess {
+				freshness.LastSuccessAt = l.CompletedAt
+				freshness.LastSuccessItems = l.ItemsSynced
+			}
+		}
+		results = append(results, freshness)
+	}
+	return results, nil
+}
+
+// MockProviderClientReloader
+type mockProviderClientReloader struct {
+	calls int
+}
+
+func (m *mockProviderClientReloader) ReloadProviderClients(ctx context.Context) error {
+	m.calls++
+	return nil
+}
+
+func TestManageProvidersUseCase(t *testing.T) {
+	t.Run("create normalizes input and defaults to active", func(t *testing.T) {
+		repo := newMockProviderRepository()
+		useCase := NewManageProvidersUseCase(repo, &mockCacheRepository{})
+
+		provider, err := useCase.Create(context.Background(), ProviderInput{
+			Name:   "  Provider 3  ",
+			URL:    "http://mock-api:8081/provider3",
+			Format: "JSON",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), provider.ID)
+		assert.Equal(t, "Provider 3", provider.Name)
+		assert.Equal(t, "json", provider.Format)
+		assert.True(t, provider.IsActive)
+	})
+
+	t.Run("create rejects invalid input", func(t *testing.T) {
+		useCase := NewManageProvidersUseCase(newMockProviderRepository(), &mockCacheRepository{})
+
+		cases := map[string]ProviderInput{
+			"name":   {URL: "http://example.com", Format: "json"},
+			"url":    {Name: "P", URL: "not-a-url", Format: "json"},
+			"format": {Name: "P", URL: "http://example.com", Format: "csv"},
+		}
+		for field, input := range cases {
+			_, err := useCase.Create(context.Background(), input)
+			var validationErr *apperrors.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, field, validationErr.Field)
+		}
+	})
+
+	t.Run("rest format requires a valid mapping", func(t *testing.T) {
+		useCase := NewManageProvidersUseCase(newMockProviderRepository(), &mockCacheRepository{})
+		mapping := &entity.ProviderMapping{ID: "id", Title: "title", DefaultType: "article", PublishedAt: "date"}
+
+		provider, err := useCase.Create(context.Background(), ProviderInput{
+			Name: "REST", URL: "http://example.com/api", Format: "rest", Mapping: mapping,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, mapping, provider.Mapping)
+
+		cases := map[string]ProviderInput{
+			"mapping":              {Name: "P", URL: "http://example.com", Format: "rest"},
+			"mapping.published_at": {Name: "P", URL: "http://example.com", Format: "rest", Mapping: &entity.ProviderMapping{ID: "id", Title: "title", DefaultType: "video"}},
+			"mapping.type":         {Name: "P", URL: "http://example.com", Format: "rest", Mapping: &entity.ProviderMapping{ID: "id", Title: "title", PublishedAt: "date"}},
+			"mapping.default_type": {Name: "P", URL: "http://example.com", Format: "rest", Mapping: &entity.ProviderMapping{ID: "id", Title: "title", PublishedAt: "date", DefaultType: "hologram"}},
+		}
+		for field, input := range cases {
+			_, err := useCase.Create(context.Background(), input)
+			var validationErr *apperrors.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, field, validationErr.Field)
+		}
+
+		_, err = useCase.Create(context.Background(), ProviderInput{
+			Name: "P", URL: "http://example.com", Format: "json", Mapping: mapping,
+		})
+		var validationErr *apperrors.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "mapping", validationErr.Field)
+	})
+
+	t.Run("validates JSONPath mapping paths", func(t *testing.T) {
+		useCase := NewManageProvidersUseCase(newMockProviderRepository(), &mockCacheRepository{})
+		withPath := func(field, path string) *entity.ProviderMapping {
+			mapping := &entity.ProviderMapping{ID: "id", Title: "title", DefaultType: "article", PublishedAt: "date"}
+			switch field {
+			case "mapping.items_path":
+				mapping.ItemsPath = path
+			case "mapping.views":
+				mapping.Views = path
+			case "mapping.tags":
+				mapping.Tags = path
+			}
+			return mapping
+		}
+
+		valid := []struct{ field, path string }{
+			{"mapping.items_path", "$.data.items"},
+			{"mapping.views", "data.items.0.metrics.views"},
+			{"mapping.views", "$.metrics[?(@.type=='views')].value"},
+			{"mapping.views", `metrics.#(type=="views").value`},
+			{"mapping.tags", "$.tags[*].name"},
+			{"mapping.tags", "tags.#.name"},
+		}
+		for _, c := range valid {
+			_, err := useCase.Create(context.Background(), ProviderInput{
+				Name: "P", URL: "http://example.com", Format: "rest", Mapping: withPath(c.field, c.path),
+			})
+			require.NoError(t, err, c.path)
+		}
+
+		invalid := []struct{ field, path string }{
+			{"mapping.items_path", "$..items"},
+			{"mapping.views", "$.metrics[0:2].views"},
+			{"mapping.views", "$.metrics[?(@.type=~'v.*')].value"},
+			{"mapping.tags", "$.tags[*"},
+		}
+		for _, c := range invalid {
+			_, err := useCase.Create(context.Background(), ProviderInput{
+				Name: "P", URL: "http://example.com", Format: "rest", Mapping: withPath(c.field, c.path),
+			})
+			var validationErr *apperrors.ValidationError
+			require.ErrorAs(t, err, &validationErr, c.path)
+			assert.Equal(t, c.field, validationErr.Field, c.path)
+			assert.Contains(t, validationErr.Message, "JSONPath", c.path)
+		}
+	})
+
+	t.Run("validates auth settings", func(t *testing.T) {
+		useCase := NewManageProvidersUseCase(newMockProviderRepository(), &mockCacheRepository{})
+		input := func(auth *entity.ProviderAuth) ProviderInput {
+			return ProviderInput{Name: "P", URL: "http://example.com", Format: "json", Auth: auth}
+		}
+
+		provider, err := useCase.Create(context.Background(), input(&entity.ProviderAuth{Type: "bearer", SecretEnv: "PROVIDER_SECRET_TOKEN"}))
+		require.NoError(t, err)
+		assert.Equal(t, "PROVIDER_SECRET_TOKEN", provider.Auth.SecretEnv)
+
+		cases := map[string]*entity.ProviderAuth{
+			"auth.secret_env": {Type: "bearer"},
+			"auth.type":       {Type: "digest", SecretEnv: "PROVIDER_SECRET_X"},
+			"auth.username":   {Type: "basic", SecretEnv: "PROVIDER_SECRET_X"},
+			"auth.client_id":  {Type: "oauth2", SecretEnv: "PROVIDER_SECRET_X", TokenURL: "https://auth.example.com/token"},
+			"auth.token_url":  {Type: "oauth2", SecretEnv: "PROVIDER_SECRET_X", ClientID: "client"},
+		}
+		for field, auth := range cases {
+			_, err := useCase.Create(context.Background(), input(auth))
+			var validationErr *apperrors.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, field, validationErr.Field)
+		}
+
+		// Başka servis secret'ları provider'a gönderilemez
+		for _, env := range []string{"DATABASE_URL", "JWT_SECRET", "PROVIDER_SECRET_", "PROVIDER_SECRET_lower"} {
+			_, err := useCase.Create(context.Background(), input(&entity.ProviderAuth{Type: "bearer", SecretEnv: env}))
+			var validationErr *apperrors.ValidationError
+			require.ErrorAs(t, err, &validationErr, env)
+			assert.Equal(t, "auth.secret_env", validationErr.Field)
+		}
+	})
+
+	t.Run("validates retry policy", func(t *testing.T) {
+		useCase := NewManageProvidersUseCase(newMockProviderRepository(), &mockCacheRepository{})
+		input := func(retry *entity.ProviderRetryPolicy) ProviderInput {
+			return ProviderInput{Name: "P", URL: "http://example.com", Format: "json", Retry: retry}
+		}
+
+		provider, err := useCase.Create(context.Background(), input(&entity.ProviderRetryPolicy{MaxAttempts: 5, MaxElapsedMs: 60000}))
+		require.NoError(t, err)
+		assert.Equal(t, 5, provider.Retry.MaxAttempts)
+
+		cases := map[string]*entity.ProviderRetryPolicy{
+			"retry.max_attempts":        {MaxAttempts: 11},
+			"retry.max_interval_ms":     {MaxIntervalMs: -1},
+			"retry.max_elapsed_ms":      {MaxElapsedMs: 10 * 60 * 1000},
+			"retry.initial_interval_ms": {InitialIntervalMs: 5000, MaxIntervalMs: 1000},
+		}
+		for field, retry := range cases {
+			_, err := useCase.Create(context.Background(), input(retry))
+			var validationErr *apperrors.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, field, validationErr.Field)
+		}
+	})
+
+	t.Run("validates and normalizes language", func(t *testing.T) {
+		useCase := NewManageProvidersUseCase(newMockProviderRepository(), &mockCacheRepository{})
+
+		provider, err := useCase.Create(context.Background(), ProviderInput{Name: "P", URL: "http://example.com", Format: "json", Language: " Turkish "})
+		require.NoError(t, err)
+		assert.Equal(t, entity.LanguageTurkish, provider.Language)
+
+		_, err = useCase.Create(context.Background(), ProviderInput{Name: "P", URL: "http://example.com", Format: "json", Language: "klingon"})
+		var validationErr *apperrors.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "language", validationErr.Field)
+	})
+
+	t.Run("update clears cache and can deactivate", func(t *testing.T) {
+		repo := newMockProviderRepository()
+		cache := &mockCacheRepository{}
+		useCase := NewManageProvidersUseCase(repo, cache)
+
+		created, err := useCase.Create(context.Background(), ProviderInput{Name: "P", URL: "http://example.com", Format: "xml"})
+		require.NoError(t, err)
+
+		inactive := false
+		updated, err := useCase.Update(context.Background(), created.ID, ProviderInput{
+			Name: "P2", URL: "https://example.com/feed", Format: "xml", IsActive: &inactive,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, created.ID, updated.ID)
+		assert.False(t, repo.providers[created.ID].IsActive)
+		assert.True(t, cache.invalidated)
+	})
+
+	t.Run("update and delete unknown provider", func(t *testing.T) {
+		useCase := NewManageProvidersUseCase(newMockProviderRepository(), &mockCacheRepository{})
+
+		_, err := useCase.Update(context.Background(), 99, ProviderInput{Name: "P", URL: "http://example.com", Format: "json"})
+		assert.ErrorIs(t, err, port.ErrProviderNotFound)
+
+		err = useCase.Delete(context.Background(), 99)
+		assert.ErrorIs(t, err, port.ErrProviderNotFound)
+	})
+
+	t.Run("delete clears cache", func(t *testing.T) {
+		repo := newMockProviderRepository()
+		cache := &mockCacheRepository{}
+		useCase := NewManageProvidersUseCase(repo, cache)
+
+		created, err := useCase.Create(context.Background(), ProviderInput{Name: "P", URL: "http://example.com", Format: "json"})
+		require.NoError(t, err)
+
+		require.NoError(t, useCase.Delete(context.Background(), created.ID))
+		assert.Empty(t, repo.providers)
+		assert.True(t, cache.invalidated)
+	})
+	t.Run("reloads provider clients after each change", func(t *testing.T) {
+		reloader := &mockProviderClientReloader{}
+		useCase := NewManageProvidersUseCase(newMockProviderRepository(), &mockCacheRepository{})
+		useCase.SetReloader(reloader)
+
+		created, err := useCase.Create(context.Background(), ProviderInput{Name: "P", URL: "http://example.com", Format: "json"})
+		require.NoError(t, err)
+		_, err = useCase.Update(context.Background(), created.ID, ProviderInput{Name: "P", URL: "http://example.com", Format: "xml"})
+		require.NoError(t, err)
+		require.NoError(t, useCase.Delete(context.Background(), created.ID))
+
+		// Başarısız değişiklik reload tetiklememeli
+		_, err = useCase.Create(context.Background(), ProviderInput{Name: "P"})
+		require.Error(t, err)
+
+		assert.Equal(t, 3, reloader.calls)
+	})
+}
@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// Sabitleme sınırları
+const (
+	maxPromotionQueryLen   = 200
+	maxPromotionContentIDs = 10
+)
+
+// ManagePromotionsUseCase arama sorgusu sabitlemeleri yönetimi (admin) use case'i
+type ManagePromotionsUseCase struct {
+	promotionRepo port.PromotionRepository
+	contentRepo   port.ContentRepository
+	cache         port.CacheRepository
+}
+
+// PromotionInput sabitleme oluşturma/güncelleme isteği
+type PromotionInput struct {
+	Query      string  `json:"query"`
+	ContentIDs []int64 `json:"content_ids"`
+}
+
+// NewManagePromotionsUseCase yeni bir sabitleme yönetim use case oluşturur
+func NewManagePromotionsUseCase(
+	promotionRepo port.PromotionRepository,
+	contentRepo port.ContentRepository,
+	cache port.CacheRepository,
+) *ManagePromotionsUseCase {
+	return &ManagePromotionsUseCase{
+		promotionRepo: promotionRepo,
+		contentRepo:   contentRepo,
+		cache:         cache,
+	}
+}
+
+// List tüm sabitlemeleri döner
+func (uc *ManagePromotionsUseCase) List(ctx context.Context) ([]*entity.Promotion, error) {
+	promotions, err := uc.promotionRepo.ListPromotions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("sabitlemeler okunamadı: %w", err)
+	}
+	return promotions, nil
+}
+
+// Save sorgu için sabitlemeyi oluşturur veya içerik listesini değiştirir
+// Sabitlenen içerikler mevcut ve silinmemiş olmalıdır
+func (uc *ManagePromotionsUseCase) Save(ctx context.Context, input PromotionInput) (*entity.Promotion, error) {
+	query := normalizePromotionQuery(input.Query)
+	if query == "" || len(query) > maxPromotionQueryLen {
+		return nil, apperrors.NewValidationError("query", fmt.Sprintf("query must be between 1 and %d characters", maxPromotionQueryLen), input.Query)
+	}
+	if len(input.ContentIDs) == 0 || len(input.ContentIDs) > maxPromotionContentIDs {
+		return nil, apperrors.NewValidationError("content_ids", fmt.Sprintf("content_ids must contain between 1 and %d ids", maxPromotionContentIDs), len(input.ContentIDs))
+	}
+
+	seen := make(map[int64]bool, len(input.ContentIDs))
+	for i, id := range input.ContentIDs {
+		field := fmt.Sprintf("content_ids[%d]", i)
+		if id < 1 {
+			return nil, apperrors.NewValidationError(field, "content id must be a positive integer", id)
+		}
+		if seen[id] {
+			return nil, apperrors.NewValidationError(field, "duplicate content id", id)
+		}
+		seen[id] = true
+
+		if _, err := uc.contentRepo.FindByID(ctx, id); err != nil {
+			if err == port.ErrContentNotFound {
+				return nil, apperrors.NewValidationError(field, "content not found", id)
+			}
+			return nil, fmt.Errorf("içerik kontrol hatası: %w", err)
+		}
+	}
+
+	promotion := &entity.Promotion{Query: query, ContentIDs: input.ContentIDs}
+	if err := uc.promotionRepo.SavePromotion(ctx, promotion); err != nil {
+		return nil, fmt.Errorf("sabitleme kaydedilemedi: %w", err)
+	}
+
+	// Cache'deki arama sonuçlarında eski sabitleme kalmasın (hata kritik değil)
+	_ = invalidateContentCache(ctx, uc.cache)
+
+	return promotion, nil
+}
+
+// Delete sabitlemeyi siler
+// Sabitleme bulunamazsa port.ErrPromotionNotFound döner
+func (uc *ManagePromotionsUseCase) Delete(ctx context.Context, id int64) error {
+	if err := uc.promotionRepo.DeletePromotion(ctx, id); err != nil {
+		if err == port.ErrPromotionNotFound {
+			return err
+		}
+		return fmt.Errorf("sabitleme silinemedi: %w", err)
+	}
+
+	_ = invalidateContentCache(ctx, uc.cache)
+
+	return nil
+}
+
+// normalizePromotionQuery sorguyu sabitleme eşleşmesi için normalize eder (küçük harf, tek boşluk)
+func normalizePromotionQuery(query string) string {
+	return strings.Join(strings.Fields(strings.ToLower(query)), " ")
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// mockPromotionStore sabitlemeleri bellekte tutar
+type mockPromotionStore struct {
+	port.PromotionRepository
+	saved   *entity.Promotion
+	deleted []int64
+}
+
+func (m *mockPromotionStore) SavePromotion(ctx context.Context, promotion *entity.Promotion) error {
+	promotion.ID = 1
+	m.saved = promotion
+	return nil
+}
+
+func (m *mockPromotionStore) DeletePromotion(ctx context.Context, id int64) error {
+	if id != 1 {
+		return port.ErrPromotionNotFound
+	}
+	m.deleted = append(m.deleted, id)
+	return nil
+}
+
+func TestManagePromotionsUseCase(t *testing.T) {
+	contentRepo := &mockSearchRepository{
+		findByID: func(ctx context.Context, id int64) (*entity.Content, error) {
+			if id > 100 {
+				return nil, port.ErrContentNotFound
+			}
+			return &entity.Content{ID: id}, nil
+		},
+	}
+
+	t.Run("saves normalized query and clears cache", func(t *testing.T) {
+		store := &mockPromotionStore{}
+		cache := &mockCacheRepository{}
+		useCase := NewManagePromotionsUseCase(store, contentRepo, cache)
+
+		promotion, err := useCase.Save(context.Background(), PromotionInput{Query: "  Black   FRIDAY ", ContentIDs: []int64{42, 7}})
+		require.NoError(t, err)
+		assert.Equal(t, "black friday", promotion.Query)
+		assert.Equal(t, []int64{42, 7}, store.saved.ContentIDs)
+		assert.True(t, cache.invalidated)
+	})
+
+	t.Run("rejects invalid promotions", func(t *testing.T) {
+		useCase := NewManagePromotionsUseCase(&mockPromotionStore{}, contentRepo, &mockCacheRepository{})
+
+		tests := []struct {
+			name  string
+			input PromotionInput
+			field string
+		}{
+			{"empty query", PromotionInput{Query: "  ", ContentIDs: []int64{1}}, "query"},
+			{"no contents", PromotionInput{Query: "go"}, "content_ids"},
+			{"too many contents", PromotionInput{Query: "go", ContentIDs: []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}}, "content_ids"},
+			{"duplicate content", PromotionInput{Query: "go", ContentIDs: []int64{1, 1}}, "content_ids[1]"},
+			{"missing content", PromotionInput{Query: "go", ContentIDs: []int64{1, 500}}, "content_ids[1]"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := useCase.Save(context.Background(), tt.input)
+				var validationErr *apperrors.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, tt.field, validationErr.Field)
+			})
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		useCase := NewManagePromotionsUseCase(&mockPromotionStore{}, contentRepo, &mockCacheRepository{})
+		assert.NoError(t, useCase.Delete(context.Background(), 1))
+		assert.ErrorIs(t, useCase.Delete(context.Background(), 2), port.ErrPromotionNotFound)
+	})
+}
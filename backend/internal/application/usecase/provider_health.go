@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/logger"
+)
+
+// defaultHealthCheckTimeout tek bir provider probe'unun varsayılan süre sınırı
+const defaultHealthCheckTimeout = 10 * time.Second
+
+// defaultProviderStaleAfter son başarılı senkronizasyonu bu süreden eski provider'lar degraded sayılır
+const defaultProviderStaleAfter = 3 * time.Hour
+
+// ProviderClientSource güncel provider client listesini sağlar
+type ProviderClientSource interface {
+	ProviderClients() []port.ProviderClient
+}
+
+// ProviderHealthUseCase provider sağlık kontrolü use case'i
+// Aktif provider'lar periyodik olarak probe edilir, sonuçlar repository'de saklanır
+type ProviderHealthUseCase struct {
+	clients      ProviderClientSource
+	providerRepo port.ProviderRepository
+	timeout      time.Duration
+	staleAfter   time.Duration
+}
+
+// NewProviderHealthUseCase yeni bir provider sağlık kontrolü use case oluşturur
+func NewProviderHealthUseCase(clients ProviderClientSource, providerRepo port.ProviderRepository) *ProviderHealthUseCase {
+	return &ProviderHealthUseCase{
+		clients:      clients,
+		providerRepo: providerRepo,
+		timeout:      defaultHealthCheckTimeout,
+		staleAfter:   defaultProviderStaleAfter,
+	}
+}
+
+// SetTimeout tek bir provider probe'unun süre sınırını ayarlar (0 veya negatifse varsayılan kullanılır)
+func (uc *ProviderHealthUseCase) SetTimeout(timeout time.Duration) {
+	if timeout <= 0 {
+		timeout = defaultHealthCheckTimeout
+	}
+	uc.timeout = timeout
+}
+
+// SetStaleAfter provider verisinin degraded sayılacağı yaşı ayarlar (0 veya negatifse varsayılan kullanılır)
+func (uc *ProviderHealthUseCase) SetStaleAfter(staleAfter time.Duration) {
+	if staleAfter <= 0 {
+		staleAfter = defaultProviderStaleAfter
+	}
+	uc.staleAfter = staleAfter
+}
+
+// CheckAll probe destekleyen tüm aktif provider'ları paralel olarak kontrol eder ve sonuçları kaydeder
+func (uc *ProviderHealthUseCase) CheckAll(ctx context.Context) []*entity.ProviderHealth {
+	var checkers []port.ProviderClient
+	for _, client := range uc.clients.ProviderClients() {
+		if _, ok := client.(port.HealthChecker); ok {
+			checkers = append(checkers, client)
+		}
+	}
+
+	results := make([]*entity.ProviderHealth, len(checkers))
+	var wg sync.WaitGroup
+	for i, client := range checkers {
+		wg.Add(1)
+		go func(i int, client port.ProviderClient) {
+			defer wg.Done()
+			results[i] = uc.check(ctx, client)
+		}(i, client)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// Check tek bir aktif provider'ı hemen kontrol eder ve sonucu kaydeder
+// Provider aktif client'lar arasında yoksa veya probe desteklemiyorsa port.ErrProviderNotFound döner
+func (uc *ProviderHealthUseCase) Check(ctx context.Context, providerID int64) (*entity.ProviderHealth, error) {
+	for _, client := range uc.clients.ProviderClients() {
+		if client.GetProviderInfo().ID != providerID {
+			continue
+		}
+		if _, ok := client.(port.HealthChecker); !ok {
+			break
+		}
+		return uc.check(ctx, client), nil
+	}
+	return nil, port.ErrProviderNotFound
+}
+
+// Get provider'ın son sağlık kontrolü sonucunu getirir
+// Henüz kontrol edilmemiş provider'lar "unknown" durumuyla döner
+func (uc *ProviderHealthUseCase) Get(ctx context.Context, providerID int64) (*entity.ProviderHealth, error) {
+	provider, err := uc.providerRepo.FindByID(ctx, providerID)
+	if err != nil {
+		return nil, err
+	}
+
+	health, err := uc.providerRepo.FindHealth(ctx, providerID)
+	if err != nil {
+		return nil, fmt.Errorf("provider sağlık durumu hatası: %w", err)
+	}
+	if health == nil {
+		health = &entity.ProviderHealth{ProviderID: provider.ID, Status: entity.ProviderHealthUnknown}
+	}
+	health.ProviderName = provider.Name
+	return health, nil
+}
+
+// List aktif provider'ların son sağlık kontrolü sonuçlarını getirir
+func (uc *ProviderHealthUseCase) List(ctx context.Context) ([]*entity.ProviderHealth, error) {
+	results, err := uc.providerRepo.ListHealth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("provider sağlık durumu hatası: %w", err)
+	}
+	if results == nil {
+		results = make([]*entity.ProviderHealth, 0)
+	}
+	return results, nil
+}
+
+// Freshness aktif provider'ların son başarılı senkronizasyon zamanını ve içerik sayısını getirir
+// Son başarılı senkronizasyonu eşikten eski olan veya hiç başarılı senkronize edilmemiş provider'lar degraded işaretlenir
+func (uc *ProviderHealthUseCase) Freshness(ctx context.Context) ([]*entity.ProviderFreshness, error) {
+	results, err := uc.providerRepo.ListSyncFreshness(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("provider güncellik durumu hatası: %w", err)
+	}
+
+	now := time.Now()
+	for _, freshness := range results {
+		freshness.Status = entity.ProviderFreshnessDegraded
+		if freshness.LastSuccessAt == nil {
+			continue
+		}
+		age := now.Sub(*freshness.LastSuccessAt)
+		freshness.AgeSeconds = int64(age.Seconds())
+		if age <= uc.staleAfter {
+			freshness.Status = entity.ProviderFreshnessFresh
+		}
+	}
+
+	if results == nil {
+		results = make([]*entity.ProviderFreshness, 0)
+	}
+	return results, nil
+}
+
+// check provider'ı probe eder ve sonucu kaydeder
+// Kayıt hatası kritik değil: sonuç yine de döner
+// ctx iptal edildiyse sonuç kaydedilmez
+func (uc *ProviderHealthUseCase) check(ctx context.Context, client port.ProviderClient) *entity.ProviderHealth {
+	provider := client.GetProviderInfo()
+
+	probeCtx, cancel := context.WithTimeout(ctx, uc.timeout)
+	defer cancel()
+
+	start := time.Now()
+	statusCode, err := client.(port.HealthChecker).CheckHealth(probeCtx)
+	checkedAt := time.Now()
+
+	health := &entity.ProviderHealth{
+		ProviderID:   provider.ID,
+		ProviderName: provider.Name,
+		Status:       entity.ProviderHealthUp,
+		StatusCode:   statusCode,
+		LatencyMs:    checkedAt.Sub(start).Milliseconds(),
+		CheckedAt:    &checkedAt,
+	}
+	if err != nil {
+		// Shutdown'da iptal edilen probe provider'ın durumu hakkında bilgi vermez
+		if ctx.Err() != nil {
+			health.Status = entity.ProviderHealthUnknown
+			health.Error = err.Error()
+			return health
+		}
+		health.Status = entity.ProviderHealthDown
+		health.Error = err.Error()
+		logger.FromContext(ctx).Warn("provider health check failed",
+			zap.String("provider", provider.Name),
+			zap.Error(err),
+		)
+	}
+
+	if err := uc.providerRepo.SaveHealth(ctx, health); err != nil {
+		logger.FromContext(ctx).Error("provider health could not be saved",
+			zap.String("provider", provider.Name),
+			zap.Error(err),
+		)
+	}
+	return health
+}
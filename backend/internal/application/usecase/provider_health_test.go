@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// mockHealthCheckClient probe destekleyen bir provider client'ı taklit eder
+type mockHealthCheckClient struct {
+	mockProviderClient
+	provider   *entity.Provider
+	statusCode int
+	probeErr   error
+	probeDelay time.Duration
+}
+
+func (m *mockHealthCheckClient) GetProviderInfo() *entity.Provider {
+	return m.provider
+}
+
+func (m *mockHealthCheckClient) CheckHealth(ctx context.Context) (int, error) {
+	select {
+	case <-time.After(m.probeDelay):
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+	return m.statusCode, m.probeErr
+}
+
+// staticClientSource sabit bir client listesi döner
+type staticClientSource []port.ProviderClient
+
+func (s staticClientSource) ProviderClients() []port.ProviderClient {
+	return s
+}
+
+func TestProviderHealthUseCase_CheckAll(t *testing.T) {
+	repo := newMockProviderRepository()
+	clients := staticClientSource{
+		&mockHealthCheckClient{provider: &entity.Provider{ID: 1, Name: "Up"}, statusCode: 200},
+		&mockHealthCheckClient{provider: &entity.Provider{ID: 2, Name: "Down"}, statusCode: 503, probeErr: errors.New("provider hata döndü: 503")},
+		&mockHealthCheckClient{provider: &entity.Provider{ID: 3, Name: "Slow"}, probeDelay: time.Second},
+		&mockProviderClient{}, // Probe desteklemeyen client atlanır
+	}
+	useCase := NewProviderHealthUseCase(clients, repo)
+	useCase.SetTimeout(50 * time.Millisecond)
+
+	results := useCase.CheckAll(context.Background())
+	require.Len(t, results, 3)
+
+	assert.Equal(t, entity.ProviderHealthUp, results[0].Status)
+	assert.Equal(t, 200, results[0].StatusCode)
+	assert.NotNil(t, results[0].CheckedAt)
+
+	assert.Equal(t, entity.ProviderHealthDown, results[1].Status)
+	assert.Equal(t, 503, results[1].StatusCode)
+	assert.Contains(t, results[1].Error, "503")
+
+	assert.Equal(t, entity.ProviderHealthDown, results[2].Status)
+	assert.Contains(t, results[2].Error, context.DeadlineExceeded.Error())
+
+	// Ardışık hatalar sayılır
+	useCase.CheckAll(context.Background())
+	assert.Equal(t, int32(2), repo.health[2].ConsecutiveFailures)
+	assert.Equal(t, int32(0), repo.health[1].ConsecutiveFailures)
+
+	listed, err := useCase.List(context.Background())
+	require.NoError(t, err)
+	sort.Slice(listed, func(i, j int) bool { return listed[i].ProviderID < listed[j].ProviderID })
+	require.Len(t, listed, 3)
+	assert.Equal(t, "Down", listed[1].ProviderName)
+}
+
+func TestProviderHealthUseCase_Get(t *testing.T) {
+	repo := newMockProviderRepository()
+	provider := &entity.Provider{Name: "JSON", Format: "json", IsActive: true}
+	require.NoError(t, repo.Create(context.Background(), provider))
+
+	client := &mockHealthCheckClient{provider: provider, statusCode: 200}
+	useCase := NewProviderHealthUseCase(staticClientSource{client}, repo)
+
+	t.Run("unknown before first check", func(t *testing.T) {
+		health, err := useCase.Get(context.Background(), provider.ID)
+		require.NoError(t, err)
+		assert.Equal(t, entity.ProviderHealthUnknown, health.Status)
+		assert.Equal(t, "JSON", health.ProviderName)
+		assert.Nil(t, health.CheckedAt)
+	})
+
+	t.Run("returns last stored result", func(t *testing.T) {
+		_, err := useCase.Check(context.Background(), provider.ID)
+		require.NoError(t, err)
+
+		health, err := useCase.Get(context.Background(), provider.ID)
+		require.NoError(t, err)
+		assert.Equal(t, entity.ProviderHealthUp, health.Status)
+	})
+
+	t.Run("unknown provider", func(t *testing.T) {
+		_, err := useCase.Get(context.Background(), 99)
+		assert.ErrorIs(t, err, port.ErrProviderNotFound)
+
+		_, err = useCase.Check(context.Background(), 99)
+		assert.ErrorIs(t, err, port.ErrProviderNotFound)
+	})
+}
+
+func TestProviderHealthUseCase_Freshness(t *testing.T) {
+	repo := newMockProviderRepository()
+	fresh := &entity.Provider{Name: "Fresh", Format: "json", IsActive: true}
+	stale := &entity.Provider{Name: "Stale", Format: "xml", IsActive: true}
+	never := &entity.Provider{Name: "Never", Format: "json", IsActive: true}
+	for _, p := range []*entity.Provider{fresh, stale, never} {
+		require.NoError(t, repo.Create(context.Background(), p))
+	}
+
+	now := time.Now()
+	addLog := func(providerID int64, startedAt time.Time, status string, items int32) {
+		completedAt := startedAt.Add(time.Minute)
+		require.NoError(t, repo.CreateSyncLog(context.Background(), &entity.ProviderSyncLog{
+			ProviderID: providerID, StartedAt: startedAt, CompletedAt: &completedAt, Status: status, ItemsSynced: items,
+		}))
+	}
+	addLog(fresh.ID, now.Add(-30*time.Minute), SyncStatusSuccess, 120)
+	addLog(stale.ID, now.Add(-5*time.Hour), SyncStatusSuccess, 40)
+	addLog(stale.ID, now.Add(-10*time.Minute), SyncStatusFailed, 0)
+	addLog(never.ID, now.Add(-10*time.Minute), SyncStatusFailed, 0)
+
+	useCase := NewProviderHealthUseCase(staticClientSource{}, repo)
+	useCase.SetStaleAfter(2 * time.Hour)
+
+	results, err := useCase.Freshness(context.Background())
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	assert.Equal(t, entity.ProviderFreshnessFresh, results[0].Status)
+	assert.Equal(t, int32(120), results[0].LastSuccessItems)
+	assert.InDelta(t, 29*60, results[0].AgeSeconds, 5)
+
+	// Son deneme başarısız olsa da son başarılı senkronizasyon bilgisi korunur
+	assert.Equal(t, entity.ProviderFreshnessDegraded, results[1].Status)
+	assert.Equal(t, int32(40), results[1].LastSuccessItems)
+	assert.Equal(t, SyncStatusFailed, results[1].LastAttemptStatus)
+
+	assert.Equal(t, entity.ProviderFreshnessDegraded, results[2].Status)
+	assert.Nil(t, results[2].LastSuccessAt)
+	assert.NotNil(t, results[2].LastAttemptAt)
+}
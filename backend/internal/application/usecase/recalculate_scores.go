@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/domain/service"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/logger"
+)
+
+// RecalculateScoresUseCase tüm içeriklerin skorlarını yeniden hesaplama use case'i
+// Güncellik skoru zamana bağlı olduğundan senkronizasyonda güncellenmeyen içeriklerin skorları eskir;
+// bu use case periyodik olarak tüm silinmemiş içerikleri güncel kurallarla yeniden skorlar
+type RecalculateScoresUseCase struct {
+	contentRepo    port.ContentRepository
+	scoringService service.ScoringService
+	cache          port.CacheRepository
+	transactor     port.Transactor // nil ise batch'ler transaction'sız yazılır ve audit kaydında actor boş kalır
+	batchSize      int
+
+	running atomic.Bool // Elle başlatılmış (ExecuteAsync) bir hesaplama sürüyorsa true
+}
+
+// NewRecalculateScoresUseCase yeni bir skor yeniden hesaplama use case oluşturur
+func NewRecalculateScoresUseCase(
+	contentRepo port.ContentRepository,
+	scoringService service.ScoringService,
+	cache port.CacheRepository,
+) *RecalculateScoresUseCase {
+	return &RecalculateScoresUseCase{
+		contentRepo:    contentRepo,
+		scoringService: scoringService,
+		cache:          cache,
+		batchSize:      syncBatchSize,
+	}
+}
+
+// SetBatchSize tek seferde okunup yazılan içerik sayısını ayarlar (0 veya negatifse varsayılan kullanılır)
+func (uc *RecalculateScoresUseCase) SetBatchSize(batchSize int) {
+	if batchSize <= 0 {
+		batchSize = syncBatchSize
+	}
+	uc.batchSize = batchSize
+}
+
+// SetTransactor her batch'in skorlarını transaction içinde yazacak transactor'ı ayarlar
+func (uc *RecalculateScoresUseCase) SetTransactor(transactor port.Transactor) {
+	uc.transactor = transactor
+}
+
+// Execute silinmemiş tüm içeriklerin skorlarını batch'ler halinde yeniden hesaplayıp yazar
+// Güncellenen skor sayısını döner; ctx iptal edilirse o ana kadar yazılan batch'ler kalır
+func (uc *RecalculateScoresUseCase) Execute(ctx context.Context) (int, error) {
+	start := time.Now()
+	updated := 0
+	var afterID int64
+
+	ctx = port.WithAuditInfo(ctx, port.AuditInfo{Actor: port.AuditActorScoring})
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return updated, err
+		}
+
+		contents, err := uc.contentRepo.FindContentsForScoring(ctx, afterID, uc.batchSize)
+		if err != nil {
+			return updated, fmt.Errorf("skorlanacak içerikler okunamadı: %w", err)
+		}
+		if len(contents) == 0 {
+			break
+		}
+
+		scores := make([]*entity.ContentScore, 0, len(contents))
+		for _, content := range contents {
+			score, err := uc.scoringService.CalculateScore(content)
+			if err != nil {
+				return updated, fmt.Errorf("skor hesaplama hatası (Content ID: %d): %w", content.ID, err)
+			}
+			if score != nil {
+				score.ContentID = content.ID
+				scores = append(scores, score)
+			}
+		}
+
+		if err := uc.writeScores(ctx, scores); err != nil {
+			return updated, fmt.Errorf("bulk skor hatası: %w", err)
+		}
+		updated += len(scores)
+		afterID = contents[len(contents)-1].ID
+
+		if len(contents) < uc.batchSize {
+			break
+		}
+	}
+
+	// Sıralama değişmiş olabilir, cache'deki arama sonuçları geçersiz (hata kritik değil)
+	if updated > 0 {
+		_ = invalidateContentCache(ctx, uc.cache)
+	}
+
+	logger.FromContext(ctx).Info("scores recalculated",
+		zap.Int("count", updated),
+		zap.Duration("duration", time.Since(start)),
+	)
+	return updated, nil
+}
+
+// ExecuteAsync yeniden hesaplamayı arka planda başlatır
+// Önceki elle başlatılmış hesaplama henüz bitmediyse yenisi başlatılmaz ve false döner
+func (uc *RecalculateScoresUseCase) ExecuteAsync() bool {
+	if !uc.running.CompareAndSwap(false, true) {
+		return false
+	}
+
+	go func() {
+		defer uc.running.Store(false)
+		if _, err := uc.Execute(context.Background()); err != nil {
+			logger.Error("score recalculation failed", zap.Error(err))
+		}
+	}()
+	return true
+}
+
+// writeScores transactor ayarlıysa skorları transaction içinde yazar
+func (uc *RecalculateScoresUseCase) writeScores(ctx context.Context, scores []*entity.ContentScore) error {
+	if uc.transactor == nil {
+		return uc.contentRepo.BulkCreateOrUpdateScores(ctx, scores)
+	}
+	return uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		return uc.contentRepo.BulkCreateOrUpdateScores(ctx, scores)
+	})
+}
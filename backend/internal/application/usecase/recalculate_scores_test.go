@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/domain/service"
+)
+
+// mockScoringContentRepository skorlanacak içerikleri ID sırasıyla sayfalar, yazılan skorları kaydeder
+type mockScoringContentRepository struct {
+	port.ContentRepository
+	contents []*entity.Content
+	scores   []*entity.ContentScore
+	pages    int
+	saveErr  error
+}
+
+func (m *mockScoringContentRepository) FindContentsForScoring(ctx context.Context, afterID int64, limit int) ([]*entity.Content, error) {
+	m.pages++
+	var page []*entity.Content
+	for _, c := range m.contents {
+		if c.ID > afterID && len(page) < limit {
+			page = append(page, c)
+		}
+	}
+	return page, nil
+}
+
+func (m *mockScoringContentRepository) BulkCreateOrUpdateScores(ctx context.Context, scores []*entity.ContentScore) error {
+	if m.saveErr != nil {
+		return m.saveErr
+	}
+	m.scores = append(m.scores, scores...)
+	return nil
+}
+
+func TestRecalculateScoresUseCase_Execute(t *testing.T) {
+	newContents := func() []*entity.Content {
+		var contents []*entity.Content
+		for i := int64(1); i <= 5; i++ {
+			contents = append(contents, &entity.Content{
+				ID:          i,
+				ContentType: entity.ContentTypeVideo,
+				PublishedAt: time.Now().Add(-60 * 24 * time.Hour),
+				Stats:       &entity.ContentStats{ContentID: i, Views: 1000, Likes: 10},
+			})
+		}
+		return contents
+	}
+
+	t.Run("rescores all contents in batches", func(t *testing.T) {
+		repo := &mockScoringContentRepository{contents: newContents()}
+		cache := &mockCacheRepository{}
+		useCase := NewRecalculateScoresUseCase(repo, service.NewScoringService(service.DefaultScoringRules()), cache)
+		useCase.SetBatchSize(2)
+
+		updated, err := useCase.Execute(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 5, updated)
+		assert.Equal(t, 3, repo.pages)
+		require.Len(t, repo.scores, 5)
+		assert.Equal(t, int64(5), repo.scores[4].ContentID)
+		assert.Equal(t, 1.0, repo.scores[0].RecencyScore, "60 days old content should get the 90-day tier")
+		assert.True(t, cache.invalidated)
+	})
+
+	t.Run("nothing to rescore", func(t *testing.T) {
+		repo := &mockScoringContentRepository{}
+		cache := &mockCacheRepository{}
+		useCase := NewRecalculateScoresUseCase(repo, service.NewScoringService(service.DefaultScoringRules()), cache)
+
+		updated, err := useCase.Execute(context.Background())
+		require.NoError(t, err)
+		assert.Zero(t, updated)
+		assert.False(t, cache.invalidated)
+	})
+
+	t.Run("stops on write error", func(t *testing.T) {
+		repo := &mockScoringContentRepository{contents: newContents(), saveErr: errors.New("db down")}
+		useCase := NewRecalculateScoresUseCase(repo, service.NewScoringService(service.DefaultScoringRules()), &mockCacheRepository{})
+		useCase.SetBatchSize(2)
+
+		_, err := useCase.Execute(context.Background())
+		assert.Error(t, err)
+		assert.Equal(t, 1, repo.pages)
+	})
+
+	t.Run("stops when context is cancelled", func(t *testing.T) {
+		repo := &mockScoringContentRepository{contents: newContents()}
+		useCase := NewRecalculateScoresUseCase(repo, service.NewScoringService(service.DefaultScoringRules()), &mockCacheRepository{})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := useCase.Execute(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Zero(t, repo.pages)
+	})
+}
+
+// blockingScoringContentRepository ilk sayfa okumasında release kapanana kadar bekler
+type blockingScoringContentRepository struct {
+	mockScoringContentRepository
+	started chan struct{}
+	release chan struct{}
+}
+
+func (m *blockingScoringContentRepository) FindContentsForScoring(ctx context.Context, afterID int64, limit int) ([]*entity.Content, error) {
+	close(m.started)
+	<-m.release
+	return nil, nil
+}
+
+func TestRecalculateScoresUseCase_ExecuteAsync(t *testing.T) {
+	repo := &blockingScoringContentRepository{started: make(chan struct{}), release: make(chan struct{})}
+	useCase := NewRecalculateScoresUseCase(repo, service.NewScoringService(service.DefaultScoringRules()), &mockCacheRepository{})
+
+	require.True(t, useCase.ExecuteAsync())
+	<-repo.started
+	assert.False(t, useCase.ExecuteAsync(), "a second manual run must not start while the first is running")
+
+	close(repo.release)
+	require.Eventually(t, func() bool { return !useCase.running.Load() }, time.Second, 5*time.Millisecond)
+}
@@ -0,0 +1,241 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// Kayıtlı arama bildirim sınırları
+const (
+	maxPendingAlertChanges      = 10000 // Değerlendirilmeyi bekleyen yeni içerik sınırı
+	maxSavedSearchAlertContents = 20    // Bildirimde listelenen en fazla içerik
+	savedSearchAlertConcurrency = 8     // Aynı anda gönderilen bildirim sayısı
+)
+
+// SavedSearchAlertStats bir Evaluate çağrısının sonucu
+type SavedSearchAlertStats struct {
+	Notified int // Tüm kanallara gönderilen bildirimler
+	Failed   int // En az bir kanalı başarısız olan bildirimler
+	Dropped  int // Kuyruk dolduğu için değerlendirilmeden düşürülen içerikler
+}
+
+// SavedSearchAlerter sync'te eklenen içerikleri kayıtlı aramalarla eşleştirir ve eşleşme olan
+// aramaların sahiplerine e-posta ve/veya imzalı webhook ile bildirim gönderir.
+// port.ContentChangePublisher arayüzünü sağlar; sadece yeni (created) içerikler değerlendirilir.
+// Bildirimler tek denemelidir, başarısız kanal kayıtlı aramanın last_error alanında görünür
+type SavedSearchAlerter struct {
+	savedSearchRepo port.SavedSearchRepository
+	sender          port.WebhookSender
+	timeout         time.Duration
+	now             func() time.Time
+	queue           *changeQueue
+
+	// E-posta gönderici (opsiyonel); nil ise notify_email bildirimleri başarısız sayılır
+	mailer port.AlertMailer
+}
+
+// NewSavedSearchAlerter yeni bir kayıtlı arama bildirim use case'i oluşturur
+// timeout tek bir bildirim isteğinin (webhook veya e-posta) süre sınırıdır
+func NewSavedSearchAlerter(savedSearchRepo port.SavedSearchRepository, sender port.WebhookSender, timeout time.Duration) *SavedSearchAlerter {
+	return &SavedSearchAlerter{
+		savedSearchRepo: savedSearchRepo,
+		sender:          sender,
+		timeout:         timeout,
+		now:             time.Now,
+		queue:           newChangeQueue(maxPendingAlertChanges),
+	}
+}
+
+// SetMailer e-posta bildirimleri için göndericiyi ayarlar
+func (a *SavedSearchAlerter) SetMailer(mailer port.AlertMailer) {
+	a.mailer = mailer
+}
+
+// Publish yeni içerikleri değerlendirilmek üzere bekletir; güncellemeler yok sayılır
+func (a *SavedSearchAlerter) Publish(changes []entity.ContentChange) {
+	created := make([]entity.ContentChange, 0, len(changes))
+	for _, change := range changes {
+		if change.Type == entity.ContentChangeCreated {
+			created = append(created, change)
+		}
+	}
+	if len(created) > 0 {
+		a.queue.push(created)
+	}
+}
+
+// Ready Publish yeni içerik aldığında sinyal alan kanal
+func (a *SavedSearchAlerter) Ready() <-chan struct{} {
+	return a.queue.ready
+}
+
+// Evaluate bekleyen yeni içerikleri kayıtlı aramalarla eşleştirir ve bildirimleri gönderir
+func (a *SavedSearchAlerter) Evaluate(ctx context.Context) (SavedSearchAlertStats, error) {
+	var stats SavedSearchAlertStats
+
+	changes, dropped := a.queue.take()
+	stats.Dropped = dropped
+	if len(changes) == 0 {
+		return stats, nil
+	}
+
+	searches, err := a.savedSearchRepo.ListAlertableSavedSearches(ctx)
+	if err != nil {
+		a.queue.requeue(changes)
+		return stats, fmt.Errorf("kayıtlı aramalar okunamadı: %w", err)
+	}
+
+	var (
+		wg      sync.WaitGroup
+		statsMu sync.Mutex
+		sem     = make(chan struct{}, savedSearchAlertConcurrency)
+	)
+	for _, search := range searches {
+		alert := a.buildAlert(search, changes)
+		if alert == nil {
+			continue
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(search *entity.SavedSearch, alert *entity.SavedSearchAlert) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			notifyErr := a.notify(ctx, search, alert)
+			lastError := ""
+			if notifyErr != nil {
+				lastError = notifyErr.Error()
+			}
+			// Sonuç kaydı başarısız olursa sadece last_notified_at/last_error güncel kalmaz, bildirim tekrarlanmaz
+			_ = a.savedSearchRepo.RecordSavedSearchAlert(context.WithoutCancel(ctx), search.ID, alert.CreatedAt, lastError)
+
+			statsMu.Lock()
+			defer statsMu.Unlock()
+			if notifyErr != nil {
+				stats.Failed++
+			} else {
+				stats.Notified++
+			}
+		}(search, alert)
+	}
+	wg.Wait()
+
+	return stats, nil
+}
+
+// buildAlert aramaya uyan yeni içeriklerden bildirim oluşturur; eşleşme yoksa nil döner
+func (a *SavedSearchAlerter) buildAlert(search *entity.SavedSearch, changes []entity.ContentChange) *entity.SavedSearchAlert {
+	filter := ContentStreamFilter{
+		Query:       search.Query,
+		Tags:        search.Tags,
+		ContentType: search.ContentType,
+		ProviderID:  search.ProviderID,
+	}
+
+	alert := &entity.SavedSearchAlert{
+		Event:         entity.SavedSearchEventNewResults,
+		SavedSearchID: search.ID,
+		Name:          search.Name,
+		Query:         search.Query,
+		Contents:      make([]*entity.Content, 0),
+		CreatedAt:     a.now(),
+	}
+	for _, change := range changes {
+		if !filter.matches(change) {
+			continue
+		}
+		alert.Total++
+		if len(alert.Contents) < maxSavedSearchAlertContents {
+			alert.Contents = append(alert.Contents, change.Content)
+		}
+	}
+
+	if alert.Total == 0 {
+		return nil
+	}
+	return alert
+}
+
+// notify bildirimi aramanın tüm kanallarına gönderir; başarısız kanalların hataları birleştirilir
+func (a *SavedSearchAlerter) notify(ctx context.Context, search *entity.SavedSearch, alert *entity.SavedSearchAlert) error {
+	var failures []string
+
+	if search.NotifyURL != "" {
+		if err := a.notifyWebhook(ctx, search, alert); err != nil {
+			failures = append(failures, "webhook: "+err.Error())
+		}
+	}
+	if search.NotifyEmail != "" {
+		if a.mailer == nil {
+			// Arama kaydedildikten sonra SMTP ayarı kaldırılmış
+			failures = append(failures, "email: email alerts are not configured")
+		} else {
+			sendCtx, cancel := context.WithTimeout(ctx, a.timeout)
+			err := a.mailer.SendMail(sendCtx, search.NotifyEmail, savedSearchAlertSubject(alert), savedSearchAlertBody(alert))
+			cancel()
+			if err != nil {
+				failures = append(failures, "email: "+err.Error())
+			}
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("%s", strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// notifyWebhook bildirimi aramanın secret'ı ile imzalayıp notify_url'ye POST eder
+func (a *SavedSearchAlerter) notifyWebhook(ctx context.Context, search *entity.SavedSearch, alert *entity.SavedSearchAlert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	header.Set(WebhookEventHeader, entity.SavedSearchEventNewResults)
+	header.Set(WebhookSignatureHeader, SignWebhookPayload(search.Secret, a.now().Unix(), body))
+
+	sendCtx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+	statusCode, err := a.sender.Send(sendCtx, search.NotifyURL, header, body)
+	if err != nil {
+		return err
+	}
+	if statusCode < 200 || statusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", statusCode)
+	}
+	return nil
+}
+
+// savedSearchAlertSubject e-posta konusu; arama adı header injection'a karşı tek satıra indirilir
+func savedSearchAlertSubject(alert *entity.SavedSearchAlert) string {
+	name := strings.Join(strings.Fields(alert.Name), " ")
+	return fmt.Sprintf("%q için %d yeni sonuç", name, alert.Total)
+}
+
+// savedSearchAlertBody düz metin e-posta gövdesi
+func savedSearchAlertBody(alert *entity.SavedSearchAlert) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%q kayıtlı aramanıza uyan %d yeni içerik var:\n\n", alert.Query, alert.Total)
+	for _, content := range alert.Contents {
+		fmt.Fprintf(&b, "- %s (%s)\n", content.Title, content.ContentType)
+		if content.URL != "" {
+			fmt.Fprintf(&b, "  %s\n", content.URL)
+		}
+	}
+	if remaining := alert.Total - len(alert.Contents); remaining > 0 {
+		fmt.Fprintf(&b, "\n... ve %d içerik daha\n", remaining)
+	}
+	return b.String()
+}
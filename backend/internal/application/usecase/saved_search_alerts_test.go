@@ -0,0 +1,149 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+)
+
+// mockAlertMailer gönderilen e-postaları kaydeder
+type mockAlertMailer struct {
+	mu     sync.Mutex
+	err    error
+	sent   map[string]string // alıcı -> konu
+	bodies map[string]string // alıcı -> gövde
+}
+
+func newMockAlertMailer() *mockAlertMailer {
+	return &mockAlertMailer{sent: make(map[string]string), bodies: make(map[string]string)}
+}
+
+func (m *mockAlertMailer) SendMail(ctx context.Context, to, subject, body string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return m.err
+	}
+	m.sent[to] = subject
+	m.bodies[to] = body
+	return nil
+}
+
+func TestSavedSearchAlerter_Evaluate(t *testing.T) {
+	t.Run("notifies matching searches over email and webhook", func(t *testing.T) {
+		repo := &mockSavedSearchRepository{searches: []*entity.SavedSearch{
+			{ID: 1, Name: "go", Query: "go", NotifyEmail: "go@example.com"},
+			{ID: 2, Name: "go hook", Query: "go", NotifyURL: "https://hooks.example.com/go", Secret: "whsec_test"},
+			{ID: 3, Name: "rust", Query: "rust", NotifyEmail: "rust@example.com"},
+		}}
+		sender := newMockWebhookSender()
+		sender.statuses["https://hooks.example.com/go"] = http.StatusOK
+		mailer := newMockAlertMailer()
+
+		alerter := NewSavedSearchAlerter(repo, sender, time.Second)
+		alerter.SetMailer(mailer)
+		now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+		alerter.now = func() time.Time { return now }
+
+		alerter.Publish([]entity.ContentChange{
+			approvedChange(entity.ContentChangeCreated, "Go Concurrency"),
+			approvedChange(entity.ContentChangeUpdated, "Go Generics"),
+		})
+		select {
+		case <-alerter.Ready():
+		default:
+			t.Fatal("Expected Publish to signal Ready")
+		}
+
+		stats, err := alerter.Evaluate(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, SavedSearchAlertStats{Notified: 2}, stats)
+
+		assert.Equal(t, `"go" için 1 yeni sonuç`, mailer.sent["go@example.com"])
+		assert.Contains(t, mailer.bodies["go@example.com"], "Go Concurrency")
+		assert.NotContains(t, mailer.bodies["go@example.com"], "Go Generics", "updates are not alerted")
+		assert.NotContains(t, mailer.sent, "rust@example.com")
+
+		body := sender.bodies["https://hooks.example.com/go"]
+		var alert entity.SavedSearchAlert
+		require.NoError(t, json.Unmarshal(body, &alert))
+		assert.Equal(t, entity.SavedSearchEventNewResults, alert.Event)
+		assert.Equal(t, int64(2), alert.SavedSearchID)
+		assert.Equal(t, 1, alert.Total)
+
+		header := sender.headers["https://hooks.example.com/go"]
+		assert.Equal(t, SignWebhookPayload("whsec_test", now.Unix(), body), header.Get(WebhookSignatureHeader))
+		assert.Equal(t, entity.SavedSearchEventNewResults, header.Get(WebhookEventHeader))
+
+		require.NotNil(t, repo.searches[0].LastNotifiedAt)
+		assert.Nil(t, repo.searches[2].LastNotifiedAt)
+	})
+
+	t.Run("records failed channels", func(t *testing.T) {
+		repo := &mockSavedSearchRepository{searches: []*entity.SavedSearch{
+			{ID: 1, Name: "go", Query: "go", NotifyEmail: "go@example.com", NotifyURL: "https://hooks.example.com/down"},
+		}}
+		sender := newMockWebhookSender()
+		sender.statuses["https://hooks.example.com/down"] = http.StatusServiceUnavailable
+		mailer := newMockAlertMailer()
+		mailer.err = errors.New("connection refused")
+
+		alerter := NewSavedSearchAlerter(repo, sender, time.Second)
+		alerter.SetMailer(mailer)
+		alerter.Publish([]entity.ContentChange{approvedChange(entity.ContentChangeCreated, "Go")})
+
+		stats, err := alerter.Evaluate(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, stats.Failed)
+		assert.Contains(t, repo.searches[0].LastError, "webhook: unexpected status code 503")
+		assert.Contains(t, repo.searches[0].LastError, "email: connection refused")
+		assert.Nil(t, repo.searches[0].LastNotifiedAt)
+	})
+
+	t.Run("caps listed contents", func(t *testing.T) {
+		repo := &mockSavedSearchRepository{searches: []*entity.SavedSearch{
+			{ID: 1, Name: "go", Query: "go", NotifyEmail: "go@example.com"},
+		}}
+		mailer := newMockAlertMailer()
+		alerter := NewSavedSearchAlerter(repo, newMockWebhookSender(), time.Second)
+		alerter.SetMailer(mailer)
+
+		changes := make([]entity.ContentChange, 0, maxSavedSearchAlertContents+5)
+		for i := 0; i < maxSavedSearchAlertContents+5; i++ {
+			changes = append(changes, approvedChange(entity.ContentChangeCreated, "Go"))
+		}
+		alerter.Publish(changes)
+
+		_, err := alerter.Evaluate(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, maxSavedSearchAlertContents, strings.Count(mailer.bodies["go@example.com"], "- Go"))
+		assert.Contains(t, mailer.bodies["go@example.com"], "5 içerik daha")
+	})
+
+	t.Run("requeues changes when searches cannot be loaded", func(t *testing.T) {
+		repo := &mockSavedSearchRepository{listErr: errors.New("db down")}
+		alerter := NewSavedSearchAlerter(repo, newMockWebhookSender(), time.Second)
+		mailer := newMockAlertMailer()
+		alerter.SetMailer(mailer)
+		alerter.Publish([]entity.ContentChange{approvedChange(entity.ContentChangeCreated, "Go")})
+
+		_, err := alerter.Evaluate(context.Background())
+		require.Error(t, err)
+
+		repo.listErr = nil
+		repo.searches = []*entity.SavedSearch{{ID: 1, Name: "go", Query: "go", NotifyEmail: "go@example.com"}}
+		stats, err := alerter.Evaluate(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, stats.Notified)
+	})
+}
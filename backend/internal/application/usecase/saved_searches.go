@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// Kayıtlı arama sınırları
+const (
+	maxSavedSearchesPerKey = 50
+	maxSavedSearchNameLen  = 100
+	maxSavedSearchQueryLen = 100 // Arama sorgusu sınırıyla aynı
+	maxSavedSearchEmailLen = 254
+)
+
+// ErrAPIKeyRequired anahtara bağlı bir işlem X-API-Key olmadan istendiğinde döner
+var ErrAPIKeyRequired = errors.New("api key required")
+
+// ManageSavedSearchesUseCase API anahtarı sahiplerinin kayıtlı aramalarını yönetir
+// Her anahtar sadece kendi kayıtlı aramalarını görebilir ve silebilir
+type ManageSavedSearchesUseCase struct {
+	savedSearchRepo port.SavedSearchRepository
+
+	// E-posta gönderimi yapılandırılmamışsa notify_email ile kayıt reddedilir
+	emailEnabled bool
+}
+
+// SavedSearchInput kayıtlı arama oluşturma isteği
+// Filtre alanları /api/v1/stream parametreleriyle aynı anlamdadır; en az bir bildirim kanalı zorunludur
+type SavedSearchInput struct {
+	Name        string             `json:"name"`
+	Query       string             `json:"query"`
+	Tags        []string           `json:"tags"`
+	ContentType entity.ContentType `json:"type"`
+	ProviderID  int64              `json:"provider_id"`
+	NotifyEmail string             `json:"notify_email"`
+	NotifyURL   string             `json:"notify_url"`
+}
+
+// CreatedSavedSearch yeni oluşturulan kayıtlı arama
+// Secret sadece notify_url verildiyse ve sadece bu yanıtta döner
+type CreatedSavedSearch struct {
+	*entity.SavedSearch
+	Secret string `json:"secret,omitempty"`
+}
+
+// NewManageSavedSearchesUseCase yeni bir kayıtlı arama yönetim use case oluşturur
+func NewManageSavedSearchesUseCase(savedSearchRepo port.SavedSearchRepository) *ManageSavedSearchesUseCase {
+	return &ManageSavedSearchesUseCase{
+		savedSearchRepo: savedSearchRepo,
+	}
+}
+
+// SetEmailAlertsEnabled e-posta bildirimlerinin kabul edilip edilmeyeceğini ayarlar
+func (uc *ManageSavedSearchesUseCase) SetEmailAlertsEnabled(enabled bool) {
+	uc.emailEnabled = enabled
+}
+
+// Create anahtar için yeni bir kayıtlı arama oluşturur
+func (uc *ManageSavedSearchesUseCase) Create(ctx context.Context, apiKeyID int64, input SavedSearchInput) (*CreatedSavedSearch, error) {
+	if apiKeyID <= 0 {
+		return nil, ErrAPIKeyRequired
+	}
+
+	search, err := uc.buildSavedSearch(input)
+	if err != nil {
+		return nil, err
+	}
+	search.APIKeyID = apiKeyID
+
+	existing, err := uc.savedSearchRepo.ListSavedSearches(ctx, apiKeyID)
+	if err != nil {
+		return nil, fmt.Errorf("kayıtlı aramalar okunamadı: %w", err)
+	}
+	if len(existing) >= maxSavedSearchesPerKey {
+		return nil, apperrors.NewValidationError("saved_searches", fmt.Sprintf("an API key can have at most %d saved searches", maxSavedSearchesPerKey), len(existing))
+	}
+
+	if search.NotifyURL != "" {
+		secret, err := generateWebhookSecret()
+		if err != nil {
+			return nil, fmt.Errorf("bildirim secret'ı üretilemedi: %w", err)
+		}
+		search.Secret = secret
+	}
+
+	if err := uc.savedSearchRepo.CreateSavedSearch(ctx, search); err != nil {
+		return nil, fmt.Errorf("kayıtlı arama kaydedilemedi: %w", err)
+	}
+
+	return &CreatedSavedSearch{SavedSearch: search, Secret: search.Secret}, nil
+}
+
+// List anahtarın kayıtlı aramalarını döner
+func (uc *ManageSavedSearchesUseCase) List(ctx context.Context, apiKeyID int64) ([]*entity.SavedSearch, error) {
+	if apiKeyID <= 0 {
+		return nil, ErrAPIKeyRequired
+	}
+
+	searches, err := uc.savedSearchRepo.ListSavedSearches(ctx, apiKeyID)
+	if err != nil {
+		return nil, fmt.Errorf("kayıtlı aramalar okunamadı: %w", err)
+	}
+	return searches, nil
+}
+
+// Delete anahtarın kayıtlı aramasını siler
+// Arama yoksa veya başka anahtara aitse port.ErrSavedSearchNotFound döner
+func (uc *ManageSavedSearchesUseCase) Delete(ctx context.Context, apiKeyID, id int64) error {
+	if apiKeyID <= 0 {
+		return ErrAPIKeyRequired
+	}
+
+	if err := uc.savedSearchRepo.DeleteSavedSearch(ctx, apiKeyID, id); err != nil {
+		if err == port.ErrSavedSearchNotFound {
+			return err
+		}
+		return fmt.Errorf("kayıtlı arama silinemedi: %w", err)
+	}
+	return nil
+}
+
+// buildSavedSearch girdiyi doğrular ve normalize edilmiş filtreyle kayıtlı arama oluşturur
+func (uc *ManageSavedSearchesUseCase) buildSavedSearch(input SavedSearchInput) (*entity.SavedSearch, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" || len(name) > maxSavedSearchNameLen {
+		return nil, apperrors.NewValidationError("name", fmt.Sprintf("name must be between 1 and %d characters", maxSavedSearchNameLen), input.Name)
+	}
+
+	filter, err := normalizeStreamFilter(ContentStreamFilter{
+		Query:       input.Query,
+		Tags:        input.Tags,
+		ContentType: input.ContentType,
+		ProviderID:  input.ProviderID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if filter.Query == "" {
+		return nil, apperrors.NewValidationError("query", "query is required", input.Query)
+	}
+	if len(filter.Query) > maxSavedSearchQueryLen {
+		return nil, apperrors.NewValidationError("query", fmt.Sprintf("query must be at most %d characters", maxSavedSearchQueryLen), input.Query)
+	}
+
+	if input.NotifyEmail == "" && input.NotifyURL == "" {
+		return nil, apperrors.NewValidationError("notify_email", "notify_email or notify_url is required", nil)
+	}
+	if input.NotifyEmail != "" {
+		if !uc.emailEnabled {
+			return nil, apperrors.NewValidationError("notify_email", "email alerts are not configured on this server", input.NotifyEmail)
+		}
+		addr, err := mail.ParseAddress(input.NotifyEmail)
+		if err != nil || addr.Address != input.NotifyEmail || len(input.NotifyEmail) > maxSavedSearchEmailLen {
+			return nil, apperrors.NewValidationError("notify_email", "notify_email must be a plain email address", input.NotifyEmail)
+		}
+	}
+	if input.NotifyURL != "" {
+		if err := validateWebhookURL("notify_url", input.NotifyURL); err != nil {
+			return nil, err
+		}
+	}
+
+	return &entity.SavedSearch{
+		Name:        name,
+		Query:       filter.Query,
+		Tags:        filter.Tags,
+		ContentType: filter.ContentType,
+		ProviderID:  filter.ProviderID,
+		NotifyEmail: input.NotifyEmail,
+		NotifyURL:   input.NotifyURL,
+	}, nil
+}
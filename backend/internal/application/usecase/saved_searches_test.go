@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// mockSavedSearchRepository kayıtlı aramaları bellekte tutar
+type mockSavedSearchRepository struct {
+	mu       sync.Mutex
+	searches []*entity.SavedSearch
+	listErr  error
+}
+
+func (m *mockSavedSearchRepository) CreateSavedSearch(ctx context.Context, search *entity.SavedSearch) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	search.ID = int64(len(m.searches) + 1)
+	search.CreatedAt = time.Now()
+	m.searches = append(m.searches, search)
+	return nil
+}
+
+func (m *mockSavedSearchRepository) ListSavedSearches(ctx context.Context, apiKeyID int64) ([]*entity.SavedSearch, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	searches := make([]*entity.SavedSearch, 0)
+	for _, search := range m.searches {
+		if search.APIKeyID == apiKeyID {
+			searches = append(searches, search)
+		}
+	}
+	return searches, nil
+}
+
+func (m *mockSavedSearchRepository) DeleteSavedSearch(ctx context.Context, apiKeyID, id int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, search := range m.searches {
+		if search.ID == id && search.APIKeyID == apiKeyID {
+			m.searches = append(m.searches[:i], m.searches[i+1:]...)
+			return nil
+		}
+	}
+	return port.ErrSavedSearchNotFound
+}
+
+func (m *mockSavedSearchRepository) ListAlertableSavedSearches(ctx context.Context) ([]*entity.SavedSearch, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listErr != nil {
+		return nil, m.listErr
+	}
+	return append([]*entity.SavedSearch(nil), m.searches...), nil
+}
+
+func (m *mockSavedSearchRepository) RecordSavedSearchAlert(ctx context.Context, id int64, notifiedAt time.Time, lastError string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, search := range m.searches {
+		if search.ID == id {
+			search.LastError = lastError
+			if lastError == "" {
+				search.LastNotifiedAt = &notifiedAt
+			}
+		}
+	}
+	return nil
+}
+
+func TestManageSavedSearchesUseCase_Create(t *testing.T) {
+	repo := &mockSavedSearchRepository{}
+	useCase := NewManageSavedSearchesUseCase(repo)
+	useCase.SetEmailAlertsEnabled(true)
+	ctx := context.Background()
+
+	t.Run("creates search with normalized filter", func(t *testing.T) {
+		created, err := useCase.Create(ctx, 7, SavedSearchInput{
+			Name:        " go videoları ",
+			Query:       "Go  Concurrency",
+			Tags:        []string{"GoLang"},
+			ContentType: entity.ContentTypeVideo,
+			NotifyEmail: "me@example.com",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(7), created.APIKeyID)
+		assert.Equal(t, "go videoları", created.Name)
+		assert.Equal(t, "go concurrency", created.Query)
+		assert.Equal(t, []string{"golang"}, created.Tags)
+		assert.Empty(t, created.Secret, "email-only searches need no signing secret")
+	})
+
+	t.Run("generates secret for webhook notifications", func(t *testing.T) {
+		created, err := useCase.Create(ctx, 7, SavedSearchInput{Name: "hook", Query: "rust", NotifyURL: "https://me.example.com/hooks"})
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(created.Secret, webhookSecretPrefix))
+	})
+
+	t.Run("requires an API key", func(t *testing.T) {
+		_, err := useCase.Create(ctx, 0, SavedSearchInput{Name: "a", Query: "go", NotifyEmail: "me@example.com"})
+		assert.ErrorIs(t, err, ErrAPIKeyRequired)
+	})
+
+	t.Run("rejects invalid input", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			input SavedSearchInput
+			field string
+		}{
+			{"empty name", SavedSearchInput{Name: " ", Query: "go", NotifyEmail: "me@example.com"}, "name"},
+			{"empty query", SavedSearchInput{Name: "a", Query: "  ", NotifyEmail: "me@example.com"}, "query"},
+			{"long query", SavedSearchInput{Name: "a", Query: strings.Repeat("a", maxSavedSearchQueryLen+1), NotifyEmail: "me@example.com"}, "query"},
+			{"no channel", SavedSearchInput{Name: "a", Query: "go"}, "notify_email"},
+			{"display name email", SavedSearchInput{Name: "a", Query: "go", NotifyEmail: "Me <me@example.com>"}, "notify_email"},
+			{"relative url", SavedSearchInput{Name: "a", Query: "go", NotifyURL: "/hooks"}, "notify_url"},
+			{"invalid type", SavedSearchInput{Name: "a", Query: "go", ContentType: "book", NotifyEmail: "me@example.com"}, "type"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := useCase.Create(ctx, 7, tt.input)
+				var validationErr *apperrors.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, tt.field, validationErr.Field)
+			})
+		}
+	})
+
+	t.Run("rejects email when alerts are not configured", func(t *testing.T) {
+		useCase := NewManageSavedSearchesUseCase(&mockSavedSearchRepository{})
+
+		_, err := useCase.Create(ctx, 7, SavedSearchInput{Name: "a", Query: "go", NotifyEmail: "me@example.com"})
+		var validationErr *apperrors.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "notify_email", validationErr.Field)
+	})
+
+	t.Run("limits searches per key", func(t *testing.T) {
+		repo := &mockSavedSearchRepository{}
+		useCase := NewManageSavedSearchesUseCase(repo)
+		for i := 0; i < maxSavedSearchesPerKey; i++ {
+			repo.searches = append(repo.searches, &entity.SavedSearch{ID: int64(i + 1), APIKeyID: 7})
+		}
+
+		_, err := useCase.Create(ctx, 7, SavedSearchInput{Name: "a", Query: "go", NotifyURL: "https://me.example.com/hooks"})
+		var validationErr *apperrors.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "saved_searches", validationErr.Field)
+
+		_, err = useCase.Create(ctx, 8, SavedSearchInput{Name: "a", Query: "go", NotifyURL: "https://me.example.com/hooks"})
+		assert.NoError(t, err, "other keys are not affected")
+	})
+}
+
+func TestManageSavedSearchesUseCase_ListAndDelete(t *testing.T) {
+	repo := &mockSavedSearchRepository{}
+	useCase := NewManageSavedSearchesUseCase(repo)
+	ctx := context.Background()
+
+	created, err := useCase.Create(ctx, 7, SavedSearchInput{Name: "a", Query: "go", NotifyURL: "https://me.example.com/hooks"})
+	require.NoError(t, err)
+
+	own, err := useCase.List(ctx, 7)
+	require.NoError(t, err)
+	assert.Len(t, own, 1)
+
+	other, err := useCase.List(ctx, 8)
+	require.NoError(t, err)
+	assert.Empty(t, other)
+
+	_, err = useCase.List(ctx, 0)
+	assert.ErrorIs(t, err, ErrAPIKeyRequired)
+
+	assert.ErrorIs(t, useCase.Delete(ctx, 8, created.ID), port.ErrSavedSearchNotFound, "keys cannot delete each other's searches")
+	require.NoError(t, useCase.Delete(ctx, 7, created.ID))
+	assert.ErrorIs(t, useCase.Delete(ctx, 7, created.ID), port.ErrSavedSearchNotFound)
+}
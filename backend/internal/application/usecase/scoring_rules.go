@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/domain/service"
+)
+
+// Skorlama kuralı sınırları
+const (
+	maxTypeWeight           = 100.0
+	maxEngagementMultiplier = 1000.0
+	maxRecencyTiers         = 10
+	maxRecencyTierDays      = 3650
+	maxRecencyTierScore     = 100.0
+	maxEngagementCap        = 1000.0
+	maxVideoDurationWeight  = 100.0
+	maxCommentWeight        = 100.0
+	maxCTRWeight            = 100.0
+)
+
+// ManageScoringRulesUseCase skorlama kuralları yönetimi (admin) use case'i
+// Kurallar veritabanında saklanır ve çalışma anında scoring service'e yüklenir
+type ManageScoringRulesUseCase struct {
+	rulesRepo      port.ScoringRulesRepository
+	scoringService service.ScoringService
+}
+
+// NewManageScoringRulesUseCase yeni bir skorlama kuralları yönetim use case oluşturur
+func NewManageScoringRulesUseCase(
+	rulesRepo port.ScoringRulesRepository,
+	scoringService service.ScoringService,
+) *ManageScoringRulesUseCase {
+	return &ManageScoringRulesUseCase{
+		rulesRepo:      rulesRepo,
+		scoringService: scoringService,
+	}
+}
+
+// Load kayıtlı kuralları scoring service'e yükler
+// Kayıt yoksa scoring service'in mevcut kuralları kullanılmaya devam eder
+func (uc *ManageScoringRulesUseCase) Load(ctx context.Context) error {
+	rules, err := uc.rulesRepo.GetScoringRules(ctx)
+	if err != nil {
+		return fmt.Errorf("skorlama kuralları yüklenemedi: %w", err)
+	}
+	if rules != nil {
+		uc.scoringService.SetRules(*rules)
+	}
+	return nil
+}
+
+// Get kayıtlı kuralları yeniden yükler ve kullanılan (varsayılanları uygulanmış) kuralları döner
+func (uc *ManageScoringRulesUseCase) Get(ctx context.Context) (*entity.ScoringRules, error) {
+	if err := uc.Load(ctx); err != nil {
+		return nil, err
+	}
+	rules := uc.scoringService.Rules()
+	return &rules, nil
+}
+
+// Update kuralları doğrular, kaydeder ve scoring service'e uygular
+// Mevcut içeriklerin skorları bir sonraki senkronizasyonda yeni kurallarla hesaplanır
+func (uc *ManageScoringRulesUseCase) Update(ctx context.Context, input entity.ScoringRules) (*entity.ScoringRules, error) {
+	if err := validateScoringRules(input); err != nil {
+		return nil, err
+	}
+
+	input.UpdatedAt = nil
+	if err := uc.rulesRepo.SaveScoringRules(ctx, &input); err != nil {
+		return nil, fmt.Errorf("skorlama kuralları kaydedilemedi: %w", err)
+	}
+	uc.scoringService.SetRules(input)
+
+	rules := uc.scoringService.Rules()
+	return &rules, nil
+}
+
+// validateScoringRules kuralların sınırlarını doğrular
+// Sıfır değerli alanlar için varsayılan kullanıldığından sadece negatif ve aşırı değerler reddedilir
+func validateScoringRules(rules entity.ScoringRules) error {
+	limits := []struct {
+		field string
+		value float64
+		max   float64
+	}{
+		{"video_type_weight", rules.VideoTypeWeight, maxTypeWeight},
+		{"article_type_weight", rules.ArticleTypeWeight, maxTypeWeight},
+		{"video_engagement_multiplier", rules.VideoEngagementMultiplier, maxEngagementMultiplier},
+		{"article_engagement_multiplier", rules.ArticleEngagementMultiplier, maxEngagementMultiplier},
+		{"recency_half_life_days", rules.RecencyHalfLifeDays, maxRecencyTierDays},
+		{"recency_max_score", rules.RecencyMaxScore, maxRecencyTierScore},
+		{"engagement_cap", rules.EngagementCap, maxEngagementCap},
+		{"video_duration_weight", rules.VideoDurationWeight, maxVideoDurationWeight},
+		{"comment_weight", rules.CommentWeight, maxCommentWeight},
+		{"ctr_weight", rules.CTRWeight, maxCTRWeight},
+	}
+	for _, l := range limits {
+		if l.value < 0 || l.value > l.max {
+			return apperrors.NewValidationError(l.field, fmt.Sprintf("%s must be between 0 and %g", l.field, l.max), l.value)
+		}
+	}
+
+	// Boş bırakılırsa config'deki güncellik fonksiyonu kullanılır
+	if rules.RecencyDecay != "" && !service.IsValidRecencyDecay(rules.RecencyDecay) {
+		return apperrors.NewValidationError("recency_decay", "invalid recency_decay (must be 'step', 'exponential' or 'gaussian')", rules.RecencyDecay)
+	}
+
+	if rules.EngagementScaling != "" && !service.IsValidEngagementScaling(rules.EngagementScaling) {
+		return apperrors.NewValidationError("engagement_scaling", "invalid engagement_scaling (must be 'linear' or 'log')", rules.EngagementScaling)
+	}
+
+	if len(rules.RecencyTiers) > maxRecencyTiers {
+		return apperrors.NewValidationError("recency_tiers", fmt.Sprintf("too many recency tiers (max %d)", maxRecencyTiers), len(rules.RecencyTiers))
+	}
+	previousDays := 0
+	for i, tier := range rules.RecencyTiers {
+		if tier.MaxAgeDays < 1 || tier.MaxAgeDays > maxRecencyTierDays {
+			return apperrors.NewValidationError(fmt.Sprintf("recency_tiers[%d].max_age_days", i), fmt.Sprintf("max_age_days must be between 1 and %d", maxRecencyTierDays), tier.MaxAgeDays)
+		}
+		if tier.MaxAgeDays <= previousDays {
+			return apperrors.NewValidationError(fmt.Sprintf("recency_tiers[%d].max_age_days", i), "recency tiers must be in ascending max_age_days order", tier.MaxAgeDays)
+		}
+		if tier.Score < 0 || tier.Score > maxRecencyTierScore {
+			return apperrors.NewValidationError(fmt.Sprintf("recency_tiers[%d].score", i), fmt.Sprintf("score must be between 0 and %g", maxRecencyTierScore), tier.Score)
+		}
+		previousDays = tier.MaxAgeDays
+	}
+
+	return nil
+}
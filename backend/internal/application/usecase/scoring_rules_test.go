@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/service"
+)
+
+// mockScoringRulesRepository bellekte tek bir kural kaydı tutar
+type mockScoringRulesRepository struct {
+	rules   *entity.ScoringRules
+	getErr  error
+	saveErr error
+}
+
+func (m *mockScoringRulesRepository) GetScoringRules(ctx context.Context) (*entity.ScoringRules, error) {
+	if m.getErr != nil {
+		return nil, m.getErr
+	}
+	if m.rules == nil {
+		return nil, nil
+	}
+	rules := *m.rules
+	return &rules, nil
+}
+
+func (m *mockScoringRulesRepository) SaveScoringRules(ctx context.Context, rules *entity.ScoringRules) error {
+	if m.saveErr != nil {
+		return m.saveErr
+	}
+	updatedAt := time.Now()
+	rules.UpdatedAt = &updatedAt
+	saved := *rules
+	m.rules = &saved
+	return nil
+}
+
+func TestManageScoringRulesUseCase_Load(t *testing.T) {
+	t.Run("keeps defaults without stored rules", func(t *testing.T) {
+		scoring := service.NewScoringService(service.DefaultScoringRules())
+		useCase := NewManageScoringRulesUseCase(&mockScoringRulesRepository{}, scoring)
+
+		require.NoError(t, useCase.Load(context.Background()))
+		assert.Equal(t, 1.5, scoring.Rules().VideoTypeWeight)
+	})
+
+	t.Run("applies stored rules", func(t *testing.T) {
+		scoring := service.NewScoringService(service.DefaultScoringRules())
+		repo := &mockScoringRulesRepository{rules: &entity.ScoringRules{VideoTypeWeight: 3, ArticleTypeWeight: 2}}
+		useCase := NewManageScoringRulesUseCase(repo, scoring)
+
+		rules, err := useCase.Get(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 3.0, rules.VideoTypeWeight)
+		assert.Equal(t, 3.0, scoring.Rules().VideoTypeWeight)
+		assert.Len(t, rules.RecencyTiers, 3, "missing fields should fall back to defaults")
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		scoring := service.NewScoringService(service.DefaultScoringRules())
+		useCase := NewManageScoringRulesUseCase(&mockScoringRulesRepository{getErr: errors.New("db down")}, scoring)
+
+		assert.Error(t, useCase.Load(context.Background()))
+		assert.Equal(t, 1.5, scoring.Rules().VideoTypeWeight)
+	})
+}
+
+func TestManageScoringRulesUseCase_Update(t *testing.T) {
+	scoring := service.NewScoringService(service.DefaultScoringRules())
+	repo := &mockScoringRulesRepository{}
+	useCase := NewManageScoringRulesUseCase(repo, scoring)
+
+	t.Run("saves and applies rules", func(t *testing.T) {
+		rules, err := useCase.Update(context.Background(), entity.ScoringRules{
+			VideoTypeWeight:   2,
+			ArticleTypeWeight: 1.2,
+			RecencyTiers:      []entity.RecencyTier{{MaxAgeDays: 3, Score: 10}, {MaxAgeDays: 14, Score: 4}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2.0, rules.VideoTypeWeight)
+		assert.NotNil(t, rules.UpdatedAt)
+		require.NotNil(t, repo.rules)
+		assert.Equal(t, 1.2, repo.rules.ArticleTypeWeight)
+		assert.Equal(t, []entity.RecencyTier{{MaxAgeDays: 3, Score: 10}, {MaxAgeDays: 14, Score: 4}}, scoring.Rules().RecencyTiers)
+	})
+
+	t.Run("rejects invalid rules", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			rules entity.ScoringRules
+			field string
+		}{
+			{"negative weight", entity.ScoringRules{VideoTypeWeight: -1}, "video_type_weight"},
+			{"too large multiplier", entity.ScoringRules{ArticleEngagementMultiplier: 5000}, "article_engagement_multiplier"},
+			{"zero tier days", entity.ScoringRules{RecencyTiers: []entity.RecencyTier{{MaxAgeDays: 0, Score: 1}}}, "recency_tiers[0].max_age_days"},
+			{"unordered tiers", entity.ScoringRules{RecencyTiers: []entity.RecencyTier{{MaxAgeDays: 30, Score: 3}, {MaxAgeDays: 7, Score: 5}}}, "recency_tiers[1].max_age_days"},
+			{"negative tier score", entity.ScoringRules{RecencyTiers: []entity.RecencyTier{{MaxAgeDays: 7, Score: -1}}}, "recency_tiers[0].score"},
+			{"unknown recency decay", entity.ScoringRules{RecencyDecay: "linear"}, "recency_decay"},
+			{"too long half-life", entity.ScoringRules{RecencyHalfLifeDays: 5000}, "recency_half_life_days"},
+			{"unknown engagement scaling", entity.ScoringRules{EngagementScaling: "sqrt"}, "engagement_scaling"},
+			{"negative engagement cap", entity.ScoringRules{EngagementCap: -1}, "engagement_cap"},
+			{"video duration weight too large", entity.ScoringRules{VideoDurationWeight: 101}, "video_duration_weight"},
+			{"negative comment weight", entity.ScoringRules{CommentWeight: -1}, "comment_weight"},
+			{"too large CTR weight", entity.ScoringRules{CTRWeight: 101}, "ctr_weight"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := useCase.Update(context.Background(), tt.rules)
+				var validationErr *apperrors.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, tt.field, validationErr.Field)
+			})
+		}
+
+		// Reddedilen kurallar uygulanmaz
+		assert.Equal(t, 2.0, scoring.Rules().VideoTypeWeight)
+	})
+
+	t.Run("does not apply rules when save fails", func(t *testing.T) {
+		repo.saveErr = errors.New("db down")
+		_, err := useCase.Update(context.Background(), entity.ScoringRules{VideoTypeWeight: 4})
+		assert.Error(t, err)
+		assert.Equal(t, 2.0, scoring.Rules().VideoTypeWeight)
+	})
+}
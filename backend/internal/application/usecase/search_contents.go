@@ -5,31 +5,64 @@ import (
 	"crypto/md5"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
 	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
 	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/domain/service"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/logger"
 )
 
+// defaultHybridRelevanceWeight hybrid sıralamada varsayılan alakalılık ağırlığı (kalan kısım popülerlik)
+const defaultHybridRelevanceWeight = 0.7
+
+// estimatedCountLimit count=estimate isteklerinde sayılacak en fazla kayıt; aşılırsa toplam "10000+" kabul edilir
+const estimatedCountLimit = 10000
+
 // SearchContentsUseCase arama use case'i
 type SearchContentsUseCase struct {
-	contentRepo port.ContentRepository
-	cache       port.CacheRepository
-	cacheTTL    time.Duration
+	contentRepo           port.ContentRepository
+	cache                 port.CacheRepository
+	cacheTTL              atomic.Int64                   // time.Duration, SetCacheTTL ile çalışma anında değişebilir
+	fuzzyThreshold        float64                        // 0 ise fuzzy fallback kapalı
+	hybridRelevanceWeight float64                        // hybrid sıralamada alakalılığın ağırlığı (0-1)
+	scoringService        service.ScoringService         // nil ise explain istekleri yok sayılır
+	promotionRepo         port.PromotionRepository       // nil ise sabitlemeler uygulanmaz
+	queryStats            port.QueryStatsRepository      // nil ise sorgu sıklığı tutulmaz, warm-up yapılmaz
+	zeroResultRepo        port.ZeroResultQueryRepository // nil ise sonuçsuz sorgular kaydedilmez
+	exportMaxRows         int                            // 0 ise defaultExportMaxRows
+	flags                 port.FeatureFlags              // nil ise bayraklı davranışların hepsi açık
 }
 
 // SearchResult arama sonucu yapısı
 type SearchResult struct {
-	Items      []*entity.Content `json:"items"`
-	Pagination Pagination        `json:"pagination"`
+	Items      []*entity.Content    `json:"items"`
+	Pagination Pagination           `json:"pagination"`
+	Facets     *entity.SearchFacets `json:"facets,omitempty"` // Sadece istenirse doldurulur
+	Meta       SearchMeta           `json:"meta"`
+}
+
+// SearchMeta aramanın nasıl yapıldığına dair ek bilgiler
+type SearchMeta struct {
+	FuzzyFallback bool `json:"fuzzy_fallback"` // Sonuçlar trigram benzerliğiyle bulunduysa true
 }
 
 // Pagination sayfalama bilgileri
 type Pagination struct {
-	Page       int   `json:"page"`
-	PageSize   int   `json:"page_size"`
-	TotalItems int64 `json:"total_items"`
-	TotalPages int64 `json:"total_pages"`
+	Page       int    `json:"page"`
+	PageSize   int    `json:"page_size"`
+	TotalItems int64  `json:"total_items"`
+	TotalPages int64  `json:"total_pages"`
+	ExactTotal bool   `json:"exact_total"`           // false ise TotalItems bir alt sınırdır (count=estimate ile "10000+")
+	NextCursor string `json:"next_cursor,omitempty"` // Keyset pagination için sonraki sayfanın cursor'ı
 }
 
 // NewSearchContentsUseCase yeni bir arama use case oluşturur
@@ -38,20 +71,140 @@ func NewSearchContentsUseCase(
 	cache port.CacheRepository,
 	cacheTTL time.Duration,
 ) *SearchContentsUseCase {
-	return &SearchContentsUseCase{
-		contentRepo: contentRepo,
-		cache:       cache,
-		cacheTTL:    cacheTTL,
+	uc := &SearchContentsUseCase{
+		contentRepo:           contentRepo,
+		cache:                 cache,
+		hybridRelevanceWeight: defaultHybridRelevanceWeight,
 	}
+	uc.cacheTTL.Store(int64(cacheTTL))
+	return uc
+}
+
+// SetCacheTTL sonuçların cache'te tutulma süresini çalışma anında değiştirir; mevcut kayıtlar eski sürelerini korur
+func (uc *SearchContentsUseCase) SetCacheTTL(ttl time.Duration) {
+	uc.cacheTTL.Store(int64(ttl))
+}
+
+// SetFuzzyThreshold FTS sonuç döndürmediğinde kullanılacak trigram benzerlik eşiğini ayarlar
+// 0 veya negatif değer fuzzy fallback'i kapatır
+func (uc *SearchContentsUseCase) SetFuzzyThreshold(threshold float64) {
+	uc.fuzzyThreshold = threshold
+}
+
+// SetHybridRelevanceWeight hybrid sıralamada alakalılığın ağırlığını ayarlar (0-1 arası)
+// Popülerlik ağırlığı 1 - weight olur; 1 saf relevance, 0 saf popularity sıralamasına denktir
+func (uc *SearchContentsUseCase) SetHybridRelevanceWeight(weight float64) {
+	uc.hybridRelevanceWeight = weight
+}
+
+// SetScoringService explain=true isteklerinde skor açıklaması üretecek scoring service'i ayarlar
+func (uc *SearchContentsUseCase) SetScoringService(scoringService service.ScoringService) {
+	uc.scoringService = scoringService
+}
+
+// SetPromotionRepository sorguya göre en üste sabitlenen içeriklerin okunacağı repository'yi ayarlar
+func (uc *SearchContentsUseCase) SetPromotionRepository(promotionRepo port.PromotionRepository) {
+	uc.promotionRepo = promotionRepo
+}
+
+// SetQueryStats sorgu sıklıklarının tutulacağı ve warm-up'ta okunacağı repository'yi ayarlar
+func (uc *SearchContentsUseCase) SetQueryStats(queryStats port.QueryStatsRepository) {
+	uc.queryStats = queryStats
+}
+
+// SetZeroResultQueries sonuç döndürmeyen sorguların kaydedileceği repository'yi ayarlar
+func (uc *SearchContentsUseCase) SetZeroResultQueries(zeroResultRepo port.ZeroResultQueryRepository) {
+	uc.zeroResultRepo = zeroResultRepo
+}
+
+// SetFeatureFlags fuzzy fallback, hybrid sıralama ve sonuçsuz aramaların cache'lenmesini
+// çalışma anında açıp kapatacak bayrakları ayarlar
+func (uc *SearchContentsUseCase) SetFeatureFlags(flags port.FeatureFlags) {
+	uc.flags = flags
 }
 
 // Execute arama işlemini gerçekleştirir
-func (uc *SearchContentsUseCase) Execute(ctx context.Context, params port.SearchParams) (*SearchResult, error) {
+func (uc *SearchContentsUseCase) Execute(ctx context.Context, params port.SearchParams) (result *SearchResult, err error) {
+	ctx, span := tracer.Start(ctx, "SearchContentsUseCase.Execute")
+	defer func() { endSpan(span, err) }()
+
 	// 1. Parametreleri validate et
-	if err := uc.validateParams(&params); err != nil {
+	if err := uc.validateParams(ctx, &params); err != nil {
 		return nil, err
 	}
+	span.SetAttributes(
+		attribute.String("search.sort", params.SortBy),
+		attribute.Int("search.page", params.Page),
+		attribute.Int("search.page_size", params.PageSize),
+		attribute.Bool("search.facets", params.IncludeFacets),
+	)
+
+	// Sadece ilk sayfa istekleri sayılır; sonraki sayfalar aynı aramanın devamıdır
+	if uc.queryStats != nil && params.Page == 1 && params.Cursor == nil {
+		if query := strings.TrimSpace(params.Query); query != "" {
+			_ = uc.queryStats.RecordQuery(ctx, query)
+		}
+	}
+
+	result, err = uc.search(ctx, params)
+	if err == nil {
+		uc.recordZeroResult(ctx, params, result)
+	}
+	return result, err
+}
+
+// recordZeroResult filtresiz ilk sayfa araması hiç sonuç döndürmediyse sorguyu kaydeder
+// Filtreli aramalar sayılmaz; sonuçsuzluk sorgudan değil filtrelerden kaynaklanıyor olabilir
+func (uc *SearchContentsUseCase) recordZeroResult(ctx context.Context, params port.SearchParams, result *SearchResult) {
+	if uc.zeroResultRepo == nil || params.Page != 1 || params.Cursor != nil || hasFilters(params) {
+		return
+	}
+	if len(result.Items) > 0 || result.Pagination.TotalItems > 0 {
+		return
+	}
+	if query := normalizePromotionQuery(params.Query); query != "" {
+		_ = uc.zeroResultRepo.RecordZeroResultQuery(ctx, query)
+	}
+}
+
+// WarmUp son dönemde en çok aranan en fazla limit kadar sorguyu varsayılan parametrelerle çalıştırıp
+// sonuçlarını cache'e yazar; ısıtılan sorgu sayısını döner. Warm-up sorguları sıklığa sayılmaz.
+// Tek bir sorgunun hatası diğerlerini durdurmaz
+func (uc *SearchContentsUseCase) WarmUp(ctx context.Context, limit int) (int, error) {
+	if uc.queryStats == nil || limit <= 0 {
+		return 0, nil
+	}
+
+	queries, err := uc.queryStats.TopQueries(ctx, limit)
+	if err != nil {
+		return 0, fmt.Errorf("popüler sorgular okunamadı: %w", err)
+	}
+
+	warmed := 0
+	for _, query := range queries {
+		if ctx.Err() != nil {
+			return warmed, ctx.Err()
+		}
+
+		params := port.SearchParams{Query: query}
+		if err := uc.validateParams(ctx, &params); err != nil {
+			continue
+		}
+		if _, err := uc.search(ctx, params); err != nil {
+			logger.FromContext(ctx).Warn("cache warm-up query failed",
+				zap.String("query", query),
+				zap.Error(err),
+			)
+			continue
+		}
+		warmed++
+	}
+
+	return warmed, nil
+}
 
+// search doğrulanmış parametrelerle aramayı cache üzerinden yapar
+func (uc *SearchContentsUseCase) search(ctx context.Context, params port.SearchParams) (*SearchResult, error) {
 	// 2. Cache key oluştur
 	cacheKey := uc.generateCacheKey(params)
 
@@ -59,9 +212,12 @@ func (uc *SearchContentsUseCase) Execute(ctx context.Context, params port.Search
 	if cached, err := uc.cache.Get(ctx, cacheKey); err == nil {
 		var result SearchResult
 		if err := json.Unmarshal(cached, &result); err == nil {
+			trace.SpanFromContext(ctx).SetAttributes(attribute.Bool("search.cache_hit", true))
+			uc.explain(&result, params)
 			return &result, nil
 		}
 	}
+	trace.SpanFromContext(ctx).SetAttributes(attribute.Bool("search.cache_hit", false))
 
 	// 4. Database'den ara
 	contents, total, err := uc.contentRepo.Search(ctx, params)
@@ -69,10 +225,32 @@ func (uc *SearchContentsUseCase) Execute(ctx context.Context, params port.Search
 		return nil, fmt.Errorf("arama hatası: %w", err)
 	}
 
+	// FTS hiç sonuç bulamadıysa yazım hatalarına karşı trigram benzerliğiyle tekrar dene
+	fuzzyUsed := false
+	if total == 0 && uc.fuzzyThreshold > 0 && strings.TrimSpace(params.Query) != "" && featureEnabled(ctx, uc.flags, entity.FlagFuzzyFallback) {
+		fuzzyParams := params
+		fuzzyParams.FuzzyThreshold = uc.fuzzyThreshold
+
+		fuzzyContents, fuzzyTotal, err := uc.contentRepo.Search(ctx, fuzzyParams)
+		if err != nil {
+			return nil, fmt.Errorf("fuzzy arama hatası: %w", err)
+		}
+		if fuzzyTotal > 0 {
+			contents, total = fuzzyContents, fuzzyTotal
+			params = fuzzyParams
+			fuzzyUsed = true
+		}
+	}
+
 	// 5. Sonucu hazırla
 	if contents == nil {
 		contents = make([]*entity.Content, 0)
 	}
+	// Tahmini sayımda limit aşıldıysa toplam limitle sınırlanır (ör. "10000+")
+	exactTotal := params.CountLimit == 0 || total <= int64(params.CountLimit)
+	if !exactTotal {
+		total = int64(params.CountLimit)
+	}
 	result := &SearchResult{
 		Items: contents,
 		Pagination: Pagination{
@@ -80,20 +258,119 @@ func (uc *SearchContentsUseCase) Execute(ctx context.Context, params port.Search
 			PageSize:   params.PageSize,
 			TotalItems: total,
 			TotalPages: (total + int64(params.PageSize) - 1) / int64(params.PageSize),
+			ExactTotal: exactTotal,
+		},
+		Meta: SearchMeta{
+			FuzzyFallback: fuzzyUsed,
 		},
 	}
 
-	// 6. Cache'e kaydet
-	if data, err := json.Marshal(result); err == nil {
+	// Popularity sıralamasında sayfa doluysa sonraki sayfa için cursor üret
+	if params.SortBy == "popularity" && len(contents) == params.PageSize {
+		result.Pagination.NextCursor = nextCursor(contents[len(contents)-1]).Encode()
+	}
+
+	// Sorgu için sabitlenen içerikleri ilk sayfanın başına ekle
+	uc.pin(ctx, result, params)
+
+	// 6. İstenirse facet sayımlarını ekle
+	if params.IncludeFacets {
+		facets, err := uc.contentRepo.GetFacets(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("facet hatası: %w", err)
+		}
+		result.Facets = facets
+	}
+
+	// 7. Cache'e kaydet (negative caching kapalıysa sonuçsuz aramalar hariç)
+	cacheable := len(result.Items) > 0 || result.Pagination.TotalItems > 0 || featureEnabled(ctx, uc.flags, entity.FlagNegativeCaching)
+	if data, err := json.Marshal(result); err == nil && cacheable {
 		// Cache hatası kritik değil, loglanabilir ama devam edilir
-		_ = uc.cache.Set(ctx, cacheKey, data, uc.cacheTTL)
+		_ = uc.cache.Set(ctx, cacheKey, data, time.Duration(uc.cacheTTL.Load()))
 	}
 
+	// Açıklamalar cache'e yazılmaz; aynı cache kaydı explain olan ve olmayan isteklere hizmet eder
+	uc.explain(result, params)
+
 	return result, nil
 }
 
+// pin filtresiz aramaların ilk sayfasında sorgu için sabitlenen içerikleri sonuçların başına ekler
+// Sabitlenen içerikler organik sonuçlardan çıkarılır; toplam sayı ve sayfalama organik sonuçlara göredir
+// Sabitleme okunamazsa arama sabitlemesiz devam eder
+func (uc *SearchContentsUseCase) pin(ctx context.Context, result *SearchResult, params port.SearchParams) {
+	if uc.promotionRepo == nil || params.Page != 1 || params.Cursor != nil || hasFilters(params) {
+		return
+	}
+	query := normalizePromotionQuery(params.Query)
+	if query == "" {
+		return
+	}
+
+	promotion, err := uc.promotionRepo.FindPromotionByQuery(ctx, query)
+	if err != nil || promotion == nil {
+		return
+	}
+
+	pinned := make([]*entity.Content, 0, len(promotion.ContentIDs))
+	pinnedIDs := make(map[int64]bool, len(promotion.ContentIDs))
+	for _, id := range promotion.ContentIDs {
+		// Sabitlendikten sonra silinen veya moderasyonda reddedilen içerikler atlanır
+		content, err := uc.contentRepo.FindByID(ctx, id)
+		if err != nil || !content.IsApproved() {
+			continue
+		}
+		content.Pinned = true
+		pinned = append(pinned, content)
+		pinnedIDs[id] = true
+	}
+	if len(pinned) == 0 {
+		return
+	}
+
+	items := pinned
+	for _, content := range result.Items {
+		if !pinnedIDs[content.ID] {
+			items = append(items, content)
+		}
+	}
+	result.Items = items
+}
+
+// hasFilters aramada sorgu dışında bir filtre olup olmadığını döner
+func hasFilters(params port.SearchParams) bool {
+	return params.ContentType != "" ||
+		params.Language != "" ||
+		params.ProviderID != 0 ||
+		params.ProviderName != "" ||
+		params.AuthorName != "" ||
+		params.Category != "" ||
+		len(params.Tags) > 0 ||
+		params.PublishedAfter != nil ||
+		params.PublishedBefore != nil
+}
+
+// explain istenirse sonuçlara skor açıklamasını ve sonuç listesindeki sırasını ekler
+func (uc *SearchContentsUseCase) explain(result *SearchResult, params port.SearchParams) {
+	if !params.Explain || uc.scoringService == nil {
+		return
+	}
+
+	offset := (params.Page - 1) * params.PageSize
+	for i, content := range result.Items {
+		explanation := uc.scoringService.Explain(content)
+		if explanation == nil {
+			// İstatistiği olmayan içerikler skorsuz sıralanır
+			explanation = &entity.ScoreExplanation{Formula: "no stats: content is ranked without a score"}
+		}
+		explanation.RelevanceScore = content.RelevanceScore
+		explanation.Rank = offset + i + 1
+		content.Explanation = explanation
+	}
+}
+
 // validateParams arama parametrelerini validate eder
-func (uc *SearchContentsUseCase) validateParams(params *port.SearchParams) error {
+func (uc *SearchContentsUseCase) validateParams(ctx context.Context, params *port.SearchParams) error {
 	// Query artık zorunlu değil (keşfet özelliği için)
 
 	// Page minimum 1
@@ -114,16 +391,86 @@ func (uc *SearchContentsUseCase) validateParams(params *port.SearchParams) error
 		params.SortBy = "popularity"
 	}
 
-	// SortBy geçerli değer kontrolü
-	if params.SortBy != "popularity" && params.SortBy != "relevance" {
-		return fmt.Errorf("geçersiz sıralama kriteri: %s (popularity veya relevance olmalı)", params.SortBy)
+	// Hybrid sıralama kapatıldıysa istemciler hata almasın, relevance sıralamasına düşülür
+	if params.SortBy == "hybrid" && !featureEnabled(ctx, uc.flags, entity.FlagHybridRanking) {
+		params.SortBy = "relevance"
+	}
+
+	// SortBy geçerli değer kontrolü (ön tanımlı sıralama veya alan listesi)
+	params.SortFields = nil
+	params.HybridRelevanceWeight = 0
+	switch params.SortBy {
+	case "popularity", "relevance":
+	case "hybrid":
+		params.HybridRelevanceWeight = uc.hybridRelevanceWeight
+	default:
+		fields, err := port.ParseSortFields(params.SortBy)
+		if err != nil {
+			return apperrors.NewValidationError("sort", fmt.Sprintf("geçersiz sıralama kriteri: %s (popularity, relevance, hybrid veya alan:yön listesi olmalı; %v)", params.SortBy, err), params.SortBy)
+		}
+		params.SortFields = fields
 	}
 
 	// ContentType geçerli değer kontrolü (boş olabilir)
-	if params.ContentType != "" &&
-		params.ContentType != entity.ContentTypeVideo &&
-		params.ContentType != entity.ContentTypeArticle {
-		return fmt.Errorf("geçersiz içerik türü: %s", params.ContentType)
+	if params.ContentType != "" && !entity.IsValidContentType(params.ContentType) {
+		return apperrors.NewValidationError("type",
+			"invalid content type (must be one of: "+strings.Join(entity.ContentTypeNames(), ", ")+")", params.ContentType)
+	}
+
+	// Dil filtresi (boşsa tüm diller)
+	params.Language = strings.ToLower(strings.TrimSpace(params.Language))
+	if params.Language != "" && !entity.IsSupportedLanguage(params.Language) {
+		return apperrors.NewValidationError("lang",
+			"invalid language (must be one of: "+strings.Join(entity.SupportedLanguages, ", ")+")", params.Language)
+	}
+
+	// Provider filtresi kontrolü
+	if params.ProviderID < 0 {
+		return apperrors.NewValidationError("provider_id", "provider_id must be a positive integer", params.ProviderID)
+	}
+	params.ProviderName = strings.TrimSpace(params.ProviderName)
+	params.AuthorName = strings.TrimSpace(params.AuthorName)
+
+	// Kategori filtresi path olarak da ("programming/go") adlarla da ("Programming > Go") verilebilir
+	params.Category = entity.CategoryPath(entity.ParseCategoryPath(params.Category))
+	if depth := len(strings.Split(params.Category, "/")); params.Category != "" && depth > entity.MaxCategoryDepth {
+		return apperrors.NewValidationError("category",
+			fmt.Sprintf("category must have at most %d levels", entity.MaxCategoryDepth), params.Category)
+	}
+
+	// Tag filtresini normalize et (küçük harf, tekrarsız, sıralı)
+	params.Tags = normalizeTags(params.Tags)
+	if params.TagMode == "" {
+		params.TagMode = port.TagModeAny
+	}
+	if params.TagMode != port.TagModeAny && params.TagMode != port.TagModeAll {
+		return apperrors.NewValidationError("tag_mode", "invalid tag_mode (must be 'any' or 'all')", params.TagMode)
+	}
+
+	// Keyset pagination sadece popularity sıralamasıyla tutarlıdır
+	if params.Cursor != nil {
+		if params.SortBy != "popularity" {
+			return apperrors.NewValidationError("cursor", "cursor pagination is only supported with popularity sort", params.SortBy)
+		}
+		params.Page = 1
+	}
+
+	// Toplam sayım modu (tahmini sayım büyük sonuç kümelerinde COUNT(*) maliyetini sınırlar)
+	params.CountLimit = 0
+	switch params.CountMode {
+	case "":
+		params.CountMode = port.CountModeExact
+	case port.CountModeExact:
+	case port.CountModeEstimate:
+		params.CountLimit = estimatedCountLimit
+	default:
+		return apperrors.NewValidationError("count", "invalid count mode (must be 'exact' or 'estimate')", params.CountMode)
+	}
+
+	// Tarih aralığı tutarlılık kontrolü
+	if params.PublishedAfter != nil && params.PublishedBefore != nil &&
+		params.PublishedAfter.After(*params.PublishedBefore) {
+		return apperrors.NewValidationError("published_after", "published_after must not be later than published_before", params.PublishedAfter.Format(time.RFC3339))
 	}
 
 	return nil
@@ -132,15 +479,91 @@ func (uc *SearchContentsUseCase) validateParams(params *port.SearchParams) error
 // generateCacheKey arama parametrelerinden cache key oluşturur
 func (uc *SearchContentsUseCase) generateCacheKey(params port.SearchParams) string {
 	// Parametreleri string'e çevir ve hash'le
-	key := fmt.Sprintf("search:%s:%s:%s:%d:%d",
+	key := fmt.Sprintf("search:%s:%s:%s:%d:%d:%t",
 		params.Query,
 		params.ContentType,
 		params.SortBy,
 		params.Page,
 		params.PageSize,
+		params.IncludeFacets,
 	)
 
+	// Tarih aralığı filtresi
+	key += ":" + formatTimeKey(params.PublishedAfter) + ":" + formatTimeKey(params.PublishedBefore)
+
+	// Dil filtresi
+	key += ":lang=" + params.Language
+
+	// Provider filtresi
+	key += fmt.Sprintf(":%d:%s", params.ProviderID, strings.ToLower(params.ProviderName))
+
+	// Yazar filtresi
+	key += ":author=" + strings.ToLower(params.AuthorName)
+
+	// Kategori filtresi (normalize edilmiş path)
+	key += ":category=" + params.Category
+
+	// Tag filtresi (normalize edilmiş ve sıralı olduğu için sıra farkı aynı key'i üretir)
+	key += ":" + strings.Join(params.Tags, ",") + ":" + params.TagMode
+
+	// Kopyaları gizleme
+	key += fmt.Sprintf(":%t", params.CollapseDuplicates)
+
+	// Toplam sayım modu
+	key += ":count=" + params.CountMode
+
+	// Hybrid ağırlığı (yapılandırma değişince eski sıralama cache'den dönmesin)
+	if params.SortBy == "hybrid" {
+		key += fmt.Sprintf(":hybrid=%g", params.HybridRelevanceWeight)
+	}
+
+	// Keyset cursor
+	if params.Cursor != nil {
+		key += ":cursor=" + params.Cursor.Encode()
+	}
+
 	// MD5 hash ile kısalt
 	hash := md5.Sum([]byte(key))
-	return fmt.Sprintf("search:%x", hash)
+	return fmt.Sprintf("%s%x", versionedCacheKey(searchCacheKeyPrefix), hash)
+}
+
+// formatTimeKey opsiyonel bir tarihi cache key'e uygun string'e çevirir
+func formatTimeKey(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// normalizeTags tag listesini küçük harfe çevirir, boşları ve tekrarları atar
+func normalizeTags(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	sort.Strings(normalized)
+
+	if len(normalized) == 0 {
+		return nil
+	}
+	return normalized
+}
+
+// nextCursor son içerikten keyset pagination cursor'ı oluşturur
+func nextCursor(last *entity.Content) port.SearchCursor {
+	cursor := port.SearchCursor{Score: -1, ID: last.ID}
+	if last.Score != nil {
+		cursor.Score = last.Score.FinalScore
+	}
+	return cursor
 }
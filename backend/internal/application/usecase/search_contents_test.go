@@ -2,8 +2,9 @@ package usecase
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,12 +12,17 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
 	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/domain/service"
 )
 
 // Mock repository for testing
 type mockSearchRepository struct {
-	searchFunc func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error)
+	searchFunc  func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error)
+	facetsFunc  func(ctx context.Context, params port.SearchParams) (*entity.SearchFacets, error)
+	similarFunc func(ctx context.Context, contentID int64, limit int) ([]*entity.Content, error)
+	findByID    func(ctx context.Context, id int64) (*entity.Content, error)
 }
 
 func (m *mockSearchRepository) Search(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
@@ -26,7 +32,24 @@ func (m *mockSearchRepository) Search(ctx context.Context, params port.SearchPar
 	return nil, 0, nil
 }
 
+func (m *mockSearchRepository) GetFacets(ctx context.Context, params port.SearchParams) (*entity.SearchFacets, error) {
+	if m.facetsFunc != nil {
+		return m.facetsFunc(ctx, params)
+	}
+	return &entity.SearchFacets{}, nil
+}
+
+func (m *mockSearchRepository) FindSimilar(ctx context.Context, contentID int64, limit int) ([]*entity.Content, error) {
+	if m.similarFunc != nil {
+		return m.similarFunc(ctx, contentID, limit)
+	}
+	return nil, nil
+}
+
 func (m *mockSearchRepository) FindByID(ctx context.Context, id int64) (*entity.Content, error) {
+	if m.findByID != nil {
+		return m.findByID(ctx, id)
+	}
 	return nil, nil
 }
 
@@ -58,6 +81,59 @@ func (m *mockSearchRepository) MarkStaleContentsAsDeleted(ctx context.Context, p
 	return nil
 }
 
+func (m *mockSearchRepository) MarkContentDeleted(ctx context.Context, providerID int64, providerContentID string) error {
+	return nil
+}
+
+func (m *mockSearchRepository) BulkUpsert(ctx context.Context, contents []*entity.Content) error {
+	return nil
+}
+
+func (m *mockSearchRepository) BulkCreateOrUpdateStats(ctx context.Context, stats []*entity.ContentStats) error {
+	return nil
+}
+
+func (m *mockSearchRepository) BulkCreateOrUpdateScores(ctx context.Context, scores []*entity.ContentScore) error {
+	return nil
+}
+
+func (m *mockSearchRepository) FindProviderContentIDs(ctx context.Context, providerID int64) (map[string]bool, error) {
+	return nil, nil
+}
+
+func (m *mockSearchRepository) FindDuplicateCandidates(ctx context.Context, providerID int64, since time.Time, window time.Duration) ([]*entity.DuplicateCandidate, error) {
+	return nil, nil
+}
+
+func (m *mockSearchRepository) LinkDuplicates(ctx context.Context, links map[int64]int64) error {
+	return nil
+}
+
+func (m *mockSearchRepository) FindContentsForScoring(ctx context.Context, afterID int64, limit int) ([]*entity.Content, error) {
+	return nil, nil
+}
+
+// mockQueryStats sorgu sayılarını bellekte tutar
+type mockQueryStats struct {
+	counts map[string]int
+	top    []string
+}
+
+func (m *mockQueryStats) RecordQuery(ctx context.Context, query string) error {
+	if m.counts == nil {
+		m.counts = make(map[string]int)
+	}
+	m.counts[query]++
+	return nil
+}
+
+func (m *mockQueryStats) TopQueries(ctx context.Context, limit int) ([]string, error) {
+	if len(m.top) > limit {
+		return m.top[:limit], nil
+	}
+	return m.top, nil
+}
+
 // Mock cache for testing
 type mockSearchCache struct {
 	storage map[string][]byte
@@ -202,8 +278,135 @@ func TestSearchContentsUseCase_Execute(t *testing.T) {
 		}
 
 		_, err := useCase.Execute(context.Background(), params)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "geçersiz içerik türü")
+		var validationErr *apperrors.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "type", validationErr.Field)
+	})
+
+	t.Run("parameter validation - registered content types", func(t *testing.T) {
+		var got entity.ContentType
+		mockRepo := &mockSearchRepository{
+			searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+				got = params.ContentType
+				return nil, 0, nil
+			},
+		}
+		useCase := NewSearchContentsUseCase(mockRepo, newMockSearchCache(), 60*time.Second)
+
+		_, err := useCase.Execute(context.Background(), port.SearchParams{ContentType: entity.ContentTypePodcast, Page: 1, PageSize: 20})
+		assert.NoError(t, err)
+		assert.Equal(t, entity.ContentTypePodcast, got)
+	})
+
+	t.Run("parameter validation - inverted date range", func(t *testing.T) {
+		mockRepo := &mockSearchRepository{}
+		mockCache := newMockSearchCache()
+		useCase := NewSearchContentsUseCase(mockRepo, mockCache, 60*time.Second)
+
+		after := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+		before := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		params := port.SearchParams{
+			Query:           "test",
+			PublishedAfter:  &after,
+			PublishedBefore: &before,
+		}
+
+		_, err := useCase.Execute(context.Background(), params)
+		var validationErr *apperrors.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "published_after", validationErr.Field)
+	})
+
+	t.Run("parameter validation - invalid tag mode", func(t *testing.T) {
+		useCase := NewSearchContentsUseCase(&mockSearchRepository{}, newMockSearchCache(), 60*time.Second)
+
+		params := port.SearchParams{
+			Tags:    []string{"golang"},
+			TagMode: "some",
+		}
+
+		_, err := useCase.Execute(context.Background(), params)
+		var validationErr *apperrors.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "tag_mode", validationErr.Field)
+	})
+
+	t.Run("tag normalization", func(t *testing.T) {
+		var capturedParams port.SearchParams
+		mockRepo := &mockSearchRepository{
+			searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+				capturedParams = params
+				return []*entity.Content{}, 0, nil
+			},
+		}
+
+		useCase := NewSearchContentsUseCase(mockRepo, newMockSearchCache(), 60*time.Second)
+
+		params := port.SearchParams{
+			Tags: []string{" Golang", "tutorial", "golang", ""},
+		}
+
+		_, err := useCase.Execute(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"golang", "tutorial"}, capturedParams.Tags)
+		assert.Equal(t, port.TagModeAny, capturedParams.TagMode)
+	})
+
+	t.Run("multi-field sort", func(t *testing.T) {
+		var capturedParams port.SearchParams
+		mockRepo := &mockSearchRepository{
+			searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+				capturedParams = params
+				return []*entity.Content{}, 0, nil
+			},
+		}
+
+		useCase := NewSearchContentsUseCase(mockRepo, newMockSearchCache(), 60*time.Second)
+
+		_, err := useCase.Execute(context.Background(), port.SearchParams{SortBy: "published_at:asc, Views"})
+		require.NoError(t, err)
+		assert.Equal(t, []port.SortField{
+			{Field: "published_at", Desc: false},
+			{Field: "views", Desc: true},
+		}, capturedParams.SortFields)
+	})
+
+	t.Run("hybrid sort uses configured relevance weight", func(t *testing.T) {
+		var capturedParams port.SearchParams
+		mockRepo := &mockSearchRepository{
+			searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+				capturedParams = params
+				return []*entity.Content{}, 0, nil
+			},
+		}
+
+		useCase := NewSearchContentsUseCase(mockRepo, newMockSearchCache(), 60*time.Second)
+
+		_, err := useCase.Execute(context.Background(), port.SearchParams{Query: "golang", SortBy: "hybrid"})
+		require.NoError(t, err)
+		assert.Equal(t, 0.7, capturedParams.HybridRelevanceWeight)
+		assert.Empty(t, capturedParams.SortFields)
+
+		useCase.SetHybridRelevanceWeight(0.4)
+		_, err = useCase.Execute(context.Background(), port.SearchParams{Query: "golang", SortBy: "hybrid"})
+		require.NoError(t, err)
+		assert.Equal(t, 0.4, capturedParams.HybridRelevanceWeight)
+
+		// Diğer sıralamalarda ağırlık repository'ye geçmez
+		_, err = useCase.Execute(context.Background(), port.SearchParams{Query: "golang", SortBy: "relevance", HybridRelevanceWeight: 0.9})
+		require.NoError(t, err)
+		assert.Zero(t, capturedParams.HybridRelevanceWeight)
+	})
+
+	t.Run("multi-field sort rejects unknown fields", func(t *testing.T) {
+		useCase := NewSearchContentsUseCase(&mockSearchRepository{}, newMockSearchCache(), 60*time.Second)
+
+		for _, sortBy := range []string{"password:desc", "views:sideways", "views,views"} {
+			_, err := useCase.Execute(context.Background(), port.SearchParams{SortBy: sortBy})
+			var validationErr *apperrors.ValidationError
+			require.ErrorAs(t, err, &validationErr, sortBy)
+			assert.Equal(t, "sort", validationErr.Field)
+		}
 	})
 
 	t.Run("parameter defaults", func(t *testing.T) {
@@ -325,6 +528,256 @@ func TestSearchContentsUseCase_Execute(t *testing.T) {
 	})
 }
 
+func TestSearchContentsUseCase_Facets(t *testing.T) {
+	t.Run("facets not computed by default", func(t *testing.T) {
+		mockRepo := &mockSearchRepository{
+			facetsFunc: func(ctx context.Context, params port.SearchParams) (*entity.SearchFacets, error) {
+				t.Fatal("GetFacets should not be called when facets are not requested")
+				return nil, nil
+			},
+		}
+
+		useCase := NewSearchContentsUseCase(mockRepo, newMockSearchCache(), 60*time.Second)
+
+		result, err := useCase.Execute(context.Background(), port.SearchParams{Query: "test"})
+		require.NoError(t, err)
+		assert.Nil(t, result.Facets)
+	})
+
+	t.Run("facets included when requested", func(t *testing.T) {
+		mockRepo := &mockSearchRepository{
+			facetsFunc: func(ctx context.Context, params port.SearchParams) (*entity.SearchFacets, error) {
+				return &entity.SearchFacets{
+					ContentTypes: []entity.FacetCount{{Value: "video", Count: 2}},
+					Providers:    []entity.FacetCount{{Value: "1", Label: "Provider 1", Count: 2}},
+					Tags:         []entity.FacetCount{{Value: "golang", Count: 1}},
+				}, nil
+			},
+		}
+
+		useCase := NewSearchContentsUseCase(mockRepo, newMockSearchCache(), 60*time.Second)
+
+		result, err := useCase.Execute(context.Background(), port.SearchParams{Query: "test", IncludeFacets: true})
+		require.NoError(t, err)
+		require.NotNil(t, result.Facets)
+		assert.Equal(t, "Provider 1", result.Facets.Providers[0].Label)
+		assert.Equal(t, "golang", result.Facets.Tags[0].Value)
+	})
+
+	t.Run("facet error", func(t *testing.T) {
+		mockRepo := &mockSearchRepository{
+			facetsFunc: func(ctx context.Context, params port.SearchParams) (*entity.SearchFacets, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		useCase := NewSearchContentsUseCase(mockRepo, newMockSearchCache(), 60*time.Second)
+
+		_, err := useCase.Execute(context.Background(), port.SearchParams{Query: "test", IncludeFacets: true})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "facet hatası")
+	})
+}
+
+func TestSearchContentsUseCase_FuzzyFallback(t *testing.T) {
+	t.Run("falls back to trigram search when FTS finds nothing", func(t *testing.T) {
+		var calls []port.SearchParams
+		mockRepo := &mockSearchRepository{
+			searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+				calls = append(calls, params)
+				if params.FuzzyThreshold > 0 {
+					return []*entity.Content{{ID: 1, Title: "Golang Tutorial"}}, 1, nil
+				}
+				return nil, 0, nil
+			},
+		}
+
+		useCase := NewSearchContentsUseCase(mockRepo, newMockSearchCache(), 60*time.Second)
+		useCase.SetFuzzyThreshold(0.3)
+
+		result, err := useCase.Execute(context.Background(), port.SearchParams{Query: "golnag"})
+		require.NoError(t, err)
+		require.Len(t, calls, 2)
+		assert.Equal(t, 0.3, calls[1].FuzzyThreshold)
+		assert.True(t, result.Meta.FuzzyFallback)
+		assert.Len(t, result.Items, 1)
+	})
+
+	t.Run("no fallback when FTS has results", func(t *testing.T) {
+		calls := 0
+		mockRepo := &mockSearchRepository{
+			searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+				calls++
+				return []*entity.Content{{ID: 1}}, 1, nil
+			},
+		}
+
+		useCase := NewSearchContentsUseCase(mockRepo, newMockSearchCache(), 60*time.Second)
+		useCase.SetFuzzyThreshold(0.3)
+
+		result, err := useCase.Execute(context.Background(), port.SearchParams{Query: "golang"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, calls)
+		assert.False(t, result.Meta.FuzzyFallback)
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		calls := 0
+		mockRepo := &mockSearchRepository{
+			searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+				calls++
+				return nil, 0, nil
+			},
+		}
+
+		useCase := NewSearchContentsUseCase(mockRepo, newMockSearchCache(), 60*time.Second)
+
+		result, err := useCase.Execute(context.Background(), port.SearchParams{Query: "golnag"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, calls)
+		assert.False(t, result.Meta.FuzzyFallback)
+	})
+}
+
+func TestSearchContentsUseCase_CursorPagination(t *testing.T) {
+	t.Run("next cursor generated for full popularity page", func(t *testing.T) {
+		mockRepo := &mockSearchRepository{
+			searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+				return []*entity.Content{
+					{ID: 7, Score: &entity.ContentScore{FinalScore: 150.5}},
+					{ID: 3, Score: &entity.ContentScore{FinalScore: 120.0}},
+				}, 5, nil
+			},
+		}
+
+		useCase := NewSearchContentsUseCase(mockRepo, newMockSearchCache(), 60*time.Second)
+
+		result, err := useCase.Execute(context.Background(), port.SearchParams{PageSize: 2})
+		require.NoError(t, err)
+		require.NotEmpty(t, result.Pagination.NextCursor)
+
+		cursor, err := port.DecodeSearchCursor(result.Pagination.NextCursor)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), cursor.ID)
+		assert.Equal(t, 120.0, cursor.Score)
+	})
+
+	t.Run("no next cursor on last page", func(t *testing.T) {
+		mockRepo := &mockSearchRepository{
+			searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+				return []*entity.Content{{ID: 1}}, 1, nil
+			},
+		}
+
+		useCase := NewSearchContentsUseCase(mockRepo, newMockSearchCache(), 60*time.Second)
+
+		result, err := useCase.Execute(context.Background(), port.SearchParams{PageSize: 2})
+		require.NoError(t, err)
+		assert.Empty(t, result.Pagination.NextCursor)
+	})
+
+	t.Run("cursor rejected for relevance sort", func(t *testing.T) {
+		useCase := NewSearchContentsUseCase(&mockSearchRepository{}, newMockSearchCache(), 60*time.Second)
+
+		params := port.SearchParams{
+			Query:  "test",
+			SortBy: "relevance",
+			Cursor: &port.SearchCursor{Score: 10, ID: 1},
+		}
+
+		_, err := useCase.Execute(context.Background(), params)
+		var validationErr *apperrors.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "cursor", validationErr.Field)
+	})
+}
+
+func TestSearchContentsUseCase_CountMode(t *testing.T) {
+	newUseCase := func(total int64, seen *port.SearchParams) *SearchContentsUseCase {
+		mockRepo := &mockSearchRepository{
+			searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+				*seen = params
+				return []*entity.Content{{ID: 1}}, total, nil
+			},
+		}
+		return NewSearchContentsUseCase(mockRepo, newMockSearchCache(), 60*time.Second)
+	}
+
+	t.Run("exact count by default", func(t *testing.T) {
+		var seen port.SearchParams
+		result, err := newUseCase(25000, &seen).Execute(context.Background(), port.SearchParams{})
+		require.NoError(t, err)
+		assert.Equal(t, 0, seen.CountLimit)
+		assert.Equal(t, int64(25000), result.Pagination.TotalItems)
+		assert.True(t, result.Pagination.ExactTotal)
+	})
+
+	t.Run("estimate caps large totals", func(t *testing.T) {
+		var seen port.SearchParams
+		result, err := newUseCase(estimatedCountLimit+1, &seen).Execute(context.Background(), port.SearchParams{CountMode: port.CountModeEstimate, PageSize: 20})
+		require.NoError(t, err)
+		assert.Equal(t, estimatedCountLimit, seen.CountLimit)
+		assert.Equal(t, int64(estimatedCountLimit), result.Pagination.TotalItems)
+		assert.Equal(t, int64(estimatedCountLimit/20), result.Pagination.TotalPages)
+		assert.False(t, result.Pagination.ExactTotal)
+	})
+
+	t.Run("estimate below limit is exact", func(t *testing.T) {
+		var seen port.SearchParams
+		result, err := newUseCase(42, &seen).Execute(context.Background(), port.SearchParams{CountMode: port.CountModeEstimate})
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), result.Pagination.TotalItems)
+		assert.True(t, result.Pagination.ExactTotal)
+	})
+
+	t.Run("invalid count mode", func(t *testing.T) {
+		var seen port.SearchParams
+		_, err := newUseCase(0, &seen).Execute(context.Background(), port.SearchParams{CountMode: "fast"})
+		var validationErr *apperrors.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "count", validationErr.Field)
+	})
+
+	t.Run("count mode is part of cache key", func(t *testing.T) {
+		useCase := newUseCase(0, &port.SearchParams{})
+		exact := port.SearchParams{Query: "go", Page: 1, PageSize: 20, SortBy: "popularity", CountMode: port.CountModeExact}
+		estimate := exact
+		estimate.CountMode = port.CountModeEstimate
+		assert.NotEqual(t, useCase.generateCacheKey(exact), useCase.generateCacheKey(estimate))
+	})
+}
+
+func TestSearchContentsUseCase_Language(t *testing.T) {
+	var seen port.SearchParams
+	mockRepo := &mockSearchRepository{
+		searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+			seen = params
+			return []*entity.Content{}, 0, nil
+		},
+	}
+	useCase := NewSearchContentsUseCase(mockRepo, newMockSearchCache(), 60*time.Second)
+
+	t.Run("normalizes language", func(t *testing.T) {
+		_, err := useCase.Execute(context.Background(), port.SearchParams{Query: "kitap", Language: " Turkish "})
+		require.NoError(t, err)
+		assert.Equal(t, entity.LanguageTurkish, seen.Language)
+	})
+
+	t.Run("rejects unsupported language", func(t *testing.T) {
+		_, err := useCase.Execute(context.Background(), port.SearchParams{Query: "kitap", Language: "latin"})
+		var validationErr *apperrors.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "lang", validationErr.Field)
+	})
+
+	t.Run("language is part of cache key", func(t *testing.T) {
+		all := port.SearchParams{Query: "kitap", Page: 1, PageSize: 20, SortBy: "popularity"}
+		turkish := all
+		turkish.Language = entity.LanguageTurkish
+		assert.NotEqual(t, useCase.generateCacheKey(all), useCase.generateCacheKey(turkish))
+	})
+}
+
 func TestSearchContentsUseCase_CacheKeyGeneration(t *testing.T) {
 	mockRepo := &mockSearchRepository{
 		searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
@@ -356,4 +809,318 @@ func TestSearchContentsUseCase_CacheKeyGeneration(t *testing.T) {
 
 	// Cache should have two entries
 	assert.Len(t, mockCache.storage, 2)
+
+	// Tag filter should be part of the cache key
+	params.Tags = []string{"golang"}
+	_, err = useCase.Execute(context.Background(), params)
+	require.NoError(t, err)
+	assert.Len(t, mockCache.storage, 3)
+
+	// Tag order should not produce a different key
+	params.Tags = []string{"tutorial", "golang"}
+	_, err = useCase.Execute(context.Background(), params)
+	require.NoError(t, err)
+	params.Tags = []string{"golang", "tutorial"}
+	_, err = useCase.Execute(context.Background(), params)
+	require.NoError(t, err)
+	assert.Len(t, mockCache.storage, 4)
+
+	// Collapsed results should not be served from the uncollapsed cache entry
+	params.CollapseDuplicates = true
+	_, err = useCase.Execute(context.Background(), params)
+	require.NoError(t, err)
+	assert.Len(t, mockCache.storage, 5)
+
+	// Author filter should be part of the cache key (case insensitive)
+	params.AuthorName = "Rob Pike"
+	_, err = useCase.Execute(context.Background(), params)
+	require.NoError(t, err)
+	params.AuthorName = " rob pike "
+	_, err = useCase.Execute(context.Background(), params)
+	require.NoError(t, err)
+	assert.Len(t, mockCache.storage, 6)
+
+	// Category filter is normalized before building the cache key
+	params.Category = "Programming > Go"
+	_, err = useCase.Execute(context.Background(), params)
+	require.NoError(t, err)
+	params.Category = "programming/go"
+	_, err = useCase.Execute(context.Background(), params)
+	require.NoError(t, err)
+	assert.Len(t, mockCache.storage, 7)
+}
+
+func TestSearchContentsUseCase_Explain(t *testing.T) {
+	searchCalls := 0
+	mockRepo := &mockSearchRepository{
+		searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+			searchCalls++
+			return []*entity.Content{
+				{ID: 1, ContentType: entity.ContentTypeVideo, PublishedAt: time.Now(), Stats: &entity.ContentStats{Views: 1000}},
+				{ID: 2, ContentType: entity.ContentTypeArticle, PublishedAt: time.Now()},
+			}, 2, nil
+		},
+	}
+
+	mockCache := newMockSearchCache()
+	useCase := NewSearchContentsUseCase(mockRepo, mockCache, 60*time.Second)
+	useCase.SetScoringService(service.NewScoringService(service.DefaultScoringRules()))
+
+	params := port.SearchParams{Query: "test", Explain: true}
+	result, err := useCase.Execute(context.Background(), params)
+	require.NoError(t, err)
+	require.NotNil(t, result.Items[0].Explanation)
+	assert.Equal(t, 1, result.Items[0].Explanation.Rank)
+	require.NotNil(t, result.Items[1].Explanation, "contents without stats should still get a rank")
+	assert.Equal(t, 2, result.Items[1].Explanation.Rank)
+
+	// Açıklamalar cache'e yazılmaz, explain'siz istek aynı kayıttan açıklamasız döner
+	require.Len(t, mockCache.storage, 1)
+	for _, data := range mockCache.storage {
+		assert.NotContains(t, string(data), "explanation")
+	}
+
+	params.Explain = false
+	result, err = useCase.Execute(context.Background(), params)
+	require.NoError(t, err)
+	assert.Equal(t, 1, searchCalls)
+	assert.Nil(t, result.Items[0].Explanation)
+
+	// Cache'ten dönen sonuçlara da açıklama eklenir
+	params.Explain = true
+	result, err = useCase.Execute(context.Background(), params)
+	require.NoError(t, err)
+	assert.Equal(t, 1, searchCalls)
+	require.NotNil(t, result.Items[0].Explanation)
+}
+
+// mockPromotionRepository tek bir sorgu için sabitleme döner
+type mockPromotionRepository struct {
+	port.PromotionRepository
+	promotion *entity.Promotion
+}
+
+func (m *mockPromotionRepository) FindPromotionByQuery(ctx context.Context, query string) (*entity.Promotion, error) {
+	if m.promotion != nil && m.promotion.Query == query {
+		return m.promotion, nil
+	}
+	return nil, nil
+}
+
+func TestSearchContentsUseCase_Pinned(t *testing.T) {
+	mockRepo := &mockSearchRepository{
+		searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+			return []*entity.Content{{ID: 1}, {ID: 2}, {ID: 3}}, 3, nil
+		},
+		findByID: func(ctx context.Context, id int64) (*entity.Content, error) {
+			if id == 99 {
+				return nil, port.ErrContentNotFound
+			}
+			return &entity.Content{ID: id}, nil
+		},
+	}
+
+	useCase := NewSearchContentsUseCase(mockRepo, newMockSearchCache(), 60*time.Second)
+	useCase.SetPromotionRepository(&mockPromotionRepository{
+		promotion: &entity.Promotion{Query: "go tutorial", ContentIDs: []int64{3, 99, 7}},
+	})
+
+	ids := func(items []*entity.Content) []int64 {
+		var result []int64
+		for _, c := range items {
+			result = append(result, c.ID)
+		}
+		return result
+	}
+
+	t.Run("pins contents on first page", func(t *testing.T) {
+		result, err := useCase.Execute(context.Background(), port.SearchParams{Query: "  Go   Tutorial "})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3, 7, 1, 2}, ids(result.Items), "deleted pins are skipped, pinned contents are not repeated")
+		assert.True(t, result.Items[0].Pinned)
+		assert.False(t, result.Items[2].Pinned)
+		assert.Equal(t, int64(3), result.Pagination.TotalItems)
+	})
+
+	t.Run("does not pin on later pages", func(t *testing.T) {
+		result, err := useCase.Execute(context.Background(), port.SearchParams{Query: "go tutorial", Page: 2})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1, 2, 3}, ids(result.Items))
+	})
+
+	t.Run("does not pin filtered searches", func(t *testing.T) {
+		result, err := useCase.Execute(context.Background(), port.SearchParams{Query: "go tutorial", ContentType: entity.ContentTypeVideo})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1, 2, 3}, ids(result.Items))
+	})
+
+	t.Run("does not pin other queries", func(t *testing.T) {
+		result, err := useCase.Execute(context.Background(), port.SearchParams{Query: "go"})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1, 2, 3}, ids(result.Items))
+	})
+}
+
+func TestSearchContentsUseCase_QueryStatsAndWarmUp(t *testing.T) {
+	t.Run("records first page queries only", func(t *testing.T) {
+		stats := &mockQueryStats{}
+		useCase := NewSearchContentsUseCase(&mockSearchRepository{}, newMockSearchCache(), 60*time.Second)
+		useCase.SetQueryStats(stats)
+
+		for _, params := range []port.SearchParams{
+			{Query: " golang "},
+			{Query: "golang", Page: 1},
+			{Query: "golang", Page: 2},
+			{Query: ""},
+		} {
+			_, err := useCase.Execute(context.Background(), params)
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, map[string]int{"golang": 2}, stats.counts)
+	})
+
+	t.Run("warm-up fills cache for top queries without counting them", func(t *testing.T) {
+		var searched []string
+		mockRepo := &mockSearchRepository{
+			searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+				searched = append(searched, params.Query)
+				return []*entity.Content{{ID: 1}}, 1, nil
+			},
+		}
+		cache := newMockSearchCache()
+		stats := &mockQueryStats{top: []string{"golang", "python", "rust"}}
+		useCase := NewSearchContentsUseCase(mockRepo, cache, 60*time.Second)
+		useCase.SetQueryStats(stats)
+
+		warmed, err := useCase.WarmUp(context.Background(), 2)
+		require.NoError(t, err)
+		assert.Equal(t, 2, warmed)
+		assert.Equal(t, []string{"golang", "python"}, searched)
+		assert.Len(t, cache.storage, 2)
+		assert.Empty(t, stats.counts)
+
+		// Isıtılan sorgu artık cache'den döner
+		_, err = useCase.Execute(context.Background(), port.SearchParams{Query: "golang"})
+		require.NoError(t, err)
+		assert.Len(t, searched, 2)
+	})
+
+	t.Run("warm-up disabled without query stats", func(t *testing.T) {
+		useCase := NewSearchContentsUseCase(&mockSearchRepository{}, newMockSearchCache(), 60*time.Second)
+
+		warmed, err := useCase.WarmUp(context.Background(), 10)
+		require.NoError(t, err)
+		assert.Zero(t, warmed)
+	})
+}
+
+func TestSearchContentsUseCase_CacheKeyVersion(t *testing.T) {
+	cache := newMockSearchCache()
+	useCase := NewSearchContentsUseCase(&mockSearchRepository{}, cache, 60*time.Second)
+
+	_, err := useCase.Execute(context.Background(), port.SearchParams{Query: "golang"})
+	require.NoError(t, err)
+
+	// Sürüm key'in başında olmalı ki invalidation deseni (search:*) eski ve yeni sürümleri kapsasın
+	require.Len(t, cache.storage, 1)
+	for key := range cache.storage {
+		assert.True(t, strings.HasPrefix(key, fmt.Sprintf("search:v%d:", cacheKeyVersion)), key)
+	}
+}
+
+func TestSearchContentsUseCase_ZeroResultQueries(t *testing.T) {
+	mockRepo := &mockSearchRepository{
+		searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+			if params.Query == "golang" {
+				return []*entity.Content{{ID: 1}}, 1, nil
+			}
+			return nil, 0, nil
+		},
+	}
+	zeroResults := &mockZeroResultQueryRepository{}
+	useCase := NewSearchContentsUseCase(mockRepo, newMockSearchCache(), 60*time.Second)
+	useCase.SetZeroResultQueries(zeroResults)
+
+	for _, params := range []port.SearchParams{
+		{Query: "  Kubernets  Operator "},
+		{Query: "kubernets operator", Page: 1},
+		{Query: "kubernets operator", Page: 2},
+		{Query: "kubernets operator", ContentType: "video"},
+		{Query: "golang"},
+		{Query: ""},
+	} {
+		_, err := useCase.Execute(context.Background(), params)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []string{"kubernets operator", "kubernets operator"}, zeroResults.recorded)
+}
+
+func TestSearchContentsUseCase_FeatureFlags(t *testing.T) {
+	t.Run("fuzzy fallback disabled by flag", func(t *testing.T) {
+		calls := 0
+		mockRepo := &mockSearchRepository{
+			searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+				calls++
+				return nil, 0, nil
+			},
+		}
+
+		useCase := NewSearchContentsUseCase(mockRepo, newMockSearchCache(), 60*time.Second)
+		useCase.SetFuzzyThreshold(0.3)
+		useCase.SetFeatureFlags(newMockFeatureFlags(map[string]bool{entity.FlagFuzzyFallback: false}))
+
+		result, err := useCase.Execute(context.Background(), port.SearchParams{Query: "golnag"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, calls)
+		assert.False(t, result.Meta.FuzzyFallback)
+	})
+
+	t.Run("hybrid sort falls back to relevance when disabled", func(t *testing.T) {
+		var got port.SearchParams
+		mockRepo := &mockSearchRepository{
+			searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+				got = params
+				return []*entity.Content{{ID: 1}}, 1, nil
+			},
+		}
+
+		useCase := NewSearchContentsUseCase(mockRepo, newMockSearchCache(), 60*time.Second)
+		flags := newMockFeatureFlags(map[string]bool{entity.FlagHybridRanking: false})
+		useCase.SetFeatureFlags(flags)
+
+		_, err := useCase.Execute(context.Background(), port.SearchParams{Query: "golang", SortBy: "hybrid"})
+		require.NoError(t, err)
+		assert.Equal(t, "relevance", got.SortBy)
+		assert.Zero(t, got.HybridRelevanceWeight)
+
+		flags.overrides[entity.FlagHybridRanking] = true
+		_, err = useCase.Execute(context.Background(), port.SearchParams{Query: "golang", SortBy: "hybrid"})
+		require.NoError(t, err)
+		assert.Equal(t, "hybrid", got.SortBy)
+		assert.Equal(t, defaultHybridRelevanceWeight, got.HybridRelevanceWeight)
+	})
+
+	t.Run("zero-result searches not cached without negative caching", func(t *testing.T) {
+		mockRepo := &mockSearchRepository{
+			searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+				if params.Query == "golang" {
+					return []*entity.Content{{ID: 1}}, 1, nil
+				}
+				return nil, 0, nil
+			},
+		}
+		cache := newMockSearchCache()
+		useCase := NewSearchContentsUseCase(mockRepo, cache, 60*time.Second)
+		useCase.SetFeatureFlags(newMockFeatureFlags(map[string]bool{entity.FlagNegativeCaching: false}))
+
+		_, err := useCase.Execute(context.Background(), port.SearchParams{Query: "kubernets"})
+		require.NoError(t, err)
+		assert.Empty(t, cache.storage)
+
+		_, err = useCase.Execute(context.Background(), port.SearchParams{Query: "golang"})
+		require.NoError(t, err)
+		assert.Len(t, cache.storage, 1)
+	})
 }
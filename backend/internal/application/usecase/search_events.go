@@ -0,0 +1,218 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// Arama olayı sınırları
+const (
+	maxSearchEventsPerRequest = 100
+	maxSearchEventQueryLen    = 100 // Arama sorgusu sınırıyla aynı
+	maxSearchEventSessionLen  = 64
+	defaultCTRLimit           = 50
+	maxCTRLimit               = 500
+	defaultCTRDays            = 7
+	maxCTRDays                = 90
+)
+
+// defaultBotUserAgents User-Agent'ında bu parçalardan biri geçen isteklerin olayları kaydedilmez
+var defaultBotUserAgents = []string{"bot", "crawler", "spider", "slurp", "headlesschrome", "lighthouse", "facebookexternalhit", "preview"}
+
+// SearchEventsUseCase arama sonuçlarının gösterim ve tıklama olaylarını kaydeder, CTR raporunu üretir
+// Bot trafiği User-Agent ile elenir; gösterimler yüksek hacimli olduğundan örneklenebilir
+type SearchEventsUseCase struct {
+	eventRepo port.SearchEventRepository
+	now       func() time.Time
+	random    func() float64
+
+	impressionSampleRate float64  // (0, 1]; 1 ise tüm gösterimler kaydedilir
+	botUserAgents        []string // Küçük harfli User-Agent parçaları
+}
+
+// SearchEventInput istemcinin gönderdiği tek bir olay
+type SearchEventInput struct {
+	Type      string `json:"type"`       // "impression" veya "click"
+	ContentID int64  `json:"content_id"` // Gösterilen/tıklanan içerik
+	Query     string `json:"query"`      // Sonuçları üreten arama sorgusu (opsiyonel)
+	Position  int    `json:"position"`   // 1 tabanlı sonuç sırası (opsiyonel)
+	SessionID string `json:"session_id"` // İstemcinin oturum kimliği (opsiyonel)
+}
+
+// RecordEventsInput tek istekte gönderilen olaylar
+// UserAgent boşsa isteğin User-Agent header'ı kullanılır; olayları sunucudan ileten istemciler son kullanıcınınkini gönderir
+type RecordEventsInput struct {
+	UserAgent string             `json:"user_agent"`
+	Events    []SearchEventInput `json:"events"`
+}
+
+// RecordEventsResult kaydedilen, örneklemeyle atlanan ve yok sayılan olay sayıları
+type RecordEventsResult struct {
+	Accepted   int `json:"accepted"`
+	SampledOut int `json:"sampled_out"`
+	Ignored    int `json:"ignored"` // Bot trafiği veya bilinmeyen içerik
+}
+
+// ContentCTRResult CTR raporu
+type ContentCTRResult struct {
+	Since    time.Time            `json:"since"`
+	Query    string               `json:"query,omitempty"`
+	Contents []*entity.ContentCTR `json:"contents"`
+}
+
+// NewSearchEventsUseCase yeni bir arama olayı use case oluşturur
+func NewSearchEventsUseCase(eventRepo port.SearchEventRepository) *SearchEventsUseCase {
+	return &SearchEventsUseCase{
+		eventRepo:            eventRepo,
+		now:                  time.Now,
+		random:               rand.Float64,
+		impressionSampleRate: 1,
+		botUserAgents:        defaultBotUserAgents,
+	}
+}
+
+// SetImpressionSampleRate kaydedilecek gösterim oranını ayarlar; tıklamalar her zaman kaydedilir
+// Kaydedilen gösterimler 1/rate ağırlığıyla saklanır, böylece CTR örneklemeden etkilenmez
+func (uc *SearchEventsUseCase) SetImpressionSampleRate(rate float64) {
+	if rate > 0 && rate <= 1 {
+		uc.impressionSampleRate = rate
+	}
+}
+
+// SetBotUserAgents bot kabul edilecek User-Agent parçalarını ayarlar; boşsa varsayılan liste kalır
+func (uc *SearchEventsUseCase) SetBotUserAgents(patterns []string) {
+	normalized := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		if pattern = strings.ToLower(strings.TrimSpace(pattern)); pattern != "" {
+			normalized = append(normalized, pattern)
+		}
+	}
+	if len(normalized) > 0 {
+		uc.botUserAgents = normalized
+	}
+}
+
+// Record olayları doğrular ve kaydeder
+// Geçersiz tek bir olay tüm isteği reddeder; bot trafiği hata dönmeden yok sayılır
+func (uc *SearchEventsUseCase) Record(ctx context.Context, apiKeyID int64, input RecordEventsInput) (*RecordEventsResult, error) {
+	if len(input.Events) == 0 || len(input.Events) > maxSearchEventsPerRequest {
+		return nil, apperrors.NewValidationError("events", fmt.Sprintf("events must contain between 1 and %d items", maxSearchEventsPerRequest), len(input.Events))
+	}
+	events := make([]*entity.SearchEvent, 0, len(input.Events))
+	for i, in := range input.Events {
+		event, err := buildSearchEvent(i, in)
+		if err != nil {
+			return nil, err
+		}
+		event.APIKeyID = apiKeyID
+		events = append(events, event)
+	}
+
+	result := &RecordEventsResult{}
+	if uc.isBot(input.UserAgent) {
+		result.Ignored = len(events)
+		return result, nil
+	}
+
+	sampled := events[:0]
+	for _, event := range events {
+		if event.Type == entity.SearchEventImpression && uc.impressionSampleRate < 1 {
+			if uc.random() >= uc.impressionSampleRate {
+				result.SampledOut++
+				continue
+			}
+			event.SampleWeight = 1 / uc.impressionSampleRate
+		}
+		sampled = append(sampled, event)
+	}
+	if len(sampled) == 0 {
+		return result, nil
+	}
+
+	inserted, err := uc.eventRepo.InsertSearchEvents(ctx, sampled)
+	if err != nil {
+		return nil, fmt.Errorf("arama olayları kaydedilemedi: %w", err)
+	}
+	result.Accepted = inserted
+	result.Ignored = len(sampled) - inserted
+
+	return result, nil
+}
+
+// ContentCTR son days gündeki en çok gösterilen içeriklerin CTR'ını döner
+// days 0 ise 7 gün, limit 0 ise 50 içerik kullanılır
+func (uc *SearchEventsUseCase) ContentCTR(ctx context.Context, query string, days, limit int) (*ContentCTRResult, error) {
+	if days == 0 {
+		days = defaultCTRDays
+	}
+	if days < 1 || days > maxCTRDays {
+		return nil, apperrors.NewValidationError("days", fmt.Sprintf("days must be between 1 and %d", maxCTRDays), days)
+	}
+	if limit == 0 {
+		limit = defaultCTRLimit
+	}
+	if limit < 1 || limit > maxCTRLimit {
+		return nil, apperrors.NewValidationError("limit", fmt.Sprintf("limit must be between 1 and %d", maxCTRLimit), limit)
+	}
+
+	result := &ContentCTRResult{
+		Since: uc.now().AddDate(0, 0, -days),
+		Query: normalizePromotionQuery(query),
+	}
+	contents, err := uc.eventRepo.ListContentCTR(ctx, result.Since, result.Query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("CTR raporu okunamadı: %w", err)
+	}
+	result.Contents = contents
+
+	return result, nil
+}
+
+// isBot User-Agent'ın bilinen bot parçalarından birini içerip içermediğini döner
+func (uc *SearchEventsUseCase) isBot(userAgent string) bool {
+	userAgent = strings.ToLower(userAgent)
+	for _, pattern := range uc.botUserAgents {
+		if strings.Contains(userAgent, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// buildSearchEvent i. olayı doğrular; sorgu sabitlemelerle aynı şekilde normalize edilir
+func buildSearchEvent(i int, in SearchEventInput) (*entity.SearchEvent, error) {
+	field := func(name string) string { return fmt.Sprintf("events[%d].%s", i, name) }
+
+	if in.Type != entity.SearchEventImpression && in.Type != entity.SearchEventClick {
+		return nil, apperrors.NewValidationError(field("type"), "invalid event type (must be 'impression' or 'click')", in.Type)
+	}
+	if in.ContentID <= 0 {
+		return nil, apperrors.NewValidationError(field("content_id"), "content_id must be a positive integer", in.ContentID)
+	}
+	if in.Position < 0 {
+		return nil, apperrors.NewValidationError(field("position"), "position must be a positive integer", in.Position)
+	}
+	query := normalizePromotionQuery(in.Query)
+	if len(query) > maxSearchEventQueryLen {
+		return nil, apperrors.NewValidationError(field("query"), fmt.Sprintf("query must be at most %d characters", maxSearchEventQueryLen), in.Query)
+	}
+	if len(in.SessionID) > maxSearchEventSessionLen {
+		return nil, apperrors.NewValidationError(field("session_id"), fmt.Sprintf("session_id must be at most %d characters", maxSearchEventSessionLen), in.SessionID)
+	}
+
+	return &entity.SearchEvent{
+		Type:         in.Type,
+		ContentID:    in.ContentID,
+		Query:        query,
+		Position:     in.Position,
+		SessionID:    in.SessionID,
+		SampleWeight: 1,
+	}, nil
+}
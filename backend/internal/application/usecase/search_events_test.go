@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+)
+
+// mockSearchEventRepository eklenen olayları kaydeder; unknown ID'li içerikler atlanır
+type mockSearchEventRepository struct {
+	events  []*entity.SearchEvent
+	unknown map[int64]bool
+
+	ctrSince time.Time
+	ctrQuery string
+	ctrLimit int
+}
+
+func (m *mockSearchEventRepository) InsertSearchEvents(ctx context.Context, events []*entity.SearchEvent) (int, error) {
+	inserted := 0
+	for _, event := range events {
+		if m.unknown[event.ContentID] {
+			continue
+		}
+		m.events = append(m.events, event)
+		inserted++
+	}
+	return inserted, nil
+}
+
+func (m *mockSearchEventRepository) ListContentCTR(ctx context.Context, since time.Time, query string, limit int) ([]*entity.ContentCTR, error) {
+	m.ctrSince, m.ctrQuery, m.ctrLimit = since, query, limit
+	return []*entity.ContentCTR{{ContentID: 1, Impressions: 10, Clicks: 2, CTR: 0.2}}, nil
+}
+
+func TestSearchEventsUseCase_Record(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("records normalized events", func(t *testing.T) {
+		repo := &mockSearchEventRepository{unknown: map[int64]bool{99: true}}
+		useCase := NewSearchEventsUseCase(repo)
+
+		result, err := useCase.Record(ctx, 7, RecordEventsInput{
+			UserAgent: "Mozilla/5.0 (X11; Linux x86_64) Firefox/120.0",
+			Events: []SearchEventInput{
+				{Type: entity.SearchEventImpression, ContentID: 1, Query: " Go  Concurrency ", Position: 1},
+				{Type: entity.SearchEventClick, ContentID: 1, Query: "go concurrency", Position: 1, SessionID: "s1"},
+				{Type: entity.SearchEventClick, ContentID: 99},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, &RecordEventsResult{Accepted: 2, Ignored: 1}, result)
+
+		require.Len(t, repo.events, 2)
+		assert.Equal(t, "go concurrency", repo.events[0].Query)
+		assert.Equal(t, int64(7), repo.events[0].APIKeyID)
+		assert.Equal(t, 1.0, repo.events[0].SampleWeight)
+	})
+
+	t.Run("ignores bot traffic", func(t *testing.T) {
+		repo := &mockSearchEventRepository{}
+		useCase := NewSearchEventsUseCase(repo)
+
+		result, err := useCase.Record(ctx, 0, RecordEventsInput{
+			UserAgent: "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+			Events:    []SearchEventInput{{Type: entity.SearchEventClick, ContentID: 1}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Ignored)
+		assert.Empty(t, repo.events)
+
+		useCase.SetBotUserAgents([]string{" Internal-Monitor "})
+		result, err = useCase.Record(ctx, 0, RecordEventsInput{
+			UserAgent: "internal-monitor/1.0",
+			Events:    []SearchEventInput{{Type: entity.SearchEventClick, ContentID: 1}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Ignored)
+	})
+
+	t.Run("samples impressions and weights the kept ones", func(t *testing.T) {
+		repo := &mockSearchEventRepository{}
+		useCase := NewSearchEventsUseCase(repo)
+		useCase.SetImpressionSampleRate(0.25)
+		rolls := []float64{0.1, 0.9}
+		useCase.random = func() float64 {
+			roll := rolls[0]
+			rolls = rolls[1:]
+			return roll
+		}
+
+		result, err := useCase.Record(ctx, 0, RecordEventsInput{Events: []SearchEventInput{
+			{Type: entity.SearchEventImpression, ContentID: 1},
+			{Type: entity.SearchEventImpression, ContentID: 2},
+			{Type: entity.SearchEventClick, ContentID: 2},
+		}})
+		require.NoError(t, err)
+		assert.Equal(t, &RecordEventsResult{Accepted: 2, SampledOut: 1}, result)
+
+		require.Len(t, repo.events, 2)
+		assert.Equal(t, 4.0, repo.events[0].SampleWeight)
+		assert.Equal(t, entity.SearchEventClick, repo.events[1].Type)
+		assert.Equal(t, 1.0, repo.events[1].SampleWeight, "clicks are never sampled")
+	})
+
+	t.Run("rejects invalid events", func(t *testing.T) {
+		useCase := NewSearchEventsUseCase(&mockSearchEventRepository{})
+		tooMany := make([]SearchEventInput, maxSearchEventsPerRequest+1)
+
+		tests := []struct {
+			name   string
+			events []SearchEventInput
+			field  string
+		}{
+			{"empty batch", nil, "events"},
+			{"too many events", tooMany, "events"},
+			{"invalid type", []SearchEventInput{{Type: "view", ContentID: 1}}, "events[0].type"},
+			{"missing content", []SearchEventInput{{Type: entity.SearchEventClick}, {Type: entity.SearchEventClick, ContentID: 1}}, "events[0].content_id"},
+			{"negative position", []SearchEventInput{{Type: entity.SearchEventClick, ContentID: 1}, {Type: entity.SearchEventClick, ContentID: 1, Position: -1}}, "events[1].position"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := useCase.Record(ctx, 0, RecordEventsInput{Events: tt.events})
+				var validationErr *apperrors.ValidationError
+				require.ErrorAs(t, err, &validationErr)
+				assert.Equal(t, tt.field, validationErr.Field)
+			})
+		}
+	})
+}
+
+func TestSearchEventsUseCase_ContentCTR(t *testing.T) {
+	repo := &mockSearchEventRepository{}
+	useCase := NewSearchEventsUseCase(repo)
+	now := time.Date(2024, 1, 20, 12, 0, 0, 0, time.UTC)
+	useCase.now = func() time.Time { return now }
+
+	result, err := useCase.ContentCTR(context.Background(), " GoLang ", 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, now.AddDate(0, 0, -defaultCTRDays), repo.ctrSince)
+	assert.Equal(t, "golang", repo.ctrQuery)
+	assert.Equal(t, defaultCTRLimit, repo.ctrLimit)
+	require.Len(t, result.Contents, 1)
+
+	_, err = useCase.ContentCTR(context.Background(), "", maxCTRDays+1, 0)
+	var validationErr *apperrors.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "days", validationErr.Field)
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// defaultExportMaxRows SetExportMaxRows çağrılmadıysa dışa aktarma başına en fazla satır
+const defaultExportMaxRows = 100000
+
+// exportBatchSize dışa aktarmada tek sorguda okunan içerik sayısı
+const exportBatchSize = 500
+
+// SetExportMaxRows tek bir dışa aktarmada yazılabilecek en fazla içerik sayısını ayarlar
+func (uc *SearchContentsUseCase) SetExportMaxRows(maxRows int) {
+	uc.exportMaxRows = maxRows
+}
+
+// ExportMaxRows dışa aktarmanın satır limitini döner
+func (uc *SearchContentsUseCase) ExportMaxRows() int {
+	if uc.exportMaxRows <= 0 {
+		return defaultExportMaxRows
+	}
+	return uc.exportMaxRows
+}
+
+// Export aramaya uyan tüm içerikleri (tek sayfa değil) popularity sırasıyla fn'e verir; yazılan içerik sayısını döner
+// Sonuçlar keyset cursor ile exportBatchSize'lık parçalar halinde okunduğundan bellek kullanımı sonuç sayısından
+// bağımsızdır. limit <= 0 veya satır limitinden büyükse satır limiti kullanılır.
+// Cache, sorgu istatistikleri ve sabitlemeler kullanılmaz; fn hata dönerse dışa aktarma durur
+func (uc *SearchContentsUseCase) Export(ctx context.Context, params port.SearchParams, limit int, fn func(*entity.Content) error) (written int, err error) {
+	ctx, span := tracer.Start(ctx, "SearchContentsUseCase.Export")
+	defer func() { endSpan(span, err) }()
+
+	// Cursor sadece popularity sıralamasında tutarlıdır
+	if params.SortBy != "" && params.SortBy != "popularity" {
+		return 0, apperrors.NewValidationError("sort", "export only supports popularity sort", params.SortBy)
+	}
+	if err := uc.validateParams(ctx, &params); err != nil {
+		return 0, err
+	}
+	if maxRows := uc.ExportMaxRows(); limit <= 0 || limit > maxRows {
+		limit = maxRows
+	}
+
+	// Toplam sayı kullanılmaz; sayım her parçada en fazla bir kayda bakar
+	params.CountLimit = 1
+	params.IncludeFacets = false
+	params.Explain = false
+
+	fuzzyChecked := uc.fuzzyThreshold <= 0 || strings.TrimSpace(params.Query) == ""
+	for written < limit {
+		params.PageSize = min(exportBatchSize, limit-written)
+
+		contents, _, err := uc.contentRepo.Search(ctx, params)
+		if err != nil {
+			return written, fmt.Errorf("dışa aktarma hatası: %w", err)
+		}
+
+		// FTS hiç sonuç bulamadıysa aramadaki gibi trigram benzerliğiyle tekrar dene
+		if !fuzzyChecked {
+			fuzzyChecked = true
+			if len(contents) == 0 {
+				params.FuzzyThreshold = uc.fuzzyThreshold
+				continue
+			}
+		}
+
+		for _, content := range contents {
+			if err := fn(content); err != nil {
+				return written, err
+			}
+			written++
+		}
+
+		if len(contents) < params.PageSize {
+			break
+		}
+		cursor := nextCursor(contents[len(contents)-1])
+		params.Cursor = &cursor
+	}
+
+	return written, nil
+}
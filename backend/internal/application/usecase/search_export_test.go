@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// keysetSearchRepository popularity sıralı n içerik üzerinde keyset pagination yapan mock repository
+func keysetSearchRepository(n int, calls *[]port.SearchParams) *mockSearchRepository {
+	return &mockSearchRepository{
+		searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+			*calls = append(*calls, params)
+
+			var page []*entity.Content
+			for id := int64(n); id >= 1 && len(page) < params.PageSize; id-- {
+				if params.Cursor != nil && id >= params.Cursor.ID {
+					continue
+				}
+				page = append(page, &entity.Content{ID: id, Score: &entity.ContentScore{FinalScore: float64(id)}})
+			}
+			return page, int64(n), nil
+		},
+	}
+}
+
+func TestSearchContentsUseCase_Export(t *testing.T) {
+	t.Run("streams all matches in batches with a cursor", func(t *testing.T) {
+		var calls []port.SearchParams
+		useCase := NewSearchContentsUseCase(keysetSearchRepository(1200, &calls), newMockSearchCache(), 60*time.Second)
+
+		var ids []int64
+		written, err := useCase.Export(context.Background(), port.SearchParams{Query: "go", PageSize: 20}, 0, func(c *entity.Content) error {
+			ids = append(ids, c.ID)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1200, written)
+		require.Len(t, ids, 1200)
+		assert.Equal(t, int64(1200), ids[0])
+		assert.Equal(t, int64(1), ids[1199])
+
+		require.Len(t, calls, 3)
+		assert.Nil(t, calls[0].Cursor)
+		assert.Equal(t, exportBatchSize, calls[0].PageSize)
+		assert.Equal(t, 1, calls[0].CountLimit)
+		require.NotNil(t, calls[2].Cursor)
+		assert.Equal(t, int64(201), calls[2].Cursor.ID)
+	})
+
+	t.Run("row cap and requested limit", func(t *testing.T) {
+		var calls []port.SearchParams
+		useCase := NewSearchContentsUseCase(keysetSearchRepository(1200, &calls), newMockSearchCache(), 60*time.Second)
+		useCase.SetExportMaxRows(700)
+
+		written, err := useCase.Export(context.Background(), port.SearchParams{}, 0, func(*entity.Content) error { return nil })
+		require.NoError(t, err)
+		assert.Equal(t, 700, written)
+		assert.Equal(t, 200, calls[len(calls)-1].PageSize)
+
+		written, err = useCase.Export(context.Background(), port.SearchParams{}, 10, func(*entity.Content) error { return nil })
+		require.NoError(t, err)
+		assert.Equal(t, 10, written)
+	})
+
+	t.Run("falls back to trigram search", func(t *testing.T) {
+		var calls []port.SearchParams
+		mockRepo := &mockSearchRepository{
+			searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+				calls = append(calls, params)
+				if params.FuzzyThreshold > 0 {
+					return []*entity.Content{{ID: 1, Title: "Golang Tutorial"}}, 1, nil
+				}
+				return nil, 0, nil
+			},
+		}
+		useCase := NewSearchContentsUseCase(mockRepo, newMockSearchCache(), 60*time.Second)
+		useCase.SetFuzzyThreshold(0.3)
+
+		written, err := useCase.Export(context.Background(), port.SearchParams{Query: "golnag"}, 0, func(*entity.Content) error { return nil })
+		require.NoError(t, err)
+		assert.Equal(t, 1, written)
+		require.Len(t, calls, 2)
+		assert.Equal(t, 0.3, calls[1].FuzzyThreshold)
+	})
+
+	t.Run("rejects non popularity sort", func(t *testing.T) {
+		useCase := NewSearchContentsUseCase(&mockSearchRepository{}, newMockSearchCache(), 60*time.Second)
+
+		_, err := useCase.Export(context.Background(), port.SearchParams{SortBy: "relevance"}, 0, func(*entity.Content) error { return nil })
+		var validationErr *apperrors.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "sort", validationErr.Field)
+	})
+
+	t.Run("writer error stops the export", func(t *testing.T) {
+		var calls []port.SearchParams
+		useCase := NewSearchContentsUseCase(keysetSearchRepository(1200, &calls), newMockSearchCache(), 60*time.Second)
+		errClosed := errors.New("connection closed")
+
+		written, err := useCase.Export(context.Background(), port.SearchParams{}, 0, func(c *entity.Content) error {
+			if c.ID == 1000 {
+				return errClosed
+			}
+			return nil
+		})
+		assert.ErrorIs(t, err, errClosed)
+		assert.Equal(t, 200, written)
+		assert.Len(t, calls, 1)
+	})
+}
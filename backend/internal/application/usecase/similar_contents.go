@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+const (
+	// defaultSimilarLimit limit verilmediğinde döndürülecek benzer içerik sayısı
+	defaultSimilarLimit = 10
+	// maxSimilarLimit döndürülebilecek en fazla benzer içerik sayısı
+	maxSimilarLimit = 50
+)
+
+// SimilarContentsUseCase benzer içerik use case'i
+type SimilarContentsUseCase struct {
+	contentRepo port.ContentRepository
+	cache       port.CacheRepository
+	cacheTTL    atomic.Int64 // time.Duration, SetCacheTTL ile çalışma anında değişebilir
+}
+
+// SimilarResult benzer içerik sonucu yapısı
+type SimilarResult struct {
+	Items []*entity.Content `json:"items"`
+}
+
+// NewSimilarContentsUseCase yeni bir benzer içerik use case oluşturur
+func NewSimilarContentsUseCase(
+	contentRepo port.ContentRepository,
+	cache port.CacheRepository,
+	cacheTTL time.Duration,
+) *SimilarContentsUseCase {
+	uc := &SimilarContentsUseCase{
+		contentRepo: contentRepo,
+		cache:       cache,
+	}
+	uc.cacheTTL.Store(int64(cacheTTL))
+	return uc
+}
+
+// SetCacheTTL sonuçların cache'te tutulma süresini çalışma anında değiştirir; mevcut kayıtlar eski sürelerini korur
+func (uc *SimilarContentsUseCase) SetCacheTTL(ttl time.Duration) {
+	uc.cacheTTL.Store(int64(ttl))
+}
+
+// Execute verilen içeriğe benzeyen içerikleri getirir
+// İçerik bulunamazsa port.ErrContentNotFound döner
+func (uc *SimilarContentsUseCase) Execute(ctx context.Context, contentID int64, limit int) (*SimilarResult, error) {
+	// Limit varsayılan ve maksimum kontrol
+	if limit < 1 {
+		limit = defaultSimilarLimit
+	}
+	if limit > maxSimilarLimit {
+		limit = maxSimilarLimit
+	}
+
+	cacheKey := fmt.Sprintf("%s%d:%d", versionedCacheKey(similarCacheKeyPrefix), contentID, limit)
+
+	// Cache'den kontrol et
+	if cached, err := uc.cache.Get(ctx, cacheKey); err == nil {
+		var result SimilarResult
+		if err := json.Unmarshal(cached, &result); err == nil {
+			return &result, nil
+		}
+	}
+
+	contents, err := uc.contentRepo.FindSimilar(ctx, contentID, limit)
+	if err != nil {
+		if err == port.ErrContentNotFound {
+			return nil, err
+		}
+		return nil, fmt.Errorf("benzer içerik hatası: %w", err)
+	}
+
+	if contents == nil {
+		contents = make([]*entity.Content, 0)
+	}
+	result := &SimilarResult{Items: contents}
+
+	// Cache'e kaydet (hata kritik değil)
+	if data, err := json.Marshal(result); err == nil {
+		_ = uc.cache.Set(ctx, cacheKey, data, time.Duration(uc.cacheTTL.Load()))
+	}
+
+	return result, nil
+}
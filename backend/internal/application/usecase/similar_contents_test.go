@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+func TestSimilarContentsUseCase_Execute(t *testing.T) {
+	t.Run("returns similar contents and caches them", func(t *testing.T) {
+		calls := 0
+		mockRepo := &mockSearchRepository{
+			similarFunc: func(ctx context.Context, contentID int64, limit int) ([]*entity.Content, error) {
+				calls++
+				assert.Equal(t, int64(1), contentID)
+				assert.Equal(t, 5, limit)
+				return []*entity.Content{{ID: 2, Title: "Related"}}, nil
+			},
+		}
+		mockCache := newMockSearchCache()
+		useCase := NewSimilarContentsUseCase(mockRepo, mockCache, 60*time.Second)
+
+		result, err := useCase.Execute(context.Background(), 1, 5)
+		require.NoError(t, err)
+		require.Len(t, result.Items, 1)
+		assert.Equal(t, int64(2), result.Items[0].ID)
+
+		// İkinci çağrı cache'den gelmeli
+		result, err = useCase.Execute(context.Background(), 1, 5)
+		require.NoError(t, err)
+		assert.Len(t, result.Items, 1)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("applies default and max limit", func(t *testing.T) {
+		var limits []int
+		mockRepo := &mockSearchRepository{
+			similarFunc: func(ctx context.Context, contentID int64, limit int) ([]*entity.Content, error) {
+				limits = append(limits, limit)
+				return nil, nil
+			},
+		}
+		useCase := NewSimilarContentsUseCase(mockRepo, newMockSearchCache(), 60*time.Second)
+
+		result, err := useCase.Execute(context.Background(), 1, 0)
+		require.NoError(t, err)
+		assert.NotNil(t, result.Items)
+
+		_, err = useCase.Execute(context.Background(), 1, 500)
+		require.NoError(t, err)
+
+		assert.Equal(t, []int{defaultSimilarLimit, maxSimilarLimit}, limits)
+	})
+
+	t.Run("content not found", func(t *testing.T) {
+		mockRepo := &mockSearchRepository{
+			similarFunc: func(ctx context.Context, contentID int64, limit int) ([]*entity.Content, error) {
+				return nil, port.ErrContentNotFound
+			},
+		}
+		useCase := NewSimilarContentsUseCase(mockRepo, newMockSearchCache(), 60*time.Second)
+
+		_, err := useCase.Execute(context.Background(), 42, 10)
+		assert.ErrorIs(t, err, port.ErrContentNotFound)
+	})
+}
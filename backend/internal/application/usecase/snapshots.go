@@ -0,0 +1,610 @@
+package usecase
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/logger"
+)
+
+// ErrSnapshotRunning başka bir yedek alma veya geri yükleme sürerken yenisi başlatılmak istendiğinde döner
+var ErrSnapshotRunning = errors.New("snapshot operation already running")
+
+// snapshotFormatVersion yedek dosyası biçiminin sürümü; header kaydında yazılır ve geri yüklemede kontrol edilir
+const snapshotFormatVersion = 1
+
+// snapshotFileExtension yedek dosyalarının uzantısı (gzip sıkıştırılmış NDJSON)
+const snapshotFileExtension = ".ndjson.gz"
+
+// Yedek dosyasındaki kayıt türleri; dosyada bu sırayla yazılırlar
+const (
+	snapshotRecordHeader   = "header"
+	snapshotRecordProvider = "provider"
+	snapshotRecordCategory = "category"
+	snapshotRecordAuthor   = "author"
+	snapshotRecordContent  = "content"
+)
+
+// snapshotRecord yedek dosyasının tek satırı; Type'a göre sadece ilgili alan doludur
+// ID'ler kaynak veritabanına aittir, geri yüklemede hedef veritabanının ID'lerine eşlenir
+type snapshotRecord struct {
+	Type      string           `json:"type"`
+	Version   int              `json:"version,omitempty"`    // Sadece header
+	CreatedAt *time.Time       `json:"created_at,omitempty"` // Sadece header
+	Provider  *entity.Provider `json:"provider,omitempty"`
+	Category  *entity.Category `json:"category,omitempty"`
+	Author    *entity.Author   `json:"author,omitempty"`
+	Content   *entity.Content  `json:"content,omitempty"`
+}
+
+// SnapshotUseCase içerik yedeği alma ve yedekten geri yükleme use case'i
+// Yedek; provider'ları, kategorileri, yazarları ve silinmemiş içerikleri (istatistik, skor ve tag'leriyle)
+// gzip sıkıştırılmış NDJSON olarak depolama alanına (yerel dizin, S3, GCS) yazar.
+// Geri yükleme upsert ile yapıldığından aynı yedek tekrar yüklenebilir; felaket kurtarma ve
+// yeni ortamları veriyle doldurmak için kullanılır
+type SnapshotUseCase struct {
+	snapshotRepo port.SnapshotRepository
+	providerRepo port.ProviderRepository
+	categoryRepo port.CategoryRepository
+	authorRepo   port.AuthorRepository
+	contentRepo  port.ContentRepository
+	store        port.SnapshotStore
+	cache        port.CacheRepository
+	indexer      SearchIndexer   // nil ise geri yüklemeden sonra arama indeksi yeniden oluşturulmaz
+	transactor   port.Transactor // nil ise içerik batch'leri transaction'sız yazılır
+	batchSize    int
+
+	mu      sync.Mutex
+	running bool
+	lastRun *entity.SnapshotRun
+
+	now func() time.Time
+}
+
+// NewSnapshotUseCase yeni bir içerik yedeği use case oluşturur
+func NewSnapshotUseCase(
+	snapshotRepo port.SnapshotRepository,
+	providerRepo port.ProviderRepository,
+	categoryRepo port.CategoryRepository,
+	authorRepo port.AuthorRepository,
+	contentRepo port.ContentRepository,
+	store port.SnapshotStore,
+	cache port.CacheRepository,
+) *SnapshotUseCase {
+	return &SnapshotUseCase{
+		snapshotRepo: snapshotRepo,
+		providerRepo: providerRepo,
+		categoryRepo: categoryRepo,
+		authorRepo:   authorRepo,
+		contentRepo:  contentRepo,
+		store:        store,
+		cache:        cache,
+		batchSize:    syncBatchSize,
+		now:          time.Now,
+	}
+}
+
+// SetSearchIndexer geri yüklemeden sonra arama indeksini yeniden oluşturacak indeksleyiciyi ayarlar
+func (uc *SnapshotUseCase) SetSearchIndexer(indexer SearchIndexer) {
+	uc.indexer = indexer
+}
+
+// SetTransactor geri yüklenen her içerik batch'ini transaction içinde yazacak transactor'ı ayarlar
+func (uc *SnapshotUseCase) SetTransactor(transactor port.Transactor) {
+	uc.transactor = transactor
+}
+
+// List depolama alanındaki yedekleri döner
+func (uc *SnapshotUseCase) List(ctx context.Context) ([]*entity.SnapshotInfo, error) {
+	return uc.store.List(ctx)
+}
+
+// LastRun son (veya süren) yedek alma / geri yükleme çalışmasını döner; hiç çalışmadıysa nil
+func (uc *SnapshotUseCase) LastRun() *entity.SnapshotRun {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	if uc.lastRun == nil {
+		return nil
+	}
+	run := *uc.lastRun
+	return &run
+}
+
+// Export yedeği senkron olarak alır ve tamamlanan çalışmayı döner (zamanlanmış yedekleme için)
+func (uc *SnapshotUseCase) Export(ctx context.Context) (*entity.SnapshotRun, error) {
+	run, err := uc.start(entity.SnapshotOperationExport, uc.newSnapshotName())
+	if err != nil {
+		return nil, err
+	}
+	err = uc.export(ctx, run)
+	return uc.finish(run, err), err
+}
+
+// StartExport yedek almayı arka planda başlatır ve "running" durumundaki çalışmayı hemen döner
+// Durum LastRun ile takip edilir
+func (uc *SnapshotUseCase) StartExport() (*entity.SnapshotRun, error) {
+	run, err := uc.start(entity.SnapshotOperationExport, uc.newSnapshotName())
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		// İstek bittikten sonra da devam etmeli
+		if err := uc.export(context.Background(), run); err != nil {
+			logger.Error("snapshot export failed", zap.String("snapshot", run.Name), zap.Error(err))
+		}
+		uc.finish(run, nil)
+	}()
+
+	return uc.LastRun(), nil
+}
+
+// StartImport name adlı yedeğin geri yüklenmesini arka planda başlatır
+// Yedek yoksa port.ErrSnapshotNotFound hemen döner; durum LastRun ile takip edilir
+func (uc *SnapshotUseCase) StartImport(ctx context.Context, name string) (*entity.SnapshotRun, error) {
+	if err := validateSnapshotName(name); err != nil {
+		return nil, err
+	}
+
+	run, err := uc.start(entity.SnapshotOperationImport, name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Gövde istek bittikten sonra okunacağından iptal edilmeyen context ile açılır
+	r, err := uc.store.Get(context.WithoutCancel(ctx), name)
+	if err != nil {
+		uc.finish(run, err)
+		return nil, err
+	}
+
+	background := logger.NewContext(context.Background(), logger.FromContext(ctx))
+	go func() {
+		defer r.Close()
+		if err := uc.importSnapshot(background, run, r); err != nil {
+			logger.FromContext(background).Error("snapshot import failed", zap.String("snapshot", run.Name), zap.Error(err))
+		}
+		uc.finish(run, nil)
+	}()
+
+	return uc.LastRun(), nil
+}
+
+// Import name adlı yedeği senkron olarak geri yükler ve tamamlanan çalışmayı döner
+func (uc *SnapshotUseCase) Import(ctx context.Context, name string) (*entity.SnapshotRun, error) {
+	if err := validateSnapshotName(name); err != nil {
+		return nil, err
+	}
+
+	run, err := uc.start(entity.SnapshotOperationImport, name)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := uc.store.Get(ctx, name)
+	if err != nil {
+		uc.finish(run, err)
+		return nil, err
+	}
+	defer r.Close()
+
+	err = uc.importSnapshot(ctx, run, r)
+	return uc.finish(run, err), err
+}
+
+// start yeni bir çalışmayı "running" olarak kaydeder; başka bir çalışma sürüyorsa ErrSnapshotRunning döner
+func (uc *SnapshotUseCase) start(operation, name string) (*entity.SnapshotRun, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	if uc.running {
+		return nil, ErrSnapshotRunning
+	}
+	uc.running = true
+	uc.lastRun = &entity.SnapshotRun{
+		Operation: operation,
+		Name:      name,
+		Status:    SyncStatusRunning,
+		StartedAt: uc.now(),
+	}
+	return uc.lastRun, nil
+}
+
+// finish çalışmayı tamamlar ve kopyasını döner
+// err nil ise çalışma sırasında kaydedilmiş hata (varsa) korunur
+func (uc *SnapshotUseCase) finish(run *entity.SnapshotRun, err error) *entity.SnapshotRun {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	completed := uc.now()
+	run.CompletedAt = &completed
+	if err != nil {
+		run.Error = err.Error()
+	}
+	run.Status = SyncStatusSuccess
+	if run.Error != "" {
+		run.Status = SyncStatusFailed
+	}
+	uc.running = false
+
+	result := *run
+	return &result
+}
+
+// update çalışmanın sayaçlarını kilit altında günceller
+func (uc *SnapshotUseCase) update(fn func()) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	fn()
+}
+
+// fail çalışmaya hatayı kaydeder (arka plan çalışmaları için) ve hatayı aynen döner
+func (uc *SnapshotUseCase) fail(run *entity.SnapshotRun, err error) error {
+	if err != nil {
+		uc.update(func() { run.Error = err.Error() })
+	}
+	return err
+}
+
+// newSnapshotName zaman damgalı, ada göre sıralandığında kronolojik olan bir yedek adı üretir
+func (uc *SnapshotUseCase) newSnapshotName() string {
+	return "contents-" + uc.now().UTC().Format("20060102T150405Z") + snapshotFileExtension
+}
+
+// validateSnapshotName yedek adının düz bir dosya adı olduğunu kontrol eder
+func validateSnapshotName(name string) error {
+	if !strings.HasSuffix(name, snapshotFileExtension) || strings.ContainsAny(name, `/\`) || strings.HasPrefix(name, ".") {
+		return apperrors.NewValidationError("name", "name must be a snapshot file name ending with "+snapshotFileExtension, name)
+	}
+	return nil
+}
+
+// export yedeği akış halinde üretip depolama alanına yazar
+// Üretim ve yükleme bir pipe üzerinden eşzamanlı yapılır; yedek bellekte tutulmaz
+func (uc *SnapshotUseCase) export(ctx context.Context, run *entity.SnapshotRun) error {
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(uc.writeSnapshot(ctx, pw, run))
+	}()
+
+	err := uc.store.Put(ctx, run.Name, pr)
+	// Yükleme erken biterse yazan goroutine'in bloklanmaması için pipe kapatılır
+	pr.CloseWithError(io.ErrClosedPipe)
+	if err != nil {
+		return uc.fail(run, fmt.Errorf("yedek yazılamadı: %w", err))
+	}
+	return nil
+}
+
+// writeSnapshot header'ı, provider'ları, kategorileri, yazarları ve içerikleri sırasıyla w'ye yazar
+func (uc *SnapshotUseCase) writeSnapshot(ctx context.Context, w io.Writer, run *entity.SnapshotRun) error {
+	gz := gzip.NewWriter(w)
+	enc := json.NewEncoder(gz)
+
+	createdAt := run.StartedAt.UTC()
+	if err := enc.Encode(snapshotRecord{Type: snapshotRecordHeader, Version: snapshotFormatVersion, CreatedAt: &createdAt}); err != nil {
+		return err
+	}
+
+	providers, err := uc.snapshotRepo.ListProviders(ctx)
+	if err != nil {
+		return err
+	}
+	for _, p := range providers {
+		if err := enc.Encode(snapshotRecord{Type: snapshotRecordProvider, Provider: p}); err != nil {
+			return err
+		}
+	}
+	uc.update(func() { run.Providers = len(providers) })
+
+	categories, err := uc.snapshotRepo.ListCategories(ctx)
+	if err != nil {
+		return err
+	}
+	for _, c := range categories {
+		if err := enc.Encode(snapshotRecord{Type: snapshotRecordCategory, Category: c}); err != nil {
+			return err
+		}
+	}
+	uc.update(func() { run.Categories = len(categories) })
+
+	authors, err := uc.snapshotRepo.ListAuthors(ctx)
+	if err != nil {
+		return err
+	}
+	for _, a := range authors {
+		if err := enc.Encode(snapshotRecord{Type: snapshotRecordAuthor, Author: a}); err != nil {
+			return err
+		}
+	}
+	uc.update(func() { run.Authors = len(authors) })
+
+	var afterID int64
+	for {
+		contents, err := uc.snapshotRepo.ListContents(ctx, afterID, uc.batchSize)
+		if err != nil {
+			return err
+		}
+		for _, c := range contents {
+			if err := enc.Encode(snapshotRecord{Type: snapshotRecordContent, Content: c}); err != nil {
+				return err
+			}
+		}
+		uc.update(func() { run.Contents += len(contents) })
+
+		if len(contents) < uc.batchSize {
+			break
+		}
+		afterID = contents[len(contents)-1].ID
+	}
+
+	return gz.Close()
+}
+
+// snapshotImport geri yükleme sırasında kaynak ID'lerden hedef ID'lere eşlemeleri ve bekleyen batch'leri tutar
+type snapshotImport struct {
+	providerIDs map[int64]int64
+	categoryIDs map[int64]int64
+	authorIDs   map[int64]int64
+
+	existingProviders map[string]int64 // Hedef veritabanındaki provider'lar, ada göre
+
+	categories []*entity.Category
+	authors    []*entity.Author
+	contents   []*entity.Content
+}
+
+// importSnapshot yedeği okuyup hedef veritabanına yazar
+// Provider'lar ada göre eşlenir (mevcut provider'ların ayarlarına dokunulmaz, olmayanlar oluşturulur);
+// kategoriler path'e, yazarlar provider + external ID'ye, içerikler provider + provider_content_id'ye göre upsert edilir
+func (uc *SnapshotUseCase) importSnapshot(ctx context.Context, run *entity.SnapshotRun, r io.Reader) error {
+	ctx = port.WithAuditInfo(ctx, port.AuditInfo{Actor: port.AuditActorRestore})
+
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return uc.fail(run, fmt.Errorf("yedek açılamadı: %w", err))
+	}
+	defer gz.Close()
+
+	existing, err := uc.snapshotRepo.ListProviders(ctx)
+	if err != nil {
+		return uc.fail(run, err)
+	}
+	state := &snapshotImport{
+		providerIDs:       make(map[int64]int64),
+		categoryIDs:       make(map[int64]int64),
+		authorIDs:         make(map[int64]int64),
+		existingProviders: make(map[string]int64, len(existing)),
+	}
+	for _, p := range existing {
+		state.existingProviders[p.Name] = p.ID
+	}
+
+	dec := json.NewDecoder(gz)
+	for line := 1; ; line++ {
+		var rec snapshotRecord
+		if err := dec.Decode(&rec); err == io.EOF {
+			break
+		} else if err != nil {
+			return uc.fail(run, fmt.Errorf("yedek %d. kayıt okunamadı: %w", line, err))
+		}
+
+		if line == 1 {
+			if rec.Type != snapshotRecordHeader || rec.Version != snapshotFormatVersion {
+				return uc.fail(run, fmt.Errorf("desteklenmeyen yedek biçimi (type %q, version %d)", rec.Type, rec.Version))
+			}
+			continue
+		}
+
+		if err := uc.importRecord(ctx, run, state, &rec); err != nil {
+			return uc.fail(run, fmt.Errorf("yedek %d. kayıt yazılamadı: %w", line, err))
+		}
+	}
+
+	if err := uc.flushReferences(ctx, run, state); err != nil {
+		return uc.fail(run, err)
+	}
+	if err := uc.flushContents(ctx, run, state); err != nil {
+		return uc.fail(run, err)
+	}
+
+	if uc.indexer != nil {
+		if _, err := uc.indexer.Reindex(ctx); err != nil {
+			logger.FromContext(ctx).Error("search index could not be rebuilt after snapshot import", zap.Error(err))
+		}
+	}
+	_ = invalidateContentCache(ctx, uc.cache)
+	return nil
+}
+
+// importRecord tek bir kaydı ilgili batch'e ekler, batch dolduysa yazar
+func (uc *SnapshotUseCase) importRecord(ctx context.Context, run *entity.SnapshotRun, state *snapshotImport, rec *snapshotRecord) error {
+	switch {
+	case rec.Type == snapshotRecordProvider && rec.Provider != nil:
+		return uc.restoreProvider(ctx, run, state, rec.Provider)
+
+	case rec.Type == snapshotRecordCategory && rec.Category != nil:
+		state.categories = append(state.categories, rec.Category)
+
+	case rec.Type == snapshotRecordAuthor && rec.Author != nil:
+		state.authors = append(state.authors, rec.Author)
+
+	case rec.Type == snapshotRecordContent && rec.Content != nil:
+		// İçerikler yazar ve kategori ID'lerine ihtiyaç duyar
+		if err := uc.flushReferences(ctx, run, state); err != nil {
+			return err
+		}
+		state.contents = append(state.contents, rec.Content)
+		if len(state.contents) >= uc.batchSize {
+			return uc.flushContents(ctx, run, state)
+		}
+
+	default:
+		return fmt.Errorf("bilinmeyen kayıt türü %q", rec.Type)
+	}
+	return nil
+}
+
+// restoreProvider provider'ı ada göre hedef veritabanındaki provider'a eşler, yoksa oluşturur
+func (uc *SnapshotUseCase) restoreProvider(ctx context.Context, run *entity.SnapshotRun, state *snapshotImport, p *entity.Provider) error {
+	if id, ok := state.existingProviders[p.Name]; ok {
+		state.providerIDs[p.ID] = id
+		uc.update(func() { run.Providers++ })
+		return nil
+	}
+
+	sourceID := p.ID
+	created := *p
+	created.ID = 0
+	if err := uc.providerRepo.Create(ctx, &created); err != nil {
+		return fmt.Errorf("provider %q oluşturulamadı: %w", p.Name, err)
+	}
+	state.providerIDs[sourceID] = created.ID
+	state.existingProviders[created.Name] = created.ID
+	uc.update(func() { run.Providers++ })
+	return nil
+}
+
+// flushReferences bekleyen kategorileri ve yazarları yazar, kaynak ID'lerini yeni ID'lere eşler
+func (uc *SnapshotUseCase) flushReferences(ctx context.Context, run *entity.SnapshotRun, state *snapshotImport) error {
+	if len(state.categories) > 0 {
+		sourceIDs := make([]int64, len(state.categories))
+		for i, c := range state.categories {
+			sourceIDs[i] = c.ID
+			c.ID, c.ParentID = 0, nil
+		}
+		if err := uc.categoryRepo.BulkUpsertCategories(ctx, state.categories); err != nil {
+			return fmt.Errorf("kategoriler yazılamadı: %w", err)
+		}
+		for i, c := range state.categories {
+			state.categoryIDs[sourceIDs[i]] = c.ID
+		}
+		count := len(state.categories)
+		uc.update(func() { run.Categories += count })
+		state.categories = nil
+	}
+
+	if len(state.authors) > 0 {
+		var authors []*entity.Author
+		var sourceIDs []int64
+		for _, a := range state.authors {
+			providerID, ok := state.providerIDs[a.ProviderID]
+			if !ok {
+				return fmt.Errorf("yazar %d bilinmeyen provider'a (%d) ait", a.ID, a.ProviderID)
+			}
+			sourceIDs = append(sourceIDs, a.ID)
+			a.ID, a.ProviderID = 0, providerID
+			authors = append(authors, a)
+		}
+		if err := uc.authorRepo.BulkUpsertAuthors(ctx, authors); err != nil {
+			return fmt.Errorf("yazarlar yazılamadı: %w", err)
+		}
+		for i, a := range authors {
+			state.authorIDs[sourceIDs[i]] = a.ID
+		}
+		count := len(authors)
+		uc.update(func() { run.Authors += count })
+		state.authors = nil
+	}
+
+	return nil
+}
+
+// flushContents bekleyen içerikleri istatistik, skor ve tag'leriyle yazar
+func (uc *SnapshotUseCase) flushContents(ctx context.Context, run *entity.SnapshotRun, state *snapshotImport) error {
+	if len(state.contents) == 0 {
+		return nil
+	}
+	contents := state.contents
+	state.contents = nil
+
+	for _, c := range contents {
+		providerID, ok := state.providerIDs[c.ProviderID]
+		if !ok {
+			return fmt.Errorf("içerik %d bilinmeyen provider'a (%d) ait", c.ID, c.ProviderID)
+		}
+		c.ID, c.ProviderID = 0, providerID
+		if c.Author != nil {
+			if id, ok := state.authorIDs[c.Author.ID]; ok {
+				c.Author.ID = id
+			} else {
+				c.Author = nil
+			}
+		}
+		if c.Category != nil {
+			if id, ok := state.categoryIDs[c.Category.ID]; ok {
+				c.Category.ID = id
+			} else {
+				c.Category = nil
+			}
+		}
+		// Kopya bağlantıları kaynak ID'lere işaret eder; bir sonraki senkronizasyonda yeniden kurulur
+		c.CanonicalContentID = nil
+	}
+
+	err := uc.withinTx(ctx, func(ctx context.Context) error {
+		if err := uc.contentRepo.BulkUpsert(ctx, contents); err != nil {
+			return fmt.Errorf("içerikler yazılamadı: %w", err)
+		}
+
+		var stats []*entity.ContentStats
+		var scores []*entity.ContentScore
+		for _, c := range contents {
+			if c.Stats != nil {
+				c.Stats.ID, c.Stats.ContentID = 0, c.ID
+				stats = append(stats, c.Stats)
+			}
+			if c.Score != nil {
+				c.Score.ID, c.Score.ContentID = 0, c.ID
+				scores = append(scores, c.Score)
+			}
+		}
+		if err := uc.contentRepo.BulkCreateOrUpdateStats(ctx, stats); err != nil {
+			return fmt.Errorf("istatistikler yazılamadı: %w", err)
+		}
+		if err := uc.contentRepo.BulkCreateOrUpdateScores(ctx, scores); err != nil {
+			return fmt.Errorf("skorlar yazılamadı: %w", err)
+		}
+
+		for _, c := range contents {
+			if len(c.Tags) == 0 {
+				continue
+			}
+			names := make([]string, len(c.Tags))
+			for i, tag := range c.Tags {
+				names[i] = tag.Name
+			}
+			if err := uc.contentRepo.AddTags(ctx, c.ID, names); err != nil {
+				return fmt.Errorf("tag'ler yazılamadı (Content ID: %d): %w", c.ID, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	uc.update(func() { run.Contents += len(contents) })
+	return nil
+}
+
+// withinTx transactor ayarlıysa fn'i transaction içinde çalıştırır
+func (uc *SnapshotUseCase) withinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if uc.transactor == nil {
+		return fn(ctx)
+	}
+	return uc.transactor.WithinTransaction(ctx, fn)
+}
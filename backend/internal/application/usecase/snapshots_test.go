@@ -0,0 +1,284 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+type mockSnapshotRepository struct {
+	providers  []*entity.Provider
+	categories []*entity.Category
+	authors    []*entity.Author
+	contents   []*entity.Content
+}
+
+func (m *mockSnapshotRepository) ListProviders(ctx context.Context) ([]*entity.Provider, error) {
+	return m.providers, nil
+}
+
+func (m *mockSnapshotRepository) ListCategories(ctx context.Context) ([]*entity.Category, error) {
+	return m.categories, nil
+}
+
+func (m *mockSnapshotRepository) ListAuthors(ctx context.Context) ([]*entity.Author, error) {
+	return m.authors, nil
+}
+
+func (m *mockSnapshotRepository) ListContents(ctx context.Context, afterID int64, limit int) ([]*entity.Content, error) {
+	var page []*entity.Content
+	for _, c := range m.contents {
+		if c.ID > afterID && len(page) < limit {
+			page = append(page, c)
+		}
+	}
+	return page, nil
+}
+
+// memorySnapshotStore yedekleri bellekte tutan port.SnapshotStore
+type memorySnapshotStore struct {
+	objects map[string][]byte
+}
+
+func (m *memorySnapshotStore) Put(ctx context.Context, name string, r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	m.objects[name] = data
+	return nil
+}
+
+func (m *memorySnapshotStore) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	data, ok := m.objects[name]
+	if !ok {
+		return nil, port.ErrSnapshotNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (m *memorySnapshotStore) List(ctx context.Context) ([]*entity.SnapshotInfo, error) {
+	var snapshots []*entity.SnapshotInfo
+	for name, data := range m.objects {
+		snapshots = append(snapshots, &entity.SnapshotInfo{Name: name, SizeBytes: int64(len(data))})
+	}
+	sort.Slice(snapshots, func(i, j int) bool { return snapshots[i].Name < snapshots[j].Name })
+	return snapshots, nil
+}
+
+// mockRestoreRepository geri yüklemede yazılan kategori, yazar ve içerikleri yeni ID'lerle kaydeder
+type mockRestoreRepository struct {
+	port.ContentRepository
+	nextID     int64
+	categories []*entity.Category
+	authors    []*entity.Author
+	contents   []*entity.Content
+	stats      []*entity.ContentStats
+	scores     []*entity.ContentScore
+	tags       map[int64][]string
+}
+
+func (m *mockRestoreRepository) newID() int64 {
+	m.nextID++
+	return 1000 + m.nextID
+}
+
+func (m *mockRestoreRepository) BulkUpsertCategories(ctx context.Context, categories []*entity.Category) error {
+	for _, c := range categories {
+		c.ID = m.newID()
+	}
+	m.categories = append(m.categories, categories...)
+	return nil
+}
+
+func (m *mockRestoreRepository) ListCategories(ctx context.Context) ([]*entity.Category, error) {
+	return m.categories, nil
+}
+
+func (m *mockRestoreRepository) BulkUpsertAuthors(ctx context.Context, authors []*entity.Author) error {
+	for _, a := range authors {
+		a.ID = m.newID()
+	}
+	m.authors = append(m.authors, authors...)
+	return nil
+}
+
+func (m *mockRestoreRepository) ListAuthors(ctx context.Context, filter port.AuthorFilter, limit, offset int) ([]*entity.Author, int64, error) {
+	return m.authors, int64(len(m.authors)), nil
+}
+
+func (m *mockRestoreRepository) BulkUpsert(ctx context.Context, contents []*entity.Content) error {
+	for _, c := range contents {
+		c.ID = m.newID()
+	}
+	m.contents = append(m.contents, contents...)
+	return nil
+}
+
+func (m *mockRestoreRepository) BulkCreateOrUpdateStats(ctx context.Context, stats []*entity.ContentStats) error {
+	m.stats = append(m.stats, stats...)
+	return nil
+}
+
+func (m *mockRestoreRepository) BulkCreateOrUpdateScores(ctx context.Context, scores []*entity.ContentScore) error {
+	m.scores = append(m.scores, scores...)
+	return nil
+}
+
+func (m *mockRestoreRepository) AddTags(ctx context.Context, contentID int64, tags []string) error {
+	m.tags[contentID] = append(m.tags[contentID], tags...)
+	return nil
+}
+
+func newTestSnapshotSource() *mockSnapshotRepository {
+	published := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	return &mockSnapshotRepository{
+		providers: []*entity.Provider{
+			{ID: 1, Name: "Provider 1", URL: "http://mock-api:8081/provider1", Format: "json", IsActive: true},
+			{ID: 2, Name: "Provider 2", URL: "http://mock-api:8081/provider2", Format: "xml"},
+		},
+		categories: []*entity.Category{
+			{ID: 10, Name: "Programming", Slug: "programming", Path: "programming"},
+			{ID: 11, Name: "Go", Slug: "go", Path: "programming/go"},
+		},
+		authors: []*entity.Author{
+			{ID: 20, ProviderID: 2, ExternalID: "ch-1", Name: "Gopher"},
+		},
+		contents: []*entity.Content{
+			{
+				ID: 100, ProviderID: 1, ProviderContentID: "v1", Title: "Go Concurrency", ContentType: entity.ContentTypeVideo,
+				PublishedAt: published, Status: entity.ContentStatusApproved,
+				Stats: &entity.ContentStats{ID: 5, ContentID: 100, Views: 1000, Likes: 50},
+				Score: &entity.ContentScore{ID: 6, ContentID: 100, FinalScore: 42.5},
+				Tags:  []entity.Tag{{ID: 1, Name: "go"}, {ID: 2, Name: "concurrency"}},
+			},
+			{
+				ID: 101, ProviderID: 2, ProviderContentID: "a1", Title: "Generics", ContentType: entity.ContentTypeArticle,
+				PublishedAt: published, Status: entity.ContentStatusPending,
+				Author:   &entity.ContentAuthor{ID: 20, Name: "Gopher"},
+				Category: &entity.ContentCategory{ID: 11, Name: "Go", Path: "programming/go"},
+			},
+			{
+				ID: 102, ProviderID: 2, ProviderContentID: "a2", Title: "Modules", ContentType: entity.ContentTypeArticle,
+				PublishedAt: published,
+			},
+		},
+	}
+}
+
+func TestSnapshotUseCase_ExportImport(t *testing.T) {
+	ctx := context.Background()
+	store := &memorySnapshotStore{objects: make(map[string][]byte)}
+
+	source := NewSnapshotUseCase(newTestSnapshotSource(), newMockProviderRepository(), nil, nil, nil, store, newMockSearchCache())
+	source.batchSize = 2
+	source.now = func() time.Time { return time.Date(2024, 1, 20, 3, 0, 0, 0, time.UTC) }
+
+	run, err := source.Export(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "contents-20240120T030000Z.ndjson.gz", run.Name)
+	assert.Equal(t, SyncStatusSuccess, run.Status)
+	assert.Equal(t, 2, run.Providers)
+	assert.Equal(t, 2, run.Categories)
+	assert.Equal(t, 1, run.Authors)
+	assert.Equal(t, 3, run.Contents)
+
+	snapshots, err := source.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	assert.Equal(t, run.Name, snapshots[0].Name)
+
+	// Hedefte "Provider 2" zaten farklı ID ile var, "Provider 1" oluşturulmalı
+	providerRepo := newMockProviderRepository()
+	providerRepo.nextID = 7
+	existing := &entity.Provider{ID: 7, Name: "Provider 2", Format: "xml"}
+	providerRepo.providers[7] = existing
+	restoreRepo := &mockRestoreRepository{tags: make(map[int64][]string)}
+	target := NewSnapshotUseCase(
+		&mockSnapshotRepository{providers: []*entity.Provider{existing}},
+		providerRepo, restoreRepo, restoreRepo, restoreRepo, store, newMockSearchCache(),
+	)
+	target.batchSize = 2
+
+	run, err = target.Import(ctx, run.Name)
+	require.NoError(t, err)
+	assert.Equal(t, entity.SnapshotOperationImport, run.Operation)
+	assert.Equal(t, SyncStatusSuccess, run.Status)
+	assert.Equal(t, 2, run.Providers)
+	assert.Equal(t, 3, run.Contents)
+
+	require.Len(t, providerRepo.providers, 2)
+	created := providerRepo.providers[8]
+	require.NotNil(t, created)
+	assert.Equal(t, "Provider 1", created.Name)
+
+	require.Len(t, restoreRepo.contents, 3)
+	video, article := restoreRepo.contents[0], restoreRepo.contents[1]
+	assert.Equal(t, created.ID, video.ProviderID)
+	assert.Equal(t, int64(7), article.ProviderID)
+	assert.Equal(t, entity.ContentStatusPending, article.Status)
+
+	// Yazar ve kategori yeni ID'lerine eşlenmeli
+	require.NotNil(t, article.Author)
+	assert.Equal(t, restoreRepo.authors[0].ID, article.Author.ID)
+	assert.Equal(t, int64(7), restoreRepo.authors[0].ProviderID)
+	require.NotNil(t, article.Category)
+	assert.Equal(t, restoreRepo.categories[1].ID, article.Category.ID)
+
+	// İstatistik, skor ve tag'ler yeni içerik ID'siyle yazılmalı
+	require.Len(t, restoreRepo.stats, 1)
+	assert.Equal(t, video.ID, restoreRepo.stats[0].ContentID)
+	assert.Equal(t, int64(1000), restoreRepo.stats[0].Views)
+	require.Len(t, restoreRepo.scores, 1)
+	assert.Equal(t, 42.5, restoreRepo.scores[0].FinalScore)
+	assert.Equal(t, []string{"go", "concurrency"}, restoreRepo.tags[video.ID])
+}
+
+func TestSnapshotUseCase_Import(t *testing.T) {
+	ctx := context.Background()
+	store := &memorySnapshotStore{objects: make(map[string][]byte)}
+	uc := NewSnapshotUseCase(&mockSnapshotRepository{}, newMockProviderRepository(), nil, nil, nil, store, newMockSearchCache())
+
+	t.Run("invalid name", func(t *testing.T) {
+		_, err := uc.StartImport(ctx, "../etc/passwd")
+		var validationErr *apperrors.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "name", validationErr.Field)
+	})
+
+	t.Run("unknown snapshot", func(t *testing.T) {
+		_, err := uc.StartImport(ctx, "missing.ndjson.gz")
+		assert.ErrorIs(t, err, port.ErrSnapshotNotFound)
+
+		// Başarısız deneme kilidi bırakmalı
+		last := uc.LastRun()
+		require.NotNil(t, last)
+		assert.Equal(t, SyncStatusFailed, last.Status)
+	})
+
+	t.Run("not a snapshot", func(t *testing.T) {
+		store.objects["broken.ndjson.gz"] = []byte("plain text")
+		run, err := uc.Import(ctx, "broken.ndjson.gz")
+		require.Error(t, err)
+		assert.Equal(t, SyncStatusFailed, run.Status)
+		assert.NotEmpty(t, run.Error)
+	})
+
+	t.Run("only one operation at a time", func(t *testing.T) {
+		_, err := uc.start(entity.SnapshotOperationExport, "running.ndjson.gz")
+		require.NoError(t, err)
+
+		_, err = uc.StartExport()
+		assert.ErrorIs(t, err, ErrSnapshotRunning)
+	})
+}
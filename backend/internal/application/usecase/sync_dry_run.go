@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// SyncDryRunResult dry-run senkronizasyon raporu
+type SyncDryRunResult struct {
+	Providers []SyncDryRunProvider `json:"providers"`
+}
+
+// SyncDryRunProvider tek bir provider için yapılacak değişikliklerin özeti
+type SyncDryRunProvider struct {
+	ProviderID   int64    `json:"provider_id"`
+	ProviderName string   `json:"provider_name"`
+	Fetched      int      `json:"fetched"`
+	Insert       []string `json:"insert"`      // Yeni eklenecek provider_content_id'ler
+	Update       []string `json:"update"`      // Güncellenecek (silinmişse geri alınacak) içerikler
+	SoftDelete   []string `json:"soft_delete"` // Provider'da artık olmadığı için silinecekler
+	Rejected     []string `json:"rejected"`    // Doğrulamadan geçemeyip karantinaya alınacaklar
+	Error        string   `json:"error,omitempty"`
+}
+
+// DryRun provider içeriklerini çekip normalize eder ve Postgres'e yazmadan
+// nelerin ekleneceğini, güncelleneceğini ve silineceğini raporlar
+// providerID 0 ise tüm aktif provider'lar, değilse sadece o provider raporlanır
+func (uc *SyncProviderContentsUseCase) DryRun(ctx context.Context, providerID int64) (*SyncDryRunResult, error) {
+	clients := uc.ProviderClients()
+	if providerID > 0 {
+		client := uc.findClient(providerID)
+		if client == nil {
+			return nil, port.ErrProviderNotFound
+		}
+		clients = []port.ProviderClient{client}
+	}
+
+	result := &SyncDryRunResult{Providers: make([]SyncDryRunProvider, 0, len(clients))}
+	for _, client := range clients {
+		result.Providers = append(result.Providers, uc.dryRunProvider(ctx, client))
+	}
+
+	return result, nil
+}
+
+// dryRunProvider tek bir provider'ın mevcut kayıtlarla farkını hesaplar
+func (uc *SyncProviderContentsUseCase) dryRunProvider(ctx context.Context, client port.ProviderClient) SyncDryRunProvider {
+	provider := client.GetProviderInfo()
+	report := SyncDryRunProvider{
+		ProviderID:   provider.ID,
+		ProviderName: provider.Name,
+		Insert:       []string{},
+		Update:       []string{},
+		SoftDelete:   []string{},
+		Rejected:     []string{},
+	}
+
+	fetchCtx, cancel := withSyncTimeout(ctx, uc.providerTimeout, "provider sync")
+	defer cancel()
+	normalized, err := client.FetchContents(fetchCtx)
+	err = syncTimeoutError(fetchCtx, err)
+	if errors.Is(err, port.ErrNotModified) {
+		// Son senkronizasyondan beri değişiklik yok
+		return report
+	}
+	if err != nil {
+		report.Error = fmt.Sprintf("içerikler çekilemedi: %v", err)
+		return report
+	}
+	report.Fetched = len(normalized)
+
+	normalized, rejected := filterValidContents(client, normalized)
+	for _, r := range rejected {
+		report.Rejected = append(report.Rejected, r.ExternalID)
+	}
+
+	existing, err := uc.contentRepo.FindProviderContentIDs(ctx, provider.ID)
+	if err != nil {
+		report.Error = fmt.Sprintf("mevcut içerikler okunamadı: %v", err)
+		return report
+	}
+
+	seen := make(map[string]bool, len(normalized))
+	for _, nc := range normalized {
+		if seen[nc.ExternalID] {
+			continue
+		}
+		seen[nc.ExternalID] = true
+
+		if _, ok := existing[nc.ExternalID]; ok {
+			report.Update = append(report.Update, nc.ExternalID)
+		} else {
+			report.Insert = append(report.Insert, nc.ExternalID)
+		}
+	}
+
+	// Sadece silinmemiş ve artık gelmeyen içerikler soft delete edilir
+	for id, deleted := range existing {
+		if !deleted && !seen[id] {
+			report.SoftDelete = append(report.SoftDelete, id)
+		}
+	}
+	sort.Strings(report.SoftDelete)
+
+	return report
+}
@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/logger"
+)
+
+// ingestInvalidationDelay değişiklik akışından gelen olaylardan sonra cache temizliğinin ertelendiği süre
+// Bu süre içinde gelen tüm olaylar tek bir (SCAN tabanlı) temizlikle sonuçlanır
+var ingestInvalidationDelay = time.Second
+
+// IngestEvent değişiklik akışından gelen tek bir içerik olayını sync pipeline'ından geçirir
+// Upsert olayları periyodik sync ile aynı doğrulama, karantina ve upsert + stats + score + tags
+// adımlarından geçer, delete olayları içeriği soft delete yapar. port.ContentEventHandler imzasına uyar
+func (uc *SyncProviderContentsUseCase) IngestEvent(ctx context.Context, event *entity.ContentEvent) error {
+	if err := validateContentEvent(event); err != nil {
+		return err
+	}
+
+	// Sadece aktif provider'ların olayları kabul edilir
+	client := uc.findClient(event.ProviderID)
+	if client == nil {
+		return port.ErrProviderNotFound
+	}
+
+	// Geçersiz içerikler periyodik sync'teki gibi yazılmaz, ham verileriyle karantinaya alınır
+	if event.Op == entity.ContentEventUpsert {
+		if err := validateNormalizedContent(event.Content, time.Now()); err != nil {
+			// Karantina kaydı yazılamazsa olay kaybolmasın diye doğrulama dışı bir hata döner,
+			// consumer olayı yeniden teslim eder
+			if qErr := uc.quarantineEvent(ctx, client.GetProviderInfo(), event.Content, err); qErr != nil {
+				return fmt.Errorf("geçersiz içerik olayı karantinaya alınamadı (ID: %s): %w", event.Content.ExternalID, qErr)
+			}
+			return err
+		}
+	}
+
+	if !uc.acquire() {
+		return ErrSyncShutdown
+	}
+	defer uc.inflight.Done()
+
+	// Shutdown süresi dolduğunda devam eden olay işleme de iptal edilir
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stop := context.AfterFunc(uc.baseCtx, cancel)
+	defer stop()
+
+	ctx = port.WithAuditInfo(ctx, port.AuditInfo{Actor: port.AuditActorIngest})
+
+	startTime := time.Now()
+
+	var (
+		written *entity.Content
+		err     error
+	)
+	switch event.Op {
+	case entity.ContentEventUpsert:
+		err = uc.withinTx(ctx, func(ctx context.Context) error {
+			var err error
+			written, err = uc.processContent(ctx, event.ProviderID, event.Content)
+			return err
+		})
+	case entity.ContentEventDelete:
+		err = uc.withinTx(ctx, func(ctx context.Context) error {
+			return uc.contentRepo.MarkContentDeleted(ctx, event.ProviderID, event.Content.ExternalID)
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("içerik olayı işlenemedi (%s, ID: %s): %w", event.Op, event.Content.ExternalID, err)
+	}
+
+	if event.Op == entity.ContentEventUpsert {
+		uc.publishChanges(client.GetProviderInfo(), []*entity.Content{written})
+		uc.linkDuplicates(ctx, event.ProviderID, startTime)
+	}
+
+	// Değişen içerik arama sonuçlarında görünsün
+	uc.scheduleIngestInvalidation(ctx)
+
+	return nil
+}
+
+// quarantineEvent reddedilen olay içeriğini provider'ın karantinasına ekler
+// Periyodik sync'in aksine mevcut karantina kayıtları korunur. Periyodik sync olayı bir sonraki
+// çalışmada tekrar görür, akıştan gelen olay ise görmez; bu yüzden yazma hatası döndürülür
+func (uc *SyncProviderContentsUseCase) quarantineEvent(ctx context.Context, provider *entity.Provider, content *entity.NormalizedContent, reason error) error {
+	logger.FromContext(ctx).Warn("invalid content event quarantined",
+		zap.String("provider", provider.Name),
+		zap.String("external_id", content.ExternalID),
+		zap.Error(reason),
+	)
+	if uc.syncLogRepo == nil {
+		return nil
+	}
+	syncError := &entity.ProviderSyncError{
+		ExternalID: content.ExternalID,
+		Reason:     reason.Error(),
+		RawData:    content.RawData,
+	}
+	if err := uc.syncLogRepo.AddSyncError(ctx, provider.ID, syncError); err != nil {
+		logger.FromContext(ctx).Error("quarantine record could not be written",
+			zap.String("provider", provider.Name),
+			zap.Error(err),
+		)
+		return err
+	}
+	return nil
+}
+
+// scheduleIngestInvalidation içerik cache'inin ingestInvalidationDelay sonra temizlenmesini planlar
+// Bekleyen bir temizlik varsa yenisi planlanmaz; böylece yoğun akışta her olay için
+// ayrı bir SCAN yapılmaz. Bekleyen temizlik shutdown'da inflight ile beklenir.
+// Çağıran inflight kaydı tutuyor olmalıdır (bkz. acquire)
+func (uc *SyncProviderContentsUseCase) scheduleIngestInvalidation(ctx context.Context) {
+	if !uc.ingestInvalidationPending.CompareAndSwap(false, true) {
+		return
+	}
+
+	log := logger.FromContext(ctx)
+	uc.inflight.Add(1)
+	time.AfterFunc(ingestInvalidationDelay, func() {
+		defer uc.inflight.Done()
+		// Temizlik başlamadan önce bayrak indirilir; temizlik sırasında yazılan olaylar yeni bir temizlik planlar
+		uc.ingestInvalidationPending.Store(false)
+		if err := invalidateContentCache(logger.NewContext(uc.baseCtx, log), uc.cache); err != nil {
+			log.Warn("content cache could not be invalidated after ingest", zap.Error(err))
+		}
+	})
+}
+
+// validateContentEvent olayın zarfını (provider, op, external_id) doğrular
+// İçerik alanları periyodik sync ile aynı kurallarla validateNormalizedContent'te doğrulanır
+func validateContentEvent(event *entity.ContentEvent) error {
+	if event.ProviderID <= 0 {
+		return apperrors.NewValidationError("provider_id", "provider_id is required", event.ProviderID)
+	}
+	if event.Op != entity.ContentEventUpsert && event.Op != entity.ContentEventDelete {
+		return apperrors.NewValidationError("op", "invalid op (must be 'upsert' or 'delete')", event.Op)
+	}
+	if event.Content == nil || event.Content.ExternalID == "" {
+		return apperrors.NewValidationError("content.external_id", "content.external_id is required", nil)
+	}
+	if event.Op == entity.ContentEventUpsert && event.Content.Language != "" && !entity.IsSupportedLanguage(event.Content.Language) {
+		return apperrors.NewValidationError("content.language", "unsupported content language", event.Content.Language)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// SyncHistoryUseCase senkronizasyon geçmişi use case'i
+type SyncHistoryUseCase struct {
+	providerRepo port.ProviderRepository
+}
+
+// SyncHistoryResult sync geçmişi sonucu yapısı
+type SyncHistoryResult struct {
+	Items      []*entity.ProviderSyncLog `json:"items"`
+	Pagination Pagination                `json:"pagination"`
+}
+
+// SyncErrorsResult karantinadaki içerikler sonucu yapısı
+type SyncErrorsResult struct {
+	Items      []*entity.ProviderSyncError `json:"items"`
+	Pagination Pagination                  `json:"pagination"`
+}
+
+// NewSyncHistoryUseCase yeni bir sync geçmişi use case oluşturur
+func NewSyncHistoryUseCase(providerRepo port.ProviderRepository) *SyncHistoryUseCase {
+	return &SyncHistoryUseCase{
+		providerRepo: providerRepo,
+	}
+}
+
+// Execute sync loglarını en yeniden eskiye sayfalı getirir
+// providerID 0 ise tüm provider'ların logları döner
+func (uc *SyncHistoryUseCase) Execute(ctx context.Context, providerID int64, page, pageSize int) (*SyncHistoryResult, error) {
+	if providerID < 0 {
+		return nil, apperrors.NewValidationError("provider_id", "provider_id must be a positive integer", providerID)
+	}
+
+	page, pageSize = normalizeHistoryPage(page, pageSize)
+
+	logs, total, err := uc.providerRepo.ListSyncLogs(ctx, providerID, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("sync geçmişi hatası: %w", err)
+	}
+
+	if logs == nil {
+		logs = make([]*entity.ProviderSyncLog, 0)
+	}
+
+	return &SyncHistoryResult{
+		Items:      logs,
+		Pagination: historyPagination(page, pageSize, total),
+	}, nil
+}
+
+// ListErrors provider'ların son senkronizasyonda karantinaya alınan içeriklerini sayfalı getirir
+// providerID 0 ise tüm provider'ların kayıtları döner
+func (uc *SyncHistoryUseCase) ListErrors(ctx context.Context, providerID int64, page, pageSize int) (*SyncErrorsResult, error) {
+	if providerID < 0 {
+		return nil, apperrors.NewValidationError("provider_id", "provider_id must be a positive integer", providerID)
+	}
+
+	page, pageSize = normalizeHistoryPage(page, pageSize)
+
+	syncErrors, total, err := uc.providerRepo.ListSyncErrors(ctx, providerID, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("karantina kayıtları hatası: %w", err)
+	}
+
+	if syncErrors == nil {
+		syncErrors = make([]*entity.ProviderSyncError, 0)
+	}
+
+	return &SyncErrorsResult{
+		Items:      syncErrors,
+		Pagination: historyPagination(page, pageSize, total),
+	}, nil
+}
+
+// normalizeHistoryPage page ve pageSize için varsayılan ve maksimum kontrolü yapar
+func normalizeHistoryPage(page, pageSize int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+	return page, pageSize
+}
+
+// historyPagination toplam kayıt sayısından sayfalama bilgisini oluşturur
+func historyPagination(page, pageSize int, total int64) Pagination {
+	return Pagination{
+		Page:       page,
+		PageSize:   pageSize,
+		TotalItems: total,
+		TotalPages: (total + int64(pageSize) - 1) / int64(pageSize),
+	}
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+)
+
+func TestSyncHistoryUseCase_Execute(t *testing.T) {
+	repo := newMockProviderRepository()
+	for i := 0; i < 5; i++ {
+		providerID := int64(1)
+		if i%2 == 1 {
+			providerID = 2
+		}
+		_ = repo.CreateSyncLog(context.Background(), &entity.ProviderSyncLog{
+			ProviderID: providerID,
+			StartedAt:  time.Now(),
+			Status:     "success",
+		})
+	}
+	useCase := NewSyncHistoryUseCase(repo)
+
+	t.Run("paginates all logs", func(t *testing.T) {
+		result, err := useCase.Execute(context.Background(), 0, 2, 2)
+		require.NoError(t, err)
+		assert.Len(t, result.Items, 2)
+		assert.Equal(t, int64(5), result.Pagination.TotalItems)
+		assert.Equal(t, int64(3), result.Pagination.TotalPages)
+	})
+
+	t.Run("filters by provider and applies defaults", func(t *testing.T) {
+		result, err := useCase.Execute(context.Background(), 2, 0, 0)
+		require.NoError(t, err)
+		assert.Len(t, result.Items, 2)
+		assert.Equal(t, 1, result.Pagination.Page)
+		assert.Equal(t, 20, result.Pagination.PageSize)
+	})
+
+	t.Run("empty page returns empty slice", func(t *testing.T) {
+		result, err := useCase.Execute(context.Background(), 0, 10, 20)
+		require.NoError(t, err)
+		assert.NotNil(t, result.Items)
+		assert.Empty(t, result.Items)
+	})
+
+	t.Run("rejects negative provider id", func(t *testing.T) {
+		_, err := useCase.Execute(context.Background(), -1, 1, 20)
+		var validationErr *apperrors.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// Sync job durumları
+const (
+	SyncStatusRunning = "running"
+	SyncStatusSuccess = "success"
+	SyncStatusFailed  = "failed"
+)
+
+// defaultMaxSyncJobs bellekte tutulacak en fazla sync job sayısı
+const defaultMaxSyncJobs = 100
+
+// SyncJob tek bir senkronizasyon çalıştırmasının durumunu tutar
+type SyncJob struct {
+	ID          string            `json:"id"`
+	Status      string            `json:"status"` // "running", "success", "failed"
+	StartedAt   time.Time         `json:"started_at"`
+	CompletedAt *time.Time        `json:"completed_at,omitempty"`
+	Providers   []SyncJobProvider `json:"providers"`
+}
+
+// SyncJobProvider job içindeki tek bir provider'ın sonucunu tutar
+type SyncJobProvider struct {
+	ProviderID   int64  `json:"provider_id"`
+	ProviderName string `json:"provider_name"`
+	Status       string `json:"status"`
+	ItemsSynced  int    `json:"items_synced"`
+	Error        string `json:"error,omitempty"`
+}
+
+// SyncJobTracker sync job'larını bellekte takip eder
+// Sadece son maxJobs adet job saklanır, eskiler sırayla düşürülür
+type SyncJobTracker struct {
+	mu      sync.RWMutex
+	jobs    map[string]*SyncJob
+	order   []string
+	maxJobs int
+}
+
+// NewSyncJobTracker yeni bir sync job tracker oluşturur
+func NewSyncJobTracker(maxJobs int) *SyncJobTracker {
+	if maxJobs < 1 {
+		maxJobs = defaultMaxSyncJobs
+	}
+	return &SyncJobTracker{
+		jobs:    make(map[string]*SyncJob),
+		maxJobs: maxJobs,
+	}
+}
+
+// Start verilen provider'lar için "running" durumunda yeni bir job oluşturur
+func (t *SyncJobTracker) Start(clients []port.ProviderClient) *SyncJob {
+	job := &SyncJob{
+		ID:        uuid.New().String(),
+		Status:    SyncStatusRunning,
+		StartedAt: time.Now(),
+		Providers: make([]SyncJobProvider, 0, len(clients)),
+	}
+	for _, c := range clients {
+		info := c.GetProviderInfo()
+		job.Providers = append(job.Providers, SyncJobProvider{
+			ProviderID:   info.ID,
+			ProviderName: info.Name,
+			Status:       SyncStatusRunning,
+		})
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.jobs[job.ID] = job
+	t.order = append(t.order, job.ID)
+	for len(t.order) > t.maxJobs {
+		delete(t.jobs, t.order[0])
+		t.order = t.order[1:]
+	}
+
+	return job.snapshot()
+}
+
+// FinishProvider job içindeki bir provider'ın sonucunu kaydeder
+func (t *SyncJobTracker) FinishProvider(jobID string, providerID int64, itemsSynced int, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	job, ok := t.jobs[jobID]
+	if !ok {
+		return
+	}
+	for i := range job.Providers {
+		if job.Providers[i].ProviderID != providerID {
+			continue
+		}
+		job.Providers[i].ItemsSynced = itemsSynced
+		job.Providers[i].Status = SyncStatusSuccess
+		if err != nil {
+			job.Providers[i].Status = SyncStatusFailed
+			job.Providers[i].Error = err.Error()
+		}
+	}
+}
+
+// Finish job'u tamamlar; herhangi bir provider başarısızsa job "failed" olur
+func (t *SyncJobTracker) Finish(jobID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	job, ok := t.jobs[jobID]
+	if !ok {
+		return
+	}
+
+	completedAt := time.Now()
+	job.CompletedAt = &completedAt
+	job.Status = SyncStatusSuccess
+	for _, p := range job.Providers {
+		if p.Status == SyncStatusFailed {
+			job.Status = SyncStatusFailed
+			break
+		}
+	}
+}
+
+// Get job'un anlık kopyasını döner
+func (t *SyncJobTracker) Get(jobID string) (*SyncJob, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	job, ok := t.jobs[jobID]
+	if !ok {
+		return nil, false
+	}
+	return job.snapshot(), true
+}
+
+// snapshot job'un eşzamanlı erişime karşı güvenli bir kopyasını oluşturur
+func (j *SyncJob) snapshot() *SyncJob {
+	copied := *j
+	copied.Providers = append([]SyncJobProvider(nil), j.Providers...)
+	if j.CompletedAt != nil {
+		completedAt := *j.CompletedAt
+		copied.CompletedAt = &completedAt
+	}
+	return &copied
+}
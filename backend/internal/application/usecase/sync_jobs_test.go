@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// namedProviderClient farklı provider ID'leri ile test için
+type namedProviderClient struct {
+	mockProviderClient
+	info *entity.Provider
+}
+
+func (m *namedProviderClient) GetProviderInfo() *entity.Provider {
+	return m.info
+}
+
+func TestSyncJobTracker(t *testing.T) {
+	t.Run("tracks per-provider results", func(t *testing.T) {
+		tracker := NewSyncJobTracker(10)
+		clients := []port.ProviderClient{
+			&namedProviderClient{info: &entity.Provider{ID: 1, Name: "P1"}},
+			&namedProviderClient{info: &entity.Provider{ID: 2, Name: "P2"}},
+		}
+
+		job := tracker.Start(clients)
+		assert.Equal(t, SyncStatusRunning, job.Status)
+		require.Len(t, job.Providers, 2)
+
+		tracker.FinishProvider(job.ID, 1, 10, nil)
+		tracker.FinishProvider(job.ID, 2, 0, errors.New("timeout"))
+
+		running, ok := tracker.Get(job.ID)
+		require.True(t, ok)
+		assert.Equal(t, SyncStatusRunning, running.Status)
+		assert.Equal(t, 10, running.Providers[0].ItemsSynced)
+
+		tracker.Finish(job.ID)
+		finished, _ := tracker.Get(job.ID)
+		assert.Equal(t, SyncStatusFailed, finished.Status)
+		assert.NotNil(t, finished.CompletedAt)
+		assert.Equal(t, "timeout", finished.Providers[1].Error)
+	})
+
+	t.Run("evicts oldest jobs", func(t *testing.T) {
+		tracker := NewSyncJobTracker(2)
+		first := tracker.Start(nil)
+		tracker.Start(nil)
+		tracker.Start(nil)
+
+		_, ok := tracker.Get(first.ID)
+		assert.False(t, ok)
+	})
+}
+
+func TestSyncProviderContentsUseCase_JobStatus(t *testing.T) {
+	client := &mockProviderClient{
+		contents: []*entity.NormalizedContent{{ExternalID: "a", Title: "A", ContentType: entity.ContentTypeVideo, PublishedAt: testPublishedAt}},
+	}
+	useCase := NewSyncProviderContentsUseCase([]port.ProviderClient{client}, &mockContentRepository{}, &mockScoringService{}, &mockCacheRepository{})
+
+	jobID, err := useCase.ExecuteAsync()
+	require.NoError(t, err)
+	require.NotEmpty(t, jobID)
+
+	var job *SyncJob
+	require.Eventually(t, func() bool {
+		job, _ = useCase.Job(jobID)
+		return job != nil && job.Status != SyncStatusRunning
+	}, time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, SyncStatusSuccess, job.Status)
+	require.Len(t, job.Providers, 1)
+	assert.Equal(t, 1, job.Providers[0].ItemsSynced)
+
+	_, err = useCase.ExecuteProviderAsync(99)
+	assert.ErrorIs(t, err, port.ErrProviderNotFound)
+}
@@ -2,22 +2,77 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
 	"github.com/onurerdog4n/search-engine/internal/domain/entity"
 	"github.com/onurerdog4n/search-engine/internal/domain/port"
 	"github.com/onurerdog4n/search-engine/internal/domain/service"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/logger"
 )
 
+// syncBatchSize tek seferde toplu yazılan içerik sayısı
+const syncBatchSize = 500
+
+// ErrSyncShutdown sunucu kapanırken yeni senkronizasyon başlatılmak istendiğinde döner
+var ErrSyncShutdown = errors.New("sync is shutting down")
+
+// ErrSyncTimeout senkronizasyon veya tek bir provider'ın senkronizasyonu süre sınırını aştığında döner
+var ErrSyncTimeout = errors.New("sync timed out")
+
 // SyncProviderContentsUseCase provider senkronizasyon use case'i
 type SyncProviderContentsUseCase struct {
 	providerClients []port.ProviderClient
 	contentRepo     port.ContentRepository
 	scoringService  service.ScoringService
 	cache           port.CacheRepository
+
+	// Provider client'larının çalışma anında yeniden yüklenmesi için
+	mu            sync.RWMutex
+	providerRepo  port.ProviderRepository
+	clientFactory port.ProviderClientFactory
+
+	syncLogRepo  port.ProviderRepository  // nil ise sync logları ve karantina kayıtları yazılmaz
+	transactor   port.Transactor          // nil ise yazmalar transaction'sız yapılır
+	dedup        service.DedupService     // nil ise kopya tespiti yapılmaz
+	authorRepo   port.AuthorRepository    // nil ise yazar/kanal bilgileri kaydedilmez
+	categoryRepo port.CategoryRepository  // nil ise kategoriler kaydedilmez
+	ctrRepo      port.CTRSignalRepository // nil ise skorlarda CTR sinyali kullanılmaz
+	jobs         *SyncJobTracker
+
+	searchIndexer SearchIndexer // nil ise arama Postgres'ten yapılır, indeks güncellenmez
+	cacheWarmer   CacheWarmer   // nil ise senkronizasyon sonrası cache ısıtılmaz
+	warmupLimit   int           // Senkronizasyon sonrası ısıtılacak en popüler sorgu sayısı
+
+	publisher port.ContentChangePublisher // nil ise yazılan içerikler canlı akışa yayınlanmaz
+
+	// Paralellik (bkz. SetConcurrency, SetParallelBatchCommits)
+	providerSlots        chan struct{} // nil ise tüm provider'lar aynı anda senkronize edilir
+	workerSlots          chan struct{} // nil ise yazma transaction'ları sınırlanmaz
+	workersPerProvider   int
+	parallelBatchCommits bool // true ise worker pool açıkken batch'ler paralel ve kendi transaction'larında yazılır
+
+	// Süre sınırları (bkz. SetTimeouts), 0 ise sınır yok
+	jobTimeout      time.Duration
+	providerTimeout time.Duration
+
+	syncAttempted atomic.Bool // Açılıştan beri en az bir senkronizasyon (başarılı veya hatalı) bitti mi
+
+	ingestInvalidationPending atomic.Bool // Değişiklik akışı için planlanmış bir cache temizliği var mı
+
+	// Graceful shutdown: devam eden senkronizasyonlar beklenir, süre dolarsa iptal edilir
+	baseCtx  context.Context
+	cancel   context.CancelFunc
+	inflight sync.WaitGroup
+	closed   bool
 }
 
 // NewSyncProviderContentsUseCase yeni bir sync use case oluşturur
@@ -27,146 +82,1035 @@ func NewSyncProviderContentsUseCase(
 	scoringService service.ScoringService,
 	cache port.CacheRepository,
 ) *SyncProviderContentsUseCase {
+	baseCtx, cancel := context.WithCancel(context.Background())
 	return &SyncProviderContentsUseCase{
 		providerClients: providerClients,
 		contentRepo:     contentRepo,
 		scoringService:  scoringService,
 		cache:           cache,
+		jobs:            NewSyncJobTracker(defaultMaxSyncJobs),
+		baseCtx:         baseCtx,
+		cancel:          cancel,
+
+		workersPerProvider: 1,
+	}
+}
+
+// SetTimeouts senkronizasyonların süre sınırlarını ayarlar; 0 sınırı kapatır
+// job: tüm provider'lar, indeks ve cache ısıtma dahil bir senkronizasyonun toplam süresi
+// provider: tek bir provider'ın içerik çekme ve yazma süresi; asılı kalan provider diğerlerini ve job'u bekletmez
+func (uc *SyncProviderContentsUseCase) SetTimeouts(job, provider time.Duration) {
+	uc.jobTimeout = job
+	uc.providerTimeout = provider
+}
+
+// withSyncTimeout d > 0 ise ctx'e süre sınırı ekler; süre dolunca context.Cause ErrSyncTimeout'u sarar
+func withSyncTimeout(ctx context.Context, d time.Duration, scope string) (context.Context, context.CancelFunc) {
+	if d <= 0 {
+		return context.WithCancel(ctx)
 	}
+	return context.WithTimeoutCause(ctx, d, fmt.Errorf("%w: %s exceeded %v", ErrSyncTimeout, scope, d))
+}
+
+// syncTimeoutError ctx süre sınırı nedeniyle iptal edildiyse err'i zaman aşımı nedeniyle sarar
+// Böylece job ve sync logu "context deadline exceeded" yerine hangi sınırın aşıldığını gösterir
+func syncTimeoutError(ctx context.Context, err error) error {
+	cause := context.Cause(ctx)
+	if err == nil || !errors.Is(cause, ErrSyncTimeout) || errors.Is(err, ErrSyncTimeout) {
+		return err
+	}
+	return fmt.Errorf("%w: %w", cause, err)
+}
+
+// SetProviderSource client'ların yeniden yükleneceği provider kaynağını ayarlar
+// ReloadProviderClients çağrılmadan önce set edilmelidir
+func (uc *SyncProviderContentsUseCase) SetProviderSource(providerRepo port.ProviderRepository, clientFactory port.ProviderClientFactory) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	uc.providerRepo = providerRepo
+	uc.clientFactory = clientFactory
+}
+
+// SetSyncLogRepository her senkronizasyon denemesi için log ve karantina kayıtlarının yazılacağı repository'yi ayarlar
+func (uc *SyncProviderContentsUseCase) SetSyncLogRepository(repo port.ProviderRepository) {
+	uc.syncLogRepo = repo
+}
+
+// SearchIndexer senkronizasyon sonrası harici arama indeksini Postgres'ten yeniden oluşturur
+type SearchIndexer interface {
+	Reindex(ctx context.Context) (int, error)
+}
+
+// SetSearchIndexer her senkronizasyondan sonra (cache temizlenmeden önce) çalışacak indeksleyiciyi ayarlar
+func (uc *SyncProviderContentsUseCase) SetSearchIndexer(indexer SearchIndexer) {
+	uc.searchIndexer = indexer
+}
+
+// CacheWarmer cache temizlendikten sonra sık aranan sorguları önceden çalıştırıp cache'i doldurur
+type CacheWarmer interface {
+	WarmUp(ctx context.Context, limit int) (int, error)
+}
+
+// SetCacheWarmer senkronizasyon sonrası en popüler limit kadar sorguyu ısıtacak warmer'ı ayarlar
+// Böylece invalidation sonrası tüm kullanıcılar aynı anda soğuk cache'e düşmez
+func (uc *SyncProviderContentsUseCase) SetCacheWarmer(warmer CacheWarmer, limit int) {
+	uc.cacheWarmer = warmer
+	uc.warmupLimit = limit
+}
+
+// SetChangePublisher commit edilen içerik eklemelerinin ve güncellemelerinin yayınlanacağı publisher'ı ayarlar
+func (uc *SyncProviderContentsUseCase) SetChangePublisher(publisher port.ContentChangePublisher) {
+	uc.publisher = publisher
+}
+
+// SetTransactor her provider'ın senkronizasyonunu tek transaction içinde çalıştıracak transactor'ı ayarlar
+func (uc *SyncProviderContentsUseCase) SetTransactor(transactor port.Transactor) {
+	uc.transactor = transactor
+}
+
+// SetAuthorRepository içeriklerle gelen yazar/kanal bilgilerinin kaydedileceği repository'yi ayarlar
+func (uc *SyncProviderContentsUseCase) SetAuthorRepository(repo port.AuthorRepository) {
+	uc.authorRepo = repo
+}
+
+// SetCategoryRepository içeriklerle gelen kategori yollarının kaydedileceği repository'yi ayarlar
+func (uc *SyncProviderContentsUseCase) SetCategoryRepository(repo port.CategoryRepository) {
+	uc.categoryRepo = repo
+}
+
+// SetCTRSignalRepository skorlanan içeriklerin kayıtlı CTR sinyallerinin okunacağı repository'yi ayarlar
+// Ayarlanmazsa sync'te yazılan skorlar gecelik CTR işinin hesapladığı bileşeni kaybeder
+func (uc *SyncProviderContentsUseCase) SetCTRSignalRepository(repo port.CTRSignalRepository) {
+	uc.ctrRepo = repo
+}
+
+// ReloadProviderClients aktif provider'ları repository'den okuyup client listesini yeniden oluşturur
+// Devam eden senkronizasyonlar eski listeyle tamamlanır, sonraki çalıştırmalar yeni listeyi kullanır
+func (uc *SyncProviderContentsUseCase) ReloadProviderClients(ctx context.Context) error {
+	uc.mu.RLock()
+	providerRepo, clientFactory := uc.providerRepo, uc.clientFactory
+	uc.mu.RUnlock()
+
+	if providerRepo == nil || clientFactory == nil {
+		return fmt.Errorf("provider kaynağı ayarlanmamış")
+	}
+
+	providers, err := providerRepo.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("provider'lar okunamadı: %w", err)
+	}
+
+	clients := make([]port.ProviderClient, 0, len(providers))
+	for _, p := range providers {
+		client, err := clientFactory(p)
+		if err != nil {
+			// Hatalı provider diğerlerinin yüklenmesini engellemez
+			logger.FromContext(ctx).Error("provider client could not be created",
+				zap.String("provider", p.Name),
+				zap.Error(err),
+			)
+			continue
+		}
+		clients = append(clients, client)
+	}
+
+	uc.mu.Lock()
+	uc.providerClients = clients
+	uc.mu.Unlock()
+
+	logger.FromContext(ctx).Info("provider clients reloaded", zap.Int("count", len(clients)))
+	return nil
+}
+
+// ProviderClients güncel provider client listesinin kopyasını döner
+func (uc *SyncProviderContentsUseCase) ProviderClients() []port.ProviderClient {
+	uc.mu.RLock()
+	defer uc.mu.RUnlock()
+	return append([]port.ProviderClient(nil), uc.providerClients...)
 }
 
 // Execute tüm provider'lardan veri çeker ve senkronize eder
 func (uc *SyncProviderContentsUseCase) Execute(ctx context.Context) error {
-	log.Println("Provider senkronizasyonu başlatılıyor...")
+	if !uc.acquire() {
+		return ErrSyncShutdown
+	}
+	defer uc.inflight.Done()
+
+	clients := uc.ProviderClients()
+	job := uc.jobs.Start(clients)
+	uc.runJob(ctx, job.ID, clients)
+	return nil
+}
+
+// ExecuteProvider sadece verilen provider'ı senkronize eder
+// Provider aktif client'lar arasında yoksa port.ErrProviderNotFound döner
+func (uc *SyncProviderContentsUseCase) ExecuteProvider(ctx context.Context, providerID int64) error {
+	client := uc.findClient(providerID)
+	if client == nil {
+		return port.ErrProviderNotFound
+	}
+
+	if !uc.acquire() {
+		return ErrSyncShutdown
+	}
+	defer uc.inflight.Done()
+
+	clients := []port.ProviderClient{client}
+	job := uc.jobs.Start(clients)
+	if err := uc.runJob(ctx, job.ID, clients); err != nil {
+		return fmt.Errorf("provider senkronizasyon hatası (%s): %w", client.GetProviderInfo().Name, err)
+	}
+
+	return nil
+}
 
-	var wg sync.WaitGroup
+// InitialSyncAttempted açılıştan beri en az bir senkronizasyonun bitip bitmediğini döner
+// Provider hataları sonucu değiştirmez; readiness kontrolü yalnızca denemenin yapılmasını bekler
+func (uc *SyncProviderContentsUseCase) InitialSyncAttempted() bool {
+	return uc.syncAttempted.Load()
+}
+
+// Job sync job'unun güncel durumunu döner
+func (uc *SyncProviderContentsUseCase) Job(jobID string) (*SyncJob, bool) {
+	return uc.jobs.Get(jobID)
+}
+
+// runJob verilen provider'ları paralel (en fazla providerSlots kadarını aynı anda) senkronize eder,
+// sonuçları job tracker'a yazar. Birden fazla provider başarısız olursa ilk hata döner
+func (uc *SyncProviderContentsUseCase) runJob(ctx context.Context, jobID string, clients []port.ProviderClient) error {
+	// Job'a ait tüm log satırları (repository ve provider dahil) job ID'sini taşır
+	log := logger.FromContext(ctx).WithFields(zap.String("job_id", jobID))
+	ctx = logger.NewContext(ctx, log)
+	log.Info("provider sync started", zap.Int("providers", len(clients)))
+	start := time.Now()
+
+	// Shutdown süresi veya job süre sınırı dolduğunda çağıranın context'i de iptal edilir
+	ctx, cancel := withSyncTimeout(ctx, uc.jobTimeout, "sync job")
+	defer cancel()
+	stop := context.AfterFunc(uc.baseCtx, cancel)
+	defer stop()
+
+	// Job içindeki içerik değişiklikleri audit kaydına job ID'siyle yazılır
+	ctx = port.WithAuditInfo(ctx, port.AuditInfo{Actor: port.AuditActorSync, SyncJobID: jobID})
+
+	var (
+		wg       sync.WaitGroup
+		errMu    sync.Mutex
+		firstErr error
+	)
 	// Her provider için senkronizasyon yap
-	for _, client := range uc.providerClients {
+	for _, client := range clients {
 		wg.Add(1)
 		go func(c port.ProviderClient) {
 			defer wg.Done()
-			if err := uc.syncProvider(ctx, c); err != nil {
-				log.Printf("Provider senkronizasyon hatası (%s): %v",
-					c.GetProviderInfo().Name, err)
+			// Provider limiti doluysa sıra beklenir; beklerken iptal edilen provider hiç başlamaz
+			var syncedCount int
+			var err error
+			if acquireSlot(ctx, uc.providerSlots) {
+				syncedCount, err = uc.syncProvider(ctx, c)
+				releaseSlot(uc.providerSlots)
+			} else {
+				err = syncTimeoutError(ctx, ctx.Err())
+			}
+			uc.jobs.FinishProvider(jobID, c.GetProviderInfo().ID, syncedCount, err)
+			if err != nil {
+				log.Error("provider sync failed",
+					zap.String("provider", c.GetProviderInfo().Name),
+					zap.Error(err),
+				)
+				errMu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				errMu.Unlock()
 			}
 		}(client)
 	}
 
 	wg.Wait()
-	
-	// Cache'i temizle (Invalidation)
-	if err := uc.cache.Clear(ctx); err != nil {
-		log.Printf("Cache temizleme hatası: %v", err)
+
+	// Arama indeksi kullanılıyorsa yeni veriyi indekse yaz (cache'ten önce, warm-up güncel indeksi okusun)
+	uc.reindex(ctx)
+
+	// Arama cache'ini temizle (Invalidation); diğer key'ler korunur
+	// Job iptal edilmiş veya süresi dolmuş olsa da yazılan içerikler eski sonuçlarla gizlenmesin
+	if err := invalidateContentCache(context.WithoutCancel(ctx), uc.cache); err != nil {
+		log.Error("content cache could not be invalidated", zap.Error(err))
 	}
+	uc.warmUpCache(ctx)
 
-	log.Println("Provider senkronizasyonu tamamlandı")
+	uc.jobs.Finish(jobID)
+	uc.syncAttempted.Store(true)
+	log.Info("provider sync completed", zap.Duration("duration", time.Since(start)))
+	return firstErr
+}
+
+// reindex varsa arama indeksini yeniden oluşturur (hata kritik değil, aramalar eski indeksle devam eder)
+func (uc *SyncProviderContentsUseCase) reindex(ctx context.Context) {
+	if uc.searchIndexer == nil {
+		return
+	}
+
+	indexed, err := uc.searchIndexer.Reindex(ctx)
+	if err != nil {
+		logger.FromContext(ctx).Error("search index could not be updated", zap.Error(err))
+		return
+	}
+	logger.FromContext(ctx).Info("search index updated", zap.Int("count", indexed))
+}
+
+// warmUpCache varsa en popüler sorguları çalıştırıp cache'i doldurur (hata kritik değil)
+func (uc *SyncProviderContentsUseCase) warmUpCache(ctx context.Context) {
+	if uc.cacheWarmer == nil || uc.warmupLimit <= 0 {
+		return
+	}
+
+	warmed, err := uc.cacheWarmer.WarmUp(ctx, uc.warmupLimit)
+	if err != nil {
+		logger.FromContext(ctx).Warn("cache warm-up failed", zap.Error(err))
+	}
+	if warmed > 0 {
+		logger.FromContext(ctx).Info("cache warmed up", zap.Int("queries", warmed))
+	}
+}
+
+// findClient provider ID'sine göre aktif client'ı bulur
+func (uc *SyncProviderContentsUseCase) findClient(providerID int64) port.ProviderClient {
+	for _, client := range uc.ProviderClients() {
+		if client.GetProviderInfo().ID == providerID {
+			return client
+		}
+	}
 	return nil
 }
 
-// syncProvider tek bir provider'ı senkronize eder
-func (uc *SyncProviderContentsUseCase) syncProvider(ctx context.Context, client port.ProviderClient) error {
+// syncProvider tek bir provider'ı senkronize eder ve denemeyi sync loguna yazar
+func (uc *SyncProviderContentsUseCase) syncProvider(ctx context.Context, client port.ProviderClient) (int, error) {
+	provider := client.GetProviderInfo()
+	ctx, span := tracer.Start(ctx, "SyncProviderContentsUseCase.syncProvider", trace.WithAttributes(
+		attribute.Int64("provider.id", provider.ID),
+		attribute.String("provider.name", provider.Name),
+	))
+	syncLog := uc.startSyncLog(ctx, provider.ID)
+
+	providerCtx, cancel := withSyncTimeout(ctx, uc.providerTimeout, "provider sync")
+	syncedCount, err := uc.runProviderSync(providerCtx, client)
+	err = syncTimeoutError(providerCtx, err)
+	cancel()
+
+	// Sonuç, senkronizasyon iptal edilmiş veya süresi dolmuş olsa da loga yazılır
+	uc.finishSyncLog(context.WithoutCancel(ctx), syncLog, syncedCount, err)
+	span.SetAttributes(attribute.Int("sync.synced", syncedCount))
+	endSpan(span, err)
+	return syncedCount, err
+}
+
+// runProviderSync provider içeriklerini çeker, işler ve senkronize edilen içerik sayısını döner
+func (uc *SyncProviderContentsUseCase) runProviderSync(ctx context.Context, client port.ProviderClient) (int, error) {
 	provider := client.GetProviderInfo()
-	log.Printf("Provider senkronizasyonu başlıyor: %s", provider.Name)
+	log := logger.FromContext(ctx).WithFields(
+		zap.String("provider", provider.Name),
+		zap.Int64("provider_id", provider.ID),
+	)
+	ctx = logger.NewContext(ctx, log)
+	log.Info("provider sync starting")
 
 	startTime := time.Now()
-	syncedCount := 0
 
 	// 1. Provider'dan içerikleri çek
 	normalized, err := client.FetchContents(ctx)
+	if errors.Is(err, port.ErrNotModified) {
+		// İçerik değişmedi: yazma, skorlama ve soft delete tamamen atlanır
+		log.Info("provider contents not modified, sync skipped")
+		return 0, nil
+	}
 	if err != nil {
-		return fmt.Errorf("içerikler çekilemedi: %w", err)
+		return 0, fmt.Errorf("içerikler çekilemedi: %w", err)
 	}
 
-	log.Printf("%s provider'ından %d içerik çekildi", provider.Name, len(normalized))
+	log.Info("provider contents fetched", zap.Int("count", len(normalized)))
 
-	// 2. Her içerik için işlem yap
-	for _, nc := range normalized {
-		if err := uc.processContent(ctx, provider.ID, nc); err != nil {
-			log.Printf("İçerik işleme hatası (ID: %s): %v", nc.ExternalID, err)
-			continue
+	// Geçersiz içerikler yazılmaz, ham verileriyle karantinaya alınır
+	normalized, rejected := filterValidContents(client, normalized)
+	uc.quarantineRejected(ctx, provider, rejected)
+
+	// 2-3. İçerikleri yaz ve feed'de olmayanları sil
+	written, complete, err := uc.writeProviderContents(ctx, provider, normalized, startTime)
+	if err != nil {
+		// Paralel batch commit'inde hatadan önce commit edilen batch'ler kalır, değişiklikleri yine yayınlanır
+		uc.publishChanges(provider, written)
+		return len(written), err
+	}
+	syncedCount := len(written)
+
+	// Yazmalar commit edildi, değişiklikler canlı akış abonelerine iletilir
+	uc.publishChanges(provider, written)
+
+	// Sonraki koşullu istekler sadece eksiksiz bir senkronizasyonun değerlerini kullanır
+	if complete {
+		uc.commitFetchValidators(ctx, client)
+	}
+
+	// Yazılan içerikler diğer provider'lardaki kopyalarına bağlanır
+	if syncedCount > 0 {
+		uc.linkDuplicates(ctx, provider.ID, startTime)
+	}
+
+	duration := time.Since(startTime)
+	log.Info("provider sync completed",
+		zap.Int("count", syncedCount),
+		zap.Duration("duration", duration),
+	)
+
+	return syncedCount, nil
+}
+
+// writeProviderContents içerikleri batch'ler halinde yazar ve tümü yazıldıysa feed'de olmayanları soft delete eder
+// Yazma ve soft delete tek transaction içinde yapılır; hata olursa provider'ın tüm değişiklikleri geri alınır ve
+// yazılan içerik dönmez. Worker pool açıksa transaction bir worker yeri bekler, böylece diğer provider'lar çekilip
+// normalize edilirken aynı anda açık yazma transaction'ı sayısı sınırlanır. SetParallelBatchCommits ile batch'ler
+// paralel ve kendi transaction'larında yazılır
+// Yazılan içerikleri ve soft delete'in yapılıp yapılmadığını döner
+func (uc *SyncProviderContentsUseCase) writeProviderContents(
+	ctx context.Context,
+	provider *entity.Provider,
+	normalized []*entity.NormalizedContent,
+	startTime time.Time,
+) ([]*entity.Content, bool, error) {
+	if uc.workerSlots != nil && uc.parallelBatchCommits {
+		written := uc.processBatchesParallel(ctx, provider.ID, normalized)
+		complete, err := uc.markStaleContents(ctx, provider, len(normalized)-len(written), startTime)
+		return written, complete, err
+	}
+
+	if !acquireSlot(ctx, uc.workerSlots) {
+		return nil, false, ctx.Err()
+	}
+	defer releaseSlot(uc.workerSlots)
+
+	var written []*entity.Content
+	complete := false
+	err := uc.withinTx(ctx, func(ctx context.Context) error {
+		// 2. İçerikleri batch'ler halinde işle
+		for start := 0; start < len(normalized) && ctx.Err() == nil; start += syncBatchSize {
+			end := start + syncBatchSize
+			if end > len(normalized) {
+				end = len(normalized)
+			}
+			written = append(written, uc.processBatch(ctx, provider.ID, normalized[start:end])...)
 		}
-		syncedCount++
+
+		var err error
+		complete, err = uc.markStaleContents(ctx, provider, len(normalized)-len(written), startTime)
+		return err
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return written, complete, nil
+}
+
+// markStaleContents 3. adım: senkronizasyonda güncellenmeyen içerikleri silinmiş olarak işaretler (Soft Delete)
+// Bazı içerikler yazılamadıysa güncellenmemiş görünürler, yanlışlıkla silinmesinler; bu durumda false döner
+func (uc *SyncProviderContentsUseCase) markStaleContents(ctx context.Context, provider *entity.Provider, failed int, startTime time.Time) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
 	}
 
-	// 3. Silinmiş olanları işaretle (Soft Delete)
+	if failed > 0 {
+		logger.FromContext(ctx).Warn("some contents could not be processed, skipping stale content deletion",
+			zap.String("provider", provider.Name),
+			zap.Int("failed", failed),
+		)
+		return false, nil
+	}
 	if err := uc.contentRepo.MarkStaleContentsAsDeleted(ctx, provider.ID, startTime); err != nil {
-		log.Printf("Silinmiş içerikleri işaretleme hatası (%s): %v", provider.Name, err)
+		return false, fmt.Errorf("silinmiş içerikleri işaretleme hatası: %w", err)
 	}
+	return true, nil
+}
 
-	duration := time.Since(startTime)
-	log.Printf("Provider senkronizasyonu tamamlandı: %s (%d içerik, %v)",
-		provider.Name, syncedCount, duration)
+// publishChanges commit edilen içerikleri "created" (yeni eklenen) veya "updated" olarak yayınlar
+// Eklenen satırda created_at ve updated_at aynı transaction zamanını taşır; güncellemede updated_at ilerler
+func (uc *SyncProviderContentsUseCase) publishChanges(provider *entity.Provider, contents []*entity.Content) {
+	if uc.publisher == nil || len(contents) == 0 {
+		return
+	}
+
+	now := time.Now()
+	changes := make([]entity.ContentChange, len(contents))
+	for i, content := range contents {
+		content.Provider = &entity.ContentProvider{ID: provider.ID, Name: provider.Name, Format: provider.Format}
+
+		changeType := entity.ContentChangeUpdated
+		if content.CreatedAt.Equal(content.UpdatedAt) {
+			changeType = entity.ContentChangeCreated
+		}
+		changes[i] = entity.ContentChange{Type: changeType, Content: content, OccurredAt: now}
+	}
+	uc.publisher.Publish(changes)
+}
+
+// commitFetchValidators koşullu istek destekleyen client'ın son ETag / Last-Modified değerlerini
+// kaydeder ve kabul eder. Kayıt hatası kritik değil: restart sonrası ilk sync tam çekim yapar
+func (uc *SyncProviderContentsUseCase) commitFetchValidators(ctx context.Context, client port.ProviderClient) {
+	fetcher, ok := client.(port.ConditionalFetcher)
+	if !ok {
+		return
+	}
+
+	uc.mu.RLock()
+	providerRepo := uc.providerRepo
+	uc.mu.RUnlock()
+
+	if providerRepo != nil {
+		etag, lastModified := fetcher.PendingValidators()
+		if err := providerRepo.UpdateFetchValidators(ctx, client.GetProviderInfo().ID, etag, lastModified); err != nil {
+			logger.FromContext(ctx).Warn("conditional request validators could not be saved",
+				zap.String("provider", client.GetProviderInfo().Name),
+				zap.Error(err),
+			)
+		}
+	}
+	fetcher.CommitValidators()
+}
+
+// startSyncLog "running" durumunda sync logu oluşturur
+// Log yazılamazsa senkronizasyon yine de devam eder (nil döner)
+func (uc *SyncProviderContentsUseCase) startSyncLog(ctx context.Context, providerID int64) *entity.ProviderSyncLog {
+	if uc.syncLogRepo == nil {
+		return nil
+	}
+
+	syncLog := &entity.ProviderSyncLog{
+		ProviderID: providerID,
+		StartedAt:  time.Now(),
+		Status:     SyncStatusRunning,
+	}
+	if err := uc.syncLogRepo.CreateSyncLog(ctx, syncLog); err != nil {
+		logger.FromContext(ctx).Error("sync log could not be created",
+			zap.Int64("provider_id", providerID),
+			zap.Error(err),
+		)
+		return nil
+	}
+	return syncLog
+}
 
+// finishSyncLog sync logunu sonuç durumuyla günceller
+func (uc *SyncProviderContentsUseCase) finishSyncLog(ctx context.Context, syncLog *entity.ProviderSyncLog, syncedCount int, syncErr error) {
+	if syncLog == nil {
+		return
+	}
+
+	completedAt := time.Now()
+	syncLog.CompletedAt = &completedAt
+	syncLog.ItemsSynced = int32(syncedCount)
+	syncLog.Status = SyncStatusSuccess
+	if syncErr != nil {
+		syncLog.Status = SyncStatusFailed
+		syncLog.ErrorMessage = syncErr.Error()
+	}
+
+	if err := uc.syncLogRepo.UpdateSyncLog(ctx, syncLog); err != nil {
+		logger.FromContext(ctx).Error("sync log could not be updated",
+			zap.Int64("sync_log_id", syncLog.ID),
+			zap.Error(err),
+		)
+	}
+}
+
+// withinTx transactor ayarlıysa fn'i transaction (iç içe çağrılarda savepoint) içinde çalıştırır
+func (uc *SyncProviderContentsUseCase) withinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if uc.transactor == nil {
+		return fn(ctx)
+	}
+	return uc.transactor.WithinTransaction(ctx, fn)
+}
+
+// processBatch bir grup içeriği toplu sorgularla işler (bulk upsert + stats + score + tags)
+// Toplu yazma başarısız olursa hatalı içeriği izole etmek için tek tek işlemeye düşer
+// Batch ve her içerik kendi savepoint'inde yazılır; başarısız olanın yarım kalan yazmaları geri alınır
+// Başarıyla yazılan içerikleri döner
+func (uc *SyncProviderContentsUseCase) processBatch(
+	ctx context.Context,
+	providerID int64,
+	batch []*entity.NormalizedContent,
+) []*entity.Content {
+	var contents []*entity.Content
+	err := uc.withinTx(ctx, func(ctx context.Context) error {
+		var err error
+		contents, err = uc.writeBatch(ctx, providerID, batch)
+		return err
+	})
+	if err != nil {
+		logger.FromContext(ctx).Warn("content batch could not be processed, retrying one by one",
+			zap.Int("count", len(batch)),
+			zap.Error(err),
+		)
+
+		processed := make([]*entity.Content, 0, len(batch))
+		for _, nc := range batch {
+			// İptal edilen senkronizasyonda kalan içerikler tek tek denenmez
+			if ctx.Err() != nil {
+				break
+			}
+			var content *entity.Content
+			err := uc.withinTx(ctx, func(ctx context.Context) error {
+				var err error
+				content, err = uc.processContent(ctx, providerID, nc)
+				return err
+			})
+			if err != nil {
+				logger.FromContext(ctx).Error("content could not be processed",
+					zap.String("external_id", nc.ExternalID),
+					zap.Error(err),
+				)
+				continue
+			}
+			processed = append(processed, content)
+		}
+		return processed
+	}
+
+	return contents
+}
+
+// writeBatch batch'i bulk upsert, bulk stats ve bulk score sorgularıyla yazar ve yazılan içerikleri döner
+func (uc *SyncProviderContentsUseCase) writeBatch(
+	ctx context.Context,
+	providerID int64,
+	batch []*entity.NormalizedContent,
+) ([]*entity.Content, error) {
+	// 1. Yazarları ve kategorileri kaydet, Content entity'lerini oluştur ve toplu upsert yap
+	authors, err := uc.upsertAuthors(ctx, providerID, batch)
+	if err != nil {
+		return nil, err
+	}
+	categories, err := uc.upsertCategories(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	providerLanguage := uc.providerLanguage(providerID)
+	status := uc.newContentStatus(providerID)
+	contents := make([]*entity.Content, len(batch))
+	for i, nc := range batch {
+		contents[i] = &entity.Content{
+			ProviderID:        providerID,
+			ProviderContentID: nc.ExternalID,
+			Title:             nc.Title,
+			Description:       nc.Description,
+			ContentType:       nc.ContentType,
+			Language:          contentLanguage(providerLanguage, nc),
+			PublishedAt:       nc.PublishedAt,
+			Author:            authors[i],
+			Category:          categories[i],
+			URL:               nc.URL,
+			ThumbnailURL:      nc.ThumbnailURL,
+			Status:            status,
+		}
+	}
+
+	if err := uc.contentRepo.BulkUpsert(ctx, contents); err != nil {
+		return nil, fmt.Errorf("bulk upsert hatası: %w", err)
+	}
+
+	// 2. Stats'ları toplu yaz
+	stats := make([]*entity.ContentStats, len(batch))
+	for i, nc := range batch {
+		stats[i] = &entity.ContentStats{
+			ContentID:       contents[i].ID,
+			Views:           nc.Stats.Views,
+			Likes:           nc.Stats.Likes,
+			ReadingTime:     nc.Stats.ReadingTime,
+			Reactions:       nc.Stats.Reactions,
+			DurationSeconds: nc.Stats.DurationSeconds,
+			Comments:        nc.Stats.Comments,
+		}
+		// Stats ve tag'leri content'e ekle (skorlama için gerekli)
+		contents[i].Stats = stats[i]
+		contents[i].Tags = tagEntities(nc.Tags)
+	}
+
+	if err := uc.contentRepo.BulkCreateOrUpdateStats(ctx, stats); err != nil {
+		return nil, fmt.Errorf("bulk stats hatası: %w", err)
+	}
+
+	// 3. Skorları hesapla ve toplu yaz
+	if err := uc.loadCTRLifts(ctx, contents); err != nil {
+		return nil, err
+	}
+	scores := make([]*entity.ContentScore, 0, len(contents))
+	for _, content := range contents {
+		score, err := uc.scoringService.CalculateScore(content)
+		if err != nil {
+			return nil, fmt.Errorf("skor hesaplama hatası (ID: %s): %w", content.ProviderContentID, err)
+		}
+		if score != nil {
+			score.ContentID = content.ID
+			scores = append(scores, score)
+		}
+		content.Score = score
+	}
+
+	if err := uc.contentRepo.BulkCreateOrUpdateScores(ctx, scores); err != nil {
+		return nil, fmt.Errorf("bulk skor hatası: %w", err)
+	}
+
+	// 4. Tag'leri ekle
+	for i, nc := range batch {
+		if len(nc.Tags) == 0 {
+			continue
+		}
+		if err := uc.addTags(ctx, contents[i].ID, nc.Tags); err != nil {
+			// Tag hatası kritik değil, logla ve devam et
+			logger.FromContext(ctx).Warn("content tags could not be added",
+				zap.Int64("content_id", contents[i].ID),
+				zap.Error(err),
+			)
+		}
+	}
+
+	return contents, nil
+}
+
+// loadCTRLifts içeriklerin kayıtlı CTR sinyallerini Score.CTRLift'e yükler (skorlama için gerekli)
+// Score skorlamadan sonra hesaplanan skorla değiştirilir
+func (uc *SyncProviderContentsUseCase) loadCTRLifts(ctx context.Context, contents []*entity.Content) error {
+	if uc.ctrRepo == nil {
+		return nil
+	}
+
+	ids := make([]int64, len(contents))
+	for i, content := range contents {
+		ids[i] = content.ID
+	}
+	lifts, err := uc.ctrRepo.FindCTRLifts(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("CTR sinyalleri okunamadı: %w", err)
+	}
+
+	for _, content := range contents {
+		if lift, ok := lifts[content.ID]; ok {
+			content.Score = &entity.ContentScore{ContentID: content.ID, CTRLift: lift}
+		}
+	}
 	return nil
 }
 
-// processContent tek bir içeriği işler (upsert + stats + score + tags)
+// processContent tek bir içeriği işler (upsert + stats + score + tags) ve yazılan içeriği döner
 func (uc *SyncProviderContentsUseCase) processContent(
 	ctx context.Context,
 	providerID int64,
 	nc *entity.NormalizedContent,
-) error {
-	// 1. Content entity'sini oluştur
+) (*entity.Content, error) {
+	// 1. Yazarı ve kategoriyi kaydet, Content entity'sini oluştur
+	authors, err := uc.upsertAuthors(ctx, providerID, []*entity.NormalizedContent{nc})
+	if err != nil {
+		return nil, err
+	}
+	categories, err := uc.upsertCategories(ctx, []*entity.NormalizedContent{nc})
+	if err != nil {
+		return nil, err
+	}
+
 	content := &entity.Content{
 		ProviderID:        providerID,
 		ProviderContentID: nc.ExternalID,
 		Title:             nc.Title,
 		Description:       nc.Description,
 		ContentType:       nc.ContentType,
+		Language:          contentLanguage(uc.providerLanguage(providerID), nc),
 		PublishedAt:       nc.PublishedAt,
+		Author:            authors[0],
+		Category:          categories[0],
+		URL:               nc.URL,
+		ThumbnailURL:      nc.ThumbnailURL,
+		Status:            uc.newContentStatus(providerID),
 	}
 
 	// 2. Upsert yap (varsa güncelle, yoksa ekle)
 	if err := uc.contentRepo.Upsert(ctx, content); err != nil {
-		return fmt.Errorf("upsert hatası: %w", err)
+		return nil, fmt.Errorf("upsert hatası: %w", err)
 	}
 
 	// 3. Stats oluştur/güncelle
 	stats := &entity.ContentStats{
-		ContentID:   content.ID,
-		Views:       nc.Stats.Views,
-		Likes:       nc.Stats.Likes,
-		ReadingTime: nc.Stats.ReadingTime,
-		Reactions:   nc.Stats.Reactions,
+		ContentID:       content.ID,
+		Views:           nc.Stats.Views,
+		Likes:           nc.Stats.Likes,
+		ReadingTime:     nc.Stats.ReadingTime,
+		Reactions:       nc.Stats.Reactions,
+		DurationSeconds: nc.Stats.DurationSeconds,
+		Comments:        nc.Stats.Comments,
 	}
 
 	if err := uc.contentRepo.CreateOrUpdateStats(ctx, stats); err != nil {
-		return fmt.Errorf("stats hatası: %w", err)
+		return nil, fmt.Errorf("stats hatası: %w", err)
 	}
 
-	// Stats'ı content'e ekle (skorlama için gerekli)
+	// Stats ve tag'leri content'e ekle (skorlama için gerekli)
 	content.Stats = stats
+	content.Tags = tagEntities(nc.Tags)
 
 	// 4. Skor hesapla ve kaydet
+	if err := uc.loadCTRLifts(ctx, []*entity.Content{content}); err != nil {
+		return nil, err
+	}
 	score, err := uc.scoringService.CalculateScore(content)
 	if err != nil {
-		return fmt.Errorf("skor hesaplama hatası: %w", err)
+		return nil, fmt.Errorf("skor hesaplama hatası: %w", err)
 	}
 
 	if score != nil {
 		score.ContentID = content.ID
 		if err := uc.contentRepo.CreateOrUpdateScore(ctx, score); err != nil {
-			return fmt.Errorf("skor kaydetme hatası: %w", err)
+			return nil, fmt.Errorf("skor kaydetme hatası: %w", err)
 		}
 	}
+	content.Score = score
 
 	// 5. Tag'leri ekle
 	if len(nc.Tags) > 0 {
-		if err := uc.contentRepo.AddTags(ctx, content.ID, nc.Tags); err != nil {
+		if err := uc.addTags(ctx, content.ID, nc.Tags); err != nil {
 			// Tag hatası kritik değil, logla ve devam et
-			log.Printf("Tag ekleme hatası (Content ID: %d): %v", content.ID, err)
+			logger.FromContext(ctx).Warn("content tags could not be added",
+				zap.Int64("content_id", content.ID),
+				zap.Error(err),
+			)
 		}
 	}
 
-	return nil
+	return content, nil
 }
 
-// ExecuteAsync senkronizasyonu arka planda başlatır
-func (uc *SyncProviderContentsUseCase) ExecuteAsync() {
+// upsertAuthors batch'teki içeriklerin yazarlarını kaydeder ve her içeriğin yazarını batch sırasıyla döner
+// Yazar repository'si ayarlı değilse veya içerikle yazar gelmediyse ilgili eleman nil'dir
+func (uc *SyncProviderContentsUseCase) upsertAuthors(
+	ctx context.Context,
+	providerID int64,
+	batch []*entity.NormalizedContent,
+) ([]*entity.ContentAuthor, error) {
+	result := make([]*entity.ContentAuthor, len(batch))
+	if uc.authorRepo == nil {
+		return result, nil
+	}
+
+	authors := make([]*entity.Author, len(batch))
+	var pending []*entity.Author
+	for i, nc := range batch {
+		if authors[i] = newAuthor(providerID, nc.Author); authors[i] != nil {
+			pending = append(pending, authors[i])
+		}
+	}
+	if len(pending) == 0 {
+		return result, nil
+	}
+
+	if err := uc.authorRepo.BulkUpsertAuthors(ctx, pending); err != nil {
+		return nil, fmt.Errorf("yazar kaydetme hatası: %w", err)
+	}
+
+	for i, author := range authors {
+		if author != nil {
+			result[i] = &entity.ContentAuthor{ID: author.ID, Name: author.Name, URL: author.URL}
+		}
+	}
+	return result, nil
+}
+
+// upsertCategories batch'teki içeriklerin kategori yollarını üst kategorileriyle birlikte kaydeder
+// ve her içeriğin (yaprak) kategorisini batch sırasıyla döner
+// Kategori repository'si ayarlı değilse veya içerikle kategori gelmediyse ilgili eleman nil'dir
+func (uc *SyncProviderContentsUseCase) upsertCategories(
+	ctx context.Context,
+	batch []*entity.NormalizedContent,
+) ([]*entity.ContentCategory, error) {
+	result := make([]*entity.ContentCategory, len(batch))
+	if uc.categoryRepo == nil {
+		return result, nil
+	}
+
+	leaves := make([]*entity.Category, len(batch))
+	var pending []*entity.Category
+	for i, nc := range batch {
+		for depth := 1; depth <= len(nc.Category); depth++ {
+			names := nc.Category[:depth]
+			category := &entity.Category{
+				Name: names[depth-1],
+				Slug: entity.CategorySlug(names[depth-1]),
+				Path: entity.CategoryPath(names),
+			}
+			pending = append(pending, category)
+			leaves[i] = category
+		}
+	}
+	if len(pending) == 0 {
+		return result, nil
+	}
+
+	if err := uc.categoryRepo.BulkUpsertCategories(ctx, pending); err != nil {
+		return nil, fmt.Errorf("kategori kaydetme hatası: %w", err)
+	}
+
+	for i, category := range leaves {
+		if category != nil {
+			result[i] = &entity.ContentCategory{ID: category.ID, Name: category.Name, Path: category.Path}
+		}
+	}
+	return result, nil
+}
+
+// newAuthor provider'dan gelen yazar bilgisinden Author oluşturur; adı olmayan yazarlar için nil döner
+// Provider yazar ID'si göndermediyse yazar adı ID olarak kullanılır
+func newAuthor(providerID int64, na *entity.NormalizedAuthor) *entity.Author {
+	if na == nil {
+		return nil
+	}
+	name := strings.TrimSpace(na.Name)
+	if name == "" {
+		return nil
+	}
+	externalID := strings.TrimSpace(na.ExternalID)
+	if externalID == "" {
+		externalID = name
+	}
+	return &entity.Author{
+		ProviderID: providerID,
+		ExternalID: externalID,
+		Name:       name,
+		URL:        strings.TrimSpace(na.URL),
+	}
+}
+
+// providerLanguage provider'a atanmış içerik dilini döner (atanmamışsa boş)
+func (uc *SyncProviderContentsUseCase) providerLanguage(providerID int64) string {
+	if client := uc.findClient(providerID); client != nil {
+		return client.GetProviderInfo().Language
+	}
+	return ""
+}
+
+// newContentStatus provider'dan ilk kez gelen içeriklerin moderasyon durumunu döner
+// Otomatik onayı kapalı provider'ların yeni içerikleri admin inceleyene kadar beklemede kalır;
+// mevcut içeriklerin durumu upsert'te korunur
+func (uc *SyncProviderContentsUseCase) newContentStatus(providerID int64) entity.ContentStatus {
+	if client := uc.findClient(providerID); client != nil && !client.GetProviderInfo().AutoApprove {
+		return entity.ContentStatusPending
+	}
+	return entity.ContentStatusApproved
+}
+
+// contentLanguage içeriğin arama dilini belirler: provider'a atanmış dil, yoksa içerikle gelen dil,
+// o da yoksa başlık, açıklama ve tag'lerden tespit edilen dil
+func contentLanguage(providerLanguage string, nc *entity.NormalizedContent) string {
+	if providerLanguage != "" {
+		return providerLanguage
+	}
+	if entity.IsSupportedLanguage(nc.Language) {
+		return nc.Language
+	}
+	return service.DetectLanguage(append([]string{nc.Title, nc.Description}, nc.Tags...)...)
+}
+
+// tagEntities provider'dan gelen tag isimlerini tag boost'larıyla eşleşecek şekilde normalize eder
+func tagEntities(names []string) []entity.Tag {
+	normalized := normalizeTags(names)
+	tags := make([]entity.Tag, len(normalized))
+	for i, name := range normalized {
+		tags[i] = entity.Tag{Name: name}
+	}
+	return tags
+}
+
+// addTags tag'leri kendi savepoint'inde ekler
+// Böylece tag hatası provider transaction'ını bozmadan loglanıp geçilebilir
+func (uc *SyncProviderContentsUseCase) addTags(ctx context.Context, contentID int64, tags []string) error {
+	return uc.withinTx(ctx, func(ctx context.Context) error {
+		return uc.contentRepo.AddTags(ctx, contentID, tags)
+	})
+}
+
+// ExecuteAsync senkronizasyonu arka planda başlatır ve takip için job ID'sini döner
+// Shutdown başladıysa ErrSyncShutdown döner
+func (uc *SyncProviderContentsUseCase) ExecuteAsync() (string, error) {
+	if !uc.acquire() {
+		return "", ErrSyncShutdown
+	}
+
+	clients := uc.ProviderClients()
+	job := uc.jobs.Start(clients)
+
+	go func() {
+		defer uc.inflight.Done()
+		if err := uc.runJob(uc.baseCtx, job.ID, clients); err != nil {
+			logger.Error("async sync failed", zap.String("job_id", job.ID), zap.Error(err))
+		}
+	}()
+	return job.ID, nil
+}
+
+// ExecuteProviderAsync tek provider senkronizasyonunu arka planda başlatır ve job ID'sini döner
+// Provider bulunamazsa senkronizasyon başlatılmadan port.ErrProviderNotFound döner
+func (uc *SyncProviderContentsUseCase) ExecuteProviderAsync(providerID int64) (string, error) {
+	client := uc.findClient(providerID)
+	if client == nil {
+		return "", port.ErrProviderNotFound
+	}
+	if !uc.acquire() {
+		return "", ErrSyncShutdown
+	}
+
+	clients := []port.ProviderClient{client}
+	job := uc.jobs.Start(clients)
+
 	go func() {
-		ctx := context.Background()
-		if err := uc.Execute(ctx); err != nil {
-			log.Printf("Async senkronizasyon hatası: %v", err)
+		defer uc.inflight.Done()
+		if err := uc.runJob(uc.baseCtx, job.ID, clients); err != nil {
+			logger.Error("async provider sync failed",
+				zap.String("job_id", job.ID),
+				zap.Int64("provider_id", providerID),
+				zap.Error(err),
+			)
 		}
 	}()
+	return job.ID, nil
+}
+
+// Shutdown yeni senkronizasyonları reddeder ve devam edenlerin bitmesini bekler
+// ctx süresi dolarsa devam eden senkronizasyonlar iptal edilir ve ctx hatası döner
+func (uc *SyncProviderContentsUseCase) Shutdown(ctx context.Context) error {
+	uc.mu.Lock()
+	uc.closed = true
+	uc.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		uc.inflight.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		uc.cancel()
+		return nil
+	case <-ctx.Done():
+		logger.Warn("shutdown timeout reached, cancelling running syncs")
+		uc.cancel()
+		<-done
+		return ctx.Err()
+	}
+}
+
+// acquire shutdown başlamadıysa yeni bir senkronizasyonu kayda alır
+// true dönerse çağıran iş bitince inflight.Done() çağırmalıdır
+func (uc *SyncProviderContentsUseCase) acquire() bool {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	if uc.closed {
+		return false
+	}
+	uc.inflight.Add(1)
+	return true
 }
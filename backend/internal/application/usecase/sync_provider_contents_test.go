@@ -2,23 +2,47 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
 	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/domain/service"
 )
 
+// testPublishedAt doğrulamadan geçen bir yayın tarihi
+var testPublishedAt = time.Now().Add(-24 * time.Hour)
+
 // MockProviderClient
 type mockProviderClient struct {
-	contents []*entity.NormalizedContent
+	contents  []*entity.NormalizedContent
+	err       error
+	moderated bool // true ise provider'ın yeni içerikleri onay bekler (auto_approve kapalı)
 }
 
 func (m *mockProviderClient) FetchContents(ctx context.Context) ([]*entity.NormalizedContent, error) {
-	return m.contents, nil
+	return m.contents, m.err
 }
 func (m *mockProviderClient) GetProviderInfo() *entity.Provider {
-	return &entity.Provider{ID: 1, Name: "Test Provider"}
+	return &entity.Provider{ID: 1, Name: "Test Provider", AutoApprove: !m.moderated}
+}
+
+// mockConditionalClient koşullu istek destekleyen bir provider client'ı taklit eder
+type mockConditionalClient struct {
+	mockProviderClient
+	pendingETag string
+	committed   string
+}
+
+func (m *mockConditionalClient) PendingValidators() (etag, lastModified string) {
+	return m.pendingETag, ""
+}
+func (m *mockConditionalClient) CommitValidators() {
+	m.committed = m.pendingETag
 }
 
 // MockContentRepository
@@ -27,9 +51,25 @@ type mockContentRepository struct {
 	markedDeleted          bool
 	providerID             int64
 	threshold              time.Time
+	upserts                int
+	upserted               []*entity.Content
+	bulkUpserts            int
+	bulkContents           []*entity.Content
+	bulkErr                error
+	upsertErr              error
+	markErr                error
+	existingIDs            map[string]bool
+	deleted                []string
+	duplicateCandidates    []*entity.DuplicateCandidate
+	links                  map[int64]int64
 }
 
 func (m *mockContentRepository) Upsert(ctx context.Context, content *entity.Content) error {
+	if m.upsertErr != nil {
+		return m.upsertErr
+	}
+	m.upserts++
+	m.upserted = append(m.upserted, content)
 	return nil
 }
 func (m *mockContentRepository) CreateOrUpdateStats(ctx context.Context, stats *entity.ContentStats) error {
@@ -42,12 +82,78 @@ func (m *mockContentRepository) AddTags(ctx context.Context, contentID int64, ta
 	return nil
 }
 func (m *mockContentRepository) MarkStaleContentsAsDeleted(ctx context.Context, providerID int64, threshold time.Time) error {
+	if m.markErr != nil {
+		return m.markErr
+	}
 	m.markedDeleted = true
 	m.providerID = providerID
 	m.threshold = threshold
 	return nil
 }
 
+func (m *mockContentRepository) MarkContentDeleted(ctx context.Context, providerID int64, providerContentID string) error {
+	m.deleted = append(m.deleted, providerContentID)
+	return nil
+}
+
+func (m *mockContentRepository) BulkUpsert(ctx context.Context, contents []*entity.Content) error {
+	if m.bulkErr != nil {
+		return m.bulkErr
+	}
+	m.bulkUpserts++
+	m.bulkContents = append(m.bulkContents, contents...)
+	for i, c := range contents {
+		c.ID = int64(i + 1)
+	}
+	return nil
+}
+func (m *mockContentRepository) BulkCreateOrUpdateStats(ctx context.Context, stats []*entity.ContentStats) error {
+	return nil
+}
+func (m *mockContentRepository) BulkCreateOrUpdateScores(ctx context.Context, scores []*entity.ContentScore) error {
+	return nil
+}
+func (m *mockContentRepository) FindProviderContentIDs(ctx context.Context, providerID int64) (map[string]bool, error) {
+	return m.existingIDs, nil
+}
+
+func (m *mockContentRepository) FindDuplicateCandidates(ctx context.Context, providerID int64, since time.Time, window time.Duration) ([]*entity.DuplicateCandidate, error) {
+	return m.duplicateCandidates, nil
+}
+
+func (m *mockContentRepository) LinkDuplicates(ctx context.Context, links map[int64]int64) error {
+	m.links = links
+	return nil
+}
+
+// mockTransactor dış transaction'ların sonucunu kaydeder, iç içe çağrıları savepoint gibi sayar
+type mockTransactor struct {
+	depth      int
+	savepoints int
+	commits    int
+	rollbacks  int
+	audit      []port.AuditInfo // Dış transaction'ların context'indeki audit bilgisi
+}
+
+func (m *mockTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	m.depth++
+	defer func() { m.depth-- }()
+	if m.depth > 1 {
+		m.savepoints++
+		return fn(ctx)
+	}
+
+	info, _ := port.AuditInfoFromContext(ctx)
+	m.audit = append(m.audit, info)
+
+	if err := fn(ctx); err != nil {
+		m.rollbacks++
+		return err
+	}
+	m.commits++
+	return nil
+}
+
 // MockScoringService
 type mockScoringService struct{}
 
@@ -55,10 +161,26 @@ func (m *mockScoringService) CalculateScore(content *entity.Content) (*entity.Co
 	return &entity.ContentScore{}, nil
 }
 
+func (m *mockScoringService) Rules() entity.ScoringRules {
+	return entity.ScoringRules{}
+}
+
+func (m *mockScoringService) SetRules(rules entity.ScoringRules) {}
+
+func (m *mockScoringService) SetDefaults(rules entity.ScoringRules) {}
+
+func (m *mockScoringService) SetBoosts(boosts []entity.BoostRule) {}
+
+func (m *mockScoringService) Explain(content *entity.Content) *entity.ScoreExplanation {
+	return nil
+}
+
 // MockCacheRepository
 type mockCacheRepository struct {
 	port.CacheRepository
-	clearCalled bool
+	clearCalled bool     // Clear (tüm DB) çağrıldı mı
+	invalidated bool     // InvalidatePattern çağrıldı mı
+	patterns    []string // InvalidatePattern ile temizlenen desenler
 }
 
 func (m *mockCacheRepository) Get(ctx context.Context, key string) ([]byte, error) {
@@ -67,6 +189,11 @@ func (m *mockCacheRepository) Get(ctx context.Context, key string) ([]byte, erro
 func (m *mockCacheRepository) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
 	return nil
 }
+func (m *mockCacheRepository) InvalidatePattern(ctx context.Context, pattern string) error {
+	m.invalidated = true
+	m.patterns = append(m.patterns, pattern)
+	return nil
+}
 func (m *mockCacheRepository) Clear(ctx context.Context) error {
 	m.clearCalled = true
 	return nil
@@ -102,8 +229,14 @@ func TestSyncProviderContentsUseCase_Execute_SoftDelete(t *testing.T) {
 		t.Error("MarkStaleContentsAsDeleted was NOT called")
 	}
 
-	if !mockCache.clearCalled {
-		t.Error("Cache.Clear was NOT called")
+	if !mockCache.invalidated {
+		t.Error("Search cache was NOT invalidated")
+	}
+	if mockCache.clearCalled {
+		t.Error("Cache.Clear must not flush unrelated keys")
+	}
+	if len(mockCache.patterns) == 0 || mockCache.patterns[0] != "search:*" {
+		t.Errorf("Expected search:* to be invalidated, got %v", mockCache.patterns)
 	}
 
 	if mockRepo.providerID != 1 {
@@ -114,3 +247,1073 @@ func TestSyncProviderContentsUseCase_Execute_SoftDelete(t *testing.T) {
 		t.Error("Threshold time should be after test start time")
 	}
 }
+
+func TestSyncProviderContentsUseCase_ReloadProviderClients(t *testing.T) {
+	repo := newMockProviderRepository()
+	_ = repo.Create(context.Background(), &entity.Provider{Name: "JSON", Format: "json", IsActive: true})
+	_ = repo.Create(context.Background(), &entity.Provider{Name: "Broken", Format: "csv", IsActive: true})
+	_ = repo.Create(context.Background(), &entity.Provider{Name: "Inactive", Format: "json", IsActive: false})
+
+	factory := func(p *entity.Provider) (port.ProviderClient, error) {
+		if p.Format != "json" {
+			return nil, errors.New("unsupported format")
+		}
+		return &mockProviderClient{}, nil
+	}
+
+	useCase := NewSyncProviderContentsUseCase(nil, &mockContentRepository{}, &mockScoringService{}, &mockCacheRepository{})
+
+	// Kaynak ayarlanmadan reload hata dönmeli
+	if err := useCase.ReloadProviderClients(context.Background()); err == nil {
+		t.Fatal("expected error without provider source")
+	}
+
+	useCase.SetProviderSource(repo, factory)
+	if err := useCase.ReloadProviderClients(context.Background()); err != nil {
+		t.Fatalf("ReloadProviderClients failed: %v", err)
+	}
+
+	// Sadece aktif ve desteklenen provider yüklenmeli
+	if got := len(useCase.ProviderClients()); got != 1 {
+		t.Fatalf("Expected 1 provider client, got %d", got)
+	}
+
+	// Yeni provider eklenince reload listeyi güncellemeli
+	_ = repo.Create(context.Background(), &entity.Provider{Name: "JSON 2", Format: "json", IsActive: true})
+	if err := useCase.ReloadProviderClients(context.Background()); err != nil {
+		t.Fatalf("ReloadProviderClients failed: %v", err)
+	}
+	if got := len(useCase.ProviderClients()); got != 2 {
+		t.Errorf("Expected 2 provider clients after reload, got %d", got)
+	}
+}
+
+func TestSyncProviderContentsUseCase_ExecuteProvider(t *testing.T) {
+	mockRepo := &mockContentRepository{}
+	mockCache := &mockCacheRepository{}
+	useCase := NewSyncProviderContentsUseCase(
+		[]port.ProviderClient{&mockProviderClient{}},
+		mockRepo,
+		&mockScoringService{},
+		mockCache,
+	)
+
+	if err := useCase.ExecuteProvider(context.Background(), 2); !errors.Is(err, port.ErrProviderNotFound) {
+		t.Fatalf("Expected ErrProviderNotFound, got %v", err)
+	}
+	if mockRepo.markedDeleted {
+		t.Error("Unknown provider should not trigger a sync")
+	}
+
+	if err := useCase.ExecuteProvider(context.Background(), 1); err != nil {
+		t.Fatalf("ExecuteProvider failed: %v", err)
+	}
+	if mockRepo.providerID != 1 {
+		t.Errorf("Expected ProviderID 1, got %d", mockRepo.providerID)
+	}
+	if !mockCache.invalidated {
+		t.Error("Search cache was NOT invalidated")
+	}
+}
+
+func TestSyncProviderContentsUseCase_InitialSyncAttempted(t *testing.T) {
+	useCase := NewSyncProviderContentsUseCase(
+		[]port.ProviderClient{&mockProviderClient{}},
+		&mockContentRepository{},
+		&mockScoringService{},
+		&mockCacheRepository{},
+	)
+
+	if useCase.InitialSyncAttempted() {
+		t.Fatal("Sync should not be marked as attempted before any run")
+	}
+	if err := useCase.Execute(context.Background()); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if !useCase.InitialSyncAttempted() {
+		t.Error("Sync should be marked as attempted after a run")
+	}
+}
+
+// mockCacheWarmer warm-up çağrısını ve o andaki cache durumunu kaydeder
+type mockCacheWarmer struct {
+	cache               *mockCacheRepository
+	limit               int
+	invalidatedAtWarmUp bool
+}
+
+func (m *mockCacheWarmer) WarmUp(ctx context.Context, limit int) (int, error) {
+	m.limit = limit
+	m.invalidatedAtWarmUp = m.cache.invalidated
+	return limit, nil
+}
+
+func TestSyncProviderContentsUseCase_CacheWarmUp(t *testing.T) {
+	mockCache := &mockCacheRepository{}
+	warmer := &mockCacheWarmer{cache: mockCache}
+	useCase := NewSyncProviderContentsUseCase(
+		[]port.ProviderClient{&mockProviderClient{}},
+		&mockContentRepository{},
+		&mockScoringService{},
+		mockCache,
+	)
+	useCase.SetCacheWarmer(warmer, 5)
+
+	if err := useCase.Execute(context.Background()); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if warmer.limit != 5 {
+		t.Errorf("Expected warm-up with limit 5, got %d", warmer.limit)
+	}
+	if !warmer.invalidatedAtWarmUp {
+		t.Error("Warm-up must run after cache invalidation")
+	}
+}
+
+// mockSearchIndexer reindex çağrısını ve o andaki cache durumunu kaydeder
+type mockSearchIndexer struct {
+	cache                *mockCacheRepository
+	calls                int
+	invalidatedAtReindex bool
+	err                  error
+}
+
+func (m *mockSearchIndexer) Reindex(ctx context.Context) (int, error) {
+	m.calls++
+	m.invalidatedAtReindex = m.cache.invalidated
+	return 0, m.err
+}
+
+func TestSyncProviderContentsUseCase_SearchIndexer(t *testing.T) {
+	mockCache := &mockCacheRepository{}
+	indexer := &mockSearchIndexer{cache: mockCache, err: errors.New("index unavailable")}
+	useCase := NewSyncProviderContentsUseCase(
+		[]port.ProviderClient{&mockProviderClient{}},
+		&mockContentRepository{},
+		&mockScoringService{},
+		mockCache,
+	)
+	useCase.SetSearchIndexer(indexer)
+
+	// İndeks hatası senkronizasyonu başarısız yapmaz
+	if err := useCase.Execute(context.Background()); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if indexer.calls != 1 {
+		t.Errorf("Expected 1 reindex, got %d", indexer.calls)
+	}
+	if indexer.invalidatedAtReindex {
+		t.Error("Reindex must run before cache invalidation")
+	}
+	if !mockCache.invalidated {
+		t.Error("Search cache was NOT invalidated")
+	}
+}
+
+func TestSyncProviderContentsUseCase_SyncLogs(t *testing.T) {
+	t.Run("records successful sync", func(t *testing.T) {
+		logRepo := newMockProviderRepository()
+		client := &mockProviderClient{
+			contents: []*entity.NormalizedContent{
+				{ExternalID: "a", Title: "A", ContentType: entity.ContentTypeVideo, PublishedAt: testPublishedAt},
+				{ExternalID: "b", Title: "B", ContentType: entity.ContentTypeArticle, PublishedAt: testPublishedAt},
+			},
+		}
+		useCase := NewSyncProviderContentsUseCase([]port.ProviderClient{client}, &mockContentRepository{}, &mockScoringService{}, &mockCacheRepository{})
+		useCase.SetSyncLogRepository(logRepo)
+
+		if err := useCase.Execute(context.Background()); err != nil {
+			t.Fatalf("Execute failed: %v", err)
+		}
+
+		if len(logRepo.syncLogs) != 1 {
+			t.Fatalf("Expected 1 sync log, got %d", len(logRepo.syncLogs))
+		}
+		syncLog := logRepo.syncLogs[0]
+		if syncLog.Status != "success" || syncLog.ItemsSynced != 2 || syncLog.CompletedAt == nil {
+			t.Errorf("Unexpected sync log: %+v", syncLog)
+		}
+	})
+
+	t.Run("records failed sync", func(t *testing.T) {
+		logRepo := newMockProviderRepository()
+		client := &mockProviderClient{err: errors.New("provider down")}
+		useCase := NewSyncProviderContentsUseCase([]port.ProviderClient{client}, &mockContentRepository{}, &mockScoringService{}, &mockCacheRepository{})
+		useCase.SetSyncLogRepository(logRepo)
+
+		if err := useCase.ExecuteProvider(context.Background(), 1); err == nil {
+			t.Fatal("Expected sync error")
+		}
+
+		if len(logRepo.syncLogs) != 1 {
+			t.Fatalf("Expected 1 sync log, got %d", len(logRepo.syncLogs))
+		}
+		syncLog := logRepo.syncLogs[0]
+		if syncLog.Status != "failed" || syncLog.ErrorMessage == "" {
+			t.Errorf("Unexpected sync log: %+v", syncLog)
+		}
+	})
+}
+
+func TestSyncProviderContentsUseCase_DryRun(t *testing.T) {
+	client := &mockProviderClient{
+		contents: []*entity.NormalizedContent{
+			{ExternalID: "new", Title: "New", ContentType: entity.ContentTypeVideo, PublishedAt: testPublishedAt},
+			{ExternalID: "existing", Title: "Existing", ContentType: entity.ContentTypeVideo, PublishedAt: testPublishedAt},
+			{ExternalID: "restored", Title: "Restored", ContentType: entity.ContentTypeArticle, PublishedAt: testPublishedAt},
+		},
+	}
+	mockRepo := &mockContentRepository{
+		existingIDs: map[string]bool{
+			"existing": false,
+			"restored": true,
+			"gone":     false,
+			"old-gone": true,
+		},
+	}
+	mockCache := &mockCacheRepository{}
+	useCase := NewSyncProviderContentsUseCase([]port.ProviderClient{client}, mockRepo, &mockScoringService{}, mockCache)
+
+	result, err := useCase.DryRun(context.Background(), 0)
+	if err != nil {
+		t.Fatalf("DryRun failed: %v", err)
+	}
+
+	if len(result.Providers) != 1 {
+		t.Fatalf("Expected 1 provider report, got %d", len(result.Providers))
+	}
+	report := result.Providers[0]
+	if report.Fetched != 3 {
+		t.Errorf("Expected 3 fetched, got %d", report.Fetched)
+	}
+	if len(report.Insert) != 1 || report.Insert[0] != "new" {
+		t.Errorf("Unexpected insert list: %v", report.Insert)
+	}
+	if len(report.Update) != 2 {
+		t.Errorf("Unexpected update list: %v", report.Update)
+	}
+	if len(report.SoftDelete) != 1 || report.SoftDelete[0] != "gone" {
+		t.Errorf("Unexpected soft delete list: %v", report.SoftDelete)
+	}
+
+	// Dry-run hiçbir yazma yapmamalı
+	if mockRepo.upserts != 0 || mockRepo.bulkUpserts != 0 || mockRepo.markedDeleted || mockCache.invalidated {
+		t.Error("DryRun must not write to the repository or cache")
+	}
+
+	if _, err := useCase.DryRun(context.Background(), 42); !errors.Is(err, port.ErrProviderNotFound) {
+		t.Errorf("Expected ErrProviderNotFound, got %v", err)
+	}
+}
+
+// blockingProviderClient context iptal edilene kadar bekleyen provider
+type blockingProviderClient struct {
+	mockProviderClient
+	started chan struct{}
+}
+
+func (m *blockingProviderClient) FetchContents(ctx context.Context) ([]*entity.NormalizedContent, error) {
+	close(m.started)
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestSyncProviderContentsUseCase_Shutdown(t *testing.T) {
+	t.Run("waits for in-flight sync and rejects new ones", func(t *testing.T) {
+		useCase := NewSyncProviderContentsUseCase(
+			[]port.ProviderClient{&mockProviderClient{}},
+			&mockContentRepository{},
+			&mockScoringService{},
+			&mockCacheRepository{},
+		)
+
+		if _, err := useCase.ExecuteAsync(); err != nil {
+			t.Fatalf("ExecuteAsync failed: %v", err)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		if err := useCase.Shutdown(ctx); err != nil {
+			t.Fatalf("Shutdown failed: %v", err)
+		}
+
+		if _, err := useCase.ExecuteAsync(); !errors.Is(err, ErrSyncShutdown) {
+			t.Errorf("Expected ErrSyncShutdown, got %v", err)
+		}
+		if err := useCase.Execute(context.Background()); !errors.Is(err, ErrSyncShutdown) {
+			t.Errorf("Expected ErrSyncShutdown, got %v", err)
+		}
+	})
+
+	t.Run("cancels in-flight sync when timeout expires", func(t *testing.T) {
+		client := &blockingProviderClient{started: make(chan struct{})}
+		mockRepo := &mockContentRepository{}
+		useCase := NewSyncProviderContentsUseCase(
+			[]port.ProviderClient{client},
+			mockRepo,
+			&mockScoringService{},
+			&mockCacheRepository{},
+		)
+
+		jobID, err := useCase.ExecuteAsync()
+		if err != nil {
+			t.Fatalf("ExecuteAsync failed: %v", err)
+		}
+		<-client.started
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		if err := useCase.Shutdown(ctx); !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("Expected DeadlineExceeded, got %v", err)
+		}
+
+		// İptal edilen sync stale-marking yapmamalı ve job failed olmalı
+		if mockRepo.markedDeleted {
+			t.Error("Aborted sync must not mark stale contents")
+		}
+		job, _ := useCase.Job(jobID)
+		if job == nil || job.Status != SyncStatusFailed {
+			t.Errorf("Expected failed job, got %+v", job)
+		}
+	})
+}
+
+func TestSyncProviderContentsUseCase_BulkWrites(t *testing.T) {
+	contents := make([]*entity.NormalizedContent, syncBatchSize+10)
+	for i := range contents {
+		contents[i] = &entity.NormalizedContent{
+			ExternalID:  fmt.Sprintf("item-%d", i),
+			Title:       "Item",
+			ContentType: entity.ContentTypeVideo,
+			PublishedAt: testPublishedAt,
+		}
+	}
+	contents[0].URL = "https://example.com/videos/0"
+	contents[0].ThumbnailURL = "https://example.com/thumbs/0.jpg"
+
+	t.Run("writes items in batches", func(t *testing.T) {
+		mockRepo := &mockContentRepository{}
+		useCase := NewSyncProviderContentsUseCase(
+			[]port.ProviderClient{&mockProviderClient{contents: contents}},
+			mockRepo, &mockScoringService{}, &mockCacheRepository{},
+		)
+
+		if err := useCase.ExecuteProvider(context.Background(), 1); err != nil {
+			t.Fatalf("ExecuteProvider failed: %v", err)
+		}
+
+		if mockRepo.bulkUpserts != 2 {
+			t.Errorf("Expected 2 bulk upserts, got %d", mockRepo.bulkUpserts)
+		}
+		if mockRepo.upserts != 0 {
+			t.Errorf("Expected no single upserts, got %d", mockRepo.upserts)
+		}
+		if first := mockRepo.bulkContents[0]; first.URL != contents[0].URL || first.ThumbnailURL != contents[0].ThumbnailURL {
+			t.Errorf("Expected URL and thumbnail to be stored, got %q and %q", first.URL, first.ThumbnailURL)
+		}
+	})
+
+	t.Run("falls back to per-item processing when bulk write fails", func(t *testing.T) {
+		mockRepo := &mockContentRepository{bulkErr: errors.New("check constraint violated")}
+		logRepo := newMockProviderRepository()
+		useCase := NewSyncProviderContentsUseCase(
+			[]port.ProviderClient{&mockProviderClient{contents: contents[:3]}},
+			mockRepo, &mockScoringService{}, &mockCacheRepository{},
+		)
+		useCase.SetSyncLogRepository(logRepo)
+
+		if err := useCase.ExecuteProvider(context.Background(), 1); err != nil {
+			t.Fatalf("ExecuteProvider failed: %v", err)
+		}
+
+		if mockRepo.upserts != 3 {
+			t.Errorf("Expected 3 single upserts, got %d", mockRepo.upserts)
+		}
+		if logRepo.syncLogs[0].ItemsSynced != 3 {
+			t.Errorf("Expected 3 items synced, got %d", logRepo.syncLogs[0].ItemsSynced)
+		}
+	})
+}
+
+func TestSyncProviderContentsUseCase_CTRSignals(t *testing.T) {
+	contents := []*entity.NormalizedContent{
+		{ExternalID: "a1", Title: "Clicked", ContentType: entity.ContentTypeArticle, PublishedAt: testPublishedAt},
+		{ExternalID: "a2", Title: "New", ContentType: entity.ContentTypeArticle, PublishedAt: testPublishedAt},
+	}
+
+	mockRepo := &mockContentRepository{}
+	useCase := NewSyncProviderContentsUseCase(
+		[]port.ProviderClient{&mockProviderClient{contents: contents}},
+		mockRepo, service.NewScoringService(service.ScoringRules{CTRWeight: 2}), &mockCacheRepository{},
+	)
+	useCase.SetCTRSignalRepository(&mockCTRSignalRepository{lifts: map[int64]float64{1: 1.5}})
+
+	if err := useCase.ExecuteProvider(context.Background(), 1); err != nil {
+		t.Fatalf("ExecuteProvider failed: %v", err)
+	}
+	if len(mockRepo.bulkContents) != 2 {
+		t.Fatalf("Expected 2 contents, got %d", len(mockRepo.bulkContents))
+	}
+
+	// Kayıtlı sinyal sync'te yazılan skorda korunur: 2 × (1.5 - 1)
+	if clicked := mockRepo.bulkContents[0].Score; clicked == nil || clicked.CTRLift != 1.5 || clicked.CTRScore != 1.0 {
+		t.Errorf("Expected CTR lift 1.5 and CTR score 1, got %+v", clicked)
+	}
+	if fresh := mockRepo.bulkContents[1].Score; fresh == nil || fresh.CTRLift != 0 || fresh.CTRScore != 0 {
+		t.Errorf("Expected no CTR signal for content without lift, got %+v", fresh)
+	}
+}
+
+// mockAuthorRepository aynı (provider, external ID) için aynı ID'yi veren yazar repository'si
+type mockAuthorRepository struct {
+	port.AuthorRepository
+	ids     map[string]int64
+	upserts int
+}
+
+func (m *mockAuthorRepository) BulkUpsertAuthors(ctx context.Context, authors []*entity.Author) error {
+	m.upserts++
+	for _, a := range authors {
+		key := fmt.Sprintf("%d/%s", a.ProviderID, a.ExternalID)
+		if _, ok := m.ids[key]; !ok {
+			m.ids[key] = int64(len(m.ids) + 1)
+		}
+		a.ID = m.ids[key]
+	}
+	return nil
+}
+
+func TestSyncProviderContentsUseCase_Authors(t *testing.T) {
+	contents := []*entity.NormalizedContent{
+		{ExternalID: "v1", Title: "Video 1", ContentType: entity.ContentTypeVideo, PublishedAt: testPublishedAt,
+			Author: &entity.NormalizedAuthor{ExternalID: "ch-1", Name: " Go Channel ", URL: "https://example.com/go"}},
+		{ExternalID: "v2", Title: "Video 2", ContentType: entity.ContentTypeVideo, PublishedAt: testPublishedAt,
+			Author: &entity.NormalizedAuthor{ExternalID: "ch-1", Name: "Go Channel"}},
+		{ExternalID: "a1", Title: "Article", ContentType: entity.ContentTypeArticle, PublishedAt: testPublishedAt,
+			Author: &entity.NormalizedAuthor{Name: "Jane Doe"}},
+		{ExternalID: "a2", Title: "Anonymous", ContentType: entity.ContentTypeArticle, PublishedAt: testPublishedAt,
+			Author: &entity.NormalizedAuthor{Name: "  "}},
+	}
+
+	mockRepo := &mockContentRepository{}
+	authorRepo := &mockAuthorRepository{ids: map[string]int64{}}
+	useCase := NewSyncProviderContentsUseCase(
+		[]port.ProviderClient{&mockProviderClient{contents: contents}},
+		mockRepo, &mockScoringService{}, &mockCacheRepository{},
+	)
+	useCase.SetAuthorRepository(authorRepo)
+
+	if err := useCase.ExecuteProvider(context.Background(), 1); err != nil {
+		t.Fatalf("ExecuteProvider failed: %v", err)
+	}
+
+	if authorRepo.upserts != 1 {
+		t.Errorf("Expected authors to be written in one bulk upsert, got %d", authorRepo.upserts)
+	}
+	if _, ok := authorRepo.ids["1/Jane Doe"]; !ok {
+		t.Errorf("Expected author name to be used as external ID, got %v", authorRepo.ids)
+	}
+	if len(mockRepo.bulkContents) != 4 {
+		t.Fatalf("Expected 4 contents, got %d", len(mockRepo.bulkContents))
+	}
+
+	first, second := mockRepo.bulkContents[0].Author, mockRepo.bulkContents[1].Author
+	if first == nil || second == nil || first.ID != second.ID {
+		t.Fatalf("Expected contents of the same channel to share the author, got %+v and %+v", first, second)
+	}
+	if first.Name != "Go Channel" || first.URL != "https://example.com/go" {
+		t.Errorf("Expected trimmed author name and URL, got %+v", first)
+	}
+	if mockRepo.bulkContents[2].Author == nil || mockRepo.bulkContents[2].Author.ID == first.ID {
+		t.Errorf("Expected a separate author for the article, got %+v", mockRepo.bulkContents[2].Author)
+	}
+	if mockRepo.bulkContents[3].Author != nil {
+		t.Errorf("Expected no author for blank author name, got %+v", mockRepo.bulkContents[3].Author)
+	}
+}
+
+// mockCategoryRepository aynı path için aynı ID'yi veren kategori repository'si
+type mockCategoryRepository struct {
+	port.CategoryRepository
+	ids      map[string]int64
+	upserted []*entity.Category
+}
+
+func (m *mockCategoryRepository) BulkUpsertCategories(ctx context.Context, categories []*entity.Category) error {
+	m.upserted = append(m.upserted, categories...)
+	for _, c := range categories {
+		if _, ok := m.ids[c.Path]; !ok {
+			m.ids[c.Path] = int64(len(m.ids) + 1)
+		}
+		c.ID = m.ids[c.Path]
+	}
+	return nil
+}
+
+func TestSyncProviderContentsUseCase_Categories(t *testing.T) {
+	contents := []*entity.NormalizedContent{
+		{ExternalID: "a1", Title: "Channels", ContentType: entity.ContentTypeArticle, PublishedAt: testPublishedAt,
+			Category: []string{"Programming", "Go", "Concurrency"}},
+		{ExternalID: "a2", Title: "Modules", ContentType: entity.ContentTypeArticle, PublishedAt: testPublishedAt,
+			Category: []string{"Programming", "Go"}},
+		{ExternalID: "a3", Title: "Uncategorized", ContentType: entity.ContentTypeArticle, PublishedAt: testPublishedAt},
+	}
+
+	mockRepo := &mockContentRepository{}
+	categoryRepo := &mockCategoryRepository{ids: map[string]int64{}}
+	useCase := NewSyncProviderContentsUseCase(
+		[]port.ProviderClient{&mockProviderClient{contents: contents}},
+		mockRepo, &mockScoringService{}, &mockCacheRepository{},
+	)
+	useCase.SetCategoryRepository(categoryRepo)
+
+	if err := useCase.ExecuteProvider(context.Background(), 1); err != nil {
+		t.Fatalf("ExecuteProvider failed: %v", err)
+	}
+
+	if len(categoryRepo.ids) != 3 {
+		t.Errorf("Expected ancestor categories to be upserted, got %v", categoryRepo.ids)
+	}
+	if len(mockRepo.bulkContents) != 3 {
+		t.Fatalf("Expected 3 contents, got %d", len(mockRepo.bulkContents))
+	}
+
+	leaf := mockRepo.bulkContents[0].Category
+	if leaf == nil || leaf.Path != "programming/go/concurrency" || leaf.Name != "Concurrency" {
+		t.Errorf("Expected leaf category of the first content, got %+v", leaf)
+	}
+	middle := mockRepo.bulkContents[1].Category
+	if middle == nil || middle.ID != categoryRepo.ids["programming/go"] {
+		t.Errorf("Expected contents to share the programming/go category, got %+v", middle)
+	}
+	if mockRepo.bulkContents[2].Category != nil {
+		t.Errorf("Expected no category for uncategorized content, got %+v", mockRepo.bulkContents[2].Category)
+	}
+}
+
+func TestSyncProviderContentsUseCase_ModerationStatus(t *testing.T) {
+	contents := []*entity.NormalizedContent{
+		{ExternalID: "m1", Title: "Untrusted", ContentType: entity.ContentTypeArticle, PublishedAt: testPublishedAt},
+	}
+
+	tests := []struct {
+		name      string
+		moderated bool
+		expected  entity.ContentStatus
+	}{
+		{"auto approved provider", false, entity.ContentStatusApproved},
+		{"moderated provider", true, entity.ContentStatusPending},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bulkRepo := &mockContentRepository{}
+			// Toplu yazma başarısız olunca tek tek upsert'e düşülür; iki yolda da durum aynı olmalı
+			fallbackRepo := &mockContentRepository{bulkErr: errors.New("bulk failed")}
+
+			for _, repo := range []*mockContentRepository{bulkRepo, fallbackRepo} {
+				useCase := NewSyncProviderContentsUseCase(
+					[]port.ProviderClient{&mockProviderClient{contents: contents, moderated: tt.moderated}},
+					repo, &mockScoringService{}, &mockCacheRepository{},
+				)
+				if err := useCase.ExecuteProvider(context.Background(), 1); err != nil {
+					t.Fatalf("ExecuteProvider failed: %v", err)
+				}
+			}
+
+			if len(bulkRepo.bulkContents) != 1 || bulkRepo.bulkContents[0].Status != tt.expected {
+				t.Errorf("Expected bulk upsert status %q, got %+v", tt.expected, bulkRepo.bulkContents)
+			}
+			if len(fallbackRepo.upserted) != 1 || fallbackRepo.upserted[0].Status != tt.expected {
+				t.Errorf("Expected upsert status %q, got %+v", tt.expected, fallbackRepo.upserted)
+			}
+		})
+	}
+}
+
+func TestSyncProviderContentsUseCase_Transaction(t *testing.T) {
+	contents := []*entity.NormalizedContent{
+		{ExternalID: "item-1", Title: "Item", ContentType: entity.ContentTypeVideo, PublishedAt: testPublishedAt, Tags: []string{"go"}},
+		{ExternalID: "item-2", Title: "Item", ContentType: entity.ContentTypeVideo, PublishedAt: testPublishedAt},
+	}
+
+	t.Run("commits provider sync in a single transaction", func(t *testing.T) {
+		mockRepo := &mockContentRepository{}
+		tx := &mockTransactor{}
+		useCase := NewSyncProviderContentsUseCase(
+			[]port.ProviderClient{&mockProviderClient{contents: contents}},
+			mockRepo, &mockScoringService{}, &mockCacheRepository{},
+		)
+		useCase.SetTransactor(tx)
+
+		if err := useCase.ExecuteProvider(context.Background(), 1); err != nil {
+			t.Fatalf("ExecuteProvider failed: %v", err)
+		}
+
+		if tx.commits != 1 || tx.rollbacks != 0 {
+			t.Errorf("Expected 1 commit and no rollback, got %d commits, %d rollbacks", tx.commits, tx.rollbacks)
+		}
+		if tx.savepoints == 0 {
+			t.Error("Expected batch writes to run in savepoints")
+		}
+		if !mockRepo.markedDeleted {
+			t.Error("MarkStaleContentsAsDeleted was NOT called")
+		}
+		if tx.audit[0].Actor != port.AuditActorSync || tx.audit[0].SyncJobID == "" {
+			t.Errorf("Expected sync audit info with job ID, got %+v", tx.audit[0])
+		}
+	})
+
+	t.Run("rolls back when stale marking fails", func(t *testing.T) {
+		mockRepo := &mockContentRepository{markErr: errors.New("connection reset")}
+		tx := &mockTransactor{}
+		logRepo := newMockProviderRepository()
+		useCase := NewSyncProviderContentsUseCase(
+			[]port.ProviderClient{&mockProviderClient{contents: contents}},
+			mockRepo, &mockScoringService{}, &mockCacheRepository{},
+		)
+		useCase.SetTransactor(tx)
+		useCase.SetSyncLogRepository(logRepo)
+
+		if err := useCase.ExecuteProvider(context.Background(), 1); err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		if tx.rollbacks != 1 || tx.commits != 0 {
+			t.Errorf("Expected 1 rollback and no commit, got %d commits, %d rollbacks", tx.commits, tx.rollbacks)
+		}
+		if logRepo.syncLogs[0].Status != SyncStatusFailed || logRepo.syncLogs[0].ItemsSynced != 0 {
+			t.Errorf("Expected failed sync log with 0 items, got %s/%d",
+				logRepo.syncLogs[0].Status, logRepo.syncLogs[0].ItemsSynced)
+		}
+	})
+
+	t.Run("skips stale marking after partial failure", func(t *testing.T) {
+		mockRepo := &mockContentRepository{
+			bulkErr:   errors.New("check constraint violated"),
+			upsertErr: errors.New("check constraint violated"),
+		}
+		useCase := NewSyncProviderContentsUseCase(
+			[]port.ProviderClient{&mockProviderClient{contents: contents}},
+			mockRepo, &mockScoringService{}, &mockCacheRepository{},
+		)
+		useCase.SetTransactor(&mockTransactor{})
+
+		if err := useCase.ExecuteProvider(context.Background(), 1); err != nil {
+			t.Fatalf("ExecuteProvider failed: %v", err)
+		}
+
+		if mockRepo.markedDeleted {
+			t.Error("MarkStaleContentsAsDeleted should NOT be called after a partial failure")
+		}
+	})
+}
+
+func TestSyncProviderContentsUseCase_ConditionalFetch(t *testing.T) {
+	contents := []*entity.NormalizedContent{
+		{ExternalID: "item-1", Title: "Item", ContentType: entity.ContentTypeVideo, PublishedAt: testPublishedAt},
+	}
+
+	t.Run("skips unchanged provider", func(t *testing.T) {
+		mockRepo := &mockContentRepository{}
+		client := &mockConditionalClient{mockProviderClient: mockProviderClient{err: port.ErrNotModified}}
+		useCase := NewSyncProviderContentsUseCase([]port.ProviderClient{client}, mockRepo, &mockScoringService{}, &mockCacheRepository{})
+
+		if err := useCase.ExecuteProvider(context.Background(), 1); err != nil {
+			t.Fatalf("ExecuteProvider failed: %v", err)
+		}
+		if mockRepo.bulkUpserts != 0 || mockRepo.markedDeleted {
+			t.Error("Unchanged provider should not be written or stale-marked")
+		}
+
+		result, err := useCase.DryRun(context.Background(), 1)
+		if err != nil {
+			t.Fatalf("DryRun failed: %v", err)
+		}
+		if result.Providers[0].Error != "" {
+			t.Errorf("Unchanged provider should not be reported as an error: %s", result.Providers[0].Error)
+		}
+	})
+
+	t.Run("commits validators after complete sync", func(t *testing.T) {
+		repo := newMockProviderRepository()
+		client := &mockConditionalClient{mockProviderClient: mockProviderClient{contents: contents}, pendingETag: `"v2"`}
+		useCase := NewSyncProviderContentsUseCase([]port.ProviderClient{client}, &mockContentRepository{}, &mockScoringService{}, &mockCacheRepository{})
+		useCase.SetProviderSource(repo, nil)
+
+		if err := useCase.ExecuteProvider(context.Background(), 1); err != nil {
+			t.Fatalf("ExecuteProvider failed: %v", err)
+		}
+		if client.committed != `"v2"` || repo.etag != `"v2"` {
+			t.Errorf("Expected validators to be committed and persisted, got %q / %q", client.committed, repo.etag)
+		}
+	})
+
+	t.Run("keeps validators after partial failure", func(t *testing.T) {
+		repo := newMockProviderRepository()
+		client := &mockConditionalClient{mockProviderClient: mockProviderClient{contents: contents}, pendingETag: `"v2"`}
+		mockRepo := &mockContentRepository{
+			bulkErr:   errors.New("check constraint violated"),
+			upsertErr: errors.New("check constraint violated"),
+		}
+		useCase := NewSyncProviderContentsUseCase([]port.ProviderClient{client}, mockRepo, &mockScoringService{}, &mockCacheRepository{})
+		useCase.SetProviderSource(repo, nil)
+
+		if err := useCase.ExecuteProvider(context.Background(), 1); err != nil {
+			t.Fatalf("ExecuteProvider failed: %v", err)
+		}
+		if client.committed != "" || repo.etag != "" {
+			t.Error("Validators should NOT be committed after a partial failure")
+		}
+	})
+}
+
+func TestSyncProviderContentsUseCase_IngestEvent(t *testing.T) {
+	newUseCase := func(repo *mockContentRepository, cache *mockCacheRepository) *SyncProviderContentsUseCase {
+		return NewSyncProviderContentsUseCase(
+			[]port.ProviderClient{&mockProviderClient{}},
+			repo, &mockScoringService{}, cache,
+		)
+	}
+
+	// Cache temizliği beklemeden yapılır, Shutdown bekleyen temizliği tamamlar
+	defer func(delay time.Duration) { ingestInvalidationDelay = delay }(ingestInvalidationDelay)
+	ingestInvalidationDelay = 0
+
+	t.Run("upsert runs the sync pipeline and clears cache", func(t *testing.T) {
+		mockRepo := &mockContentRepository{}
+		mockCache := &mockCacheRepository{}
+		useCase := newUseCase(mockRepo, mockCache)
+
+		err := useCase.IngestEvent(context.Background(), &entity.ContentEvent{
+			ProviderID: 1,
+			Op:         entity.ContentEventUpsert,
+			Content:    &entity.NormalizedContent{ExternalID: "v1", Title: "Video", ContentType: entity.ContentTypeVideo, PublishedAt: testPublishedAt},
+		})
+		if err != nil {
+			t.Fatalf("IngestEvent failed: %v", err)
+		}
+		if err := useCase.Shutdown(context.Background()); err != nil {
+			t.Fatalf("Shutdown failed: %v", err)
+		}
+		if mockRepo.upserts != 1 {
+			t.Errorf("Expected 1 upsert, got %d", mockRepo.upserts)
+		}
+		if !mockCache.invalidated {
+			t.Error("Search cache was NOT invalidated")
+		}
+	})
+
+	t.Run("events within the delay share one cache invalidation", func(t *testing.T) {
+		ingestInvalidationDelay = time.Hour
+		defer func() { ingestInvalidationDelay = 0 }()
+
+		mockCache := &mockCacheRepository{}
+		useCase := newUseCase(&mockContentRepository{}, mockCache)
+
+		for _, id := range []string{"v1", "v2", "v3"} {
+			err := useCase.IngestEvent(context.Background(), &entity.ContentEvent{
+				ProviderID: 1,
+				Op:         entity.ContentEventDelete,
+				Content:    &entity.NormalizedContent{ExternalID: id},
+			})
+			if err != nil {
+				t.Fatalf("IngestEvent failed: %v", err)
+			}
+		}
+		if !useCase.ingestInvalidationPending.Load() {
+			t.Fatal("Expected a pending cache invalidation")
+		}
+		if mockCache.invalidated {
+			t.Error("Cache should NOT be invalidated before the delay")
+		}
+	})
+
+	t.Run("invalid upsert is quarantined and not written", func(t *testing.T) {
+		mockRepo := &mockContentRepository{}
+		providerRepo := &mockProviderRepository{}
+		useCase := newUseCase(mockRepo, &mockCacheRepository{})
+		useCase.SetSyncLogRepository(providerRepo)
+
+		err := useCase.IngestEvent(context.Background(), &entity.ContentEvent{
+			ProviderID: 1,
+			Op:         entity.ContentEventUpsert,
+			Content: &entity.NormalizedContent{
+				ExternalID: "v1", Title: "Video", ContentType: entity.ContentTypeVideo,
+				PublishedAt: testPublishedAt, Stats: entity.ContentStats{Views: -5}, RawData: `{"id":"v1"}`,
+			},
+		})
+		var validationErr *apperrors.ValidationError
+		if !errors.As(err, &validationErr) || validationErr.Field != "stats.views" {
+			t.Fatalf("Expected stats.views validation error, got %v", err)
+		}
+		if mockRepo.upserts != 0 {
+			t.Errorf("Invalid content should NOT be written, got %d upserts", mockRepo.upserts)
+		}
+		quarantined := providerRepo.syncErrors[1]
+		if len(quarantined) != 1 || quarantined[0].ExternalID != "v1" || quarantined[0].RawData != `{"id":"v1"}` {
+			t.Errorf("Expected v1 to be quarantined with raw data, got %v", quarantined)
+		}
+	})
+
+	t.Run("quarantine write failure is returned for redelivery", func(t *testing.T) {
+		mockRepo := &mockContentRepository{}
+		useCase := newUseCase(mockRepo, &mockCacheRepository{})
+		useCase.SetSyncLogRepository(failingSyncErrorRepository{&mockProviderRepository{}})
+
+		err := useCase.IngestEvent(context.Background(), &entity.ContentEvent{
+			ProviderID: 1,
+			Op:         entity.ContentEventUpsert,
+			Content: &entity.NormalizedContent{
+				ExternalID: "v1", Title: "Video", ContentType: entity.ContentTypeVideo,
+				PublishedAt: testPublishedAt, Stats: entity.ContentStats{Views: -5},
+			},
+		})
+		// Doğrulama hatası dönseydi consumer olayı sonlandırır ve olay kaybolurdu
+		var validationErr *apperrors.ValidationError
+		if err == nil || errors.As(err, &validationErr) {
+			t.Fatalf("Expected a non-validation error, got %v", err)
+		}
+		if mockRepo.upserts != 0 {
+			t.Errorf("Invalid content should NOT be written, got %d upserts", mockRepo.upserts)
+		}
+	})
+
+	t.Run("delete soft deletes the content", func(t *testing.T) {
+		mockRepo := &mockContentRepository{}
+		useCase := newUseCase(mockRepo, &mockCacheRepository{})
+
+		err := useCase.IngestEvent(context.Background(), &entity.ContentEvent{
+			ProviderID: 1,
+			Op:         entity.ContentEventDelete,
+			Content:    &entity.NormalizedContent{ExternalID: "v1"},
+		})
+		if err != nil {
+			t.Fatalf("IngestEvent failed: %v", err)
+		}
+		if len(mockRepo.deleted) != 1 || mockRepo.deleted[0] != "v1" {
+			t.Errorf("Expected v1 to be deleted, got %v", mockRepo.deleted)
+		}
+	})
+
+	t.Run("rejects invalid and unknown provider events", func(t *testing.T) {
+		useCase := newUseCase(&mockContentRepository{}, &mockCacheRepository{})
+
+		err := useCase.IngestEvent(context.Background(), &entity.ContentEvent{
+			ProviderID: 1,
+			Op:         entity.ContentEventUpsert,
+			Content:    &entity.NormalizedContent{ExternalID: "p1", Title: "Hologram", ContentType: "hologram"},
+		})
+		var validationErr *apperrors.ValidationError
+		if !errors.As(err, &validationErr) || validationErr.Field != "content_type" {
+			t.Errorf("Expected content_type validation error, got %v", err)
+		}
+
+		err = useCase.IngestEvent(context.Background(), &entity.ContentEvent{
+			ProviderID: 99,
+			Op:         entity.ContentEventDelete,
+			Content:    &entity.NormalizedContent{ExternalID: "v1"},
+		})
+		if !errors.Is(err, port.ErrProviderNotFound) {
+			t.Errorf("Expected ErrProviderNotFound, got %v", err)
+		}
+	})
+}
+
+// mockChangePublisher yayınlanan içerik değişikliklerini kaydeder
+type mockChangePublisher struct {
+	changes []entity.ContentChange
+}
+
+func (m *mockChangePublisher) Publish(changes []entity.ContentChange) {
+	m.changes = append(m.changes, changes...)
+}
+
+func TestSyncProviderContentsUseCase_ChangePublisher(t *testing.T) {
+	contents := []*entity.NormalizedContent{
+		{ExternalID: "v1", Title: "Go Concurrency", ContentType: entity.ContentTypeVideo, PublishedAt: testPublishedAt, Tags: []string{"golang"}},
+		{ExternalID: "a1", Title: "Rust Ownership", ContentType: entity.ContentTypeArticle, PublishedAt: testPublishedAt},
+	}
+
+	t.Run("publishes written contents after commit", func(t *testing.T) {
+		publisher := &mockChangePublisher{}
+		useCase := NewSyncProviderContentsUseCase(
+			[]port.ProviderClient{&mockProviderClient{contents: contents}},
+			&mockContentRepository{}, &mockScoringService{}, &mockCacheRepository{},
+		)
+		useCase.SetChangePublisher(publisher)
+
+		if err := useCase.Execute(context.Background()); err != nil {
+			t.Fatalf("Execute failed: %v", err)
+		}
+
+		if len(publisher.changes) != 2 {
+			t.Fatalf("Expected 2 changes, got %d", len(publisher.changes))
+		}
+		first := publisher.changes[0]
+		if first.Type != entity.ContentChangeCreated {
+			t.Errorf("Expected created change, got %q", first.Type)
+		}
+		if first.Content.ProviderContentID != "v1" || first.Content.Provider == nil || first.Content.Provider.Name != "Test Provider" {
+			t.Errorf("Unexpected published content: %+v", first.Content)
+		}
+		if len(first.Content.Tags) != 1 || first.Content.Tags[0].Name != "golang" {
+			t.Errorf("Expected tags to be published, got %v", first.Content.Tags)
+		}
+	})
+
+	t.Run("reports existing contents as updated", func(t *testing.T) {
+		publisher := &mockChangePublisher{}
+		mockRepo := &mockContentRepository{bulkErr: errors.New("bulk disabled")}
+		useCase := NewSyncProviderContentsUseCase(
+			[]port.ProviderClient{&mockProviderClient{contents: contents[:1]}},
+			&updatingContentRepository{mockContentRepository: mockRepo}, &mockScoringService{}, &mockCacheRepository{},
+		)
+		useCase.SetChangePublisher(publisher)
+
+		if err := useCase.Execute(context.Background()); err != nil {
+			t.Fatalf("Execute failed: %v", err)
+		}
+		if len(publisher.changes) != 1 || publisher.changes[0].Type != entity.ContentChangeUpdated {
+			t.Errorf("Expected 1 updated change, got %+v", publisher.changes)
+		}
+	})
+
+	t.Run("publishes nothing when the sync is rolled back", func(t *testing.T) {
+		publisher := &mockChangePublisher{}
+		useCase := NewSyncProviderContentsUseCase(
+			[]port.ProviderClient{&mockProviderClient{contents: contents}},
+			&mockContentRepository{markErr: errors.New("connection reset")}, &mockScoringService{}, &mockCacheRepository{},
+		)
+		useCase.SetChangePublisher(publisher)
+
+		_ = useCase.Execute(context.Background())
+		if len(publisher.changes) != 0 {
+			t.Errorf("Expected no changes, got %d", len(publisher.changes))
+		}
+	})
+}
+
+// updatingContentRepository upsert'leri mevcut içeriğin güncellenmesi gibi döner (updated_at ilerler)
+type updatingContentRepository struct {
+	*mockContentRepository
+}
+
+func (m *updatingContentRepository) Upsert(ctx context.Context, content *entity.Content) error {
+	content.CreatedAt = testPublishedAt
+	content.UpdatedAt = time.Now()
+	return m.mockContentRepository.Upsert(ctx, content)
+}
+
+func TestContentLanguage(t *testing.T) {
+	turkish := &entity.NormalizedContent{Title: "Go ile eşzamanlı programlama", Tags: []string{"yazılım"}}
+
+	if got := contentLanguage("", turkish); got != entity.LanguageTurkish {
+		t.Errorf("Expected detected language %q, got %q", entity.LanguageTurkish, got)
+	}
+	if got := contentLanguage(entity.LanguageGerman, turkish); got != entity.LanguageGerman {
+		t.Errorf("Expected provider language %q, got %q", entity.LanguageGerman, got)
+	}
+
+	withLanguage := &entity.NormalizedContent{Title: "Go ile eşzamanlı programlama", Language: entity.LanguageFrench}
+	if got := contentLanguage("", withLanguage); got != entity.LanguageFrench {
+		t.Errorf("Expected content language %q, got %q", entity.LanguageFrench, got)
+	}
+
+	unsupported := &entity.NormalizedContent{Title: "Introduction to the Go language", Language: "klingon"}
+	if got := contentLanguage("", unsupported); got != entity.LanguageEnglish {
+		t.Errorf("Expected detected language %q for unsupported content language, got %q", entity.LanguageEnglish, got)
+	}
+}
+
+// wedgedProviderClient farklı ID ile raporlanan, context iptal edilene kadar asılı kalan provider
+type wedgedProviderClient struct {
+	blockingProviderClient
+}
+
+func (m *wedgedProviderClient) GetProviderInfo() *entity.Provider {
+	return &entity.Provider{ID: 2, Name: "Wedged Provider", AutoApprove: true}
+}
+
+// ctxSyncLogRepository iptal edilmiş context ile yapılan sync log güncellemelerini reddeder
+type ctxSyncLogRepository struct {
+	*mockProviderRepository
+}
+
+func (r ctxSyncLogRepository) UpdateSyncLog(ctx context.Context, log *entity.ProviderSyncLog) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return r.mockProviderRepository.UpdateSyncLog(ctx, log)
+}
+
+// failingSyncErrorRepository karantina kayıtlarını yazamayan sync log repository
+type failingSyncErrorRepository struct {
+	*mockProviderRepository
+}
+
+func (r failingSyncErrorRepository) AddSyncError(ctx context.Context, providerID int64, syncError *entity.ProviderSyncError) error {
+	return errors.New("database unavailable")
+}
+
+func TestSyncProviderContentsUseCase_Timeouts(t *testing.T) {
+	t.Run("wedged provider times out and is logged", func(t *testing.T) {
+		logRepo := ctxSyncLogRepository{newMockProviderRepository()}
+		useCase := NewSyncProviderContentsUseCase(
+			[]port.ProviderClient{&wedgedProviderClient{blockingProviderClient{started: make(chan struct{})}}},
+			&mockContentRepository{}, &mockScoringService{}, &mockCacheRepository{},
+		)
+		useCase.SetSyncLogRepository(logRepo)
+		useCase.SetTimeouts(time.Minute, 20*time.Millisecond)
+
+		err := useCase.ExecuteProvider(context.Background(), 2)
+		if !errors.Is(err, ErrSyncTimeout) || !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("Expected sync timeout, got %v", err)
+		}
+
+		if len(logRepo.syncLogs) != 1 {
+			t.Fatalf("Expected 1 sync log, got %d", len(logRepo.syncLogs))
+		}
+		syncLog := logRepo.syncLogs[0]
+		if syncLog.Status != SyncStatusFailed || !strings.Contains(syncLog.ErrorMessage, "provider sync exceeded") {
+			t.Errorf("Expected failed sync log with timeout reason, got %+v", syncLog)
+		}
+	})
+
+	t.Run("other providers are not blocked", func(t *testing.T) {
+		mockRepo := &mockContentRepository{}
+		mockCache := &mockCacheRepository{}
+		useCase := NewSyncProviderContentsUseCase(
+			[]port.ProviderClient{
+				&wedgedProviderClient{blockingProviderClient{started: make(chan struct{})}},
+				&mockProviderClient{contents: testNormalizedContents(3)},
+			},
+			mockRepo, &mockScoringService{}, mockCache,
+		)
+		useCase.SetTimeouts(50*time.Millisecond, 0)
+
+		jobID, err := useCase.ExecuteAsync()
+		if err != nil {
+			t.Fatalf("ExecuteAsync failed: %v", err)
+		}
+		if err := useCase.Shutdown(context.Background()); err != nil {
+			t.Fatalf("Shutdown failed: %v", err)
+		}
+
+		job, _ := useCase.Job(jobID)
+		if job.Status != SyncStatusFailed {
+			t.Errorf("Expected failed job, got %s", job.Status)
+		}
+		for _, p := range job.Providers {
+			if p.ProviderID == 2 && !strings.Contains(p.Error, ErrSyncTimeout.Error()) {
+				t.Errorf("Expected timeout error for wedged provider, got %q", p.Error)
+			}
+			if p.ProviderID == 1 && (p.Status != SyncStatusSuccess || p.ItemsSynced != 3) {
+				t.Errorf("Expected healthy provider to finish, got %+v", p)
+			}
+		}
+		if !mockCache.invalidated {
+			t.Error("Search cache was NOT invalidated after a timed out sync")
+		}
+	})
+}
@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+)
+
+// syncMinBatchSize paralel yazmada içerikler bundan küçük batch'lere bölünmez (toplu sorgu avantajı korunur)
+const syncMinBatchSize = 50
+
+// SetConcurrency senkronizasyon paralelliğini ayarlar
+// providers: aynı anda senkronize edilen (çekilen ve normalize edilen) en fazla provider sayısı (0 ise hepsi birlikte)
+// workers: tüm provider'ların paylaştığı yazma worker sayısı. 1'den büyükse aynı anda en fazla bu kadar yazma
+// transaction'ı açılır; her provider yine tek transaction içinde yazılır (bkz. SetParallelBatchCommits)
+// perProvider: batch'ler paralel commit edilirken tek bir provider'ın aynı anda kullanabileceği en fazla worker sayısı
+// Senkronizasyon başlamadan önce çağrılmalıdır
+func (uc *SyncProviderContentsUseCase) SetConcurrency(providers, workers, perProvider int) {
+	uc.providerSlots = nil
+	if providers > 0 {
+		uc.providerSlots = make(chan struct{}, providers)
+	}
+
+	uc.workerSlots = nil
+	if workers > 1 {
+		uc.workerSlots = make(chan struct{}, workers)
+	}
+	uc.workersPerProvider = max(perProvider, 1)
+}
+
+// SetParallelBatchCommits worker pool açıkken bir provider'ın batch'lerinin paralel ve her birinin kendi
+// transaction'ında yazılmasını sağlar. Büyük provider'lar daha hızlı yazılır, ama senkronizasyon atomik olmaktan
+// çıkar: hatadan önce commit edilen batch'ler geri alınmaz (soft delete yine sadece tüm içerikler yazıldıysa yapılır)
+// Senkronizasyon başlamadan önce çağrılmalıdır
+func (uc *SyncProviderContentsUseCase) SetParallelBatchCommits(enabled bool) {
+	uc.parallelBatchCommits = enabled
+}
+
+// acquireSlot slots nil değilse boş yer açılana kadar bekler; context iptal edilirse false döner
+func acquireSlot(ctx context.Context, slots chan struct{}) bool {
+	if slots == nil {
+		return true
+	}
+	select {
+	case slots <- struct{}{}:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// releaseSlot acquireSlot ile alınan yeri bırakır
+func releaseSlot(slots chan struct{}) {
+	if slots != nil {
+		<-slots
+	}
+}
+
+// parallelBatchSize içerik sayısını provider'ın worker'larına bölen batch boyutu
+func (uc *SyncProviderContentsUseCase) parallelBatchSize(total int) int {
+	size := (total + uc.workersPerProvider - 1) / uc.workersPerProvider
+	return min(max(size, syncMinBatchSize), syncBatchSize)
+}
+
+// processBatchesParallel içerikleri batch'lere böler ve worker pool'da paralel işler
+// Her batch processBatch ile kendi transaction'ında yazılır; ctx bir transaction taşımamalıdır
+// Yazılan içerikleri girdi sırasıyla döner; context iptal edilirse kalan batch'ler atlanır
+func (uc *SyncProviderContentsUseCase) processBatchesParallel(
+	ctx context.Context,
+	providerID int64,
+	normalized []*entity.NormalizedContent,
+) []*entity.Content {
+	size := uc.parallelBatchSize(len(normalized))
+	var batches [][]*entity.NormalizedContent
+	for start := 0; start < len(normalized); start += size {
+		batches = append(batches, normalized[start:min(start+size, len(normalized))])
+	}
+
+	results := make([][]*entity.Content, len(batches))
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(uc.workersPerProvider, len(batches)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				if !acquireSlot(ctx, uc.workerSlots) {
+					continue
+				}
+				results[i] = uc.processBatch(ctx, providerID, batches[i])
+				releaseSlot(uc.workerSlots)
+			}
+		}()
+	}
+
+feed:
+	for i := range batches {
+		select {
+		case next <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(next)
+	wg.Wait()
+
+	var written []*entity.Content
+	for _, contents := range results {
+		written = append(written, contents...)
+	}
+	return written
+}
@@ -0,0 +1,345 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// concurrencyGauge aynı anda çalışan çağrıların en yüksek sayısını ölçer
+type concurrencyGauge struct {
+	mu     sync.Mutex
+	active int
+	peak   int
+}
+
+// enter çağrıyı başlatır ve biraz bekletir; dönen fonksiyon çağrıyı bitirir
+func (g *concurrencyGauge) enter() func() {
+	g.mu.Lock()
+	g.active++
+	g.peak = max(g.peak, g.active)
+	g.mu.Unlock()
+
+	time.Sleep(10 * time.Millisecond)
+	return func() {
+		g.mu.Lock()
+		g.active--
+		g.mu.Unlock()
+	}
+}
+
+// concurrentContentRepository yazmaları eşzamanlı çağrılara karşı kilitleyen mock repository
+type concurrentContentRepository struct {
+	mockContentRepository
+	gauge      concurrencyGauge
+	mu         sync.Mutex
+	failIDs    map[string]bool // Bu external ID'leri içeren yazmalar hata döner
+	contentIDs map[string]bool
+}
+
+func (m *concurrentContentRepository) BulkUpsert(ctx context.Context, contents []*entity.Content) error {
+	defer m.gauge.enter()()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, c := range contents {
+		if m.failIDs[c.ProviderContentID] {
+			return errors.New("constraint violation")
+		}
+	}
+	for _, c := range contents {
+		m.contentIDs[c.ProviderContentID] = true
+	}
+	return m.mockContentRepository.BulkUpsert(ctx, contents)
+}
+
+func (m *concurrentContentRepository) Upsert(ctx context.Context, content *entity.Content) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.failIDs[content.ProviderContentID] {
+		return errors.New("constraint violation")
+	}
+	m.contentIDs[content.ProviderContentID] = true
+	return m.mockContentRepository.Upsert(ctx, content)
+}
+
+func (m *concurrentContentRepository) MarkStaleContentsAsDeleted(ctx context.Context, providerID int64, threshold time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.mockContentRepository.MarkStaleContentsAsDeleted(ctx, providerID, threshold)
+}
+
+// outerTxKey context'te dış transaction'ın açık olduğunu işaretler
+type outerTxKey struct{}
+
+// concurrentTransactor eşzamanlı senkronizasyonlar için mockTransactor; iç içe çağrıları context'ten ayırt eder
+// ve aynı anda açık dış transaction sayısını ölçer
+type concurrentTransactor struct {
+	gauge      concurrencyGauge
+	mu         sync.Mutex
+	commits    int
+	rollbacks  int
+	savepoints int
+}
+
+func (m *concurrentTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if ctx.Value(outerTxKey{}) != nil {
+		m.mu.Lock()
+		m.savepoints++
+		m.mu.Unlock()
+		return fn(ctx)
+	}
+
+	defer m.gauge.enter()()
+	err := fn(context.WithValue(ctx, outerTxKey{}, true))
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err != nil {
+		m.rollbacks++
+	} else {
+		m.commits++
+	}
+	return err
+}
+
+// numberedProviderClient verilen ID'yle ve içeriklerle dönen provider client'ı
+type numberedProviderClient struct {
+	mockProviderClient
+	id int64
+}
+
+func (c *numberedProviderClient) GetProviderInfo() *entity.Provider {
+	return &entity.Provider{ID: c.id, Name: fmt.Sprintf("Provider %d", c.id), AutoApprove: true}
+}
+
+// gaugedProviderClient FetchContents çağrılarının eşzamanlılığını ölçen provider client'ı
+type gaugedProviderClient struct {
+	mockProviderClient
+	id    int64
+	gauge *concurrencyGauge
+}
+
+func (c *gaugedProviderClient) FetchContents(ctx context.Context) ([]*entity.NormalizedContent, error) {
+	defer c.gauge.enter()()
+	return nil, nil
+}
+
+func (c *gaugedProviderClient) GetProviderInfo() *entity.Provider {
+	return &entity.Provider{ID: c.id, Name: fmt.Sprintf("Provider %d", c.id), AutoApprove: true}
+}
+
+func testNormalizedContents(n int) []*entity.NormalizedContent {
+	contents := make([]*entity.NormalizedContent, n)
+	for i := range contents {
+		contents[i] = &entity.NormalizedContent{
+			ExternalID:  fmt.Sprintf("v%d", i),
+			Title:       fmt.Sprintf("Video %d", i),
+			ContentType: entity.ContentTypeVideo,
+			PublishedAt: testPublishedAt,
+		}
+	}
+	return contents
+}
+
+func TestSyncProviderContentsUseCase_WorkerPool(t *testing.T) {
+	t.Run("each provider is written in one transaction within the write limit", func(t *testing.T) {
+		repo := &concurrentContentRepository{contentIDs: make(map[string]bool)}
+		clients := make([]port.ProviderClient, 3)
+		for i := range clients {
+			contents := testNormalizedContents(600)
+			for _, c := range contents {
+				c.ExternalID = fmt.Sprintf("p%d-%s", i+1, c.ExternalID)
+			}
+			clients[i] = &numberedProviderClient{mockProviderClient: mockProviderClient{contents: contents}, id: int64(i + 1)}
+		}
+		tx := &concurrentTransactor{}
+		useCase := NewSyncProviderContentsUseCase(clients, repo, &mockScoringService{}, &mockCacheRepository{})
+		useCase.SetTransactor(tx)
+		useCase.SetConcurrency(0, 2, 4)
+
+		if err := useCase.Execute(context.Background()); err != nil {
+			t.Fatalf("Execute failed: %v", err)
+		}
+
+		if len(repo.contentIDs) != 1800 {
+			t.Errorf("Expected 1800 contents written, got %d", len(repo.contentIDs))
+		}
+		if tx.commits != 3 || tx.rollbacks != 0 {
+			t.Errorf("Expected one committed transaction per provider, got %d commits, %d rollbacks", tx.commits, tx.rollbacks)
+		}
+		if tx.savepoints == 0 {
+			t.Error("Expected batch writes to run in savepoints of the provider transaction")
+		}
+		if tx.gauge.peak > 2 {
+			t.Errorf("Expected at most 2 concurrent write transactions, got %d", tx.gauge.peak)
+		}
+		if !repo.markedDeleted {
+			t.Error("Stale contents were NOT marked deleted after a complete sync")
+		}
+	})
+
+	t.Run("failure rolls back the whole provider with workers", func(t *testing.T) {
+		repo := &concurrentContentRepository{contentIDs: make(map[string]bool)}
+		repo.markErr = errors.New("connection reset")
+		tx := &concurrentTransactor{}
+		logRepo := newMockProviderRepository()
+		useCase := NewSyncProviderContentsUseCase(
+			[]port.ProviderClient{&mockProviderClient{contents: testNormalizedContents(1000)}},
+			repo, &mockScoringService{}, &mockCacheRepository{},
+		)
+		useCase.SetTransactor(tx)
+		useCase.SetSyncLogRepository(logRepo)
+		useCase.SetConcurrency(0, 4, 4)
+
+		if err := useCase.ExecuteProvider(context.Background(), 1); err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		if tx.rollbacks != 1 || tx.commits != 0 {
+			t.Errorf("Expected 1 rollback and no commit, got %d commits, %d rollbacks", tx.commits, tx.rollbacks)
+		}
+		if logRepo.syncLogs[0].Status != SyncStatusFailed || logRepo.syncLogs[0].ItemsSynced != 0 {
+			t.Errorf("Expected failed sync log with 0 items, got %s/%d",
+				logRepo.syncLogs[0].Status, logRepo.syncLogs[0].ItemsSynced)
+		}
+	})
+
+	t.Run("parallel batch commits within limits", func(t *testing.T) {
+		repo := &concurrentContentRepository{contentIDs: make(map[string]bool)}
+		useCase := NewSyncProviderContentsUseCase(
+			[]port.ProviderClient{&mockProviderClient{contents: testNormalizedContents(1000)}},
+			repo, &mockScoringService{}, &mockCacheRepository{},
+		)
+		useCase.SetConcurrency(0, 2, 4)
+		useCase.SetParallelBatchCommits(true)
+
+		if err := useCase.Execute(context.Background()); err != nil {
+			t.Fatalf("Execute failed: %v", err)
+		}
+
+		if len(repo.contentIDs) != 1000 {
+			t.Errorf("Expected 1000 contents written, got %d", len(repo.contentIDs))
+		}
+		if repo.bulkUpserts != 4 {
+			t.Errorf("Expected 1000 contents split into 4 batches, got %d", repo.bulkUpserts)
+		}
+		if repo.gauge.peak > 2 {
+			t.Errorf("Expected at most 2 concurrent batch writes, got %d", repo.gauge.peak)
+		}
+		if !repo.markedDeleted {
+			t.Error("Stale contents were NOT marked deleted after a complete sync")
+		}
+	})
+
+	t.Run("failed item skips soft delete with parallel batch commits", func(t *testing.T) {
+		repo := &concurrentContentRepository{contentIDs: make(map[string]bool), failIDs: map[string]bool{"v42": true}}
+		useCase := NewSyncProviderContentsUseCase(
+			[]port.ProviderClient{&mockProviderClient{contents: testNormalizedContents(200)}},
+			repo, &mockScoringService{}, &mockCacheRepository{},
+		)
+		useCase.SetConcurrency(0, 4, 4)
+		useCase.SetParallelBatchCommits(true)
+
+		if err := useCase.Execute(context.Background()); err != nil {
+			t.Fatalf("Execute failed: %v", err)
+		}
+
+		if len(repo.contentIDs) != 199 {
+			t.Errorf("Expected 199 contents written around the failing one, got %d", len(repo.contentIDs))
+		}
+		if repo.markedDeleted {
+			t.Error("Stale contents must not be marked deleted when an item failed")
+		}
+	})
+
+	t.Run("failed batch mid-run with parallel batch commits", func(t *testing.T) {
+		// 200 içerik 4 batch'e bölünür; ikinci batch'in tüm içerikleri yazılamaz
+		failIDs := make(map[string]bool)
+		for i := 50; i < 100; i++ {
+			failIDs[fmt.Sprintf("v%d", i)] = true
+		}
+		repo := &concurrentContentRepository{contentIDs: make(map[string]bool), failIDs: failIDs}
+		tx := &concurrentTransactor{}
+		logRepo := newMockProviderRepository()
+		client := &mockConditionalClient{mockProviderClient: mockProviderClient{contents: testNormalizedContents(200)}, pendingETag: `"v2"`}
+		useCase := NewSyncProviderContentsUseCase([]port.ProviderClient{client}, repo, &mockScoringService{}, &mockCacheRepository{})
+		useCase.SetTransactor(tx)
+		useCase.SetSyncLogRepository(logRepo)
+		useCase.SetConcurrency(0, 4, 4)
+		useCase.SetParallelBatchCommits(true)
+
+		if err := useCase.ExecuteProvider(context.Background(), 1); err != nil {
+			t.Fatalf("ExecuteProvider failed: %v", err)
+		}
+
+		// Commit edilen batch'ler kalır ve raporlanan sayı yazılanlarla aynıdır
+		if len(repo.contentIDs) != 150 {
+			t.Errorf("Expected the 3 other batches to be written, got %d contents", len(repo.contentIDs))
+		}
+		for id := range repo.contentIDs {
+			if failIDs[id] {
+				t.Fatalf("Content %s of the failed batch was written", id)
+			}
+		}
+		if logRepo.syncLogs[0].ItemsSynced != 150 {
+			t.Errorf("Expected sync log to report 150 committed contents, got %d", logRepo.syncLogs[0].ItemsSynced)
+		}
+		// Eksik senkronizasyon feed'de olmayanları silmez ve koşullu istek değerlerini kabul etmez
+		if repo.markedDeleted {
+			t.Error("Stale contents must not be marked deleted when a batch failed")
+		}
+		if client.committed != "" {
+			t.Errorf("Fetch validators must not be committed after a partial sync, got %q", client.committed)
+		}
+		if tx.rollbacks == 0 || tx.commits < 3 {
+			t.Errorf("Expected the failed batch rolled back and the others committed, got %d commits, %d rollbacks", tx.commits, tx.rollbacks)
+		}
+	})
+
+	t.Run("error after batches commit with parallel batch commits", func(t *testing.T) {
+		repo := &concurrentContentRepository{contentIDs: make(map[string]bool)}
+		repo.markErr = errors.New("connection reset")
+		logRepo := newMockProviderRepository()
+		useCase := NewSyncProviderContentsUseCase(
+			[]port.ProviderClient{&mockProviderClient{contents: testNormalizedContents(1000)}},
+			repo, &mockScoringService{}, &mockCacheRepository{},
+		)
+		useCase.SetTransactor(&concurrentTransactor{})
+		useCase.SetSyncLogRepository(logRepo)
+		useCase.SetConcurrency(0, 4, 4)
+		useCase.SetParallelBatchCommits(true)
+
+		if err := useCase.ExecuteProvider(context.Background(), 1); err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		if logRepo.syncLogs[0].Status != SyncStatusFailed || int(logRepo.syncLogs[0].ItemsSynced) != len(repo.contentIDs) {
+			t.Errorf("Expected failed sync log reporting the %d committed contents, got %s/%d",
+				len(repo.contentIDs), logRepo.syncLogs[0].Status, logRepo.syncLogs[0].ItemsSynced)
+		}
+	})
+
+	t.Run("provider concurrency limit", func(t *testing.T) {
+		gauge := &concurrencyGauge{}
+		clients := make([]port.ProviderClient, 3)
+		for i := range clients {
+			clients[i] = &gaugedProviderClient{id: int64(i + 1), gauge: gauge}
+		}
+		useCase := NewSyncProviderContentsUseCase(clients, &mockContentRepository{}, &mockScoringService{}, &mockCacheRepository{})
+		useCase.SetConcurrency(1, 1, 1)
+
+		if err := useCase.Execute(context.Background()); err != nil {
+			t.Fatalf("Execute failed: %v", err)
+		}
+		if gauge.peak != 1 {
+			t.Errorf("Expected providers to sync one at a time, peak was %d", gauge.peak)
+		}
+	})
+}
@@ -0,0 +1,19 @@
+package usecase
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer use case span'lerini üretir; tracer provider kurulmamışsa span'ler no-op'tur
+var tracer = otel.Tracer("github.com/onurerdog4n/search-engine/internal/application/usecase")
+
+// endSpan span'i kapatır; err nil değilse span'e hata olarak kaydedilir
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
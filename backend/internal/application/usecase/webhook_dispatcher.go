@@ -0,0 +1,308 @@
+package usecase
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// Webhook isteklerinde gönderilen header'lar
+const (
+	WebhookSignatureHeader = "X-Webhook-Signature" // t=<unix>,v1=<hex HMAC-SHA256(secret, "<unix>.<body>")>
+	WebhookDeliveryHeader  = "X-Webhook-Delivery"  // Gönderim ID'si; tekrar denemelerde aynı kalır
+	WebhookEventHeader     = "X-Webhook-Event"
+)
+
+// Webhook gönderim sınırları
+const (
+	maxPendingWebhookChanges = 10000 // Kuyruğa alınmayı bekleyen değişiklik sınırı; aşılırsa yeni değişiklikler düşürülür
+	maxWebhookChangesPerBody = 100   // Tek gönderimdeki değişiklik sayısı; fazlası ayrı gönderimlere bölünür
+	webhookClaimBatch        = 10    // Bir turda paralel gönderilen teslimat sayısı
+	webhookRetryBaseDelay    = time.Minute
+	webhookRetryMaxDelay     = time.Hour
+)
+
+// WebhookDeliveryStats bir DeliverDue çağrısının sonucu
+type WebhookDeliveryStats struct {
+	Delivered int // Alıcı 2xx döndü
+	Retried   int // Başarısız, tekrar denenecek
+	Failed    int // Deneme hakkı bitti veya alıcı kalıcı olarak reddetti
+}
+
+// WebhookDispatcher sync'te commit edilen değişiklikleri aboneliklerle eşleştirip kuyruğa alır
+// ve kuyruktaki gönderimleri imzalayıp tekrar denemelerle POST eder.
+// port.ContentChangePublisher arayüzünü sağlar; Publish sync'i bloklamaz, eşleştirme ve
+// kuyruğa alma EnqueuePending ile arka planda yapılır
+type WebhookDispatcher struct {
+	webhookRepo port.WebhookRepository
+	sender      port.WebhookSender
+	maxAttempts int
+	timeout     time.Duration
+	now         func() time.Time
+	queue       *changeQueue
+}
+
+// NewWebhookDispatcher yeni bir webhook dispatcher oluşturur
+// maxAttempts bir gönderimin en fazla kaç kez deneneceği, timeout tek bir isteğin süre sınırıdır
+func NewWebhookDispatcher(webhookRepo port.WebhookRepository, sender port.WebhookSender, maxAttempts int, timeout time.Duration) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		webhookRepo: webhookRepo,
+		sender:      sender,
+		maxAttempts: maxAttempts,
+		timeout:     timeout,
+		now:         time.Now,
+		queue:       newChangeQueue(maxPendingWebhookChanges),
+	}
+}
+
+// Publish değişiklikleri eşleştirilmek üzere bekletir ve Ready kanalını tetikler
+func (d *WebhookDispatcher) Publish(changes []entity.ContentChange) {
+	d.queue.push(changes)
+}
+
+// Ready Publish çağrıldığında sinyal alan kanal; worker periyodik taramayı beklemeden uyanır
+func (d *WebhookDispatcher) Ready() <-chan struct{} {
+	return d.queue.ready
+}
+
+// EnqueuePending bekleyen değişiklikleri aktif aboneliklerle eşleştirir ve gönderimleri kuyruğa alır
+// Kuyruğa alınan gönderim sayısını ve son çağrıdan beri limit yüzünden düşürülen değişiklik sayısını döner
+func (d *WebhookDispatcher) EnqueuePending(ctx context.Context) (enqueued int, dropped int, err error) {
+	changes, dropped := d.queue.take()
+	if len(changes) == 0 {
+		return 0, dropped, nil
+	}
+
+	subs, err := d.webhookRepo.ListWebhooks(ctx)
+	if err != nil {
+		d.queue.requeue(changes)
+		return 0, dropped, fmt.Errorf("webhook'lar okunamadı: %w", err)
+	}
+
+	now := d.now()
+	deliveries := make([]*entity.WebhookDelivery, 0)
+	for _, sub := range subs {
+		if !sub.Active {
+			continue
+		}
+		filter := ContentStreamFilter{
+			Query:       sub.Query,
+			Tags:        sub.Tags,
+			ContentType: sub.ContentType,
+			ProviderID:  sub.ProviderID,
+			Changes:     sub.Changes,
+		}
+
+		var matched []entity.ContentChange
+		for _, change := range changes {
+			if filter.matches(change) {
+				matched = append(matched, change)
+			}
+		}
+
+		for start := 0; start < len(matched); start += maxWebhookChangesPerBody {
+			end := start + maxWebhookChangesPerBody
+			if end > len(matched) {
+				end = len(matched)
+			}
+			payload, err := json.Marshal(entity.WebhookPayload{
+				Event:          entity.WebhookEventContentMatched,
+				SubscriptionID: sub.ID,
+				Changes:        matched[start:end],
+				CreatedAt:      now,
+			})
+			if err != nil {
+				d.queue.requeue(changes)
+				return 0, dropped, fmt.Errorf("webhook gövdesi oluşturulamadı: %w", err)
+			}
+			deliveries = append(deliveries, &entity.WebhookDelivery{
+				SubscriptionID: sub.ID,
+				Payload:        payload,
+				ItemCount:      end - start,
+			})
+		}
+	}
+
+	if err := d.webhookRepo.EnqueueWebhookDeliveries(ctx, deliveries); err != nil {
+		d.queue.requeue(changes)
+		return 0, dropped, fmt.Errorf("webhook gönderimleri kuyruğa alınamadı: %w", err)
+	}
+
+	return len(deliveries), dropped, nil
+}
+
+// DeliverDue zamanı gelmiş gönderimleri kuyruk boşalana veya ctx iptal edilene kadar gönderir
+func (d *WebhookDispatcher) DeliverDue(ctx context.Context) (WebhookDeliveryStats, error) {
+	var (
+		stats WebhookDeliveryStats
+		subs  map[int64]*entity.WebhookSubscription
+	)
+
+	// Sahiplenilen gönderimler paralel gönderildiği için lease tek istek süresinin biraz üstündedir
+	lease := d.timeout + time.Minute
+
+	for ctx.Err() == nil {
+		deliveries, err := d.webhookRepo.ClaimDueWebhookDeliveries(ctx, webhookClaimBatch, lease)
+		if err != nil {
+			return stats, fmt.Errorf("webhook gönderimleri alınamadı: %w", err)
+		}
+		if len(deliveries) == 0 {
+			break
+		}
+
+		if subs == nil {
+			list, err := d.webhookRepo.ListWebhooks(ctx)
+			if err != nil {
+				return stats, fmt.Errorf("webhook'lar okunamadı: %w", err)
+			}
+			subs = make(map[int64]*entity.WebhookSubscription, len(list))
+			for _, sub := range list {
+				subs[sub.ID] = sub
+			}
+		}
+
+		var (
+			wg      sync.WaitGroup
+			statsMu sync.Mutex
+		)
+		for _, delivery := range deliveries {
+			sub, ok := subs[delivery.SubscriptionID]
+			if !ok {
+				// Bu tur başladıktan sonra oluşturulan abonelik; lease bitince tekrar sahiplenilir
+				continue
+			}
+			wg.Add(1)
+			go func(sub *entity.WebhookSubscription, delivery *entity.WebhookDelivery) {
+				defer wg.Done()
+				var status string
+				if sub.Active {
+					status = d.deliver(ctx, sub, delivery)
+				} else {
+					// Gönderim kuyruğa alındıktan sonra pasifleştirilen abonelik: alıcıya gönderilmez
+					status = d.discard(ctx, delivery, "webhook subscription is inactive")
+				}
+
+				statsMu.Lock()
+				defer statsMu.Unlock()
+				switch status {
+				case entity.WebhookDeliveryDelivered:
+					stats.Delivered++
+				case entity.WebhookDeliveryPending:
+					stats.Retried++
+				case entity.WebhookDeliveryFailed:
+					stats.Failed++
+				}
+			}(sub, delivery)
+		}
+		wg.Wait()
+
+		if len(deliveries) < webhookClaimBatch {
+			break
+		}
+	}
+
+	return stats, nil
+}
+
+// deliver tek bir gönderimi imzalayıp POST eder ve sonucu kaydeder; yeni durumu döner
+// ctx iptal edildiyse sonuç kaydedilmez ve boş string döner: gönderim lease bitince tekrar denenir
+func (d *WebhookDispatcher) deliver(ctx context.Context, sub *entity.WebhookSubscription, delivery *entity.WebhookDelivery) string {
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	header.Set(WebhookDeliveryHeader, strconv.FormatInt(delivery.ID, 10))
+	header.Set(WebhookEventHeader, entity.WebhookEventContentMatched)
+	header.Set(WebhookSignatureHeader, SignWebhookPayload(sub.Secret, d.now().Unix(), delivery.Payload))
+
+	sendCtx, cancel := context.WithTimeout(ctx, d.timeout)
+	statusCode, err := d.sender.Send(sendCtx, sub.URL, header, delivery.Payload)
+	cancel()
+	if err != nil && ctx.Err() != nil {
+		return ""
+	}
+
+	now := d.now()
+	delivery.Attempts++
+	delivery.LastStatusCode = statusCode
+	switch {
+	case err == nil && statusCode >= 200 && statusCode < 300:
+		delivery.Status = entity.WebhookDeliveryDelivered
+		delivery.LastError = ""
+		delivery.DeliveredAt = &now
+	default:
+		if err != nil {
+			delivery.LastError = err.Error()
+		} else {
+			delivery.LastError = fmt.Sprintf("unexpected status code %d", statusCode)
+		}
+		if retryableWebhookFailure(statusCode, err) && delivery.Attempts < d.maxAttempts {
+			delivery.Status = entity.WebhookDeliveryPending
+			delivery.NextAttemptAt = now.Add(webhookRetryDelay(delivery.Attempts))
+		} else {
+			delivery.Status = entity.WebhookDeliveryFailed
+		}
+	}
+
+	// Gönderim yapıldı; sonuç shutdown başlasa bile kaydedilir ki aynı gövde tekrar gönderilmesin
+	updateCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), d.timeout)
+	defer cancel()
+	if err := d.webhookRepo.UpdateWebhookDelivery(updateCtx, delivery); err != nil {
+		return ""
+	}
+	return delivery.Status
+}
+
+// discard gönderimi alıcıya göndermeden başarısız olarak kaydeder; yeni durumu döner
+// Kayıt yazılamazsa boş string döner: gönderim lease bitince tekrar sahiplenilir
+func (d *WebhookDispatcher) discard(ctx context.Context, delivery *entity.WebhookDelivery, reason string) string {
+	delivery.Status = entity.WebhookDeliveryFailed
+	delivery.LastError = reason
+
+	updateCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), d.timeout)
+	defer cancel()
+	if err := d.webhookRepo.UpdateWebhookDelivery(updateCtx, delivery); err != nil {
+		return ""
+	}
+	return delivery.Status
+}
+
+// SignWebhookPayload X-Webhook-Signature header değerini üretir
+// Alıcı "<timestamp>.<gövde>" üzerinden aynı HMAC'i hesaplayıp v1 ile karşılaştırmalı,
+// replay'e karşı timestamp'in yakın bir zamana ait olduğunu kontrol etmelidir
+func SignWebhookPayload(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return fmt.Sprintf("t=%d,v1=%s", timestamp, hex.EncodeToString(mac.Sum(nil)))
+}
+
+// retryableWebhookFailure bağlantı hataları, 408, 429 ve 5xx yanıtlarında tekrar denenir
+// Diğer yanıtlar (3xx, 4xx) alıcının isteği kalıcı olarak reddettiği kabul edilir
+func retryableWebhookFailure(statusCode int, err error) bool {
+	if err != nil {
+		return true
+	}
+	return statusCode == http.StatusRequestTimeout || statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
+// webhookRetryDelay attempts. denemeden sonraki bekleme süresi: 1dk, 2dk, 4dk ... en fazla 1 saat
+func webhookRetryDelay(attempts int) time.Duration {
+	delay := webhookRetryBaseDelay
+	for i := 1; i < attempts && delay < webhookRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > webhookRetryMaxDelay {
+		delay = webhookRetryMaxDelay
+	}
+	return delay
+}
@@ -0,0 +1,237 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+)
+
+// mockWebhookSender URL'ye göre sabit durum kodu döner ve gönderilen istekleri kaydeder
+type mockWebhookSender struct {
+	mu       sync.Mutex
+	statuses map[string]int
+	errs     map[string]error
+	headers  map[string]http.Header
+	bodies   map[string][]byte
+}
+
+func newMockWebhookSender() *mockWebhookSender {
+	return &mockWebhookSender{
+		statuses: make(map[string]int),
+		errs:     make(map[string]error),
+		headers:  make(map[string]http.Header),
+		bodies:   make(map[string][]byte),
+	}
+}
+
+func (m *mockWebhookSender) Send(ctx context.Context, url string, header http.Header, body []byte) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.headers[url] = header
+	m.bodies[url] = body
+	if err := m.errs[url]; err != nil {
+		return 0, err
+	}
+	return m.statuses[url], nil
+}
+
+func approvedChange(changeType, title string, tags ...string) entity.ContentChange {
+	change := streamChange(changeType, title, tags...)
+	change.Content.Status = entity.ContentStatusApproved
+	return change
+}
+
+func TestWebhookDispatcher_EnqueuePending(t *testing.T) {
+	t.Run("matches changes against active subscriptions", func(t *testing.T) {
+		repo := &mockWebhookRepository{subs: []*entity.WebhookSubscription{
+			{ID: 1, Active: true, Tags: []string{"golang"}},
+			{ID: 2, Active: false},
+			{ID: 3, Active: true, Changes: []string{entity.ContentChangeUpdated}},
+		}}
+		dispatcher := NewWebhookDispatcher(repo, newMockWebhookSender(), 3, time.Second)
+
+		dispatcher.Publish([]entity.ContentChange{
+			approvedChange(entity.ContentChangeCreated, "Go", "golang"),
+			approvedChange(entity.ContentChangeCreated, "Rust", "rust"),
+		})
+		select {
+		case <-dispatcher.Ready():
+		default:
+			t.Fatal("Expected Publish to signal Ready")
+		}
+
+		enqueued, dropped, err := dispatcher.EnqueuePending(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, enqueued)
+		assert.Zero(t, dropped)
+
+		require.Len(t, repo.deliveries, 1)
+		assert.Equal(t, int64(1), repo.deliveries[0].SubscriptionID)
+		assert.Equal(t, 1, repo.deliveries[0].ItemCount)
+
+		var payload entity.WebhookPayload
+		require.NoError(t, json.Unmarshal(repo.deliveries[0].Payload, &payload))
+		assert.Equal(t, entity.WebhookEventContentMatched, payload.Event)
+		require.Len(t, payload.Changes, 1)
+		assert.Equal(t, "Go", payload.Changes[0].Content.Title)
+
+		// Kuyruk boşaldı
+		enqueued, _, err = dispatcher.EnqueuePending(context.Background())
+		require.NoError(t, err)
+		assert.Zero(t, enqueued)
+	})
+
+	t.Run("splits large batches", func(t *testing.T) {
+		repo := &mockWebhookRepository{subs: []*entity.WebhookSubscription{{ID: 1, Active: true}}}
+		dispatcher := NewWebhookDispatcher(repo, newMockWebhookSender(), 3, time.Second)
+
+		changes := make([]entity.ContentChange, maxWebhookChangesPerBody+50)
+		for i := range changes {
+			changes[i] = approvedChange(entity.ContentChangeCreated, "Go")
+		}
+		dispatcher.Publish(changes)
+
+		enqueued, _, err := dispatcher.EnqueuePending(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 2, enqueued)
+		assert.Equal(t, maxWebhookChangesPerBody, repo.deliveries[0].ItemCount)
+		assert.Equal(t, 50, repo.deliveries[1].ItemCount)
+	})
+
+	t.Run("keeps changes when enqueue fails", func(t *testing.T) {
+		repo := &mockWebhookRepository{
+			subs:       []*entity.WebhookSubscription{{ID: 1, Active: true}},
+			enqueueErr: errors.New("db down"),
+		}
+		dispatcher := NewWebhookDispatcher(repo, newMockWebhookSender(), 3, time.Second)
+		dispatcher.Publish([]entity.ContentChange{approvedChange(entity.ContentChangeCreated, "Go")})
+
+		_, _, err := dispatcher.EnqueuePending(context.Background())
+		require.Error(t, err)
+
+		repo.enqueueErr = nil
+		enqueued, _, err := dispatcher.EnqueuePending(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, enqueued)
+	})
+
+	t.Run("keeps changes when the payload cannot be encoded", func(t *testing.T) {
+		repo := &mockWebhookRepository{subs: []*entity.WebhookSubscription{{ID: 1, Active: true}}}
+		dispatcher := NewWebhookDispatcher(repo, newMockWebhookSender(), 3, time.Second)
+		change := approvedChange(entity.ContentChangeCreated, "Go")
+		change.Content.RelevanceScore = math.NaN()
+		dispatcher.Publish([]entity.ContentChange{change})
+
+		_, _, err := dispatcher.EnqueuePending(context.Background())
+		require.Error(t, err)
+
+		pending, _ := dispatcher.queue.take()
+		assert.Len(t, pending, 1)
+	})
+
+	t.Run("drops changes beyond the pending limit", func(t *testing.T) {
+		dispatcher := NewWebhookDispatcher(&mockWebhookRepository{}, newMockWebhookSender(), 3, time.Second)
+		dispatcher.Publish(make([]entity.ContentChange, maxPendingWebhookChanges+5))
+
+		_, dropped, err := dispatcher.EnqueuePending(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 5, dropped)
+	})
+}
+
+func TestWebhookDispatcher_DeliverDue(t *testing.T) {
+	now := time.Now()
+	repo := &mockWebhookRepository{
+		subs: []*entity.WebhookSubscription{
+			{ID: 1, URL: "https://ok.example.com", Secret: "whsec_ok", Active: true},
+			{ID: 2, URL: "https://flaky.example.com", Secret: "whsec_flaky", Active: true},
+			{ID: 3, URL: "https://gone.example.com", Secret: "whsec_gone", Active: true},
+			{ID: 4, URL: "https://down.example.com", Secret: "whsec_down", Active: true},
+			{ID: 5, URL: "https://paused.example.com", Secret: "whsec_paused", Active: false},
+		},
+		deliveries: []*entity.WebhookDelivery{
+			{ID: 1, SubscriptionID: 1, Payload: []byte(`{"n":1}`), Status: entity.WebhookDeliveryPending},
+			{ID: 2, SubscriptionID: 2, Payload: []byte(`{"n":2}`), Status: entity.WebhookDeliveryPending},
+			{ID: 3, SubscriptionID: 3, Payload: []byte(`{"n":3}`), Status: entity.WebhookDeliveryPending},
+			{ID: 4, SubscriptionID: 4, Payload: []byte(`{"n":4}`), Status: entity.WebhookDeliveryPending, Attempts: 2},
+			{ID: 5, SubscriptionID: 5, Payload: []byte(`{"n":5}`), Status: entity.WebhookDeliveryPending},
+		},
+	}
+	sender := newMockWebhookSender()
+	sender.statuses["https://ok.example.com"] = http.StatusOK
+	sender.statuses["https://flaky.example.com"] = http.StatusServiceUnavailable
+	sender.statuses["https://gone.example.com"] = http.StatusGone
+	sender.errs["https://down.example.com"] = errors.New("connection refused")
+
+	dispatcher := NewWebhookDispatcher(repo, sender, 3, time.Second)
+	dispatcher.now = func() time.Time { return now }
+
+	stats, err := dispatcher.DeliverDue(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, WebhookDeliveryStats{Delivered: 1, Retried: 1, Failed: 3}, stats)
+
+	header := sender.headers["https://ok.example.com"]
+	assert.Equal(t, SignWebhookPayload("whsec_ok", now.Unix(), []byte(`{"n":1}`)), header.Get(WebhookSignatureHeader))
+	assert.Equal(t, "1", header.Get(WebhookDeliveryHeader))
+	assert.Equal(t, "application/json", header.Get("Content-Type"))
+
+	delivered := repo.deliveries[0]
+	assert.Equal(t, entity.WebhookDeliveryDelivered, delivered.Status)
+	assert.Equal(t, 1, delivered.Attempts)
+	require.NotNil(t, delivered.DeliveredAt)
+
+	retried := repo.deliveries[1]
+	assert.Equal(t, entity.WebhookDeliveryPending, retried.Status)
+	assert.Equal(t, now.Add(webhookRetryBaseDelay), retried.NextAttemptAt)
+	assert.Equal(t, http.StatusServiceUnavailable, retried.LastStatusCode)
+
+	// 4xx yanıtlar tekrar denenmez
+	assert.Equal(t, entity.WebhookDeliveryFailed, repo.deliveries[2].Status)
+
+	// Deneme hakkı biten gönderim başarısız olur
+	exhausted := repo.deliveries[3]
+	assert.Equal(t, entity.WebhookDeliveryFailed, exhausted.Status)
+	assert.Equal(t, 3, exhausted.Attempts)
+	assert.Equal(t, "connection refused", exhausted.LastError)
+
+	// Pasif aboneliğin gönderimi alıcıya gönderilmeden başarısız olur
+	inactive := repo.deliveries[4]
+	assert.Equal(t, entity.WebhookDeliveryFailed, inactive.Status)
+	assert.Equal(t, 0, inactive.Attempts)
+	assert.Equal(t, "webhook subscription is inactive", inactive.LastError)
+	assert.NotContains(t, sender.bodies, "https://paused.example.com")
+}
+
+func TestSignWebhookPayload(t *testing.T) {
+	signature := SignWebhookPayload("whsec_test", 1700000000, []byte(`{"event":"content.matched"}`))
+	assert.Equal(t, "t=1700000000,v1=", signature[:16])
+	assert.Len(t, signature, 16+64)
+	assert.NotEqual(t, signature, SignWebhookPayload("whsec_other", 1700000000, []byte(`{"event":"content.matched"}`)))
+	assert.NotEqual(t, signature, SignWebhookPayload("whsec_test", 1700000001, []byte(`{"event":"content.matched"}`)))
+}
+
+func TestWebhookRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{4, 8 * time.Minute},
+		{7, time.Hour},
+		{20, time.Hour},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, webhookRetryDelay(tt.attempts), "attempts=%d", tt.attempts)
+	}
+}
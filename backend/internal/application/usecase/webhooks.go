@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// Webhook abonelik sınırları
+const (
+	webhookSecretPrefix         = "whsec_"
+	webhookSecretRandomBytes    = 24
+	maxWebhookNameLen           = 100
+	maxWebhookURLLen            = 2048
+	defaultWebhookDeliveryLimit = 50
+	maxWebhookDeliveryLimit     = 200
+)
+
+// ManageWebhooksUseCase webhook aboneliklerinin yönetimi (admin) use case'i
+type ManageWebhooksUseCase struct {
+	webhookRepo port.WebhookRepository
+}
+
+// WebhookInput abonelik oluşturma/güncelleme isteği
+// Filtre alanları /api/v1/stream parametreleriyle aynı anlamdadır; boş alanlar filtre uygulamaz
+type WebhookInput struct {
+	Name        string             `json:"name"`
+	URL         string             `json:"url"`
+	Query       string             `json:"query"`
+	Tags        []string           `json:"tags"`
+	ContentType entity.ContentType `json:"type"`
+	ProviderID  int64              `json:"provider_id"`
+	Changes     []string           `json:"changes"`
+	Active      *bool              `json:"active"` // Belirtilmezse true
+}
+
+// CreatedWebhook yeni oluşturulan abonelik; Secret sadece bu yanıtta döner, sonradan okunamaz
+type CreatedWebhook struct {
+	*entity.WebhookSubscription
+	Secret string `json:"secret"`
+}
+
+// NewManageWebhooksUseCase yeni bir webhook yönetim use case oluşturur
+func NewManageWebhooksUseCase(webhookRepo port.WebhookRepository) *ManageWebhooksUseCase {
+	return &ManageWebhooksUseCase{
+		webhookRepo: webhookRepo,
+	}
+}
+
+// Create aboneliği doğrular, imza secret'ı üretir ve kaydeder
+func (uc *ManageWebhooksUseCase) Create(ctx context.Context, input WebhookInput) (*CreatedWebhook, error) {
+	sub, err := buildWebhookSubscription(input)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, fmt.Errorf("webhook secret üretilemedi: %w", err)
+	}
+	sub.Secret = secret
+
+	if err := uc.webhookRepo.CreateWebhook(ctx, sub); err != nil {
+		return nil, fmt.Errorf("webhook kaydedilemedi: %w", err)
+	}
+
+	return &CreatedWebhook{WebhookSubscription: sub, Secret: secret}, nil
+}
+
+// Update aboneliğin URL, filtre ve aktiflik alanlarını değiştirir; secret korunur
+// Abonelik bulunamazsa port.ErrWebhookNotFound döner
+func (uc *ManageWebhooksUseCase) Update(ctx context.Context, id int64, input WebhookInput) (*entity.WebhookSubscription, error) {
+	sub, err := buildWebhookSubscription(input)
+	if err != nil {
+		return nil, err
+	}
+	sub.ID = id
+
+	if err := uc.webhookRepo.UpdateWebhook(ctx, sub); err != nil {
+		if err == port.ErrWebhookNotFound {
+			return nil, err
+		}
+		return nil, fmt.Errorf("webhook güncellenemedi: %w", err)
+	}
+
+	return sub, nil
+}
+
+// List tüm abonelikleri döner (secret'lar olmadan)
+func (uc *ManageWebhooksUseCase) List(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	subs, err := uc.webhookRepo.ListWebhooks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("webhook'lar okunamadı: %w", err)
+	}
+	return subs, nil
+}
+
+// Delete aboneliği ve bekleyen gönderimlerini siler
+// Abonelik bulunamazsa port.ErrWebhookNotFound döner
+func (uc *ManageWebhooksUseCase) Delete(ctx context.Context, id int64) error {
+	if err := uc.webhookRepo.DeleteWebhook(ctx, id); err != nil {
+		if err == port.ErrWebhookNotFound {
+			return err
+		}
+		return fmt.Errorf("webhook silinemedi: %w", err)
+	}
+	return nil
+}
+
+// Deliveries aboneliğin son gönderimlerini yeniden eskiye döner
+// limit 0 ise varsayılan (50) kullanılır
+func (uc *ManageWebhooksUseCase) Deliveries(ctx context.Context, id int64, limit int) ([]*entity.WebhookDelivery, error) {
+	if limit == 0 {
+		limit = defaultWebhookDeliveryLimit
+	}
+	if limit < 1 || limit > maxWebhookDeliveryLimit {
+		return nil, apperrors.NewValidationError("limit", fmt.Sprintf("limit must be between 1 and %d", maxWebhookDeliveryLimit), limit)
+	}
+
+	if _, err := uc.webhookRepo.GetWebhook(ctx, id); err != nil {
+		if err == port.ErrWebhookNotFound {
+			return nil, err
+		}
+		return nil, fmt.Errorf("webhook okunamadı: %w", err)
+	}
+
+	deliveries, err := uc.webhookRepo.ListWebhookDeliveries(ctx, id, limit)
+	if err != nil {
+		return nil, fmt.Errorf("webhook gönderimleri okunamadı: %w", err)
+	}
+	return deliveries, nil
+}
+
+// buildWebhookSubscription girdiyi doğrular ve normalize edilmiş filtreyle abonelik oluşturur
+func buildWebhookSubscription(input WebhookInput) (*entity.WebhookSubscription, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" || len(name) > maxWebhookNameLen {
+		return nil, apperrors.NewValidationError("name", fmt.Sprintf("name must be between 1 and %d characters", maxWebhookNameLen), input.Name)
+	}
+	if err := validateWebhookURL("url", input.URL); err != nil {
+		return nil, err
+	}
+
+	filter, err := normalizeStreamFilter(ContentStreamFilter{
+		Query:       input.Query,
+		Tags:        input.Tags,
+		ContentType: input.ContentType,
+		ProviderID:  input.ProviderID,
+		Changes:     input.Changes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	active := true
+	if input.Active != nil {
+		active = *input.Active
+	}
+
+	return &entity.WebhookSubscription{
+		Name:        name,
+		URL:         input.URL,
+		Query:       filter.Query,
+		Tags:        filter.Tags,
+		ContentType: filter.ContentType,
+		ProviderID:  filter.ProviderID,
+		Changes:     filter.Changes,
+		Active:      active,
+	}, nil
+}
+
+// validateWebhookURL URL'nin mutlak bir http(s) adresi olduğunu doğrular; hatalar field alanıyla döner
+func validateWebhookURL(field, raw string) error {
+	if raw == "" || len(raw) > maxWebhookURLLen {
+		return apperrors.NewValidationError(field, fmt.Sprintf("%s must be between 1 and %d characters", field, maxWebhookURLLen), raw)
+	}
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return apperrors.NewValidationError(field, field+" must be an absolute http or https URL", raw)
+	}
+	if u.User != nil {
+		return apperrors.NewValidationError(field, field+" must not contain credentials", nil)
+	}
+	return nil
+}
+
+// generateWebhookSecret "whsec_" ön ekli rastgele bir imza secret'ı üretir
+func generateWebhookSecret() (string, error) {
+	buf := make([]byte, webhookSecretRandomBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return webhookSecretPrefix + hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// Sonuçsuz sorgu raporu sınırları
+const (
+	defaultZeroResultDays  = 7
+	maxZeroResultDays      = 90
+	defaultZeroResultLimit = 50
+	maxZeroResultLimit     = 500
+)
+
+// ZeroResultQueriesUseCase hiç sonuç döndürmeyen arama sorgularını raporlar
+// Rapor eş anlamlı kelime veya yeni provider adaylarını bulmak için kullanılır; kayıt arama use case'inde yapılır
+type ZeroResultQueriesUseCase struct {
+	zeroResultRepo port.ZeroResultQueryRepository
+	now            func() time.Time
+}
+
+// ZeroResultQueriesResult sonuçsuz sorgu raporu
+type ZeroResultQueriesResult struct {
+	Since   time.Time                 `json:"since"`
+	Queries []*entity.ZeroResultQuery `json:"queries"`
+}
+
+// NewZeroResultQueriesUseCase yeni bir sonuçsuz sorgu raporu use case oluşturur
+func NewZeroResultQueriesUseCase(zeroResultRepo port.ZeroResultQueryRepository) *ZeroResultQueriesUseCase {
+	return &ZeroResultQueriesUseCase{
+		zeroResultRepo: zeroResultRepo,
+		now:            time.Now,
+	}
+}
+
+// Report son days günde en çok sonuçsuz aranan sorguları döner
+// days 0 ise 7 gün, limit 0 ise 50 sorgu kullanılır
+func (uc *ZeroResultQueriesUseCase) Report(ctx context.Context, days, limit int) (*ZeroResultQueriesResult, error) {
+	if days == 0 {
+		days = defaultZeroResultDays
+	}
+	if days < 1 || days > maxZeroResultDays {
+		return nil, apperrors.NewValidationError("days", fmt.Sprintf("days must be between 1 and %d", maxZeroResultDays), days)
+	}
+	if limit == 0 {
+		limit = defaultZeroResultLimit
+	}
+	if limit < 1 || limit > maxZeroResultLimit {
+		return nil, apperrors.NewValidationError("limit", fmt.Sprintf("limit must be between 1 and %d", maxZeroResultLimit), limit)
+	}
+
+	result := &ZeroResultQueriesResult{Since: uc.now().AddDate(0, 0, -days)}
+	queries, err := uc.zeroResultRepo.ListZeroResultQueries(ctx, result.Since, limit)
+	if err != nil {
+		return nil, fmt.Errorf("sonuçsuz sorgu raporu okunamadı: %w", err)
+	}
+	result.Queries = queries
+
+	return result, nil
+}
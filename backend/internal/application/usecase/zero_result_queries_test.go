@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+)
+
+// mockZeroResultQueryRepository kaydedilen sorguları ve son rapor parametrelerini tutar
+type mockZeroResultQueryRepository struct {
+	recorded []string
+
+	listSince time.Time
+	listLimit int
+}
+
+func (m *mockZeroResultQueryRepository) RecordZeroResultQuery(ctx context.Context, query string) error {
+	m.recorded = append(m.recorded, query)
+	return nil
+}
+
+func (m *mockZeroResultQueryRepository) ListZeroResultQueries(ctx context.Context, since time.Time, limit int) ([]*entity.ZeroResultQuery, error) {
+	m.listSince, m.listLimit = since, limit
+	return []*entity.ZeroResultQuery{{Query: "kubernets", Count: 3, LastSeenAt: since}}, nil
+}
+
+func TestZeroResultQueriesUseCase_Report(t *testing.T) {
+	repo := &mockZeroResultQueryRepository{}
+	useCase := NewZeroResultQueriesUseCase(repo)
+	now := time.Date(2024, 1, 20, 12, 0, 0, 0, time.UTC)
+	useCase.now = func() time.Time { return now }
+
+	t.Run("defaults", func(t *testing.T) {
+		result, err := useCase.Report(context.Background(), 0, 0)
+		require.NoError(t, err)
+		assert.Equal(t, now.AddDate(0, 0, -defaultZeroResultDays), result.Since)
+		assert.Equal(t, result.Since, repo.listSince)
+		assert.Equal(t, defaultZeroResultLimit, repo.listLimit)
+		require.Len(t, result.Queries, 1)
+		assert.Equal(t, "kubernets", result.Queries[0].Query)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		for field, args := range map[string][2]int{
+			"days":  {maxZeroResultDays + 1, 0},
+			"limit": {30, -1},
+		} {
+			_, err := useCase.Report(context.Background(), args[0], args[1])
+			var validationErr *apperrors.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, field, validationErr.Field)
+		}
+	})
+}
@@ -1,43 +1,285 @@
 package entity
 
-import "time"
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
 
-// ContentType içerik türünü temsil eder (video veya article)
+// ContentType içerik türünü temsil eder
+// Yerleşik türlerin dışındaki türler RegisterContentType ile eklenir
 type ContentType string
 
 const (
 	ContentTypeVideo   ContentType = "video"
 	ContentTypeArticle ContentType = "article"
+	ContentTypePodcast ContentType = "podcast"
+	ContentTypeCourse  ContentType = "course"
+	ContentTypeImage   ContentType = "image"
+)
+
+// contentTypes kabul edilen içerik türleri, kayıt sırasıyla
+var (
+	contentTypesMu sync.RWMutex
+	contentTypes   = []ContentType{ContentTypeVideo, ContentTypeArticle, ContentTypePodcast, ContentTypeCourse, ContentTypeImage}
+)
+
+// RegisterContentType içerik türünü kabul edilen türlere ekler, tür zaten kayıtlıysa bir şey yapmaz
+// Skor formülüyle birlikte kayıt için service.RegisterContentType kullanılmalıdır
+func RegisterContentType(contentType ContentType) {
+	contentTypesMu.Lock()
+	defer contentTypesMu.Unlock()
+	for _, registered := range contentTypes {
+		if registered == contentType {
+			return
+		}
+	}
+	contentTypes = append(contentTypes, contentType)
+}
+
+// ContentTypes kabul edilen içerik türlerini kayıt sırasıyla döner
+func ContentTypes() []ContentType {
+	contentTypesMu.RLock()
+	defer contentTypesMu.RUnlock()
+	return append([]ContentType(nil), contentTypes...)
+}
+
+// ContentTypeNames kabul edilen içerik türlerinin adlarını döner (hata mesajları için)
+func ContentTypeNames() []string {
+	types := ContentTypes()
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = string(t)
+	}
+	return names
+}
+
+// IsValidContentType içerik türünün kayıtlı olup olmadığını döner
+func IsValidContentType(contentType ContentType) bool {
+	contentTypesMu.RLock()
+	defer contentTypesMu.RUnlock()
+	for _, registered := range contentTypes {
+		if registered == contentType {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseContentType provider'dan gelen tür adını küçük harfe çevirip kayıtlı türlerle eşleştirir
+func ParseContentType(value string) (ContentType, bool) {
+	contentType := ContentType(strings.ToLower(strings.TrimSpace(value)))
+	return contentType, IsValidContentType(contentType)
+}
+
+// Desteklenen içerik dilleri; değerler PostgreSQL text search configuration adlarıdır
+const (
+	LanguageEnglish = "english"
+	LanguageTurkish = "turkish"
+	LanguageGerman  = "german"
+	LanguageFrench  = "french"
+	LanguageSpanish = "spanish"
 )
 
+// SupportedLanguages arama indekslemesinde kullanılabilecek diller
+var SupportedLanguages = []string{LanguageEnglish, LanguageTurkish, LanguageGerman, LanguageFrench, LanguageSpanish}
+
+// IsSupportedLanguage dilin SupportedLanguages içinde olup olmadığını döner
+func IsSupportedLanguage(language string) bool {
+	for _, supported := range SupportedLanguages {
+		if language == supported {
+			return true
+		}
+	}
+	return false
+}
+
+// ContentStatus içeriğin moderasyon durumu
+type ContentStatus string
+
+// İçerik moderasyon durumları
+const (
+	ContentStatusPending  ContentStatus = "pending"  // İncelemeyi bekliyor, aramada görünmez
+	ContentStatusApproved ContentStatus = "approved" // Aramada görünür
+	ContentStatusRejected ContentStatus = "rejected" // Reddedildi, aramada görünmez
+)
+
+// IsValidContentStatus moderasyon durumunun geçerli olup olmadığını döner
+func IsValidContentStatus(status ContentStatus) bool {
+	switch status {
+	case ContentStatusPending, ContentStatusApproved, ContentStatusRejected:
+		return true
+	}
+	return false
+}
+
+// IsApproved içeriğin aramada ve içerik detayında görünür olup olmadığını döner
+// Durumu okunmamış (boş) içerikler onaylı kabul edilir
+func (c *Content) IsApproved() bool {
+	return c.Status == "" || c.Status == ContentStatusApproved
+}
+
 // Content ana içerik entity'si
 type Content struct {
-	ID                int64         `json:"id"`
-	ProviderID        int64         `json:"provider_id"`
-	ProviderContentID string        `json:"provider_content_id"`
-	Title             string        `json:"title"`
-	Description       string        `json:"description"`
-	ContentType       ContentType   `json:"content_type"`
-	PublishedAt       time.Time     `json:"published_at"`
-	CreatedAt         time.Time     `json:"created_at"`
-	UpdatedAt         time.Time     `json:"updated_at"`
-	Stats             *ContentStats `json:"stats,omitempty"`
-	Score             *ContentScore `json:"score,omitempty"`
-	Tags              []Tag         `json:"tags,omitempty"`
-	RelevanceScore    float64       `json:"relevance_score,omitempty"`
-	RawData           string        `json:"raw_data,omitempty"` // Provider'dan gelen ham veri
-	Deleted           bool          `json:"deleted"`
+	ID                int64            `json:"id"`
+	ProviderID        int64            `json:"provider_id"`
+	ProviderContentID string           `json:"provider_content_id"`
+	Title             string           `json:"title"`
+	Description       string           `json:"description"`
+	ContentType       ContentType      `json:"content_type"`
+	Language          string           `json:"language"`                // Arama vektörünün dili (LanguageEnglish, LanguageTurkish...)
+	URL               string           `json:"url,omitempty"`           // İçeriğin kaynak sayfası (mutlak http/https URL)
+	ThumbnailURL      string           `json:"thumbnail_url,omitempty"` // Küçük resim / og:image
+	PublishedAt       time.Time        `json:"published_at"`
+	CreatedAt         time.Time        `json:"created_at"`
+	UpdatedAt         time.Time        `json:"updated_at"`
+	Provider          *ContentProvider `json:"provider,omitempty"`
+	Author            *ContentAuthor   `json:"author,omitempty"`   // Provider yazar/kanal bilgisi göndermediyse nil
+	Category          *ContentCategory `json:"category,omitempty"` // Provider kategori göndermediyse nil
+	Stats             *ContentStats    `json:"stats,omitempty"`
+	Score             *ContentScore    `json:"score,omitempty"`
+	Tags              []Tag            `json:"tags,omitempty"`
+	RelevanceScore    float64          `json:"relevance_score,omitempty"`
+	RawData           string           `json:"raw_data,omitempty"` // Provider'dan gelen ham veri
+	Deleted           bool             `json:"deleted"`
+	Status            ContentStatus    `json:"status,omitempty"` // Boşsa kayıtta approved kabul edilir
+
+	// CanonicalContentID içerik başka bir provider'dan gelen bir içeriğin kopyasıysa o içeriğin ID'si
+	// Kanonik (veya kopyası olmayan) içeriklerde nil
+	CanonicalContentID *int64 `json:"canonical_content_id,omitempty"`
+
+	// Explanation skor açıklaması, sadece explain=true ile istendiğinde doldurulur
+	Explanation *ScoreExplanation `json:"explanation,omitempty"`
+
+	// Pinned içerik arama sorgusu için admin tarafından sabitlendiyse true (sıralamadan bağımsız en üstte)
+	Pinned bool `json:"pinned,omitempty"`
+}
+
+// DuplicateCandidate senkronize edilen bir içerik ile başka bir provider'daki olası kopyası
+// Aday bulunamayan içerikler CandidateID 0 ile temsil edilir
+type DuplicateCandidate struct {
+	ContentID   int64
+	Title       string
+	PublishedAt time.Time
+
+	CandidateID          int64
+	CandidateTitle       string
+	CandidatePublishedAt time.Time
+	CandidateCanonicalID int64 // Adayın bağlı olduğu kanonik içerik (kendisi kanonikse kendi ID'si)
+}
+
+// ContentProvider içerikle birlikte döndürülen provider özet bilgisini tutar
+type ContentProvider struct {
+	ID     int64  `json:"id"`
+	Name   string `json:"name"`
+	Format string `json:"format"`
+}
+
+// ContentAuthor içerikle birlikte döndürülen yazar/kanal özet bilgisini tutar
+type ContentAuthor struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+}
+
+// Author provider'ın içerikle birlikte gönderdiği yazar veya kanal
+// ExternalID provider'daki yazar ID'sidir; provider ID göndermiyorsa yazar adıdır
+type Author struct {
+	ID           int64     `json:"id"`
+	ProviderID   int64     `json:"provider_id"`
+	ProviderName string    `json:"provider_name,omitempty"` // Sadece listelemede doldurulur
+	ExternalID   string    `json:"external_id"`
+	Name         string    `json:"name"`
+	URL          string    `json:"url,omitempty"`
+	ContentCount int64     `json:"content_count"` // Silinmemiş içerik sayısı, sadece listelemede doldurulur
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// MaxCategoryDepth bir kategori yolunun en fazla kaç seviye olabileceği
+const MaxCategoryDepth = 5
+
+// ContentCategory içerikle birlikte döndürülen kategori özet bilgisini tutar
+type ContentCategory struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	Path string `json:"path"` // Kökten yaprağa slug'lar, "/" ile ayrılmış (ör. "programming/go/concurrency")
+}
+
+// Category hiyerarşik içerik kategorisi; kategoriler tüm provider'lar arasında ortaktır
+// Path üst kategorilerin slug'larını da içerdiği için bir kategori yolu ile tekil olarak belirlenir
+type Category struct {
+	ID           int64     `json:"id"`
+	ParentID     *int64    `json:"parent_id,omitempty"` // Kök kategorilerde nil
+	Name         string    `json:"name"`
+	Slug         string    `json:"slug"`
+	Path         string    `json:"path"`
+	ContentCount int64     `json:"content_count"` // Alt kategoriler dahil silinmemiş içerik sayısı, sadece listelemede doldurulur
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// ParseCategoryPath "Programming > Go > Concurrency" veya "programming/go/concurrency" biçimindeki
+// kategori yolunu kökten yaprağa seviye adlarına ayırır; boş seviyeler atlanır
+func ParseCategoryPath(value string) []string {
+	separator := "/"
+	if strings.Contains(value, ">") {
+		separator = ">"
+	}
+
+	var names []string
+	for _, part := range strings.Split(value, separator) {
+		if name := strings.Join(strings.Fields(part), " "); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// CategorySlug kategori adının yoldaki karşılığını döner ("Machine Learning" -> "machine-learning")
+// Ad içindeki "/" yol ayracıyla karışmasın diye boşluk gibi ele alınır ("CI/CD" -> "ci-cd")
+func CategorySlug(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(strings.ReplaceAll(name, "/", " ")), "-"))
+}
+
+// CategoryPath seviye adlarından kategori yolunu oluşturur (["Programming", "Go"] -> "programming/go")
+func CategoryPath(names []string) string {
+	slugs := make([]string, len(names))
+	for i, name := range names {
+		slugs[i] = CategorySlug(name)
+	}
+	return strings.Join(slugs, "/")
+}
+
+// CategoryAncestorPaths kategori yolunun kendisi dahil tüm üst yollarını kökten başlayarak döner
+// ("programming/go" -> ["programming", "programming/go"])
+func CategoryAncestorPaths(path string) []string {
+	if path == "" {
+		return nil
+	}
+	var paths []string
+	for i := 0; i < len(path); i++ {
+		if path[i] == '/' {
+			paths = append(paths, path[:i])
+		}
+	}
+	return append(paths, path)
 }
 
 // ContentStats içerik istatistiklerini tutar
 type ContentStats struct {
-	ID          int64     `json:"id"`
-	ContentID   int64     `json:"content_id"`
-	Views       int64     `json:"views"`
-	Likes       int32     `json:"likes"`
-	ReadingTime int32     `json:"reading_time"` // dakika cinsinden
-	Reactions   int32     `json:"reactions"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID              int64     `json:"id"`
+	ContentID       int64     `json:"content_id"`
+	Views           int64     `json:"views"`
+	Likes           int32     `json:"likes"`
+	ReadingTime     int32     `json:"reading_time"` // dakika cinsinden
+	Reactions       int32     `json:"reactions"`
+	DurationSeconds int32     `json:"duration_seconds"` // video/podcast süresi saniye cinsinden, bilinmiyorsa 0
+	Comments        int32     `json:"comments"`
+	UpdatedAt       time.Time `json:"updated_at"`
 }
 
 // ContentScore içerik skorlama bilgilerini tutar
@@ -48,10 +290,136 @@ type ContentScore struct {
 	TypeWeight      float64   `json:"type_weight"`
 	RecencyScore    float64   `json:"recency_score"`
 	EngagementScore float64   `json:"engagement_score"`
+	CTRScore        float64   `json:"ctr_score"`
 	FinalScore      float64   `json:"final_score"`
 	CalculatedAt    time.Time `json:"calculated_at"`
+
+	// CTRLift gecelik CTR işinin hesapladığı sinyal (yumuşatılmış CTR / global CTR, 1 ortalama)
+	// 0 ise içeriğin yeterli gösterimi yoktur; skor yazılırken değişmez, sadece CTR işi günceller
+	CTRLift float64 `json:"ctr_lift"`
+}
+
+// ScoreExplanation içerik skorunun bileşenlerini ve formül girdilerini açıklar
+// Bileşenler güncel skorlama kurallarıyla hesaplanır; sıralamada kullanılan kayıtlı skor StoredFinalScore'dadır
+type ScoreExplanation struct {
+	Formula          string         `json:"formula"`
+	Base             ScoreComponent `json:"base"`
+	TypeWeight       ScoreComponent `json:"type_weight"`
+	Recency          ScoreComponent `json:"recency"`
+	Engagement       ScoreComponent `json:"engagement"`
+	CTR              ScoreComponent `json:"ctr"`
+	Boost            ScoreComponent `json:"boost"`
+	FinalScore       float64        `json:"final_score"`
+	StoredFinalScore *float64       `json:"stored_final_score,omitempty"` // Kayıtlı skor; kurallar veya içeriğin yaşı değiştiyse FinalScore'dan farklı olabilir
+	CalculatedAt     *time.Time     `json:"calculated_at,omitempty"`      // Kayıtlı skorun hesaplandığı zaman
+	RelevanceScore   float64        `json:"relevance_score"`              // Arama metni eşleşme skoru (metin araması yoksa 0)
+	Rank             int            `json:"rank,omitempty"`               // Sonuç listesindeki sıra (1'den başlar, sadece aramada)
+}
+
+// ScoreComponent skorun tek bir bileşeni
+type ScoreComponent struct {
+	Value   float64            `json:"value"`
+	Formula string             `json:"formula"`
+	Inputs  map[string]float64 `json:"inputs,omitempty"`
+}
+
+// ScoringRules içerik skorlama kurallarını tutar
+// Sıfır değerli alanlar için varsayılanlar kullanılır
+type ScoringRules struct {
+	VideoTypeWeight             float64           `json:"video_type_weight"`             // Video içerikler için katsayı (varsayılan: 1.5)
+	ArticleTypeWeight           float64           `json:"article_type_weight"`           // Makale içerikler için katsayı (varsayılan: 1.0)
+	RecencyTiers                []RecencyTier     `json:"recency_tiers"`                 // Güncellik kademeleri, yaşa göre artan sırada (varsayılan: 7/30/90 gün)
+	VideoEngagementMultiplier   float64           `json:"video_engagement_multiplier"`   // (likes/views) çarpanı (varsayılan: 10)
+	ArticleEngagementMultiplier float64           `json:"article_engagement_multiplier"` // (reactions/reading_time) çarpanı (varsayılan: 5)
+	RecencyDecay                RecencyDecay      `json:"recency_decay"`                 // Güncellik skor fonksiyonu (varsayılan: step)
+	RecencyHalfLifeDays         float64           `json:"recency_half_life_days"`        // exponential/gaussian için skorun yarıya indiği yaş (varsayılan: 14)
+	RecencyMaxScore             float64           `json:"recency_max_score"`             // exponential/gaussian için yeni yayınlanan içeriğin skoru (varsayılan: 5)
+	EngagementScaling           EngagementScaling `json:"engagement_scaling"`            // Etkileşim oranının ölçeklenmesi (varsayılan: linear)
+	EngagementCap               float64           `json:"engagement_cap"`                // Etkileşim skorunun üst sınırı, 0 ise sınırsız
+	VideoDurationWeight         float64           `json:"video_duration_weight"`         // Video base skoruna süre dakikası başına eklenen puan, 0 ise süre kullanılmaz
+	CommentWeight               float64           `json:"comment_weight"`                // Etkileşim oranında bir yorumun kaç beğeni/tepki sayılacağı, 0 ise yorumlar kullanılmaz
+	CTRWeight                   float64           `json:"ctr_weight"`                    // Tıklama oranı sinyalinin ağırlığı (CTR skoru -ctr_weight..+ctr_weight), 0 ise CTR kullanılmaz
+	UpdatedAt                   *time.Time        `json:"updated_at,omitempty"`          // Kurallar veritabanından yüklendiyse son güncelleme zamanı
+}
+
+// RecencyDecay güncellik skorunun içeriğin yaşıyla nasıl azaldığını belirler
+type RecencyDecay string
+
+const (
+	RecencyDecayStep        RecencyDecay = "step"        // RecencyTiers kademeleri
+	RecencyDecayExponential RecencyDecay = "exponential" // max × 0.5^(yaş / yarılanma)
+	RecencyDecayGaussian    RecencyDecay = "gaussian"    // max × 0.5^((yaş / yarılanma)²)
+)
+
+// RecencyTier yayın tarihi MaxAgeDays gün içinde olan içeriklere verilen güncellik skoru
+type RecencyTier struct {
+	MaxAgeDays int     `json:"max_age_days"`
+	Score      float64 `json:"score"`
+}
+
+// BoostRule belirli bir tag'e sahip içeriklerin final skorunu yüzde olarak artırır veya azaltır
+// Birden fazla kuralla eşleşen içeriklerde çarpanlar birbiriyle çarpılır
+type BoostRule struct {
+	ID        int64     `json:"id"`
+	Tag       string    `json:"tag"`
+	Percent   float64   `json:"percent"` // +20: skor %20 artar, -50: skor yarıya iner
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// APIKey public API entegratörlerine verilen anahtar
+// Anahtarın kendisi saklanmaz, sadece SHA-256 hash'i tutulur; rate limit anahtar bazında uygulanır
+type APIKey struct {
+	ID                 int64      `json:"id"`
+	Name               string     `json:"name"`
+	Prefix             string     `json:"prefix"` // Anahtarın ilk karakterleri, listede tanımak için
+	KeyHash            string     `json:"-"`
+	RateLimitPerMinute int        `json:"rate_limit_per_minute"` // 0: sunucunun varsayılan limiti
+	RevokedAt          *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt          time.Time  `json:"created_at"`
+}
+
+// IsActive anahtar iptal edilmemiş mi
+func (k *APIKey) IsActive() bool {
+	return k.RevokedAt == nil
+}
+
+// Promotion bir arama sorgusu için en üste sabitlenen içerikler
+// Query normalize edilmiş haliyle (küçük harf, tek boşluk) birebir eşleşir
+type Promotion struct {
+	ID         int64     `json:"id"`
+	Query      string    `json:"query"`
+	ContentIDs []int64   `json:"content_ids"` // Sonuçlarda bu sırayla gösterilir
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
 }
 
+// Feature flag'ler; yeni davranışlar bu bayraklarla kademeli açılır, sorun çıkarsa yeniden başlatmadan kapatılır
+const (
+	FlagFuzzyFallback   = "fuzzy_fallback"   // FTS sonuç bulamazsa trigram benzerliğiyle tekrar arama
+	FlagHybridRanking   = "hybrid_ranking"   // sort=hybrid; kapalıyken relevance sıralaması kullanılır
+	FlagNegativeCaching = "negative_caching" // Sonuçsuz aramaların da cache'e yazılması
+)
+
+// FeatureFlagNames tanımlı feature flag'ler
+var FeatureFlagNames = []string{FlagFuzzyFallback, FlagHybridRanking, FlagNegativeCaching}
+
+// FeatureFlag bir bayrağın config'teki varsayılanı ve çalışma anında verilmiş override'ı
+type FeatureFlag struct {
+	Name       string `json:"name"`
+	Enabled    bool   `json:"enabled"` // Geçerli değer: override varsa override, yoksa varsayılan
+	Default    bool   `json:"default"`
+	Overridden bool   `json:"overridden"`
+}
+
+// EngagementScaling etkileşim oranının (likes/views, reactions/reading_time) skora nasıl çevrildiğini belirler
+type EngagementScaling string
+
+const (
+	EngagementScalingLinear EngagementScaling = "linear" // oran × çarpan
+	EngagementScalingLog    EngagementScaling = "log"    // ln(1 + oran) × çarpan; küçük oranlarda linear'e yakın, büyük oranları bastırır
+)
+
 // Tag içerik etiketlerini temsil eder
 type Tag struct {
 	ID        int64     `json:"id"`
@@ -61,19 +429,247 @@ type Tag struct {
 
 // Provider veri sağlayıcı bilgilerini tutar
 type Provider struct {
-	ID        int64     `json:"id"`
-	Name      string    `json:"name"`
-	URL       string    `json:"url"`
-	Format    string    `json:"format"` // "json" veya "xml"
-	IsActive  bool      `json:"is_active"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID          int64                `json:"id"`
+	Name        string               `json:"name"`
+	URL         string               `json:"url"`
+	Format      string               `json:"format"`             // "json", "xml", "rss" veya "rest"
+	Mapping     *ProviderMapping     `json:"mapping,omitempty"`  // Sadece "rest" formatında kullanılır
+	Auth        *ProviderAuth        `json:"auth,omitempty"`     // nil ise istekler kimlik doğrulamasız yapılır
+	Retry       *ProviderRetryPolicy `json:"retry,omitempty"`    // nil ise varsayılan retry politikası kullanılır
+	Language    string               `json:"language,omitempty"` // İçeriklerin dili; boşsa her içerik için tespit edilir
+	IsActive    bool                 `json:"is_active"`
+	AutoApprove bool                 `json:"auto_approve"` // false ise yeni içerikler admin onaylayana kadar aramada görünmez (pending)
+	CreatedAt   time.Time            `json:"created_at"`
+	UpdatedAt   time.Time            `json:"updated_at"`
+
+	// Son başarılı senkronizasyonun koşullu istek değerleri (ETag / Last-Modified)
+	ETag         string `json:"-"`
+	LastModified string `json:"-"`
+}
+
+// ProviderMapping generic REST provider'ı için JSON alan eşlemesi
+// Her alan bir gjson yoludur (ör. "data.items", "metrics.views", "media.0.url", `stats.#(type=="views").value`);
+// "$" ile başlayan yollar JSONPath olarak yazılabilir (ör. "$.data.items[0].id"), bkz. MappingPathToGJSON
+type ProviderMapping struct {
+	ItemsPath    string `json:"items_path,omitempty"` // İçerik dizisinin yolu, boşsa response'un kendisi dizidir
+	ID           string `json:"id"`
+	Title        string `json:"title"`
+	Description  string `json:"description,omitempty"`
+	Type         string `json:"type,omitempty"`         // Değeri kayıtlı bir içerik türü olmalı
+	DefaultType  string `json:"default_type,omitempty"` // Type yoksa veya boşsa kullanılır
+	PublishedAt  string `json:"published_at"`           // RFC3339, YYYY-MM-DD veya unix saniye
+	Views        string `json:"views,omitempty"`
+	Likes        string `json:"likes,omitempty"`
+	ReadingTime  string `json:"reading_time,omitempty"`
+	Reactions    string `json:"reactions,omitempty"`
+	Duration     string `json:"duration,omitempty"`  // Saniye, "MM:SS", "HH:MM:SS" veya ISO 8601 (PT12M34S)
+	Tags         string `json:"tags,omitempty"`      // String dizisi veya virgülle ayrılmış string
+	Category     string `json:"category,omitempty"`  // "a > b > c" / "a/b/c" string'i veya ["a", "b", "c"] dizisi
+	AuthorID     string `json:"author_id,omitempty"` // Boşsa yazar adı ID olarak kullanılır
+	AuthorName   string `json:"author_name,omitempty"`
+	AuthorURL    string `json:"author_url,omitempty"`
+	URL          string `json:"url,omitempty"` // Göreli bağlantılar provider URL'ine göre çözülür
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+}
+
+// MappingPathToGJSON mapping yolunu gjson sözdizimine çevirir
+// "$" ile başlamayan yollar zaten gjson yoludur ve olduğu gibi döner. JSONPath yollarında alan erişimi
+// (.name, ['name']), indeks ([0]), joker ([*], .*) ve karşılaştırma filtreleri ([?(@.type=='views')])
+// desteklenir; filtreler gjson'daki gibi ilk eşleşen elemanı seçer. Özyinelemeli iniş (..), dilim ([0:2])
+// ve birleşim ([0,1]) desteklenmez
+func MappingPathToGJSON(path string) (string, error) {
+	path = strings.TrimSpace(path)
+	if !strings.HasPrefix(path, "$") {
+		if path == "" {
+			return "", fmt.Errorf("path is empty")
+		}
+		return path, nil
+	}
+
+	var segments []string
+	rest := path[1:]
+	for rest != "" {
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			return "", fmt.Errorf("recursive descent (..) is not supported")
+		case rest[0] == '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			name := rest[1 : end+1]
+			if name == "" {
+				return "", fmt.Errorf("empty field name")
+			}
+			if name == "*" {
+				segments = append(segments, "#")
+			} else {
+				segments = append(segments, escapeGJSONKey(name))
+			}
+			rest = rest[end+1:]
+		case rest[0] == '[':
+			segment, n, err := jsonPathBracket(rest)
+			if err != nil {
+				return "", err
+			}
+			segments = append(segments, segment)
+			rest = rest[n:]
+		default:
+			return "", fmt.Errorf("unexpected %q, expected . or [", rest[0])
+		}
+	}
+
+	if len(segments) == 0 {
+		return "@this", nil
+	}
+	return strings.Join(segments, "."), nil
+}
+
+// jsonPathBracket "[...]" ile başlayan JSONPath parçasını gjson parçasına çevirir ve tüketilen uzunluğu döner
+func jsonPathBracket(rest string) (string, int, error) {
+	switch {
+	case strings.HasPrefix(rest, "[?("):
+		end := strings.Index(rest, ")]")
+		if end < 0 {
+			return "", 0, fmt.Errorf("unterminated filter")
+		}
+		filter, err := jsonPathFilter(rest[3:end])
+		if err != nil {
+			return "", 0, err
+		}
+		return filter, end + 2, nil
+	case strings.HasPrefix(rest, "['"), strings.HasPrefix(rest, `["`):
+		quote := rest[1]
+		end := strings.IndexByte(rest[2:], quote)
+		if end < 0 || !strings.HasPrefix(rest[2+end+1:], "]") {
+			return "", 0, fmt.Errorf("unterminated quoted field name")
+		}
+		name := rest[2 : 2+end]
+		if name == "" {
+			return "", 0, fmt.Errorf("empty field name")
+		}
+		return escapeGJSONKey(name), 2 + end + 2, nil
+	}
+
+	end := strings.IndexByte(rest, ']')
+	if end < 0 {
+		return "", 0, fmt.Errorf("unterminated [")
+	}
+	inner := strings.TrimSpace(rest[1:end])
+	if inner == "*" {
+		return "#", end + 1, nil
+	}
+	if index, err := strconv.Atoi(inner); err == nil && index >= 0 {
+		return inner, end + 1, nil
+	}
+	return "", 0, fmt.Errorf("unsupported subscript [%s]", inner)
+}
+
+// jsonPathFilter "@.field op value" filtresini gjson sorgusuna (#(field op value)) çevirir
+func jsonPathFilter(expr string) (string, error) {
+	expr = strings.TrimSpace(expr)
+	if !strings.HasPrefix(expr, "@.") {
+		return "", fmt.Errorf("filter must compare a field of the current element (@.field)")
+	}
+	expr = expr[2:]
+
+	opStart := strings.IndexAny(expr, "=!<>")
+	if opStart <= 0 {
+		return "", fmt.Errorf("filter must be a comparison (==, !=, <, <=, >, >=)")
+	}
+	opEnd := opStart
+	for opEnd < len(expr) && strings.IndexByte("=!<>", expr[opEnd]) >= 0 {
+		opEnd++
+	}
+	field := strings.TrimSpace(expr[:opStart])
+	op := expr[opStart:opEnd]
+	value := strings.TrimSpace(expr[opEnd:])
+
+	switch op {
+	case "==", "!=", "<", "<=", ">", ">=":
+	default:
+		return "", fmt.Errorf("unsupported filter operator %q", op)
+	}
+	if value == "" {
+		return "", fmt.Errorf("filter value is empty")
+	}
+	// gjson string değerleri çift tırnakla yazar
+	if len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\'' {
+		value = strconv.Quote(value[1 : len(value)-1])
+	}
+
+	return "#(" + field + op + value + ")", nil
+}
+
+// escapeGJSONKey alan adındaki gjson özel karakterlerini (., *, ?, #, | ...) kaçışlar
+func escapeGJSONKey(name string) string {
+	var b strings.Builder
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		safe := (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') ||
+			c <= ' ' || c > '~' || c == '_' || c == '-' || c == ':'
+		if !safe {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+// Provider kimlik doğrulama türleri
+const (
+	ProviderAuthAPIKey = "api_key"
+	ProviderAuthBasic  = "basic"
+	ProviderAuthBearer = "bearer"
+	ProviderAuthOAuth2 = "oauth2"
+)
+
+// ProviderSecretEnvPrefix provider secret'larını tutabilecek environment değişkenlerinin ön eki
+// Admin'in secret_env ile DB şifresi, JWT secret'ı gibi diğer değişkenleri provider'a göndermesini engeller
+const ProviderSecretEnvPrefix = "PROVIDER_SECRET_"
+
+// IsProviderSecretEnv name'in provider secret'ı olarak okunabilecek bir değişken adı olup olmadığını döner
+// Ad ProviderSecretEnvPrefix ile başlamalı ve devamı büyük harf, rakam veya alt çizgiden oluşmalıdır
+func IsProviderSecretEnv(name string) bool {
+	suffix, ok := strings.CutPrefix(name, ProviderSecretEnvPrefix)
+	if !ok || suffix == "" {
+		return false
+	}
+	for _, r := range suffix {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '_' {
+			return false
+		}
+	}
+	return true
+}
+
+// ProviderAuth provider API'sinin kimlik doğrulama ayarı
+// Secret'ın kendisi saklanmaz; SecretEnv secret'ı tutan environment değişkeninin adıdır
+// (api_key için key, basic için şifre, bearer için token, oauth2 için client secret)
+type ProviderAuth struct {
+	Type      string   `json:"type"` // "api_key", "basic", "bearer" veya "oauth2"
+	SecretEnv string   `json:"secret_env"`
+	Header    string   `json:"header,omitempty"`    // api_key: header adı, varsayılan X-API-Key
+	Username  string   `json:"username,omitempty"`  // basic
+	ClientID  string   `json:"client_id,omitempty"` // oauth2
+	TokenURL  string   `json:"token_url,omitempty"` // oauth2
+	Scopes    []string `json:"scopes,omitempty"`    // oauth2
+}
+
+// ProviderRetryPolicy provider isteklerinin retry/backoff ayarı
+// Sıfır değerli alanlar için varsayılanlar kullanılır
+type ProviderRetryPolicy struct {
+	MaxAttempts       int `json:"max_attempts,omitempty"`        // İlk istek dahil toplam deneme sayısı
+	InitialIntervalMs int `json:"initial_interval_ms,omitempty"` // İlk bekleme süresi, her denemede iki katına çıkar
+	MaxIntervalMs     int `json:"max_interval_ms,omitempty"`     // Tek bir bekleme için üst sınır
+	MaxElapsedMs      int `json:"max_elapsed_ms,omitempty"`      // Tüm denemeler için toplam süre sınırı
 }
 
 // ProviderSyncLog senkronizasyon loglarını tutar
 type ProviderSyncLog struct {
 	ID           int64      `json:"id"`
 	ProviderID   int64      `json:"provider_id"`
+	ProviderName string     `json:"provider_name,omitempty"` // Sadece listelemede doldurulur
 	StartedAt    time.Time  `json:"started_at"`
 	CompletedAt  *time.Time `json:"completed_at,omitempty"`
 	Status       string     `json:"status"` // "success", "failed", "running"
@@ -81,14 +677,352 @@ type ProviderSyncLog struct {
 	ErrorMessage string     `json:"error_message,omitempty"`
 }
 
+// ProviderSyncError senkronizasyonda reddedilip karantinaya alınan içerik kaydı
+// Provider'ın son senkronizasyonunda geçersiz bulunan içerikleri ham veriyle birlikte tutar
+type ProviderSyncError struct {
+	ID           int64     `json:"id"`
+	ProviderID   int64     `json:"provider_id"`
+	ProviderName string    `json:"provider_name,omitempty"` // Sadece listelemede doldurulur
+	ExternalID   string    `json:"external_id"`             // Okunamadıysa boş olabilir
+	Reason       string    `json:"reason"`
+	RawData      string    `json:"raw_data"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// ContentVersion içeriğin bir provider güncellemesiyle değiştirilen önceki hali
+type ContentVersion struct {
+	ID          int64                `json:"id"`
+	ContentID   int64                `json:"content_id"`
+	Title       string               `json:"title"`
+	Description string               `json:"description"`
+	Stats       *ContentVersionStats `json:"stats,omitempty"` // İçeriğin o an istatistiği yoksa nil
+	ReplacedAt  time.Time            `json:"replaced_at"`     // Bu halin yerini yenisinin aldığı zaman
+}
+
+// ContentVersionStats versiyondaki istatistikler
+type ContentVersionStats struct {
+	Views       int64 `json:"views"`
+	Likes       int32 `json:"likes"`
+	ReadingTime int32 `json:"reading_time"`
+	Reactions   int32 `json:"reactions"`
+}
+
+// ContentAuditEntry bir içerikte (veya istatistik, skor ve tag'lerinde) yapılan tek bir değişikliğin kaydı
+type ContentAuditEntry struct {
+	ID        int64                         `json:"id"`
+	ContentID int64                         `json:"content_id"`
+	Table     string                        `json:"table"`     // contents, content_stats, content_scores, content_tags
+	Operation string                        `json:"operation"` // insert, update, delete (soft delete dahil)
+	Changes   map[string]ContentAuditChange `json:"changes"`
+	Actor     string                        `json:"actor,omitempty"` // sync, ingest, scoring; transaction dışındaki yazmalarda boş
+	SyncJobID string                        `json:"sync_job_id,omitempty"`
+	ChangedAt time.Time                     `json:"changed_at"`
+}
+
+// ContentAuditChange bir kolonun değişiklik öncesi ve sonrası değeri (insert'te old, delete'te new null)
+type ContentAuditChange struct {
+	Old json.RawMessage `json:"old"`
+	New json.RawMessage `json:"new"`
+}
+
+// ProviderHealthStatus provider'ın erişilebilirlik durumu
+type ProviderHealthStatus string
+
+const (
+	ProviderHealthUp      ProviderHealthStatus = "up"
+	ProviderHealthDown    ProviderHealthStatus = "down"
+	ProviderHealthUnknown ProviderHealthStatus = "unknown" // Henüz kontrol edilmedi
+)
+
+// ProviderHealth provider'ın son sağlık kontrolü sonucu
+type ProviderHealth struct {
+	ProviderID          int64                `json:"provider_id"`
+	ProviderName        string               `json:"provider_name,omitempty"`
+	Status              ProviderHealthStatus `json:"status"`
+	StatusCode          int                  `json:"status_code,omitempty"` // Provider'ın döndüğü HTTP kodu, bağlantı hatasında 0
+	LatencyMs           int64                `json:"latency_ms"`
+	Error               string               `json:"error,omitempty"`
+	ConsecutiveFailures int32                `json:"consecutive_failures"`
+	CheckedAt           *time.Time           `json:"checked_at,omitempty"`
+	LastUpAt            *time.Time           `json:"last_up_at,omitempty"` // Son başarılı kontrol
+}
+
+// ProviderFreshnessStatus provider verisinin güncellik durumu
+type ProviderFreshnessStatus string
+
+const (
+	ProviderFreshnessFresh    ProviderFreshnessStatus = "fresh"
+	ProviderFreshnessDegraded ProviderFreshnessStatus = "degraded" // Son başarılı senkronizasyon eşikten eski veya hiç yok
+)
+
+// ProviderFreshness provider'ın sync loglarından hesaplanan güncellik bilgisi
+type ProviderFreshness struct {
+	ProviderID        int64                   `json:"provider_id"`
+	ProviderName      string                  `json:"provider_name"`
+	Status            ProviderFreshnessStatus `json:"status"`
+	LastSuccessAt     *time.Time              `json:"last_success_at,omitempty"` // Son başarılı senkronizasyonun bitişi
+	LastSuccessItems  int32                   `json:"last_success_items"`        // Son başarılı senkronizasyonda yazılan içerik sayısı
+	LastAttemptAt     *time.Time              `json:"last_attempt_at,omitempty"`
+	LastAttemptStatus string                  `json:"last_attempt_status,omitempty"` // success, failed, running
+	AgeSeconds        int64                   `json:"age_seconds,omitempty"`         // Son başarılı senkronizasyondan beri geçen süre
+}
+
 // NormalizedContent provider'lardan gelen veriyi normalize edilmiş formatta tutar
 type NormalizedContent struct {
-	ExternalID  string       `json:"external_id"`
-	Title       string       `json:"title"`
-	Description string       `json:"description"`
-	ContentType ContentType  `json:"content_type"`
-	PublishedAt time.Time    `json:"published_at"`
-	Stats       ContentStats `json:"stats"`
-	Tags        []string     `json:"tags"`
-	RawData     string       `json:"raw_data"`
+	ExternalID   string            `json:"external_id"`
+	Title        string            `json:"title"`
+	Description  string            `json:"description"`
+	ContentType  ContentType       `json:"content_type"`
+	Language     string            `json:"language,omitempty"` // Boşsa provider ayarından veya metinden belirlenir
+	PublishedAt  time.Time         `json:"published_at"`
+	Stats        ContentStats      `json:"stats"`
+	Tags         []string          `json:"tags"`
+	Author       *NormalizedAuthor `json:"author,omitempty"`        // Provider yazar/kanal bilgisi göndermediyse nil
+	URL          string            `json:"url,omitempty"`           // Mutlak http/https URL, yoksa boş
+	ThumbnailURL string            `json:"thumbnail_url,omitempty"` // Mutlak http/https URL, yoksa boş
+	Category     []string          `json:"category,omitempty"`      // Kökten yaprağa kategori adları, yoksa boş
+	RawData      string            `json:"raw_data"`
+}
+
+// NormalizedAuthor provider'dan gelen yazar/kanal bilgisinin normalize edilmiş hali
+type NormalizedAuthor struct {
+	ExternalID string `json:"external_id,omitempty"` // Boşsa Name kullanılır
+	Name       string `json:"name"`
+	URL        string `json:"url,omitempty"`
+}
+
+// FacetCount tek bir facet değeri için sonuç sayısını tutar
+type FacetCount struct {
+	Value string `json:"value"`
+	Label string `json:"label,omitempty"` // Görüntüleme adı (örn. provider adı)
+	Count int64  `json:"count"`
+}
+
+// SearchFacets arama sonuçlarının filtre bazlı dağılımını tutar
+type SearchFacets struct {
+	ContentTypes []FacetCount `json:"content_types"`
+	Providers    []FacetCount `json:"providers"`
+	Tags         []FacetCount `json:"tags"`
+}
+
+// İçerik olay türleri
+const (
+	ContentEventUpsert = "upsert"
+	ContentEventDelete = "delete"
+)
+
+// ContentEvent provider'ların değişiklik akışında yayınladığı içerik olayı
+type ContentEvent struct {
+	ProviderID int64              `json:"provider_id"`
+	Op         string             `json:"op"`                // "upsert" veya "delete"
+	Content    *NormalizedContent `json:"content,omitempty"` // delete için sadece external_id yeterli
+}
+
+// İçerik değişiklik türleri (canlı yayın akışı)
+const (
+	ContentChangeCreated = "created"
+	ContentChangeUpdated = "updated"
+)
+
+// ContentChange senkronizasyonda eklenen veya güncellenen bir içerik
+// Sadece yazmalar commit edildikten sonra yayınlanır
+type ContentChange struct {
+	Type       string    `json:"type"` // "created" veya "updated"
+	Content    *Content  `json:"content"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// WebhookSubscription entegratörün kayıtlı webhook'u
+// Sync sonrası filtreye uyan yeni/güncellenen içerikler URL'ye imzalı POST olarak gönderilir.
+// Boş filtre alanları filtre uygulamaz; Secret sadece oluşturma yanıtında döner
+type WebhookSubscription struct {
+	ID          int64       `json:"id"`
+	Name        string      `json:"name"`
+	URL         string      `json:"url"`
+	Secret      string      `json:"-"`
+	Query       string      `json:"query,omitempty"`
+	Tags        []string    `json:"tags,omitempty"`
+	ContentType ContentType `json:"type,omitempty"`
+	ProviderID  int64       `json:"provider_id,omitempty"`
+	Changes     []string    `json:"changes,omitempty"` // "created", "updated"; boşsa ikisi de
+	Active      bool        `json:"active"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+}
+
+// Webhook gönderim durumları
+const (
+	WebhookDeliveryPending   = "pending"   // Gönderilecek veya tekrar denenecek
+	WebhookDeliveryDelivered = "delivered" // Alıcı 2xx döndü
+	WebhookDeliveryFailed    = "failed"    // Deneme hakkı bitti veya alıcı isteği kalıcı olarak reddetti
+)
+
+// WebhookEventContentMatched webhook gövdesindeki olay adı
+const WebhookEventContentMatched = "content.matched"
+
+// WebhookPayload bir gönderimde POST edilen gövde
+type WebhookPayload struct {
+	Event          string          `json:"event"`
+	SubscriptionID int64           `json:"subscription_id"`
+	Changes        []ContentChange `json:"changes"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+// WebhookDelivery bir aboneliğe yapılan tek bir gönderim ve deneme durumu
+type WebhookDelivery struct {
+	ID             int64           `json:"id"`
+	SubscriptionID int64           `json:"subscription_id"`
+	Payload        json.RawMessage `json:"-"`
+	ItemCount      int             `json:"item_count"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  time.Time       `json:"next_attempt_at"`
+	LastStatusCode int             `json:"last_status_code,omitempty"`
+	LastError      string          `json:"last_error,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+}
+
+// SavedSearch API anahtarı sahibinin kayıtlı araması
+// Sync'te sorguya uyan yeni içerikler geldiğinde NotifyEmail ve/veya NotifyURL'ye bildirim gönderilir
+type SavedSearch struct {
+	ID             int64       `json:"id"`
+	APIKeyID       int64       `json:"-"`
+	Name           string      `json:"name"`
+	Query          string      `json:"query"`
+	Tags           []string    `json:"tags,omitempty"`
+	ContentType    ContentType `json:"type,omitempty"`
+	ProviderID     int64       `json:"provider_id,omitempty"`
+	NotifyEmail    string      `json:"notify_email,omitempty"`
+	NotifyURL      string      `json:"notify_url,omitempty"`
+	Secret         string      `json:"-"` // NotifyURL bildirimlerinin HMAC imzası için
+	LastNotifiedAt *time.Time  `json:"last_notified_at,omitempty"`
+	LastError      string      `json:"last_error,omitempty"` // Son bildirim hatası; başarılı bildirimde temizlenir
+	CreatedAt      time.Time   `json:"created_at"`
+}
+
+// SavedSearchEventNewResults kayıtlı arama bildirimindeki olay adı
+const SavedSearchEventNewResults = "saved_search.new_results"
+
+// SavedSearchAlert kayıtlı aramaya uyan yeni içerik bildirimi
+type SavedSearchAlert struct {
+	Event         string     `json:"event"`
+	SavedSearchID int64      `json:"saved_search_id"`
+	Name          string     `json:"name"`
+	Query         string     `json:"query"`
+	Total         int        `json:"total"`    // Eşleşen yeni içerik sayısı
+	Contents      []*Content `json:"contents"` // İlk eşleşmeler (Total'dan az olabilir)
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// Arama sonucu olay türleri
+const (
+	SearchEventImpression = "impression" // Sonuç kullanıcıya gösterildi
+	SearchEventClick      = "click"      // Kullanıcı sonuca tıkladı
+)
+
+// SearchEvent arama sonucunun tek bir gösterim veya tıklama olayı
+type SearchEvent struct {
+	Type         string
+	ContentID    int64
+	Query        string // Normalize edilmiş sorgu; arama dışı gösterimlerde boş
+	Position     int    // 1 tabanlı sonuç sırası; 0 ise bilinmiyor
+	SessionID    string
+	APIKeyID     int64   // 0 ise anahtarsız istek
+	SampleWeight float64 // Örnekleme oranının tersi; örneklenmeyen olaylarda 1
+}
+
+// ContentCTR bir içeriğin belirli bir dönemdeki gösterim, tıklama ve tıklanma oranı
+type ContentCTR struct {
+	ContentID   int64   `json:"content_id"`
+	Title       string  `json:"title"`
+	Impressions int64   `json:"impressions"` // Örnekleme ağırlıklarıyla tahmin edilen gösterim sayısı
+	Clicks      int64   `json:"clicks"`
+	CTR         float64 `json:"ctr"` // Clicks / Impressions; gösterim yoksa 0
+}
+
+// ZeroResultQuery belirli bir dönemde sonuç döndürmeyen bir arama sorgusu
+type ZeroResultQuery struct {
+	Query      string    `json:"query"`        // Normalize edilmiş sorgu
+	Count      int64     `json:"count"`        // Dönem içinde kaç kez sonuçsuz arandığı
+	LastSeenAt time.Time `json:"last_seen_at"` // En son sonuçsuz arandığı zaman
+}
+
+// AdminStats yönetim paneli için tek çağrıda dönen sistem özeti
+type AdminStats struct {
+	GeneratedAt time.Time         `json:"generated_at"`
+	Contents    ContentCountStats `json:"contents"` // Tüm provider'ların toplamı
+	Providers   []*ProviderStats  `json:"providers"`
+	Cache       CacheStats        `json:"cache"`
+	Index       SearchIndexStatus `json:"index"`
+}
+
+// ContentCountStats içerik sayıları; silinmiş içerikler Active ve ByType'a dahil değildir
+type ContentCountStats struct {
+	Active  int64            `json:"active"`
+	Deleted int64            `json:"deleted"`
+	ByType  map[string]int64 `json:"by_type"` // İçerik türüne göre silinmemiş içerik sayısı
+}
+
+// ProviderStats provider başına içerik sayıları ve son tamamlanan senkronizasyon
+type ProviderStats struct {
+	ProviderID   int64  `json:"provider_id"`
+	ProviderName string `json:"provider_name"`
+	IsActive     bool   `json:"is_active"`
+	ContentCountStats
+	LastSync *LastSyncStats `json:"last_sync,omitempty"` // Hiç tamamlanmış senkronizasyon yoksa nil
+}
+
+// LastSyncStats provider'ın son tamamlanan (başarılı veya başarısız) senkronizasyonu
+type LastSyncStats struct {
+	Status      string    `json:"status"` // "success" veya "failed"
+	StartedAt   time.Time `json:"started_at"`
+	CompletedAt time.Time `json:"completed_at"`
+	DurationMs  int64     `json:"duration_ms"`
+	ItemsSynced int32     `json:"items_synced"`
+}
+
+// CacheStats süreç başlangıcından beri cache okuma sayıları
+type CacheStats struct {
+	Hits     int64   `json:"hits"`
+	Misses   int64   `json:"misses"`
+	HitRatio float64 `json:"hit_ratio"` // Hits / (Hits + Misses); okuma yoksa 0
+}
+
+// SearchIndexStatus arama indeksinin güncelliği
+// Postgres backend'inde arama doğrudan tablolardan yapıldığından indeks her zaman günceldir
+type SearchIndexStatus struct {
+	Backend               string     `json:"backend"`                            // "postgres", "elasticsearch" veya "embedded"
+	LastReindexAt         *time.Time `json:"last_reindex_at,omitempty"`          // Son başarılı yeniden oluşturma
+	IndexedContents       int        `json:"indexed_contents"`                   // Son başarılı yeniden oluşturmada indekslenen içerik
+	LastError             string     `json:"last_error,omitempty"`               // Son yeniden oluşturma başarısızsa hata mesajı
+	LatestContentUpdateAt *time.Time `json:"latest_content_update_at,omitempty"` // En son değişen içeriğin zamanı
+	Stale                 bool       `json:"stale"`                              // İndeks oluşturulduktan sonra değişen içerik varsa true
+}
+
+// Snapshot işlem türleri
+const (
+	SnapshotOperationExport = "export"
+	SnapshotOperationImport = "import"
+)
+
+// SnapshotInfo depolama alanındaki bir içerik yedeği (gzip sıkıştırılmış NDJSON)
+type SnapshotInfo struct {
+	Name       string    `json:"name"`
+	SizeBytes  int64     `json:"size_bytes"`
+	ModifiedAt time.Time `json:"modified_at"`
+}
+
+// SnapshotRun bir yedek alma (export) veya geri yükleme (import) çalışmasının durumu
+type SnapshotRun struct {
+	Operation   string     `json:"operation"` // SnapshotOperationExport veya SnapshotOperationImport
+	Name        string     `json:"name"`
+	Status      string     `json:"status"` // "running", "success" veya "failed"
+	StartedAt   time.Time  `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	Providers   int        `json:"providers"`
+	Categories  int        `json:"categories"`
+	Authors     int        `json:"authors"`
+	Contents    int        `json:"contents"`
+	Error       string     `json:"error,omitempty"`
 }
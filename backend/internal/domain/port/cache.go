@@ -24,6 +24,20 @@ type CacheRepository interface {
 	// Delete cache'den veri siler
 	Delete(ctx context.Context, key string) error
 
+	// InvalidatePattern glob desenine (örn. "search:*") uyan tüm key'leri siler
+	// Diğer key'lere dokunmaz; içerik değişikliklerinden sonra Clear yerine tercih edilmelidir
+	InvalidatePattern(ctx context.Context, pattern string) error
+
 	// Clear tüm cache'i temizler (opsiyonel, dikkatli kullanılmalı)
 	Clear(ctx context.Context) error
 }
+
+// QueryStatsRepository arama sorgularının kullanım sıklığını tutar
+// Cache ısıtma (warm-up) için son dönemde en çok aranan sorguları belirlemede kullanılır
+type QueryStatsRepository interface {
+	// RecordQuery sorgunun aranma sayısını bir artırır (sorgu normalize edilmiş olmalıdır)
+	RecordQuery(ctx context.Context, query string) error
+
+	// TopQueries son dönemde en çok aranan en fazla limit kadar sorguyu sıklık sırasıyla döner
+	TopQueries(ctx context.Context, limit int) ([]string, error)
+}
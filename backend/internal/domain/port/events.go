@@ -0,0 +1,33 @@
+package port
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+)
+
+// ContentChangePublisher senkronizasyonda yazılan içerikleri süreç içi abonelere iletir
+type ContentChangePublisher interface {
+	// Publish bloklamaz; yetişemeyen abonelerin olayları düşürülür
+	Publish(changes []entity.ContentChange)
+}
+
+// ContentChangeSubscriber içerik değişikliklerine abone olmayı sağlar
+type ContentChangeSubscriber interface {
+	// Subscribe buffer kapasiteli bir kanal ve aboneliği bitiren fonksiyonu döner
+	// unsubscribe çağrıldığında kanal kapatılır
+	Subscribe(buffer int) (changes <-chan entity.ContentChange, unsubscribe func())
+}
+
+// WebhookSender imzalanmış webhook gövdesini alıcıya POST eder
+type WebhookSender interface {
+	// Send alıcının HTTP durum kodunu döner; bağlantı/timeout hatalarında err doludur
+	Send(ctx context.Context, url string, header http.Header, body []byte) (statusCode int, err error)
+}
+
+// AlertMailer kayıtlı arama bildirimlerini e-posta ile gönderir
+type AlertMailer interface {
+	// SendMail düz metin bir e-posta gönderir
+	SendMail(ctx context.Context, to, subject, body string) error
+}
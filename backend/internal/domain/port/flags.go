@@ -0,0 +1,29 @@
+package port
+
+import (
+	"context"
+	"errors"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+)
+
+// ErrFeatureFlagNotFound tanımlı olmayan bir feature flag değiştirilmek istendiğinde döner
+var ErrFeatureFlagNotFound = errors.New("feature flag not found")
+
+// FeatureFlags yeni davranışları açıp kapatan bayrakları değerlendirir
+// Varsayılanlar config'ten gelir; override'lar paylaşılan store üzerinden tüm instance'lara yayılır
+type FeatureFlags interface {
+	// Enabled bayrağın açık olup olmadığını döner; tanımsız bayraklar kapalıdır
+	// Store'a erişilemezse son bilinen değer kullanılır, hata dönmez
+	Enabled(ctx context.Context, name string) bool
+
+	// List tanımlı bayrakları ada göre sıralı döner
+	List(ctx context.Context) ([]entity.FeatureFlag, error)
+
+	// SetOverride bayrağın varsayılanını ezer; bayrak tanımlı değilse ErrFeatureFlagNotFound döner
+	SetOverride(ctx context.Context, name string, enabled bool) error
+
+	// ClearOverride override'ı kaldırır, bayrak varsayılanına döner
+	// Bayrak tanımlı değilse ErrFeatureFlagNotFound döner
+	ClearOverride(ctx context.Context, name string) error
+}
@@ -2,6 +2,7 @@ package port
 
 import (
 	"context"
+	"errors"
 
 	"github.com/onurerdog4n/search-engine/internal/domain/entity"
 )
@@ -14,3 +15,46 @@ type ProviderClient interface {
 	// GetProviderInfo provider bilgilerini döner
 	GetProviderInfo() *entity.Provider
 }
+
+// ErrNotModified provider içeriği son başarılı senkronizasyondan beri değişmediyse (HTTP 304) döner
+var ErrNotModified = errors.New("provider content not modified")
+
+// ConditionalFetcher koşullu istek (ETag / If-Modified-Since) destekleyen provider client'ları
+// FetchContents içerik değişmediyse ErrNotModified döner
+type ConditionalFetcher interface {
+	// PendingValidators son fetch'in döndürdüğü ETag / Last-Modified değerlerini döner
+	PendingValidators() (etag, lastModified string)
+
+	// CommitValidators bekleyen değerleri sonraki isteklerde kullanılmak üzere kabul eder
+	// Sadece senkronizasyon eksiksiz tamamlandıktan sonra çağrılmalıdır
+	CommitValidators()
+}
+
+// RejectionReporter normalize edilemeyen içerikleri raporlayan provider client'ları
+// Senkronizasyon bu içerikleri sessizce atlamak yerine karantinaya alır
+type RejectionReporter interface {
+	// Rejections son FetchContents çağrısında normalize edilemeyip atlanan içerikleri döner
+	Rejections() []*entity.ProviderSyncError
+}
+
+// HealthChecker sağlık kontrolü (probe) destekleyen provider client'ları
+type HealthChecker interface {
+	// CheckHealth provider endpoint'ine hafif bir istek gönderir
+	// Provider erişilebilirse HTTP durum koduyla nil, değilse (varsa durum koduyla) hata döner
+	CheckHealth(ctx context.Context) (statusCode int, err error)
+}
+
+// ProviderClientFactory provider kaydından ilgili client'ı oluşturur
+// Desteklenmeyen formatlar için hata döner
+type ProviderClientFactory func(provider *entity.Provider) (ProviderClient, error)
+
+// ContentEventHandler değişiklik akışından gelen tek bir olayı işler
+type ContentEventHandler func(ctx context.Context, event *entity.ContentEvent) error
+
+// ContentEventConsumer değişiklik akışı (örn. NATS) yayınlayan provider'lardan olay okur
+type ContentEventConsumer interface {
+	// Consume ctx iptal edilene kadar olayları okuyup handler'a iletir
+	// Handler hataları loglanır, tüketim devam eder. Doğrulama hataları (apperrors.ValidationError) ve
+	// ErrProviderNotFound kalıcı sayılır; diğer hatalarda broker olayı yeniden teslim edebilir
+	Consume(ctx context.Context, handler ContentEventHandler) error
+}
@@ -2,7 +2,11 @@ package port
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/onurerdog4n/search-engine/internal/domain/entity"
@@ -13,6 +17,18 @@ var (
 	ErrContentNotFound = errors.New("content not found")
 	// ErrDuplicateContent aynı içerik zaten varsa döner
 	ErrDuplicateContent = errors.New("content already exists")
+	// ErrProviderNotFound provider bulunamadığında döner
+	ErrProviderNotFound = errors.New("provider not found")
+	// ErrBoostRuleNotFound tag için boost kuralı bulunamadığında döner
+	ErrBoostRuleNotFound = errors.New("boost rule not found")
+	// ErrPromotionNotFound sabitleme kaydı bulunamadığında döner
+	ErrPromotionNotFound = errors.New("promotion not found")
+	// ErrAPIKeyNotFound API anahtarı bulunamadığında döner
+	ErrAPIKeyNotFound = errors.New("api key not found")
+	// ErrWebhookNotFound webhook aboneliği bulunamadığında döner
+	ErrWebhookNotFound = errors.New("webhook subscription not found")
+	// ErrSavedSearchNotFound kayıtlı arama bulunamadığında (veya başka anahtara aitse) döner
+	ErrSavedSearchNotFound = errors.New("saved search not found")
 )
 
 // ContentRepository içerik veri erişim katmanı interface'i
@@ -23,37 +39,234 @@ type ContentRepository interface {
 	// Update mevcut bir içeriği günceller
 	Update(ctx context.Context, content *entity.Content) error
 
-	// FindByID ID'ye göre içerik getirir
+	// FindByID ID'ye göre silinmemiş içeriği getirir, yoksa ErrContentNotFound döner
 	FindByID(ctx context.Context, id int64) (*entity.Content, error)
 
 	// Upsert içerik varsa günceller, yoksa ekler (provider_id + provider_content_id bazlı)
 	Upsert(ctx context.Context, content *entity.Content) error
 
+	// BulkUpsert içerikleri tek sorguda upsert eder ve her içeriğin ID'sini doldurur
+	// Aynı provider_id + provider_content_id birden fazla gelirse son gelen kazanır
+	BulkUpsert(ctx context.Context, contents []*entity.Content) error
+
 	// Search arama parametrelerine göre içerikleri getirir
 	Search(ctx context.Context, params SearchParams) ([]*entity.Content, int64, error)
 
+	// GetFacets arama parametrelerine uyan içeriklerin facet sayımlarını getirir
+	// Sayfalama ve sıralama parametreleri dikkate alınmaz
+	GetFacets(ctx context.Context, params SearchParams) (*entity.SearchFacets, error)
+
+	// FindSimilar ortak tag'ler ve başlık benzerliğine göre benzer içerikleri getirir
+	// Kaynak içerik yoksa ErrContentNotFound döner
+	FindSimilar(ctx context.Context, contentID int64, limit int) ([]*entity.Content, error)
+
 	// CreateOrUpdateStats içerik istatistiklerini oluşturur veya günceller
 	CreateOrUpdateStats(ctx context.Context, stats *entity.ContentStats) error
 
 	// CreateOrUpdateScore içerik skorunu oluşturur veya günceller
 	CreateOrUpdateScore(ctx context.Context, score *entity.ContentScore) error
 
+	// BulkCreateOrUpdateStats birden fazla içeriğin istatistiklerini tek sorguda yazar
+	BulkCreateOrUpdateStats(ctx context.Context, stats []*entity.ContentStats) error
+
+	// BulkCreateOrUpdateScores birden fazla içeriğin skorunu tek sorguda yazar
+	BulkCreateOrUpdateScores(ctx context.Context, scores []*entity.ContentScore) error
+
 	// AddTags içeriğe etiketler ekler
 	AddTags(ctx context.Context, contentID int64, tags []string) error
 
 	// MarkStaleContentsAsDeleted güncellenmeyen içerikleri silinmiş olarak işaretler
 	MarkStaleContentsAsDeleted(ctx context.Context, providerID int64, threshold time.Time) error
+
+	// MarkContentDeleted provider'ın tek bir içeriğini silinmiş olarak işaretler
+	// İçerik yoksa veya zaten silinmişse hata dönmez
+	MarkContentDeleted(ctx context.Context, providerID int64, providerContentID string) error
+
+	// FindProviderContentIDs provider'ın kayıtlı içeriklerini provider_content_id -> deleted olarak getirir
+	FindProviderContentIDs(ctx context.Context, providerID int64) (map[string]bool, error)
+
+	// FindDuplicateCandidates provider'ın since'ten sonra güncellenen içerikleri için
+	// diğer provider'lardaki, yayın tarihi window içinde ve başlığı benzer olası kopyaları getirir
+	// Adayı olmayan içerikler de CandidateID 0 ile döner
+	FindDuplicateCandidates(ctx context.Context, providerID int64, since time.Time, window time.Duration) ([]*entity.DuplicateCandidate, error)
+
+	// LinkDuplicates içerikleri content ID -> kanonik içerik ID eşlemesine göre bağlar
+	// Kendine eşlenen içeriğin bağlantısı kaldırılır
+	LinkDuplicates(ctx context.Context, links map[int64]int64) error
+
+	// FindContentsForScoring ID'si afterID'den büyük, silinmemiş ve istatistiği olan içerikleri
+	// ID sırasıyla en fazla limit kadar getirir; sadece skorlama için gereken alanlar, Stats ve Tags doldurulur
+	FindContentsForScoring(ctx context.Context, afterID int64, limit int) ([]*entity.Content, error)
+}
+
+// SearchIndex içerik aramasının yapıldığı harici arama indeksi (Elasticsearch/OpenSearch)
+// Doğruluk kaynağı Postgres'tir; indeks ondan yeniden oluşturulur ve sadece arama için kullanılır
+type SearchIndex interface {
+	// Search arama parametrelerine göre içerikleri indeksten getirir (ContentRepository.Search ile aynı sözleşme)
+	Search(ctx context.Context, params SearchParams) ([]*entity.Content, int64, error)
+
+	// Reindex silinmemiş tüm içerikleri indekse yazar ve artık olmayanları indeksten siler
+	// İndekslenen içerik sayısını döner
+	Reindex(ctx context.Context) (int, error)
 }
 
 // SearchParams arama parametrelerini tutar
 type SearchParams struct {
-	Query       string              // Arama terimi (zorunlu)
-	ContentType entity.ContentType  // İçerik türü filtresi (opsiyonel)
-	SortBy      string              // Sıralama kriteri: "popularity" veya "relevance"
-	Page        int                 // Sayfa numarası (1'den başlar)
-	PageSize    int                 // Sayfa boyutu (max 50)
+	Query         string             // Arama terimi (zorunlu)
+	ContentType   entity.ContentType // İçerik türü filtresi (opsiyonel)
+	SortBy        string             // Sıralama kriteri: "popularity", "relevance", "hybrid" veya "alan:yön,..." listesi
+	Page          int                // Sayfa numarası (1'den başlar)
+	PageSize      int                // Sayfa boyutu (max 50)
+	IncludeFacets bool               // Facet sayımları da hesaplansın mı (opsiyonel)
+	Explain       bool               // Sonuçlara skor açıklaması eklensin mi (opsiyonel, sorguyu etkilemez)
+
+	PublishedAfter  *time.Time // Bu tarihte veya sonrasında yayınlananlar (opsiyonel)
+	PublishedBefore *time.Time // Bu tarihte veya öncesinde yayınlananlar (opsiyonel)
+
+	ProviderID   int64  // Provider ID filtresi (opsiyonel, 0 ise filtre yok)
+	ProviderName string // Provider adı filtresi (opsiyonel, büyük/küçük harf duyarsız)
+
+	AuthorName string // Yazar/kanal adı filtresi (opsiyonel, büyük/küçük harf duyarsız)
+
+	Category string // Kategori yolu filtresi (opsiyonel, ör. "programming/go"); alt kategorilerdeki içerikler de eşleşir
+
+	Tags    []string // Tag filtresi (opsiyonel, küçük harfe normalize edilir)
+	TagMode string   // Tag eşleşme modu: "any" (OR, varsayılan) veya "all" (AND)
+
+	// Language verilirse sadece bu dildeki içerikler aranır ve sorgu bu dilin kurallarıyla (kök bulma) işlenir.
+	// Boşsa sorgu tüm desteklenen dillerde işlenip sonuçlar birleştirilir (entity.SupportedLanguages)
+	Language string
+
+	// FuzzyThreshold > 0 ise FTS yerine başlık üzerinde trigram benzerliği kullanılır
+	// (pg_trgm similarity, 0-1 arası eşik). Use case tarafından fallback için set edilir.
+	FuzzyThreshold float64
+
+	// HybridRelevanceWeight hybrid sıralamada alakalılığın ağırlığı (0-1 arası).
+	// Popülerlik ağırlığı 1 - HybridRelevanceWeight'tir. Use case tarafından set edilir.
+	HybridRelevanceWeight float64
+
+	// Cursor verilirse page yerine keyset pagination kullanılır (sadece popularity sıralaması)
+	Cursor *SearchCursor
+
+	// SortFields SortBy alan listesi ise ParseSortFields ile doldurulur
+	// Boş ise SortBy ön tanımlı sıralama (popularity/relevance/hybrid) olarak yorumlanır
+	SortFields []SortField
+
+	// CollapseDuplicates true ise başka provider'daki bir içeriğin kopyası olan içerikler gizlenir
+	CollapseDuplicates bool
+
+	// CountMode toplam sayım modu: "exact" (varsayılan) veya "estimate"
+	CountMode string
+
+	// CountLimit > 0 ise toplam en fazla CountLimit+1'e kadar sayılır; CountLimit'i aşan toplam kesin değildir.
+	// Tahmini sayım (CountMode=estimate) için use case tarafından set edilir
+	CountLimit int
 }
 
+// SortField çoklu sıralamada tek bir alanı temsil eder
+type SortField struct {
+	Field string // SortableFields içindeki alan adı
+	Desc  bool   // true ise azalan sıralama
+}
+
+// SortableFields çoklu sıralamada izin verilen alanlar
+var SortableFields = []string{
+	"published_at", "created_at", "title",
+	"views", "likes", "reactions", "reading_time",
+	"score", "relevance",
+}
+
+// ParseSortFields "published_at:desc,views:desc" biçimindeki sıralama ifadesini çözer
+// Yön belirtilmezse azalan (desc) kabul edilir
+func ParseSortFields(value string) ([]SortField, error) {
+	var fields []SortField
+	seen := make(map[string]bool)
+
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		name, dir, _ := strings.Cut(part, ":")
+		name = strings.ToLower(strings.TrimSpace(name))
+		dir = strings.ToLower(strings.TrimSpace(dir))
+
+		if !isSortableField(name) {
+			return nil, fmt.Errorf("unknown sort field: %s", name)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("duplicate sort field: %s", name)
+		}
+		seen[name] = true
+
+		switch dir {
+		case "", "desc":
+			fields = append(fields, SortField{Field: name, Desc: true})
+		case "asc":
+			fields = append(fields, SortField{Field: name, Desc: false})
+		default:
+			return nil, fmt.Errorf("invalid sort direction for %s: %s", name, dir)
+		}
+	}
+
+	if len(fields) == 0 {
+		return nil, errors.New("empty sort expression")
+	}
+	return fields, nil
+}
+
+// isSortableField alanın sıralamaya uygun olup olmadığını kontrol eder
+func isSortableField(name string) bool {
+	for _, f := range SortableFields {
+		if f == name {
+			return true
+		}
+	}
+	return false
+}
+
+// SearchCursor keyset pagination için son görülen kaydın sıralama anahtarını tutar
+type SearchCursor struct {
+	Score float64 `json:"s"`  // Son kaydın final_score değeri (skor yoksa -1)
+	ID    int64   `json:"id"` // Son kaydın ID'si (eşit skorlarda sıralama için)
+}
+
+// Encode cursor'ı URL-safe opak bir string'e çevirir
+func (c SearchCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeSearchCursor Encode ile üretilmiş cursor string'ini çözer
+func DecodeSearchCursor(value string) (*SearchCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var cursor SearchCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, err
+	}
+	if cursor.ID <= 0 {
+		return nil, errors.New("invalid cursor id")
+	}
+	return &cursor, nil
+}
+
+// Toplam sayım modları
+const (
+	CountModeExact    = "exact"    // COUNT(*) ile kesin toplam
+	CountModeEstimate = "estimate" // CountLimit'e kadar sayım, aşılırsa "CountLimit+" anlamında toplam
+)
+
+// Tag eşleşme modları
+const (
+	TagModeAny = "any" // İçerik tag'lerden en az birine sahip olmalı
+	TagModeAll = "all" // İçerik tag'lerin tamamına sahip olmalı
+)
+
 // ProviderRepository provider veri erişim katmanı interface'i
 type ProviderRepository interface {
 	// FindByID ID'ye göre provider getirir
@@ -62,9 +275,305 @@ type ProviderRepository interface {
 	// FindAll tüm aktif provider'ları getirir
 	FindAll(ctx context.Context) ([]*entity.Provider, error)
 
+	// Create yeni bir provider kaydeder
+	Create(ctx context.Context, provider *entity.Provider) error
+
+	// Update mevcut bir provider'ı günceller, yoksa ErrProviderNotFound döner
+	Update(ctx context.Context, provider *entity.Provider) error
+
+	// Delete provider'ı ve (cascade ile) içeriklerini siler, yoksa ErrProviderNotFound döner
+	Delete(ctx context.Context, id int64) error
+
+	// UpdateFetchValidators son başarılı senkronizasyonun ETag / Last-Modified değerlerini kaydeder
+	UpdateFetchValidators(ctx context.Context, providerID int64, etag, lastModified string) error
+
 	// CreateSyncLog senkronizasyon logu oluşturur
 	CreateSyncLog(ctx context.Context, log *entity.ProviderSyncLog) error
 
 	// UpdateSyncLog senkronizasyon logunu günceller
 	UpdateSyncLog(ctx context.Context, log *entity.ProviderSyncLog) error
+
+	// ListSyncLogs senkronizasyon loglarını en yeniden eskiye sayfalı getirir
+	// providerID 0 ise tüm provider'ların logları döner; toplam kayıt sayısı da döner
+	ListSyncLogs(ctx context.Context, providerID int64, limit, offset int) ([]*entity.ProviderSyncLog, int64, error)
+
+	// ReplaceSyncErrors provider'ın karantinadaki içeriklerini son senkronizasyonun reddettikleriyle değiştirir
+	ReplaceSyncErrors(ctx context.Context, providerID int64, syncErrors []*entity.ProviderSyncError) error
+
+	// AddSyncError provider'ın karantinasına mevcut kayıtları silmeden yeni bir kayıt ekler
+	// Değişiklik akışından gelen tekil olayların reddedilmesinde kullanılır
+	AddSyncError(ctx context.Context, providerID int64, syncError *entity.ProviderSyncError) error
+
+	// ListSyncErrors karantinadaki içerikleri en yeniden eskiye sayfalı getirir
+	// providerID 0 ise tüm provider'ların kayıtları döner; toplam kayıt sayısı da döner
+	ListSyncErrors(ctx context.Context, providerID int64, limit, offset int) ([]*entity.ProviderSyncError, int64, error)
+
+	// SaveHealth provider'ın son sağlık kontrolü sonucunu kaydeder
+	// ConsecutiveFailures ve LastUpAt önceki kayda göre güncellenip health'e yazılır
+	SaveHealth(ctx context.Context, health *entity.ProviderHealth) error
+
+	// FindHealth provider'ın son sağlık kontrolü sonucunu getirir, henüz kontrol edilmediyse nil döner
+	FindHealth(ctx context.Context, providerID int64) (*entity.ProviderHealth, error)
+
+	// ListHealth aktif provider'ların son sağlık kontrolü sonuçlarını getirir
+	ListHealth(ctx context.Context) ([]*entity.ProviderHealth, error)
+
+	// ListSyncFreshness aktif provider'ların son başarılı ve son denenen senkronizasyonlarını getirir
+	// Status ve AgeSeconds doldurulmaz; eşiğe göre use case hesaplar
+	ListSyncFreshness(ctx context.Context) ([]*entity.ProviderFreshness, error)
+}
+
+// ScoringRulesRepository skorlama kuralları veri erişim katmanı interface'i
+type ScoringRulesRepository interface {
+	// GetScoringRules kayıtlı skorlama kurallarını getirir, kayıt yoksa nil döner
+	GetScoringRules(ctx context.Context) (*entity.ScoringRules, error)
+
+	// SaveScoringRules skorlama kurallarını kaydeder ve rules.UpdatedAt'i doldurur
+	SaveScoringRules(ctx context.Context, rules *entity.ScoringRules) error
+}
+
+// BoostRuleRepository tag boost kuralları veri erişim katmanı interface'i
+type BoostRuleRepository interface {
+	// ListBoostRules tüm boost kurallarını tag sırasıyla getirir
+	ListBoostRules(ctx context.Context) ([]*entity.BoostRule, error)
+
+	// SaveBoostRule tag için boost kuralını oluşturur veya yüzdesini günceller; ID ve zamanları doldurur
+	SaveBoostRule(ctx context.Context, rule *entity.BoostRule) error
+
+	// DeleteBoostRule tag'in boost kuralını siler, kural yoksa ErrBoostRuleNotFound döner
+	DeleteBoostRule(ctx context.Context, tag string) error
+}
+
+// APIKeyRepository public API anahtarları veri erişim katmanı interface'i
+type APIKeyRepository interface {
+	// CreateAPIKey yeni bir anahtar kaydeder; ID ve oluşturulma zamanını doldurur
+	CreateAPIKey(ctx context.Context, key *entity.APIKey) error
+
+	// FindAPIKeyByHash hash'e sahip anahtarı getirir (iptal edilmiş olsa da), yoksa ErrAPIKeyNotFound döner
+	FindAPIKeyByHash(ctx context.Context, keyHash string) (*entity.APIKey, error)
+
+	// ListAPIKeys tüm anahtarları oluşturulma sırasıyla getirir
+	ListAPIKeys(ctx context.Context) ([]*entity.APIKey, error)
+
+	// RevokeAPIKey anahtarı iptal eder ve güncel halini döner, anahtar yoksa ErrAPIKeyNotFound döner
+	RevokeAPIKey(ctx context.Context, id int64) (*entity.APIKey, error)
+}
+
+// WebhookRepository webhook abonelikleri ve gönderim kuyruğu veri erişim katmanı interface'i
+type WebhookRepository interface {
+	// CreateWebhook yeni bir abonelik kaydeder; ID ve zaman damgalarını doldurur
+	CreateWebhook(ctx context.Context, sub *entity.WebhookSubscription) error
+
+	// UpdateWebhook aboneliğin URL, filtre ve aktiflik alanlarını günceller (secret değişmez)
+	// Abonelik yoksa ErrWebhookNotFound döner
+	UpdateWebhook(ctx context.Context, sub *entity.WebhookSubscription) error
+
+	// GetWebhook aboneliği getirir, yoksa ErrWebhookNotFound döner
+	GetWebhook(ctx context.Context, id int64) (*entity.WebhookSubscription, error)
+
+	// ListWebhooks tüm abonelikleri oluşturulma sırasıyla getirir
+	ListWebhooks(ctx context.Context) ([]*entity.WebhookSubscription, error)
+
+	// DeleteWebhook aboneliği ve bekleyen gönderimlerini siler, yoksa ErrWebhookNotFound döner
+	DeleteWebhook(ctx context.Context, id int64) error
+
+	// EnqueueWebhookDeliveries gönderimleri pending olarak kuyruğa ekler; ID'leri doldurur
+	EnqueueWebhookDeliveries(ctx context.Context, deliveries []*entity.WebhookDelivery) error
+
+	// ClaimDueWebhookDeliveries zamanı gelmiş en fazla limit pending gönderimi aktif abonelikler için
+	// sahiplenir. Sahiplenilen gönderimlerin next_attempt_at'i lease kadar ileri alınır,
+	// böylece birden fazla instance aynı gönderimi aynı anda denemez
+	ClaimDueWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*entity.WebhookDelivery, error)
+
+	// UpdateWebhookDelivery deneme sonucunu (status, attempts, next_attempt_at, son hata) kaydeder
+	UpdateWebhookDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error
+
+	// ListWebhookDeliveries aboneliğin son gönderimlerini yeniden eskiye getirir
+	ListWebhookDeliveries(ctx context.Context, subscriptionID int64, limit int) ([]*entity.WebhookDelivery, error)
+}
+
+// SavedSearchRepository kayıtlı aramalar veri erişim katmanı interface'i
+type SavedSearchRepository interface {
+	// CreateSavedSearch yeni bir kayıtlı arama ekler; ID ve oluşturulma zamanını doldurur
+	CreateSavedSearch(ctx context.Context, search *entity.SavedSearch) error
+
+	// ListSavedSearches API anahtarının kayıtlı aramalarını oluşturulma sırasıyla getirir
+	ListSavedSearches(ctx context.Context, apiKeyID int64) ([]*entity.SavedSearch, error)
+
+	// DeleteSavedSearch anahtarın kayıtlı aramasını siler; yoksa veya başka anahtara aitse ErrSavedSearchNotFound döner
+	DeleteSavedSearch(ctx context.Context, apiKeyID, id int64) error
+
+	// ListAlertableSavedSearches iptal edilmemiş anahtarlara ait tüm kayıtlı aramaları getirir
+	ListAlertableSavedSearches(ctx context.Context) ([]*entity.SavedSearch, error)
+
+	// RecordSavedSearchAlert bildirim sonucunu kaydeder; lastError boşsa last_notified_at güncellenir
+	RecordSavedSearchAlert(ctx context.Context, id int64, notifiedAt time.Time, lastError string) error
+}
+
+// SearchEventRepository arama sonucu gösterim/tıklama olayları veri erişim katmanı interface'i
+type SearchEventRepository interface {
+	// InsertSearchEvents olayları tek seferde ekler; var olmayan içeriklerin olayları atlanır
+	// Eklenen olay sayısını döner
+	InsertSearchEvents(ctx context.Context, events []*entity.SearchEvent) (int, error)
+
+	// ListContentCTR since'ten beri en çok gösterilen içeriklerin gösterim, tıklama ve CTR değerlerini getirir
+	// query boş değilse sadece o sorgunun olayları sayılır
+	ListContentCTR(ctx context.Context, since time.Time, query string, limit int) ([]*entity.ContentCTR, error)
+}
+
+// ZeroResultQueryRepository sonuç döndürmeyen arama sorguları veri erişim katmanı interface'i
+type ZeroResultQueryRepository interface {
+	// RecordZeroResultQuery sorgunun bugünkü sonuçsuz arama sayısını bir artırır (sorgu normalize edilmiş olmalıdır)
+	RecordZeroResultQuery(ctx context.Context, query string) error
+
+	// ListZeroResultQueries since'ten beri en çok sonuçsuz aranan sorguları sayı ve son görülme zamanıyla getirir
+	ListZeroResultQueries(ctx context.Context, since time.Time, limit int) ([]*entity.ZeroResultQuery, error)
+}
+
+// AdminStatsRepository yönetim paneli özeti için sayım sorguları interface'i
+type AdminStatsRepository interface {
+	// ListProviderStats tüm provider'ların türe göre içerik sayılarını ve son tamamlanan senkronizasyonlarını getirir
+	ListProviderStats(ctx context.Context) ([]*entity.ProviderStats, error)
+
+	// LatestContentUpdate en son eklenen/güncellenen içeriğin zamanını getirir; içerik yoksa nil döner
+	LatestContentUpdate(ctx context.Context) (*time.Time, error)
+}
+
+// SnapshotRepository içerik yedeği için toplu okuma sorguları interface'i
+// Geri yükleme mevcut repository'lerin upsert metodlarıyla yapılır
+type SnapshotRepository interface {
+	// ListProviders pasif olanlar dahil tüm provider'ları ID sırasıyla getirir
+	ListProviders(ctx context.Context) ([]*entity.Provider, error)
+
+	// ListCategories tüm kategorileri path sırasıyla getirir
+	ListCategories(ctx context.Context) ([]*entity.Category, error)
+
+	// ListAuthors tüm yazarları ID sırasıyla getirir
+	ListAuthors(ctx context.Context) ([]*entity.Author, error)
+
+	// ListContents ID'si afterID'den büyük en fazla limit silinmemiş içeriği (moderasyon durumundan bağımsız)
+	// istatistik, skor ve tag'leriyle ID sırasıyla getirir
+	ListContents(ctx context.Context, afterID int64, limit int) ([]*entity.Content, error)
+}
+
+// CTRSignalRepository sıralamada kullanılan içerik CTR sinyalleri (content_scores.ctr_lift) veri erişim katmanı interface'i
+type CTRSignalRepository interface {
+	// AggregateContentCTR since'ten beri olayı olan tüm içeriklerin ağırlıklı gösterim ve tıklama sayılarını getirir
+	// Title doldurulmaz
+	AggregateContentCTR(ctx context.Context, since time.Time) ([]*entity.ContentCTR, error)
+
+	// ReplaceCTRLifts içeriklerin CTR sinyallerini verilenlerle değiştirir; map'te olmayan içeriklerin sinyali sıfırlanır
+	// Skoru olmayan içerikler atlanır. Değişen kayıt sayısını döner
+	ReplaceCTRLifts(ctx context.Context, lifts map[int64]float64) (int, error)
+
+	// FindCTRLifts içeriklerin kayıtlı CTR sinyallerini getirir; sinyali olmayan içerikler map'te yer almaz
+	FindCTRLifts(ctx context.Context, contentIDs []int64) (map[int64]float64, error)
+}
+
+// PromotionRepository arama sorgusu sabitlemeleri veri erişim katmanı interface'i
+type PromotionRepository interface {
+	// FindPromotionByQuery normalize edilmiş sorgunun sabitlemesini getirir, yoksa nil döner
+	FindPromotionByQuery(ctx context.Context, query string) (*entity.Promotion, error)
+
+	// ListPromotions tüm sabitlemeleri sorgu sırasıyla getirir
+	ListPromotions(ctx context.Context) ([]*entity.Promotion, error)
+
+	// SavePromotion sorgu için sabitlemeyi oluşturur veya içerik listesini değiştirir; ID ve zamanları doldurur
+	SavePromotion(ctx context.Context, promotion *entity.Promotion) error
+
+	// DeletePromotion sabitlemeyi siler, yoksa ErrPromotionNotFound döner
+	DeletePromotion(ctx context.Context, id int64) error
+}
+
+// ContentAuditRepository içerik değişiklik geçmişi veri erişim katmanı interface'i
+// Kayıtlar içerik yazılırken veritabanında oluşturulur; bu interface sadece okur
+type ContentAuditRepository interface {
+	// ListContentAudit içeriğin değişiklik kayıtlarını en yeniden eskiye sayfalı getirir; toplam kayıt sayısı da döner
+	ListContentAudit(ctx context.Context, contentID int64, limit, offset int) ([]*entity.ContentAuditEntry, int64, error)
+}
+
+// ContentVersionRepository içeriklerin önceki halleri veri erişim katmanı interface'i
+// Versiyonlar içerik yazılırken veritabanında oluşturulur; bu interface sadece okur
+type ContentVersionRepository interface {
+	// ListContentVersions içeriğin önceki hallerini en yeniden eskiye sayfalı getirir; toplam kayıt sayısı da döner
+	ListContentVersions(ctx context.Context, contentID int64, limit, offset int) ([]*entity.ContentVersion, int64, error)
+}
+
+// AuthorRepository yazar/kanal veri erişim katmanı interface'i
+type AuthorRepository interface {
+	// BulkUpsertAuthors yazarları (provider_id, external_id) anahtarıyla ekler veya ad/URL'lerini günceller
+	// ve ID'lerini entity'lere yazar
+	BulkUpsertAuthors(ctx context.Context, authors []*entity.Author) error
+
+	// ListAuthors filtreye uyan yazarları içerik sayılarıyla sayfalı getirir; toplam kayıt sayısı da döner
+	ListAuthors(ctx context.Context, filter AuthorFilter, limit, offset int) ([]*entity.Author, int64, error)
+}
+
+// CategoryRepository hiyerarşik kategori veri erişim katmanı interface'i
+type CategoryRepository interface {
+	// BulkUpsertCategories kategorileri path anahtarıyla ekler veya adlarını günceller ve ID'lerini entity'lere yazar
+	// Üst kategoriler (path'in önekleri) aynı çağrıda veya daha önce kaydedilmiş olmalıdır
+	BulkUpsertCategories(ctx context.Context, categories []*entity.Category) error
+
+	// ListCategories tüm kategorileri path sırasıyla, alt kategoriler dahil içerik sayılarıyla getirir
+	ListCategories(ctx context.Context) ([]*entity.Category, error)
+}
+
+// ContentModerationRepository içerik moderasyon kuyruğu veri erişim katmanı interface'i
+type ContentModerationRepository interface {
+	// ListContentsByStatus filtreye uyan silinmemiş içerikleri en eskiden yeniye sayfalı getirir; toplam kayıt sayısı da döner
+	ListContentsByStatus(ctx context.Context, filter ContentModerationFilter, limit, offset int) ([]*entity.Content, int64, error)
+
+	// UpdateContentStatus silinmemiş içeriklerin moderasyon durumunu değiştirir ve güncellenen içerik sayısını döner
+	UpdateContentStatus(ctx context.Context, contentIDs []int64, status entity.ContentStatus) (int64, error)
+}
+
+// ContentModerationFilter moderasyon kuyruğu filtresi
+type ContentModerationFilter struct {
+	Status     entity.ContentStatus
+	ProviderID int64 // 0 ise tüm provider'lar
+}
+
+// AuthorFilter yazar listeleme filtresi
+type AuthorFilter struct {
+	ProviderID int64  // 0 ise tüm provider'lar
+	Query      string // Boş değilse adında bu metin geçen yazarlar (büyük/küçük harf duyarsız)
+}
+
+// Transactor birden fazla repository çağrısını tek bir veritabanı transaction'ı içinde çalıştırır
+// Transaction fn'e verilen context üzerinden taşınır; repository'ler bu context ile çağrılmalıdır
+type Transactor interface {
+	// WithinTransaction fn hata dönerse tüm değişiklikleri geri alır, dönmezse commit eder
+	// İç içe çağrılar savepoint kullanır: iç çağrı hata dönerse sadece kendi değişiklikleri geri alınır
+	// ctx'te AuditInfo varsa transaction içindeki içerik değişiklikleri bu bilgiyle audit kaydına yazılır
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Audit kayıtlarında içerik değişikliğini yapan taraflar
+const (
+	AuditActorSync    = "sync"    // Provider senkronizasyonu (periyodik veya admin tetiklemeli)
+	AuditActorIngest  = "ingest"  // Değişiklik akışından gelen içerik olayları
+	AuditActorScoring = "scoring" // Skorların periyodik yeniden hesaplanması
+	AuditActorRestore = "restore" // İçerik yedeğinden geri yükleme
+	AuditActorImport  = "import"  // Admin API ile NDJSON/CSV toplu içe aktarma
+)
+
+// AuditInfo içerik değişikliklerinin audit kaydına yazılan kaynak bilgisi
+type AuditInfo struct {
+	Actor     string
+	SyncJobID string // Sadece senkronizasyon job'u içindeki değişikliklerde dolu
+}
+
+// auditInfoKey context içinde AuditInfo taşımak için kullanılan anahtar
+type auditInfoKey struct{}
+
+// WithAuditInfo audit bilgisini context'e ekler
+func WithAuditInfo(ctx context.Context, info AuditInfo) context.Context {
+	return context.WithValue(ctx, auditInfoKey{}, info)
+}
+
+// AuditInfoFromContext context'teki audit bilgisini döner
+func AuditInfoFromContext(ctx context.Context) (AuditInfo, bool) {
+	info, ok := ctx.Value(auditInfoKey{}).(AuditInfo)
+	return info, ok
 }
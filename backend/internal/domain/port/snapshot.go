@@ -0,0 +1,25 @@
+package port
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+)
+
+// ErrSnapshotNotFound istenen yedek depolama alanında yoksa döner
+var ErrSnapshotNotFound = errors.New("snapshot not found")
+
+// SnapshotStore içerik yedeklerinin yazıldığı depolama alanı (yerel dizin, S3 veya GCS)
+type SnapshotStore interface {
+	// Put r'nin tamamını name adıyla yazar; aynı adlı yedek varsa üzerine yazılır
+	Put(ctx context.Context, name string, r io.Reader) error
+
+	// Get yedeği okumak için açar, yoksa ErrSnapshotNotFound döner
+	// Dönen reader'ı kapatmak çağıranın sorumluluğundadır
+	Get(ctx context.Context, name string) (io.ReadCloser, error)
+
+	// List depolanan yedekleri ada göre sıralı döner
+	List(ctx context.Context) ([]*entity.SnapshotInfo, error)
+}
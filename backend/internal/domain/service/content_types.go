@@ -0,0 +1,173 @@
+package service
+
+import (
+	"math"
+	"sync"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+)
+
+// ContentTypeScorer bir içerik türünün base ve etkileşim skoru formüllerini tanımlar
+// Formül adları skor açıklamasında (Explain) gösterilir
+type ContentTypeScorer struct {
+	// BaseFormula ve Base: istatistiklerden ham popülerlik skoru ve formül girdileri
+	BaseFormula string
+	Base        func(stats *entity.ContentStats, rules ScoringRules) (float64, map[string]float64)
+
+	// WeightName ve Weight: base skorun çarpıldığı tür katsayısı
+	WeightName string
+	Weight     func(rules ScoringRules) float64
+
+	// EngagementRatioFormula ve EngagementRatio: etkileşim oranı ve girdileri
+	// Payda sıfırsa ok false döner ve etkileşim skoru 0 olur
+	EngagementRatioFormula string
+	EngagementRatio        func(stats *entity.ContentStats, rules ScoringRules) (ratio float64, ok bool, inputs map[string]float64)
+
+	// MultiplierName ve Multiplier: ölçeklenen oranın çarpıldığı etkileşim çarpanı
+	MultiplierName string
+	Multiplier     func(rules ScoringRules) float64
+}
+
+var (
+	contentTypeScorersMu sync.RWMutex
+	contentTypeScorers   = map[entity.ContentType]ContentTypeScorer{
+		// Video: views/1000 + likes/100 (+ süre dakikası × video_duration_weight), etkileşim likes/views
+		entity.ContentTypeVideo: {
+			BaseFormula:            "views / 1000 + likes / 100 + duration_minutes × video_duration_weight",
+			Base:                   videoBase,
+			WeightName:             "video_type_weight",
+			Weight:                 func(rules ScoringRules) float64 { return rules.VideoTypeWeight },
+			EngagementRatioFormula: "likes / views",
+			EngagementRatio:        likesPerView,
+			MultiplierName:         "video_engagement_multiplier",
+			Multiplier:             func(rules ScoringRules) float64 { return rules.VideoEngagementMultiplier },
+		},
+		// Makale: reading_time + reactions/50, etkileşim reactions/reading_time
+		entity.ContentTypeArticle: articleScorer,
+		// Podcast: dinlenme (views) ve beğeniler videodaki gibi sayılır
+		entity.ContentTypePodcast: {
+			BaseFormula:            "views / 1000 + likes / 100",
+			Base:                   viewsAndLikesBase,
+			WeightName:             "podcast_type_weight",
+			Weight:                 fixedRule(1.2),
+			EngagementRatioFormula: "likes / views",
+			EngagementRatio:        likesPerView,
+			MultiplierName:         "podcast_engagement_multiplier",
+			Multiplier:             fixedRule(10.0),
+		},
+		// Kurs: kayıt (views) ve değerlendirmeler (reactions), etkileşim reactions/views
+		entity.ContentTypeCourse: {
+			BaseFormula: "views / 1000 + reactions / 50",
+			Base: func(stats *entity.ContentStats, _ ScoringRules) (float64, map[string]float64) {
+				return float64(stats.Views)/1000.0 + float64(stats.Reactions)/50.0,
+					map[string]float64{"views": float64(stats.Views), "reactions": float64(stats.Reactions)}
+			},
+			WeightName:             "course_type_weight",
+			Weight:                 fixedRule(1.3),
+			EngagementRatioFormula: "reactions / views",
+			EngagementRatio: func(stats *entity.ContentStats, rules ScoringRules) (float64, bool, map[string]float64) {
+				inputs := map[string]float64{"reactions": float64(stats.Reactions), "views": float64(stats.Views)}
+				if stats.Views == 0 {
+					return 0, false, inputs
+				}
+				return withComments(float64(stats.Reactions), stats, rules, inputs) / float64(stats.Views), true, inputs
+			},
+			MultiplierName: "course_engagement_multiplier",
+			Multiplier:     fixedRule(20.0),
+		},
+		// Görsel: görüntülenme ve beğeniler, tüketimi kısa olduğu için düşük katsayı
+		entity.ContentTypeImage: {
+			BaseFormula:            "views / 1000 + likes / 100",
+			Base:                   viewsAndLikesBase,
+			WeightName:             "image_type_weight",
+			Weight:                 fixedRule(0.8),
+			EngagementRatioFormula: "likes / views",
+			EngagementRatio:        likesPerView,
+			MultiplierName:         "image_engagement_multiplier",
+			Multiplier:             fixedRule(5.0),
+		},
+	}
+)
+
+// articleScorer makale formülleri; skor formülü kayıtlı olmayan türler için de kullanılır
+var articleScorer = ContentTypeScorer{
+	BaseFormula: "reading_time + reactions / 50",
+	Base: func(stats *entity.ContentStats, _ ScoringRules) (float64, map[string]float64) {
+		return float64(stats.ReadingTime) + float64(stats.Reactions)/50.0,
+			map[string]float64{"reading_time": float64(stats.ReadingTime), "reactions": float64(stats.Reactions)}
+	},
+	WeightName:             "article_type_weight",
+	Weight:                 func(rules ScoringRules) float64 { return rules.ArticleTypeWeight },
+	EngagementRatioFormula: "reactions / reading_time",
+	EngagementRatio: func(stats *entity.ContentStats, rules ScoringRules) (float64, bool, map[string]float64) {
+		inputs := map[string]float64{"reactions": float64(stats.Reactions), "reading_time": float64(stats.ReadingTime)}
+		if stats.ReadingTime == 0 {
+			return 0, false, inputs
+		}
+		return withComments(float64(stats.Reactions), stats, rules, inputs) / float64(stats.ReadingTime), true, inputs
+	},
+	MultiplierName: "article_engagement_multiplier",
+	Multiplier:     func(rules ScoringRules) float64 { return rules.ArticleEngagementMultiplier },
+}
+
+// RegisterContentType yeni bir içerik türünü skor formülleriyle birlikte kaydeder
+// Tür sync, arama filtresi ve doğrulamalarda kabul edilir; kayıtlı bir türün formülleri değiştirilir
+func RegisterContentType(contentType entity.ContentType, scorer ContentTypeScorer) {
+	contentTypeScorersMu.Lock()
+	contentTypeScorers[contentType] = scorer
+	contentTypeScorersMu.Unlock()
+	entity.RegisterContentType(contentType)
+}
+
+// scorerFor içerik türünün formüllerini döner, kayıtlı formülü olmayan türler makale gibi skorlanır
+func scorerFor(contentType entity.ContentType) ContentTypeScorer {
+	contentTypeScorersMu.RLock()
+	defer contentTypeScorersMu.RUnlock()
+	if scorer, ok := contentTypeScorers[contentType]; ok {
+		return scorer
+	}
+	return articleScorer
+}
+
+// viewsAndLikesBase views/1000 + likes/100
+func viewsAndLikesBase(stats *entity.ContentStats, _ ScoringRules) (float64, map[string]float64) {
+	return float64(stats.Views)/1000.0 + float64(stats.Likes)/100.0,
+		map[string]float64{"views": float64(stats.Views), "likes": float64(stats.Likes)}
+}
+
+// videoBase views/1000 + likes/100, VideoDurationWeight verilmişse süre dakikası başına puan eklenir
+func videoBase(stats *entity.ContentStats, rules ScoringRules) (float64, map[string]float64) {
+	base, inputs := viewsAndLikesBase(stats, rules)
+	if rules.VideoDurationWeight > 0 {
+		minutes := float64(stats.DurationSeconds) / 60.0
+		base += minutes * rules.VideoDurationWeight
+		inputs["duration_minutes"] = math.Round(minutes*10) / 10
+		inputs["video_duration_weight"] = rules.VideoDurationWeight
+	}
+	return base, inputs
+}
+
+// likesPerView likes/views, görüntülenmesi olmayan içeriklerde oran yoktur
+func likesPerView(stats *entity.ContentStats, rules ScoringRules) (float64, bool, map[string]float64) {
+	inputs := map[string]float64{"likes": float64(stats.Likes), "views": float64(stats.Views)}
+	if stats.Views == 0 {
+		return 0, false, inputs
+	}
+	return withComments(float64(stats.Likes), stats, rules, inputs) / float64(stats.Views), true, inputs
+}
+
+// withComments CommentWeight verilmişse yorumları etkileşim oranının payına ekler
+// (her yorum CommentWeight kadar beğeni/tepki sayılır)
+func withComments(engagements float64, stats *entity.ContentStats, rules ScoringRules, inputs map[string]float64) float64 {
+	if rules.CommentWeight <= 0 {
+		return engagements
+	}
+	inputs["comments"] = float64(stats.Comments)
+	inputs["comment_weight"] = rules.CommentWeight
+	return engagements + float64(stats.Comments)*rules.CommentWeight
+}
+
+// fixedRule skorlama kurallarından bağımsız sabit bir katsayı döner
+func fixedRule(value float64) func(ScoringRules) float64 {
+	return func(ScoringRules) float64 { return value }
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+)
+
+// DedupService farklı provider'lardan gelen aynı içerikleri tespit eder
+type DedupService interface {
+	// PublishedWindow kopya sayılabilecek iki içeriğin yayın tarihleri arasındaki en büyük fark
+	PublishedWindow() time.Duration
+
+	// IsDuplicate iki içeriğin normalize başlık ve yayın tarihine göre aynı içerik olup olmadığını döner
+	IsDuplicate(titleA string, publishedA time.Time, titleB string, publishedB time.Time) bool
+
+	// ResolveCanonical adaylardan kopya gruplarını oluşturur ve her içerik için kanonik içerik ID'sini döner
+	// Gruptaki en eski (en küçük ID'li) içerik kanoniktir; kanonik içerik kendi ID'sine eşlenir
+	ResolveCanonical(candidates []*entity.DuplicateCandidate) map[int64]int64
+}
+
+// dedupService DedupService interface'inin implementasyonu
+type dedupService struct {
+	rules DedupRules
+}
+
+// DedupRules kopya tespit kurallarını tutar
+type DedupRules struct {
+	TitleSimilarity float64       // Normalize başlık kelimeleri için en düşük Dice benzerliği (varsayılan: 0.85)
+	PublishedWindow time.Duration // Yayın tarihleri arasındaki en büyük fark (varsayılan: 48 saat)
+}
+
+// NewDedupService yeni bir DedupService oluşturur
+func NewDedupService(rules DedupRules) DedupService {
+	// Varsayılan değerleri ayarla
+	if rules.TitleSimilarity <= 0 || rules.TitleSimilarity > 1 {
+		rules.TitleSimilarity = 0.85
+	}
+	if rules.PublishedWindow <= 0 {
+		rules.PublishedWindow = 48 * time.Hour
+	}
+
+	return &dedupService{
+		rules: rules,
+	}
+}
+
+// PublishedWindow kopya sayılabilecek yayın tarihi farkını döner
+func (s *dedupService) PublishedWindow() time.Duration {
+	return s.rules.PublishedWindow
+}
+
+// IsDuplicate yayın tarihleri pencere içindeyse normalize başlıkların benzerliğine bakar
+func (s *dedupService) IsDuplicate(titleA string, publishedA time.Time, titleB string, publishedB time.Time) bool {
+	diff := publishedA.Sub(publishedB)
+	if diff < 0 {
+		diff = -diff
+	}
+	if diff > s.rules.PublishedWindow {
+		return false
+	}
+
+	return TitleSimilarity(titleA, titleB) >= s.rules.TitleSimilarity
+}
+
+// ResolveCanonical eşleşen içerikleri union-find ile gruplar
+// Bir içerik kanonik içeriğe bağlı bir adayla eşleşirse aynı gruba girer; böylece zincir oluşmaz
+func (s *dedupService) ResolveCanonical(candidates []*entity.DuplicateCandidate) map[int64]int64 {
+	parent := make(map[int64]int64)
+	var find func(id int64) int64
+	find = func(id int64) int64 {
+		p, ok := parent[id]
+		if !ok || p == id {
+			parent[id] = id
+			return id
+		}
+		root := find(p)
+		parent[id] = root
+		return root
+	}
+	union := func(a, b int64) {
+		ra, rb := find(a), find(b)
+		// Küçük ID kök olur: en eski içerik kanonik kalır
+		if ra < rb {
+			parent[rb] = ra
+		} else if rb < ra {
+			parent[ra] = rb
+		}
+	}
+
+	for _, c := range candidates {
+		find(c.ContentID)
+		if c.CandidateID == 0 {
+			continue
+		}
+		if s.IsDuplicate(c.Title, c.PublishedAt, c.CandidateTitle, c.CandidatePublishedAt) {
+			union(c.ContentID, c.CandidateCanonicalID)
+		}
+	}
+
+	canonical := make(map[int64]int64, len(parent))
+	for id := range parent {
+		canonical[id] = find(id)
+	}
+	return canonical
+}
+
+// NormalizeTitle başlığı karşılaştırma için küçük harfe çevirir, noktalama işaretlerini atar ve boşlukları sadeleştirir
+func NormalizeTitle(title string) string {
+	cleaned := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return ' '
+	}, title)
+	return strings.Join(strings.Fields(cleaned), " ")
+}
+
+// TitleSimilarity normalize başlıkların kelime kümeleri üzerinden Dice benzerliğini (0-1) döner
+func TitleSimilarity(a, b string) float64 {
+	wordsA := strings.Fields(NormalizeTitle(a))
+	wordsB := strings.Fields(NormalizeTitle(b))
+	if len(wordsA) == 0 || len(wordsB) == 0 {
+		return 0
+	}
+
+	setA := make(map[string]struct{}, len(wordsA))
+	for _, w := range wordsA {
+		setA[w] = struct{}{}
+	}
+	setB := make(map[string]struct{}, len(wordsB))
+	for _, w := range wordsB {
+		setB[w] = struct{}{}
+	}
+
+	common := 0
+	for w := range setA {
+		if _, ok := setB[w]; ok {
+			common++
+		}
+	}
+	return 2 * float64(common) / float64(len(setA)+len(setB))
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTitle(t *testing.T) {
+	assert.Equal(t, "go 1 22 release notes", NormalizeTitle("  Go 1.22: Release-Notes!  "))
+	assert.Equal(t, "çay kahve", NormalizeTitle("Çay & Kahve"))
+	assert.Empty(t, NormalizeTitle("?!"))
+}
+
+func TestTitleSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, TitleSimilarity("Go Concurrency Patterns", "go concurrency patterns!"))
+	assert.InDelta(t, 0.857, TitleSimilarity("Go Concurrency Patterns Explained", "Go Concurrency Patterns"), 0.001)
+	assert.Zero(t, TitleSimilarity("Docker Basics", "Rust Ownership"))
+	assert.Zero(t, TitleSimilarity("", "Docker Basics"))
+}
+
+func TestDedupService_IsDuplicate(t *testing.T) {
+	service := NewDedupService(DedupRules{})
+	published := time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC)
+
+	t.Run("Should match same title within window", func(t *testing.T) {
+		assert.True(t, service.IsDuplicate("Go Concurrency Patterns", published, "GO: Concurrency Patterns", published.Add(-24*time.Hour)))
+	})
+
+	t.Run("Should not match outside published window", func(t *testing.T) {
+		assert.False(t, service.IsDuplicate("Go Concurrency Patterns", published, "Go Concurrency Patterns", published.Add(72*time.Hour)))
+	})
+
+	t.Run("Should not match different titles", func(t *testing.T) {
+		assert.False(t, service.IsDuplicate("Go Concurrency Patterns", published, "Go Generics Patterns", published))
+	})
+
+	t.Run("Should apply default rules", func(t *testing.T) {
+		assert.Equal(t, 48*time.Hour, service.PublishedWindow())
+	})
+}
+
+func TestDedupService_ResolveCanonical(t *testing.T) {
+	service := NewDedupService(DedupRules{})
+	published := time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC)
+	candidate := func(contentID int64, title string, candidateID int64, candidateTitle string, canonicalID int64) *entity.DuplicateCandidate {
+		return &entity.DuplicateCandidate{
+			ContentID: contentID, Title: title, PublishedAt: published,
+			CandidateID: candidateID, CandidateTitle: candidateTitle, CandidatePublishedAt: published,
+			CandidateCanonicalID: canonicalID,
+		}
+	}
+
+	t.Run("Should link newer content to oldest", func(t *testing.T) {
+		links := service.ResolveCanonical([]*entity.DuplicateCandidate{
+			candidate(10, "Docker Basics", 3, "Docker Basics", 3),
+		})
+		assert.Equal(t, map[int64]int64{10: 3, 3: 3}, links)
+	})
+
+	t.Run("Should link to candidate's canonical content", func(t *testing.T) {
+		// 7, 2'nin kopyası: 10 doğrudan 2'ye bağlanır
+		links := service.ResolveCanonical([]*entity.DuplicateCandidate{
+			candidate(10, "Docker Basics", 7, "Docker Basics", 2),
+		})
+		assert.Equal(t, int64(2), links[10])
+	})
+
+	t.Run("Should re-root when synced content is oldest", func(t *testing.T) {
+		links := service.ResolveCanonical([]*entity.DuplicateCandidate{
+			candidate(1, "Docker Basics", 5, "Docker Basics", 5),
+		})
+		assert.Equal(t, map[int64]int64{1: 1, 5: 1}, links)
+	})
+
+	t.Run("Should unlink content without matching candidates", func(t *testing.T) {
+		links := service.ResolveCanonical([]*entity.DuplicateCandidate{
+			{ContentID: 10, Title: "Docker Basics", PublishedAt: published},
+			candidate(11, "Docker Basics", 4, "Rust Ownership", 4),
+		})
+		assert.Equal(t, map[int64]int64{10: 10, 11: 11}, links)
+	})
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+)
+
+// turkishOnlyLetters Türkçeye özgü (diğer desteklenen dillerde geçmeyen) harfler
+const turkishOnlyLetters = "ğışĞİŞ"
+
+// languageStopwords dil tespitinde kullanılan, o dilde sık geçen kısa kelimeler
+var languageStopwords = map[string]map[string]bool{
+	entity.LanguageEnglish: wordSet("the", "and", "of", "to", "in", "for", "with", "on", "is", "how", "what", "your", "from", "an", "a"),
+	entity.LanguageTurkish: wordSet("ve", "bir", "bu", "için", "ile", "da", "de", "nasıl", "nedir", "ne", "çok", "daha", "gibi", "en", "mi"),
+	entity.LanguageGerman:  wordSet("der", "die", "das", "und", "mit", "für", "ist", "ein", "eine", "wie", "auf", "den", "nicht"),
+	entity.LanguageFrench:  wordSet("le", "la", "les", "et", "des", "du", "pour", "avec", "est", "une", "un", "comment", "dans"),
+	entity.LanguageSpanish: wordSet("el", "los", "las", "y", "del", "para", "con", "es", "una", "cómo", "por", "qué"),
+}
+
+// DetectLanguage metinlerin (başlık, açıklama, tag'ler) dilini basit sezgilerle tahmin eder:
+// dile özgü harfler ve sık kullanılan kelimeler sayılır, en yüksek puanı alan dil döner.
+// Hiçbir işaret bulunamazsa veya eşitlikte LanguageEnglish döner
+func DetectLanguage(texts ...string) string {
+	scores := make(map[string]int, len(languageStopwords))
+
+	for _, text := range texts {
+		// Türkçeye özgü harfler güçlü işarettir
+		if strings.ContainsAny(text, turkishOnlyLetters) {
+			scores[entity.LanguageTurkish] += 2
+		}
+
+		words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+			return !unicode.IsLetter(r)
+		})
+		for _, word := range words {
+			for language, stopwords := range languageStopwords {
+				if stopwords[word] {
+					scores[language]++
+				}
+			}
+		}
+	}
+
+	best, bestScore := entity.LanguageEnglish, scores[entity.LanguageEnglish]
+	// Eşitlikte sonuç deterministik olsun diye SupportedLanguages sırası kullanılır
+	for _, language := range entity.SupportedLanguages {
+		if scores[language] > bestScore {
+			best, bestScore = language, scores[language]
+		}
+	}
+	return best
+}
+
+func wordSet(words ...string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, w := range words {
+		set[w] = true
+	}
+	return set
+}
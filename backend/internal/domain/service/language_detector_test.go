@@ -0,0 +1,31 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+)
+
+func TestDetectLanguage(t *testing.T) {
+	tests := []struct {
+		name  string
+		texts []string
+		want  string
+	}{
+		{"english title", []string{"Introduction to the Go programming language"}, entity.LanguageEnglish},
+		{"turkish title", []string{"Go ile eşzamanlı programlama nasıl yapılır"}, entity.LanguageTurkish},
+		{"turkish letters without stopwords", []string{"Yazılım Geliştirme"}, entity.LanguageTurkish},
+		{"turkish description outweighs english tag", []string{"Docker", "Konteynerler için bir başlangıç rehberi", "the basics"}, entity.LanguageTurkish},
+		{"german", []string{"Wie man mit der Programmiersprache Go arbeitet und die Grundlagen lernt"}, entity.LanguageGerman},
+		{"no signal defaults to english", []string{"Kubernetes 101"}, entity.LanguageEnglish},
+		{"empty", nil, entity.LanguageEnglish},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DetectLanguage(tt.texts...))
+		})
+	}
+}
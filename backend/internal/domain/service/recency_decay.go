@@ -0,0 +1,108 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+)
+
+// RecencyDecayFunc içeriğin yaşından güncellik skoru üreten strateji
+type RecencyDecayFunc interface {
+	// Score verilen yaş için güncellik skorunu döner
+	Score(age time.Duration) float64
+
+	// Describe skor açıklaması için formülü ve formüle özgü girdileri döner
+	Describe(age time.Duration) (string, map[string]float64)
+}
+
+// NewRecencyDecayFunc kurallarda seçili güncellik stratejisini oluşturur
+// Bilinmeyen veya boş strateji adı için kademeli (step) fonksiyon kullanılır
+func NewRecencyDecayFunc(rules ScoringRules) RecencyDecayFunc {
+	switch rules.RecencyDecay {
+	case entity.RecencyDecayExponential:
+		return exponentialDecay{halfLifeDays: rules.RecencyHalfLifeDays, maxScore: rules.RecencyMaxScore}
+	case entity.RecencyDecayGaussian:
+		return gaussianDecay{halfLifeDays: rules.RecencyHalfLifeDays, maxScore: rules.RecencyMaxScore}
+	default:
+		return stepDecay{tiers: rules.RecencyTiers}
+	}
+}
+
+// IsValidRecencyDecay strateji adının desteklenip desteklenmediğini döner
+func IsValidRecencyDecay(decay entity.RecencyDecay) bool {
+	switch decay {
+	case entity.RecencyDecayStep, entity.RecencyDecayExponential, entity.RecencyDecayGaussian:
+		return true
+	}
+	return false
+}
+
+// stepDecay yaşın girdiği ilk kademenin skorunu verir, hiçbir kademeye girmeyen içerikler 0 alır
+type stepDecay struct {
+	tiers []entity.RecencyTier
+}
+
+func (d stepDecay) Score(age time.Duration) float64 {
+	if tier, ok := d.match(age); ok {
+		return tier.Score
+	}
+	return 0.0
+}
+
+func (d stepDecay) Describe(age time.Duration) (string, map[string]float64) {
+	if tier, ok := d.match(age); ok {
+		return fmt.Sprintf("age_days <= %d", tier.MaxAgeDays), map[string]float64{"max_age_days": float64(tier.MaxAgeDays)}
+	}
+	return "age_days outside all recency tiers", nil
+}
+
+// match verilen yaşın girdiği ilk güncellik kademesini döner
+func (d stepDecay) match(age time.Duration) (entity.RecencyTier, bool) {
+	for _, tier := range d.tiers {
+		if age <= time.Duration(tier.MaxAgeDays)*24*time.Hour {
+			return tier, true
+		}
+	}
+	return entity.RecencyTier{}, false
+}
+
+// exponentialDecay skoru her yarılanma süresinde yarıya indirir
+type exponentialDecay struct {
+	halfLifeDays float64
+	maxScore     float64
+}
+
+func (d exponentialDecay) Score(age time.Duration) float64 {
+	return d.maxScore * math.Pow(0.5, ageInDays(age)/d.halfLifeDays)
+}
+
+func (d exponentialDecay) Describe(age time.Duration) (string, map[string]float64) {
+	return "recency_max_score × 0.5^(age_days / recency_half_life_days)",
+		map[string]float64{"recency_max_score": d.maxScore, "recency_half_life_days": d.halfLifeDays}
+}
+
+// gaussianDecay yeni içerikleri daha uzun süre yüksek tutar, yarılanma süresinden sonra hızla düşer
+type gaussianDecay struct {
+	halfLifeDays float64
+	maxScore     float64
+}
+
+func (d gaussianDecay) Score(age time.Duration) float64 {
+	x := ageInDays(age) / d.halfLifeDays
+	return d.maxScore * math.Pow(0.5, x*x)
+}
+
+func (d gaussianDecay) Describe(age time.Duration) (string, map[string]float64) {
+	return "recency_max_score × 0.5^((age_days / recency_half_life_days)²)",
+		map[string]float64{"recency_max_score": d.maxScore, "recency_half_life_days": d.halfLifeDays}
+}
+
+// ageInDays yaşı gün cinsinden döner; ileri tarihli içerikler yeni yayınlanmış sayılır
+func ageInDays(age time.Duration) float64 {
+	if age < 0 {
+		return 0
+	}
+	return age.Hours() / 24
+}
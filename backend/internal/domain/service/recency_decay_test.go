@@ -0,0 +1,79 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecencyDecayFunc(t *testing.T) {
+	day := 24 * time.Hour
+	base := DefaultScoringRules()
+
+	t.Run("Should default to step function", func(t *testing.T) {
+		decay := NewRecencyDecayFunc(base)
+		assert.Equal(t, 5.0, decay.Score(3*day))
+		assert.Equal(t, 3.0, decay.Score(20*day))
+		assert.Equal(t, 0.0, decay.Score(200*day))
+	})
+
+	t.Run("Should halve exponential score every half-life", func(t *testing.T) {
+		rules := base
+		rules.RecencyDecay = entity.RecencyDecayExponential
+		decay := NewRecencyDecayFunc(rules)
+
+		assert.Equal(t, 5.0, decay.Score(0))
+		assert.InDelta(t, 2.5, decay.Score(14*day), 1e-9)
+		assert.InDelta(t, 1.25, decay.Score(28*day), 1e-9)
+		assert.Equal(t, 5.0, decay.Score(-day), "future content should count as new")
+
+		formula, inputs := decay.Describe(14 * day)
+		assert.Equal(t, "recency_max_score × 0.5^(age_days / recency_half_life_days)", formula)
+		assert.Equal(t, 14.0, inputs["recency_half_life_days"])
+	})
+
+	t.Run("Should keep gaussian score high before half-life and drop after", func(t *testing.T) {
+		rules := base
+		rules.RecencyDecay = entity.RecencyDecayGaussian
+		rules.RecencyHalfLifeDays = 10
+		rules.RecencyMaxScore = 8
+		decay := NewRecencyDecayFunc(rules)
+
+		assert.InDelta(t, 4.0, decay.Score(10*day), 1e-9)
+		assert.InDelta(t, 0.5, decay.Score(20*day), 1e-9)
+
+		exponential := exponentialDecay{halfLifeDays: 10, maxScore: 8}
+		assert.Greater(t, decay.Score(5*day), exponential.Score(5*day))
+		assert.Less(t, decay.Score(20*day), exponential.Score(20*day))
+	})
+
+	t.Run("Should validate decay names", func(t *testing.T) {
+		assert.True(t, IsValidRecencyDecay(entity.RecencyDecayGaussian))
+		assert.False(t, IsValidRecencyDecay("linear"))
+		assert.False(t, IsValidRecencyDecay(""))
+	})
+}
+
+func TestScoringService_RecencyDecayFallback(t *testing.T) {
+	// Config'den gelen fonksiyon, kayıtlı kurallar seçmediyse korunur
+	service := NewScoringService(ScoringRules{RecencyDecay: entity.RecencyDecayExponential, RecencyHalfLifeDays: 7})
+	content := &entity.Content{
+		ContentType: entity.ContentTypeArticle,
+		PublishedAt: time.Now().Add(-14 * 24 * time.Hour),
+		Stats:       &entity.ContentStats{ReadingTime: 1},
+	}
+
+	service.SetRules(ScoringRules{VideoTypeWeight: 2})
+	assert.Equal(t, entity.RecencyDecayExponential, service.Rules().RecencyDecay)
+	score, err := service.CalculateScore(content)
+	assert.NoError(t, err)
+	assert.Equal(t, 1.25, score.RecencyScore)
+
+	service.SetRules(ScoringRules{RecencyDecay: entity.RecencyDecayStep})
+	score, err = service.CalculateScore(content)
+	assert.NoError(t, err)
+	assert.Equal(t, 3.0, score.RecencyScore)
+	assert.Equal(t, 7.0, service.Rules().RecencyHalfLifeDays)
+}
@@ -2,6 +2,8 @@ package service
 
 import (
 	"math"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/onurerdog4n/search-engine/internal/domain/entity"
@@ -10,115 +12,390 @@ import (
 // ScoringService skorlama işlemlerini yönetir
 type ScoringService interface {
 	CalculateScore(content *entity.Content) (*entity.ContentScore, error)
+
+	// Explain içeriğin skorunu güncel kurallarla bileşenleri ve formül girdileriyle açıklar
+	// İstatistiği olmayan içerikler için nil döner
+	Explain(content *entity.Content) *entity.ScoreExplanation
+
+	// Rules o an kullanılan (varsayılanları uygulanmış) skorlama kurallarını döner
+	Rules() ScoringRules
+
+	// SetRules skorlama kurallarını çalışma anında değiştirir; sonraki hesaplamalar yeni kuralları kullanır
+	SetRules(rules ScoringRules)
+
+	// SetDefaults SetRules'ta boş bırakılan alanlar için kullanılan varsayılanları değiştirir (örn. config yeniden yüklendiğinde)
+	SetDefaults(rules ScoringRules)
+
+	// SetBoosts tag boost kurallarını çalışma anında değiştirir; önceki kuralların tamamı kaldırılır
+	SetBoosts(boosts []entity.BoostRule)
 }
 
 // scoringService ScoringService interface'inin implementasyonu
 type scoringService struct {
-	rules ScoringRules
+	mu        sync.RWMutex
+	rules     ScoringRules
+	defaults  ScoringRules       // SetRules'ta boş bırakılan alanlar için kullanılır
+	overrides ScoringRules       // Son SetRules çağrısında verilen kurallar (varsayılanlar uygulanmadan)
+	boosts    map[string]float64 // tag -> yüzde
 }
 
 // ScoringRules skorlama kurallarını tutar
-type ScoringRules struct {
-	VideoTypeWeight   float64 // Video içerikler için katsayı (varsayılan: 1.5)
-	ArticleTypeWeight float64 // Makale içerikler için katsayı (varsayılan: 1.0)
-}
+// Kurallar veritabanında saklandığı için entity katmanında tanımlıdır
+type ScoringRules = entity.ScoringRules
 
 // NewScoringService yeni bir ScoringService oluşturur
+// Verilen kurallar (örn. config'den gelen güncellik fonksiyonu) sonraki SetRules çağrılarında
+// boş bırakılan alanlar için de varsayılan olarak kullanılır
 func NewScoringService(rules ScoringRules) ScoringService {
-	// Varsayılan değerleri ayarla
+	rules = withDefaultRules(rules)
+	return &scoringService{
+		rules:    rules,
+		defaults: rules,
+	}
+}
+
+// DefaultScoringRules varsayılan skorlama kurallarını döner
+func DefaultScoringRules() ScoringRules {
+	return withDefaultRules(ScoringRules{})
+}
+
+// withDefaultRules sıfır değerli alanlara yerleşik varsayılanları atar
+func withDefaultRules(rules ScoringRules) ScoringRules {
+	return withFallbackRules(rules, ScoringRules{
+		VideoTypeWeight:   1.5,
+		ArticleTypeWeight: 1.0,
+		// 1 hafta içinde: +5, 1 ay içinde: +3, 3 ay içinde: +1
+		RecencyTiers: []entity.RecencyTier{
+			{MaxAgeDays: 7, Score: 5.0},
+			{MaxAgeDays: 30, Score: 3.0},
+			{MaxAgeDays: 90, Score: 1.0},
+		},
+		VideoEngagementMultiplier:   10.0,
+		ArticleEngagementMultiplier: 5.0,
+		RecencyDecay:                entity.RecencyDecayStep,
+		RecencyHalfLifeDays:         14.0,
+		RecencyMaxScore:             5.0,
+		EngagementScaling:           entity.EngagementScalingLinear,
+	})
+}
+
+// withFallbackRules sıfır değerli alanlara fallback kurallarındaki değerleri atar
+// Kademe listesi kopyalanır; çağıranın slice'ı sonradan değişse de kurallar etkilenmez
+func withFallbackRules(rules, fallback ScoringRules) ScoringRules {
 	if rules.VideoTypeWeight == 0 {
-		rules.VideoTypeWeight = 1.5
+		rules.VideoTypeWeight = fallback.VideoTypeWeight
 	}
 	if rules.ArticleTypeWeight == 0 {
-		rules.ArticleTypeWeight = 1.0
+		rules.ArticleTypeWeight = fallback.ArticleTypeWeight
+	}
+	if len(rules.RecencyTiers) == 0 {
+		rules.RecencyTiers = fallback.RecencyTiers
+	}
+	rules.RecencyTiers = append([]entity.RecencyTier(nil), rules.RecencyTiers...)
+	if rules.VideoEngagementMultiplier == 0 {
+		rules.VideoEngagementMultiplier = fallback.VideoEngagementMultiplier
+	}
+	if rules.ArticleEngagementMultiplier == 0 {
+		rules.ArticleEngagementMultiplier = fallback.ArticleEngagementMultiplier
+	}
+	if rules.RecencyDecay == "" {
+		rules.RecencyDecay = fallback.RecencyDecay
+	}
+	if rules.RecencyHalfLifeDays == 0 {
+		rules.RecencyHalfLifeDays = fallback.RecencyHalfLifeDays
+	}
+	if rules.RecencyMaxScore == 0 {
+		rules.RecencyMaxScore = fallback.RecencyMaxScore
+	}
+	if rules.EngagementScaling == "" {
+		rules.EngagementScaling = fallback.EngagementScaling
+	}
+	if rules.EngagementCap == 0 {
+		rules.EngagementCap = fallback.EngagementCap
+	}
+	if rules.VideoDurationWeight == 0 {
+		rules.VideoDurationWeight = fallback.VideoDurationWeight
 	}
+	if rules.CommentWeight == 0 {
+		rules.CommentWeight = fallback.CommentWeight
+	}
+	if rules.CTRWeight == 0 {
+		rules.CTRWeight = fallback.CTRWeight
+	}
+	return rules
+}
 
-	return &scoringService{
-		rules: rules,
+// Rules o an kullanılan skorlama kurallarının kopyasını döner
+func (s *scoringService) Rules() ScoringRules {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rules := s.rules
+	rules.RecencyTiers = append([]entity.RecencyTier(nil), s.rules.RecencyTiers...)
+	return rules
+}
+
+// SetRules skorlama kurallarını servis oluşturulurken verilen kuralları varsayılan alarak değiştirir
+func (s *scoringService) SetRules(rules ScoringRules) {
+	rules.RecencyTiers = append([]entity.RecencyTier(nil), rules.RecencyTiers...)
+	s.mu.Lock()
+	s.overrides = rules
+	s.rules = withFallbackRules(rules, s.defaults)
+	s.mu.Unlock()
+}
+
+// SetDefaults varsayılan kuralları değiştirir; son SetRules ile verilen kurallar yeni varsayılanlarla tekrar uygulanır
+func (s *scoringService) SetDefaults(rules ScoringRules) {
+	defaults := withDefaultRules(rules)
+	s.mu.Lock()
+	s.defaults = defaults
+	s.rules = withFallbackRules(s.overrides, defaults)
+	s.mu.Unlock()
+}
+
+// SetBoosts tag boost kurallarını değiştirir, tag'ler küçük harfe çevrilerek eşleştirilir
+func (s *scoringService) SetBoosts(boosts []entity.BoostRule) {
+	byTag := make(map[string]float64, len(boosts))
+	for _, boost := range boosts {
+		byTag[strings.ToLower(strings.TrimSpace(boost.Tag))] = boost.Percent
 	}
+	s.mu.Lock()
+	s.boosts = byTag
+	s.mu.Unlock()
 }
 
 // CalculateScore içerik için skor hesaplar
-// Formül: (BaseScore × TypeWeight) + RecencyScore + EngagementScore
+// Formül: ((BaseScore × TypeWeight) + RecencyScore + EngagementScore + CTRScore) × Boost
+// CTR sinyali content.Score.CTRLift'ten okunur (Score nil ise sinyal yok sayılır)
 func (s *scoringService) CalculateScore(content *entity.Content) (*entity.ContentScore, error) {
 	if content.Stats == nil {
 		return nil, nil
 	}
 
+	rules, boosts := s.current()
+	return calculateScore(rules, boosts, content), nil
+}
+
+// current kuralları ve boost'ları tek seferde okur; hesaplama boyunca değişseler de tutarlı kalır
+// SetRules ve SetBoosts yeni map/slice atadığı için dönen değerler değiştirilmediği sürece paylaşılabilir
+func (s *scoringService) current() (ScoringRules, map[string]float64) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.rules, s.boosts
+}
+
+// calculateScore verilen kurallarla skor hesaplar, content.Stats nil olmamalıdır
+func calculateScore(rules ScoringRules, boosts map[string]float64, content *entity.Content) *entity.ContentScore {
 	score := &entity.ContentScore{
 		ContentID:    content.ID,
 		CalculatedAt: time.Now(),
 	}
 
-	// Base score hesaplama
-	if content.ContentType == entity.ContentTypeVideo {
-		// Video için: views/1000 + likes/100
-		score.BaseScore = float64(content.Stats.Views)/1000.0 + float64(content.Stats.Likes)/100.0
-		score.TypeWeight = s.rules.VideoTypeWeight
-	} else {
-		// Makale için: reading_time + reactions/50
-		score.BaseScore = float64(content.Stats.ReadingTime) + float64(content.Stats.Reactions)/50.0
-		score.TypeWeight = s.rules.ArticleTypeWeight
-	}
+	// Base score hesaplama (formül içerik türüne göre, bkz. ContentTypeScorer)
+	scorer := scorerFor(content.ContentType)
+	score.BaseScore, _ = scorer.Base(content.Stats, rules)
+	score.TypeWeight = scorer.Weight(rules)
 
 	// Güncellik skoru hesaplama
-	score.RecencyScore = s.calculateRecencyScore(content.PublishedAt)
+	score.RecencyScore = calculateRecencyScore(rules, content.PublishedAt)
 
 	// Etkileşim skoru hesaplama
-	score.EngagementScore = s.calculateEngagementScore(content)
+	score.EngagementScore = calculateEngagementScore(rules, content)
+
+	// Tıklama oranı skoru (gecelik CTR işinin sinyali)
+	score.CTRLift = ctrLiftOf(content)
+	score.CTRScore = calculateCTRScore(rules, score.CTRLift)
+
+	// Final skor hesaplama; düşük CTR skoru negatife düşürmez
+	score.FinalScore = (score.BaseScore * score.TypeWeight) + score.RecencyScore + score.EngagementScore + score.CTRScore
+	score.FinalScore = math.Max(0, score.FinalScore)
 
-	// Final skor hesaplama
-	score.FinalScore = (score.BaseScore * score.TypeWeight) + score.RecencyScore + score.EngagementScore
+	// Tag boost'ları final skoru çarpan olarak etkiler
+	score.FinalScore *= boostFactor(boosts, content.Tags)
 
 	// Skorları 2 ondalık basamağa yuvarla
 	score.BaseScore = math.Round(score.BaseScore*100) / 100
 	score.RecencyScore = math.Round(score.RecencyScore*100) / 100
 	score.EngagementScore = math.Round(score.EngagementScore*100) / 100
+	score.CTRScore = math.Round(score.CTRScore*100) / 100
 	score.FinalScore = math.Round(score.FinalScore*100) / 100
 
-	return score, nil
+	return score
 }
 
-// calculateRecencyScore yayın tarihine göre güncellik skoru hesaplar
-// 1 hafta içinde: +5
-// 1 ay içinde: +3
-// 3 ay içinde: +1
-// Daha eski: 0
-func (s *scoringService) calculateRecencyScore(publishedAt time.Time) float64 {
-	now := time.Now()
-	duration := now.Sub(publishedAt)
-
-	switch {
-	case duration <= 7*24*time.Hour: // 1 hafta
-		return 5.0
-	case duration <= 30*24*time.Hour: // 1 ay
-		return 3.0
-	case duration <= 90*24*time.Hour: // 3 ay
-		return 1.0
-	default:
-		return 0.0
+// Explain skor bileşenlerini formülleri ve girdileriyle birlikte döner
+func (s *scoringService) Explain(content *entity.Content) *entity.ScoreExplanation {
+	if content.Stats == nil {
+		return nil
+	}
+
+	rules, boosts := s.current()
+	score := calculateScore(rules, boosts, content)
+	stats := content.Stats
+
+	explanation := &entity.ScoreExplanation{
+		Formula:    "(base × type_weight + recency + engagement + ctr) × boost",
+		FinalScore: score.FinalScore,
+	}
+
+	scorer := scorerFor(content.ContentType)
+	_, baseInputs := scorer.Base(stats, rules)
+	explanation.Base = entity.ScoreComponent{Value: score.BaseScore, Formula: scorer.BaseFormula, Inputs: baseInputs}
+	explanation.TypeWeight = entity.ScoreComponent{Value: score.TypeWeight, Formula: scorer.WeightName}
+	_, _, engagementInputs := scorer.EngagementRatio(stats, rules)
+	ratioFormula := scorer.EngagementRatioFormula
+	if _, ok := engagementInputs["comments"]; ok {
+		ratioFormula = withCommentsFormula(ratioFormula)
+	}
+	engagementInputs[scorer.MultiplierName] = scorer.Multiplier(rules)
+	explanation.Engagement = entity.ScoreComponent{
+		Value:   score.EngagementScore,
+		Formula: engagementFormula(rules, ratioFormula, scorer.MultiplierName),
+		Inputs:  engagementInputs,
+	}
+	if rules.EngagementCap > 0 {
+		explanation.Engagement.Inputs["engagement_cap"] = rules.EngagementCap
+	}
+
+	// Güncellik: içeriğin yaşı ve seçili güncellik fonksiyonunun formülü
+	age := time.Since(content.PublishedAt)
+	formula, inputs := NewRecencyDecayFunc(rules).Describe(age)
+	if inputs == nil {
+		inputs = make(map[string]float64, 1)
+	}
+	inputs["age_days"] = math.Round(age.Hours()/24*10) / 10
+	explanation.Recency = entity.ScoreComponent{
+		Value:   score.RecencyScore,
+		Formula: formula,
+		Inputs:  inputs,
+	}
+
+	// CTR: gecelik işin hesapladığı lift ve ağırlık
+	explanation.CTR = entity.ScoreComponent{
+		Value:   score.CTRScore,
+		Formula: "ctr_weight × (min(ctr_lift, 2) - 1), 0 without click data",
+		Inputs: map[string]float64{
+			"ctr_lift":   score.CTRLift,
+			"ctr_weight": rules.CTRWeight,
+		},
+	}
+
+	// Boost: eşleşen tag'ler ve yüzdeleri
+	explanation.Boost = entity.ScoreComponent{
+		Value:   boostFactor(boosts, content.Tags),
+		Formula: "product of (1 + percent / 100) for boosted tags",
 	}
+	for _, tag := range content.Tags {
+		if percent, ok := boosts[strings.ToLower(tag.Name)]; ok {
+			if explanation.Boost.Inputs == nil {
+				explanation.Boost.Inputs = make(map[string]float64)
+			}
+			explanation.Boost.Inputs[tag.Name] = percent
+		}
+	}
+
+	// Sıralamada kullanılan kayıtlı skor
+	if content.Score != nil {
+		stored := content.Score.FinalScore
+		calculatedAt := content.Score.CalculatedAt
+		explanation.StoredFinalScore = &stored
+		explanation.CalculatedAt = &calculatedAt
+	}
+
+	return explanation
 }
 
-// calculateEngagementScore içerik türüne göre etkileşim skoru hesaplar
-// Video için: (likes/views) × 10
-// Makale için: (reactions/reading_time) × 5
-func (s *scoringService) calculateEngagementScore(content *entity.Content) float64 {
+// calculateRecencyScore yayın tarihine göre güncellik skoru hesaplar
+// Varsayılan kademeli fonksiyonda içeriğin yaşına uyan ilk kademenin skoru döner
+// (1 hafta +5, 1 ay +3, 3 ay +1), hiçbir kademeye girmeyen içerikler 0 alır
+func calculateRecencyScore(rules ScoringRules, publishedAt time.Time) float64 {
+	return NewRecencyDecayFunc(rules).Score(time.Since(publishedAt))
+}
+
+// calculateEngagementScore içerik türünün etkileşim oranıyla etkileşim skoru hesaplar
+// Video için: (likes/views) × VideoEngagementMultiplier
+// Makale için: (reactions/reading_time) × ArticleEngagementMultiplier
+// Oran EngagementScaling'e göre ölçeklenir, sonuç EngagementCap ile sınırlanır
+func calculateEngagementScore(rules ScoringRules, content *entity.Content) float64 {
 	if content.Stats == nil {
 		return 0.0
 	}
 
-	if content.ContentType == entity.ContentTypeVideo {
-		// Video için etkileşim
-		if content.Stats.Views == 0 {
-			return 0.0
-		}
-		return (float64(content.Stats.Likes) / float64(content.Stats.Views)) * 10.0
-	} else {
-		// Makale için etkileşim
-		if content.Stats.ReadingTime == 0 {
-			return 0.0
+	scorer := scorerFor(content.ContentType)
+	ratio, ok, _ := scorer.EngagementRatio(content.Stats, rules)
+	if !ok {
+		return 0.0
+	}
+	return scaleEngagement(rules, ratio, scorer.Multiplier(rules))
+}
+
+// scaleEngagement etkileşim oranını ölçekleyip çarpanla çarpar ve üst sınırı uygular
+// Makalelerde reactions/reading_time oranı sınırsız büyüyebildiği için tek başına final skoru domine edebilir
+func scaleEngagement(rules ScoringRules, ratio, multiplier float64) float64 {
+	if rules.EngagementScaling == entity.EngagementScalingLog {
+		ratio = math.Log1p(ratio)
+	}
+	score := ratio * multiplier
+	if rules.EngagementCap > 0 {
+		score = math.Min(score, rules.EngagementCap)
+	}
+	return score
+}
+
+// maxCTRLift CTR skorunda kullanılan en yüksek lift; CTR skoru -ctr_weight ile +ctr_weight arasında kalır
+const maxCTRLift = 2.0
+
+// ctrLiftOf içeriğin kayıtlı CTR sinyalini döner (skoru yoksa 0)
+func ctrLiftOf(content *entity.Content) float64 {
+	if content.Score == nil {
+		return 0
+	}
+	return content.Score.CTRLift
+}
+
+// calculateCTRScore CTR lift'inden skor bileşenini hesaplar
+// Lift 1 (ortalama CTR) 0 puan, 0'a yakın lift -ctr_weight, 2 ve üstü +ctr_weight verir.
+// Lift 0 yeterli gösterimi olmayan içerik demektir ve skoru etkilemez
+func calculateCTRScore(rules ScoringRules, lift float64) float64 {
+	if lift <= 0 || rules.CTRWeight <= 0 {
+		return 0
+	}
+	return rules.CTRWeight * (math.Min(lift, maxCTRLift) - 1)
+}
+
+// IsValidEngagementScaling ölçekleme adının desteklenip desteklenmediğini döner
+func IsValidEngagementScaling(scaling entity.EngagementScaling) bool {
+	return scaling == entity.EngagementScalingLinear || scaling == entity.EngagementScalingLog
+}
+
+// engagementFormula skor açıklaması için etkileşim formülünü kurallara göre oluşturur
+func engagementFormula(rules ScoringRules, ratio, multiplier string) string {
+	formula := "(" + ratio + ") × " + multiplier
+	if rules.EngagementScaling == entity.EngagementScalingLog {
+		formula = "ln(1 + " + ratio + ") × " + multiplier
+	}
+	if rules.EngagementCap > 0 {
+		formula = "min(" + formula + ", engagement_cap)"
+	}
+	return formula
+}
+
+// withCommentsFormula oran formülünün payına ağırlıklı yorumları ekler ("likes / views" -> "(likes + comments × comment_weight) / views")
+func withCommentsFormula(ratio string) string {
+	numerator, denominator, ok := strings.Cut(ratio, " / ")
+	if !ok {
+		return ratio
+	}
+	return "(" + numerator + " + comments × comment_weight) / " + denominator
+}
+
+// boostFactor içeriğin tag'leriyle eşleşen boost'ların çarpanını döner (eşleşme yoksa 1)
+// -100% ve altı içeriği 0'a indirir, skor negatife düşmez
+func boostFactor(boosts map[string]float64, tags []entity.Tag) float64 {
+	factor := 1.0
+	for _, tag := range tags {
+		if percent, ok := boosts[strings.ToLower(tag.Name)]; ok {
+			factor *= math.Max(0, 1+percent/100)
 		}
-		return (float64(content.Stats.Reactions) / float64(content.Stats.ReadingTime)) * 5.0
 	}
+	return factor
 }
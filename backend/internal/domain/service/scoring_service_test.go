@@ -6,6 +6,7 @@ import (
 
 	"github.com/onurerdog4n/search-engine/internal/domain/entity"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestScoringService_CalculateScore(t *testing.T) {
@@ -138,3 +139,394 @@ func TestScoringService_RecencyScore(t *testing.T) {
 		})
 	}
 }
+
+func TestScoringService_SetRules(t *testing.T) {
+	service := NewScoringService(ScoringRules{})
+	content := &entity.Content{
+		ContentType: entity.ContentTypeVideo,
+		Stats:       &entity.ContentStats{Views: 1000, Likes: 100},
+		PublishedAt: time.Now().Add(-20 * 24 * time.Hour),
+	}
+
+	t.Run("Should apply defaults", func(t *testing.T) {
+		rules := service.Rules()
+		assert.Equal(t, 1.5, rules.VideoTypeWeight)
+		assert.Len(t, rules.RecencyTiers, 3)
+		assert.Equal(t, 10.0, rules.VideoEngagementMultiplier)
+	})
+
+	t.Run("Should use new rules after SetRules", func(t *testing.T) {
+		service.SetRules(ScoringRules{
+			VideoTypeWeight:           2.0,
+			RecencyTiers:              []entity.RecencyTier{{MaxAgeDays: 30, Score: 8}},
+			VideoEngagementMultiplier: 20,
+		})
+
+		score, err := service.CalculateScore(content)
+		assert.NoError(t, err)
+		// Base: 1000/1000 + 100/100 = 2, Recency: 8, Engagement: (100/1000) * 20 = 2
+		assert.Equal(t, 2.0, score.TypeWeight)
+		assert.Equal(t, 8.0, score.RecencyScore)
+		assert.Equal(t, 2.0, score.EngagementScore)
+		assert.Equal(t, 14.0, score.FinalScore)
+
+		// Belirtilmeyen alanlar varsayılana döner
+		assert.Equal(t, 5.0, service.Rules().ArticleEngagementMultiplier)
+	})
+
+	t.Run("Should not share tiers with caller", func(t *testing.T) {
+		tiers := []entity.RecencyTier{{MaxAgeDays: 30, Score: 8}}
+		service.SetRules(ScoringRules{RecencyTiers: tiers})
+		tiers[0].Score = 100
+
+		rules := service.Rules()
+		rules.RecencyTiers[0].Score = 50
+		assert.Equal(t, 8.0, service.Rules().RecencyTiers[0].Score)
+	})
+}
+
+func TestScoringService_SetDefaults(t *testing.T) {
+	service := NewScoringService(ScoringRules{CTRWeight: 2})
+	service.SetRules(ScoringRules{VideoTypeWeight: 3})
+
+	// Config yeniden yüklendiğinde kayıtlı kurallar korunur, boş alanlar yeni varsayılanları alır
+	service.SetDefaults(ScoringRules{CTRWeight: 4, RecencyDecay: entity.RecencyDecayExponential})
+
+	rules := service.Rules()
+	assert.Equal(t, 3.0, rules.VideoTypeWeight)
+	assert.Equal(t, 4.0, rules.CTRWeight)
+	assert.Equal(t, entity.RecencyDecayExponential, rules.RecencyDecay)
+	assert.Equal(t, 1.0, rules.ArticleTypeWeight)
+
+	// Sonraki SetRules da yeni varsayılanları kullanır
+	service.SetRules(ScoringRules{})
+	assert.Equal(t, 4.0, service.Rules().CTRWeight)
+	assert.Equal(t, 1.5, service.Rules().VideoTypeWeight)
+}
+
+func TestScoringService_Explain(t *testing.T) {
+	service := NewScoringService(ScoringRules{})
+
+	t.Run("Should return nil without stats", func(t *testing.T) {
+		assert.Nil(t, service.Explain(&entity.Content{ContentType: entity.ContentTypeVideo}))
+	})
+
+	t.Run("Should explain video score", func(t *testing.T) {
+		content := &entity.Content{
+			ContentType: entity.ContentTypeVideo,
+			PublishedAt: time.Now().Add(-3 * 24 * time.Hour),
+			Stats:       &entity.ContentStats{Views: 10000, Likes: 500},
+		}
+
+		explanation := service.Explain(content)
+		score, _ := service.CalculateScore(content)
+		assert.Equal(t, score.FinalScore, explanation.FinalScore)
+		// Base: 10 + 5 = 15, Weight: 1.5, Recency: 5, Engagement: 0.05 * 10 = 0.5
+		assert.Equal(t, 15.0, explanation.Base.Value)
+		assert.Equal(t, 500.0, explanation.Base.Inputs["likes"])
+		assert.Equal(t, 1.5, explanation.TypeWeight.Value)
+		assert.Equal(t, "age_days <= 7", explanation.Recency.Formula)
+		assert.Equal(t, 3.0, explanation.Recency.Inputs["age_days"])
+		assert.Equal(t, 10.0, explanation.Engagement.Inputs["video_engagement_multiplier"])
+		assert.Equal(t, 28.0, explanation.FinalScore)
+		assert.Nil(t, explanation.StoredFinalScore)
+	})
+
+	t.Run("Should explain old article with stored score", func(t *testing.T) {
+		content := &entity.Content{
+			ContentType: entity.ContentTypeArticle,
+			PublishedAt: time.Now().Add(-200 * 24 * time.Hour),
+			Stats:       &entity.ContentStats{ReadingTime: 10, Reactions: 50},
+			Score:       &entity.ContentScore{FinalScore: 14},
+		}
+
+		explanation := service.Explain(content)
+		assert.Equal(t, "reading_time + reactions / 50", explanation.Base.Formula)
+		assert.Equal(t, "article_type_weight", explanation.TypeWeight.Formula)
+		assert.Zero(t, explanation.Recency.Value)
+		assert.NotContains(t, explanation.Recency.Inputs, "max_age_days")
+		require.NotNil(t, explanation.StoredFinalScore)
+		assert.Equal(t, 14.0, *explanation.StoredFinalScore)
+	})
+}
+
+func TestScoringService_Boosts(t *testing.T) {
+	service := NewScoringService(ScoringRules{})
+	service.SetBoosts([]entity.BoostRule{
+		{Tag: "GoLang", Percent: 20},
+		{Tag: "clickbait", Percent: -50},
+		{Tag: "spam", Percent: -100},
+	})
+	newContent := func(tags ...string) *entity.Content {
+		content := &entity.Content{
+			ContentType: entity.ContentTypeArticle,
+			PublishedAt: time.Now().Add(-365 * 24 * time.Hour),
+			Stats:       &entity.ContentStats{ReadingTime: 10},
+		}
+		for _, tag := range tags {
+			content.Tags = append(content.Tags, entity.Tag{Name: tag})
+		}
+		return content
+	}
+
+	tests := []struct {
+		name     string
+		tags     []string
+		expected float64
+	}{
+		{"Should not change unboosted content", []string{"python"}, 10},
+		{"Should boost matching tag case-insensitively", []string{"golang"}, 12},
+		{"Should multiply multiple boosts", []string{"golang", "clickbait"}, 6},
+		{"Should not go below zero", []string{"spam", "golang"}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, err := service.CalculateScore(newContent(tt.tags...))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, score.FinalScore)
+		})
+	}
+
+	t.Run("Should explain boost", func(t *testing.T) {
+		explanation := service.Explain(newContent("golang", "python"))
+		assert.Equal(t, 1.2, explanation.Boost.Value)
+		assert.Equal(t, map[string]float64{"golang": 20}, explanation.Boost.Inputs)
+	})
+
+	t.Run("Should replace previous boosts", func(t *testing.T) {
+		service.SetBoosts(nil)
+		score, err := service.CalculateScore(newContent("golang"))
+		require.NoError(t, err)
+		assert.Equal(t, 10.0, score.FinalScore)
+	})
+}
+
+func TestScoringService_EngagementNormalization(t *testing.T) {
+	// Makale: reactions/reading_time = 100/5 = 20, linear: 20 × 5 = 100
+	article := &entity.Content{
+		ContentType: entity.ContentTypeArticle,
+		PublishedAt: time.Now().Add(-365 * 24 * time.Hour),
+		Stats:       &entity.ContentStats{ReadingTime: 5, Reactions: 100},
+	}
+	// Video: likes/views = 0.05, linear: 0.05 × 10 = 0.5
+	video := &entity.Content{
+		ContentType: entity.ContentTypeVideo,
+		PublishedAt: time.Now().Add(-365 * 24 * time.Hour),
+		Stats:       &entity.ContentStats{Views: 10000, Likes: 500},
+	}
+
+	tests := []struct {
+		name            string
+		rules           ScoringRules
+		expectedArticle float64
+		expectedVideo   float64
+		formula         string
+	}{
+		{"Should keep linear scaling by default", ScoringRules{}, 100, 0.5, "(reactions / reading_time) × article_engagement_multiplier"},
+		{"Should compress large ratios with log scaling", ScoringRules{EngagementScaling: entity.EngagementScalingLog}, 15.22, 0.49, "ln(1 + reactions / reading_time) × article_engagement_multiplier"},
+		{"Should cap engagement score", ScoringRules{EngagementCap: 10}, 10, 0.5, "min((reactions / reading_time) × article_engagement_multiplier, engagement_cap)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewScoringService(tt.rules)
+
+			score, err := service.CalculateScore(article)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedArticle, score.EngagementScore)
+
+			score, err = service.CalculateScore(video)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedVideo, score.EngagementScore)
+
+			assert.Equal(t, tt.formula, service.Explain(article).Engagement.Formula)
+		})
+	}
+}
+
+func TestScoringService_ContentTypes(t *testing.T) {
+	service := NewScoringService(ScoringRules{})
+	old := time.Now().Add(-200 * 24 * time.Hour) // Güncellik skoru 0
+
+	tests := []struct {
+		name        string
+		contentType entity.ContentType
+		stats       entity.ContentStats
+		base        float64
+		weight      float64
+		final       float64
+	}{
+		// 10 + 5 = 15, × 1.2 = 18, etkileşim 0.05 × 10 = 0.5
+		{"podcast", entity.ContentTypePodcast, entity.ContentStats{Views: 10000, Likes: 500}, 15, 1.2, 18.5},
+		// 2 + 2 = 4, × 1.3 = 5.2, etkileşim 0.05 × 20 = 1
+		{"course", entity.ContentTypeCourse, entity.ContentStats{Views: 2000, Reactions: 100}, 4, 1.3, 6.2},
+		// 1 + 0.1 = 1.1, × 0.8 = 0.88, etkileşim 0.01 × 5 = 0.05
+		{"image", entity.ContentTypeImage, entity.ContentStats{Views: 1000, Likes: 10}, 1.1, 0.8, 0.93},
+		// Formülü kayıtlı olmayan türler makale gibi skorlanır: 10 + 1 = 11, etkileşim 5 × 5 = 25
+		{"unregistered", entity.ContentType("hologram"), entity.ContentStats{ReadingTime: 10, Reactions: 50}, 11, 1.0, 36},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats := tt.stats
+			score, err := service.CalculateScore(&entity.Content{ContentType: tt.contentType, PublishedAt: old, Stats: &stats})
+			require.NoError(t, err)
+			assert.Equal(t, tt.base, score.BaseScore)
+			assert.Equal(t, tt.weight, score.TypeWeight)
+			assert.Equal(t, tt.final, score.FinalScore)
+		})
+	}
+
+	t.Run("Should register custom type with its own formula", func(t *testing.T) {
+		livestream := entity.ContentType("livestream")
+		assert.False(t, entity.IsValidContentType(livestream))
+
+		RegisterContentType(livestream, ContentTypeScorer{
+			BaseFormula: "views / 100",
+			Base: func(stats *entity.ContentStats, _ ScoringRules) (float64, map[string]float64) {
+				return float64(stats.Views) / 100, map[string]float64{"views": float64(stats.Views)}
+			},
+			WeightName:             "livestream_type_weight",
+			Weight:                 fixedRule(2),
+			EngagementRatioFormula: "0",
+			EngagementRatio: func(*entity.ContentStats, ScoringRules) (float64, bool, map[string]float64) {
+				return 0, false, map[string]float64{}
+			},
+			MultiplierName: "livestream_engagement_multiplier",
+			Multiplier:     fixedRule(1),
+		})
+		assert.True(t, entity.IsValidContentType(livestream))
+
+		content := &entity.Content{ContentType: livestream, PublishedAt: old, Stats: &entity.ContentStats{Views: 500}}
+		explanation := service.Explain(content)
+		assert.Equal(t, 10.0, explanation.FinalScore) // 5 × 2
+		assert.Equal(t, "views / 100", explanation.Base.Formula)
+		assert.Equal(t, "livestream_type_weight", explanation.TypeWeight.Formula)
+		assert.Equal(t, 1.0, explanation.Engagement.Inputs["livestream_engagement_multiplier"])
+	})
+}
+
+func TestScoringService_VideoDuration(t *testing.T) {
+	content := &entity.Content{
+		ContentType: entity.ContentTypeVideo,
+		PublishedAt: time.Now().Add(-200 * 24 * time.Hour),
+		Stats:       &entity.ContentStats{Views: 10000, Likes: 500, DurationSeconds: 1200},
+	}
+
+	t.Run("Should ignore duration by default", func(t *testing.T) {
+		score, _ := NewScoringService(ScoringRules{}).CalculateScore(content)
+		assert.Equal(t, 15.0, score.BaseScore)
+	})
+
+	t.Run("Should add weighted duration minutes to video base", func(t *testing.T) {
+		service := NewScoringService(ScoringRules{VideoDurationWeight: 0.5})
+
+		// 10 + 5 + 20 dk × 0.5 = 25
+		score, _ := service.CalculateScore(content)
+		assert.Equal(t, 25.0, score.BaseScore)
+
+		explanation := service.Explain(content)
+		assert.Equal(t, 20.0, explanation.Base.Inputs["duration_minutes"])
+		assert.Equal(t, 0.5, explanation.Base.Inputs["video_duration_weight"])
+
+		// Makalelerde süre kullanılmaz
+		article := &entity.Content{
+			ContentType: entity.ContentTypeArticle,
+			PublishedAt: content.PublishedAt,
+			Stats:       &entity.ContentStats{ReadingTime: 10, DurationSeconds: 1200},
+		}
+		score, _ = service.CalculateScore(article)
+		assert.Equal(t, 10.0, score.BaseScore)
+	})
+}
+
+func TestScoringService_CommentWeight(t *testing.T) {
+	content := &entity.Content{
+		ContentType: entity.ContentTypeVideo,
+		PublishedAt: time.Now().Add(-200 * 24 * time.Hour),
+		Stats:       &entity.ContentStats{Views: 1000, Likes: 50, Comments: 25},
+	}
+
+	t.Run("Should ignore comments by default", func(t *testing.T) {
+		score, _ := NewScoringService(ScoringRules{}).CalculateScore(content)
+		// (50 / 1000) × 10
+		assert.InDelta(t, 0.5, score.EngagementScore, 1e-9)
+	})
+
+	t.Run("Should count weighted comments as engagement", func(t *testing.T) {
+		service := NewScoringService(ScoringRules{CommentWeight: 2})
+
+		// ((50 + 25 × 2) / 1000) × 10
+		score, _ := service.CalculateScore(content)
+		assert.InDelta(t, 1.0, score.EngagementScore, 1e-9)
+
+		explanation := service.Explain(content)
+		assert.Equal(t, "((likes + comments × comment_weight) / views) × video_engagement_multiplier", explanation.Engagement.Formula)
+		assert.Equal(t, 25.0, explanation.Engagement.Inputs["comments"])
+		assert.Equal(t, 2.0, explanation.Engagement.Inputs["comment_weight"])
+
+		// Makalelerde yorumlar tepkilere eklenir: ((10 + 25 × 2) / 5) × 5
+		article := &entity.Content{
+			ContentType: entity.ContentTypeArticle,
+			PublishedAt: content.PublishedAt,
+			Stats:       &entity.ContentStats{ReadingTime: 5, Reactions: 10, Comments: 25},
+		}
+		score, _ = service.CalculateScore(article)
+		assert.InDelta(t, 60.0, score.EngagementScore, 1e-9)
+	})
+}
+
+func TestScoringService_CTR(t *testing.T) {
+	newContent := func(lift float64) *entity.Content {
+		return &entity.Content{
+			ContentType: entity.ContentTypeArticle,
+			PublishedAt: time.Now().Add(-200 * 24 * time.Hour),
+			Stats:       &entity.ContentStats{ReadingTime: 4},
+			Score:       &entity.ContentScore{CTRLift: lift},
+		}
+	}
+
+	t.Run("Should ignore CTR by default", func(t *testing.T) {
+		score, _ := NewScoringService(ScoringRules{}).CalculateScore(newContent(1.8))
+		assert.Zero(t, score.CTRScore)
+		assert.Equal(t, 4.0, score.FinalScore)
+	})
+
+	t.Run("Should add weighted CTR lift", func(t *testing.T) {
+		service := NewScoringService(ScoringRules{CTRWeight: 3})
+
+		// 3 × (1.5 - 1)
+		score, _ := service.CalculateScore(newContent(1.5))
+		assert.InDelta(t, 1.5, score.CTRScore, 1e-9)
+		assert.InDelta(t, 5.5, score.FinalScore, 1e-9)
+		assert.Equal(t, 1.5, score.CTRLift)
+
+		// Lift 2 ile sınırlı
+		score, _ = service.CalculateScore(newContent(10))
+		assert.InDelta(t, 3.0, score.CTRScore, 1e-9)
+
+		// Ortalamanın altı cezalandırılır, final skor negatife düşmez
+		score, _ = service.CalculateScore(newContent(0.0001))
+		assert.InDelta(t, -3.0, score.CTRScore, 1e-9)
+		assert.Equal(t, 1.0, score.FinalScore)
+		score, _ = NewScoringService(ScoringRules{CTRWeight: 10}).CalculateScore(newContent(0.0001))
+		assert.Zero(t, score.FinalScore)
+
+		// Sinyali olmayan içerik etkilenmez
+		content := newContent(0)
+		content.Score = nil
+		score, _ = service.CalculateScore(content)
+		assert.Zero(t, score.CTRScore)
+		assert.Equal(t, 4.0, score.FinalScore)
+	})
+
+	t.Run("Should explain CTR component", func(t *testing.T) {
+		explanation := NewScoringService(ScoringRules{CTRWeight: 2}).Explain(newContent(1.25))
+		assert.Equal(t, "(base × type_weight + recency + engagement + ctr) × boost", explanation.Formula)
+		assert.InDelta(t, 0.5, explanation.CTR.Value, 1e-9)
+		assert.Equal(t, 1.25, explanation.CTR.Inputs["ctr_lift"])
+		assert.Equal(t, 2.0, explanation.CTR.Inputs["ctr_weight"])
+		assert.InDelta(t, 4.5, explanation.FinalScore, 1e-9)
+	})
+}
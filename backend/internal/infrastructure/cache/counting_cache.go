@@ -0,0 +1,67 @@
+package cache
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/metrics"
+)
+
+// CountingCache okumaların isabet oranını sayan CacheRepository sarmalayıcısı
+// Sayılar süreç başlangıcından beri tutulur ve Prometheus cache sayaçlarına da yazılır;
+// hata dönen her okuma (backend hataları dahil) miss sayılır, çünkü çağıran kaynağa gider
+type CountingCache struct {
+	next   port.CacheRepository
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+// NewCountingCache next'i saran ve okumalarını sayan bir cache oluşturur
+func NewCountingCache(next port.CacheRepository) *CountingCache {
+	return &CountingCache{next: next}
+}
+
+// CacheStats şu ana kadarki isabet ve ıskalama sayılarını döner
+func (c *CountingCache) CacheStats() entity.CacheStats {
+	stats := entity.CacheStats{Hits: c.hits.Load(), Misses: c.misses.Load()}
+	if total := stats.Hits + stats.Misses; total > 0 {
+		stats.HitRatio = float64(stats.Hits) / float64(total)
+	}
+	return stats
+}
+
+// Get okumayı isabet veya ıskalama olarak sayar
+func (c *CountingCache) Get(ctx context.Context, key string) ([]byte, error) {
+	value, err := c.next.Get(ctx, key)
+	if err == nil {
+		c.hits.Add(1)
+		metrics.RecordCacheHit()
+	} else {
+		c.misses.Add(1)
+		metrics.RecordCacheMiss()
+	}
+	return value, err
+}
+
+// Set next'e yazar
+func (c *CountingCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return c.next.Set(ctx, key, value, ttl)
+}
+
+// Delete next'ten siler
+func (c *CountingCache) Delete(ctx context.Context, key string) error {
+	return c.next.Delete(ctx, key)
+}
+
+// InvalidatePattern next'te desenle siler
+func (c *CountingCache) InvalidatePattern(ctx context.Context, pattern string) error {
+	return c.next.InvalidatePattern(ctx, pattern)
+}
+
+// Clear next'i temizler
+func (c *CountingCache) Clear(ctx context.Context) error {
+	return c.next.Clear(ctx)
+}
@@ -0,0 +1,36 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountingCache(t *testing.T) {
+	ctx := context.Background()
+	l2 := newFakeL2()
+	c := NewCountingCache(l2)
+
+	assert.Zero(t, c.CacheStats().HitRatio)
+
+	require.NoError(t, c.Set(ctx, "search:a", []byte("1"), time.Minute))
+	_, err := c.Get(ctx, "search:a")
+	require.NoError(t, err)
+	_, err = c.Get(ctx, "search:a")
+	require.NoError(t, err)
+	_, err = c.Get(ctx, "search:b")
+	require.Error(t, err)
+
+	// Backend hatası da miss sayılır
+	l2.down = true
+	_, err = c.Get(ctx, "search:a")
+	require.Error(t, err)
+
+	stats := c.CacheStats()
+	assert.Equal(t, int64(2), stats.Hits)
+	assert.Equal(t, int64(2), stats.Misses)
+	assert.Equal(t, 0.5, stats.HitRatio)
+}
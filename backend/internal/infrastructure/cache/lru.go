@@ -0,0 +1,116 @@
+package cache
+
+import (
+	"container/list"
+	"path"
+	"sync"
+	"time"
+)
+
+// lruStore kayıt başına son kullanma süresi olan, boyutu sınırlı ve eşzamanlı kullanıma uygun LRU
+// Süresi dolan kayıtlar hemen silinmez; kapasite dolunca veya üzerine yazılınca çıkarılır
+type lruStore struct {
+	size    int
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List // Ön: en son kullanılan, arka: en eski
+	now     func() time.Time
+}
+
+// lruEntry LRU'da tutulan tek bir kayıt
+type lruEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time // Sıfır ise kayıt süresizdir
+}
+
+// newLRUStore en fazla size kayıt tutan bir LRU oluşturur
+func newLRUStore(size int) *lruStore {
+	return &lruStore{
+		size:    size,
+		entries: make(map[string]*list.Element, size),
+		order:   list.New(),
+		now:     time.Now,
+	}
+}
+
+// get kayıttaki değeri döner; fresh süresi dolmamış, found kaydın var olduğunu belirtir
+// Süresi dolmuş kayıtların değeri de döner (çağıran eski değeri kullanıp kullanmayacağına karar verir)
+func (s *lruStore) get(key string) (value []byte, fresh bool, found bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	elem, ok := s.entries[key]
+	if !ok {
+		return nil, false, false
+	}
+	entry := elem.Value.(*lruEntry)
+	if !entry.expiresAt.IsZero() && s.now().After(entry.expiresAt) {
+		return entry.value, false, true
+	}
+
+	s.order.MoveToFront(elem)
+	return entry.value, true, true
+}
+
+// set değeri yazar, kapasite aşılırsa en az kullanılan kaydı çıkarır
+// ttl 0 veya negatif ise kayıt süresizdir (sadece kapasite dolunca çıkarılır)
+func (s *lruStore) set(key string, value []byte, ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = s.now().Add(ttl)
+	}
+	if elem, ok := s.entries[key]; ok {
+		entry := elem.Value.(*lruEntry)
+		entry.value = value
+		entry.expiresAt = expiresAt
+		s.order.MoveToFront(elem)
+		return
+	}
+
+	s.entries[key] = s.order.PushFront(&lruEntry{key: key, value: value, expiresAt: expiresAt})
+	for s.order.Len() > s.size {
+		s.remove(s.order.Back())
+	}
+}
+
+// delete kaydı siler
+func (s *lruStore) delete(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if elem, ok := s.entries[key]; ok {
+		s.remove(elem)
+	}
+}
+
+// deleteMatching glob desenine uyan kayıtları siler
+// Eşleşme path.Match ile yapılır (cache key'lerinde "/" kullanılmaz)
+func (s *lruStore) deleteMatching(pattern string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, elem := range s.entries {
+		if matched, _ := path.Match(pattern, key); matched {
+			s.remove(elem)
+		}
+	}
+}
+
+// clear tüm kayıtları siler
+func (s *lruStore) clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries = make(map[string]*list.Element, s.size)
+	s.order.Init()
+}
+
+// remove kaydı LRU'dan çıkarır (s.mu tutulurken çağrılmalıdır)
+func (s *lruStore) remove(elem *list.Element) {
+	s.order.Remove(elem)
+	delete(s.entries, elem.Value.(*lruEntry).key)
+}
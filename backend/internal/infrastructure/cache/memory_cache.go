@@ -0,0 +1,52 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// memoryCache sadece süreç içi LRU ile CacheRepository implementasyonu
+// Geliştirme, testler ve tek instance'lı kurulumlar için; instance'lar arasında paylaşılmaz
+type memoryCache struct {
+	store *lruStore
+}
+
+// NewMemoryCache en fazla size kayıt tutan bir bellek içi cache oluşturur
+func NewMemoryCache(size int) port.CacheRepository {
+	return &memoryCache{store: newLRUStore(size)}
+}
+
+// Get cache'den veri okur, süresi dolmuş kayıtlar için ErrCacheMiss döner
+func (c *memoryCache) Get(ctx context.Context, key string) ([]byte, error) {
+	value, fresh, _ := c.store.get(key)
+	if !fresh {
+		return nil, port.ErrCacheMiss
+	}
+	return value, nil
+}
+
+// Set cache'e veri yazar
+func (c *memoryCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	c.store.set(key, value, ttl)
+	return nil
+}
+
+// Delete cache'den veri siler
+func (c *memoryCache) Delete(ctx context.Context, key string) error {
+	c.store.delete(key)
+	return nil
+}
+
+// InvalidatePattern desene uyan key'leri siler
+func (c *memoryCache) InvalidatePattern(ctx context.Context, pattern string) error {
+	c.store.deleteMatching(pattern)
+	return nil
+}
+
+// Clear tüm cache'i temizler
+func (c *memoryCache) Clear(ctx context.Context) error {
+	c.store.clear()
+	return nil
+}
@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+func TestMemoryCache(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("set, get and expire", func(t *testing.T) {
+		now := time.Now()
+		c := NewMemoryCache(10).(*memoryCache)
+		c.store.now = func() time.Time { return now }
+
+		require.NoError(t, c.Set(ctx, "search:a", []byte("a"), time.Minute))
+		require.NoError(t, c.Set(ctx, "search:b", []byte("b"), 0))
+
+		value, err := c.Get(ctx, "search:a")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("a"), value)
+
+		now = now.Add(2 * time.Minute)
+		_, err = c.Get(ctx, "search:a")
+		assert.ErrorIs(t, err, port.ErrCacheMiss)
+
+		// TTL'siz kayıtlar süresizdir
+		value, err = c.Get(ctx, "search:b")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("b"), value)
+	})
+
+	t.Run("delete, invalidate and clear", func(t *testing.T) {
+		c := NewMemoryCache(10)
+
+		require.NoError(t, c.Set(ctx, "search:a", []byte("a"), time.Minute))
+		require.NoError(t, c.Set(ctx, "search:b", []byte("b"), time.Minute))
+		require.NoError(t, c.Set(ctx, "similar:1:5", []byte("s"), time.Minute))
+		require.NoError(t, c.Set(ctx, "other", []byte("o"), time.Minute))
+
+		require.NoError(t, c.Delete(ctx, "search:a"))
+		_, err := c.Get(ctx, "search:a")
+		assert.ErrorIs(t, err, port.ErrCacheMiss)
+
+		require.NoError(t, c.InvalidatePattern(ctx, "search:*"))
+		_, err = c.Get(ctx, "search:b")
+		assert.ErrorIs(t, err, port.ErrCacheMiss)
+		_, err = c.Get(ctx, "similar:1:5")
+		assert.NoError(t, err)
+
+		require.NoError(t, c.Clear(ctx))
+		_, err = c.Get(ctx, "other")
+		assert.ErrorIs(t, err, port.ErrCacheMiss)
+	})
+}
+
+func TestNoopCache(t *testing.T) {
+	ctx := context.Background()
+	c := NewNoopCache()
+
+	require.NoError(t, c.Set(ctx, "search:a", []byte("a"), time.Minute))
+	_, err := c.Get(ctx, "search:a")
+	assert.ErrorIs(t, err, port.ErrCacheMiss)
+	assert.NoError(t, c.InvalidatePattern(ctx, "search:*"))
+	assert.NoError(t, c.Clear(ctx))
+}
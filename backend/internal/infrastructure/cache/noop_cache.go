@@ -0,0 +1,42 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// noopCache hiçbir şey saklamayan CacheRepository implementasyonu
+// Cache'i tamamen kapatmak için; her okuma ErrCacheMiss döner ve istekler doğrudan veritabanına gider
+type noopCache struct{}
+
+// NewNoopCache yeni bir no-op cache oluşturur
+func NewNoopCache() port.CacheRepository {
+	return noopCache{}
+}
+
+// Get her zaman ErrCacheMiss döner
+func (noopCache) Get(ctx context.Context, key string) ([]byte, error) {
+	return nil, port.ErrCacheMiss
+}
+
+// Set veriyi yok sayar
+func (noopCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return nil
+}
+
+// Delete hiçbir şey yapmaz
+func (noopCache) Delete(ctx context.Context, key string) error {
+	return nil
+}
+
+// InvalidatePattern hiçbir şey yapmaz
+func (noopCache) InvalidatePattern(ctx context.Context, pattern string) error {
+	return nil
+}
+
+// Clear hiçbir şey yapmaz
+func (noopCache) Clear(ctx context.Context) error {
+	return nil
+}
@@ -37,6 +37,34 @@ func (c *redisCache) Delete(ctx context.Context, key string) error {
 	return c.client.Del(ctx, key).Err()
 }
 
+// invalidateBatchSize SCAN ile okunup tek DEL komutunda silinecek key sayısı
+const invalidateBatchSize = 500
+
+// InvalidatePattern desene uyan key'leri siler
+// KEYS yerine SCAN kullanılır, böylece büyük veritabanlarında Redis bloklanmaz
+func (c *redisCache) InvalidatePattern(ctx context.Context, pattern string) error {
+	iter := c.client.Scan(ctx, 0, pattern, invalidateBatchSize).Iterator()
+
+	keys := make([]string, 0, invalidateBatchSize)
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+		if len(keys) >= invalidateBatchSize {
+			if err := c.client.Del(ctx, keys...).Err(); err != nil {
+				return err
+			}
+			keys = keys[:0]
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return err
+	}
+
+	if len(keys) > 0 {
+		return c.client.Del(ctx, keys...).Err()
+	}
+	return nil
+}
+
 // Clear tüm cache'i temizler
 func (c *redisCache) Clear(ctx context.Context) error {
 	return c.client.FlushDB(ctx).Err()
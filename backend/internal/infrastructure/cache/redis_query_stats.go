@@ -0,0 +1,66 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// Sorgu istatistikleri günlük sorted set'lerde tutulur (member: sorgu, score: aranma sayısı)
+// Key'ler arama cache önekinden (search:) farklıdır, böylece cache invalidation istatistikleri silmez
+const (
+	queryStatsKeyPrefix = "stats:queries:"
+	queryStatsTopKey    = queryStatsKeyPrefix + "top"
+	queryStatsDays      = 2 // Bugün ve dün; "son dönem" penceresi
+	queryStatsTopTTL    = time.Minute
+)
+
+// redisQueryStats Redis ile QueryStatsRepository implementasyonu
+type redisQueryStats struct {
+	client *redis.Client
+}
+
+// NewRedisQueryStats yeni bir Redis sorgu istatistikleri repository oluşturur
+func NewRedisQueryStats(client *redis.Client) port.QueryStatsRepository {
+	return &redisQueryStats{client: client}
+}
+
+// RecordQuery bugünün sayacını artırır; eski günler pencere dışına çıkınca kendiliğinden silinir
+func (s *redisQueryStats) RecordQuery(ctx context.Context, query string) error {
+	key := dayKey(time.Now())
+
+	pipe := s.client.TxPipeline()
+	pipe.ZIncrBy(ctx, key, 1, query)
+	pipe.Expire(ctx, key, queryStatsDays*24*time.Hour)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// TopQueries pencere içindeki günlük sayaçları birleştirip en çok arananları döner
+func (s *redisQueryStats) TopQueries(ctx context.Context, limit int) ([]string, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	keys := make([]string, queryStatsDays)
+	for i := range keys {
+		keys[i] = dayKey(time.Now().AddDate(0, 0, -i))
+	}
+
+	pipe := s.client.TxPipeline()
+	pipe.ZUnionStore(ctx, queryStatsTopKey, &redis.ZStore{Keys: keys, Aggregate: "SUM"})
+	pipe.Expire(ctx, queryStatsTopKey, queryStatsTopTTL)
+	top := pipe.ZRevRange(ctx, queryStatsTopKey, 0, int64(limit-1))
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return top.Val(), nil
+}
+
+// dayKey günün sayaç key'ini döner (UTC)
+func dayKey(t time.Time) string {
+	return queryStatsKeyPrefix + t.UTC().Format("20060102")
+}
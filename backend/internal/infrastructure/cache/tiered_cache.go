@@ -0,0 +1,87 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/logger"
+)
+
+// tieredCache süreç içi LRU (L1) ve paylaşılan cache (L2, Redis) katmanlarından oluşan CacheRepository
+// Sık tekrarlanan sorgular L1'den döner; L2'ye erişilemediğinde L1'deki (süresi dolmuş olsa bile)
+// son değer kullanılır. L1 her instance'a özeldir, bu yüzden TTL'i kısa tutulmalıdır.
+type tieredCache struct {
+	l1  *lruStore
+	l2  port.CacheRepository
+	ttl time.Duration
+}
+
+// NewTieredCache l2 önüne en fazla size kayıt tutan bir LRU ekler
+// L1 kayıtları en fazla ttl kadar taze kabul edilir; size veya ttl 0 ise L1 devre dışıdır ve l2 döner
+func NewTieredCache(l2 port.CacheRepository, size int, ttl time.Duration) port.CacheRepository {
+	if size <= 0 || ttl <= 0 {
+		return l2
+	}
+	return &tieredCache{
+		l1:  newLRUStore(size),
+		l2:  l2,
+		ttl: ttl,
+	}
+}
+
+// Get önce L1'e, sonra L2'ye bakar; L2'den okunan değer L1'e alınır
+func (c *tieredCache) Get(ctx context.Context, key string) ([]byte, error) {
+	stale, fresh, found := c.l1.get(key)
+	if fresh {
+		return stale, nil
+	}
+
+	value, err := c.l2.Get(ctx, key)
+	if err != nil {
+		// L2 kesintisinde eski L1 değeri hiç sonuç dönmemekten iyidir
+		if err != port.ErrCacheMiss && found {
+			logger.FromContext(ctx).Warn("shared cache unavailable, serving stale local value",
+				zap.String("key", key),
+				zap.Error(err),
+			)
+			return stale, nil
+		}
+		return nil, err
+	}
+
+	c.l1.set(key, value, c.ttl)
+	return value, nil
+}
+
+// Set değeri iki katmana da yazar; L1 TTL'i ttl'den uzun olamaz
+// L2 hatası döner ancak değer L1'de kalır
+func (c *tieredCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	l1TTL := c.ttl
+	if ttl > 0 && ttl < l1TTL {
+		l1TTL = ttl
+	}
+	c.l1.set(key, value, l1TTL)
+
+	return c.l2.Set(ctx, key, value, ttl)
+}
+
+// Delete key'i iki katmandan da siler
+func (c *tieredCache) Delete(ctx context.Context, key string) error {
+	c.l1.delete(key)
+	return c.l2.Delete(ctx, key)
+}
+
+// InvalidatePattern desene uyan key'leri iki katmandan da siler
+func (c *tieredCache) InvalidatePattern(ctx context.Context, pattern string) error {
+	c.l1.deleteMatching(pattern)
+	return c.l2.InvalidatePattern(ctx, pattern)
+}
+
+// Clear iki katmanı da temizler
+func (c *tieredCache) Clear(ctx context.Context) error {
+	c.l1.clear()
+	return c.l2.Clear(ctx)
+}
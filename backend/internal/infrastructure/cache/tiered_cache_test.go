@@ -0,0 +1,145 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// fakeL2 Redis yerine kullanılan bellek içi cache; down true iken tüm çağrılar hata döner
+type fakeL2 struct {
+	storage map[string][]byte
+	gets    int
+	down    bool
+}
+
+func newFakeL2() *fakeL2 {
+	return &fakeL2{storage: make(map[string][]byte)}
+}
+
+var errL2Down = errors.New("connection refused")
+
+func (f *fakeL2) Get(ctx context.Context, key string) ([]byte, error) {
+	f.gets++
+	if f.down {
+		return nil, errL2Down
+	}
+	value, ok := f.storage[key]
+	if !ok {
+		return nil, port.ErrCacheMiss
+	}
+	return value, nil
+}
+
+func (f *fakeL2) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if f.down {
+		return errL2Down
+	}
+	f.storage[key] = value
+	return nil
+}
+
+func (f *fakeL2) Delete(ctx context.Context, key string) error {
+	delete(f.storage, key)
+	return nil
+}
+
+func (f *fakeL2) InvalidatePattern(ctx context.Context, pattern string) error {
+	f.storage = make(map[string][]byte)
+	return nil
+}
+
+func (f *fakeL2) Clear(ctx context.Context) error {
+	f.storage = make(map[string][]byte)
+	return nil
+}
+
+func newTestTieredCache(l2 *fakeL2, size int, now *time.Time) *tieredCache {
+	c := NewTieredCache(l2, size, 5*time.Second).(*tieredCache)
+	c.l1.now = func() time.Time { return *now }
+	return c
+}
+
+func TestTieredCache(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("disabled when size or ttl is zero", func(t *testing.T) {
+		l2 := newFakeL2()
+		assert.Same(t, l2, NewTieredCache(l2, 0, time.Second))
+		assert.Same(t, l2, NewTieredCache(l2, 10, 0))
+	})
+
+	t.Run("serves hot keys from L1", func(t *testing.T) {
+		now := time.Now()
+		l2 := newFakeL2()
+		c := newTestTieredCache(l2, 10, &now)
+
+		require.NoError(t, c.Set(ctx, "search:a", []byte("a"), time.Minute))
+		value, err := c.Get(ctx, "search:a")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("a"), value)
+		assert.Equal(t, 0, l2.gets)
+
+		// L1 süresi dolunca L2'den okunur ve L1 tazelenir
+		now = now.Add(6 * time.Second)
+		_, err = c.Get(ctx, "search:a")
+		require.NoError(t, err)
+		assert.Equal(t, 1, l2.gets)
+		_, err = c.Get(ctx, "search:a")
+		require.NoError(t, err)
+		assert.Equal(t, 1, l2.gets)
+	})
+
+	t.Run("evicts least recently used", func(t *testing.T) {
+		now := time.Now()
+		l2 := newFakeL2()
+		c := newTestTieredCache(l2, 2, &now)
+
+		require.NoError(t, c.Set(ctx, "a", []byte("a"), time.Minute))
+		require.NoError(t, c.Set(ctx, "b", []byte("b"), time.Minute))
+		_, _ = c.Get(ctx, "a")
+		require.NoError(t, c.Set(ctx, "c", []byte("c"), time.Minute))
+
+		assert.Contains(t, c.l1.entries, "a")
+		assert.NotContains(t, c.l1.entries, "b")
+		assert.Contains(t, c.l1.entries, "c")
+	})
+
+	t.Run("serves stale L1 value when L2 is down", func(t *testing.T) {
+		now := time.Now()
+		l2 := newFakeL2()
+		c := newTestTieredCache(l2, 10, &now)
+
+		require.NoError(t, c.Set(ctx, "search:a", []byte("a"), time.Minute))
+		now = now.Add(time.Minute)
+		l2.down = true
+
+		value, err := c.Get(ctx, "search:a")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("a"), value)
+
+		_, err = c.Get(ctx, "search:unknown")
+		assert.ErrorIs(t, err, errL2Down)
+	})
+
+	t.Run("invalidates matching keys in both tiers", func(t *testing.T) {
+		now := time.Now()
+		l2 := newFakeL2()
+		c := newTestTieredCache(l2, 10, &now)
+
+		require.NoError(t, c.Set(ctx, "search:a", []byte("a"), time.Minute))
+		require.NoError(t, c.Set(ctx, "session:1", []byte("s"), time.Minute))
+		require.NoError(t, c.InvalidatePattern(ctx, "search:*"))
+
+		assert.NotContains(t, c.l1.entries, "search:a")
+		assert.Contains(t, c.l1.entries, "session:1")
+		_, err := c.Get(ctx, "search:a")
+		assert.ErrorIs(t, err, port.ErrCacheMiss)
+	})
+}
@@ -0,0 +1,80 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/tracing"
+)
+
+// tracedCache her cache işlemi için span üreten CacheRepository
+// Miss hata olarak değil cache.hit=false attribute'u olarak kaydedilir
+type tracedCache struct {
+	next    port.CacheRepository
+	backend string
+}
+
+// NewTracedCache next'i saran ve işlemlerini trace'leyen bir cache oluşturur
+// backend span attribute'u olarak yazılır (örn. "redis", "memory")
+func NewTracedCache(next port.CacheRepository, backend string) port.CacheRepository {
+	return &tracedCache{next: next, backend: backend}
+}
+
+// startSpan cache işlemi için client span'i başlatır
+func (c *tracedCache) startSpan(ctx context.Context, operation string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	attrs = append(attrs, attribute.String("cache.backend", c.backend))
+	return tracing.Tracer().Start(ctx, "Cache."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+// Get okumayı trace'ler
+func (c *tracedCache) Get(ctx context.Context, key string) ([]byte, error) {
+	ctx, span := c.startSpan(ctx, "Get")
+	value, err := c.next.Get(ctx, key)
+	span.SetAttributes(attribute.Bool("cache.hit", err == nil))
+	if errors.Is(err, port.ErrCacheMiss) {
+		span.End()
+		return value, err
+	}
+	tracing.End(span, err)
+	return value, err
+}
+
+// Set yazımı trace'ler
+func (c *tracedCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	ctx, span := c.startSpan(ctx, "Set", attribute.Int("cache.value_bytes", len(value)))
+	err := c.next.Set(ctx, key, value, ttl)
+	tracing.End(span, err)
+	return err
+}
+
+// Delete silmeyi trace'ler
+func (c *tracedCache) Delete(ctx context.Context, key string) error {
+	ctx, span := c.startSpan(ctx, "Delete")
+	err := c.next.Delete(ctx, key)
+	tracing.End(span, err)
+	return err
+}
+
+// InvalidatePattern desenle silmeyi trace'ler
+func (c *tracedCache) InvalidatePattern(ctx context.Context, pattern string) error {
+	ctx, span := c.startSpan(ctx, "InvalidatePattern", attribute.String("cache.pattern", pattern))
+	err := c.next.InvalidatePattern(ctx, pattern)
+	tracing.End(span, err)
+	return err
+}
+
+// Clear tüm cache'in temizlenmesini trace'ler
+func (c *tracedCache) Clear(ctx context.Context) error {
+	ctx, span := c.startSpan(ctx, "Clear")
+	err := c.next.Clear(ctx)
+	tracing.End(span, err)
+	return err
+}
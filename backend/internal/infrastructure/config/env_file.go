@@ -0,0 +1,86 @@
+package config
+
+import (
+	"errors"
+	"io/fs"
+	"maps"
+	"os"
+
+	"github.com/joho/godotenv"
+)
+
+// envFile applies KEY=value pairs from a dotenv file to the process environment
+// Variables set in the real environment win, as with godotenv.Load. On re-apply
+// changed values are updated and variables removed from the file are unset.
+type envFile struct {
+	path    string
+	applied map[string]string // values set from the file, to tell them apart from the real environment
+}
+
+// owns reports whether the current value of key was set from the file
+func (f *envFile) owns(key string) bool {
+	value, applied := f.applied[key]
+	current, set := os.LookupEnv(key)
+	return applied && set && current == value
+}
+
+// apply reads the file and updates the environment; a missing file counts as empty
+// The returned undo restores the environment as it was before the call
+func (f *envFile) apply() (undo func(), err error) {
+	values, err := godotenv.Read(f.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		values, err = map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	previousApplied := maps.Clone(f.applied)
+	previous := make(map[string]*string)
+	remember := func(key string) {
+		if _, ok := previous[key]; ok {
+			return
+		}
+		if current, set := os.LookupEnv(key); set {
+			previous[key] = &current
+		} else {
+			previous[key] = nil
+		}
+	}
+
+	if f.applied == nil {
+		f.applied = make(map[string]string)
+	}
+	for key, value := range values {
+		current, set := os.LookupEnv(key)
+		if set && !f.owns(key) {
+			continue
+		}
+		if !set || current != value {
+			remember(key)
+			os.Setenv(key, value)
+		}
+		f.applied[key] = value
+	}
+	for key := range f.applied {
+		if _, ok := values[key]; ok {
+			continue
+		}
+		if f.owns(key) {
+			remember(key)
+			os.Unsetenv(key)
+		}
+		delete(f.applied, key)
+	}
+
+	return func() {
+		for key, value := range previous {
+			if value != nil {
+				os.Setenv(key, *value)
+			} else {
+				os.Unsetenv(key)
+			}
+		}
+		f.applied = previousApplied
+	}, nil
+}
@@ -0,0 +1,147 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"reflect"
+	"sync"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/logger"
+)
+
+// Watcher reloads the configuration on SIGHUP or when the env file changes
+// and passes the new configuration to subscribers
+// Settings no subscriber applies keep their startup value until a restart
+type Watcher struct {
+	mu          sync.Mutex
+	current     *Config
+	subscribers []func(*Config)
+	fileState   fileState
+}
+
+// fileState is what the env file looked like at the last reload
+type fileState struct {
+	exists  bool
+	modTime time.Time
+	size    int64
+}
+
+// NewWatcher creates a watcher for the configuration returned by LoadConfig
+func NewWatcher(current *Config) *Watcher {
+	return &Watcher{
+		current:   current,
+		fileState: statFile(dotEnv.path),
+	}
+}
+
+// Subscribe registers fn to be called with the new configuration after every successful reload
+// Subscribers run one at a time and must not call Reload
+func (w *Watcher) Subscribe(fn func(*Config)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.subscribers = append(w.subscribers, fn)
+}
+
+// Current returns the most recently loaded configuration
+func (w *Watcher) Current() *Config {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.current
+}
+
+// Reload re-applies the env file and reloads the configuration
+// An invalid configuration is rejected, the environment is restored and subscribers are not called
+func (w *Watcher) Reload() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.fileState = statFile(dotEnv.path)
+	undo, err := dotEnv.apply()
+	if err != nil {
+		return fmt.Errorf("config file %s could not be read: %w", dotEnv.path, err)
+	}
+
+	cfg, err := loadFromEnv()
+	if err != nil {
+		undo()
+		return err
+	}
+
+	changed := changedSections(w.current, cfg)
+	w.current = cfg
+	for _, fn := range w.subscribers {
+		fn(cfg)
+	}
+
+	logger.Info("Configuration reloaded", zap.Strings("changed_sections", changed))
+	return nil
+}
+
+// Run reloads on SIGHUP and, unless Reload.WatchIntervalSeconds is 0, when the env file changes
+// Blocks until ctx is cancelled
+func (w *Watcher) Run(ctx context.Context) {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	defer signal.Stop(hangup)
+
+	var poll <-chan time.Time
+	if interval := w.Current().Reload.WatchIntervalSeconds; interval > 0 {
+		ticker := time.NewTicker(time.Duration(interval) * time.Second)
+		defer ticker.Stop()
+		poll = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hangup:
+			w.reload("signal")
+		case <-poll:
+			if w.fileChanged() {
+				w.reload("file change")
+			}
+		}
+	}
+}
+
+func (w *Watcher) reload(trigger string) {
+	if err := w.Reload(); err != nil {
+		logger.Error("Configuration reload failed, keeping the current configuration",
+			zap.String("trigger", trigger),
+			zap.Error(err),
+		)
+	}
+}
+
+func (w *Watcher) fileChanged() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return statFile(dotEnv.path) != w.fileState
+}
+
+func statFile(path string) fileState {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fileState{}
+	}
+	return fileState{exists: true, modTime: info.ModTime(), size: info.Size()}
+}
+
+// changedSections lists the top-level Config fields that differ between previous and next
+func changedSections(previous, next *Config) []string {
+	var changed []string
+	oldValue, newValue := reflect.ValueOf(previous).Elem(), reflect.ValueOf(next).Elem()
+	for i := 0; i < oldValue.NumField(); i++ {
+		if !reflect.DeepEqual(oldValue.Field(i).Interface(), newValue.Field(i).Interface()) {
+			changed = append(changed, oldValue.Type().Field(i).Name)
+		}
+	}
+	return changed
+}
@@ -0,0 +1,93 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// unsetEnv test bitince key'in önceki değerini geri yükler
+func unsetEnv(t *testing.T, key string) {
+	t.Helper()
+	t.Setenv(key, "")
+	os.Unsetenv(key)
+}
+
+func writeEnvFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func TestEnvFile_Apply(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env")
+	unsetEnv(t, "CFG_TEST_A")
+	unsetEnv(t, "CFG_TEST_B")
+	t.Setenv("CFG_TEST_REAL", "from-env")
+
+	f := envFile{path: path}
+	writeEnvFile(t, path, "CFG_TEST_A=1\nCFG_TEST_B=2\nCFG_TEST_REAL=from-file\n")
+	_, err := f.apply()
+	require.NoError(t, err)
+	assert.Equal(t, "1", os.Getenv("CFG_TEST_A"))
+	assert.Equal(t, "2", os.Getenv("CFG_TEST_B"))
+	assert.Equal(t, "from-env", os.Getenv("CFG_TEST_REAL"), "gerçek ortam değişkeni dosyaya üstün gelmeli")
+
+	writeEnvFile(t, path, "CFG_TEST_A=10\n")
+	undo, err := f.apply()
+	require.NoError(t, err)
+	assert.Equal(t, "10", os.Getenv("CFG_TEST_A"))
+	_, set := os.LookupEnv("CFG_TEST_B")
+	assert.False(t, set, "dosyadan silinen değişken kaldırılmalı")
+
+	undo()
+	assert.Equal(t, "1", os.Getenv("CFG_TEST_A"))
+	assert.Equal(t, "2", os.Getenv("CFG_TEST_B"))
+	assert.Equal(t, "from-env", os.Getenv("CFG_TEST_REAL"))
+}
+
+func TestEnvFile_ApplyMissingFile(t *testing.T) {
+	f := envFile{path: filepath.Join(t.TempDir(), "missing.env")}
+	_, err := f.apply()
+	assert.NoError(t, err)
+}
+
+func TestWatcher_Reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env")
+	unsetEnv(t, "LOG_LEVEL")
+	previous := dotEnv
+	t.Cleanup(func() { dotEnv = previous })
+	dotEnv = envFile{path: path}
+
+	writeEnvFile(t, path, "LOG_LEVEL=info\n")
+	_, err := dotEnv.apply()
+	require.NoError(t, err)
+	cfg, err := loadFromEnv()
+	require.NoError(t, err)
+
+	watcher := NewWatcher(cfg)
+	var received []*Config
+	watcher.Subscribe(func(c *Config) { received = append(received, c) })
+
+	t.Run("valid change is applied", func(t *testing.T) {
+		writeEnvFile(t, path, "LOG_LEVEL=debug\n")
+		require.True(t, watcher.fileChanged())
+		require.NoError(t, watcher.Reload())
+
+		require.Len(t, received, 1)
+		assert.Equal(t, "debug", received[0].Logger.Level)
+		assert.Same(t, received[0], watcher.Current())
+		assert.False(t, watcher.fileChanged())
+	})
+
+	t.Run("invalid config is rejected", func(t *testing.T) {
+		writeEnvFile(t, path, "LOG_LEVEL=verbose\n")
+		assert.Error(t, watcher.Reload())
+
+		assert.Len(t, received, 1, "geçersiz config abonelere iletilmemeli")
+		assert.Equal(t, "debug", watcher.Current().Logger.Level)
+		assert.Equal(t, "debug", os.Getenv("LOG_LEVEL"), "ortam değişkenleri geri yüklenmeli")
+	})
+}
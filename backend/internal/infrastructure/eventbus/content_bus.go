@@ -0,0 +1,69 @@
+// Package eventbus süreç içi (tek instance) olay dağıtımını sağlar
+package eventbus
+
+import (
+	"sync"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/metrics"
+)
+
+// ContentBus içerik değişikliklerini tüm abonelere dağıtan bellek içi bus
+// port.ContentChangePublisher ve port.ContentChangeSubscriber arayüzlerini sağlar.
+// Yayıncı (sync) hiçbir zaman bloklanmaz: buffer'ı dolu abonenin olayı düşürülür
+type ContentBus struct {
+	mu          sync.RWMutex
+	subscribers map[chan entity.ContentChange]struct{}
+}
+
+// NewContentBus abonesiz bir bus oluşturur
+func NewContentBus() *ContentBus {
+	return &ContentBus{
+		subscribers: make(map[chan entity.ContentChange]struct{}),
+	}
+}
+
+// Publish değişiklikleri her abonenin kanalına bloklamadan yazar
+func (b *ContentBus) Publish(changes []entity.ContentChange) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for ch := range b.subscribers {
+		for _, change := range changes {
+			select {
+			case ch <- change:
+			default:
+				metrics.RecordStreamEventDropped()
+			}
+		}
+	}
+}
+
+// Subscribe yeni bir abone kanalı açar; unsubscribe birden fazla kez çağrılabilir
+func (b *ContentBus) Subscribe(buffer int) (<-chan entity.ContentChange, func()) {
+	ch := make(chan entity.ContentChange, buffer)
+
+	b.mu.Lock()
+	b.subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			// Publish okuma kilidini tutarken kanal kapatılmaz
+			b.mu.Lock()
+			delete(b.subscribers, ch)
+			b.mu.Unlock()
+			close(ch)
+		})
+	}
+
+	return ch, unsubscribe
+}
+
+// Subscribers aktif abone sayısını döner
+func (b *ContentBus) Subscribers() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.subscribers)
+}
@@ -0,0 +1,17 @@
+package eventbus
+
+import (
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// FanOut değişiklikleri sırayla tüm yayıncılara iletir
+// Sync tek bir port.ContentChangePublisher kabul ettiği için birden fazla tüketici bununla bağlanır
+type FanOut []port.ContentChangePublisher
+
+// Publish değişiklikleri her yayıncıya iletir
+func (f FanOut) Publish(changes []entity.ContentChange) {
+	for _, publisher := range f {
+		publisher.Publish(changes)
+	}
+}
@@ -0,0 +1,181 @@
+// Package flags config varsayılanları ve paylaşılan store'daki override'larla feature flag'leri değerlendirir
+package flags
+
+import (
+	"context"
+	"maps"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/logger"
+)
+
+// Store feature flag override'larının saklandığı yer
+type Store interface {
+	// Overrides kayıtlı tüm override'ları döner
+	Overrides(ctx context.Context) (map[string]bool, error)
+
+	// SetOverride bayrağın override'ını yazar
+	SetOverride(ctx context.Context, name string, enabled bool) error
+
+	// DeleteOverride bayrağın override'ını siler, override yoksa bir şey yapmaz
+	DeleteOverride(ctx context.Context, name string) error
+}
+
+// Flags port.FeatureFlags implementasyonu
+// Override'lar her istekte store'dan okunmaz; en fazla refresh aralığında bir yenilenir,
+// böylece başka bir instance'ta yapılan değişiklik en geç refresh süresi sonunda uygulanır
+type Flags struct {
+	store   Store
+	refresh time.Duration
+
+	mu        sync.RWMutex
+	defaults  map[string]bool
+	overrides map[string]bool
+	loadedAt  time.Time
+
+	refreshing sync.Mutex // Aynı anda tek bir yenileme yapılır, diğer istekler son değerleri kullanır
+}
+
+var _ port.FeatureFlags = (*Flags)(nil)
+
+// New defaults ile tanımlı bayraklardan bir Flags oluşturur; defaults'ta olmayan bayraklar tanımsızdır
+func New(defaults map[string]bool, store Store, refresh time.Duration) *Flags {
+	return &Flags{
+		store:    store,
+		refresh:  refresh,
+		defaults: maps.Clone(defaults),
+	}
+}
+
+// SetDefaults config yeniden yüklendiğinde bayrakların varsayılanlarını değiştirir
+// Override'lar korunur; yeni varsayılanlarda olmayan bayraklar tanımsız olur
+func (f *Flags) SetDefaults(defaults map[string]bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.defaults = maps.Clone(defaults)
+}
+
+// Enabled bayrağın geçerli değerini döner
+func (f *Flags) Enabled(ctx context.Context, name string) bool {
+	f.refreshIfStale(ctx)
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.value(name)
+}
+
+// List tanımlı bayrakları geçerli değerleri ve override durumlarıyla döner
+func (f *Flags) List(ctx context.Context) ([]entity.FeatureFlag, error) {
+	if err := f.Refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	flags := make([]entity.FeatureFlag, 0, len(f.defaults))
+	for name, def := range f.defaults {
+		_, overridden := f.overrides[name]
+		flags = append(flags, entity.FeatureFlag{
+			Name:       name,
+			Enabled:    f.value(name),
+			Default:    def,
+			Overridden: overridden,
+		})
+	}
+	sort.Slice(flags, func(i, j int) bool { return flags[i].Name < flags[j].Name })
+	return flags, nil
+}
+
+// SetOverride bayrağın override'ını store'a yazar; bu instance'ta hemen geçerli olur
+func (f *Flags) SetOverride(ctx context.Context, name string, enabled bool) error {
+	if !f.defined(name) {
+		return port.ErrFeatureFlagNotFound
+	}
+	if err := f.store.SetOverride(ctx, name, enabled); err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	overrides := maps.Clone(f.overrides)
+	if overrides == nil {
+		overrides = make(map[string]bool)
+	}
+	overrides[name] = enabled
+	f.overrides = overrides
+	return nil
+}
+
+// ClearOverride bayrağın override'ını store'dan siler; bu instance'ta hemen geçerli olur
+func (f *Flags) ClearOverride(ctx context.Context, name string) error {
+	if !f.defined(name) {
+		return port.ErrFeatureFlagNotFound
+	}
+	if err := f.store.DeleteOverride(ctx, name); err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	overrides := maps.Clone(f.overrides)
+	delete(overrides, name)
+	f.overrides = overrides
+	return nil
+}
+
+// Refresh override'ları store'dan yeniden okur
+// Hata olursa son okunan override'lar kullanılmaya devam eder
+func (f *Flags) Refresh(ctx context.Context) error {
+	overrides, err := f.store.Overrides(ctx)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.loadedAt = time.Now()
+	if err != nil {
+		return err
+	}
+	f.overrides = overrides
+	return nil
+}
+
+// refreshIfStale override'lar refresh süresinden eskiyse yeniler
+// Başka bir istek zaten yeniliyorsa beklemeden döner
+func (f *Flags) refreshIfStale(ctx context.Context) {
+	f.mu.RLock()
+	stale := time.Since(f.loadedAt) >= f.refresh
+	f.mu.RUnlock()
+	if !stale || !f.refreshing.TryLock() {
+		return
+	}
+	defer f.refreshing.Unlock()
+
+	if err := f.Refresh(ctx); err != nil {
+		logger.FromContext(ctx).Warn("feature flag overrides could not be read, keeping the last values", zap.Error(err))
+	}
+}
+
+func (f *Flags) defined(name string) bool {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	_, ok := f.defaults[name]
+	return ok
+}
+
+// value bayrağın geçerli değerini döner; f.mu okuma kilidi tutulmalıdır
+func (f *Flags) value(name string) bool {
+	def, ok := f.defaults[name]
+	if !ok {
+		return false
+	}
+	if enabled, overridden := f.overrides[name]; overridden {
+		return enabled
+	}
+	return def
+}
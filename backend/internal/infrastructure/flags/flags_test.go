@@ -0,0 +1,117 @@
+package flags
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// failingStore okuma hatası verebilen Store
+type failingStore struct {
+	Store
+	err error
+}
+
+func (s *failingStore) Overrides(ctx context.Context) (map[string]bool, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.Store.Overrides(ctx)
+}
+
+func testDefaults() map[string]bool {
+	return map[string]bool{
+		entity.FlagFuzzyFallback: true,
+		entity.FlagHybridRanking: false,
+	}
+}
+
+func TestFlags_Enabled(t *testing.T) {
+	ctx := context.Background()
+	f := New(testDefaults(), NewMemoryStore(), time.Minute)
+
+	assert.True(t, f.Enabled(ctx, entity.FlagFuzzyFallback))
+	assert.False(t, f.Enabled(ctx, entity.FlagHybridRanking))
+	assert.False(t, f.Enabled(ctx, "unknown"), "tanımsız bayraklar kapalı olmalı")
+
+	require.NoError(t, f.SetOverride(ctx, entity.FlagFuzzyFallback, false))
+	require.NoError(t, f.SetOverride(ctx, entity.FlagHybridRanking, true))
+	assert.False(t, f.Enabled(ctx, entity.FlagFuzzyFallback))
+	assert.True(t, f.Enabled(ctx, entity.FlagHybridRanking))
+
+	require.NoError(t, f.ClearOverride(ctx, entity.FlagFuzzyFallback))
+	assert.True(t, f.Enabled(ctx, entity.FlagFuzzyFallback))
+
+	assert.ErrorIs(t, f.SetOverride(ctx, "unknown", true), port.ErrFeatureFlagNotFound)
+	assert.ErrorIs(t, f.ClearOverride(ctx, "unknown"), port.ErrFeatureFlagNotFound)
+}
+
+func TestFlags_List(t *testing.T) {
+	ctx := context.Background()
+	f := New(testDefaults(), NewMemoryStore(), time.Minute)
+	require.NoError(t, f.SetOverride(ctx, entity.FlagHybridRanking, true))
+
+	flags, err := f.List(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []entity.FeatureFlag{
+		{Name: entity.FlagFuzzyFallback, Enabled: true, Default: true},
+		{Name: entity.FlagHybridRanking, Enabled: true, Default: false, Overridden: true},
+	}, flags)
+}
+
+func TestFlags_Refresh(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("picks up overrides written by another instance", func(t *testing.T) {
+		store := NewMemoryStore()
+		f := New(testDefaults(), store, time.Minute)
+		assert.True(t, f.Enabled(ctx, entity.FlagFuzzyFallback))
+
+		require.NoError(t, store.SetOverride(ctx, entity.FlagFuzzyFallback, false))
+		assert.True(t, f.Enabled(ctx, entity.FlagFuzzyFallback), "refresh süresi dolmadan store okunmamalı")
+
+		require.NoError(t, f.Refresh(ctx))
+		assert.False(t, f.Enabled(ctx, entity.FlagFuzzyFallback))
+	})
+
+	t.Run("refreshes when stale", func(t *testing.T) {
+		store := NewMemoryStore()
+		f := New(testDefaults(), store, 0)
+
+		require.NoError(t, store.SetOverride(ctx, entity.FlagHybridRanking, true))
+		assert.True(t, f.Enabled(ctx, entity.FlagHybridRanking))
+	})
+
+	t.Run("store error keeps last known overrides", func(t *testing.T) {
+		store := &failingStore{Store: NewMemoryStore()}
+		f := New(testDefaults(), store, 0)
+		require.NoError(t, f.SetOverride(ctx, entity.FlagFuzzyFallback, false))
+
+		store.err = errors.New("redis down")
+		assert.False(t, f.Enabled(ctx, entity.FlagFuzzyFallback))
+
+		_, err := f.List(ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestFlags_SetDefaults(t *testing.T) {
+	ctx := context.Background()
+	f := New(testDefaults(), NewMemoryStore(), time.Minute)
+	require.NoError(t, f.SetOverride(ctx, entity.FlagHybridRanking, true))
+
+	f.SetDefaults(map[string]bool{
+		entity.FlagFuzzyFallback: false,
+		entity.FlagHybridRanking: false,
+	})
+
+	assert.False(t, f.Enabled(ctx, entity.FlagFuzzyFallback))
+	assert.True(t, f.Enabled(ctx, entity.FlagHybridRanking), "override varsayılan değişikliğinden etkilenmemeli")
+}
@@ -0,0 +1,85 @@
+package flags
+
+import (
+	"context"
+	"maps"
+	"strconv"
+	"sync"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// overridesKey override'ların tutulduğu Redis hash'i (field: bayrak adı, value: "true"/"false")
+// Arama cache önekinden (search:) farklıdır, böylece cache invalidation override'ları silmez
+const overridesKey = "flags:overrides"
+
+// redisStore override'ları tüm instance'ların paylaştığı Redis'te tutar
+type redisStore struct {
+	client *redis.Client
+}
+
+// NewRedisStore Redis ile Store implementasyonu oluşturur
+func NewRedisStore(client *redis.Client) Store {
+	return &redisStore{client: client}
+}
+
+// Overrides hash'teki tüm override'ları okur; bool'a çevrilemeyen değerler yok sayılır
+func (s *redisStore) Overrides(ctx context.Context) (map[string]bool, error) {
+	values, err := s.client.HGetAll(ctx, overridesKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	overrides := make(map[string]bool, len(values))
+	for name, raw := range values {
+		if enabled, err := strconv.ParseBool(raw); err == nil {
+			overrides[name] = enabled
+		}
+	}
+	return overrides, nil
+}
+
+// SetOverride bayrağın override'ını yazar
+func (s *redisStore) SetOverride(ctx context.Context, name string, enabled bool) error {
+	return s.client.HSet(ctx, overridesKey, name, strconv.FormatBool(enabled)).Err()
+}
+
+// DeleteOverride bayrağın override'ını siler
+func (s *redisStore) DeleteOverride(ctx context.Context, name string) error {
+	return s.client.HDel(ctx, overridesKey, name).Err()
+}
+
+// memoryStore Redis'siz kurulumlar için süreç içi Store
+// Override'lar sadece bu instance'ta geçerlidir ve restart'ta kaybolur
+type memoryStore struct {
+	mu        sync.Mutex
+	overrides map[string]bool
+}
+
+// NewMemoryStore süreç içi Store oluşturur
+func NewMemoryStore() Store {
+	return &memoryStore{overrides: make(map[string]bool)}
+}
+
+// Overrides override'ların kopyasını döner
+func (s *memoryStore) Overrides(ctx context.Context) (map[string]bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return maps.Clone(s.overrides), nil
+}
+
+// SetOverride bayrağın override'ını yazar
+func (s *memoryStore) SetOverride(ctx context.Context, name string, enabled bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.overrides[name] = enabled
+	return nil
+}
+
+// DeleteOverride bayrağın override'ını siler
+func (s *memoryStore) DeleteOverride(ctx context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.overrides, name)
+	return nil
+}
@@ -0,0 +1,42 @@
+package ingest
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// Desteklenen broker'lar
+// Şu an sadece NATS JetStream desteklenir
+const (
+	BrokerNATS = "nats"
+)
+
+// Config değişiklik akışı consumer ayarları
+type Config struct {
+	Broker  string // "nats"
+	URL     string // nats://[user:pass@ veya token@]host:port, TLS için tls://host:port
+	Subject string // NATS subject
+	Group   string // JetStream durable consumer adı, aynı adı kullanan instance'lar olayları paylaşır
+	Stream  string // JetStream stream adı, yoksa Subject için oluşturulur
+
+	CredsFile    string // JWT + NKEY creds dosyası
+	NKeySeedFile string // NKEY seed dosyası (CredsFile ile birlikte kullanılamaz)
+	TLSCAFile    string // sunucu sertifikasını doğrulayan CA dosyası
+	TLSCertFile  string // client sertifikası (mTLS)
+	TLSKeyFile   string // client sertifikasının anahtarı
+
+	MaxDeliver int           // işlenemeyen bir olayın en fazla teslim sayısı
+	AckWait    time.Duration // onaylanmayan olayın yeniden teslim edilmesi için beklenen süre
+}
+
+// NewConsumer broker türüne göre uygun consumer'ı oluşturur
+func NewConsumer(cfg Config) (port.ContentEventConsumer, error) {
+	switch cfg.Broker {
+	case BrokerNATS:
+		return NewNATSConsumer(cfg)
+	default:
+		return nil, fmt.Errorf("desteklenmeyen broker: %s", cfg.Broker)
+	}
+}
@@ -0,0 +1,313 @@
+package ingest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/logger"
+)
+
+// natsReconnectWait bağlantı koptuğunda veya abonelik kurulamadığında yeniden denemeden önce beklenecek süre
+const natsReconnectWait = 2 * time.Second
+
+// natsFetchBatch tek pull isteğinde alınan en fazla mesaj sayısı
+const natsFetchBatch = 10
+
+// natsFetchWait bir pull isteğinin mesaj bekleme süresi; dolunca istek yenilenir
+const natsFetchWait = 5 * time.Second
+
+// Consumer ayarları verilmezse kullanılan varsayılanlar
+const (
+	natsDefaultStream     = "CONTENT_EVENTS"
+	natsDefaultDurable    = "search-engine"
+	natsDefaultMaxDeliver = 5
+	natsDefaultAckWait    = 30 * time.Second
+)
+
+// natsMessage dispatch'in kullandığı JetStream mesaj işlemleri (*nats.Msg bu arayüzü sağlar)
+type natsMessage interface {
+	Ack(opts ...nats.AckOpt) error
+	Nak(opts ...nats.AckOpt) error
+	Term(opts ...nats.AckOpt) error
+	Metadata() (*nats.MsgMetadata, error)
+}
+
+// natsConsumer NATS JetStream üzerinde durable bir pull consumer ile olay okuyan consumer
+// Olaylar işlendikten sonra onaylanır (ack); işlenemeyen olaylar AckWait sonunda MaxDeliver'a kadar
+// yeniden teslim edilir. Aynı durable adını kullanan instance'lar olayları paylaşır
+type natsConsumer struct {
+	opts       nats.Options
+	stream     string
+	subject    string
+	durable    string
+	maxDeliver int
+	ackWait    time.Duration
+}
+
+// NewNATSConsumer yeni bir NATS JetStream consumer oluşturur
+// cfg.URL nats://[user:pass@ veya token@]host[:port] ya da TLS için tls://... formatında olmalıdır,
+// virgülle birden fazla sunucu verilebilir. Creds/NKEY/TLS dosyaları burada okunup doğrulanır
+func NewNATSConsumer(cfg Config) (port.ContentEventConsumer, error) {
+	servers := strings.Split(cfg.URL, ",")
+	for i, server := range servers {
+		servers[i] = strings.TrimSpace(server)
+		parsed, err := url.Parse(servers[i])
+		if err != nil || (parsed.Scheme != "nats" && parsed.Scheme != "tls") || parsed.Hostname() == "" {
+			return nil, fmt.Errorf("geçersiz NATS URL: %s", server)
+		}
+	}
+	if cfg.Subject == "" || strings.ContainsAny(cfg.Subject, " \t\r\n") {
+		return nil, fmt.Errorf("geçersiz NATS subject: %q", cfg.Subject)
+	}
+	if cfg.CredsFile != "" && cfg.NKeySeedFile != "" {
+		return nil, errors.New("NATS creds dosyası ve NKEY seed dosyası birlikte kullanılamaz")
+	}
+
+	c := &natsConsumer{
+		opts:       nats.GetDefaultOptions(),
+		stream:     cfg.Stream,
+		subject:    cfg.Subject,
+		durable:    cfg.Group,
+		maxDeliver: cfg.MaxDeliver,
+		ackWait:    cfg.AckWait,
+	}
+	if c.stream == "" {
+		c.stream = natsDefaultStream
+	}
+	if c.durable == "" {
+		c.durable = natsDefaultDurable
+	}
+	if c.maxDeliver <= 0 {
+		c.maxDeliver = natsDefaultMaxDeliver
+	}
+	if c.ackWait <= 0 {
+		c.ackWait = natsDefaultAckWait
+	}
+	if strings.ContainsAny(c.stream, ". \t\r\n*>") || strings.ContainsAny(c.durable, ". \t\r\n*>") {
+		return nil, fmt.Errorf("geçersiz JetStream stream/consumer adı: %q/%q", c.stream, c.durable)
+	}
+
+	options, err := natsOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+	c.opts.Servers = servers
+	for _, option := range options {
+		if err := option(&c.opts); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// natsOptions bağlantı adı, yeniden bağlanma, kimlik doğrulama ve TLS seçeneklerini oluşturur
+// URL'deki kullanıcı/şifre veya token nats.go tarafından doğrudan kullanılır
+func natsOptions(cfg Config) ([]nats.Option, error) {
+	options := []nats.Option{
+		nats.Name("search-engine-ingest"),
+		nats.MaxReconnects(-1), // Bağlantı koparsa süresiz yeniden bağlanılır
+		nats.ReconnectWait(natsReconnectWait),
+	}
+
+	switch {
+	case cfg.CredsFile != "":
+		// JWT + NKEY (decentralized auth); dosya her bağlantıda okunur, şimdiden erişilebilir olmalı
+		if _, err := os.Stat(cfg.CredsFile); err != nil {
+			return nil, fmt.Errorf("NATS creds dosyası okunamadı: %w", err)
+		}
+		options = append(options, nats.UserCredentials(cfg.CredsFile))
+	case cfg.NKeySeedFile != "":
+		option, err := nats.NkeyOptionFromSeed(cfg.NKeySeedFile)
+		if err != nil {
+			return nil, fmt.Errorf("NATS NKEY seed dosyası okunamadı: %w", err)
+		}
+		options = append(options, option)
+	}
+
+	if cfg.TLSCAFile != "" {
+		options = append(options, nats.RootCAs(cfg.TLSCAFile))
+	}
+	if cfg.TLSCertFile != "" || cfg.TLSKeyFile != "" {
+		if cfg.TLSCertFile == "" || cfg.TLSKeyFile == "" {
+			return nil, errors.New("NATS TLS client sertifikası için sertifika ve anahtar dosyası birlikte verilmelidir")
+		}
+		options = append(options, nats.ClientCert(cfg.TLSCertFile, cfg.TLSKeyFile))
+	}
+	return options, nil
+}
+
+// Consume ctx iptal edilene kadar durable consumer'dan olay çeker
+// Bağlantı veya abonelik kurulamazsa natsReconnectWait aralıkla yeniden denenir; kurulan
+// bağlantı koparsa nats.go yeniden bağlanır, durable consumer kaldığı yerden devam eder
+func (c *natsConsumer) Consume(ctx context.Context, handler port.ContentEventHandler) error {
+	log := logger.FromContext(ctx)
+
+	opts := c.opts
+	opts.DisconnectedErrCB = func(_ *nats.Conn, err error) {
+		log.Warn("NATS connection lost, reconnecting", zap.Error(err))
+	}
+	opts.ReconnectedCB = func(nc *nats.Conn) {
+		log.Info("NATS connection restored", zap.String("server", nc.ConnectedUrl()))
+	}
+
+	var (
+		nc  *nats.Conn
+		sub *nats.Subscription
+	)
+	for {
+		var err error
+		nc, sub, err = c.subscribe(&opts)
+		if err == nil {
+			break
+		}
+		log.Warn("NATS subscription could not be started, retrying",
+			zap.Strings("servers", opts.Servers),
+			zap.Duration("wait", natsReconnectWait),
+			zap.Error(err),
+		)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(natsReconnectWait):
+		}
+	}
+	defer nc.Close()
+
+	log.Info("NATS subscription started",
+		zap.String("stream", c.stream),
+		zap.String("subject", c.subject),
+		zap.String("durable", c.durable),
+	)
+
+	for {
+		fetchCtx, cancel := context.WithTimeout(ctx, natsFetchWait)
+		msgs, err := sub.Fetch(natsFetchBatch, nats.Context(fetchCtx))
+		cancel()
+		if ctx.Err() != nil {
+			// Çekilmiş ama işlenmemiş mesajlar onaylanmaz, AckWait sonunda yeniden teslim edilir
+			return nil
+		}
+		if err != nil {
+			if !errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, nats.ErrTimeout) {
+				log.Warn("NATS fetch failed", zap.Error(err))
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-time.After(natsReconnectWait):
+				}
+			}
+			continue
+		}
+
+		for _, msg := range msgs {
+			c.dispatch(ctx, msg.Data, msg, handler)
+		}
+	}
+}
+
+// subscribe bağlanır, stream yoksa subject için oluşturur ve durable pull consumer'a abone olur
+func (c *natsConsumer) subscribe(opts *nats.Options) (*nats.Conn, *nats.Subscription, error) {
+	nc, err := opts.Connect()
+	if err != nil {
+		return nil, nil, fmt.Errorf("NATS bağlantı hatası: %w", err)
+	}
+
+	js, err := nc.JetStream()
+	if err != nil {
+		nc.Close()
+		return nil, nil, err
+	}
+
+	if _, err := js.StreamInfo(c.stream); err != nil {
+		// Stream yoksa oluşturulur; varsa (ve farklı ayarlarla tanımlıysa) mevcut stream kullanılır
+		if _, addErr := js.AddStream(&nats.StreamConfig{Name: c.stream, Subjects: []string{c.subject}}); addErr != nil {
+			nc.Close()
+			return nil, nil, fmt.Errorf("JetStream stream %s hazırlanamadı: %v (%w)", c.stream, err, addErr)
+		}
+	}
+
+	sub, err := js.PullSubscribe(c.subject, c.durable,
+		nats.BindStream(c.stream),
+		nats.ManualAck(),
+		nats.AckExplicit(),
+		nats.AckWait(c.ackWait),
+		nats.MaxDeliver(c.maxDeliver),
+		nats.DeliverAll(),
+	)
+	if err != nil {
+		nc.Close()
+		return nil, nil, fmt.Errorf("JetStream consumer %s oluşturulamadı: %w", c.durable, err)
+	}
+	return nc, sub, nil
+}
+
+// dispatch mesajı ContentEvent olarak decode edip handler'a iletir ve sonucu JetStream'e bildirir
+//   - başarılı olaylar onaylanır (ack)
+//   - decode edilemeyen ve doğrulamadan geçmeyen olaylar (karantinaya alınmıştır) veya bilinmeyen
+//     provider'ların olayları tekrar denense de başarısız olacağından sonlandırılır (term)
+//   - shutdown sırasında kesilen olaylar başka bir instance'a hemen teslim edilsin diye reddedilir (nak)
+//   - diğer hatalarda onay verilmez; olay AckWait sonunda MaxDeliver'a kadar yeniden teslim edilir
+//
+// Ham veri taşımayan olaylarda mesajın kendisi karantina kaydı için ham veri olarak eklenir
+func (c *natsConsumer) dispatch(ctx context.Context, payload []byte, msg natsMessage, handler port.ContentEventHandler) {
+	log := logger.FromContext(ctx)
+
+	var event entity.ContentEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		log.Warn("invalid content event terminated", zap.Error(err))
+		c.settle(ctx, msg.Term)
+		return
+	}
+	if event.Content != nil && event.Content.RawData == "" {
+		event.Content.RawData = string(payload)
+	}
+
+	err := handler(ctx, &event)
+	var validationErr *apperrors.ValidationError
+	switch {
+	case err == nil:
+		c.settle(ctx, msg.Ack)
+	case errors.As(err, &validationErr), errors.Is(err, port.ErrProviderNotFound):
+		log.Warn("content event rejected",
+			zap.Int64("provider_id", event.ProviderID),
+			zap.Error(err),
+		)
+		c.settle(ctx, msg.Term)
+	case ctx.Err() != nil:
+		c.settle(ctx, msg.Nak)
+	default:
+		fields := []zap.Field{zap.Int64("provider_id", event.ProviderID), zap.Error(err)}
+		if meta, metaErr := msg.Metadata(); metaErr == nil {
+			fields = append(fields,
+				zap.Uint64("stream_seq", meta.Sequence.Stream),
+				zap.Uint64("delivery", meta.NumDelivered),
+			)
+			if meta.NumDelivered >= uint64(c.maxDeliver) {
+				// Olay stream'de kalır, stream_seq ile yeniden yayınlanabilir
+				log.Error("content event could not be processed, delivery attempts exhausted", fields...)
+				return
+			}
+		}
+		log.Warn("content event could not be processed, will be redelivered", fields...)
+	}
+}
+
+// settle ack/nak/term sonucunu loglar; başarısız onaylanan olay AckWait sonunda yeniden teslim edilir
+func (c *natsConsumer) settle(ctx context.Context, ack func(opts ...nats.AckOpt) error) {
+	if err := ack(); err != nil {
+		logger.FromContext(ctx).Warn("NATS message could not be acknowledged", zap.Error(err))
+	}
+}
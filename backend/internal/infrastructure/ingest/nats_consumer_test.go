@@ -0,0 +1,199 @@
+package ingest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nkeys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// fakeNATSMessage dispatch'in JetStream'e bildirdiği sonucu kaydeder
+type fakeNATSMessage struct {
+	delivered uint64
+	result    string // "ack", "nak", "term" veya onay verilmediyse boş
+}
+
+func (m *fakeNATSMessage) Ack(...nats.AckOpt) error  { m.result = "ack"; return nil }
+func (m *fakeNATSMessage) Nak(...nats.AckOpt) error  { m.result = "nak"; return nil }
+func (m *fakeNATSMessage) Term(...nats.AckOpt) error { m.result = "term"; return nil }
+
+func (m *fakeNATSMessage) Metadata() (*nats.MsgMetadata, error) {
+	return &nats.MsgMetadata{NumDelivered: m.delivered, Sequence: nats.SequencePair{Stream: 42}}, nil
+}
+
+func newTestNATSConsumer(t *testing.T, cfg Config) *natsConsumer {
+	t.Helper()
+
+	if cfg.URL == "" {
+		cfg.URL = "nats://localhost:4222"
+	}
+	if cfg.Subject == "" {
+		cfg.Subject = "content.events"
+	}
+	consumer, err := NewNATSConsumer(cfg)
+	require.NoError(t, err)
+	return consumer.(*natsConsumer)
+}
+
+func TestNATSConsumer_Dispatch(t *testing.T) {
+	const upsert = `{"provider_id": 1, "op": "upsert", "content": {"external_id": "v1", "title": "Video", "content_type": "video"}}`
+
+	tests := []struct {
+		name       string
+		payload    string
+		handlerErr error
+		canceled   bool
+		delivered  uint64
+		want       string
+	}{
+		{"processed event is acked", upsert, nil, false, 1, "ack"},
+		{"invalid json is terminated", `not json`, nil, false, 1, "term"},
+		{"quarantined event is terminated", upsert, apperrors.NewValidationError("title", "title is required", ""), false, 1, "term"},
+		{"unknown provider is terminated", upsert, fmt.Errorf("wrapped: %w", port.ErrProviderNotFound), false, 1, "term"},
+		{"failed event is left for redelivery", upsert, errors.New("database unavailable"), false, 1, ""},
+		{"last delivery attempt is not acked", upsert, errors.New("database unavailable"), false, 5, ""},
+		{"event interrupted by shutdown is nacked", upsert, context.Canceled, true, 1, "nak"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestNATSConsumer(t, Config{MaxDeliver: 5})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var received *entity.ContentEvent
+			msg := &fakeNATSMessage{delivered: tt.delivered}
+			c.dispatch(ctx, []byte(tt.payload), msg, func(ctx context.Context, event *entity.ContentEvent) error {
+				received = event
+				if tt.canceled {
+					cancel()
+				}
+				return tt.handlerErr
+			})
+
+			assert.Equal(t, tt.want, msg.result)
+			if tt.payload == upsert {
+				require.NotNil(t, received)
+				assert.Equal(t, entity.ContentEventUpsert, received.Op)
+				assert.Equal(t, "v1", received.Content.ExternalID)
+				assert.Equal(t, upsert, received.Content.RawData) // Karantina için ham veri
+			}
+		})
+	}
+}
+
+func TestNATSConsumer_Consume(t *testing.T) {
+	// Kapalı bir port: bağlantı kurulamaz, consumer ctx iptal edilene kadar yeniden dener
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	listener.Close()
+
+	c := newTestNATSConsumer(t, Config{URL: "nats://" + addr})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- c.Consume(ctx, func(ctx context.Context, event *entity.ContentEvent) error { return nil })
+	}()
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Consume did not return after context cancellation")
+	}
+}
+
+func TestNewConsumer(t *testing.T) {
+	_, err := NewConsumer(Config{Broker: BrokerNATS, URL: "nats://localhost:4222", Subject: "content.events"})
+	assert.NoError(t, err)
+
+	_, err = NewConsumer(Config{Broker: BrokerNATS, URL: "http://localhost:4222", Subject: "content.events"})
+	assert.Error(t, err)
+
+	_, err = NewConsumer(Config{Broker: "kafka", URL: "localhost:9092", Subject: "content.events"})
+	assert.Error(t, err)
+}
+
+func TestNewNATSConsumer_Options(t *testing.T) {
+	t.Run("defaults and servers", func(t *testing.T) {
+		c := newTestNATSConsumer(t, Config{URL: "nats://a:4222, tls://b:4222"})
+
+		assert.Equal(t, []string{"nats://a:4222", "tls://b:4222"}, c.opts.Servers)
+		assert.Equal(t, "search-engine-ingest", c.opts.Name)
+		assert.Equal(t, -1, c.opts.MaxReconnect)
+		assert.Equal(t, natsDefaultStream, c.stream)
+		assert.Equal(t, natsDefaultDurable, c.durable)
+		assert.Equal(t, natsDefaultMaxDeliver, c.maxDeliver)
+		assert.Equal(t, natsDefaultAckWait, c.ackWait)
+	})
+
+	t.Run("nkey seed", func(t *testing.T) {
+		user, err := nkeys.CreateUser()
+		require.NoError(t, err)
+		seed, err := user.Seed()
+		require.NoError(t, err)
+		pub, err := user.PublicKey()
+		require.NoError(t, err)
+
+		seedFile := filepath.Join(t.TempDir(), "user.nk")
+		require.NoError(t, os.WriteFile(seedFile, seed, 0600))
+
+		c := newTestNATSConsumer(t, Config{NKeySeedFile: seedFile})
+		assert.Equal(t, pub, c.opts.Nkey)
+		assert.NotNil(t, c.opts.SignatureCB)
+	})
+
+	t.Run("creds file", func(t *testing.T) {
+		credsFile := filepath.Join(t.TempDir(), "user.creds")
+		require.NoError(t, os.WriteFile(credsFile, []byte("creds"), 0600))
+
+		c := newTestNATSConsumer(t, Config{CredsFile: credsFile})
+		assert.NotNil(t, c.opts.UserJWT)
+		assert.NotNil(t, c.opts.SignatureCB)
+	})
+
+	t.Run("invalid settings", func(t *testing.T) {
+		dir := t.TempDir()
+		badPEM := filepath.Join(dir, "bad.pem")
+		require.NoError(t, os.WriteFile(badPEM, []byte("not a certificate"), 0600))
+
+		tests := []struct {
+			name string
+			cfg  Config
+		}{
+			{"missing subject", Config{URL: "nats://localhost:4222"}},
+			{"unsupported scheme", Config{URL: "nats://localhost:4222,http://localhost:4222", Subject: "content.events"}},
+			{"stream name with dots", Config{URL: "nats://localhost:4222", Subject: "content.events", Stream: "content.events"}},
+			{"creds and nkey together", Config{URL: "nats://localhost:4222", Subject: "content.events", CredsFile: badPEM, NKeySeedFile: badPEM}},
+			{"missing creds file", Config{URL: "nats://localhost:4222", Subject: "content.events", CredsFile: filepath.Join(dir, "missing.creds")}},
+			{"invalid nkey seed", Config{URL: "nats://localhost:4222", Subject: "content.events", NKeySeedFile: badPEM}},
+			{"invalid CA file", Config{URL: "tls://localhost:4222", Subject: "content.events", TLSCAFile: badPEM}},
+			{"client cert without key", Config{URL: "tls://localhost:4222", Subject: "content.events", TLSCertFile: badPEM}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := NewNATSConsumer(tt.cfg)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
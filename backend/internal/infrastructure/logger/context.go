@@ -0,0 +1,20 @@
+package logger
+
+import "context"
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying l
+// Code further down the call chain picks it up with FromContext, so request scoped
+// fields such as request_id end up on every log line written for that request
+func NewContext(ctx context.Context, l *Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, l)
+}
+
+// FromContext returns the logger stored with NewContext, or the global logger if there is none
+func FromContext(ctx context.Context) *Logger {
+	if l, ok := ctx.Value(contextKey{}).(*Logger); ok {
+		return l
+	}
+	return GetLogger()
+}
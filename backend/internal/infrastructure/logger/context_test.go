@@ -0,0 +1,44 @@
+package logger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestFromContext(t *testing.T) {
+	t.Run("falls back to the global logger", func(t *testing.T) {
+		assert.Same(t, GetLogger(), FromContext(context.Background()))
+	})
+
+	t.Run("returns the logger stored in the context", func(t *testing.T) {
+		core, logs := observer.New(zap.InfoLevel)
+		base := &Logger{Logger: zap.New(core)}
+
+		ctx := NewContext(context.Background(), base.WithRequestID("req-1"))
+		FromContext(ctx).Info("query executed")
+
+		entries := logs.All()
+		if assert.Len(t, entries, 1) {
+			assert.Equal(t, "req-1", entries[0].ContextMap()["request_id"])
+		}
+	})
+}
+
+func TestLogger_WithFields(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	base := &Logger{Logger: zap.New(core)}
+
+	base.WithRequestID("req-1").WithFields(zap.String("provider", "provider-1"), zap.Int64("provider_id", 1)).Info("provider sync starting")
+
+	entries := logs.All()
+	if assert.Len(t, entries, 1) {
+		fields := entries[0].ContextMap()
+		assert.Equal(t, "req-1", fields["request_id"])
+		assert.Equal(t, "provider-1", fields["provider"])
+		assert.Equal(t, int64(1), fields["provider_id"])
+	}
+}
@@ -10,6 +10,7 @@ import (
 // Logger is a wrapper around zap.Logger
 type Logger struct {
 	*zap.Logger
+	level *zap.AtomicLevel // nil unless created with NewLogger
 }
 
 // Config holds logger configuration
@@ -17,22 +18,27 @@ type Config struct {
 	Level      string // debug, info, warn, error
 	Encoding   string // json or console
 	OutputPath string // stdout, stderr, or file path
+	Rotation   RotationConfig
 }
 
-// NewLogger creates a new logger instance
-func NewLogger(cfg Config) (*Logger, error) {
-	// Parse log level
-	level := zapcore.InfoLevel
-	switch cfg.Level {
+// parseLevel maps a config level name to a zap level, unknown names fall back to info
+func parseLevel(name string) zapcore.Level {
+	switch name {
 	case "debug":
-		level = zapcore.DebugLevel
-	case "info":
-		level = zapcore.InfoLevel
+		return zapcore.DebugLevel
 	case "warn":
-		level = zapcore.WarnLevel
+		return zapcore.WarnLevel
 	case "error":
-		level = zapcore.ErrorLevel
+		return zapcore.ErrorLevel
+	default:
+		return zapcore.InfoLevel
 	}
+}
+
+// NewLogger creates a new logger instance
+func NewLogger(cfg Config) (*Logger, error) {
+	// Parse log level, kept atomic so it can be changed at runtime
+	level := zap.NewAtomicLevelAt(parseLevel(cfg.Level))
 
 	// Configure encoder
 	encoderConfig := zapcore.EncoderConfig{
@@ -65,11 +71,11 @@ func NewLogger(cfg Config) (*Logger, error) {
 	} else if cfg.OutputPath == "stderr" {
 		output = zapcore.AddSync(os.Stderr)
 	} else {
-		file, err := os.OpenFile(cfg.OutputPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		file, err := newRotatingFile(cfg.OutputPath, cfg.Rotation)
 		if err != nil {
 			return nil, err
 		}
-		output = zapcore.AddSync(file)
+		output = file
 	}
 
 	// Create core
@@ -78,7 +84,15 @@ func NewLogger(cfg Config) (*Logger, error) {
 	// Create logger
 	zapLogger := zap.New(core, zap.AddCaller(), zap.AddStacktrace(zapcore.ErrorLevel))
 
-	return &Logger{Logger: zapLogger}, nil
+	return &Logger{Logger: zapLogger, level: &level}, nil
+}
+
+// SetLevel changes the minimum level at runtime, including loggers derived with With*
+// Loggers not created with NewLogger are left unchanged
+func (l *Logger) SetLevel(name string) {
+	if l.level != nil {
+		l.level.SetLevel(parseLevel(name))
+	}
 }
 
 // NewDevelopmentLogger creates a logger for development
@@ -103,6 +117,7 @@ func NewProductionLogger() (*Logger, error) {
 func (l *Logger) WithRequestID(requestID string) *Logger {
 	return &Logger{
 		Logger: l.With(zap.String("request_id", requestID)),
+		level:  l.level,
 	}
 }
 
@@ -110,6 +125,7 @@ func (l *Logger) WithRequestID(requestID string) *Logger {
 func (l *Logger) WithUserID(userID string) *Logger {
 	return &Logger{
 		Logger: l.With(zap.String("user_id", userID)),
+		level:  l.level,
 	}
 }
 
@@ -117,6 +133,15 @@ func (l *Logger) WithUserID(userID string) *Logger {
 func (l *Logger) WithComponent(component string) *Logger {
 	return &Logger{
 		Logger: l.With(zap.String("component", component)),
+		level:  l.level,
+	}
+}
+
+// WithFields adds arbitrary fields to logger, e.g. the provider a sync job works on
+func (l *Logger) WithFields(fields ...zap.Field) *Logger {
+	return &Logger{
+		Logger: l.With(fields...),
+		level:  l.level,
 	}
 }
 
@@ -124,6 +149,7 @@ func (l *Logger) WithComponent(component string) *Logger {
 func (l *Logger) WithError(err error) *Logger {
 	return &Logger{
 		Logger: l.With(zap.Error(err)),
+		level:  l.level,
 	}
 }
 
@@ -150,6 +176,11 @@ func GetLogger() *Logger {
 	return globalLogger
 }
 
+// SetLevel changes the global logger's level at runtime
+func SetLevel(name string) {
+	GetLogger().SetLevel(name)
+}
+
 // Debug logs a debug message
 func Debug(msg string, fields ...zap.Field) {
 	GetLogger().Debug(msg, fields...)
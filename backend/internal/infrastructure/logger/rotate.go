@@ -0,0 +1,166 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// backupTimeFormat is the timestamp added to rotated file names (app.log -> app-2006-01-02T15-04-05.000.log)
+const backupTimeFormat = "2006-01-02T15-04-05.000"
+
+// RotationConfig controls log file rotation; it only applies when OutputPath is a file
+type RotationConfig struct {
+	MaxSizeMB  int // rotate once the file would exceed this size, 0 disables rotation
+	MaxBackups int // rotated files to keep, 0 keeps all (subject to MaxAgeDays)
+	MaxAgeDays int // delete rotated files older than this, 0 keeps them regardless of age
+}
+
+// rotatingFile is a zapcore.WriteSyncer that renames the file to a timestamped
+// backup and starts a new one when it grows past MaxSizeMB
+type rotatingFile struct {
+	mu   sync.Mutex
+	path string
+	cfg  RotationConfig
+	file *os.File
+	size int64
+	now  func() time.Time
+}
+
+func newRotatingFile(path string, cfg RotationConfig) (*rotatingFile, error) {
+	r := &rotatingFile{path: path, cfg: cfg, now: time.Now}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Write appends p, rotating first if p would push the file over the size limit
+// A single write larger than the limit still goes to one file
+func (r *rotatingFile) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if limit := int64(r.cfg.MaxSizeMB) * 1024 * 1024; limit > 0 && r.size > 0 && r.size+int64(len(p)) > limit {
+		if err := r.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := r.file.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+// Sync flushes the current file
+func (r *rotatingFile) Sync() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.file.Sync()
+}
+
+// Close closes the current file
+func (r *rotatingFile) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.file.Close()
+}
+
+func (r *rotatingFile) open() error {
+	file, err := os.OpenFile(r.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	r.file, r.size = file, info.Size()
+	return nil
+}
+
+// rotate renames the current file to a backup, opens a new one and prunes old backups
+func (r *rotatingFile) rotate() error {
+	if err := r.file.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(r.path, r.backupName(r.now())); err != nil {
+		return fmt.Errorf("log file could not be rotated: %w", err)
+	}
+	if err := r.open(); err != nil {
+		return err
+	}
+
+	// Pruning failures must not stop logging; the next rotation retries
+	_ = r.prune()
+	return nil
+}
+
+func (r *rotatingFile) backupName(t time.Time) string {
+	ext := filepath.Ext(r.path)
+	base := strings.TrimSuffix(r.path, ext)
+	return base + "-" + t.UTC().Format(backupTimeFormat) + ext
+}
+
+// prune deletes backups beyond MaxBackups and older than MaxAgeDays
+func (r *rotatingFile) prune() error {
+	if r.cfg.MaxBackups <= 0 && r.cfg.MaxAgeDays <= 0 {
+		return nil
+	}
+
+	backups, err := r.backups()
+	if err != nil {
+		return err
+	}
+
+	cutoff := r.now().Add(-time.Duration(r.cfg.MaxAgeDays) * 24 * time.Hour)
+	for i, backup := range backups {
+		tooMany := r.cfg.MaxBackups > 0 && i >= r.cfg.MaxBackups
+		tooOld := r.cfg.MaxAgeDays > 0 && backup.rotatedAt.Before(cutoff)
+		if tooMany || tooOld {
+			if err := os.Remove(backup.path); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type logBackup struct {
+	path      string
+	rotatedAt time.Time
+}
+
+// backups lists this file's rotated backups, newest first
+func (r *rotatingFile) backups() ([]logBackup, error) {
+	dir := filepath.Dir(r.path)
+	ext := filepath.Ext(r.path)
+	prefix := strings.TrimSuffix(filepath.Base(r.path), ext) + "-"
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var backups []logBackup
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, ext) {
+			continue
+		}
+		stamp := strings.TrimSuffix(strings.TrimPrefix(name, prefix), ext)
+		rotatedAt, err := time.Parse(backupTimeFormat, stamp)
+		if err != nil {
+			continue // not one of ours
+		}
+		backups = append(backups, logBackup{path: filepath.Join(dir, name), rotatedAt: rotatedAt})
+	}
+
+	sort.Slice(backups, func(i, j int) bool { return backups[i].rotatedAt.After(backups[j].rotatedAt) })
+	return backups, nil
+}
@@ -0,0 +1,125 @@
+package logger
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock gives rotatingFile increasing, distinct timestamps
+type fakeClock struct{ t time.Time }
+
+func (c *fakeClock) now() time.Time {
+	c.t = c.t.Add(time.Second)
+	return c.t
+}
+
+func listBackups(t *testing.T, r *rotatingFile) []logBackup {
+	t.Helper()
+	backups, err := r.backups()
+	require.NoError(t, err)
+	return backups
+}
+
+func TestRotatingFile_RotatesBySize(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	r, err := newRotatingFile(path, RotationConfig{MaxSizeMB: 1})
+	require.NoError(t, err)
+	defer r.Close()
+	clock := &fakeClock{t: time.Now()}
+	r.now = clock.now
+
+	line := []byte(strings.Repeat("x", 1023) + "\n")
+	for i := 0; i < 1024; i++ {
+		_, err := r.Write(line)
+		require.NoError(t, err)
+	}
+	assert.Empty(t, listBackups(t, r), "must not rotate before reaching the limit")
+
+	_, err = r.Write([]byte("next\n"))
+	require.NoError(t, err)
+
+	backups := listBackups(t, r)
+	require.Len(t, backups, 1)
+	info, err := os.Stat(backups[0].path)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1024*1024), info.Size())
+
+	current, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "next\n", string(current))
+}
+
+func TestRotatingFile_ContinuesExistingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	require.NoError(t, os.WriteFile(path, []byte(strings.Repeat("x", 1024*1024)), 0644))
+
+	r, err := newRotatingFile(path, RotationConfig{MaxSizeMB: 1})
+	require.NoError(t, err)
+	defer r.Close()
+
+	_, err = r.Write([]byte("after restart\n"))
+	require.NoError(t, err)
+	assert.Len(t, listBackups(t, r), 1, "size written before a restart must count")
+}
+
+func TestRotatingFile_Prune(t *testing.T) {
+	t.Run("keeps max backups", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "app.log")
+		r, err := newRotatingFile(path, RotationConfig{MaxSizeMB: 1, MaxBackups: 2})
+		require.NoError(t, err)
+		defer r.Close()
+		clock := &fakeClock{t: time.Now()}
+		r.now = clock.now
+
+		for i := 0; i < 4; i++ {
+			_, err := r.Write([]byte("line\n"))
+			require.NoError(t, err)
+			r.mu.Lock()
+			require.NoError(t, r.rotate())
+			r.mu.Unlock()
+		}
+
+		backups := listBackups(t, r)
+		require.Len(t, backups, 2)
+		assert.True(t, backups[0].rotatedAt.After(backups[1].rotatedAt))
+	})
+
+	t.Run("deletes backups older than max age", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "app.log")
+		old := filepath.Join(dir, "app-"+time.Now().AddDate(0, 0, -10).UTC().Format(backupTimeFormat)+".log")
+		recent := filepath.Join(dir, "app-"+time.Now().AddDate(0, 0, -1).UTC().Format(backupTimeFormat)+".log")
+		unrelated := filepath.Join(dir, "app-notes.log")
+		for _, file := range []string{old, recent, unrelated} {
+			require.NoError(t, os.WriteFile(file, []byte("x"), 0644))
+		}
+
+		r, err := newRotatingFile(path, RotationConfig{MaxSizeMB: 1, MaxAgeDays: 7})
+		require.NoError(t, err)
+		defer r.Close()
+		require.NoError(t, r.prune())
+
+		assert.NoFileExists(t, old)
+		assert.FileExists(t, recent)
+		assert.FileExists(t, unrelated, "files without a timestamp must be left alone")
+	})
+}
+
+func TestNewLogger_FileOutput(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "server.log")
+	l, err := NewLogger(Config{Level: "info", Encoding: "json", OutputPath: path, Rotation: RotationConfig{MaxSizeMB: 10}})
+	require.NoError(t, err)
+
+	l.Info("hello")
+	require.NoError(t, l.Sync())
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `"message":"hello"`)
+}
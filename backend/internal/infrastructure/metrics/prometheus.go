@@ -1,6 +1,9 @@
 package metrics
 
 import (
+	"database/sql"
+	"strconv"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
@@ -24,6 +27,24 @@ var (
 		[]string{"method", "path"},
 	)
 
+	// gRPC Metrics
+	GRPCRequestsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_requests_total",
+			Help: "Total number of gRPC requests",
+		},
+		[]string{"method", "code"},
+	)
+
+	GRPCRequestDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "grpc_request_duration_seconds",
+			Help:    "gRPC request duration in seconds",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"method"},
+	)
+
 	// Search Metrics
 	SearchQueriesTotal = promauto.NewCounterVec(
 		prometheus.CounterOpts{
@@ -101,6 +122,135 @@ var (
 		[]string{"operation", "table"},
 	)
 
+	SlowQueriesTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "slow_queries_total",
+			Help: "Total number of database queries exceeding the slow query threshold",
+		},
+		[]string{"repository", "operation"},
+	)
+
+	// Database Pool Metrics (sql.DBStats, labelled by pool: primary, replica)
+	DBPoolConnections = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "db_pool_connections",
+			Help: "Current number of database connections by state (in_use, idle)",
+		},
+		[]string{"pool", "state"},
+	)
+
+	DBPoolMaxOpenConnections = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "db_pool_max_open_connections",
+			Help: "Maximum number of open database connections",
+		},
+		[]string{"pool"},
+	)
+
+	DBPoolWaitTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "db_pool_wait_total",
+			Help: "Total number of times a query waited for a free database connection",
+		},
+		[]string{"pool"},
+	)
+
+	DBPoolWaitSeconds = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "db_pool_wait_seconds_total",
+			Help: "Total time spent waiting for a free database connection in seconds",
+		},
+		[]string{"pool"},
+	)
+
+	DBPoolClosedTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "db_pool_closed_connections_total",
+			Help: "Total number of database connections closed by the pool by reason (max_idle, max_idle_time, max_lifetime)",
+		},
+		[]string{"pool", "reason"},
+	)
+
+	// Content Stream (SSE) Metrics
+	StreamClients = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "stream_clients",
+			Help: "Number of connected content stream clients",
+		},
+	)
+
+	StreamEventsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "stream_events_total",
+			Help: "Total number of content change events sent to stream clients",
+		},
+		[]string{"type"},
+	)
+
+	StreamEventsDroppedTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "stream_events_dropped_total",
+			Help: "Total number of content change events dropped for slow stream clients",
+		},
+	)
+
+	// Webhook Metrics
+	WebhookRequestsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "webhook_requests_total",
+			Help: "Total number of webhook POST requests by outcome (2xx, 3xx, 4xx, 5xx, error)",
+		},
+		[]string{"outcome"},
+	)
+
+	WebhookRequestDuration = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "webhook_request_duration_seconds",
+			Help:    "Webhook POST request duration in seconds",
+			Buckets: prometheus.DefBuckets,
+		},
+	)
+
+	WebhookDeliveriesTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "webhook_deliveries_total",
+			Help: "Total number of webhook deliveries by result (enqueued, delivered, retried, failed)",
+		},
+		[]string{"result"},
+	)
+
+	WebhookChangesDroppedTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "webhook_changes_dropped_total",
+			Help: "Total number of content changes dropped because the webhook pending queue was full",
+		},
+	)
+
+	// Saved Search Alert Metrics
+	SavedSearchAlertsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "saved_search_alerts_total",
+			Help: "Total number of saved search alerts by result (notified, failed)",
+		},
+		[]string{"result"},
+	)
+
+	SavedSearchChangesDroppedTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "saved_search_changes_dropped_total",
+			Help: "Total number of new contents dropped because the saved search pending queue was full",
+		},
+	)
+
+	// Search Event Metrics
+	SearchEventsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "search_events_total",
+			Help: "Total number of click/impression events by result (accepted, sampled_out, ignored)",
+		},
+		[]string{"result"},
+	)
+
 	// Rate Limiting Metrics
 	RateLimitExceededTotal = promauto.NewCounterVec(
 		prometheus.CounterOpts{
@@ -113,10 +263,16 @@ var (
 
 // RecordHTTPRequest records an HTTP request metric
 func RecordHTTPRequest(method, path string, status int, duration float64) {
-	HTTPRequestsTotal.WithLabelValues(method, path, string(rune(status))).Inc()
+	HTTPRequestsTotal.WithLabelValues(method, path, strconv.Itoa(status)).Inc()
 	HTTPRequestDuration.WithLabelValues(method, path).Observe(duration)
 }
 
+// RecordGRPCRequest records a gRPC request metric
+func RecordGRPCRequest(method, code string, duration float64) {
+	GRPCRequestsTotal.WithLabelValues(method, code).Inc()
+	GRPCRequestDuration.WithLabelValues(method).Observe(duration)
+}
+
 // RecordSearchQuery records a search query metric
 func RecordSearchQuery(contentType, sortBy string, resultCount int) {
 	SearchQueriesTotal.WithLabelValues(contentType, sortBy).Inc()
@@ -150,6 +306,77 @@ func RecordDatabaseQuery(operation, table string, duration float64) {
 	DatabaseQueryDuration.WithLabelValues(operation, table).Observe(duration)
 }
 
+// RecordDBStats records a pool stats sample; previous is the last sample recorded for the pool
+// (zero for the first one) so the cumulative counters in sql.DBStats are exported as increments
+func RecordDBStats(pool string, current, previous sql.DBStats) {
+	DBPoolConnections.WithLabelValues(pool, "in_use").Set(float64(current.InUse))
+	DBPoolConnections.WithLabelValues(pool, "idle").Set(float64(current.Idle))
+	DBPoolMaxOpenConnections.WithLabelValues(pool).Set(float64(current.MaxOpenConnections))
+
+	DBPoolWaitTotal.WithLabelValues(pool).Add(float64(current.WaitCount - previous.WaitCount))
+	DBPoolWaitSeconds.WithLabelValues(pool).Add((current.WaitDuration - previous.WaitDuration).Seconds())
+	DBPoolClosedTotal.WithLabelValues(pool, "max_idle").Add(float64(current.MaxIdleClosed - previous.MaxIdleClosed))
+	DBPoolClosedTotal.WithLabelValues(pool, "max_idle_time").Add(float64(current.MaxIdleTimeClosed - previous.MaxIdleTimeClosed))
+	DBPoolClosedTotal.WithLabelValues(pool, "max_lifetime").Add(float64(current.MaxLifetimeClosed - previous.MaxLifetimeClosed))
+}
+
+// RecordSlowQuery records a query that exceeded the slow query threshold
+func RecordSlowQuery(repository, operation string) {
+	SlowQueriesTotal.WithLabelValues(repository, operation).Inc()
+}
+
+// RecordStreamClient records a content stream client connecting (+1) or disconnecting (-1)
+func RecordStreamClient(delta float64) {
+	StreamClients.Add(delta)
+}
+
+// RecordStreamEvent records a content change event sent to a stream client
+func RecordStreamEvent(changeType string) {
+	StreamEventsTotal.WithLabelValues(changeType).Inc()
+}
+
+// RecordStreamEventDropped records a content change event dropped for a slow subscriber
+func RecordStreamEventDropped() {
+	StreamEventsDroppedTotal.Inc()
+}
+
+// RecordWebhookRequest records a webhook POST request; outcome is the status class or "error"
+func RecordWebhookRequest(outcome string, duration float64) {
+	WebhookRequestsTotal.WithLabelValues(outcome).Inc()
+	WebhookRequestDuration.Observe(duration)
+}
+
+// RecordWebhookDeliveries records count webhook deliveries with the given result
+func RecordWebhookDeliveries(result string, count int) {
+	if count > 0 {
+		WebhookDeliveriesTotal.WithLabelValues(result).Add(float64(count))
+	}
+}
+
+// RecordWebhookChangesDropped records content changes dropped before webhook matching
+func RecordWebhookChangesDropped(count int) {
+	WebhookChangesDroppedTotal.Add(float64(count))
+}
+
+// RecordSavedSearchAlerts records count saved search alerts with the given result
+func RecordSavedSearchAlerts(result string, count int) {
+	if count > 0 {
+		SavedSearchAlertsTotal.WithLabelValues(result).Add(float64(count))
+	}
+}
+
+// RecordSavedSearchChangesDropped records new contents dropped before saved search matching
+func RecordSavedSearchChangesDropped(count int) {
+	SavedSearchChangesDroppedTotal.Add(float64(count))
+}
+
+// RecordSearchEvents records count click/impression events with the given result
+func RecordSearchEvents(result string, count int) {
+	if count > 0 {
+		SearchEventsTotal.WithLabelValues(result).Add(float64(count))
+	}
+}
+
 // RecordRateLimitExceeded records a rate limit exceeded event
 func RecordRateLimitExceeded(endpoint string) {
 	RateLimitExceededTotal.WithLabelValues(endpoint).Inc()
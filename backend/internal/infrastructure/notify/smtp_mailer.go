@@ -0,0 +1,107 @@
+// Package notify kayıtlı arama bildirimlerinin e-posta ile gönderilmesini sağlar
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// SMTPConfig SMTP sunucu ayarları
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string // Boşsa kimlik doğrulama yapılmaz
+	Password string
+	From     string
+}
+
+// SMTPMailer port.AlertMailer'ın net/smtp implementasyonu
+// Sunucu destekliyorsa STARTTLS kullanılır; PLAIN auth sadece TLS üzerinden (veya localhost'a) yapılır
+type SMTPMailer struct {
+	cfg SMTPConfig
+}
+
+// NewSMTPMailer yeni bir SMTP e-posta gönderici oluşturur
+func NewSMTPMailer(cfg SMTPConfig) port.AlertMailer {
+	return &SMTPMailer{cfg: cfg}
+}
+
+// SendMail düz metin e-postayı gönderir; ctx süresi bağlantının tamamına uygulanır
+func (m *SMTPMailer) SendMail(ctx context.Context, to, subject, body string) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(m.cfg.Host, strconv.Itoa(m.cfg.Port)))
+	if err != nil {
+		return fmt.Errorf("SMTP sunucusuna bağlanılamadı: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, m.cfg.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("SMTP oturumu açılamadı: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: m.cfg.Host}); err != nil {
+			return fmt.Errorf("STARTTLS başarısız: %w", err)
+		}
+	}
+	if m.cfg.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", m.cfg.Username, m.cfg.Password, m.cfg.Host)); err != nil {
+			return fmt.Errorf("SMTP kimlik doğrulaması başarısız: %w", err)
+		}
+	}
+
+	if err := client.Mail(m.cfg.From); err != nil {
+		return fmt.Errorf("SMTP MAIL FROM reddedildi: %w", err)
+	}
+	if err := client.Rcpt(to); err != nil {
+		return fmt.Errorf("SMTP RCPT TO reddedildi: %w", err)
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("SMTP DATA reddedildi: %w", err)
+	}
+	if _, err := w.Write(buildMessage(m.cfg.From, to, subject, body, time.Now())); err != nil {
+		return fmt.Errorf("e-posta yazılamadı: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("e-posta gönderilemedi: %w", err)
+	}
+
+	return client.Quit()
+}
+
+// buildMessage UTF-8 düz metin bir MIME mesajı oluşturur
+// Konu RFC 2047 ile kodlanır, gövde quoted-printable olarak yazılır
+func buildMessage(from, to, subject, body string, date time.Time) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", to)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+
+	qp := quotedprintable.NewWriter(&buf)
+	_, _ = qp.Write([]byte(strings.ReplaceAll(body, "\n", "\r\n")))
+	_ = qp.Close()
+
+	return buf.Bytes()
+}
@@ -0,0 +1,118 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"mime"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeSMTPServer STARTTLS ve AUTH desteklemeyen, tek mesaj kabul eden minimal bir SMTP sunucusu
+type fakeSMTPServer struct {
+	listener net.Listener
+	from     string
+	to       string
+	data     string
+	done     chan struct{}
+}
+
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen failed: %v", err)
+	}
+	s := &fakeSMTPServer{listener: listener, done: make(chan struct{})}
+	go s.serve()
+	return s
+}
+
+func (s *fakeSMTPServer) serve() {
+	defer close(s.done)
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	tp := textproto.NewConn(conn)
+	_ = tp.PrintfLine("220 localhost ready")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch cmd {
+		case "EHLO", "HELO":
+			_ = tp.PrintfLine("250 localhost")
+		case "MAIL":
+			s.from = line
+			_ = tp.PrintfLine("250 OK")
+		case "RCPT":
+			s.to = line
+			_ = tp.PrintfLine("250 OK")
+		case "DATA":
+			_ = tp.PrintfLine("354 go ahead")
+			lines, _ := tp.ReadDotLines()
+			s.data = strings.Join(lines, "\n")
+			_ = tp.PrintfLine("250 OK")
+		case "QUIT":
+			_ = tp.PrintfLine("221 bye")
+			return
+		default:
+			_ = tp.PrintfLine("502 not implemented")
+		}
+	}
+}
+
+func TestSMTPMailer_SendMail(t *testing.T) {
+	server := newFakeSMTPServer(t)
+	defer server.listener.Close()
+
+	host, rawPort, _ := net.SplitHostPort(server.listener.Addr().String())
+	port, _ := strconv.Atoi(rawPort)
+	mailer := NewSMTPMailer(SMTPConfig{Host: host, Port: port, From: "alerts@example.com"})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := mailer.SendMail(ctx, "user@example.com", `"golang" için 2 yeni sonuç`, "- Go Concurrency (video)\n"); err != nil {
+		t.Fatalf("SendMail failed: %v", err)
+	}
+	<-server.done
+
+	if !strings.Contains(server.from, "<alerts@example.com>") {
+		t.Errorf("Unexpected MAIL FROM: %s", server.from)
+	}
+	if !strings.Contains(server.to, "<user@example.com>") {
+		t.Errorf("Unexpected RCPT TO: %s", server.to)
+	}
+
+	reader := textproto.NewReader(bufio.NewReader(strings.NewReader(server.data + "\n")))
+	header, err := reader.ReadMIMEHeader()
+	if err != nil {
+		t.Fatalf("message header could not be parsed: %v", err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(header.Get("Subject"))
+	if err != nil || subject != `"golang" için 2 yeni sonuç` {
+		t.Errorf("Unexpected subject: %q (%v)", subject, err)
+	}
+	if !strings.Contains(server.data, "Go Concurrency (video)") {
+		t.Errorf("Body missing from message: %s", server.data)
+	}
+}
+
+func TestSMTPMailer_ConnectionError(t *testing.T) {
+	listener, _ := net.Listen("tcp", "127.0.0.1:0")
+	addr := listener.Addr().(*net.TCPAddr)
+	listener.Close()
+
+	mailer := NewSMTPMailer(SMTPConfig{Host: "127.0.0.1", Port: addr.Port, From: "alerts@example.com"})
+	if err := mailer.SendMail(context.Background(), "user@example.com", "subject", "body"); err == nil {
+		t.Error("Expected an error for a closed server")
+	}
+}
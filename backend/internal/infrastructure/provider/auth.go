@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+)
+
+// oauth2ExpiryMargin token süresi dolmadan bu kadar önce yenilenir
+const oauth2ExpiryMargin = 30 * time.Second
+
+// authorizer provider isteklerine kimlik bilgisi ekler
+type authorizer interface {
+	authorize(ctx context.Context, req *http.Request) error
+}
+
+// newAuthorizer provider'ın auth ayarına göre authorizer oluşturur
+// Secret'lar veritabanında tutulmaz; SecretEnv ile belirtilen environment değişkeninden her istekte okunur
+func newAuthorizer(auth *entity.ProviderAuth) authorizer {
+	if auth == nil {
+		return noAuth{}
+	}
+
+	switch auth.Type {
+	case entity.ProviderAuthAPIKey:
+		header := auth.Header
+		if header == "" {
+			header = "X-API-Key"
+		}
+		return apiKeyAuth{header: header, secretEnv: auth.SecretEnv}
+	case entity.ProviderAuthBasic:
+		return basicAuth{username: auth.Username, secretEnv: auth.SecretEnv}
+	case entity.ProviderAuthBearer:
+		return bearerAuth{secretEnv: auth.SecretEnv}
+	case entity.ProviderAuthOAuth2:
+		return &oauth2Auth{
+			clientID:  auth.ClientID,
+			secretEnv: auth.SecretEnv,
+			tokenURL:  auth.TokenURL,
+			scopes:    auth.Scopes,
+			client:    &http.Client{Timeout: 30 * time.Second},
+		}
+	default:
+		return invalidAuth{authType: auth.Type}
+	}
+}
+
+// providerAuthorizer provider kaydına göre authorizer döner (provider nil olabilir)
+func providerAuthorizer(provider *entity.Provider) authorizer {
+	if provider == nil {
+		return noAuth{}
+	}
+	return newAuthorizer(provider.Auth)
+}
+
+// newAuthorizedRequest ctx'e bağlı, kimlik bilgisi eklenmiş bir GET isteği oluşturur
+func newAuthorizedRequest(ctx context.Context, auth authorizer, rawURL string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("istek oluşturulamadı: %w", err)
+	}
+	if err := auth.authorize(ctx, req); err != nil {
+		return nil, fmt.Errorf("provider kimlik doğrulama hatası: %w", err)
+	}
+	return req, nil
+}
+
+// lookupSecret secret'ı environment değişkeninden okur
+// Sadece PROVIDER_SECRET_ ön ekli değişkenler okunur; doğrulamadan geçmemiş kayıtlar
+// (ör. yedekten geri yüklenen provider'lar) başka secret'ları dışarı gönderemez
+func lookupSecret(env string) (string, error) {
+	if env == "" {
+		return "", fmt.Errorf("secret_env tanımlı değil")
+	}
+	if !entity.IsProviderSecretEnv(env) {
+		return "", fmt.Errorf("%s provider secret'ı olarak okunamaz (%s ön eki gerekli)", env, entity.ProviderSecretEnvPrefix)
+	}
+	secret := os.Getenv(env)
+	if secret == "" {
+		return "", fmt.Errorf("%s environment değişkeni boş", env)
+	}
+	return secret, nil
+}
+
+// noAuth kimlik doğrulamasız provider'lar için
+type noAuth struct{}
+
+func (noAuth) authorize(ctx context.Context, req *http.Request) error { return nil }
+
+// invalidAuth desteklenmeyen auth türleri için her istekte hata döner
+type invalidAuth struct {
+	authType string
+}
+
+func (a invalidAuth) authorize(ctx context.Context, req *http.Request) error {
+	return fmt.Errorf("desteklenmeyen auth türü: %s", a.authType)
+}
+
+// apiKeyAuth API key'i verilen header ile gönderir
+type apiKeyAuth struct {
+	header    string
+	secretEnv string
+}
+
+func (a apiKeyAuth) authorize(ctx context.Context, req *http.Request) error {
+	key, err := lookupSecret(a.secretEnv)
+	if err != nil {
+		return err
+	}
+	req.Header.Set(a.header, key)
+	return nil
+}
+
+// basicAuth HTTP Basic kimlik doğrulaması
+type basicAuth struct {
+	username  string
+	secretEnv string
+}
+
+func (a basicAuth) authorize(ctx context.Context, req *http.Request) error {
+	password, err := lookupSecret(a.secretEnv)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(a.username, password)
+	return nil
+}
+
+// bearerAuth sabit bearer token
+type bearerAuth struct {
+	secretEnv string
+}
+
+func (a bearerAuth) authorize(ctx context.Context, req *http.Request) error {
+	token, err := lookupSecret(a.secretEnv)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// oauth2Auth OAuth2 client-credentials akışıyla alınan token'ı kullanır
+// Token süresi dolana kadar cache'lenir, eşzamanlı isteklerde tek token alınır
+type oauth2Auth struct {
+	clientID  string
+	secretEnv string
+	tokenURL  string
+	scopes    []string
+	client    *http.Client
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+func (a *oauth2Auth) authorize(ctx context.Context, req *http.Request) error {
+	token, err := a.token(ctx)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// token geçerli bir access token döner, gerekirse token endpoint'inden yenisini alır
+func (a *oauth2Auth) token(ctx context.Context) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.accessToken != "" && time.Now().Before(a.expiresAt) {
+		return a.accessToken, nil
+	}
+
+	secret, err := lookupSecret(a.secretEnv)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if len(a.scopes) > 0 {
+		form.Set("scope", strings.Join(a.scopes, " "))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("token isteği oluşturulamadı: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(a.clientID), url.QueryEscape(secret)) // RFC 6749 2.3.1
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("token isteği başarısız: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token endpoint hata döndü: %d", resp.StatusCode)
+	}
+
+	var body struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("token response parse hatası: %w", err)
+	}
+	if body.AccessToken == "" {
+		return "", fmt.Errorf("token response access_token içermiyor")
+	}
+
+	a.accessToken = body.AccessToken
+	a.expiresAt = time.Now().Add(time.Duration(body.ExpiresIn)*time.Second - oauth2ExpiryMargin)
+	if body.ExpiresIn == 0 {
+		// Süre belirtilmemişse token bir sonraki istekte yenilenir
+		a.expiresAt = time.Time{}
+	}
+
+	return a.accessToken, nil
+}
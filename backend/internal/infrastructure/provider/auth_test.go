@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAuthorizer(t *testing.T) {
+	t.Setenv("PROVIDER_SECRET_TEST", "s3cret")
+	ctx := context.Background()
+
+	authorize := func(t *testing.T, auth *entity.ProviderAuth) *http.Request {
+		req, err := newAuthorizedRequest(ctx, newAuthorizer(auth), "http://provider.test/contents")
+		require.NoError(t, err)
+		return req
+	}
+
+	t.Run("Should send API key with default and custom header", func(t *testing.T) {
+		req := authorize(t, &entity.ProviderAuth{Type: entity.ProviderAuthAPIKey, SecretEnv: "PROVIDER_SECRET_TEST"})
+		assert.Equal(t, "s3cret", req.Header.Get("X-API-Key"))
+
+		req = authorize(t, &entity.ProviderAuth{Type: entity.ProviderAuthAPIKey, Header: "Api-Token", SecretEnv: "PROVIDER_SECRET_TEST"})
+		assert.Equal(t, "s3cret", req.Header.Get("Api-Token"))
+	})
+
+	t.Run("Should send basic auth and bearer token", func(t *testing.T) {
+		req := authorize(t, &entity.ProviderAuth{Type: entity.ProviderAuthBasic, Username: "user", SecretEnv: "PROVIDER_SECRET_TEST"})
+		username, password, ok := req.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "user", username)
+		assert.Equal(t, "s3cret", password)
+
+		req = authorize(t, &entity.ProviderAuth{Type: entity.ProviderAuthBearer, SecretEnv: "PROVIDER_SECRET_TEST"})
+		assert.Equal(t, "Bearer s3cret", req.Header.Get("Authorization"))
+	})
+
+	t.Run("Should send no credentials without auth", func(t *testing.T) {
+		req := authorize(t, nil)
+		assert.Empty(t, req.Header)
+	})
+
+	t.Run("Should fail when secret env is empty, not allowed or type is unknown", func(t *testing.T) {
+		_, err := newAuthorizedRequest(ctx, newAuthorizer(&entity.ProviderAuth{Type: entity.ProviderAuthBearer, SecretEnv: "PROVIDER_SECRET_MISSING"}), "http://provider.test")
+		assert.ErrorContains(t, err, "PROVIDER_SECRET_MISSING")
+
+		_, err = newAuthorizedRequest(ctx, newAuthorizer(&entity.ProviderAuth{Type: "digest", SecretEnv: "PROVIDER_SECRET_TEST"}), "http://provider.test")
+		assert.Error(t, err)
+
+		t.Setenv("TEST_DATABASE_PASSWORD", "db-secret")
+		_, err = newAuthorizedRequest(ctx, newAuthorizer(&entity.ProviderAuth{Type: entity.ProviderAuthBearer, SecretEnv: "TEST_DATABASE_PASSWORD"}), "http://provider.test")
+		assert.ErrorContains(t, err, entity.ProviderSecretEnvPrefix)
+	})
+}
+
+func TestOAuth2Auth(t *testing.T) {
+	t.Setenv("PROVIDER_SECRET_TEST_OAUTH", "client-secret")
+
+	tokenRequests := 0
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests++
+		clientID, secret, _ := r.BasicAuth()
+		require.NoError(t, r.ParseForm())
+		if clientID != "client" || secret != "client-secret" || r.Form.Get("grant_type") != "client_credentials" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		assert.Equal(t, "read:contents", r.Form.Get("scope"))
+		json.NewEncoder(w).Encode(map[string]interface{}{"access_token": "token-1", "expires_in": 3600})
+	}))
+	defer tokenServer.Close()
+
+	auth := newAuthorizer(&entity.ProviderAuth{
+		Type:      entity.ProviderAuthOAuth2,
+		ClientID:  "client",
+		SecretEnv: "PROVIDER_SECRET_TEST_OAUTH",
+		TokenURL:  tokenServer.URL,
+		Scopes:    []string{"read:contents"},
+	})
+
+	for i := 0; i < 2; i++ {
+		req, err := newAuthorizedRequest(context.Background(), auth, "http://provider.test")
+		require.NoError(t, err)
+		assert.Equal(t, "Bearer token-1", req.Header.Get("Authorization"))
+	}
+	assert.Equal(t, 1, tokenRequests, "token should be cached until it expires")
+}
+
+func TestJSONProvider_SendsCredentials(t *testing.T) {
+	t.Setenv("PROVIDER_SECRET_TEST", "s3cret")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-API-Key") != "s3cret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"contents": [], "pagination": {"total": 0, "page": 1, "per_page": 10}}`))
+	}))
+	defer server.Close()
+
+	prov := &entity.Provider{
+		ID:   1,
+		Name: "Secured JSON",
+		Auth: &entity.ProviderAuth{Type: entity.ProviderAuthAPIKey, SecretEnv: "PROVIDER_SECRET_TEST"},
+	}
+
+	_, err := NewJSONProvider(prov, server.URL, nil).FetchContents(context.Background())
+	assert.NoError(t, err)
+}
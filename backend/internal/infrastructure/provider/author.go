@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+)
+
+// rssAuthorPattern RSS <author> alanının "e-posta (Ad)" biçimi
+var rssAuthorPattern = regexp.MustCompile(`^\S+@\S+\s+\((.+)\)$`)
+
+// newNormalizedAuthor provider'dan gelen yazar/kanal alanlarından NormalizedAuthor oluşturur
+// Adı boş olan yazarlar için nil döner (içerik yazarsız kaydedilir)
+func newNormalizedAuthor(id, name, url string) *entity.NormalizedAuthor {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil
+	}
+	return &entity.NormalizedAuthor{
+		ExternalID: strings.TrimSpace(id),
+		Name:       name,
+		URL:        strings.TrimSpace(url),
+	}
+}
+
+// rssAuthorName RSS <author> değerinden yazar adını çıkarır ("jane@example.com (Jane Doe)" -> "Jane Doe")
+func rssAuthorName(value string) string {
+	value = strings.TrimSpace(value)
+	if m := rssAuthorPattern.FindStringSubmatch(value); m != nil {
+		return strings.TrimSpace(m[1])
+	}
+	return value
+}
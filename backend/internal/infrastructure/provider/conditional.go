@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+)
+
+// conditionalState koşullu istek (ETag / Last-Modified) durumunu tutar
+// İsteklerde sadece commit edilmiş değerler kullanılır; son fetch'in döndürdüğü değerler
+// senkronizasyon başarıyla tamamlanıp CommitValidators çağrılana kadar beklemede kalır.
+// Böylece yarıda kalan bir sync sonraki çalıştırmada 304 ile atlanmaz
+type conditionalState struct {
+	mu                  sync.Mutex
+	etag                string
+	lastModified        string
+	pendingETag         string
+	pendingLastModified string
+}
+
+// initValidators provider kaydındaki son başarılı sync'in değerlerini yükler
+func (s *conditionalState) initValidators(provider *entity.Provider) {
+	if provider == nil {
+		return
+	}
+	s.etag, s.lastModified = provider.ETag, provider.LastModified
+}
+
+// applyConditional commit edilmiş değerleri If-None-Match / If-Modified-Since header'ları olarak ekler
+func (s *conditionalState) applyConditional(req *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.etag != "" {
+		req.Header.Set("If-None-Match", s.etag)
+	}
+	if s.lastModified != "" {
+		req.Header.Set("If-Modified-Since", s.lastModified)
+	}
+}
+
+// recordValidators response'taki ETag / Last-Modified değerlerini beklemeye alır
+func (s *conditionalState) recordValidators(resp *http.Response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pendingETag = resp.Header.Get("ETag")
+	s.pendingLastModified = resp.Header.Get("Last-Modified")
+}
+
+// PendingValidators son fetch'in döndürdüğü ETag / Last-Modified değerlerini döner
+func (s *conditionalState) PendingValidators() (etag, lastModified string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.pendingETag, s.pendingLastModified
+}
+
+// CommitValidators bekleyen değerleri sonraki isteklerde kullanılmak üzere kabul eder
+func (s *conditionalState) CommitValidators() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.etag, s.lastModified = s.pendingETag, s.pendingLastModified
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+func TestJSONProvider_ConditionalFetch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Last-Modified", "Mon, 01 Jan 2024 12:00:00 GMT")
+		w.Write([]byte(`{"contents": [], "pagination": {"total": 0, "page": 1, "per_page": 10}}`))
+	}))
+	defer server.Close()
+
+	client := NewJSONProvider(&entity.Provider{ID: 1, Name: "JSON"}, server.URL, nil)
+	fetcher, ok := client.(port.ConditionalFetcher)
+	require.True(t, ok)
+
+	// Commit edilmeden değerler isteklerde kullanılmaz
+	_, err := client.FetchContents(context.Background())
+	require.NoError(t, err)
+	etag, lastModified := fetcher.PendingValidators()
+	assert.Equal(t, `"v1"`, etag)
+	assert.Equal(t, "Mon, 01 Jan 2024 12:00:00 GMT", lastModified)
+
+	_, err = client.FetchContents(context.Background())
+	require.NoError(t, err)
+
+	fetcher.CommitValidators()
+	_, err = client.FetchContents(context.Background())
+	assert.ErrorIs(t, err, port.ErrNotModified)
+}
+
+func TestXMLProvider_ConditionalFetch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-Modified-Since") == "Mon, 01 Jan 2024 12:00:00 GMT" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Write([]byte(`<feed><items></items><meta><total_count>0</total_count><current_page>1</current_page><items_per_page>10</items_per_page></meta></feed>`))
+	}))
+	defer server.Close()
+
+	// Kayıtlı değerler constructor'da yüklenir
+	prov := &entity.Provider{ID: 2, Name: "XML", LastModified: "Mon, 01 Jan 2024 12:00:00 GMT"}
+	_, err := NewXMLProvider(prov, server.URL, nil).FetchContents(context.Background())
+	assert.ErrorIs(t, err, port.ErrNotModified)
+}
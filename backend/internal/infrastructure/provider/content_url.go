@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"net/url"
+	"strings"
+)
+
+// normalizeContentURL provider'dan gelen içerik/küçük resim bağlantısını mutlak bir URL'e çevirir
+// Göreli bağlantılar (ör. "/videos/42") provider adresine göre çözülür, fragment atılır
+// Boş, parse edilemeyen veya http/https olmayan değerler için boş string döner; içerik reddedilmez
+func normalizeContentURL(value, base string) string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return ""
+	}
+
+	u, err := url.Parse(value)
+	if err != nil {
+		return ""
+	}
+	if !u.IsAbs() {
+		baseURL, err := url.Parse(strings.TrimSpace(base))
+		if err != nil || !baseURL.IsAbs() {
+			return ""
+		}
+		u = baseURL.ResolveReference(u)
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ""
+	}
+	u.Host = strings.ToLower(u.Host)
+	u.Fragment = ""
+	return u.String()
+}
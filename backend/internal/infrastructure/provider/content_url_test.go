@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeContentURL(t *testing.T) {
+	const base = "https://API.example.com/feeds/videos.json"
+
+	tests := map[string]string{
+		"":                                   "",
+		"   ":                                "",
+		"https://example.com/watch?v=1":      "https://example.com/watch?v=1",
+		" HTTP://Example.COM/Path#comments ": "http://example.com/Path",
+		"/videos/42":                         "https://api.example.com/videos/42",
+		"thumbs/42.jpg":                      "https://api.example.com/feeds/thumbs/42.jpg",
+		"//cdn.example.com/42.jpg":           "https://cdn.example.com/42.jpg",
+		"ftp://example.com/file":             "",
+		"javascript:alert(1)":                "",
+		"mailto:someone@example.com":         "",
+		"http://%zz":                         "",
+	}
+
+	for input, expected := range tests {
+		assert.Equal(t, expected, normalizeContentURL(input, base), "input: %q", input)
+	}
+
+	// Provider adresi yoksa göreli bağlantılar çözülemez
+	assert.Empty(t, normalizeContentURL("/videos/42", ""))
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// isoDurationPattern ISO 8601 süre biçimi (ör. "PT1H2M3S", "PT45M", "PT90.5S")
+var isoDurationPattern = regexp.MustCompile(`^P(?:(\d+)D)?T?(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?$`)
+
+// parseDurationSeconds provider'ların süre alanını saniyeye çevirir
+// Desteklenen biçimler: saniye ("754"), "MM:SS" / "HH:MM:SS", ISO 8601 ("PT12M34S") ve Go süresi ("12m34s")
+// Boş veya tanınmayan değerler için 0 döner (süre bilinmiyor); diğer metrikler gibi içerik reddedilmez
+func parseDurationSeconds(value string) int32 {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+
+	var seconds float64
+	switch {
+	case strings.Contains(value, ":"):
+		parts := strings.Split(value, ":")
+		if len(parts) > 3 {
+			return 0
+		}
+		for _, part := range parts {
+			n, err := strconv.ParseFloat(part, 64)
+			if err != nil || n < 0 {
+				return 0
+			}
+			seconds = seconds*60 + n
+		}
+	case strings.HasPrefix(strings.ToUpper(value), "P"):
+		m := isoDurationPattern.FindStringSubmatch(strings.ToUpper(value))
+		if m == nil {
+			return 0
+		}
+		for i, unit := range []float64{24 * 60 * 60, 60 * 60, 60, 1} {
+			if m[i+1] != "" {
+				n, _ := strconv.ParseFloat(m[i+1], 64)
+				seconds += n * unit
+			}
+		}
+	default:
+		if n, err := strconv.ParseFloat(value, 64); err == nil {
+			seconds = n
+		} else if d, err := time.ParseDuration(value); err == nil {
+			seconds = d.Seconds()
+		} else {
+			return 0
+		}
+	}
+
+	if seconds < 0 || seconds > math.MaxInt32 {
+		return 0
+	}
+	return int32(math.Round(seconds))
+}
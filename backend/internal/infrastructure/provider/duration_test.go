@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDurationSeconds(t *testing.T) {
+	tests := map[string]int32{
+		"":         0,
+		"754":      754,
+		"12:34":    754,
+		"1:02:03":  3723,
+		"PT12M34S": 754,
+		"pt1h":     3600,
+		"PT90.5S":  91,
+		"P1DT1S":   86401,
+		"10m":      600,
+		"1h2m3s":   3723,
+		" 05:00 ":  300,
+		"abc":      0,
+		"1:2:3:4":  0,
+		"-5":       0,
+		"12:-1":    0,
+		"PT1X":     0,
+	}
+	for value, want := range tests {
+		assert.Equal(t, want, parseDurationSeconds(value), value)
+	}
+}
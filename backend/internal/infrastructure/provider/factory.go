@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// NewProviderClientFactory tüm provider'ların verilen HTTP client'ı paylaştığı bir factory döner
+// client nil ise paylaşımlı varsayılan HTTP client kullanılır
+func NewProviderClientFactory(client *http.Client) port.ProviderClientFactory {
+	return func(p *entity.Provider) (port.ProviderClient, error) {
+		return newProviderClient(p, client)
+	}
+}
+
+// NewProviderClient provider'ın formatına göre varsayılan HTTP client ile uygun client'ı oluşturur
+// port.ProviderClientFactory imzasına uyar
+func NewProviderClient(p *entity.Provider) (port.ProviderClient, error) {
+	return newProviderClient(p, nil)
+}
+
+// newProviderClient provider'ın formatına göre uygun client'ı oluşturur
+func newProviderClient(p *entity.Provider, client *http.Client) (port.ProviderClient, error) {
+	switch p.Format {
+	case "json":
+		return NewJSONProvider(p, p.URL, client), nil
+	case "xml":
+		return NewXMLProvider(p, p.URL, client), nil
+	case "rss":
+		return NewRSSProvider(p, p.URL, client), nil
+	case "rest":
+		if p.Mapping == nil {
+			return nil, fmt.Errorf("%w: rest provider requires a mapping", apperrors.ErrInvalidProvider)
+		}
+		return NewRESTProvider(p, *p.Mapping, client), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", apperrors.ErrInvalidProvider, p.Format)
+	}
+}
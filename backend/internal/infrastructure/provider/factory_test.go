@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProviderClient(t *testing.T) {
+	t.Run("Should create client matching provider format", func(t *testing.T) {
+		for _, format := range []string{"json", "xml", "rss"} {
+			prov := &entity.Provider{ID: 1, Name: "Provider", URL: "http://mock-api:8081/provider", Format: format}
+
+			client, err := NewProviderClient(prov)
+			require.NoError(t, err)
+			assert.Equal(t, prov, client.GetProviderInfo())
+		}
+	})
+
+	t.Run("Should create rest client only with mapping", func(t *testing.T) {
+		prov := &entity.Provider{ID: 1, Name: "REST", URL: "http://mock-api:8081/rest", Format: "rest"}
+
+		_, err := NewProviderClient(prov)
+		assert.ErrorIs(t, err, apperrors.ErrInvalidProvider)
+
+		prov.Mapping = &entity.ProviderMapping{ID: "id", Title: "title", DefaultType: "article", PublishedAt: "date"}
+		client, err := NewProviderClient(prov)
+		require.NoError(t, err)
+		assert.Equal(t, prov, client.GetProviderInfo())
+	})
+
+	t.Run("Should reject unknown format", func(t *testing.T) {
+		_, err := NewProviderClient(&entity.Provider{Format: "csv"})
+		assert.ErrorIs(t, err, apperrors.ErrInvalidProvider)
+	})
+}
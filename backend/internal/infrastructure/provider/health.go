@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"golang.org/x/time/rate"
+)
+
+// probe provider endpoint'ine sağlık kontrolü isteği gönderir
+// Önce HEAD denenir; provider HEAD desteklemiyorsa (405/501) aynı adres GET ile istenir
+// Retry uygulanmaz: sonuç provider'ın o anki durumunu yansıtmalıdır
+func probe(ctx context.Context, client *http.Client, limiter *rate.Limiter, auth authorizer, rawURL string) (int, error) {
+	statusCode, err := probeWithMethod(ctx, client, limiter, auth, http.MethodHead, rawURL)
+	if err == nil || (statusCode != http.StatusMethodNotAllowed && statusCode != http.StatusNotImplemented) {
+		return statusCode, err
+	}
+	return probeWithMethod(ctx, client, limiter, auth, http.MethodGet, rawURL)
+}
+
+// probeWithMethod tek bir probe isteği gönderir; 2xx ve 3xx yanıtlar sağlıklı kabul edilir
+func probeWithMethod(ctx context.Context, client *http.Client, limiter *rate.Limiter, auth authorizer, method, rawURL string) (int, error) {
+	// Probe'lar senkronizasyonla aynı rate limit'i paylaşır
+	if err := limiter.Wait(ctx); err != nil {
+		return 0, fmt.Errorf("rate limiter hatası: %w", err)
+	}
+
+	req, err := newAuthorizedRequest(ctx, auth, rawURL)
+	if err != nil {
+		return 0, err
+	}
+	req.Method = method
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("provider erişilemiyor: %w", err)
+	}
+	defer resp.Body.Close()
+	// Bağlantının pool'a dönebilmesi için body'nin küçük bir kısmı okunur, fazlası atılır
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return resp.StatusCode, fmt.Errorf("provider hata döndü: %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+func TestProviderCheckHealth(t *testing.T) {
+	t.Run("probes first page with HEAD", func(t *testing.T) {
+		var method, query string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			method, query = r.Method, r.URL.RawQuery
+		}))
+		defer server.Close()
+
+		client := NewJSONProvider(&entity.Provider{ID: 1, Name: "JSON"}, server.URL, nil)
+		statusCode, err := client.(port.HealthChecker).CheckHealth(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, statusCode)
+		assert.Equal(t, http.MethodHead, method)
+		assert.Equal(t, "page=1", query)
+	})
+
+	t.Run("falls back to GET when HEAD is not allowed", func(t *testing.T) {
+		var methods []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			methods = append(methods, r.Method)
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			w.Write([]byte(`<rss><channel></channel></rss>`))
+		}))
+		defer server.Close()
+
+		client := NewRSSProvider(&entity.Provider{ID: 2, Name: "RSS"}, server.URL, nil)
+		statusCode, err := client.(port.HealthChecker).CheckHealth(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, statusCode)
+		assert.Equal(t, []string{http.MethodHead, http.MethodGet}, methods)
+	})
+
+	t.Run("reports provider errors without retrying", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		client := NewXMLProvider(&entity.Provider{ID: 3, Name: "XML"}, server.URL, nil)
+		statusCode, err := client.(port.HealthChecker).CheckHealth(context.Background())
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, statusCode)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("reports unreachable providers", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		server.Close()
+
+		mapping := entity.ProviderMapping{ID: "id", Title: "title"}
+		client := NewRESTProvider(&entity.Provider{ID: 4, Name: "REST", URL: server.URL}, mapping, nil)
+		statusCode, err := client.(port.HealthChecker).CheckHealth(context.Background())
+		assert.Error(t, err)
+		assert.Equal(t, 0, statusCode)
+	})
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Varsayılan provider HTTP client ayarları
+const (
+	defaultHTTPTimeout         = 30 * time.Second
+	defaultHTTPDialTimeout     = 5 * time.Second
+	defaultMaxIdleConnsPerHost = 10
+	defaultIdleConnTimeout     = 90 * time.Second
+)
+
+// HTTPClientOptions provider'ların paylaştığı HTTP client ayarları
+// Sıfır değerli alanlar için varsayılanlar kullanılır
+type HTTPClientOptions struct {
+	Timeout             time.Duration // Tek bir isteğin toplam süresi (body okuma dahil)
+	DialTimeout         time.Duration // TCP bağlantı kurma süresi
+	MaxIdleConnsPerHost int           // Provider başına keep-alive ile açık tutulan bağlantı sayısı
+	IdleConnTimeout     time.Duration // Boştaki bağlantının kapatılma süresi
+	ProxyURL            string        // Boşsa HTTP_PROXY / HTTPS_PROXY / NO_PROXY kullanılır
+}
+
+// defaultHTTPClient client verilmeden oluşturulan provider'lar için paylaşımlı client
+var defaultHTTPClient, _ = NewHTTPClient(HTTPClientOptions{})
+
+// NewHTTPClient connection pooling, timeout ve proxy ayarlı bir HTTP client oluşturur
+// Dönen client tüm provider'lar arasında paylaşılmak üzere tasarlanmıştır
+func NewHTTPClient(opts HTTPClientOptions) (*http.Client, error) {
+	if opts.Timeout <= 0 {
+		opts.Timeout = defaultHTTPTimeout
+	}
+	if opts.DialTimeout <= 0 {
+		opts.DialTimeout = defaultHTTPDialTimeout
+	}
+	if opts.MaxIdleConnsPerHost <= 0 {
+		opts.MaxIdleConnsPerHost = defaultMaxIdleConnsPerHost
+	}
+	if opts.IdleConnTimeout <= 0 {
+		opts.IdleConnTimeout = defaultIdleConnTimeout
+	}
+
+	proxy := http.ProxyFromEnvironment
+	if opts.ProxyURL != "" {
+		proxyURL, err := url.Parse(opts.ProxyURL)
+		if err != nil || proxyURL.Host == "" {
+			return nil, fmt.Errorf("geçersiz proxy URL: %q", opts.ProxyURL)
+		}
+		proxy = http.ProxyURL(proxyURL)
+	}
+
+	dialer := &net.Dialer{Timeout: opts.DialTimeout, KeepAlive: 30 * time.Second}
+	transport := &http.Transport{
+		Proxy:                 proxy,
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   opts.MaxIdleConnsPerHost,
+		IdleConnTimeout:       opts.IdleConnTimeout,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: time.Second,
+	}
+
+	return &http.Client{Timeout: opts.Timeout, Transport: transport}, nil
+}
+
+// httpClientOrDefault nil client yerine paylaşımlı varsayılan client'ı döner
+func httpClientOrDefault(client *http.Client) *http.Client {
+	if client == nil {
+		return defaultHTTPClient
+	}
+	return client
+}
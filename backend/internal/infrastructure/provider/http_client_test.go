@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+)
+
+func TestNewHTTPClient(t *testing.T) {
+	t.Run("applies defaults", func(t *testing.T) {
+		client, err := NewHTTPClient(HTTPClientOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, defaultHTTPTimeout, client.Timeout)
+
+		transport := client.Transport.(*http.Transport)
+		assert.Equal(t, defaultMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+		assert.Equal(t, defaultIdleConnTimeout, transport.IdleConnTimeout)
+	})
+
+	t.Run("rejects invalid proxy URL", func(t *testing.T) {
+		_, err := NewHTTPClient(HTTPClientOptions{ProxyURL: "not a url"})
+		assert.Error(t, err)
+	})
+
+	t.Run("routes requests through proxy", func(t *testing.T) {
+		var proxied string
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			proxied = r.URL.String()
+		}))
+		defer proxy.Close()
+
+		client, err := NewHTTPClient(HTTPClientOptions{ProxyURL: proxy.URL})
+		require.NoError(t, err)
+
+		resp, err := client.Get("http://provider.example.com/feed")
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, "http://provider.example.com/feed", proxied)
+	})
+
+	t.Run("times out slow providers", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(200 * time.Millisecond)
+		}))
+		defer server.Close()
+
+		client, err := NewHTTPClient(HTTPClientOptions{Timeout: 20 * time.Millisecond})
+		require.NoError(t, err)
+
+		_, err = client.Get(server.URL)
+		assert.Error(t, err)
+	})
+}
+
+func TestJSONProvider_HonorsContextCancellation(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := NewJSONProvider(&entity.Provider{ID: 1, Name: "Slow JSON"}, server.URL, nil).FetchContents(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+}
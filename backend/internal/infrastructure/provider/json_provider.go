@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
 	"time"
 
@@ -18,7 +17,12 @@ import (
 type jsonProvider struct {
 	provider *entity.Provider
 	apiURL   string
+	client   *http.Client
+	auth     authorizer
+	retry    retryPolicy
 	limiter  *rate.Limiter
+	conditionalState
+	rejectionLog
 }
 
 // JSONContent JSON dosyasındaki içerik yapısı
@@ -29,13 +33,25 @@ type JSONContent struct {
 	Metrics     JSONMetrics `json:"metrics"`
 	PublishedAt string      `json:"published_at"`
 	Tags        []string    `json:"tags"`
+	Author      *JSONAuthor `json:"author,omitempty"`
+	Channel     *JSONAuthor `json:"channel,omitempty"` // Videolarda author yerine kullanılabilir
+	URL         string      `json:"url,omitempty"`
+	Thumbnail   string      `json:"thumbnail_url,omitempty"`
+	Category    string      `json:"category,omitempty"` // "Programming > Go" veya "programming/go"
+}
+
+// JSONAuthor JSON'daki yazar/kanal yapısı
+type JSONAuthor struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
 }
 
 // JSONMetrics JSON'daki metrics yapısı
 type JSONMetrics struct {
 	Views       int64  `json:"views"`
 	Likes       int32  `json:"likes"`
-	Duration    string `json:"duration,omitempty"`     // Video için
+	Duration    string `json:"duration,omitempty"`     // Video için ("MM:SS", "HH:MM:SS", ISO 8601 veya saniye)
 	ReadingTime int32  `json:"reading_time,omitempty"` // Article için
 	Reactions   int32  `json:"reactions,omitempty"`    // Article için
 }
@@ -51,92 +67,94 @@ type JSONResponse struct {
 }
 
 // NewJSONProvider yeni bir JSON provider client oluşturur
-func NewJSONProvider(provider *entity.Provider, apiURL string) port.ProviderClient {
+// client nil ise paylaşımlı varsayılan HTTP client kullanılır
+func NewJSONProvider(provider *entity.Provider, apiURL string, client *http.Client) port.ProviderClient {
 	// Rate Limiter: Saniyede 1 istek (Burst 1)
-	return &jsonProvider{
+	p := &jsonProvider{
 		provider: provider,
 		apiURL:   apiURL,
+		client:   httpClientOrDefault(client),
+		auth:     providerAuthorizer(provider),
+		retry:    providerRetryPolicy(provider),
 		limiter:  rate.NewLimiter(rate.Every(time.Second), 1),
 	}
+	p.initValidators(provider)
+	return p
 }
 
 // FetchContents Mock API'den içerikleri sayfalar halinde çeker ve normalize eder
 func (p *jsonProvider) FetchContents(ctx context.Context) ([]*entity.NormalizedContent, error) {
-	var allNormalized []*entity.NormalizedContent
-	var page int = 1
-	var totalPages int = 1 // En az bir sayfa var varsayıyoruz
-
-	for page <= totalPages {
-		// Rate Limiter bekleme
-		if err := p.limiter.Wait(ctx); err != nil {
-			return nil, fmt.Errorf("rate limiter hatası: %w", err)
-		}
-
-		// Mock API'den sayfayı çek
-		var resp *http.Response
-		var err error
-		maxRetries := 3
-		for i := 0; i < maxRetries; i++ {
-			url := fmt.Sprintf("%s?page=%d", p.apiURL, page)
-			resp, err = http.Get(url)
-			if err == nil && resp.StatusCode == http.StatusOK {
-				break
-			}
-			if resp != nil {
-				resp.Body.Close()
-			}
-			log.Printf("JSON API retry %d/%d (Page %d): %v", i+1, maxRetries, page, err)
-			time.Sleep(time.Second * time.Duration(i+1)) // Exponential backoff benzeri
-		}
+	p.resetRejections()
+	return fetchAllPages(ctx, p.fetchPage)
+}
 
-		if err != nil {
-			return nil, fmt.Errorf("JSON API isteği başarısız: %w", err)
-		}
-		defer resp.Body.Close()
+// fetchPage tek bir sayfayı çeker ve normalize eder
+func (p *jsonProvider) fetchPage(ctx context.Context, page int) ([]*entity.NormalizedContent, pageInfo, error) {
+	// Rate Limiter bekleme
+	if err := p.limiter.Wait(ctx); err != nil {
+		return nil, pageInfo{}, fmt.Errorf("rate limiter hatası: %w", err)
+	}
 
-		if resp.StatusCode != http.StatusOK {
-			return nil, fmt.Errorf("JSON API hata döndü: %d", resp.StatusCode)
-		}
+	// Mock API'den sayfayı çek
+	url := fmt.Sprintf("%s?page=%d", p.apiURL, page)
+	req, err := newAuthorizedRequest(ctx, p.auth, url)
+	if err != nil {
+		return nil, pageInfo{}, err
+	}
+	// İçerik değişmediyse ilk sayfa 304 döner, kalan sayfalar çekilmez
+	if page == 1 {
+		p.applyConditional(req)
+	}
+	resp, err := p.retry.do(ctx, fmt.Sprintf("JSON API (Page %d)", page), func() (*http.Response, error) {
+		return p.client.Do(req)
+	})
+	if err != nil {
+		return nil, pageInfo{}, fmt.Errorf("JSON API isteği başarısız: %w", err)
+	}
+	defer resp.Body.Close()
 
-		// Body'i oku (Raw Data için)
-		bodyBytes, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return nil, fmt.Errorf("response body okuma hatası: %w", err)
-		}
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, pageInfo{}, port.ErrNotModified
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, pageInfo{}, fmt.Errorf("JSON API hata döndü: %d", resp.StatusCode)
+	}
+	if page == 1 {
+		p.recordValidators(resp)
+	}
 
-		// JSON parse et
-		var response JSONResponse
-		if err := json.Unmarshal(bodyBytes, &response); err != nil {
-			return nil, fmt.Errorf("JSON parse hatası: %w", err)
-		}
+	// Body'i oku (Raw Data için)
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, pageInfo{}, fmt.Errorf("response body okuma hatası: %w", err)
+	}
 
-		// İlk sayfada toplam sayfa sayısını hesapla
-		if page == 1 && response.Pagination.PerPage > 0 {
-			totalPages = (response.Pagination.Total + response.Pagination.PerPage - 1) / response.Pagination.PerPage
-		}
+	// JSON parse et
+	var response JSONResponse
+	if err := json.Unmarshal(bodyBytes, &response); err != nil {
+		return nil, pageInfo{}, fmt.Errorf("JSON parse hatası: %w", err)
+	}
 
-		if len(allNormalized) >= response.Pagination.Total || len(allNormalized) >= 1000 { // Güvenlik sınırı 1000
-			break
-		}
+	// Normalize et
+	normalized := make([]*entity.NormalizedContent, 0, len(response.Contents))
+	for _, raw := range response.Contents {
+		// Item'a özel raw datayı saklamak için tekrar marshal ediyoruz
+		itemRawBytes, _ := json.Marshal(raw)
 
-		// Normalize et
-		for _, raw := range response.Contents {
-			// Bu içerik için raw datayı tekrar marshal ediyoruz (bireysel raw data saklamak istiyorsak)
-			// Veya tüm sayfanın raw datasını saklayamayız çünkü context item bazlı. 
-			// En doğrusu item'a ait raw datayı saklamak.
-			itemRawBytes, _ := json.Marshal(raw)
-			
-			content, err := p.normalize(raw, string(itemRawBytes))
-			if err != nil {
-				continue
-			}
-			allNormalized = append(allNormalized, content)
+		content, err := p.normalize(raw, string(itemRawBytes))
+		if err != nil {
+			p.reject(raw.ID, string(itemRawBytes), err)
+			continue
 		}
-
-		page++
+		normalized = append(normalized, content)
 	}
 
-	return allNormalized, nil
+	return normalized, pageInfo{Total: response.Pagination.Total, PerPage: response.Pagination.PerPage}, nil
+}
+
+// CheckHealth provider'ın ilk sayfayı probe eder
+func (p *jsonProvider) CheckHealth(ctx context.Context) (int, error) {
+	return probe(ctx, p.client, p.limiter, p.auth, fmt.Sprintf("%s?page=1", p.apiURL))
 }
 
 // GetProviderInfo provider bilgilerini döner
@@ -153,13 +171,8 @@ func (p *jsonProvider) normalize(raw JSONContent, rawData string) (*entity.Norma
 	}
 
 	// İçerik türünü belirle
-	var contentType entity.ContentType
-	switch raw.Type {
-	case "video":
-		contentType = entity.ContentTypeVideo
-	case "article":
-		contentType = entity.ContentTypeArticle
-	default:
+	contentType, ok := entity.ParseContentType(raw.Type)
+	if !ok {
 		return nil, fmt.Errorf("geçersiz içerik türü: %s", raw.Type)
 	}
 
@@ -171,12 +184,30 @@ func (p *jsonProvider) normalize(raw JSONContent, rawData string) (*entity.Norma
 		ContentType: contentType,
 		PublishedAt: publishedAt,
 		Stats: entity.ContentStats{
-			Views:       raw.Metrics.Views,
-			Likes:       raw.Metrics.Likes,
-			ReadingTime: raw.Metrics.ReadingTime,
-			Reactions:   raw.Metrics.Reactions,
+			Views:           raw.Metrics.Views,
+			Likes:           raw.Metrics.Likes,
+			ReadingTime:     raw.Metrics.ReadingTime,
+			Reactions:       raw.Metrics.Reactions,
+			DurationSeconds: parseDurationSeconds(raw.Metrics.Duration),
 		},
-		Tags:    raw.Tags,
-		RawData: rawData,
+		Tags:         raw.Tags,
+		Author:       jsonAuthor(raw),
+		URL:          normalizeContentURL(raw.URL, p.apiURL),
+		ThumbnailURL: normalizeContentURL(raw.Thumbnail, p.apiURL),
+		Category:     entity.ParseCategoryPath(raw.Category),
+		RawData:      rawData,
 	}, nil
 }
+
+// jsonAuthor içeriğin yazarını, yoksa kanalını döner
+func jsonAuthor(raw JSONContent) *entity.NormalizedAuthor {
+	for _, a := range []*JSONAuthor{raw.Author, raw.Channel} {
+		if a == nil {
+			continue
+		}
+		if author := newNormalizedAuthor(a.ID, a.Name, a.URL); author != nil {
+			return author
+		}
+	}
+	return nil
+}
@@ -12,7 +12,7 @@ import (
 func TestJSONProvider_Normalize(t *testing.T) {
 	// Setup
 	prov := &entity.Provider{ID: 1, Name: "Test Provider"}
-	p := &jsonProvider{provider: prov}
+	p := &jsonProvider{provider: prov, apiURL: "https://api.example.com/contents"}
 
 	t.Run("Should correctly normalize valid JSON video content", func(t *testing.T) {
 		raw := JSONContent{
@@ -26,6 +26,9 @@ func TestJSONProvider_Normalize(t *testing.T) {
 			},
 			PublishedAt: "2024-01-01T12:00:00Z",
 			Tags:        []string{"go", "tutorial"},
+			URL:         "https://example.com/videos/123",
+			Thumbnail:   "/thumbs/123.jpg",
+			Category:    "Programming > Go",
 		}
 		
 		// Simulate raw data string
@@ -41,6 +44,10 @@ func TestJSONProvider_Normalize(t *testing.T) {
 		assert.Equal(t, entity.ContentTypeVideo, normalized.ContentType)
 		assert.Equal(t, int64(1000), normalized.Stats.Views)
 		assert.Equal(t, int32(500), normalized.Stats.Likes)
+		assert.Equal(t, int32(600), normalized.Stats.DurationSeconds) // "10m"
+		assert.Equal(t, "https://example.com/videos/123", normalized.URL)
+		assert.Equal(t, "https://api.example.com/thumbs/123.jpg", normalized.ThumbnailURL)
+		assert.Equal(t, []string{"Programming", "Go"}, normalized.Category)
 		expectedTime, _ := time.Parse(time.RFC3339, "2024-01-01T12:00:00Z")
 		assert.Equal(t, expectedTime, normalized.PublishedAt)
 		assert.Equal(t, rawData, normalized.RawData) // Verify RawData storage
@@ -61,6 +68,19 @@ func TestJSONProvider_Normalize(t *testing.T) {
 		assert.Contains(t, err.Error(), "tarih parse hatası")
 	})
 
+	t.Run("Should accept registered content types case-insensitively", func(t *testing.T) {
+		raw := JSONContent{
+			ID:          "podcast-1",
+			Title:       "Go Time",
+			Type:        "Podcast",
+			PublishedAt: "2024-01-01T12:00:00Z",
+		}
+
+		normalized, err := p.normalize(raw, "")
+		assert.NoError(t, err)
+		assert.Equal(t, entity.ContentTypePodcast, normalized.ContentType)
+	})
+
 	t.Run("Should return error for unknown content type", func(t *testing.T) {
 		raw := JSONContent{
 			ID:          "123",
@@ -75,4 +95,24 @@ func TestJSONProvider_Normalize(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "geçersiz içerik türü")
 	})
+
+	t.Run("Should use channel as author when author is missing", func(t *testing.T) {
+		raw := JSONContent{
+			ID:          "video-456",
+			Title:       "Channel Video",
+			Type:        "video",
+			PublishedAt: "2024-01-01T12:00:00Z",
+			Channel:     &JSONAuthor{ID: "ch-1", Name: "Go Channel", URL: "https://example.com/ch/1"},
+		}
+
+		normalized, err := p.normalize(raw, "")
+		assert.NoError(t, err)
+		assert.Equal(t, &entity.NormalizedAuthor{ExternalID: "ch-1", Name: "Go Channel", URL: "https://example.com/ch/1"}, normalized.Author)
+
+		// İsmi boş yazar yok sayılır
+		raw.Channel = &JSONAuthor{ID: "ch-2"}
+		normalized, err = p.normalize(raw, "")
+		assert.NoError(t, err)
+		assert.Nil(t, normalized.Author)
+	})
 }
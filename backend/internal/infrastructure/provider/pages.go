@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"sync"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+)
+
+// Sayfalı provider'lar için ortak sınırlar
+const (
+	maxProviderContents = 1000 // Güvenlik sınırı: tek senkronizasyonda çekilecek en fazla içerik
+	pageFetchWorkers    = 4    // Kalan sayfaları paralel çeken worker sayısı
+)
+
+// pageInfo provider response'undaki sayfalama bilgisi
+type pageInfo struct {
+	Total   int
+	PerPage int
+}
+
+// pageFetcher tek bir sayfayı çekip normalize eder
+type pageFetcher func(ctx context.Context, page int) ([]*entity.NormalizedContent, pageInfo, error)
+
+// fetchAllPages ilk sayfayı çeker, toplam sayfa sayısı öğrenildikten sonra kalan sayfaları
+// sınırlı sayıda worker ile paralel çeker. Rate limiter fetch içinde beklendiğinden istek hızı değişmez,
+// sadece yavaş yanıtlar birbirini beklemez. Sonuçlar sayfa sırasıyla birleştirilir;
+// herhangi bir sayfa hata verirse kalan istekler iptal edilir
+func fetchAllPages(ctx context.Context, fetch pageFetcher) ([]*entity.NormalizedContent, error) {
+	first, info, err := fetch(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := 1
+	if info.PerPage > 0 {
+		totalPages = (info.Total + info.PerPage - 1) / info.PerPage
+		maxPages := (maxProviderContents + info.PerPage - 1) / info.PerPage
+		totalPages = min(totalPages, maxPages)
+	}
+
+	results := make([][]*entity.NormalizedContent, max(totalPages, 1))
+	results[0] = first
+
+	if totalPages > 1 {
+		if err := fetchRemainingPages(ctx, fetch, totalPages, results); err != nil {
+			return nil, err
+		}
+	}
+
+	var all []*entity.NormalizedContent
+	for _, contents := range results {
+		all = append(all, contents...)
+	}
+	if len(all) > maxProviderContents {
+		all = all[:maxProviderContents]
+	}
+	return all, nil
+}
+
+// fetchRemainingPages 2..totalPages arasındaki sayfaları worker pool ile çekip results'a yazar
+func fetchRemainingPages(ctx context.Context, fetch pageFetcher, totalPages int, results [][]*entity.NormalizedContent) error {
+	workerCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	pages := make(chan int)
+
+	for i := 0; i < min(pageFetchWorkers, totalPages-1); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for page := range pages {
+				contents, _, err := fetch(workerCtx, page)
+				if err != nil {
+					errOnce.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					continue
+				}
+				results[page-1] = contents
+			}
+		}()
+	}
+
+dispatch:
+	for page := 2; page <= totalPages; page++ {
+		select {
+		case pages <- page:
+		case <-workerCtx.Done():
+			break dispatch
+		}
+	}
+	close(pages)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
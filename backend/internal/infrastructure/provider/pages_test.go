@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+)
+
+// fakePages her sayfa için perPage adet içerik döner ve eşzamanlı istek sayısını ölçer
+type fakePages struct {
+	mu          sync.Mutex
+	total       int
+	perPage     int
+	failPage    int
+	inFlight    int
+	maxInFlight int
+	fetched     []int
+}
+
+func (f *fakePages) fetch(ctx context.Context, page int) ([]*entity.NormalizedContent, pageInfo, error) {
+	f.mu.Lock()
+	f.inFlight++
+	f.maxInFlight = max(f.maxInFlight, f.inFlight)
+	f.fetched = append(f.fetched, page)
+	f.mu.Unlock()
+
+	defer func() {
+		f.mu.Lock()
+		f.inFlight--
+		f.mu.Unlock()
+	}()
+
+	// Sonraki sayfalar daha hızlı döner, sıralama yine de korunmalı
+	select {
+	case <-time.After(time.Duration(20-page%20) * time.Millisecond):
+	case <-ctx.Done():
+		return nil, pageInfo{}, ctx.Err()
+	}
+	if page == f.failPage {
+		return nil, pageInfo{}, errors.New("page failed")
+	}
+
+	contents := make([]*entity.NormalizedContent, 0, f.perPage)
+	for i := 0; i < f.perPage; i++ {
+		contents = append(contents, &entity.NormalizedContent{ExternalID: fmt.Sprintf("%d-%d", page, i)})
+	}
+	return contents, pageInfo{Total: f.total, PerPage: f.perPage}, nil
+}
+
+func TestFetchAllPages(t *testing.T) {
+	t.Run("fetches remaining pages concurrently in order", func(t *testing.T) {
+		pages := &fakePages{total: 95, perPage: 10}
+
+		contents, err := fetchAllPages(context.Background(), pages.fetch)
+		require.NoError(t, err)
+
+		assert.Len(t, pages.fetched, 10)
+		assert.Len(t, contents, 100)
+		assert.Equal(t, "1-0", contents[0].ExternalID)
+		assert.Equal(t, "2-0", contents[10].ExternalID)
+		assert.Equal(t, "10-9", contents[99].ExternalID)
+
+		assert.Greater(t, pages.maxInFlight, 1, "remaining pages should be fetched concurrently")
+		assert.LessOrEqual(t, pages.maxInFlight, pageFetchWorkers)
+	})
+
+	t.Run("caps the number of pages", func(t *testing.T) {
+		pages := &fakePages{total: 100000, perPage: 100}
+
+		contents, err := fetchAllPages(context.Background(), pages.fetch)
+		require.NoError(t, err)
+
+		assert.Len(t, pages.fetched, maxProviderContents/100)
+		assert.Len(t, contents, maxProviderContents)
+	})
+
+	t.Run("single page without pagination", func(t *testing.T) {
+		pages := &fakePages{total: 3, perPage: 0}
+
+		_, err := fetchAllPages(context.Background(), pages.fetch)
+		require.NoError(t, err)
+		assert.Equal(t, []int{1}, pages.fetched)
+	})
+
+	t.Run("fails and cancels when a page fails", func(t *testing.T) {
+		pages := &fakePages{total: 500, perPage: 10, failPage: 3}
+
+		_, err := fetchAllPages(context.Background(), pages.fetch)
+		assert.EqualError(t, err, "page failed")
+		assert.Less(t, len(pages.fetched), 50, "remaining pages should not be dispatched after a failure")
+	})
+}
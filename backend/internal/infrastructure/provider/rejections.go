@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"sync"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+)
+
+// rejectionLog son fetch'te normalize edilemeyen içerikleri toplar (port.RejectionReporter)
+// Sayfalar paralel çekilebildiğinden eşzamanlı kullanıma uygundur
+type rejectionLog struct {
+	rejectionsMu sync.Mutex
+	rejections   []*entity.ProviderSyncError
+}
+
+// resetRejections yeni bir fetch başlarken önceki kayıtları temizler
+func (l *rejectionLog) resetRejections() {
+	l.rejectionsMu.Lock()
+	defer l.rejectionsMu.Unlock()
+	l.rejections = nil
+}
+
+// reject normalize edilemeyen içeriği ham verisiyle kaydeder
+func (l *rejectionLog) reject(externalID, rawData string, err error) {
+	l.rejectionsMu.Lock()
+	defer l.rejectionsMu.Unlock()
+	l.rejections = append(l.rejections, &entity.ProviderSyncError{
+		ExternalID: externalID,
+		Reason:     err.Error(),
+		RawData:    rawData,
+	})
+}
+
+// Rejections son FetchContents çağrısında atlanan içeriklerin kopyasını döner
+func (l *rejectionLog) Rejections() []*entity.ProviderSyncError {
+	l.rejectionsMu.Lock()
+	defer l.rejectionsMu.Unlock()
+	return append([]*entity.ProviderSyncError(nil), l.rejections...)
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+func TestJSONProvider_ReportsRejectedItems(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{
+			"contents": [
+				{"id": "v1", "title": "Valid", "type": "video", "published_at": "2024-01-01T12:00:00Z"},
+				{"id": "v2", "title": "Bad Date", "type": "video", "published_at": "yesterday"}
+			],
+			"pagination": {"total": 2, "page": 1, "per_page": 10}
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewJSONProvider(&entity.Provider{ID: 1, Name: "JSON"}, server.URL, nil)
+	contents, err := client.FetchContents(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, contents, 1)
+
+	reporter, ok := client.(port.RejectionReporter)
+	require.True(t, ok)
+	rejections := reporter.Rejections()
+	require.Len(t, rejections, 1)
+	assert.Equal(t, "v2", rejections[0].ExternalID)
+	assert.Contains(t, rejections[0].Reason, "tarih parse hatası")
+	assert.Contains(t, rejections[0].RawData, `"published_at":"yesterday"`)
+}
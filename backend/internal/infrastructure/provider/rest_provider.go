@@ -0,0 +1,330 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/tidwall/gjson"
+	"golang.org/x/time/rate"
+)
+
+// restProvider alan eşlemesi veritabanında tutulan generic JSON provider client implementasyonu
+// Yeni JSON provider'lar Go kodu yazmadan sadece mapping tanımlanarak eklenebilir
+type restProvider struct {
+	provider *entity.Provider
+	mapping  entity.ProviderMapping
+	auth     authorizer
+	retry    retryPolicy
+	client   *http.Client
+	limiter  *rate.Limiter
+	rejectionLog
+}
+
+// NewRESTProvider yeni bir generic REST provider client oluşturur
+// client nil ise paylaşımlı varsayılan HTTP client kullanılır
+func NewRESTProvider(provider *entity.Provider, mapping entity.ProviderMapping, client *http.Client) port.ProviderClient {
+	// Rate Limiter: Saniyede 1 istek (Burst 1)
+	return &restProvider{
+		provider: provider,
+		mapping:  gjsonMapping(mapping),
+		auth:     providerAuthorizer(provider),
+		retry:    providerRetryPolicy(provider),
+		client:   httpClientOrDefault(client),
+		limiter:  rate.NewLimiter(rate.Every(time.Second), 1),
+	}
+}
+
+// FetchContents endpoint'i çeker ve mapping'e göre içerikleri normalize eder
+// Eşlenemeyen içerikler atlanıp raporlanır
+func (p *restProvider) FetchContents(ctx context.Context) ([]*entity.NormalizedContent, error) {
+	p.resetRejections()
+
+	if err := p.limiter.Wait(ctx); err != nil {
+		return nil, fmt.Errorf("rate limiter hatası: %w", err)
+	}
+
+	req, err := newAuthorizedRequest(ctx, p.auth, p.provider.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.retry.do(ctx, "REST API", func() (*http.Response, error) {
+		return p.client.Do(req)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("REST API isteği başarısız: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("REST API hata döndü: %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("response body okuma hatası: %w", err)
+	}
+
+	return p.parse(body)
+}
+
+// CheckHealth provider'ın endpoint'i probe eder
+func (p *restProvider) CheckHealth(ctx context.Context) (int, error) {
+	return probe(ctx, p.client, p.limiter, p.auth, p.provider.URL)
+}
+
+// GetProviderInfo provider bilgilerini döner
+func (p *restProvider) GetProviderInfo() *entity.Provider {
+	return p.provider
+}
+
+// parse response gövdesinden içerik dizisini bulur ve her elemanı normalize eder
+func (p *restProvider) parse(body []byte) ([]*entity.NormalizedContent, error) {
+	if !gjson.ValidBytes(body) {
+		return nil, fmt.Errorf("JSON parse hatası: geçersiz JSON")
+	}
+
+	itemsValue := gjson.ParseBytes(body)
+	if p.mapping.ItemsPath != "" {
+		var ok bool
+		if itemsValue, ok = lookupPath(itemsValue, p.mapping.ItemsPath); !ok {
+			return nil, fmt.Errorf("içerik dizisi bulunamadı: %s", p.mapping.ItemsPath)
+		}
+	}
+	if !itemsValue.IsArray() {
+		return nil, fmt.Errorf("içerik dizisi bir JSON array değil: %s", p.mapping.ItemsPath)
+	}
+
+	var allNormalized []*entity.NormalizedContent
+	for _, item := range itemsValue.Array() {
+		content, err := p.normalize(item)
+		if err != nil {
+			p.reject(stringAt(item, p.mapping.ID), compactRaw(item), err)
+			continue
+		}
+		allNormalized = append(allNormalized, content)
+	}
+
+	return allNormalized, nil
+}
+
+// normalize tek bir JSON elemanını mapping'e göre NormalizedContent'e dönüştürür
+func (p *restProvider) normalize(item gjson.Result) (*entity.NormalizedContent, error) {
+	m := p.mapping
+
+	id := stringAt(item, m.ID)
+	if id == "" {
+		return nil, fmt.Errorf("ID eksik")
+	}
+
+	title := stringAt(item, m.Title)
+	if title == "" {
+		return nil, fmt.Errorf("başlık eksik (ID: %s)", id)
+	}
+
+	typeName := stringAt(item, m.Type)
+	if typeName == "" {
+		typeName = m.DefaultType
+	}
+	contentType, ok := entity.ParseContentType(typeName)
+	if !ok {
+		return nil, fmt.Errorf("geçersiz içerik türü: %s", typeName)
+	}
+
+	rawDate, _ := lookupPath(item, m.PublishedAt)
+	publishedAt, err := parseMappedDate(rawDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.NormalizedContent{
+		ExternalID:  id,
+		Title:       title,
+		Description: stringAt(item, m.Description),
+		ContentType: contentType,
+		PublishedAt: publishedAt,
+		Stats: entity.ContentStats{
+			Views:           intAt(item, m.Views),
+			Likes:           int32(intAt(item, m.Likes)),
+			ReadingTime:     int32(intAt(item, m.ReadingTime)),
+			Reactions:       int32(intAt(item, m.Reactions)),
+			DurationSeconds: parseDurationSeconds(stringAt(item, m.Duration)),
+		},
+		Tags:         tagsAt(item, m.Tags),
+		Author:       newNormalizedAuthor(stringAt(item, m.AuthorID), stringAt(item, m.AuthorName), stringAt(item, m.AuthorURL)),
+		URL:          normalizeContentURL(stringAt(item, m.URL), p.baseURL()),
+		ThumbnailURL: normalizeContentURL(stringAt(item, m.ThumbnailURL), p.baseURL()),
+		Category:     categoryAt(item, m.Category),
+		RawData:      compactRaw(item),
+	}, nil
+}
+
+// baseURL göreli içerik bağlantılarının çözüleceği provider adresi
+func (p *restProvider) baseURL() string {
+	if p.provider == nil {
+		return ""
+	}
+	return p.provider.URL
+}
+
+// gjsonMapping mapping'deki JSONPath yollarını gjson yollarına çevirir
+// Yollar provider kaydedilirken doğrulanır (bkz. usecase.validateMapping); çevrilemeyen yol olduğu gibi bırakılır
+func gjsonMapping(m entity.ProviderMapping) entity.ProviderMapping {
+	for _, path := range []*string{
+		&m.ItemsPath, &m.ID, &m.Title, &m.Description, &m.Type, &m.PublishedAt, &m.Views, &m.Likes,
+		&m.ReadingTime, &m.Reactions, &m.Duration, &m.Tags, &m.Category, &m.AuthorID, &m.AuthorName,
+		&m.AuthorURL, &m.URL, &m.ThumbnailURL,
+	} {
+		if *path == "" {
+			continue
+		}
+		if converted, err := entity.MappingPathToGJSON(*path); err == nil {
+			*path = converted
+		}
+	}
+	return m
+}
+
+// lookupPath gjson yolunu JSON değeri üzerinde izler; yol yoksa veya değer null ise false döner
+func lookupPath(value gjson.Result, path string) (gjson.Result, bool) {
+	if path == "" {
+		return gjson.Result{}, false
+	}
+	result := value.Get(path)
+	return result, result.Exists() && result.Type != gjson.Null
+}
+
+// compactRaw elemanın ham JSON'ını boşluksuz döner
+func compactRaw(item gjson.Result) string {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, []byte(item.Raw)); err != nil {
+		return item.Raw
+	}
+	return buf.String()
+}
+
+// stringAt yoldaki değeri string olarak döner (sayılar da kabul edilir)
+func stringAt(item gjson.Result, path string) string {
+	value, ok := lookupPath(item, path)
+	if !ok {
+		return ""
+	}
+	switch value.Type {
+	case gjson.String:
+		return strings.TrimSpace(value.Str)
+	case gjson.Number:
+		return value.Raw // Büyük sayılar float'a dönüşüp hassasiyet kaybetmesin
+	case gjson.True, gjson.False:
+		return strconv.FormatBool(value.Bool())
+	default:
+		return ""
+	}
+}
+
+// intAt yoldaki değeri tam sayı olarak döner; yoksa veya sayı değilse 0 döner
+func intAt(item gjson.Result, path string) int64 {
+	value, ok := lookupPath(item, path)
+	if !ok {
+		return 0
+	}
+
+	var raw string
+	switch value.Type {
+	case gjson.Number:
+		raw = value.Raw
+	case gjson.String:
+		raw = strings.TrimSpace(value.Str)
+	default:
+		return 0
+	}
+
+	if n, err := strconv.ParseInt(raw, 10, 64); err == nil {
+		return n
+	}
+	if f, err := strconv.ParseFloat(raw, 64); err == nil {
+		return int64(f)
+	}
+	return 0
+}
+
+// tagsAt yoldaki string dizisini veya virgülle ayrılmış string'i tag listesine çevirir
+func tagsAt(item gjson.Result, path string) []string {
+	value, ok := lookupPath(item, path)
+	if !ok {
+		return nil
+	}
+
+	var tags []string
+	switch {
+	case value.IsArray():
+		for _, t := range value.Array() {
+			if t.Type == gjson.String && strings.TrimSpace(t.Str) != "" {
+				tags = append(tags, strings.TrimSpace(t.Str))
+			}
+		}
+	case value.Type == gjson.String:
+		for _, s := range strings.Split(value.Str, ",") {
+			if strings.TrimSpace(s) != "" {
+				tags = append(tags, strings.TrimSpace(s))
+			}
+		}
+	}
+	return tags
+}
+
+// categoryAt yoldaki kategori yolunu kökten yaprağa seviye adları olarak okur
+// Değer "a > b" / "a/b" string'i veya ["a", "b"] dizisi olabilir
+func categoryAt(item gjson.Result, path string) []string {
+	value, ok := lookupPath(item, path)
+	if !ok {
+		return nil
+	}
+
+	switch {
+	case value.IsArray():
+		var names []string
+		for _, c := range value.Array() {
+			if c.Type == gjson.String {
+				if name := strings.Join(strings.Fields(c.Str), " "); name != "" {
+					names = append(names, name)
+				}
+			}
+		}
+		return names
+	case value.Type == gjson.String:
+		return entity.ParseCategoryPath(value.Str)
+	}
+	return nil
+}
+
+// parseMappedDate RFC3339, YYYY-MM-DD veya unix saniye formatındaki tarihi parse eder
+func parseMappedDate(value gjson.Result) (time.Time, error) {
+	switch value.Type {
+	case gjson.Number:
+		seconds, err := strconv.ParseInt(value.Raw, 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("tarih parse hatası (%s): %w", value.Raw, err)
+		}
+		return time.Unix(seconds, 0).UTC(), nil
+	case gjson.String:
+		if t, err := time.Parse(time.RFC3339, value.Str); err == nil {
+			return t, nil
+		}
+		t, err := time.Parse("2006-01-02", value.Str)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("tarih parse hatası (%s): %w", value.Str, err)
+		}
+		return t, nil
+	default:
+		return time.Time{}, fmt.Errorf("tarih eksik")
+	}
+}
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRESTResponse = `{
+  "data": {
+    "items": [
+      {
+        "uid": 9007199254740993,
+        "headline": "REST Video",
+        "kind": "video",
+        "stats": {"views": 1500, "likes": "30", "length": "PT12M34S"},
+        "meta": {"created": "2024-01-01T15:30:00Z"},
+        "labels": ["go", " api "],
+        "creator": {"id": "c-1", "name": " Go Channel ", "url": "https://example.com/c/1"},
+        "link": "/watch/1#t=10",
+        "thumb": "https://cdn.example.com/1.jpg",
+        "section": ["Programming", " Go ", ""]
+      },
+      {
+        "uid": "a-2",
+        "headline": "REST Article",
+        "stats": {"minutes": 7, "reactions": 12.0},
+        "meta": {"created": 1704067200},
+        "labels": "news, tech",
+        "link": "javascript:alert(1)",
+        "section": "News > World"
+      },
+      {
+        "headline": "Missing ID",
+        "meta": {"created": "2024-01-01"}
+      },
+      {
+        "uid": "a-4",
+        "headline": "Bad Type",
+        "kind": "hologram",
+        "meta": {"created": "2024-01-01"}
+      }
+    ]
+  }
+}`
+
+var testRESTMapping = entity.ProviderMapping{
+	ItemsPath:    "data.items",
+	ID:           "uid",
+	Title:        "headline",
+	Type:         "kind",
+	DefaultType:  "article",
+	PublishedAt:  "meta.created",
+	Views:        "stats.views",
+	Likes:        "stats.likes",
+	ReadingTime:  "stats.minutes",
+	Reactions:    "stats.reactions",
+	Duration:     "stats.length",
+	Tags:         "labels",
+	AuthorID:     "creator.id",
+	AuthorName:   "creator.name",
+	AuthorURL:    "creator.url",
+	URL:          "link",
+	ThumbnailURL: "thumb",
+	Category:     "section",
+}
+
+func TestRESTProvider_Parse(t *testing.T) {
+	p := &restProvider{provider: &entity.Provider{ID: 4, Name: "REST Provider", URL: "https://api.example.com/v1/items"}, mapping: testRESTMapping}
+
+	t.Run("Should map fields using configured paths", func(t *testing.T) {
+		contents, err := p.parse([]byte(testRESTResponse))
+		require.NoError(t, err)
+		require.Len(t, contents, 2) // ID'siz ve türü geçersiz içerikler atlanır
+
+		video := contents[0]
+		assert.Equal(t, "9007199254740993", video.ExternalID) // Büyük sayılar hassasiyet kaybetmez
+		assert.Equal(t, "REST Video", video.Title)
+		assert.Equal(t, entity.ContentTypeVideo, video.ContentType)
+		assert.Equal(t, int64(1500), video.Stats.Views)
+		assert.Equal(t, int32(30), video.Stats.Likes)
+		assert.Equal(t, int32(754), video.Stats.DurationSeconds)
+		assert.Equal(t, []string{"go", "api"}, video.Tags)
+		assert.Equal(t, time.Date(2024, 1, 1, 15, 30, 0, 0, time.UTC), video.PublishedAt)
+		assert.Contains(t, video.RawData, "REST Video")
+		assert.Equal(t, &entity.NormalizedAuthor{ExternalID: "c-1", Name: "Go Channel", URL: "https://example.com/c/1"}, video.Author)
+		assert.Equal(t, "https://api.example.com/watch/1", video.URL) // Göreli bağlantı provider adresine göre çözülür
+		assert.Equal(t, "https://cdn.example.com/1.jpg", video.ThumbnailURL)
+		assert.Equal(t, []string{"Programming", "Go"}, video.Category) // Dizi biçimi, boş seviyeler atlanır
+
+		article := contents[1]
+		assert.Equal(t, entity.ContentTypeArticle, article.ContentType) // default_type
+		assert.Equal(t, int32(7), article.Stats.ReadingTime)
+		assert.Equal(t, int32(12), article.Stats.Reactions)
+		assert.Equal(t, []string{"news", "tech"}, article.Tags)
+		assert.Equal(t, time.Unix(1704067200, 0).UTC(), article.PublishedAt)
+		assert.Nil(t, article.Author) // Yazar alanı yoksa içerik yazarsız kalır
+		assert.Empty(t, article.URL)  // http/https olmayan bağlantılar atılır
+		assert.Equal(t, []string{"News", "World"}, article.Category)
+	})
+
+	t.Run("Should support root arrays and indexed paths", func(t *testing.T) {
+		p := &restProvider{mapping: entity.ProviderMapping{
+			ID: "id", Title: "titles.0", DefaultType: "video", PublishedAt: "date",
+		}}
+
+		contents, err := p.parse([]byte(`[{"id": "v-1", "titles": ["First", "Second"], "date": "2024-02-01"}]`))
+		require.NoError(t, err)
+		require.Len(t, contents, 1)
+		assert.Equal(t, "First", contents[0].Title)
+	})
+
+	t.Run("Should support gjson and JSONPath expressions", func(t *testing.T) {
+		const body = `{"result": {"entries": [{
+			"meta": {"id": "e-1", "published": "2024-03-01"},
+			"names": [{"lang": "tr", "value": "Başlık"}, {"lang": "en", "value": "Title"}],
+			"metrics": [{"type": "views", "value": 42}, {"type": "likes", "value": 7}],
+			"topics": [{"name": "go"}, {"name": "api"}],
+			"dotted.key": "video"
+		}]}}`
+
+		for name, mapping := range map[string]entity.ProviderMapping{
+			"gjson": {
+				ItemsPath: "result.entries", ID: "meta.id", Title: `names.#(lang=="en").value`,
+				Type: `dotted\.key`, PublishedAt: "meta.published",
+				Views: `metrics.#(type=="views").value`, Likes: `metrics.#(type=="likes").value`, Tags: "topics.#.name",
+			},
+			"jsonpath": {
+				ItemsPath: "$.result.entries", ID: "$.meta.id", Title: "$.names[?(@.lang=='en')].value",
+				Type: "$['dotted.key']", PublishedAt: "$['meta']['published']",
+				Views: "$.metrics[?(@.type == 'views')].value", Likes: "$.metrics[1].value", Tags: "$.topics[*].name",
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				p := NewRESTProvider(&entity.Provider{Name: "REST"}, mapping, nil).(*restProvider)
+
+				contents, err := p.parse([]byte(body))
+				require.NoError(t, err)
+				require.Len(t, contents, 1)
+				assert.Equal(t, "e-1", contents[0].ExternalID)
+				assert.Equal(t, "Title", contents[0].Title)
+				assert.Equal(t, entity.ContentTypeVideo, contents[0].ContentType)
+				assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), contents[0].PublishedAt)
+				assert.Equal(t, int64(42), contents[0].Stats.Views)
+				assert.Equal(t, int32(7), contents[0].Stats.Likes)
+				assert.Equal(t, []string{"go", "api"}, contents[0].Tags)
+			})
+		}
+	})
+
+	t.Run("Should return error when items path is not an array", func(t *testing.T) {
+		_, err := p.parse([]byte(`{"data": {"items": {"uid": 1}}}`))
+		assert.Error(t, err)
+
+		_, err = p.parse([]byte(`{"data": {}}`))
+		assert.Error(t, err)
+	})
+}
+
+func TestRESTProvider_FetchContents(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(testRESTResponse))
+	}))
+	defer server.Close()
+
+	client := NewRESTProvider(&entity.Provider{ID: 4, Name: "REST Provider", URL: server.URL}, testRESTMapping, nil)
+
+	contents, err := client.FetchContents(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, contents, 2)
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/logger"
+)
+
+// Varsayılan retry ayarları (provider kaydında override edilmemiş alanlar için)
+const (
+	defaultRetryMaxAttempts     = 3
+	defaultRetryInitialInterval = time.Second
+	defaultRetryMaxInterval     = 10 * time.Second
+	defaultRetryMaxElapsed      = 30 * time.Second
+)
+
+// retryPolicy provider HTTP istekleri için exponential backoff ayarı
+type retryPolicy struct {
+	maxAttempts     int
+	initialInterval time.Duration
+	maxInterval     time.Duration
+	maxElapsed      time.Duration
+}
+
+// newRetryPolicy provider kaydındaki override'ları varsayılanların üzerine uygular
+func newRetryPolicy(cfg *entity.ProviderRetryPolicy) retryPolicy {
+	policy := retryPolicy{
+		maxAttempts:     defaultRetryMaxAttempts,
+		initialInterval: defaultRetryInitialInterval,
+		maxInterval:     defaultRetryMaxInterval,
+		maxElapsed:      defaultRetryMaxElapsed,
+	}
+	if cfg == nil {
+		return policy
+	}
+
+	if cfg.MaxAttempts > 0 {
+		policy.maxAttempts = cfg.MaxAttempts
+	}
+	if cfg.InitialIntervalMs > 0 {
+		policy.initialInterval = time.Duration(cfg.InitialIntervalMs) * time.Millisecond
+	}
+	if cfg.MaxIntervalMs > 0 {
+		policy.maxInterval = time.Duration(cfg.MaxIntervalMs) * time.Millisecond
+	}
+	if cfg.MaxElapsedMs > 0 {
+		policy.maxElapsed = time.Duration(cfg.MaxElapsedMs) * time.Millisecond
+	}
+	return policy
+}
+
+// providerRetryPolicy provider kaydının retry politikasını döner
+func providerRetryPolicy(provider *entity.Provider) retryPolicy {
+	if provider == nil {
+		return newRetryPolicy(nil)
+	}
+	return newRetryPolicy(provider.Retry)
+}
+
+// do send'i başarılı olana, kalıcı bir yanıt dönene veya deneme/süre sınırı dolana kadar tekrarlar
+// Ağ hataları, 429 ve 5xx yanıtları tekrar denenir; son denemenin sonucu olduğu gibi döner.
+// send body'siz (GET) request'ler için aynı request'i tekrar gönderebilir
+func (p retryPolicy) do(ctx context.Context, label string, send func() (*http.Response, error)) (*http.Response, error) {
+	start := time.Now()
+	interval := p.initialInterval
+
+	for attempt := 1; ; attempt++ {
+		resp, err := send()
+		if err == nil && !isRetryableStatus(resp.StatusCode) {
+			return resp, nil
+		}
+		if attempt >= p.maxAttempts {
+			return resp, err
+		}
+
+		wait := jitter(interval)
+		if time.Since(start)+wait > p.maxElapsed {
+			return resp, err
+		}
+
+		cause := err
+		if resp != nil {
+			cause = fmt.Errorf("status %d", resp.StatusCode)
+			resp.Body.Close()
+		}
+		logger.FromContext(ctx).Warn("provider request failed, retrying",
+			zap.String("request", label),
+			zap.Int("attempt", attempt),
+			zap.Int("max_attempts", p.maxAttempts),
+			zap.Duration("wait", wait.Round(time.Millisecond)),
+			zap.Error(cause),
+		)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		interval *= 2
+		if interval > p.maxInterval {
+			interval = p.maxInterval
+		}
+	}
+}
+
+// isRetryableStatus geçici olabilecek HTTP yanıtlarını belirler
+func isRetryableStatus(status int) bool {
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+// jitter aynı anda başarısız olan isteklerin aynı anda tekrar denenmemesi için
+// bekleme süresini [d/2, 3d/2) aralığında rastgele seçer
+func jitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d)))
+}
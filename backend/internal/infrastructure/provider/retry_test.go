@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+)
+
+func TestNewRetryPolicy(t *testing.T) {
+	assert.Equal(t, retryPolicy{
+		maxAttempts:     defaultRetryMaxAttempts,
+		initialInterval: defaultRetryInitialInterval,
+		maxInterval:     defaultRetryMaxInterval,
+		maxElapsed:      defaultRetryMaxElapsed,
+	}, newRetryPolicy(nil))
+
+	// Sadece verilen alanlar override edilir
+	policy := newRetryPolicy(&entity.ProviderRetryPolicy{MaxAttempts: 5, InitialIntervalMs: 250})
+	assert.Equal(t, 5, policy.maxAttempts)
+	assert.Equal(t, 250*time.Millisecond, policy.initialInterval)
+	assert.Equal(t, defaultRetryMaxInterval, policy.maxInterval)
+	assert.Equal(t, defaultRetryMaxElapsed, policy.maxElapsed)
+}
+
+func TestRetryPolicy_Do(t *testing.T) {
+	fast := retryPolicy{maxAttempts: 3, initialInterval: time.Millisecond, maxInterval: 5 * time.Millisecond, maxElapsed: time.Second}
+
+	// statuses sırayla döndürülür, sonrasında 200 döner
+	newServer := func(statuses ...int) (*httptest.Server, *int) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls <= len(statuses) {
+				w.WriteHeader(statuses[calls-1])
+			}
+		}))
+		return server, &calls
+	}
+	get := func(url string) func() (*http.Response, error) {
+		return func() (*http.Response, error) { return http.Get(url) }
+	}
+
+	t.Run("retries transient failures", func(t *testing.T) {
+		server, calls := newServer(http.StatusServiceUnavailable, http.StatusTooManyRequests)
+		defer server.Close()
+
+		resp, err := fast.do(context.Background(), "test", get(server.URL))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 3, *calls)
+	})
+
+	t.Run("does not retry client errors", func(t *testing.T) {
+		server, calls := newServer(http.StatusNotFound)
+		defer server.Close()
+
+		resp, err := fast.do(context.Background(), "test", get(server.URL))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		assert.Equal(t, 1, *calls)
+	})
+
+	t.Run("returns last response after max attempts", func(t *testing.T) {
+		server, calls := newServer(http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway)
+		defer server.Close()
+
+		resp, err := fast.do(context.Background(), "test", get(server.URL))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+		assert.Equal(t, 3, *calls)
+	})
+
+	t.Run("stops when max elapsed time would be exceeded", func(t *testing.T) {
+		policy := retryPolicy{maxAttempts: 10, initialInterval: time.Second, maxInterval: time.Second, maxElapsed: 100 * time.Millisecond}
+		attempts := 0
+
+		_, err := policy.do(context.Background(), "test", func() (*http.Response, error) {
+			attempts++
+			return nil, errors.New("connection refused")
+		})
+		assert.EqualError(t, err, "connection refused")
+		assert.Equal(t, 1, attempts)
+	})
+
+	t.Run("honors context cancellation", func(t *testing.T) {
+		policy := retryPolicy{maxAttempts: 3, initialInterval: time.Second, maxInterval: time.Second, maxElapsed: time.Minute}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := policy.do(ctx, "test", func() (*http.Response, error) {
+			return nil, errors.New("connection refused")
+		})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
@@ -0,0 +1,346 @@
+package provider
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"golang.org/x/time/rate"
+)
+
+// rssWordsPerMinute okuma süresi tahmini için kullanılan dakikadaki kelime sayısı
+const rssWordsPerMinute = 200
+
+// htmlTagPattern açıklamalardaki HTML etiketlerini temizlemek için
+var htmlTagPattern = regexp.MustCompile(`<[^>]*>`)
+
+// rssProvider RSS 2.0 ve Atom feed'leri için provider client implementasyonu
+type rssProvider struct {
+	provider *entity.Provider
+	feedURL  string
+	auth     authorizer
+	retry    retryPolicy
+	client   *http.Client
+	limiter  *rate.Limiter
+	rejectionLog
+}
+
+// RSSFeed RSS (<rss><channel><item>) ve Atom (<feed><entry>) feed'lerinin ortak root yapısı
+type RSSFeed struct {
+	Channel struct {
+		Items []RSSItem `xml:"item"`
+	} `xml:"channel"`
+	Entries []AtomEntry `xml:"entry"`
+}
+
+// RSSItem RSS 2.0 item yapısı
+type RSSItem struct {
+	GUID        string   `xml:"guid"`
+	Link        string   `xml:"link"`
+	Title       string   `xml:"title"`
+	Description string   `xml:"description"`
+	PubDate     string   `xml:"pubDate"`
+	Categories  []string `xml:"category"`
+	Author      string   `xml:"author,omitempty"`                                              // "e-posta (Ad)" veya sadece ad
+	Creator     string   `xml:"http://purl.org/dc/elements/1.1/ creator,omitempty"`            // dc:creator, author yoksa kullanılır
+	Duration    string   `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd duration,omitempty"` // itunes:duration (podcast'ler)
+	Enclosure   *struct {
+		URL  string `xml:"url,attr"`
+		Type string `xml:"type,attr"` // MIME türü, içerik türünü belirler (ör. audio/mpeg -> podcast)
+	} `xml:"enclosure,omitempty"`
+	Thumbnail *MediaThumbnail `xml:"http://search.yahoo.com/mrss/ thumbnail,omitempty"` // media:thumbnail
+}
+
+// MediaThumbnail Media RSS küçük resim yapısı
+type MediaThumbnail struct {
+	URL string `xml:"url,attr"`
+}
+
+// AtomEntry Atom entry yapısı
+type AtomEntry struct {
+	ID        string `xml:"id"`
+	Title     string `xml:"title"`
+	Summary   string `xml:"summary"`
+	Content   string `xml:"content"`
+	Published string `xml:"published"`
+	Updated   string `xml:"updated"`
+	Links     []struct {
+		Href string `xml:"href,attr"`
+		Rel  string `xml:"rel,attr"`
+		Type string `xml:"type,attr"`
+	} `xml:"link"`
+	Categories []struct {
+		Term string `xml:"term,attr"`
+	} `xml:"category"`
+	Author *struct {
+		Name string `xml:"name"`
+		URI  string `xml:"uri,omitempty"`
+	} `xml:"author,omitempty"`
+	Thumbnail *MediaThumbnail `xml:"http://search.yahoo.com/mrss/ thumbnail,omitempty"` // media:thumbnail
+}
+
+// NewRSSProvider yeni bir RSS/Atom provider client oluşturur
+// Feed içerikleri "article" türünde normalize edilir, ses/görsel/video enclosure'ı olanlar
+// podcast/image/video olur; client nil ise paylaşımlı varsayılan HTTP client kullanılır
+func NewRSSProvider(provider *entity.Provider, feedURL string, client *http.Client) port.ProviderClient {
+	// Rate Limiter: Saniyede 1 istek (Burst 1)
+	return &rssProvider{
+		provider: provider,
+		feedURL:  feedURL,
+		auth:     providerAuthorizer(provider),
+		retry:    providerRetryPolicy(provider),
+		client:   httpClientOrDefault(client),
+		limiter:  rate.NewLimiter(rate.Every(time.Second), 1),
+	}
+}
+
+// FetchContents feed'i çeker ve item/entry'leri normalize eder
+// Feed'ler sayfalı olmadığından tek istek yapılır; hatalı entry'ler atlanıp raporlanır
+func (p *rssProvider) FetchContents(ctx context.Context) ([]*entity.NormalizedContent, error) {
+	p.resetRejections()
+
+	if err := p.limiter.Wait(ctx); err != nil {
+		return nil, fmt.Errorf("rate limiter hatası: %w", err)
+	}
+
+	req, err := newAuthorizedRequest(ctx, p.auth, p.feedURL)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.retry.do(ctx, "RSS", func() (*http.Response, error) {
+		return p.client.Do(req)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("RSS isteği başarısız: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("RSS feed hata döndü: %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("response body okuma hatası: %w", err)
+	}
+
+	return p.parse(body)
+}
+
+// CheckHealth provider'ın feed adresini probe eder
+func (p *rssProvider) CheckHealth(ctx context.Context) (int, error) {
+	return probe(ctx, p.client, p.limiter, p.auth, p.feedURL)
+}
+
+// GetProviderInfo provider bilgilerini döner
+func (p *rssProvider) GetProviderInfo() *entity.Provider {
+	return p.provider
+}
+
+// parse feed gövdesini ayrıştırır ve normalize eder
+func (p *rssProvider) parse(body []byte) ([]*entity.NormalizedContent, error) {
+	var feed RSSFeed
+	if err := xml.Unmarshal(body, &feed); err != nil {
+		return nil, fmt.Errorf("RSS parse hatası: %w", err)
+	}
+
+	var allNormalized []*entity.NormalizedContent
+	for _, item := range feed.Channel.Items {
+		rawBytes, _ := xml.Marshal(item)
+		content, err := p.normalizeItem(item, string(rawBytes))
+		if err != nil {
+			p.reject(item.GUID, string(rawBytes), err)
+			continue
+		}
+		allNormalized = append(allNormalized, content)
+	}
+	for _, entry := range feed.Entries {
+		rawBytes, _ := xml.Marshal(entry)
+		content, err := p.normalizeEntry(entry, string(rawBytes))
+		if err != nil {
+			p.reject(entry.ID, string(rawBytes), err)
+			continue
+		}
+		allNormalized = append(allNormalized, content)
+	}
+
+	return allNormalized, nil
+}
+
+// normalizeItem RSS item'ını NormalizedContent'e dönüştürür
+// guid yoksa link ID olarak kullanılır
+func (p *rssProvider) normalizeItem(raw RSSItem, rawData string) (*entity.NormalizedContent, error) {
+	id := strings.TrimSpace(raw.GUID)
+	if id == "" {
+		id = strings.TrimSpace(raw.Link)
+	}
+	if id == "" {
+		return nil, fmt.Errorf("ID eksik")
+	}
+
+	publishedAt, err := parseFeedDate(raw.PubDate)
+	if err != nil {
+		return nil, err
+	}
+
+	content := newFeedArticle(id, raw.Title, raw.Description, publishedAt, raw.Categories, rawData)
+	content.URL = normalizeContentURL(raw.Link, p.feedURL)
+	content.Category = feedCategory(raw.Categories)
+	if raw.Thumbnail != nil {
+		content.ThumbnailURL = normalizeContentURL(raw.Thumbnail.URL, p.feedURL)
+	}
+	if raw.Enclosure != nil {
+		content.ContentType = enclosureContentType(raw.Enclosure.Type)
+		if content.ThumbnailURL == "" && content.ContentType == entity.ContentTypeImage {
+			content.ThumbnailURL = normalizeContentURL(raw.Enclosure.URL, p.feedURL)
+		}
+	}
+	content.Stats.DurationSeconds = parseDurationSeconds(raw.Duration)
+	if name := rssAuthorName(raw.Author); name != "" {
+		content.Author = newNormalizedAuthor("", name, "")
+	} else {
+		content.Author = newNormalizedAuthor("", raw.Creator, "")
+	}
+	return content, nil
+}
+
+// normalizeEntry Atom entry'sini NormalizedContent'e dönüştürür
+// id yoksa alternate link, published yoksa updated kullanılır
+func (p *rssProvider) normalizeEntry(raw AtomEntry, rawData string) (*entity.NormalizedContent, error) {
+	id := strings.TrimSpace(raw.ID)
+	if id == "" {
+		for _, link := range raw.Links {
+			if link.Rel == "" || link.Rel == "alternate" {
+				id = strings.TrimSpace(link.Href)
+				break
+			}
+		}
+	}
+	if id == "" {
+		return nil, fmt.Errorf("ID eksik")
+	}
+
+	date := raw.Published
+	if strings.TrimSpace(date) == "" {
+		date = raw.Updated
+	}
+	publishedAt, err := parseFeedDate(date)
+	if err != nil {
+		return nil, err
+	}
+
+	description := raw.Summary
+	if strings.TrimSpace(description) == "" {
+		description = raw.Content
+	}
+
+	tags := make([]string, 0, len(raw.Categories))
+	for _, c := range raw.Categories {
+		if c.Term != "" {
+			tags = append(tags, c.Term)
+		}
+	}
+
+	content := newFeedArticle(id, raw.Title, description, publishedAt, tags, rawData)
+	content.Category = feedCategory(tags)
+	if raw.Thumbnail != nil {
+		content.ThumbnailURL = normalizeContentURL(raw.Thumbnail.URL, p.feedURL)
+	}
+	enclosure := false
+	for _, link := range raw.Links {
+		switch {
+		case (link.Rel == "" || link.Rel == "alternate") && content.URL == "":
+			content.URL = normalizeContentURL(link.Href, p.feedURL)
+		case link.Rel == "enclosure" && !enclosure:
+			enclosure = true
+			content.ContentType = enclosureContentType(link.Type)
+			if content.ThumbnailURL == "" && content.ContentType == entity.ContentTypeImage {
+				content.ThumbnailURL = normalizeContentURL(link.Href, p.feedURL)
+			}
+		}
+	}
+	if raw.Author != nil {
+		content.Author = newNormalizedAuthor(raw.Author.URI, raw.Author.Name, raw.Author.URI)
+	}
+	return content, nil
+}
+
+// feedCategory feed kategorileri arasından hiyerarşik olan ilkini ("Programming/Go", "Programming > Go") kategori yolu olarak döner
+// Feed'lerde category alanı genelde düz etiket olarak kullanıldığı için tek seviyeli kategoriler yol kabul edilmez
+func feedCategory(categories []string) []string {
+	for _, c := range categories {
+		if !strings.ContainsAny(c, "/>") {
+			continue
+		}
+		if names := entity.ParseCategoryPath(c); len(names) > 1 {
+			return names
+		}
+	}
+	return nil
+}
+
+// newFeedArticle feed girdisinden article türünde NormalizedContent oluşturur
+// Feed'lerde metrik olmadığından sadece okuma süresi açıklamanın uzunluğundan tahmin edilir
+func newFeedArticle(id, title, description string, publishedAt time.Time, tags []string, rawData string) *entity.NormalizedContent {
+	text := stripHTML(description)
+
+	var readingTime int32
+	if words := len(strings.Fields(text)); words > 0 {
+		readingTime = int32((words + rssWordsPerMinute - 1) / rssWordsPerMinute)
+	}
+
+	return &entity.NormalizedContent{
+		ExternalID:  id,
+		Title:       stripHTML(title),
+		Description: text,
+		ContentType: entity.ContentTypeArticle,
+		PublishedAt: publishedAt,
+		Stats: entity.ContentStats{
+			ReadingTime: readingTime,
+		},
+		Tags:    tags,
+		RawData: rawData,
+	}
+}
+
+// enclosureContentType feed enclosure'ının MIME türünden içerik türünü belirler
+// Tanınmayan türler (ör. application/pdf) article olarak kalır
+func enclosureContentType(mimeType string) entity.ContentType {
+	switch {
+	case strings.HasPrefix(mimeType, "audio/"):
+		return entity.ContentTypePodcast
+	case strings.HasPrefix(mimeType, "video/"):
+		return entity.ContentTypeVideo
+	case strings.HasPrefix(mimeType, "image/"):
+		return entity.ContentTypeImage
+	default:
+		return entity.ContentTypeArticle
+	}
+}
+
+// parseFeedDate RSS (RFC1123) ve Atom (RFC3339) tarih formatlarını parse eder
+func parseFeedDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	layouts := []string{time.RFC1123Z, time.RFC1123, time.RFC3339, "Mon, 2 Jan 2006 15:04:05 -0700", "2006-01-02"}
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("tarih parse hatası (%s)", value)
+}
+
+// stripHTML HTML etiketlerini ve entity'leri temizler, boşlukları sadeleştirir
+func stripHTML(s string) string {
+	text := html.UnescapeString(htmlTagPattern.ReplaceAllString(s, " "))
+	return strings.Join(strings.Fields(text), " ")
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRSSFeed = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd" xmlns:media="http://search.yahoo.com/mrss/">
+  <channel>
+    <title>Go Blog</title>
+    <item>
+      <guid>https://example.com/posts/1</guid>
+      <title>Go Generics</title>
+      <description>&lt;p&gt;Type parameters &amp;amp; constraints&lt;/p&gt;</description>
+      <pubDate>Mon, 01 Jan 2024 15:30:00 +0000</pubDate>
+      <category>golang</category>
+      <category>generics</category>
+      <author>gopher@example.com (Rob Pike)</author>
+      <link>https://example.com/posts/1</link>
+      <media:thumbnail url="/images/1.png"/>
+    </item>
+    <item>
+      <link>https://example.com/posts/2</link>
+      <title>No GUID</title>
+      <dc:creator>Russ Cox</dc:creator>
+      <category>podcast</category>
+      <category>Programming/Go</category>
+      <enclosure url="https://example.com/episodes/2.mp3" type="audio/mpeg" length="1024"/>
+      <itunes:duration>1:02:03</itunes:duration>
+      <pubDate>Tue, 02 Jan 2024 10:00:00 GMT</pubDate>
+    </item>
+    <item>
+      <guid>https://example.com/posts/3</guid>
+      <title>Bad Date</title>
+      <pubDate>yesterday</pubDate>
+    </item>
+  </channel>
+</rss>`
+
+const testAtomFeed = `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>Atom Blog</title>
+  <entry>
+    <id>urn:uuid:1225c695</id>
+    <title>Atom Entry</title>
+    <summary>Short summary</summary>
+    <updated>2024-01-03T12:00:00Z</updated>
+    <category term="atom"/>
+    <author><name>Jane Doe</name><uri>https://example.com/jane</uri></author>
+  </entry>
+  <entry>
+    <title>Link Only</title>
+    <link rel="alternate" href="https://example.com/atom/2"/>
+    <link rel="enclosure" type="image/png" href="https://example.com/atom/2.png"/>
+    <published>2024-01-04T12:00:00Z</published>
+  </entry>
+</feed>`
+
+func TestRSSProvider_Parse(t *testing.T) {
+	prov := &entity.Provider{ID: 3, Name: "RSS Provider", Format: "rss"}
+	p := &rssProvider{provider: prov, feedURL: "https://example.com/feed.xml"}
+
+	t.Run("Should normalize RSS items as articles", func(t *testing.T) {
+		contents, err := p.parse([]byte(testRSSFeed))
+		require.NoError(t, err)
+		require.Len(t, contents, 2) // Tarihi hatalı item atlanır
+
+		first := contents[0]
+		assert.Equal(t, "https://example.com/posts/1", first.ExternalID)
+		assert.Equal(t, "Go Generics", first.Title)
+		assert.Equal(t, "Type parameters & constraints", first.Description)
+		assert.Equal(t, entity.ContentTypeArticle, first.ContentType)
+		assert.Equal(t, int32(1), first.Stats.ReadingTime)
+		assert.Equal(t, []string{"golang", "generics"}, first.Tags)
+		assert.Equal(t, time.Date(2024, 1, 1, 15, 30, 0, 0, time.UTC), first.PublishedAt.UTC())
+		assert.NotEmpty(t, first.RawData)
+		require.NotNil(t, first.Author)
+		assert.Equal(t, "Rob Pike", first.Author.Name) // "e-posta (Ad)" biçiminden ad çıkarılır
+		assert.Equal(t, "https://example.com/posts/1", first.URL)
+		assert.Equal(t, "https://example.com/images/1.png", first.ThumbnailURL) // media:thumbnail, feed adresine göre çözülür
+
+		// guid yoksa link ID olarak kullanılır
+		assert.Equal(t, "https://example.com/posts/2", contents[1].ExternalID)
+		// author yoksa dc:creator kullanılır
+		require.NotNil(t, contents[1].Author)
+		assert.Equal(t, "Russ Cox", contents[1].Author.Name)
+		// Ses enclosure'ı olan item podcast olur
+		assert.Equal(t, entity.ContentTypePodcast, contents[1].ContentType)
+		assert.Equal(t, int32(3723), contents[1].Stats.DurationSeconds)
+		assert.Equal(t, "https://example.com/posts/2", contents[1].URL)
+		assert.Empty(t, contents[1].ThumbnailURL) // Ses enclosure'ı küçük resim değildir
+
+		// Sadece hiyerarşik kategori kategori yolu olur, düz kategoriler etikettir
+		assert.Nil(t, first.Category)
+		assert.Equal(t, []string{"Programming", "Go"}, contents[1].Category)
+	})
+
+	t.Run("Should normalize Atom entries as articles", func(t *testing.T) {
+		contents, err := p.parse([]byte(testAtomFeed))
+		require.NoError(t, err)
+		require.Len(t, contents, 2)
+
+		assert.Equal(t, "urn:uuid:1225c695", contents[0].ExternalID)
+		assert.Equal(t, "Short summary", contents[0].Description)
+		assert.Equal(t, []string{"atom"}, contents[0].Tags)
+		assert.Equal(t, time.Date(2024, 1, 3, 12, 0, 0, 0, time.UTC), contents[0].PublishedAt)
+		assert.Equal(t, &entity.NormalizedAuthor{
+			ExternalID: "https://example.com/jane", Name: "Jane Doe", URL: "https://example.com/jane",
+		}, contents[0].Author)
+		assert.Nil(t, contents[1].Author)
+
+		assert.Equal(t, "https://example.com/atom/2", contents[1].ExternalID)
+		assert.Equal(t, entity.ContentTypeArticle, contents[0].ContentType)
+		assert.Equal(t, entity.ContentTypeImage, contents[1].ContentType)
+		assert.Empty(t, contents[0].URL)
+		assert.Equal(t, "https://example.com/atom/2", contents[1].URL)
+		assert.Equal(t, "https://example.com/atom/2.png", contents[1].ThumbnailURL) // Görsel enclosure küçük resim olur
+	})
+
+	t.Run("Should return error for invalid XML", func(t *testing.T) {
+		_, err := p.parse([]byte("<rss><channel>"))
+		assert.Error(t, err)
+	})
+}
+
+func TestRSSProvider_FetchContents(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/rss+xml")
+		w.Write([]byte(testRSSFeed))
+	}))
+	defer server.Close()
+
+	client := NewRSSProvider(&entity.Provider{ID: 3, Name: "RSS Provider"}, server.URL, nil)
+
+	contents, err := client.FetchContents(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, contents, 2)
+}
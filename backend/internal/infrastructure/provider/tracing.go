@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/tracing"
+)
+
+// WithTracing client'ın bir kopyasını döner; her provider isteği için client span üretilir
+// ve trace context (traceparent, baggage) provider'a header olarak iletilir
+func WithTracing(client *http.Client) *http.Client {
+	traced := *httpClientOrDefault(client)
+	base := traced.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	traced.Transport = &tracingTransport{base: base}
+	return &traced
+}
+
+// tracingTransport provider isteklerini trace'leyen RoundTripper
+type tracingTransport struct {
+	base http.RoundTripper
+}
+
+// RoundTrip isteği span içinde gönderir
+// Query string span'e yazılmaz; provider API anahtarları içerebilir
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := tracing.Tracer().Start(req.Context(), "HTTP "+req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", req.Method),
+			attribute.String("server.address", req.URL.Host),
+			attribute.String("url.path", req.URL.Path),
+		),
+	)
+	defer span.End()
+
+	req = req.Clone(ctx)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	if resp.StatusCode >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, resp.Status)
+	}
+	return resp, nil
+}
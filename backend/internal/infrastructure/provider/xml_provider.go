@@ -5,7 +5,6 @@ import (
 	"encoding/xml"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
 	"time"
 
@@ -18,7 +17,12 @@ import (
 type xmlProvider struct {
 	provider *entity.Provider
 	apiURL   string
+	client   *http.Client
+	auth     authorizer
+	retry    retryPolicy
 	limiter  *rate.Limiter
+	conditionalState
+	rejectionLog
 }
 
 // XMLItem XML dosyasındaki içerik yapısı
@@ -31,14 +35,28 @@ type XMLItem struct {
 	Categories struct {
 		Category []string `xml:"category"`
 	} `xml:"categories"`
+	Author    *XMLAuthor `xml:"author,omitempty"`
+	Channel   *XMLAuthor `xml:"channel,omitempty"` // Videolarda author yerine kullanılabilir
+	URL       string     `xml:"url,omitempty"`
+	Thumbnail string     `xml:"thumbnail,omitempty"`
+	Category  string     `xml:"category_path,omitempty"` // "Programming > Go" veya "programming/go"
+}
+
+// XMLAuthor XML'deki yazar/kanal yapısı
+type XMLAuthor struct {
+	ID   string `xml:"id,omitempty"`
+	Name string `xml:"name"`
+	URL  string `xml:"url,omitempty"`
 }
 
 // XMLStats XML'deki stats yapısı
 type XMLStats struct {
-	Views       int64 `xml:"views"`
-	Likes       int32 `xml:"likes"`
-	ReadingTime int32 `xml:"reading_time"` // Article için
-	Reactions   int32 `xml:"reactions"`    // Article için
+	Views       int64  `xml:"views"`
+	Likes       int32  `xml:"likes"`
+	ReadingTime int32  `xml:"reading_time"` // Article için
+	Reactions   int32  `xml:"reactions"`    // Article için
+	Duration    string `xml:"duration"`     // Video için ("MM:SS", "HH:MM:SS", ISO 8601 veya saniye)
+	Comments    int32  `xml:"comments"`
 }
 
 // XMLResponse XML dosyasının root yapısı
@@ -55,90 +73,94 @@ type XMLResponse struct {
 }
 
 // NewXMLProvider yeni bir XML provider client oluşturur
-func NewXMLProvider(provider *entity.Provider, apiURL string) port.ProviderClient {
+// client nil ise paylaşımlı varsayılan HTTP client kullanılır
+func NewXMLProvider(provider *entity.Provider, apiURL string, client *http.Client) port.ProviderClient {
 	// Rate Limiter: Saniyede 1 istek (Burst 1)
-	return &xmlProvider{
+	p := &xmlProvider{
 		provider: provider,
 		apiURL:   apiURL,
+		client:   httpClientOrDefault(client),
+		auth:     providerAuthorizer(provider),
+		retry:    providerRetryPolicy(provider),
 		limiter:  rate.NewLimiter(rate.Every(time.Second), 1),
 	}
+	p.initValidators(provider)
+	return p
 }
 
 // FetchContents Mock API'den içerikleri sayfalar halinde çeker ve normalize eder
 func (p *xmlProvider) FetchContents(ctx context.Context) ([]*entity.NormalizedContent, error) {
-	var allNormalized []*entity.NormalizedContent
-	var page int = 1
-	var totalPages int = 1 // En az bir sayfa var varsayıyoruz
-
-	for page <= totalPages {
-		// Rate Limiter bekleme
-		if err := p.limiter.Wait(ctx); err != nil {
-			return nil, fmt.Errorf("rate limiter hatası: %w", err)
-		}
-
-		// Mock API'den sayfayı çek
-		var resp *http.Response
-		var err error
-		maxRetries := 3
-		for i := 0; i < maxRetries; i++ {
-			url := fmt.Sprintf("%s?page=%d", p.apiURL, page)
-			resp, err = http.Get(url)
-			if err == nil && resp.StatusCode == http.StatusOK {
-				break
-			}
-			if resp != nil {
-				resp.Body.Close()
-			}
-			log.Printf("XML API retry %d/%d (Page %d): %v", i+1, maxRetries, page, err)
-			time.Sleep(time.Second * time.Duration(i+1))
-		}
-
-		if err != nil {
-			return nil, fmt.Errorf("XML API isteği başarısız: %w", err)
-		}
-		defer resp.Body.Close()
+	p.resetRejections()
+	return fetchAllPages(ctx, p.fetchPage)
+}
 
-		if resp.StatusCode != http.StatusOK {
-			return nil, fmt.Errorf("XML API hata döndü: %d", resp.StatusCode)
-		}
+// fetchPage tek bir sayfayı çeker ve normalize eder
+func (p *xmlProvider) fetchPage(ctx context.Context, page int) ([]*entity.NormalizedContent, pageInfo, error) {
+	// Rate Limiter bekleme
+	if err := p.limiter.Wait(ctx); err != nil {
+		return nil, pageInfo{}, fmt.Errorf("rate limiter hatası: %w", err)
+	}
 
-		// Body'i oku (Raw Data için)
-		bodyBytes, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return nil, fmt.Errorf("response body okuma hatası: %w", err)
-		}
+	// Mock API'den sayfayı çek
+	url := fmt.Sprintf("%s?page=%d", p.apiURL, page)
+	req, err := newAuthorizedRequest(ctx, p.auth, url)
+	if err != nil {
+		return nil, pageInfo{}, err
+	}
+	// İçerik değişmediyse ilk sayfa 304 döner, kalan sayfalar çekilmez
+	if page == 1 {
+		p.applyConditional(req)
+	}
+	resp, err := p.retry.do(ctx, fmt.Sprintf("XML API (Page %d)", page), func() (*http.Response, error) {
+		return p.client.Do(req)
+	})
+	if err != nil {
+		return nil, pageInfo{}, fmt.Errorf("XML API isteği başarısız: %w", err)
+	}
+	defer resp.Body.Close()
 
-		// XML parse et
-		var response XMLResponse
-		if err := xml.Unmarshal(bodyBytes, &response); err != nil {
-			return nil, fmt.Errorf("XML parse hatası: %w", err)
-		}
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, pageInfo{}, port.ErrNotModified
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, pageInfo{}, fmt.Errorf("XML API hata döndü: %d", resp.StatusCode)
+	}
+	if page == 1 {
+		p.recordValidators(resp)
+	}
 
-		// İlk sayfada toplam sayfa sayısını hesapla
-		if page == 1 && response.Meta.PerPage > 0 {
-			totalPages = (response.Meta.Total + response.Meta.PerPage - 1) / response.Meta.PerPage
-		}
+	// Body'i oku (Raw Data için)
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, pageInfo{}, fmt.Errorf("response body okuma hatası: %w", err)
+	}
 
-		if len(allNormalized) >= response.Meta.Total || len(allNormalized) >= 1000 {
-			break
-		}
+	// XML parse et
+	var response XMLResponse
+	if err := xml.Unmarshal(bodyBytes, &response); err != nil {
+		return nil, pageInfo{}, fmt.Errorf("XML parse hatası: %w", err)
+	}
 
-		// Normalize et
-		for _, raw := range response.Items.Items {
-			// Item'a özel raw datayı elde etmek için tekrar marshal ediyoruz
-			itemRawBytes, _ := xml.Marshal(raw)
+	// Normalize et
+	normalized := make([]*entity.NormalizedContent, 0, len(response.Items.Items))
+	for _, raw := range response.Items.Items {
+		// Item'a özel raw datayı saklamak için tekrar marshal ediyoruz
+		itemRawBytes, _ := xml.Marshal(raw)
 
-			content, err := p.normalize(raw, string(itemRawBytes))
-			if err != nil {
-				continue
-			}
-			allNormalized = append(allNormalized, content)
+		content, err := p.normalize(raw, string(itemRawBytes))
+		if err != nil {
+			p.reject(raw.ID, string(itemRawBytes), err)
+			continue
 		}
-
-		page++
+		normalized = append(normalized, content)
 	}
 
-	return allNormalized, nil
+	return normalized, pageInfo{Total: response.Meta.Total, PerPage: response.Meta.PerPage}, nil
+}
+
+// CheckHealth provider'ın ilk sayfayı probe eder
+func (p *xmlProvider) CheckHealth(ctx context.Context) (int, error) {
+	return probe(ctx, p.client, p.limiter, p.auth, fmt.Sprintf("%s?page=1", p.apiURL))
 }
 
 // GetProviderInfo provider bilgilerini döner
@@ -163,13 +185,8 @@ func (p *xmlProvider) normalize(raw XMLItem, rawData string) (*entity.Normalized
 	}
 
 	// İçerik türünü belirle
-	var contentType entity.ContentType
-	switch raw.Type {
-	case "video":
-		contentType = entity.ContentTypeVideo
-	case "article":
-		contentType = entity.ContentTypeArticle
-	default:
+	contentType, ok := entity.ParseContentType(raw.Type)
+	if !ok {
 		return nil, fmt.Errorf("geçersiz içerik türü: %s", raw.Type)
 	}
 
@@ -181,12 +198,31 @@ func (p *xmlProvider) normalize(raw XMLItem, rawData string) (*entity.Normalized
 		ContentType: contentType,
 		PublishedAt: publishedAt,
 		Stats: entity.ContentStats{
-			Views:       raw.Stats.Views,
-			Likes:       raw.Stats.Likes,
-			ReadingTime: raw.Stats.ReadingTime,
-			Reactions:   raw.Stats.Reactions,
+			Views:           raw.Stats.Views,
+			Likes:           raw.Stats.Likes,
+			ReadingTime:     raw.Stats.ReadingTime,
+			Reactions:       raw.Stats.Reactions,
+			DurationSeconds: parseDurationSeconds(raw.Stats.Duration),
+			Comments:        raw.Stats.Comments,
 		},
-		Tags:    raw.Categories.Category,
-		RawData: rawData,
+		Tags:         raw.Categories.Category,
+		Author:       xmlAuthor(raw),
+		URL:          normalizeContentURL(raw.URL, p.apiURL),
+		ThumbnailURL: normalizeContentURL(raw.Thumbnail, p.apiURL),
+		Category:     entity.ParseCategoryPath(raw.Category),
+		RawData:      rawData,
 	}, nil
 }
+
+// xmlAuthor içeriğin yazarını, yoksa kanalını döner
+func xmlAuthor(raw XMLItem) *entity.NormalizedAuthor {
+	for _, a := range []*XMLAuthor{raw.Author, raw.Channel} {
+		if a == nil {
+			continue
+		}
+		if author := newNormalizedAuthor(a.ID, a.Name, a.URL); author != nil {
+			return author
+		}
+	}
+	return nil
+}
@@ -60,4 +60,43 @@ func TestXMLProvider_Normalize(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "ID eksik")
 	})
+
+	t.Run("Should use channel as author when author is missing", func(t *testing.T) {
+		raw := XMLItem{
+			ID:      "video-456",
+			Title:   "Channel Video",
+			Type:    "video",
+			Stats:   XMLStats{Duration: "31:25"},
+			PubDate: "2024-01-01T12:00:00Z",
+			Channel: &XMLAuthor{ID: "ch-1", Name: "Go Channel", URL: "https://example.com/ch/1"},
+		}
+
+		normalized, err := p.normalize(raw, "")
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1885), normalized.Stats.DurationSeconds)
+		assert.Equal(t, &entity.NormalizedAuthor{ExternalID: "ch-1", Name: "Go Channel", URL: "https://example.com/ch/1"}, normalized.Author)
+
+		// İsmi boş yazar yok sayılır
+		raw.Channel = &XMLAuthor{ID: "ch-2"}
+		normalized, err = p.normalize(raw, "")
+		assert.NoError(t, err)
+		assert.Nil(t, normalized.Author)
+	})
+
+	t.Run("Should read comments, links and category from XML items", func(t *testing.T) {
+		var raw XMLItem
+		data := `<item><id>video-789</id><headline>Commented</headline><type>video</type>` +
+			`<stats><views>1000</views><likes>40</likes><comments>47</comments></stats>` +
+			`<url>https://example.com/videos/789</url><thumbnail>ftp://example.com/789.jpg</thumbnail>` +
+			`<category_path>programming/go/concurrency</category_path>` +
+			`<publication_date>2024-01-01T12:00:00Z</publication_date></item>`
+		assert.NoError(t, xml.Unmarshal([]byte(data), &raw))
+
+		normalized, err := p.normalize(raw, data)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(47), normalized.Stats.Comments)
+		assert.Equal(t, "https://example.com/videos/789", normalized.URL)
+		assert.Empty(t, normalized.ThumbnailURL) // http/https olmayan bağlantılar atılır
+		assert.Equal(t, []string{"programming", "go", "concurrency"}, normalized.Category)
+	})
 }
@@ -0,0 +1,503 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// Elasticsearch indeksleme ayarları
+const (
+	esReindexBatchSize = 500
+	esErrorBodyLimit   = 512 // Hata mesajına eklenecek en fazla yanıt gövdesi (byte)
+
+	// esHybridPopularityPivot hybrid sıralamada popülerlik katkısının yarıya ulaştığı final_score değeri
+	// ES script'i sonuç kümesinin en yüksek skorunu bilmediği için skorlar s / (s + pivot) ile 0-1'e getirilir
+	esHybridPopularityPivot = 100.0
+)
+
+// esIndexMapping içerik indeksinin mapping'i
+// content alanı sadece sonuç döndürmek için saklanır, aranmaz; diğer alanlar filtre/sıralama içindir
+const esIndexMapping = `{
+	"mappings": {
+		"properties": {
+			"content":       {"type": "object", "enabled": false},
+			"id":            {"type": "long"},
+			"title":         {"type": "text", "analyzer": "english", "fields": {"raw": {"type": "keyword"}}},
+			"tags":          {"type": "keyword", "fields": {"text": {"type": "text", "analyzer": "english"}}},
+			"content_type":  {"type": "keyword"},
+			"language":      {"type": "keyword"},
+			"provider_id":   {"type": "long"},
+			"provider_name": {"type": "keyword"},
+			"author_name":   {"type": "keyword"},
+			"category_paths": {"type": "keyword"},
+			"published_at":  {"type": "date"},
+			"created_at":    {"type": "date"},
+			"views":         {"type": "long"},
+			"likes":         {"type": "long"},
+			"reactions":     {"type": "long"},
+			"reading_time":  {"type": "long"},
+			"score":         {"type": "double"},
+			"duplicate":     {"type": "boolean"},
+			"generation":    {"type": "long"}
+		}
+	}
+}`
+
+// esSortFields çoklu sıralamada izin verilen alanların indeks karşılıkları (sortColumns ile aynı alanlar)
+var esSortFields = map[string]string{
+	"published_at": "published_at",
+	"created_at":   "created_at",
+	"title":        "title.raw",
+	"views":        "views",
+	"likes":        "likes",
+	"reactions":    "reactions",
+	"reading_time": "reading_time",
+	"score":        "score",
+	"relevance":    "_score",
+}
+
+// esContentDocument indekste tutulan içerik dokümanı
+type esContentDocument struct {
+	Content       *entity.Content `json:"content"` // Arama sonucunda olduğu gibi döner
+	ID            int64           `json:"id"`
+	Title         string          `json:"title"`
+	Tags          []string        `json:"tags"`
+	ContentType   string          `json:"content_type"`
+	Language      string          `json:"language"`
+	ProviderID    int64           `json:"provider_id"`
+	ProviderName  string          `json:"provider_name"`  // Küçük harf (büyük/küçük harf duyarsız filtre için)
+	AuthorName    string          `json:"author_name"`    // Küçük harf, yazarı olmayan içeriklerde boş
+	CategoryPaths []string        `json:"category_paths"` // Kategori yolu ve tüm üst yolları; alt kategorileri kapsayan filtre için
+	PublishedAt   time.Time       `json:"published_at"`
+	CreatedAt     time.Time       `json:"created_at"`
+	Views         int64           `json:"views"`
+	Likes         int64           `json:"likes"`
+	Reactions     int64           `json:"reactions"`
+	ReadingTime   int64           `json:"reading_time"`
+	Score         *float64        `json:"score"`     // Skoru olmayan içeriklerde nil
+	Duplicate     bool            `json:"duplicate"` // Kanoniği silinmemiş bir kopyaysa true
+	Generation    int64           `json:"generation"`
+}
+
+// elasticsearchIndex Elasticsearch/OpenSearch REST API ile port.SearchIndex implementasyonu
+// Sadece gereken API alt kümesini (index oluşturma, _bulk, _search, _delete_by_query) net/http ile kullanır
+type elasticsearchIndex struct {
+	source  *postgresContentRepository // İndekslenecek içeriklerin okunduğu doğruluk kaynağı
+	client  *http.Client
+	baseURL string
+	index   string
+	user    *url.Userinfo
+}
+
+// NewElasticsearchIndex yeni bir Elasticsearch arama indeksi oluşturur
+// rawURL http(s)://[user:pass@]host:port formatında olmalıdır; içerikler db'den okunur
+func NewElasticsearchIndex(db *sql.DB, rawURL, index string, client *http.Client) (port.SearchIndex, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid elasticsearch URL: %s", rawURL)
+	}
+	if index == "" || strings.ContainsAny(index, "/ ,*?\"<>|\\") {
+		return nil, fmt.Errorf("invalid elasticsearch index name: %q", index)
+	}
+
+	user := parsed.User
+	parsed.User = nil
+
+	return &elasticsearchIndex{
+		source:  &postgresContentRepository{db: db},
+		client:  client,
+		baseURL: strings.TrimRight(parsed.String(), "/"),
+		index:   index,
+		user:    user,
+	}, nil
+}
+
+// Search arama parametrelerini Elasticsearch sorgusuna çevirip içerikleri indeksten getirir
+func (ix *elasticsearchIndex) Search(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+	var response struct {
+		Hits struct {
+			Total struct {
+				Value int64 `json:"value"`
+			} `json:"total"`
+			Hits []struct {
+				Score  *float64          `json:"_score"`
+				Source esContentDocument `json:"_source"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+
+	body, err := json.Marshal(buildESSearchBody(params))
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := ix.do(ctx, http.MethodPost, "/"+ix.index+"/_search", "application/json", body, &response); err != nil {
+		return nil, 0, err
+	}
+
+	hasQuery := strings.TrimSpace(params.Query) != ""
+	contents := make([]*entity.Content, 0, len(response.Hits.Hits))
+	for _, hit := range response.Hits.Hits {
+		content := hit.Source.Content
+		if content == nil {
+			continue
+		}
+		// Postgres ile aynı: metin araması yoksa alakalılık skoru 0
+		if hasQuery && hit.Score != nil {
+			content.RelevanceScore = *hit.Score
+		}
+		contents = append(contents, content)
+	}
+
+	return contents, response.Hits.Total.Value, nil
+}
+
+// buildESSearchBody arama parametrelerinden _search isteğinin gövdesini oluşturur
+// Filtre ve sıralama anlamları Postgres aramasıyla (buildSearchFilter, Search) aynıdır
+func buildESSearchBody(params port.SearchParams) map[string]interface{} {
+	var must, filter []interface{}
+
+	query := strings.TrimSpace(params.Query)
+	if query != "" {
+		if params.FuzzyThreshold > 0 {
+			// Fuzzy mod: yazım hatalarına toleranslı başlık eşleşmesi
+			must = append(must, map[string]interface{}{
+				"match": map[string]interface{}{
+					"title": map[string]interface{}{"query": query, "fuzziness": "AUTO", "operator": "and"},
+				},
+			})
+		} else {
+			// Tüm kelimeler eşleşmeli, son kelime prefix olarak (yazarken arama); başlık tag'lerden ağır basar
+			must = append(must, map[string]interface{}{
+				"multi_match": map[string]interface{}{
+					"query":    query,
+					"type":     "bool_prefix",
+					"fields":   []string{"title^3", "tags.text"},
+					"operator": "and",
+				},
+			})
+		}
+	}
+
+	term := func(field string, value interface{}) map[string]interface{} {
+		return map[string]interface{}{"term": map[string]interface{}{field: value}}
+	}
+
+	if params.ContentType != "" {
+		filter = append(filter, term("content_type", params.ContentType))
+	}
+	if params.Language != "" {
+		filter = append(filter, term("language", params.Language))
+	}
+	if params.ProviderID > 0 {
+		filter = append(filter, term("provider_id", params.ProviderID))
+	}
+	if params.ProviderName != "" {
+		filter = append(filter, term("provider_name", strings.ToLower(params.ProviderName)))
+	}
+	if params.AuthorName != "" {
+		filter = append(filter, term("author_name", strings.ToLower(params.AuthorName)))
+	}
+	if params.Category != "" {
+		filter = append(filter, term("category_paths", params.Category))
+	}
+	if params.PublishedAfter != nil || params.PublishedBefore != nil {
+		dateRange := map[string]interface{}{}
+		if params.PublishedAfter != nil {
+			dateRange["gte"] = params.PublishedAfter.UTC().Format(time.RFC3339)
+		}
+		if params.PublishedBefore != nil {
+			dateRange["lte"] = params.PublishedBefore.UTC().Format(time.RFC3339)
+		}
+		filter = append(filter, map[string]interface{}{"range": map[string]interface{}{"published_at": dateRange}})
+	}
+	if params.CollapseDuplicates {
+		filter = append(filter, term("duplicate", false))
+	}
+	if len(params.Tags) > 0 {
+		if params.TagMode == port.TagModeAll {
+			for _, tag := range params.Tags {
+				filter = append(filter, term("tags", tag))
+			}
+		} else {
+			filter = append(filter, map[string]interface{}{"terms": map[string]interface{}{"tags": params.Tags}})
+		}
+	}
+
+	boolQuery := map[string]interface{}{}
+	if len(must) > 0 {
+		boolQuery["must"] = must
+	}
+	if len(filter) > 0 {
+		boolQuery["filter"] = filter
+	}
+	var esQuery interface{} = map[string]interface{}{"bool": boolQuery}
+
+	popularity := map[string]interface{}{"score": map[string]interface{}{"order": "desc", "missing": -1}}
+	idDesc := map[string]interface{}{"id": "desc"}
+
+	var sort []interface{}
+	switch {
+	case len(params.SortFields) > 0:
+		for _, f := range params.SortFields {
+			field, ok := esSortFields[f.Field]
+			if !ok {
+				continue
+			}
+			order := "asc"
+			if f.Desc {
+				order = "desc"
+			}
+			if field == "_score" {
+				sort = append(sort, map[string]interface{}{"_score": order})
+			} else {
+				sort = append(sort, map[string]interface{}{field: map[string]interface{}{"order": order, "missing": "_last"}})
+			}
+		}
+		sort = append(sort, idDesc)
+	case params.SortBy == "relevance" && query != "":
+		sort = []interface{}{"_score", map[string]interface{}{"published_at": "desc"}}
+	case params.SortBy == "hybrid":
+		// w × r / (r + 1) + (1 - w) × s / (s + pivot): iki skor da 0-1 aralığına doyurularak birleştirilir
+		esQuery = map[string]interface{}{
+			"script_score": map[string]interface{}{
+				"query": esQuery,
+				"script": map[string]interface{}{
+					"source": "double r = _score; double s = doc['score'].size() == 0 ? 0 : Math.max(doc['score'].value, 0); " +
+						"return params.w * r / (r + 1) + (1 - params.w) * s / (s + params.pivot);",
+					"params": map[string]interface{}{"w": params.HybridRelevanceWeight, "pivot": esHybridPopularityPivot},
+				},
+			},
+		}
+		sort = []interface{}{"_score", idDesc}
+	default:
+		// Varsayılan: popularity; keyset pagination ile tutarlı olması için (score, id) sırası kullanılır
+		sort = []interface{}{popularity, idDesc}
+	}
+
+	body := map[string]interface{}{
+		"query":            esQuery,
+		"sort":             sort,
+		"size":             params.PageSize,
+		"track_total_hits": true,
+		"track_scores":     query != "",
+	}
+	// Tahmini sayımda ES toplamı en fazla CountLimit+1'e kadar sayar
+	if params.CountLimit > 0 {
+		body["track_total_hits"] = params.CountLimit + 1
+	}
+	if params.Cursor != nil {
+		body["search_after"] = []interface{}{params.Cursor.Score, params.Cursor.ID}
+	} else {
+		body["from"] = (params.Page - 1) * params.PageSize
+	}
+
+	return body
+}
+
+// Reindex silinmemiş tüm içerikleri ID sırasıyla batch'ler halinde indekse yazar
+// Her çalıştırma dokümanlara yeni bir generation değeri verir; sonunda eski generation'daki
+// (silinmiş veya artık var olmayan) dokümanlar silinir
+func (ix *elasticsearchIndex) Reindex(ctx context.Context) (int, error) {
+	if err := ix.ensureIndex(ctx); err != nil {
+		return 0, err
+	}
+
+	generation := time.Now().UnixNano()
+	indexed := 0
+	var afterID int64
+	for {
+		contents, err := ix.source.loadIndexBatch(ctx, afterID, esReindexBatchSize)
+		if err != nil {
+			return indexed, fmt.Errorf("failed to load contents for indexing: %w", err)
+		}
+		if len(contents) == 0 {
+			break
+		}
+
+		if err := ix.bulkIndex(ctx, contents, generation); err != nil {
+			return indexed, err
+		}
+		indexed += len(contents)
+		afterID = contents[len(contents)-1].ID
+
+		if len(contents) < esReindexBatchSize {
+			break
+		}
+	}
+
+	// Yeni dokümanlar görünür olmadan silme yapılırsa güncel dokümanlar da eski generation'la eşleşir
+	if err := ix.do(ctx, http.MethodPost, "/"+ix.index+"/_refresh", "", nil, nil); err != nil {
+		return indexed, err
+	}
+	staleQuery := fmt.Sprintf(`{"query": {"range": {"generation": {"lt": %d}}}}`, generation)
+	if err := ix.do(ctx, http.MethodPost, "/"+ix.index+"/_delete_by_query?conflicts=proceed&refresh=true",
+		"application/json", []byte(staleQuery), nil); err != nil {
+		return indexed, err
+	}
+
+	return indexed, nil
+}
+
+// ensureIndex indeks yoksa mapping ile oluşturur
+func (ix *elasticsearchIndex) ensureIndex(ctx context.Context) error {
+	req, err := ix.newRequest(ctx, http.MethodHead, "/"+ix.index, "", nil)
+	if err != nil {
+		return err
+	}
+	resp, err := ix.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("elasticsearch request failed: %w", err)
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return ix.do(ctx, http.MethodPut, "/"+ix.index, "application/json", []byte(esIndexMapping), nil)
+	default:
+		return fmt.Errorf("elasticsearch index check failed: status %d", resp.StatusCode)
+	}
+}
+
+// bulkIndex içerikleri tek _bulk isteğiyle indekse yazar
+func (ix *elasticsearchIndex) bulkIndex(ctx context.Context, contents []*entity.Content, generation int64) error {
+	duplicates, err := ix.source.findDuplicateIDs(ctx, contents)
+	if err != nil {
+		return fmt.Errorf("failed to load duplicate links for indexing: %w", err)
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, c := range contents {
+		action := map[string]interface{}{"index": map[string]interface{}{"_index": ix.index, "_id": c.ID}}
+		if err := encoder.Encode(action); err != nil {
+			return err
+		}
+		if err := encoder.Encode(newESContentDocument(c, duplicates[c.ID], generation)); err != nil {
+			return err
+		}
+	}
+
+	var response struct {
+		Errors bool `json:"errors"`
+		Items  []map[string]struct {
+			Status int             `json:"status"`
+			Error  json.RawMessage `json:"error"`
+		} `json:"items"`
+	}
+	if err := ix.do(ctx, http.MethodPost, "/_bulk", "application/x-ndjson", buf.Bytes(), &response); err != nil {
+		return err
+	}
+	if response.Errors {
+		for _, item := range response.Items {
+			for _, result := range item {
+				if result.Error != nil {
+					return fmt.Errorf("elasticsearch bulk index failed: status %d: %s", result.Status, result.Error)
+				}
+			}
+		}
+		return fmt.Errorf("elasticsearch bulk index failed")
+	}
+
+	return nil
+}
+
+// newESContentDocument içerikten indeks dokümanı oluşturur
+func newESContentDocument(c *entity.Content, duplicate bool, generation int64) esContentDocument {
+	doc := esContentDocument{
+		Content:     c,
+		ID:          c.ID,
+		Title:       c.Title,
+		Tags:        make([]string, len(c.Tags)),
+		ContentType: string(c.ContentType),
+		Language:    c.Language,
+		ProviderID:  c.ProviderID,
+		PublishedAt: c.PublishedAt,
+		CreatedAt:   c.CreatedAt,
+		Duplicate:   duplicate,
+		Generation:  generation,
+	}
+	for i, tag := range c.Tags {
+		doc.Tags[i] = tag.Name
+	}
+	if c.Provider != nil {
+		doc.ProviderName = strings.ToLower(c.Provider.Name)
+	}
+	if c.Author != nil {
+		doc.AuthorName = strings.ToLower(c.Author.Name)
+	}
+	if c.Category != nil {
+		doc.CategoryPaths = entity.CategoryAncestorPaths(c.Category.Path)
+	}
+	if c.Stats != nil {
+		doc.Views = c.Stats.Views
+		doc.Likes = int64(c.Stats.Likes)
+		doc.Reactions = int64(c.Stats.Reactions)
+		doc.ReadingTime = int64(c.Stats.ReadingTime)
+	}
+	if c.Score != nil {
+		score := c.Score.FinalScore
+		doc.Score = &score
+	}
+	return doc
+}
+
+// newRequest kimlik bilgileri eklenmiş bir Elasticsearch isteği oluşturur
+func (ix *elasticsearchIndex) newRequest(ctx context.Context, method, path, contentType string, body []byte) (*http.Request, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, ix.baseURL+path, reader)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if ix.user != nil {
+		password, _ := ix.user.Password()
+		req.SetBasicAuth(ix.user.Username(), password)
+	}
+	return req, nil
+}
+
+// do isteği gönderir, 2xx olmayan yanıtları hataya çevirir ve out verilmişse yanıtı decode eder
+func (ix *elasticsearchIndex) do(ctx context.Context, method, path, contentType string, body []byte, out interface{}) error {
+	req, err := ix.newRequest(ctx, method, path, contentType, body)
+	if err != nil {
+		return err
+	}
+
+	resp, err := ix.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("elasticsearch request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, esErrorBodyLimit))
+		return fmt.Errorf("elasticsearch %s %s failed: status %d: %s", method, path, resp.StatusCode, bytes.TrimSpace(detail))
+	}
+
+	if out == nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode elasticsearch response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,548 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis"
+	"github.com/blevesearch/bleve/v2/analysis/analyzer/custom"
+	"github.com/blevesearch/bleve/v2/analysis/token/lowercase"
+	"github.com/blevesearch/bleve/v2/analysis/tokenizer/unicode"
+	"github.com/blevesearch/bleve/v2/mapping"
+	"github.com/blevesearch/bleve/v2/numeric"
+	"github.com/blevesearch/bleve/v2/search"
+	"github.com/blevesearch/bleve/v2/search/query"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// Embedded indeks ayarları
+const (
+	embeddedReindexBatchSize = 1000
+
+	// embeddedAnalyzer başlık ve tag'lerin analyzer'ı: Postgres'teki 'simple' yapılandırması gibi
+	// sadece kelimelere böler ve küçük harfe çevirir (stemming ve stop-word yok)
+	embeddedAnalyzer = "search_simple"
+
+	// Terim ağırlıkları Postgres aramasındaki ts_rank_cd ağırlıklarıyla aynıdır: başlık (A) ve tag'ler (B)
+	embeddedTitleBoost = 1.0
+	embeddedTagBoost   = 0.2
+)
+
+// errEmbeddedIndexNotBuilt Reindex hiç tamamlanmadan arama yapıldığında döner
+var errEmbeddedIndexNotBuilt = errors.New("embedded search index is not built")
+
+// embeddedSortFields çoklu sıralamada izin verilen alanların indeks karşılıkları (sortColumns ile aynı alanlar)
+var embeddedSortFields = map[string]search.SortFieldType{
+	"published_at": search.SortFieldAsDate,
+	"created_at":   search.SortFieldAsDate,
+	"title_raw":    search.SortFieldAsString,
+	"views":        search.SortFieldAsNumber,
+	"likes":        search.SortFieldAsNumber,
+	"reactions":    search.SortFieldAsNumber,
+	"reading_time": search.SortFieldAsNumber,
+	"score":        search.SortFieldAsNumber,
+}
+
+// embeddedDocument Bleve indeksinde tutulan içerik dokümanı
+// İçeriğin kendisi indekste saklanmaz; sonuçlar snapshot'taki içeriklerden döner
+type embeddedDocument struct {
+	ID            float64   `json:"id"`
+	Title         string    `json:"title"`
+	TitleRaw      string    `json:"title_raw"`
+	Tags          []string  `json:"tags"`     // Tag filtresi için olduğu gibi
+	TagText       []string  `json:"tag_text"` // Metin araması için analiz edilir
+	ContentType   string    `json:"content_type"`
+	Language      string    `json:"language"`
+	ProviderID    float64   `json:"provider_id"`
+	ProviderName  string    `json:"provider_name"`  // Küçük harf (büyük/küçük harf duyarsız filtre için)
+	AuthorName    string    `json:"author_name"`    // Küçük harf, yazarı olmayan içeriklerde boş
+	CategoryPaths []string  `json:"category_paths"` // Kategori yolu ve tüm üst yolları; alt kategorileri kapsayan filtre için
+	PublishedAt   time.Time `json:"published_at"`
+	CreatedAt     time.Time `json:"created_at"`
+	Views         *float64  `json:"views"` // İstatistiği olmayan içeriklerde nil (NULLS LAST sıralama)
+	Likes         *float64  `json:"likes"`
+	Reactions     *float64  `json:"reactions"`
+	ReadingTime   *float64  `json:"reading_time"`
+	Score         *float64  `json:"score"`      // Skoru olmayan içeriklerde nil
+	Popularity    float64   `json:"popularity"` // Skoru olmayan içeriklerde -1 (popularitySortKey)
+	Duplicate     bool      `json:"duplicate"`  // Kanoniği silinmemiş bir kopyaysa true
+}
+
+// embeddedIndex süreç içinde çalışan Bleve indeksiyle port.SearchIndex implementasyonu
+// Tek binary kurulumlar içindir: indeks bellekte tutulur, açılışta ve her senkronizasyondan sonra
+// Postgres'ten yeniden oluşturulur. Yeniden oluşturma sırasında aramalar önceki indeksten yapılır.
+type embeddedIndex struct {
+	source *postgresContentRepository // İndekslenecek içeriklerin okunduğu doğruluk kaynağı
+
+	mu       sync.RWMutex
+	snapshot *embeddedSnapshot
+}
+
+// NewEmbeddedIndex içerikleri db'den okuyan yeni bir embedded arama indeksi oluşturur
+// İndeks boş başlar; arama yapılmadan önce Reindex çağrılmalıdır
+func NewEmbeddedIndex(db *sql.DB) port.SearchIndex {
+	return &embeddedIndex{source: &postgresContentRepository{db: db}}
+}
+
+// Search içerikleri Bleve indeksinden getirir
+// Okuma kilidi arama boyunca tutulur; böylece Reindex eski indeksi kullanımdayken kapatmaz
+func (ix *embeddedIndex) Search(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	if ix.snapshot == nil {
+		return nil, 0, errEmbeddedIndexNotBuilt
+	}
+	return ix.snapshot.search(ctx, params)
+}
+
+// Reindex silinmemiş tüm içeriklerden yeni bir Bleve indeksi oluşturur ve tamamlanınca eskisiyle değiştirir
+// Hata durumunda önceki indeks kullanılmaya devam eder
+func (ix *embeddedIndex) Reindex(ctx context.Context) (int, error) {
+	snapshot, err := newEmbeddedSnapshot()
+	if err != nil {
+		return 0, err
+	}
+
+	var afterID int64
+	for {
+		contents, err := ix.source.loadIndexBatch(ctx, afterID, embeddedReindexBatchSize)
+		if err != nil {
+			snapshot.close()
+			return 0, fmt.Errorf("failed to load contents for indexing: %w", err)
+		}
+		if len(contents) == 0 {
+			break
+		}
+
+		duplicates, err := ix.source.findDuplicateIDs(ctx, contents)
+		if err != nil {
+			snapshot.close()
+			return 0, fmt.Errorf("failed to load duplicate links for indexing: %w", err)
+		}
+		if err := snapshot.add(contents, duplicates); err != nil {
+			snapshot.close()
+			return 0, err
+		}
+		afterID = contents[len(contents)-1].ID
+
+		if len(contents) < embeddedReindexBatchSize {
+			break
+		}
+	}
+
+	ix.mu.Lock()
+	previous := ix.snapshot
+	ix.snapshot = snapshot
+	ix.mu.Unlock()
+
+	if previous != nil {
+		previous.close()
+	}
+	return len(snapshot.contents), nil
+}
+
+// embeddedSnapshot bir Bleve indeksi ve indekslenen içerikler; oluşturulduktan sonra değişmez
+type embeddedSnapshot struct {
+	index    bleve.Index
+	analyzer analysis.Analyzer
+	contents map[string]*entity.Content // Doküman ID'si -> içerik
+}
+
+// newEmbeddedSnapshot boş, bellekte tutulan bir Bleve indeksi oluşturur
+func newEmbeddedSnapshot() (*embeddedSnapshot, error) {
+	indexMapping, err := newEmbeddedMapping()
+	if err != nil {
+		return nil, err
+	}
+	index, err := bleve.NewMemOnly(indexMapping)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create embedded search index: %w", err)
+	}
+	return &embeddedSnapshot{
+		index:    index,
+		analyzer: indexMapping.AnalyzerNamed(embeddedAnalyzer),
+		contents: make(map[string]*entity.Content),
+	}, nil
+}
+
+// newEmbeddedMapping indeks mapping'ini oluşturur
+// Metin alanları embeddedAnalyzer ile analiz edilir, filtre alanları keyword olarak olduğu gibi tutulur
+func newEmbeddedMapping() (*mapping.IndexMappingImpl, error) {
+	indexMapping := bleve.NewIndexMapping()
+	err := indexMapping.AddCustomAnalyzer(embeddedAnalyzer, map[string]interface{}{
+		"type":          custom.Name,
+		"tokenizer":     unicode.Name,
+		"token_filters": []string{lowercase.Name},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	text := bleve.NewTextFieldMapping()
+	text.Analyzer = embeddedAnalyzer
+	text.Store = false
+	text.IncludeInAll = false
+
+	keyword := bleve.NewKeywordFieldMapping()
+	keyword.Store = false
+	keyword.IncludeInAll = false
+
+	number := bleve.NewNumericFieldMapping()
+	number.Store = false
+	number.IncludeInAll = false
+
+	datetime := bleve.NewDateTimeFieldMapping()
+	datetime.Store = false
+	datetime.IncludeInAll = false
+
+	boolean := bleve.NewBooleanFieldMapping()
+	boolean.Store = false
+	boolean.IncludeInAll = false
+
+	doc := bleve.NewDocumentStaticMapping()
+	doc.AddFieldMappingsAt("title", text)
+	doc.AddFieldMappingsAt("tag_text", text)
+	for _, field := range []string{"title_raw", "tags", "content_type", "language", "provider_name", "author_name", "category_paths"} {
+		doc.AddFieldMappingsAt(field, keyword)
+	}
+	for _, field := range []string{"id", "provider_id", "views", "likes", "reactions", "reading_time", "score", "popularity"} {
+		doc.AddFieldMappingsAt(field, number)
+	}
+	doc.AddFieldMappingsAt("published_at", datetime)
+	doc.AddFieldMappingsAt("created_at", datetime)
+	doc.AddFieldMappingsAt("duplicate", boolean)
+
+	indexMapping.DefaultMapping = doc
+	indexMapping.DefaultAnalyzer = embeddedAnalyzer
+	return indexMapping, nil
+}
+
+// add içerikleri tek bir batch olarak indekse yazar
+func (s *embeddedSnapshot) add(contents []*entity.Content, duplicates map[int64]bool) error {
+	batch := s.index.NewBatch()
+	for _, c := range contents {
+		id := strconv.FormatInt(c.ID, 10)
+		if err := batch.Index(id, newEmbeddedDocument(c, duplicates[c.ID])); err != nil {
+			return fmt.Errorf("failed to index content %d: %w", c.ID, err)
+		}
+		s.contents[id] = c
+	}
+	if err := s.index.Batch(batch); err != nil {
+		return fmt.Errorf("embedded search index batch failed: %w", err)
+	}
+	return nil
+}
+
+// close indeksi kapatır
+func (s *embeddedSnapshot) close() {
+	s.index.Close()
+}
+
+// newEmbeddedDocument içerikten indeks dokümanı oluşturur
+func newEmbeddedDocument(c *entity.Content, duplicate bool) embeddedDocument {
+	doc := embeddedDocument{
+		ID:          float64(c.ID),
+		Title:       c.Title,
+		TitleRaw:    c.Title,
+		Tags:        make([]string, len(c.Tags)),
+		TagText:     make([]string, len(c.Tags)),
+		ContentType: string(c.ContentType),
+		Language:    c.Language,
+		ProviderID:  float64(c.ProviderID),
+		PublishedAt: c.PublishedAt,
+		CreatedAt:   c.CreatedAt,
+		Popularity:  -1,
+		Duplicate:   duplicate,
+	}
+	for i, tag := range c.Tags {
+		doc.Tags[i] = tag.Name
+		doc.TagText[i] = tag.Name
+	}
+	if c.Provider != nil {
+		doc.ProviderName = strings.ToLower(c.Provider.Name)
+	}
+	if c.Author != nil {
+		doc.AuthorName = strings.ToLower(c.Author.Name)
+	}
+	if c.Category != nil {
+		doc.CategoryPaths = entity.CategoryAncestorPaths(c.Category.Path)
+	}
+	if c.Stats != nil {
+		number := func(v float64) *float64 { return &v }
+		doc.Views = number(float64(c.Stats.Views))
+		doc.Likes = number(float64(c.Stats.Likes))
+		doc.Reactions = number(float64(c.Stats.Reactions))
+		doc.ReadingTime = number(float64(c.Stats.ReadingTime))
+	}
+	if c.Score != nil {
+		score := c.Score.FinalScore
+		doc.Score = &score
+		doc.Popularity = score
+	}
+	return doc
+}
+
+// search filtre, sıralama ve sayfalama anlamları Postgres aramasıyla (buildSearchFilter, Search) aynıdır
+func (s *embeddedSnapshot) search(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+	textQuery := s.textQuery(params)
+	hasQuery := textQuery != nil
+	if !hasQuery {
+		textQuery = bleve.NewMatchAllQuery()
+	}
+	request := bleve.NewSearchRequestOptions(bleve.NewConjunctionQuery(append([]query.Query{textQuery}, embeddedFilters(params)...)...), params.PageSize, 0, false)
+
+	popularity := &search.SortField{Field: "popularity", Type: search.SortFieldAsNumber, Desc: true}
+	idDesc := &search.SortField{Field: "id", Type: search.SortFieldAsNumber, Desc: true}
+
+	switch {
+	case len(params.SortFields) > 0:
+		var order search.SortOrder
+		for _, f := range params.SortFields {
+			if f.Field == "relevance" {
+				order = append(order, &search.SortScore{Desc: f.Desc})
+				continue
+			}
+			field := f.Field
+			if field == "title" {
+				field = "title_raw"
+			}
+			fieldType, ok := embeddedSortFields[field]
+			if !ok {
+				continue
+			}
+			order = append(order, &search.SortField{Field: field, Type: fieldType, Desc: f.Desc, Missing: search.SortFieldMissingLast})
+		}
+		request.SortByCustom(append(order, idDesc))
+	case params.SortBy == "relevance" && hasQuery:
+		request.SortByCustom(search.SortOrder{
+			&search.SortScore{Desc: true},
+			&search.SortField{Field: "published_at", Type: search.SortFieldAsDate, Desc: true},
+			idDesc,
+		})
+	case params.SortBy == "hybrid":
+		return s.searchHybrid(ctx, request, params, hasQuery)
+	default:
+		// Varsayılan: popularity; keyset pagination ile tutarlı olması için (popularity, id) sırası kullanılır
+		request.SortByCustom(search.SortOrder{popularity, idDesc})
+		if params.Cursor != nil {
+			request.SetSearchAfter([]string{
+				string(numeric.MustNewPrefixCodedInt64(numeric.Float64ToInt64(params.Cursor.Score), 0)),
+				string(numeric.MustNewPrefixCodedInt64(numeric.Float64ToInt64(float64(params.Cursor.ID)), 0)),
+			})
+		}
+	}
+	if params.Cursor == nil {
+		request.From = (params.Page - 1) * params.PageSize
+	}
+
+	result, err := s.index.SearchInContext(ctx, request)
+	if err != nil {
+		return nil, 0, fmt.Errorf("embedded search failed: %w", err)
+	}
+
+	contents := make([]*entity.Content, 0, len(result.Hits))
+	for _, hit := range result.Hits {
+		relevance := 0.0
+		if hasQuery {
+			relevance = hit.Score
+		}
+		if content := s.content(hit.ID, relevance); content != nil {
+			contents = append(contents, content)
+		}
+	}
+	return contents, int64(result.Total), nil
+}
+
+// searchHybrid eşleşen tüm kayıtları alır ve Postgres'teki hybridSortKey ile aynı şekilde sıralar:
+// alakalılık ve popülerlik eşleşen kayıtlar içindeki en yüksek değere bölünerek 0-1 aralığına getirilir
+func (s *embeddedSnapshot) searchHybrid(ctx context.Context, request *bleve.SearchRequest, params port.SearchParams, hasQuery bool) ([]*entity.Content, int64, error) {
+	request.Size = len(s.contents)
+	request.From = 0
+	request.SortByCustom(search.SortOrder{&search.SortScore{Desc: true}})
+
+	result, err := s.index.SearchInContext(ctx, request)
+	if err != nil {
+		return nil, 0, fmt.Errorf("embedded search failed: %w", err)
+	}
+
+	hits := make([]*entity.Content, 0, len(result.Hits))
+	var maxRelevance, maxPopularity float64
+	for _, hit := range result.Hits {
+		relevance := 0.0
+		if hasQuery {
+			relevance = hit.Score
+		}
+		content := s.content(hit.ID, relevance)
+		if content == nil {
+			continue
+		}
+		hits = append(hits, content)
+		maxRelevance = max(maxRelevance, content.RelevanceScore)
+		if content.Score != nil {
+			maxPopularity = max(maxPopularity, content.Score.FinalScore)
+		}
+	}
+
+	normalize := func(value, max float64) float64 {
+		if max == 0 {
+			return 0
+		}
+		return value / max
+	}
+	weight := params.HybridRelevanceWeight
+	key := func(c *entity.Content) float64 {
+		popularity := 0.0
+		if c.Score != nil {
+			popularity = c.Score.FinalScore
+		}
+		return weight*normalize(c.RelevanceScore, maxRelevance) + (1-weight)*normalize(popularity, maxPopularity)
+	}
+	sort.SliceStable(hits, func(i, j int) bool {
+		if ki, kj := key(hits[i]), key(hits[j]); ki != kj {
+			return ki > kj
+		}
+		return hits[i].ID > hits[j].ID
+	})
+
+	offset := min((params.Page-1)*params.PageSize, len(hits))
+	hits = hits[offset:]
+	if len(hits) > params.PageSize {
+		hits = hits[:params.PageSize]
+	}
+	return hits, int64(result.Total), nil
+}
+
+// content indeksteki içeriğin kopyasını verilen alakalılık skoruyla döner
+// İndeksteki kayıt aramalar arasında paylaşıldığından kopyası döner
+func (s *embeddedSnapshot) content(id string, relevance float64) *entity.Content {
+	indexed, ok := s.contents[id]
+	if !ok {
+		return nil
+	}
+	content := *indexed
+	content.RelevanceScore = relevance
+	return &content
+}
+
+// textQuery metin sorgusunu Bleve sorgusuna çevirir; sorgu yoksa nil döner
+// Tüm kelimeler eşleşmelidir: normal modda prefix olarak başlık veya tag'lerde (Postgres'teki
+// "kelime:* & ..." gibi), fuzzy modda yazım hatalarına toleranslı olarak başlıkta
+func (s *embeddedSnapshot) textQuery(params port.SearchParams) query.Query {
+	var terms []string
+	for _, token := range s.analyzer.Analyze([]byte(params.Query)) {
+		terms = append(terms, string(token.Term))
+	}
+	if len(terms) == 0 {
+		return nil
+	}
+
+	conjuncts := make([]query.Query, 0, len(terms))
+	for _, term := range terms {
+		if params.FuzzyThreshold > 0 {
+			fuzzy := bleve.NewFuzzyQuery(term)
+			fuzzy.SetField("title")
+			fuzzy.SetFuzziness(autoFuzziness(term))
+			conjuncts = append(conjuncts, fuzzy)
+			continue
+		}
+
+		title := bleve.NewPrefixQuery(term)
+		title.SetField("title")
+		title.SetBoost(embeddedTitleBoost)
+		tags := bleve.NewPrefixQuery(term)
+		tags.SetField("tag_text")
+		tags.SetBoost(embeddedTagBoost)
+		conjuncts = append(conjuncts, bleve.NewDisjunctionQuery(title, tags))
+	}
+	return bleve.NewConjunctionQuery(conjuncts...)
+}
+
+// autoFuzziness Elasticsearch'teki "AUTO" gibi kelime uzunluğuna göre izin verilen düzenleme sayısını döner
+func autoFuzziness(term string) int {
+	switch n := len([]rune(term)); {
+	case n <= 2:
+		return 0
+	case n <= 5:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// embeddedFilters arama filtrelerini Bleve sorgularına çevirir
+// Filtrelerin ağırlığı 0'dır; alakalılık skoru sadece metin eşleşmesinden gelir (ES'teki filter context gibi)
+func embeddedFilters(params port.SearchParams) []query.Query {
+	var filters []query.Query
+	term := func(field, value string) query.Query {
+		q := bleve.NewTermQuery(value)
+		q.SetField(field)
+		q.SetBoost(0)
+		return q
+	}
+
+	if params.ContentType != "" {
+		filters = append(filters, term("content_type", string(params.ContentType)))
+	}
+	if params.Language != "" {
+		filters = append(filters, term("language", params.Language))
+	}
+	if params.ProviderID > 0 {
+		id, inclusive := float64(params.ProviderID), true
+		q := bleve.NewNumericRangeInclusiveQuery(&id, &id, &inclusive, &inclusive)
+		q.SetField("provider_id")
+		q.SetBoost(0)
+		filters = append(filters, q)
+	}
+	if params.ProviderName != "" {
+		filters = append(filters, term("provider_name", strings.ToLower(params.ProviderName)))
+	}
+	if params.AuthorName != "" {
+		filters = append(filters, term("author_name", strings.ToLower(params.AuthorName)))
+	}
+	if params.Category != "" {
+		filters = append(filters, term("category_paths", params.Category))
+	}
+	if params.PublishedAfter != nil || params.PublishedBefore != nil {
+		var start, end time.Time
+		if params.PublishedAfter != nil {
+			start = *params.PublishedAfter
+		}
+		if params.PublishedBefore != nil {
+			end = *params.PublishedBefore
+		}
+		inclusive := true
+		q := bleve.NewDateRangeInclusiveQuery(start, end, &inclusive, &inclusive)
+		q.SetField("published_at")
+		q.SetBoost(0)
+		filters = append(filters, q)
+	}
+	if params.CollapseDuplicates {
+		q := bleve.NewBoolFieldQuery(false)
+		q.SetField("duplicate")
+		q.SetBoost(0)
+		filters = append(filters, q)
+	}
+	if len(params.Tags) > 0 {
+		tags := make([]query.Query, len(params.Tags))
+		for i, tag := range params.Tags {
+			tags[i] = term("tags", tag)
+		}
+		if params.TagMode == port.TagModeAll {
+			filters = append(filters, tags...)
+		} else {
+			filters = append(filters, bleve.NewDisjunctionQuery(tags...))
+		}
+	}
+	return filters
+}
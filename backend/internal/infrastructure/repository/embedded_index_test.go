@@ -0,0 +1,225 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// newTestSnapshot test içerikleriyle bir indeks oluşturur (Postgres gerektirmez)
+func newTestSnapshot(t *testing.T) *embeddedSnapshot {
+	t.Helper()
+
+	now := time.Now()
+	content := func(id int64, title string, contentType entity.ContentType, score float64, tags ...string) *entity.Content {
+		c := &entity.Content{
+			ID:          id,
+			ProviderID:  1,
+			Title:       title,
+			ContentType: contentType,
+			Language:    entity.LanguageEnglish,
+			PublishedAt: now.Add(-time.Duration(id) * time.Hour),
+			Provider:    &entity.ContentProvider{ID: 1, Name: "Provider A"},
+			Stats:       &entity.ContentStats{Views: id * 100},
+		}
+		if score >= 0 {
+			c.Score = &entity.ContentScore{FinalScore: score}
+		}
+		for _, tag := range tags {
+			c.Tags = append(c.Tags, entity.Tag{Name: tag})
+		}
+		return c
+	}
+
+	concurrency := content(2, "Advanced Go Concurrency", entity.ContentTypeArticle, 50, "go")
+	concurrency.Author = &entity.ContentAuthor{ID: 1, Name: "Rob Pike"}
+	concurrency.Category = &entity.ContentCategory{ID: 2, Name: "Go", Path: "programming/go"}
+	pasta := content(5, "Cooking Pasta", entity.ContentTypeArticle, -1)
+	pasta.Language = entity.LanguageTurkish
+	pasta.Stats = nil
+
+	s, err := newEmbeddedSnapshot()
+	require.NoError(t, err)
+	t.Cleanup(s.close)
+	require.NoError(t, s.add([]*entity.Content{
+		content(1, "Go Programming Basics", entity.ContentTypeVideo, 10, "go", "programming"),
+		concurrency,
+		content(3, "Python Programming", entity.ContentTypeVideo, 30, "python"),
+		content(4, "Go Programming Basics", entity.ContentTypeVideo, 20, "go"),
+		pasta,
+	}, map[int64]bool{4: true}))
+	return s
+}
+
+func contentIDs(contents []*entity.Content) []int64 {
+	ids := make([]int64, len(contents))
+	for i, c := range contents {
+		ids[i] = c.ID
+	}
+	return ids
+}
+
+func TestEmbeddedIndex_SearchBeforeReindex(t *testing.T) {
+	index := NewEmbeddedIndex(nil)
+
+	_, _, err := index.Search(context.Background(), port.SearchParams{Page: 1, PageSize: 10})
+	assert.ErrorIs(t, err, errEmbeddedIndexNotBuilt)
+}
+
+func TestEmbeddedSnapshot_Search(t *testing.T) {
+	s := newTestSnapshot(t)
+	search := func(t *testing.T, params port.SearchParams) ([]*entity.Content, int64) {
+		t.Helper()
+		contents, total, err := s.search(context.Background(), params)
+		require.NoError(t, err)
+		return contents, total
+	}
+
+	t.Run("default sort by popularity, missing score last", func(t *testing.T) {
+		contents, total := search(t, port.SearchParams{Page: 1, PageSize: 10})
+		assert.Equal(t, int64(5), total)
+		assert.Equal(t, []int64{2, 3, 4, 1, 5}, contentIDs(contents))
+		assert.Zero(t, contents[0].RelevanceScore)
+	})
+
+	t.Run("all query words must match as prefix", func(t *testing.T) {
+		contents, total := search(t, port.SearchParams{Query: "go prog", Page: 1, PageSize: 10})
+		assert.Equal(t, int64(2), total)
+		assert.ElementsMatch(t, []int64{1, 4}, contentIDs(contents))
+		assert.Greater(t, contents[0].RelevanceScore, 0.0)
+	})
+
+	t.Run("title matches rank above tag matches", func(t *testing.T) {
+		contents, _ := search(t, port.SearchParams{Query: "programming", SortBy: "relevance", Page: 1, PageSize: 10})
+		require.Len(t, contents, 3)
+		// 1 başlık + tag ile eşleşir, 3 ve 4 sadece başlıkla; eşitlikte yeni yayınlanan önce gelir
+		assert.Equal(t, []int64{1, 3, 4}, contentIDs(contents))
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		contents, total := search(t, port.SearchParams{
+			Query:              "go",
+			ContentType:        entity.ContentTypeVideo,
+			ProviderName:       "provider a",
+			CollapseDuplicates: true,
+			Page:               1,
+			PageSize:           10,
+		})
+		assert.Equal(t, int64(1), total)
+		assert.Equal(t, []int64{1}, contentIDs(contents))
+	})
+
+	t.Run("author filter", func(t *testing.T) {
+		contents, total := search(t, port.SearchParams{AuthorName: "rob pike", Page: 1, PageSize: 10})
+		assert.Equal(t, int64(1), total)
+		assert.Equal(t, []int64{2}, contentIDs(contents))
+	})
+
+	t.Run("category filter includes descendants", func(t *testing.T) {
+		contents, total := search(t, port.SearchParams{Category: "programming", Page: 1, PageSize: 10})
+		assert.Equal(t, int64(1), total)
+		assert.Equal(t, []int64{2}, contentIDs(contents))
+
+		_, total = search(t, port.SearchParams{Category: "program", Page: 1, PageSize: 10})
+		assert.Zero(t, total)
+	})
+
+	t.Run("language filter", func(t *testing.T) {
+		_, total := search(t, port.SearchParams{Language: entity.LanguageEnglish, Page: 1, PageSize: 10})
+		assert.Equal(t, int64(4), total)
+
+		contents, _ := search(t, port.SearchParams{Language: entity.LanguageTurkish, Page: 1, PageSize: 10})
+		assert.Equal(t, []int64{5}, contentIDs(contents))
+	})
+
+	t.Run("tag modes", func(t *testing.T) {
+		_, total := search(t, port.SearchParams{Tags: []string{"go", "python"}, TagMode: port.TagModeAny, Page: 1, PageSize: 10})
+		assert.Equal(t, int64(4), total)
+
+		contents, _ := search(t, port.SearchParams{Tags: []string{"go", "programming"}, TagMode: port.TagModeAll, Page: 1, PageSize: 10})
+		assert.Equal(t, []int64{1}, contentIDs(contents))
+	})
+
+	t.Run("fuzzy matches typos on title", func(t *testing.T) {
+		contents, _ := search(t, port.SearchParams{Query: "pyton programing", FuzzyThreshold: 0.3, Page: 1, PageSize: 10})
+		require.NotEmpty(t, contents)
+		assert.Equal(t, int64(3), contents[0].ID)
+	})
+
+	t.Run("page and cursor pagination", func(t *testing.T) {
+		contents, total := search(t, port.SearchParams{Page: 2, PageSize: 2})
+		assert.Equal(t, int64(5), total)
+		assert.Equal(t, []int64{4, 1}, contentIDs(contents))
+
+		contents, total = search(t, port.SearchParams{Page: 1, PageSize: 2, Cursor: &port.SearchCursor{Score: 30, ID: 3}})
+		assert.Equal(t, int64(5), total)
+		assert.Equal(t, []int64{4, 1}, contentIDs(contents))
+	})
+
+	t.Run("multi-field sort with nulls last", func(t *testing.T) {
+		contents, _ := search(t, port.SearchParams{
+			SortFields: []port.SortField{{Field: "score", Desc: false}},
+			Page:       1,
+			PageSize:   10,
+		})
+		assert.Equal(t, []int64{1, 4, 3, 2, 5}, contentIDs(contents))
+	})
+
+	t.Run("descending sort keeps missing values last", func(t *testing.T) {
+		contents, _ := search(t, port.SearchParams{
+			SortFields: []port.SortField{{Field: "views", Desc: true}},
+			Page:       1,
+			PageSize:   10,
+		})
+		assert.Equal(t, []int64{4, 3, 2, 1, 5}, contentIDs(contents))
+	})
+
+	t.Run("filters do not change relevance", func(t *testing.T) {
+		unfiltered, _ := search(t, port.SearchParams{Query: "programming", Page: 1, PageSize: 10})
+		filtered, _ := search(t, port.SearchParams{Query: "programming", ContentType: entity.ContentTypeVideo, Tags: []string{"go"}, Page: 1, PageSize: 10})
+		require.Len(t, filtered, 2)
+
+		relevance := make(map[int64]float64)
+		for _, c := range unfiltered {
+			relevance[c.ID] = c.RelevanceScore
+		}
+		for _, c := range filtered {
+			assert.InDelta(t, relevance[c.ID], c.RelevanceScore, 1e-9)
+		}
+	})
+
+	t.Run("published date range", func(t *testing.T) {
+		after := time.Now().Add(-3*time.Hour - time.Minute)
+		before := time.Now().Add(-time.Hour - time.Minute)
+		contents, total := search(t, port.SearchParams{PublishedAfter: &after, PublishedBefore: &before, Page: 1, PageSize: 10})
+		assert.Equal(t, int64(2), total)
+		assert.ElementsMatch(t, []int64{2, 3}, contentIDs(contents))
+	})
+
+	t.Run("hybrid normalizes relevance and popularity", func(t *testing.T) {
+		params := port.SearchParams{Query: "programming", SortBy: "hybrid", Page: 1, PageSize: 10}
+
+		params.HybridRelevanceWeight = 0
+		contents, _ := search(t, params)
+		assert.Equal(t, []int64{3, 4, 1}, contentIDs(contents))
+
+		params.HybridRelevanceWeight = 1
+		contents, _ = search(t, params)
+		assert.Equal(t, int64(1), contents[0].ID)
+	})
+
+	t.Run("returns copies of indexed contents", func(t *testing.T) {
+		contents, _ := search(t, port.SearchParams{Query: "pasta", Page: 1, PageSize: 10})
+		require.Len(t, contents, 1)
+		contents[0].Title = "changed"
+
+		contents, _ = search(t, port.SearchParams{Query: "pasta", Page: 1, PageSize: 10})
+		assert.Equal(t, "Cooking Pasta", contents[0].Title)
+	})
+}
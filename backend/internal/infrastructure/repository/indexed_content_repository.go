@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// indexedContentRepository aramaları harici arama indeksine yönlendiren ContentRepository
+// Search dışındaki tüm işlemler (yazmalar, facet'ler, benzer içerikler) doğruluk kaynağına (Postgres) gider
+type indexedContentRepository struct {
+	port.ContentRepository
+	index port.SearchIndex
+}
+
+// NewIndexedContentRepository source'u saran ve Search'ü index üzerinden yapan bir repository oluşturur
+func NewIndexedContentRepository(source port.ContentRepository, index port.SearchIndex) port.ContentRepository {
+	return &indexedContentRepository{
+		ContentRepository: source,
+		index:             index,
+	}
+}
+
+// Search içerikleri arama indeksinden getirir
+func (r *indexedContentRepository) Search(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+	return r.index.Search(ctx, params)
+}
@@ -0,0 +1,313 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/testutil"
+)
+
+// migrationsDir numaralı migration dosyalarının bulunduğu dizin (paket dizinine göre)
+const migrationsDir = "../../../migrations"
+
+// newMigrationDB migration testleri için boş bir veritabanı oluşturur; test bitince veritabanı silinir
+func newMigrationDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	admin := testutil.SetupTestDB(t)
+	t.Cleanup(func() { admin.Close() })
+
+	name := fmt.Sprintf("search_engine_migrations_%d", time.Now().UnixNano())
+	_, err := admin.Exec("CREATE DATABASE " + name)
+	require.NoError(t, err)
+
+	dbURL, err := url.Parse(testutil.TestDatabaseURL())
+	require.NoError(t, err)
+	dbURL.Path = "/" + name
+
+	db, err := sql.Open("postgres", dbURL.String())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+		if _, err := admin.Exec("DROP DATABASE IF EXISTS " + name + " WITH (FORCE)"); err != nil {
+			t.Logf("Warning: Failed to drop database %s: %v", name, err)
+		}
+	})
+	return db
+}
+
+// applySQLFile bir migration dosyasını tek seferde çalıştırır (psql -f gibi aynı oturumda)
+func applySQLFile(t *testing.T, db *sql.DB, path string) {
+	t.Helper()
+
+	query, err := os.ReadFile(path)
+	require.NoError(t, err)
+	_, err = db.Exec(string(query))
+	require.NoError(t, err, "migration %s failed", filepath.Base(path))
+}
+
+// applyUpMigrations numaralı tüm up migration'larını sırayla uygular
+func applyUpMigrations(t *testing.T, db *sql.DB) {
+	t.Helper()
+
+	files, err := filepath.Glob(filepath.Join(migrationsDir, "*.up.sql"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	sort.Strings(files)
+
+	for _, file := range files {
+		applySQLFile(t, db, file)
+	}
+}
+
+// legacyScoringRulesDDL 001'in eski sürümlerinin oluşturduğu anahtar/değer scoring_rules tablosu
+const legacyScoringRulesDDL = `
+	CREATE TABLE scoring_rules (
+		id SERIAL PRIMARY KEY,
+		rule_name VARCHAR(100) NOT NULL UNIQUE,
+		rule_value JSONB NOT NULL,
+		description TEXT,
+		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	);
+	INSERT INTO scoring_rules (rule_name, rule_value, description) VALUES
+		('video_type_weight', '2.5', 'Video içerikler için tür katsayısı'),
+		('article_type_weight', '1.0', 'Makale içerikler için tür katsayısı');
+`
+
+func TestMigrations_ScoringRules(t *testing.T) {
+	readRules := func(t *testing.T, db *sql.DB) (video, article float64, decay string) {
+		t.Helper()
+		err := db.QueryRow(`
+			SELECT video_type_weight, article_type_weight, recency_decay
+			FROM scoring_rules WHERE id = 1
+		`).Scan(&video, &article, &decay)
+		require.NoError(t, err)
+		return video, article, decay
+	}
+
+	t.Run("fresh database", func(t *testing.T) {
+		db := newMigrationDB(t)
+		applyUpMigrations(t, db)
+
+		video, article, decay := readRules(t, db)
+		assert.Equal(t, 1.5, video)
+		assert.Equal(t, 1.0, article)
+		assert.Equal(t, "", decay)
+	})
+
+	t.Run("existing database with the legacy key/value table", func(t *testing.T) {
+		db := newMigrationDB(t)
+		_, err := db.Exec(legacyScoringRulesDDL)
+		require.NoError(t, err)
+
+		applyUpMigrations(t, db)
+
+		// Eski tablodaki tür katsayıları yeni tek satırlık tabloya taşınır
+		video, article, _ := readRules(t, db)
+		assert.Equal(t, 2.5, video)
+		assert.Equal(t, 1.0, article)
+
+		var legacyColumns int
+		require.NoError(t, db.QueryRow(`
+			SELECT COUNT(*) FROM information_schema.columns
+			WHERE table_name = 'scoring_rules' AND column_name IN ('rule_name', 'rule_value')
+		`).Scan(&legacyColumns))
+		assert.Zero(t, legacyColumns)
+	})
+}
+
+// contentTables partitioning migration'ının dönüştürdüğü tablolar
+var contentTables = []string{"contents", "content_stats", "content_scores", "content_tags"}
+
+func countContentRows(t *testing.T, db *sql.DB) map[string]int {
+	t.Helper()
+
+	counts := make(map[string]int, len(contentTables))
+	for _, table := range contentTables {
+		var n int
+		require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM "+table).Scan(&n))
+		counts[table] = n
+	}
+	return counts
+}
+
+func requirePQCode(t *testing.T, err error, code pq.ErrorCode) {
+	t.Helper()
+
+	require.Error(t, err)
+	var pqErr *pq.Error
+	require.True(t, errors.As(err, &pqErr), "expected a postgres error, got %v", err)
+	assert.Equal(t, code, pqErr.Code, pqErr.Message)
+}
+
+func TestMigrations_PartitionContents(t *testing.T) {
+	db := newMigrationDB(t)
+	applyUpMigrations(t, db)
+
+	// Dolu veritabanı: iki provider, istatistik/skor/etiketli içerikler
+	providers := []*entity.Provider{
+		testutil.CreateTestProvider(t, db, "Partition Provider A", "json"),
+		testutil.CreateTestProvider(t, db, "Partition Provider B", "xml"),
+	}
+	tag := testutil.CreateTestTag(t, db, "partitioning")
+	var contents []*entity.Content
+	for _, provider := range providers {
+		for i := 0; i < 5; i++ {
+			content := testutil.CreateTestContentWithScore(t, db, provider.ID, float64(i))
+			testutil.AddTagToContent(t, db, content.ID, tag.ID)
+			contents = append(contents, content)
+		}
+	}
+	before := countContentRows(t, db)
+	require.Equal(t, len(contents), before["contents"])
+
+	applySQLFile(t, db, filepath.Join(migrationsDir, "partitioning", "001_partition_contents.up.sql"))
+
+	t.Run("up preserves rows", func(t *testing.T) {
+		assert.Equal(t, before, countContentRows(t, db))
+
+		var relkind string
+		require.NoError(t, db.QueryRow("SELECT relkind FROM pg_class WHERE oid = 'contents'::regclass").Scan(&relkind))
+		assert.Equal(t, "p", relkind)
+
+		// Mevcut provider'ların satırları kendi partition'larındadır
+		for _, provider := range providers {
+			var n int
+			require.NoError(t, db.QueryRow(fmt.Sprintf("SELECT COUNT(*) FROM contents_p%d", provider.ID)).Scan(&n))
+			assert.Equal(t, 5, n)
+		}
+	})
+
+	t.Run("unique provider content id", func(t *testing.T) {
+		_, err := db.Exec(`
+			INSERT INTO contents (provider_id, provider_content_id, title, content_type, published_at)
+			VALUES ($1, $2, 'Duplicate', 'video', NOW())
+		`, contents[0].ProviderID, contents[0].ProviderContentID)
+		requirePQCode(t, err, "23505")
+	})
+
+	t.Run("child tables reject missing contents", func(t *testing.T) {
+		for _, query := range []string{
+			"INSERT INTO content_stats (content_id, views) VALUES (999999999, 1)",
+			"INSERT INTO content_scores (content_id, final_score) VALUES (999999999, 1)",
+			fmt.Sprintf("INSERT INTO content_tags (content_id, tag_id) VALUES (999999999, %d)", tag.ID),
+		} {
+			_, err := db.Exec(query)
+			requirePQCode(t, err, "23503")
+		}
+	})
+
+	t.Run("deleting contents deletes children", func(t *testing.T) {
+		deleted := contents[len(contents)-1]
+		contents = contents[:len(contents)-1]
+		_, err := db.Exec("DELETE FROM contents WHERE id = $1", deleted.ID)
+		require.NoError(t, err)
+
+		for _, table := range contentTables[1:] {
+			var n int
+			require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM "+table+" WHERE content_id = $1", deleted.ID).Scan(&n))
+			assert.Zero(t, n, table)
+		}
+		for table, n := range before {
+			before[table] = n - 1
+		}
+		assert.Equal(t, before, countContentRows(t, db))
+	})
+
+	t.Run("new provider rows move out of the default partition", func(t *testing.T) {
+		provider := testutil.CreateTestProvider(t, db, "Partition Provider C", "json")
+		content := testutil.CreateTestContentWithScore(t, db, provider.ID, 1)
+		contents = append(contents, content)
+
+		var inDefault int
+		require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM contents_default WHERE id = $1", content.ID).Scan(&inDefault))
+		assert.Equal(t, 1, inDefault)
+
+		_, err := db.Exec("SELECT * FROM maintain_contents_partitions()")
+		require.NoError(t, err)
+
+		var inPartition int
+		require.NoError(t, db.QueryRow(fmt.Sprintf("SELECT COUNT(*) FROM contents_p%d WHERE id = $1", provider.ID), content.ID).Scan(&inPartition))
+		assert.Equal(t, 1, inPartition)
+
+		// Taşıma alt kayıtları silmez
+		var stats int
+		require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM content_stats WHERE content_id = $1", content.ID).Scan(&stats))
+		assert.Equal(t, 1, stats)
+	})
+
+	t.Run("search uses partition indexes", func(t *testing.T) {
+		tx, err := db.Begin()
+		require.NoError(t, err)
+		defer tx.Rollback()
+
+		// Küçük tablolarda planlayıcı seq scan seçmesin
+		_, err = tx.Exec("SET LOCAL enable_seqscan = off")
+		require.NoError(t, err)
+
+		provider := providers[0]
+		for _, query := range []string{
+			"SELECT id FROM contents WHERE provider_id = %d AND search_vector @@ plainto_tsquery('simple', 'test')",
+			"SELECT id FROM contents WHERE provider_id = %d AND immutable_unaccent(title) %% immutable_unaccent('Test Content')",
+		} {
+			plan := explain(t, tx, fmt.Sprintf(query, provider.ID))
+			assert.Contains(t, plan, fmt.Sprintf("contents_p%d", provider.ID))
+			assert.Contains(t, plan, "Index")
+			assert.NotContains(t, plan, "Seq Scan")
+			assert.NotContains(t, plan, "contents_default")
+		}
+	})
+
+	after := countContentRows(t, db)
+	applySQLFile(t, db, filepath.Join(migrationsDir, "partitioning", "001_partition_contents.down.sql"))
+
+	t.Run("down preserves rows and restores foreign keys", func(t *testing.T) {
+		assert.Equal(t, after, countContentRows(t, db))
+
+		var relkind string
+		require.NoError(t, db.QueryRow("SELECT relkind FROM pg_class WHERE oid = 'contents'::regclass").Scan(&relkind))
+		assert.Equal(t, "r", relkind)
+
+		_, err := db.Exec("INSERT INTO content_stats (content_id, views) VALUES (999999999, 1)")
+		requirePQCode(t, err, "23503")
+
+		_, err = db.Exec(`
+			INSERT INTO contents (provider_id, provider_content_id, title, content_type, published_at)
+			VALUES ($1, $2, 'Duplicate', 'video', NOW())
+		`, contents[0].ProviderID, contents[0].ProviderContentID)
+		requirePQCode(t, err, "23505")
+
+		// Sequence'ler yeni tablolara bağlıdır; yeni içerik mevcut id'lerle çakışmaz
+		content := testutil.CreateTestContentWithScore(t, db, providers[1].ID, 1)
+		assert.Greater(t, content.ID, contents[len(contents)-1].ID)
+	})
+}
+
+func explain(t *testing.T, tx *sql.Tx, query string) string {
+	t.Helper()
+
+	rows, err := tx.Query("EXPLAIN " + query)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var plan string
+	for rows.Next() {
+		var line string
+		require.NoError(t, rows.Scan(&line))
+		plan += line + "\n"
+	}
+	require.NoError(t, rows.Err())
+	return plan
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// postgresAdminStatsRepository PostgreSQL ile AdminStatsRepository implementasyonu
+type postgresAdminStatsRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresAdminStatsRepository yeni bir PostgreSQL yönetim paneli özeti repository oluşturur
+func NewPostgresAdminStatsRepository(db *sql.DB) port.AdminStatsRepository {
+	return &postgresAdminStatsRepository{db: db}
+}
+
+// ListProviderStats içerik sayılarını provider ve türe göre gruplar, son tamamlanan senkronizasyonları ekler
+// İçeriği olmayan provider'lar da sıfır sayılarla listelenir
+func (r *postgresAdminStatsRepository) ListProviderStats(ctx context.Context) ([]*entity.ProviderStats, error) {
+	countQuery := `
+		SELECT p.id, p.name, COALESCE(p.is_active, false), COALESCE(c.content_type, ''),
+		       COALESCE(c.active, 0), COALESCE(c.deleted, 0)
+		FROM providers p
+		LEFT JOIN (
+			SELECT provider_id, content_type,
+				COUNT(*) FILTER (WHERE COALESCE(deleted, 0) = 0) AS active,
+				COUNT(*) FILTER (WHERE COALESCE(deleted, 0) <> 0) AS deleted
+			FROM contents
+			GROUP BY provider_id, content_type
+		) c ON c.provider_id = p.id
+		ORDER BY p.id, c.content_type
+	`
+
+	rows, err := r.db.QueryContext(ctx, countQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count provider contents: %w", err)
+	}
+	defer rows.Close()
+
+	results := make([]*entity.ProviderStats, 0)
+	byID := make(map[int64]*entity.ProviderStats)
+	for rows.Next() {
+		var (
+			providerID      int64
+			name            string
+			isActive        bool
+			contentType     string
+			active, deleted int64
+		)
+		if err := rows.Scan(&providerID, &name, &isActive, &contentType, &active, &deleted); err != nil {
+			return nil, fmt.Errorf("failed to scan provider content count: %w", err)
+		}
+
+		stats, ok := byID[providerID]
+		if !ok {
+			stats = &entity.ProviderStats{
+				ProviderID:        providerID,
+				ProviderName:      name,
+				IsActive:          isActive,
+				ContentCountStats: entity.ContentCountStats{ByType: make(map[string]int64)},
+			}
+			byID[providerID] = stats
+			results = append(results, stats)
+		}
+		if contentType == "" {
+			continue
+		}
+		stats.Active += active
+		stats.Deleted += deleted
+		if active > 0 {
+			stats.ByType[contentType] = active
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadLastSyncs(ctx, byID); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// loadLastSyncs provider'ların son tamamlanan senkronizasyonlarını süreleriyle birlikte ekler
+func (r *postgresAdminStatsRepository) loadLastSyncs(ctx context.Context, byID map[int64]*entity.ProviderStats) error {
+	query := `
+		SELECT DISTINCT ON (provider_id) provider_id, status, started_at, completed_at, COALESCE(items_synced, 0)
+		FROM provider_sync_logs
+		WHERE completed_at IS NOT NULL
+		ORDER BY provider_id, completed_at DESC, id DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to list last syncs: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var providerID int64
+		last := &entity.LastSyncStats{}
+		if err := rows.Scan(&providerID, &last.Status, &last.StartedAt, &last.CompletedAt, &last.ItemsSynced); err != nil {
+			return fmt.Errorf("failed to scan last sync: %w", err)
+		}
+		last.DurationMs = last.CompletedAt.Sub(last.StartedAt).Milliseconds()
+		if stats, ok := byID[providerID]; ok {
+			stats.LastSync = last
+		}
+	}
+
+	return rows.Err()
+}
+
+// LatestContentUpdate silinenler dahil en son değişen içeriğin updated_at değerini getirir
+func (r *postgresAdminStatsRepository) LatestContentUpdate(ctx context.Context) (*time.Time, error) {
+	var latest sql.NullTime
+	if err := r.db.QueryRowContext(ctx, "SELECT MAX(updated_at) FROM contents").Scan(&latest); err != nil {
+		return nil, fmt.Errorf("failed to get latest content update: %w", err)
+	}
+	if !latest.Valid {
+		return nil, nil
+	}
+	return &latest.Time, nil
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/testutil"
+)
+
+func TestPostgresAdminStatsRepository(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	repo := NewPostgresAdminStatsRepository(db)
+	ctx := context.Background()
+
+	t.Run("no contents", func(t *testing.T) {
+		latest, err := repo.LatestContentUpdate(ctx)
+		require.NoError(t, err)
+		assert.Nil(t, latest)
+	})
+
+	provider1 := testutil.CreateTestProvider(t, db, "Provider 1", "json")
+	provider2 := testutil.CreateTestProvider(t, db, "Provider 2", "xml")
+	testutil.CreateTestContent(t, db, provider1.ID, entity.ContentTypeVideo)
+	testutil.CreateTestContent(t, db, provider1.ID, entity.ContentTypeVideo)
+	deleted := testutil.CreateTestContent(t, db, provider1.ID, entity.ContentTypeArticle)
+	_, err := db.Exec("UPDATE contents SET deleted = 1 WHERE id = $1", deleted.ID)
+	require.NoError(t, err)
+
+	started := time.Date(2024, 1, 20, 12, 0, 0, 0, time.UTC)
+	_, err = db.Exec(`INSERT INTO provider_sync_logs (provider_id, started_at, completed_at, status, items_synced)
+		VALUES ($1, $2, $3, 'success', 3), ($1, $4, NULL, 'running', 0)`,
+		provider1.ID, started, started.Add(1500*time.Millisecond), time.Now())
+	require.NoError(t, err)
+
+	t.Run("counts contents by provider and type", func(t *testing.T) {
+		stats, err := repo.ListProviderStats(ctx)
+		require.NoError(t, err)
+		require.Len(t, stats, 2)
+
+		assert.Equal(t, provider1.ID, stats[0].ProviderID)
+		assert.Equal(t, int64(2), stats[0].Active)
+		assert.Equal(t, int64(1), stats[0].Deleted)
+		assert.Equal(t, map[string]int64{"video": 2}, stats[0].ByType)
+		require.NotNil(t, stats[0].LastSync)
+		assert.Equal(t, "success", stats[0].LastSync.Status)
+		assert.Equal(t, int64(1500), stats[0].LastSync.DurationMs)
+		assert.Equal(t, int32(3), stats[0].LastSync.ItemsSynced)
+
+		// İçeriği ve tamamlanmış senkronizasyonu olmayan provider
+		assert.Equal(t, provider2.ID, stats[1].ProviderID)
+		assert.Zero(t, stats[1].Active)
+		assert.Empty(t, stats[1].ByType)
+		assert.Nil(t, stats[1].LastSync)
+	})
+
+	t.Run("latest content update", func(t *testing.T) {
+		latest, err := repo.LatestContentUpdate(ctx)
+		require.NoError(t, err)
+		assert.NotNil(t, latest)
+	})
+}
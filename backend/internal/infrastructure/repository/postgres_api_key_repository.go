@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// postgresAPIKeyRepository PostgreSQL ile APIKeyRepository implementasyonu
+type postgresAPIKeyRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresAPIKeyRepository yeni bir PostgreSQL API anahtarı repository oluşturur
+func NewPostgresAPIKeyRepository(db *sql.DB) port.APIKeyRepository {
+	return &postgresAPIKeyRepository{db: db}
+}
+
+// apiKeyColumns anahtar sorgularında okunan kolonlar (scanAPIKey ile aynı sırada)
+const apiKeyColumns = `id, name, prefix, key_hash, rate_limit_per_minute, revoked_at, created_at`
+
+// CreateAPIKey yeni bir anahtar kaydeder
+func (r *postgresAPIKeyRepository) CreateAPIKey(ctx context.Context, key *entity.APIKey) error {
+	query := `
+		INSERT INTO api_keys (name, prefix, key_hash, rate_limit_per_minute, created_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		RETURNING id, created_at
+	`
+
+	if err := r.db.QueryRowContext(ctx, query, key.Name, key.Prefix, key.KeyHash, key.RateLimitPerMinute).Scan(
+		&key.ID, &key.CreatedAt,
+	); err != nil {
+		return fmt.Errorf("failed to create api key: %w", err)
+	}
+
+	return nil
+}
+
+// FindAPIKeyByHash hash'e sahip anahtarı getirir
+func (r *postgresAPIKeyRepository) FindAPIKeyByHash(ctx context.Context, keyHash string) (*entity.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE key_hash = $1`
+
+	key, err := scanAPIKey(r.db.QueryRowContext(ctx, query, keyHash))
+	if err == sql.ErrNoRows {
+		return nil, port.ErrAPIKeyNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find api key: %w", err)
+	}
+
+	return key, nil
+}
+
+// ListAPIKeys tüm anahtarları oluşturulma sırasıyla getirir
+func (r *postgresAPIKeyRepository) ListAPIKeys(ctx context.Context) ([]*entity.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys ORDER BY id`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api keys: %w", err)
+	}
+	defer rows.Close()
+
+	keys := make([]*entity.APIKey, 0)
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan api key: %w", err)
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, rows.Err()
+}
+
+// RevokeAPIKey anahtarı iptal eder; zaten iptal edilmişse ilk iptal zamanı korunur
+func (r *postgresAPIKeyRepository) RevokeAPIKey(ctx context.Context, id int64) (*entity.APIKey, error) {
+	query := `
+		UPDATE api_keys SET revoked_at = COALESCE(revoked_at, NOW())
+		WHERE id = $1
+		RETURNING ` + apiKeyColumns
+
+	key, err := scanAPIKey(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, port.ErrAPIKeyNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke api key: %w", err)
+	}
+
+	return key, nil
+}
+
+// scanAPIKey tek bir anahtar satırını okur
+func scanAPIKey(row rowScanner) (*entity.APIKey, error) {
+	key := &entity.APIKey{}
+	var revokedAt sql.NullTime
+	if err := row.Scan(&key.ID, &key.Name, &key.Prefix, &key.KeyHash, &key.RateLimitPerMinute, &revokedAt, &key.CreatedAt); err != nil {
+		return nil, err
+	}
+	if revokedAt.Valid {
+		key.RevokedAt = &revokedAt.Time
+	}
+	return key, nil
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/testutil"
+)
+
+func TestPostgresAPIKeyRepository(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	repo := NewPostgresAPIKeyRepository(db)
+	ctx := context.Background()
+
+	key := &entity.APIKey{
+		Name:               "partner-a",
+		Prefix:             "sek_0123abcd",
+		KeyHash:            "3f2a6b1c9d8e7f60a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718",
+		RateLimitPerMinute: 600,
+	}
+
+	t.Run("create and find by hash", func(t *testing.T) {
+		require.NoError(t, repo.CreateAPIKey(ctx, key))
+		assert.NotZero(t, key.ID)
+		assert.False(t, key.CreatedAt.IsZero())
+
+		found, err := repo.FindAPIKeyByHash(ctx, key.KeyHash)
+		require.NoError(t, err)
+		assert.Equal(t, key.ID, found.ID)
+		assert.Equal(t, 600, found.RateLimitPerMinute)
+		assert.True(t, found.IsActive())
+
+		_, err = repo.FindAPIKeyByHash(ctx, "unknown")
+		assert.ErrorIs(t, err, port.ErrAPIKeyNotFound)
+	})
+
+	t.Run("revoke and list", func(t *testing.T) {
+		revoked, err := repo.RevokeAPIKey(ctx, key.ID)
+		require.NoError(t, err)
+		require.NotNil(t, revoked.RevokedAt)
+
+		again, err := repo.RevokeAPIKey(ctx, key.ID)
+		require.NoError(t, err)
+		assert.Equal(t, revoked.RevokedAt.Unix(), again.RevokedAt.Unix())
+
+		keys, err := repo.ListAPIKeys(ctx)
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		assert.False(t, keys[0].IsActive())
+
+		_, err = repo.RevokeAPIKey(ctx, key.ID+100)
+		assert.ErrorIs(t, err, port.ErrAPIKeyNotFound)
+	})
+}
@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// postgresAuthorRepository PostgreSQL ile AuthorRepository implementasyonu
+type postgresAuthorRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresAuthorRepository yeni bir PostgreSQL author repository oluşturur
+func NewPostgresAuthorRepository(db *sql.DB) port.AuthorRepository {
+	return &postgresAuthorRepository{db: db}
+}
+
+// BulkUpsertAuthors yazarları tek sorguda ekler veya günceller ve ID'lerini entity'lere yazar
+func (r *postgresAuthorRepository) BulkUpsertAuthors(ctx context.Context, authors []*entity.Author) error {
+	if len(authors) == 0 {
+		return nil
+	}
+
+	type authorKey struct {
+		providerID int64
+		externalID string
+	}
+
+	// ON CONFLICT aynı satırı iki kez güncelleyemez, bu yüzden tekilleştir
+	index := make(map[authorKey]int, len(authors))
+	var unique []*entity.Author
+	for _, a := range authors {
+		key := authorKey{a.ProviderID, a.ExternalID}
+		if i, ok := index[key]; ok {
+			unique[i] = a
+			continue
+		}
+		index[key] = len(unique)
+		unique = append(unique, a)
+	}
+
+	var (
+		providerIDs = make([]int64, len(unique))
+		externalIDs = make([]string, len(unique))
+		names       = make([]string, len(unique))
+		urls        = make([]string, len(unique))
+	)
+	for i, a := range unique {
+		providerIDs[i] = a.ProviderID
+		externalIDs[i] = a.ExternalID
+		names[i] = a.Name
+		urls[i] = a.URL
+	}
+
+	query := `
+		INSERT INTO authors (provider_id, external_id, name, url)
+		SELECT u.provider_id, u.external_id, u.name, NULLIF(u.url, '')
+		FROM unnest($1::int[], $2::text[], $3::text[], $4::text[]) AS u(provider_id, external_id, name, url)
+		ON CONFLICT (provider_id, external_id)
+		DO UPDATE SET
+			name = EXCLUDED.name,
+			url = EXCLUDED.url
+		RETURNING id, provider_id, external_id, created_at, updated_at
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query,
+		pq.Array(providerIDs),
+		pq.Array(externalIDs),
+		pq.Array(names),
+		pq.Array(urls),
+	)
+	if err != nil {
+		return fmt.Errorf("bulk author upsert failed: %w", err)
+	}
+	defer rows.Close()
+
+	// RETURNING sırası garanti değil, anahtar üzerinden eşleştir
+	for rows.Next() {
+		var (
+			key                  authorKey
+			id                   int64
+			createdAt, updatedAt time.Time
+		)
+		if err := rows.Scan(&id, &key.providerID, &key.externalID, &createdAt, &updatedAt); err != nil {
+			return fmt.Errorf("bulk author upsert scan failed: %w", err)
+		}
+		if i, ok := index[key]; ok {
+			unique[i].ID = id
+			unique[i].CreatedAt = createdAt
+			unique[i].UpdatedAt = updatedAt
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	// Tekrar eden girdiler de aynı kaydın bilgilerini alır
+	for _, a := range authors {
+		winner := unique[index[authorKey{a.ProviderID, a.ExternalID}]]
+		a.ID, a.CreatedAt, a.UpdatedAt = winner.ID, winner.CreatedAt, winner.UpdatedAt
+	}
+
+	return nil
+}
+
+// ListAuthors filtreye uyan yazarları silinmemiş içerik sayısına göre azalan sırada getirir
+func (r *postgresAuthorRepository) ListAuthors(ctx context.Context, filter port.AuthorFilter, limit, offset int) ([]*entity.Author, int64, error) {
+	var conditions []string
+	args := []interface{}{}
+	if filter.ProviderID > 0 {
+		args = append(args, filter.ProviderID)
+		conditions = append(conditions, fmt.Sprintf("a.provider_id = $%d", len(args)))
+	}
+	if filter.Query != "" {
+		args = append(args, "%"+escapeLikePattern(strings.ToLower(filter.Query))+"%")
+		conditions = append(conditions, fmt.Sprintf("LOWER(a.name) LIKE $%d", len(args)))
+	}
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var total int64
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM authors a "+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count authors: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT a.id, a.provider_id, p.name, a.external_id, a.name, COALESCE(a.url, ''),
+		       a.created_at, a.updated_at,
+		       (SELECT COUNT(*) FROM contents c WHERE c.author_id = a.id AND c.deleted = 0 AND c.status = 'approved') AS content_count
+		FROM authors a
+		JOIN providers p ON p.id = a.provider_id
+		%s
+		ORDER BY content_count DESC, a.name, a.id
+		LIMIT $%d OFFSET $%d
+	`, where, len(args)+1, len(args)+2)
+	args = append(args, limit, offset)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list authors: %w", err)
+	}
+	defer rows.Close()
+
+	var authors []*entity.Author
+	for rows.Next() {
+		a := &entity.Author{}
+		if err := rows.Scan(
+			&a.ID, &a.ProviderID, &a.ProviderName, &a.ExternalID, &a.Name, &a.URL,
+			&a.CreatedAt, &a.UpdatedAt, &a.ContentCount,
+		); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan author: %w", err)
+		}
+		authors = append(authors, a)
+	}
+
+	return authors, total, rows.Err()
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/testutil"
+)
+
+func TestPostgresAuthorRepository(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	contentRepo := NewPostgresContentRepository(db)
+	authorRepo := NewPostgresAuthorRepository(db)
+	provider := testutil.CreateTestProvider(t, db, "Test Provider", "json")
+	ctx := context.Background()
+
+	rob := &entity.Author{ProviderID: provider.ID, ExternalID: "rob", Name: "Rob Pike"}
+	russ := &entity.Author{ProviderID: provider.ID, ExternalID: "russ", Name: "Russ Cox", URL: "https://example.com/russ"}
+
+	t.Run("bulk upsert assigns stable IDs", func(t *testing.T) {
+		require.NoError(t, authorRepo.BulkUpsertAuthors(ctx, []*entity.Author{rob, russ}))
+		assert.NotZero(t, rob.ID)
+		assert.NotZero(t, russ.ID)
+
+		// Aynı anahtar tekrar geldiğinde aynı kayıt güncellenir
+		again := &entity.Author{ProviderID: provider.ID, ExternalID: "rob", Name: "Rob Pike"}
+		dup := &entity.Author{ProviderID: provider.ID, ExternalID: "rob", Name: "Rob Pike"}
+		require.NoError(t, authorRepo.BulkUpsertAuthors(ctx, []*entity.Author{again, dup}))
+		assert.Equal(t, rob.ID, again.ID)
+		assert.Equal(t, rob.ID, dup.ID)
+	})
+
+	content := &entity.Content{
+		ProviderID:        provider.ID,
+		ProviderContentID: "author-1",
+		Title:             "Go Concurrency",
+		ContentType:       entity.ContentTypeVideo,
+		PublishedAt:       time.Now(),
+		Author:            &entity.ContentAuthor{ID: rob.ID, Name: rob.Name},
+	}
+	require.NoError(t, contentRepo.Upsert(ctx, content))
+
+	t.Run("list authors with content counts", func(t *testing.T) {
+		authors, total, err := authorRepo.ListAuthors(ctx, port.AuthorFilter{}, 20, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		require.Len(t, authors, 2)
+
+		assert.Equal(t, "Rob Pike", authors[0].Name)
+		assert.Equal(t, "Test Provider", authors[0].ProviderName)
+		assert.Equal(t, int64(1), authors[0].ContentCount)
+		assert.Equal(t, "https://example.com/russ", authors[1].URL)
+		assert.Zero(t, authors[1].ContentCount)
+	})
+
+	t.Run("list authors filters by name", func(t *testing.T) {
+		authors, total, err := authorRepo.ListAuthors(ctx, port.AuthorFilter{ProviderID: provider.ID, Query: "RUSS"}, 20, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, authors, 1)
+		assert.Equal(t, russ.ID, authors[0].ID)
+	})
+
+	t.Run("search filters by author name", func(t *testing.T) {
+		found, err := contentRepo.FindByID(ctx, content.ID)
+		require.NoError(t, err)
+		require.NotNil(t, found.Author)
+		assert.Equal(t, "Rob Pike", found.Author.Name)
+
+		results, total, err := contentRepo.Search(ctx, port.SearchParams{AuthorName: "rob pike", Page: 1, PageSize: 10})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, results, 1)
+		assert.Equal(t, content.ID, results[0].ID)
+
+		_, total, err = contentRepo.Search(ctx, port.SearchParams{AuthorName: "Russ Cox", Page: 1, PageSize: 10})
+		require.NoError(t, err)
+		assert.Zero(t, total)
+	})
+}
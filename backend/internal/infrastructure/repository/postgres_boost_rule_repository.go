@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// postgresBoostRuleRepository PostgreSQL ile BoostRuleRepository implementasyonu
+type postgresBoostRuleRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresBoostRuleRepository yeni bir PostgreSQL boost kuralları repository oluşturur
+func NewPostgresBoostRuleRepository(db *sql.DB) port.BoostRuleRepository {
+	return &postgresBoostRuleRepository{db: db}
+}
+
+// ListBoostRules tüm boost kurallarını tag sırasıyla getirir
+func (r *postgresBoostRuleRepository) ListBoostRules(ctx context.Context) ([]*entity.BoostRule, error) {
+	query := `
+		SELECT id, tag, percent, created_at, updated_at
+		FROM boost_rules
+		ORDER BY tag
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list boost rules: %w", err)
+	}
+	defer rows.Close()
+
+	rules := make([]*entity.BoostRule, 0)
+	for rows.Next() {
+		rule := &entity.BoostRule{}
+		if err := rows.Scan(&rule.ID, &rule.Tag, &rule.Percent, &rule.CreatedAt, &rule.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan boost rule: %w", err)
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, rows.Err()
+}
+
+// SaveBoostRule tag için boost kuralını oluşturur veya yüzdesini günceller
+func (r *postgresBoostRuleRepository) SaveBoostRule(ctx context.Context, rule *entity.BoostRule) error {
+	query := `
+		INSERT INTO boost_rules (tag, percent, created_at, updated_at)
+		VALUES ($1, $2, NOW(), NOW())
+		ON CONFLICT (tag) DO UPDATE SET
+			percent = EXCLUDED.percent,
+			updated_at = EXCLUDED.updated_at
+		RETURNING id, created_at, updated_at
+	`
+
+	if err := r.db.QueryRowContext(ctx, query, rule.Tag, rule.Percent).Scan(
+		&rule.ID, &rule.CreatedAt, &rule.UpdatedAt,
+	); err != nil {
+		return fmt.Errorf("failed to save boost rule: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteBoostRule tag'in boost kuralını siler
+func (r *postgresBoostRuleRepository) DeleteBoostRule(ctx context.Context, tag string) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM boost_rules WHERE tag = $1", tag)
+	if err != nil {
+		return fmt.Errorf("failed to delete boost rule: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete boost rule: %w", err)
+	}
+	if affected == 0 {
+		return port.ErrBoostRuleNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/testutil"
+)
+
+func TestPostgresBoostRuleRepository(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	repo := NewPostgresBoostRuleRepository(db)
+	ctx := context.Background()
+
+	t.Run("save, update and list", func(t *testing.T) {
+		golang := &entity.BoostRule{Tag: "golang", Percent: 20}
+		require.NoError(t, repo.SaveBoostRule(ctx, golang))
+		assert.NotZero(t, golang.ID)
+
+		require.NoError(t, repo.SaveBoostRule(ctx, &entity.BoostRule{Tag: "clickbait", Percent: -50}))
+
+		updated := &entity.BoostRule{Tag: "golang", Percent: 30}
+		require.NoError(t, repo.SaveBoostRule(ctx, updated))
+		assert.Equal(t, golang.ID, updated.ID)
+
+		rules, err := repo.ListBoostRules(ctx)
+		require.NoError(t, err)
+		require.Len(t, rules, 2)
+		assert.Equal(t, "clickbait", rules[0].Tag)
+		assert.Equal(t, 30.0, rules[1].Percent)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, repo.DeleteBoostRule(ctx, "clickbait"))
+		assert.ErrorIs(t, repo.DeleteBoostRule(ctx, "clickbait"), port.ErrBoostRuleNotFound)
+	})
+}
@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// postgresCategoryRepository PostgreSQL ile CategoryRepository implementasyonu
+type postgresCategoryRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresCategoryRepository yeni bir PostgreSQL category repository oluşturur
+func NewPostgresCategoryRepository(db *sql.DB) port.CategoryRepository {
+	return &postgresCategoryRepository{db: db}
+}
+
+// BulkUpsertCategories kategorileri seviye seviye ekler veya günceller ve ID'lerini entity'lere yazar
+// parent_id üst kategorinin path'inden bulunduğu için her seviye ayrı bir sorguyla, üstten alta yazılır
+func (r *postgresCategoryRepository) BulkUpsertCategories(ctx context.Context, categories []*entity.Category) error {
+	if len(categories) == 0 {
+		return nil
+	}
+
+	// ON CONFLICT aynı satırı iki kez güncelleyemez, bu yüzden tekilleştir
+	index := make(map[string]int, len(categories))
+	var unique []*entity.Category
+	for _, c := range categories {
+		if i, ok := index[c.Path]; ok {
+			unique[i] = c
+			continue
+		}
+		index[c.Path] = len(unique)
+		unique = append(unique, c)
+	}
+
+	levels := make(map[int][]*entity.Category)
+	for _, c := range unique {
+		depth := strings.Count(c.Path, "/")
+		levels[depth] = append(levels[depth], c)
+	}
+	depths := make([]int, 0, len(levels))
+	for depth := range levels {
+		depths = append(depths, depth)
+	}
+	sort.Ints(depths)
+
+	for _, depth := range depths {
+		if err := r.upsertLevel(ctx, levels[depth], index, unique); err != nil {
+			return err
+		}
+	}
+
+	// Tekrar eden girdiler de aynı kaydın bilgilerini alır
+	for _, c := range categories {
+		winner := unique[index[c.Path]]
+		c.ID, c.ParentID, c.CreatedAt, c.UpdatedAt = winner.ID, winner.ParentID, winner.CreatedAt, winner.UpdatedAt
+	}
+
+	return nil
+}
+
+// upsertLevel aynı derinlikteki kategorileri tek sorguda yazar
+func (r *postgresCategoryRepository) upsertLevel(ctx context.Context, level []*entity.Category, index map[string]int, unique []*entity.Category) error {
+	var (
+		names       = make([]string, len(level))
+		slugs       = make([]string, len(level))
+		paths       = make([]string, len(level))
+		parentPaths = make([]string, len(level))
+	)
+	for i, c := range level {
+		names[i] = c.Name
+		slugs[i] = c.Slug
+		paths[i] = c.Path
+		if cut := strings.LastIndex(c.Path, "/"); cut >= 0 {
+			parentPaths[i] = c.Path[:cut]
+		}
+	}
+
+	query := `
+		INSERT INTO categories (parent_id, name, slug, path)
+		SELECT parent.id, u.name, u.slug, u.path
+		FROM unnest($1::text[], $2::text[], $3::text[], $4::text[]) AS u(name, slug, path, parent_path)
+		LEFT JOIN categories parent ON parent.path = u.parent_path
+		ON CONFLICT (path)
+		DO UPDATE SET
+			name = EXCLUDED.name
+		RETURNING id, parent_id, path, created_at, updated_at
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query,
+		pq.Array(names),
+		pq.Array(slugs),
+		pq.Array(paths),
+		pq.Array(parentPaths),
+	)
+	if err != nil {
+		return fmt.Errorf("bulk category upsert failed: %w", err)
+	}
+	defer rows.Close()
+
+	// RETURNING sırası garanti değil, path üzerinden eşleştir
+	for rows.Next() {
+		var (
+			id                   int64
+			parentID             sql.NullInt64
+			path                 string
+			createdAt, updatedAt time.Time
+		)
+		if err := rows.Scan(&id, &parentID, &path, &createdAt, &updatedAt); err != nil {
+			return fmt.Errorf("bulk category upsert scan failed: %w", err)
+		}
+		if i, ok := index[path]; ok {
+			unique[i].ID = id
+			unique[i].ParentID = nil
+			if parentID.Valid {
+				unique[i].ParentID = &parentID.Int64
+			}
+			unique[i].CreatedAt = createdAt
+			unique[i].UpdatedAt = updatedAt
+		}
+	}
+	return rows.Err()
+}
+
+// ListCategories tüm kategorileri path sırasıyla getirir
+// İçerik sayısı kategorinin kendisindeki ve alt kategorilerindeki silinmemiş, onaylı içeriklerin toplamıdır
+func (r *postgresCategoryRepository) ListCategories(ctx context.Context) ([]*entity.Category, error) {
+	query := `
+		SELECT cat.id, cat.parent_id, cat.name, cat.slug, cat.path, cat.created_at, cat.updated_at,
+		       (SELECT COUNT(*)
+		        FROM contents c
+		        JOIN categories sub ON sub.id = c.category_id
+		        WHERE c.deleted = 0 AND c.status = 'approved'
+		          AND (sub.path = cat.path OR left(sub.path, length(cat.path) + 1) = cat.path || '/')) AS content_count
+		FROM categories cat
+		ORDER BY cat.path
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+	defer rows.Close()
+
+	var categories []*entity.Category
+	for rows.Next() {
+		c := &entity.Category{}
+		var parentID sql.NullInt64
+		if err := rows.Scan(
+			&c.ID, &parentID, &c.Name, &c.Slug, &c.Path,
+			&c.CreatedAt, &c.UpdatedAt, &c.ContentCount,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan category: %w", err)
+		}
+		if parentID.Valid {
+			c.ParentID = &parentID.Int64
+		}
+		categories = append(categories, c)
+	}
+
+	return categories, rows.Err()
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/testutil"
+)
+
+func TestPostgresCategoryRepository(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	contentRepo := NewPostgresContentRepository(db)
+	categoryRepo := NewPostgresCategoryRepository(db)
+	provider := testutil.CreateTestProvider(t, db, "Test Provider", "json")
+	ctx := context.Background()
+
+	programming := &entity.Category{Name: "Programming", Slug: "programming", Path: "programming"}
+	golang := &entity.Category{Name: "Go", Slug: "go", Path: "programming/go"}
+	concurrency := &entity.Category{Name: "Concurrency", Slug: "concurrency", Path: "programming/go/concurrency"}
+
+	t.Run("bulk upsert links parents", func(t *testing.T) {
+		// Alt kategori önce gelse de üst kategoriler önce yazılır
+		require.NoError(t, categoryRepo.BulkUpsertCategories(ctx, []*entity.Category{concurrency, golang, programming}))
+		assert.NotZero(t, programming.ID)
+		assert.Nil(t, programming.ParentID)
+		require.NotNil(t, golang.ParentID)
+		assert.Equal(t, programming.ID, *golang.ParentID)
+		require.NotNil(t, concurrency.ParentID)
+		assert.Equal(t, golang.ID, *concurrency.ParentID)
+
+		again := &entity.Category{Name: "Go", Slug: "go", Path: "programming/go"}
+		require.NoError(t, categoryRepo.BulkUpsertCategories(ctx, []*entity.Category{again}))
+		assert.Equal(t, golang.ID, again.ID)
+	})
+
+	newContent := func(id string, category *entity.Category) *entity.Content {
+		return &entity.Content{
+			ProviderID:        provider.ID,
+			ProviderContentID: id,
+			Title:             "Category " + id,
+			ContentType:       entity.ContentTypeArticle,
+			PublishedAt:       time.Now(),
+			Category:          &entity.ContentCategory{ID: category.ID, Name: category.Name, Path: category.Path},
+		}
+	}
+	leaf := newContent("cat-1", concurrency)
+	middle := newContent("cat-2", golang)
+	require.NoError(t, contentRepo.Upsert(ctx, leaf))
+	require.NoError(t, contentRepo.Upsert(ctx, middle))
+
+	t.Run("list categories with descendant counts", func(t *testing.T) {
+		categories, err := categoryRepo.ListCategories(ctx)
+		require.NoError(t, err)
+		require.Len(t, categories, 3)
+		assert.Equal(t, "programming", categories[0].Path)
+		assert.Equal(t, int64(2), categories[0].ContentCount)
+		assert.Equal(t, int64(2), categories[1].ContentCount)
+		assert.Equal(t, int64(1), categories[2].ContentCount)
+	})
+
+	t.Run("search filter includes descendants", func(t *testing.T) {
+		found, err := contentRepo.FindByID(ctx, leaf.ID)
+		require.NoError(t, err)
+		require.NotNil(t, found.Category)
+		assert.Equal(t, "programming/go/concurrency", found.Category.Path)
+
+		_, total, err := contentRepo.Search(ctx, port.SearchParams{Category: "programming/go", Page: 1, PageSize: 10})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+
+		_, total, err = contentRepo.Search(ctx, port.SearchParams{Category: "programming/go/concurrency", Page: 1, PageSize: 10})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+
+		// Önek eşleşmesi seviye sınırına uyar ("programming/g" -> "programming/go" değil)
+		_, total, err = contentRepo.Search(ctx, port.SearchParams{Category: "programming/g", Page: 1, PageSize: 10})
+		require.NoError(t, err)
+		assert.Zero(t, total)
+	})
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// postgresContentAuditRepository PostgreSQL ile ContentAuditRepository implementasyonu
+// Kayıtlar content_audit trigger'larıyla yazılır (bkz. 022_create_content_audit)
+type postgresContentAuditRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresContentAuditRepository yeni bir PostgreSQL içerik audit repository oluşturur
+func NewPostgresContentAuditRepository(db *sql.DB) port.ContentAuditRepository {
+	return &postgresContentAuditRepository{db: db}
+}
+
+// ListContentAudit içeriğin değişiklik kayıtlarını en yeniden eskiye sayfalı getirir
+func (r *postgresContentAuditRepository) ListContentAudit(ctx context.Context, contentID int64, limit, offset int) ([]*entity.ContentAuditEntry, int64, error) {
+	var total int64
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM content_audit WHERE content_id = $1", contentID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count content audit entries: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, content_id, table_name, operation, changes,
+		       COALESCE(actor, ''), COALESCE(sync_job_id, ''), changed_at
+		FROM content_audit
+		WHERE content_id = $1
+		ORDER BY id DESC
+		LIMIT $2 OFFSET $3
+	`, contentID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list content audit entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*entity.ContentAuditEntry
+	for rows.Next() {
+		e := &entity.ContentAuditEntry{}
+		var changes []byte
+		if err := rows.Scan(&e.ID, &e.ContentID, &e.Table, &e.Operation, &changes, &e.Actor, &e.SyncJobID, &e.ChangedAt); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan content audit entry: %w", err)
+		}
+		if err := json.Unmarshal(changes, &e.Changes); err != nil {
+			return nil, 0, fmt.Errorf("failed to decode content audit changes: %w", err)
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, total, rows.Err()
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/testutil"
+)
+
+func TestPostgresContentAuditRepository(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	contentRepo := NewPostgresContentRepository(db)
+	auditRepo := NewPostgresContentAuditRepository(db)
+	transactor := NewPostgresTransactor(db)
+	provider := testutil.CreateTestProvider(t, db, "Test Provider", "json")
+
+	ctx := port.WithAuditInfo(context.Background(), port.AuditInfo{Actor: port.AuditActorSync, SyncJobID: "job-1"})
+	content := &entity.Content{
+		ProviderID:        provider.ID,
+		ProviderContentID: "audit-1",
+		Title:             "Original Title",
+		ContentType:       entity.ContentTypeArticle,
+		PublishedAt:       time.Now(),
+	}
+
+	t.Run("records changes with actor and sync job", func(t *testing.T) {
+		err := transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			if err := contentRepo.Upsert(ctx, content); err != nil {
+				return err
+			}
+			if err := contentRepo.CreateOrUpdateStats(ctx, &entity.ContentStats{ContentID: content.ID, Views: 100}); err != nil {
+				return err
+			}
+			return contentRepo.AddTags(ctx, content.ID, []string{"golang"})
+		})
+		require.NoError(t, err)
+
+		entries, total, err := auditRepo.ListContentAudit(context.Background(), content.ID, 20, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		require.Len(t, entries, 3)
+
+		// En yeniden eskiye
+		assert.Equal(t, "content_tags", entries[0].Table)
+		assert.JSONEq(t, `"golang"`, string(entries[0].Changes["tag"].New))
+		assert.Equal(t, "content_stats", entries[1].Table)
+		assert.Equal(t, "contents", entries[2].Table)
+		assert.Equal(t, "insert", entries[2].Operation)
+		assert.JSONEq(t, `"Original Title"`, string(entries[2].Changes["title"].New))
+		for _, e := range entries {
+			assert.Equal(t, port.AuditActorSync, e.Actor)
+			assert.Equal(t, "job-1", e.SyncJobID)
+		}
+	})
+
+	t.Run("records only changed columns and skips unchanged upserts", func(t *testing.T) {
+		content.Title = "Updated Title"
+		require.NoError(t, transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			return contentRepo.Upsert(ctx, content)
+		}))
+		require.NoError(t, transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			return contentRepo.Upsert(ctx, content)
+		}))
+
+		entries, total, err := auditRepo.ListContentAudit(context.Background(), content.ID, 1, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), total)
+		require.Len(t, entries, 1)
+		assert.Equal(t, "update", entries[0].Operation)
+		require.Len(t, entries[0].Changes, 1)
+		assert.JSONEq(t, `"Original Title"`, string(entries[0].Changes["title"].Old))
+		assert.JSONEq(t, `"Updated Title"`, string(entries[0].Changes["title"].New))
+	})
+
+	t.Run("soft delete is recorded as delete without actor outside transactions", func(t *testing.T) {
+		require.NoError(t, contentRepo.MarkContentDeleted(context.Background(), provider.ID, "audit-1"))
+
+		entries, _, err := auditRepo.ListContentAudit(context.Background(), content.ID, 1, 0)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, "delete", entries[0].Operation)
+		assert.Empty(t, entries[0].Actor)
+
+		var deleted int
+		require.NoError(t, json.Unmarshal(entries[0].Changes["deleted"].New, &deleted))
+		assert.Equal(t, 1, deleted)
+	})
+
+	t.Run("unknown content has no history", func(t *testing.T) {
+		entries, total, err := auditRepo.ListContentAudit(context.Background(), 1<<30, 20, 0)
+		require.NoError(t, err)
+		assert.Zero(t, total)
+		assert.Empty(t, entries)
+	})
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// postgresContentModerationRepository PostgreSQL ile ContentModerationRepository implementasyonu
+type postgresContentModerationRepository struct {
+	db       *sql.DB
+	contents *postgresContentRepository // Kuyruktaki içeriklerin tag'lerini yüklemek için
+}
+
+// NewPostgresContentModerationRepository yeni bir PostgreSQL içerik moderasyon repository oluşturur
+func NewPostgresContentModerationRepository(db *sql.DB) port.ContentModerationRepository {
+	return &postgresContentModerationRepository{
+		db:       db,
+		contents: &postgresContentRepository{db: db},
+	}
+}
+
+// ListContentsByStatus filtreye uyan silinmemiş içerikleri ekleniş sırasıyla (önce en eski) sayfalı getirir
+func (r *postgresContentModerationRepository) ListContentsByStatus(
+	ctx context.Context,
+	filter port.ContentModerationFilter,
+	limit, offset int,
+) ([]*entity.Content, int64, error) {
+	where := " WHERE c.deleted = 0 AND c.status = $1"
+	args := []interface{}{filter.Status}
+	if filter.ProviderID > 0 {
+		args = append(args, filter.ProviderID)
+		where += fmt.Sprintf(" AND c.provider_id = $%d", len(args))
+	}
+
+	var total int64
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM contents c"+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count contents by status: %w", err)
+	}
+
+	args = append(args, limit, offset)
+	query := fmt.Sprintf(`
+		SELECT %s,
+			0.0 as relevance_score
+		FROM contents c
+		JOIN providers p ON c.provider_id = p.id
+		LEFT JOIN authors a ON c.author_id = a.id
+		LEFT JOIN categories cat ON c.category_id = cat.id
+		LEFT JOIN content_stats cs ON c.id = cs.content_id
+		LEFT JOIN content_scores csc ON c.id = csc.content_id
+		%s
+		ORDER BY c.created_at, c.id
+		LIMIT $%d OFFSET $%d
+	`, contentListColumns, where, len(args)-1, len(args))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list contents by status: %w", err)
+	}
+	defer rows.Close()
+
+	var contents []*entity.Content
+	for rows.Next() {
+		content, err := scanContentRow(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan content: %w", err)
+		}
+		contents = append(contents, content)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	if err := r.contents.loadTagsForContents(ctx, contents); err != nil {
+		return nil, 0, fmt.Errorf("failed to load tags: %w", err)
+	}
+
+	return contents, total, nil
+}
+
+// UpdateContentStatus içeriklerin moderasyon durumunu değiştirir
+// Silinmiş veya bulunamayan içerikler atlanır; güncellenen içerik sayısı döner
+func (r *postgresContentModerationRepository) UpdateContentStatus(ctx context.Context, contentIDs []int64, status entity.ContentStatus) (int64, error) {
+	if len(contentIDs) == 0 {
+		return 0, nil
+	}
+
+	result, err := conn(ctx, r.db).ExecContext(ctx,
+		"UPDATE contents SET status = $1, updated_at = CURRENT_TIMESTAMP WHERE id = ANY($2) AND deleted = 0",
+		status, pq.Array(contentIDs),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update content status: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to update content status: %w", err)
+	}
+	return affected, nil
+}
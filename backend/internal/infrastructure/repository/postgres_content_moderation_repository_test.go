@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/testutil"
+)
+
+func TestPostgresContentModerationRepository(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	contentRepo := NewPostgresContentRepository(db)
+	moderationRepo := NewPostgresContentModerationRepository(db)
+	provider := testutil.CreateTestProvider(t, db, "Untrusted Provider", "json")
+	ctx := context.Background()
+
+	pending := &entity.Content{
+		ProviderID:        provider.ID,
+		ProviderContentID: "pending-1",
+		Title:             "Golang moderation queue",
+		ContentType:       entity.ContentTypeArticle,
+		PublishedAt:       time.Now(),
+		Status:            entity.ContentStatusPending,
+	}
+	approved := &entity.Content{
+		ProviderID:        provider.ID,
+		ProviderContentID: "approved-1",
+		Title:             "Golang approved article",
+		ContentType:       entity.ContentTypeArticle,
+		PublishedAt:       time.Now(),
+	}
+	require.NoError(t, contentRepo.BulkUpsert(ctx, []*entity.Content{pending, approved}))
+	assert.Equal(t, entity.ContentStatusPending, pending.Status)
+	assert.Equal(t, entity.ContentStatusApproved, approved.Status)
+
+	t.Run("pending contents are hidden from search", func(t *testing.T) {
+		results, total, err := contentRepo.Search(ctx, port.SearchParams{Query: "golang", Page: 1, PageSize: 10})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, results, 1)
+		assert.Equal(t, approved.ID, results[0].ID)
+	})
+
+	t.Run("lists contents by status", func(t *testing.T) {
+		contents, total, err := moderationRepo.ListContentsByStatus(ctx, port.ContentModerationFilter{Status: entity.ContentStatusPending}, 20, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, contents, 1)
+		assert.Equal(t, pending.ID, contents[0].ID)
+		assert.Equal(t, entity.ContentStatusPending, contents[0].Status)
+
+		contents, total, err = moderationRepo.ListContentsByStatus(ctx, port.ContentModerationFilter{Status: entity.ContentStatusPending, ProviderID: provider.ID + 1}, 20, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), total)
+		assert.Empty(t, contents)
+	})
+
+	t.Run("upsert keeps the moderation decision", func(t *testing.T) {
+		again := &entity.Content{
+			ProviderID:        provider.ID,
+			ProviderContentID: "approved-1",
+			Title:             "Golang approved article (updated)",
+			ContentType:       entity.ContentTypeArticle,
+			PublishedAt:       time.Now(),
+			Status:            entity.ContentStatusPending,
+		}
+		require.NoError(t, contentRepo.Upsert(ctx, again))
+		assert.Equal(t, entity.ContentStatusApproved, again.Status)
+	})
+
+	t.Run("approving makes content searchable", func(t *testing.T) {
+		updated, err := moderationRepo.UpdateContentStatus(ctx, []int64{pending.ID, 999999}, entity.ContentStatusApproved)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), updated)
+
+		_, total, err := contentRepo.Search(ctx, port.SearchParams{Query: "golang", Page: 1, PageSize: 10})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+	})
+
+	t.Run("rejected content is excluded from search", func(t *testing.T) {
+		_, err := moderationRepo.UpdateContentStatus(ctx, []int64{pending.ID}, entity.ContentStatusRejected)
+		require.NoError(t, err)
+
+		_, total, err := contentRepo.Search(ctx, port.SearchParams{Query: "golang", Page: 1, PageSize: 10})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+
+		content, err := contentRepo.FindByID(ctx, pending.ID)
+		require.NoError(t, err)
+		assert.False(t, content.IsApproved())
+	})
+}
@@ -4,33 +4,80 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"log"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
 
 	"github.com/onurerdog4n/search-engine/internal/domain/entity"
 	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/logger"
 )
 
 // postgresContentRepository PostgreSQL ile ContentRepository implementasyonu
 type postgresContentRepository struct {
-	db *sql.DB
+	db            *sql.DB
+	replica       *readReplica  // nil ise tüm okumalar db'den yapılır
+	countStrategy string        // Search toplamının nasıl sayılacağı (CountStrategySeparate veya CountStrategyWindow)
+	slowQuery     time.Duration // Bu süreyi aşan sorgular log'lanır, 0 ise kapalı
+}
+
+// Search toplam sayım stratejileri
+const (
+	// CountStrategySeparate toplamı ana sorgudan önce ayrı bir COUNT(*) sorgusuyla sayar
+	CountStrategySeparate = "separate"
+	// CountStrategyWindow toplamı ana sorguda COUNT(*) OVER () ile alır (tek round-trip)
+	CountStrategyWindow = "window"
+)
+
+// PostgresContentRepositoryOptions PostgreSQL content repository ayarları
+type PostgresContentRepositoryOptions struct {
+	CountStrategy string // Boş ise CountStrategySeparate
+
+	// ReadReplica verilirse Search, GetFacets, FindSimilar ve FindByID bu havuzdan okunur;
+	// replikaya ulaşılamazsa okumalar bir süre primary'den yapılır. Yazmalar her zaman primary'dedir
+	ReadReplica *sql.DB
+
+	// SlowQueryThreshold sıfırdan büyükse bu süreyi aşan sorgular SQL ve parametreleriyle log'lanır
+	SlowQueryThreshold time.Duration
 }
 
 // NewPostgresContentRepository yeni bir PostgreSQL content repository oluşturur
 func NewPostgresContentRepository(db *sql.DB) port.ContentRepository {
-	return &postgresContentRepository{db: db}
+	return NewPostgresContentRepositoryWithOptions(db, PostgresContentRepositoryOptions{})
+}
+
+// NewPostgresContentRepositoryWithOptions verilen ayarlarla yeni bir PostgreSQL content repository oluşturur
+func NewPostgresContentRepositoryWithOptions(db *sql.DB, opts PostgresContentRepositoryOptions) port.ContentRepository {
+	if opts.CountStrategy == "" {
+		opts.CountStrategy = CountStrategySeparate
+	}
+	r := &postgresContentRepository{db: db, countStrategy: opts.CountStrategy, slowQuery: opts.SlowQueryThreshold}
+	if opts.ReadReplica != nil {
+		r.replica = newReadReplica(opts.ReadReplica)
+	}
+	return r
+}
+
+// conn context'teki transaction'ı veya repository'nin havuzunu slow query log'u ile sarılı döner
+func (r *postgresContentRepository) conn(ctx context.Context) queryer {
+	return withSlowQueryLog(conn(ctx, r.db), "content", r.slowQuery)
 }
 
 // Create yeni bir içerik oluşturur
 func (r *postgresContentRepository) Create(ctx context.Context, content *entity.Content) error {
 	query := `
-		INSERT INTO contents (provider_id, provider_content_id, title, description, content_type, published_at, raw_data)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO contents (provider_id, provider_content_id, title, description, content_type, published_at, raw_data, language, author_id, url, thumbnail_url, category_id, status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 		RETURNING id, created_at, updated_at
 	`
 
-	err := r.db.QueryRowContext(
+	err := r.conn(ctx).QueryRowContext(
 		ctx, query,
 		content.ProviderID,
 		content.ProviderContentID,
@@ -39,7 +86,16 @@ func (r *postgresContentRepository) Create(ctx context.Context, content *entity.
 		content.ContentType,
 		content.PublishedAt,
 		content.RawData,
+		languageOrDefault(content.Language),
+		authorIDOf(content),
+		content.URL,
+		content.ThumbnailURL,
+		categoryIDOf(content),
+		statusOrDefault(content.Status),
 	).Scan(&content.ID, &content.CreatedAt, &content.UpdatedAt)
+	if err == nil {
+		content.Status = statusOrDefault(content.Status)
+	}
 
 	return err
 }
@@ -48,69 +104,103 @@ func (r *postgresContentRepository) Create(ctx context.Context, content *entity.
 func (r *postgresContentRepository) Update(ctx context.Context, content *entity.Content) error {
 	query := `
 		UPDATE contents
-		SET title = $1, description = $2, content_type = $3, published_at = $4, raw_data = $5
-		WHERE id = $6
+		SET title = $1, description = $2, content_type = $3, published_at = $4, raw_data = $5, language = $6, author_id = $7,
+			url = $8, thumbnail_url = $9, category_id = $10
+		WHERE id = $11
 		RETURNING updated_at
 	`
 
-	err := r.db.QueryRowContext(
+	err := r.conn(ctx).QueryRowContext(
 		ctx, query,
 		content.Title,
 		content.Description,
 		content.ContentType,
 		content.PublishedAt,
 		content.RawData,
+		languageOrDefault(content.Language),
+		authorIDOf(content),
+		content.URL,
+		content.ThumbnailURL,
+		categoryIDOf(content),
 		content.ID,
 	).Scan(&content.UpdatedAt)
 
 	return err
 }
 
-// FindByID ID'ye göre içerik getirir
+// FindByID ID'ye göre içerik getirir (read replica varsa oradan)
 func (r *postgresContentRepository) FindByID(ctx context.Context, id int64) (*entity.Content, error) {
+	var content *entity.Content
+	err := r.read(ctx, func(reader *postgresContentRepository) error {
+		var err error
+		content, err = reader.findByID(ctx, id)
+		return err
+	})
+	return content, err
+}
+
+// findByID FindByID'nin verilen bağlantı havuzunda çalışan gövdesi
+func (r *postgresContentRepository) findByID(ctx context.Context, id int64) (*entity.Content, error) {
 	query := `
 		SELECT 
 			c.id, c.provider_id, c.provider_content_id, c.title, c.description,
 			c.content_type, c.published_at, c.created_at, c.updated_at, c.raw_data,
-			cs.id, cs.views, cs.likes, cs.reading_time, cs.reactions, cs.updated_at,
+			p.name, p.format,
+			cs.id, cs.views, cs.likes, cs.reading_time, cs.reactions, cs.duration_seconds, cs.comments, cs.updated_at,
 			csc.id, csc.base_score, csc.type_weight, csc.recency_score, 
-			csc.engagement_score, csc.final_score, csc.calculated_at
+			csc.engagement_score, csc.final_score, csc.calculated_at, csc.ctr_score, csc.ctr_lift,
+			c.canonical_content_id, c.language, c.url, c.thumbnail_url, c.status,
+			a.id, a.name, a.url,
+			cat.id, cat.name, cat.path
 		FROM contents c
+		JOIN providers p ON c.provider_id = p.id
+		LEFT JOIN authors a ON c.author_id = a.id
+		LEFT JOIN categories cat ON c.category_id = cat.id
 		LEFT JOIN content_stats cs ON c.id = cs.content_id
 		LEFT JOIN content_scores csc ON c.id = csc.content_id
 		WHERE c.id = $1 AND c.deleted = 0
 	`
 
 	content := &entity.Content{
-		Stats: &entity.ContentStats{},
-		Score: &entity.ContentScore{},
+		Provider: &entity.ContentProvider{},
+		Stats:    &entity.ContentStats{},
+		Score:    &entity.ContentScore{},
 	}
 
 	var statsID, scoreID sql.NullInt64
 	var statsUpdatedAt, scoreCalculatedAt sql.NullTime
 	var rawData sql.NullString
-	
+	var canonicalID sql.NullInt64
+	var author nullableAuthor
+	var category nullableCategory
+
 	// Stats fields - can be NULL
 	var views sql.NullInt64
 	var likes sql.NullInt32
 	var readingTime sql.NullInt32
 	var reactions sql.NullInt32
-	
+	var durationSeconds sql.NullInt32
+	var comments sql.NullInt32
+
 	// Score fields - can be NULL
-	var baseScore, typeWeight, recencyScore, engagementScore, finalScore sql.NullFloat64
+	var baseScore, typeWeight, recencyScore, engagementScore, finalScore, ctrScore, ctrLift sql.NullFloat64
 
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	err := r.conn(ctx).QueryRowContext(ctx, query, id).Scan(
 		&content.ID, &content.ProviderID, &content.ProviderContentID,
 		&content.Title, &content.Description, &content.ContentType,
 		&content.PublishedAt, &content.CreatedAt, &content.UpdatedAt, &rawData,
-		&statsID, &views, &likes, &readingTime, &reactions, &statsUpdatedAt,
+		&content.Provider.Name, &content.Provider.Format,
+		&statsID, &views, &likes, &readingTime, &reactions, &durationSeconds, &comments, &statsUpdatedAt,
 		&scoreID, &baseScore, &typeWeight, &recencyScore, &engagementScore,
-		&finalScore, &scoreCalculatedAt,
+		&finalScore, &scoreCalculatedAt, &ctrScore, &ctrLift,
+		&canonicalID, &content.Language, &content.URL, &content.ThumbnailURL, &content.Status,
+		&author.id, &author.name, &author.url,
+		&category.id, &category.name, &category.path,
 	)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("content with id %d not found", id)
+			return nil, port.ErrContentNotFound
 		}
 		return nil, fmt.Errorf("failed to find content: %w", err)
 	}
@@ -119,6 +209,12 @@ func (r *postgresContentRepository) FindByID(ctx context.Context, id int64) (*en
 	if rawData.Valid {
 		content.RawData = rawData.String
 	}
+	if canonicalID.Valid {
+		content.CanonicalContentID = &canonicalID.Int64
+	}
+	content.Provider.ID = content.ProviderID
+	content.Author = author.toEntity()
+	content.Category = category.toEntity()
 
 	// Handle stats - only set if exists
 	if statsID.Valid {
@@ -128,6 +224,8 @@ func (r *postgresContentRepository) FindByID(ctx context.Context, id int64) (*en
 		content.Stats.Likes = int32(likes.Int32)
 		content.Stats.ReadingTime = int32(readingTime.Int32)
 		content.Stats.Reactions = int32(reactions.Int32)
+		content.Stats.DurationSeconds = int32(durationSeconds.Int32)
+		content.Stats.Comments = int32(comments.Int32)
 		if statsUpdatedAt.Valid {
 			content.Stats.UpdatedAt = statsUpdatedAt.Time
 		}
@@ -144,6 +242,8 @@ func (r *postgresContentRepository) FindByID(ctx context.Context, id int64) (*en
 		content.Score.RecencyScore = recencyScore.Float64
 		content.Score.EngagementScore = engagementScore.Float64
 		content.Score.FinalScore = finalScore.Float64
+		content.Score.CTRScore = ctrScore.Float64
+		content.Score.CTRLift = ctrLift.Float64
 		if scoreCalculatedAt.Valid {
 			content.Score.CalculatedAt = scoreCalculatedAt.Time
 		}
@@ -163,8 +263,8 @@ func (r *postgresContentRepository) FindByID(ctx context.Context, id int64) (*en
 // Upsert içerik varsa günceller, yoksa ekler
 func (r *postgresContentRepository) Upsert(ctx context.Context, content *entity.Content) error {
 	query := `
-		INSERT INTO contents (provider_id, provider_content_id, title, description, content_type, published_at, raw_data, deleted)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, 0)
+		INSERT INTO contents (provider_id, provider_content_id, title, description, content_type, published_at, raw_data, language, author_id, url, thumbnail_url, category_id, status, deleted)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, 0)
 		ON CONFLICT (provider_id, provider_content_id)
 		DO UPDATE SET
 			title = EXCLUDED.title,
@@ -172,11 +272,16 @@ func (r *postgresContentRepository) Upsert(ctx context.Context, content *entity.
 			content_type = EXCLUDED.content_type,
 			published_at = EXCLUDED.published_at,
 			raw_data = EXCLUDED.raw_data,
+			language = EXCLUDED.language,
+			author_id = EXCLUDED.author_id,
+			url = EXCLUDED.url,
+			thumbnail_url = EXCLUDED.thumbnail_url,
+			category_id = EXCLUDED.category_id,
 			deleted = 0
-		RETURNING id, created_at, updated_at
+		RETURNING id, status, created_at, updated_at
 	`
 
-	err := r.db.QueryRowContext(
+	err := r.conn(ctx).QueryRowContext(
 		ctx, query,
 		content.ProviderID,
 		content.ProviderContentID,
@@ -185,127 +290,555 @@ func (r *postgresContentRepository) Upsert(ctx context.Context, content *entity.
 		content.ContentType,
 		content.PublishedAt,
 		content.RawData,
-	).Scan(&content.ID, &content.CreatedAt, &content.UpdatedAt)
+		languageOrDefault(content.Language),
+		authorIDOf(content),
+		content.URL,
+		content.ThumbnailURL,
+		categoryIDOf(content),
+		statusOrDefault(content.Status),
+	).Scan(&content.ID, &content.Status, &content.CreatedAt, &content.UpdatedAt)
 
 	return err
 }
 
-// Search arama parametrelerine göre içerikleri getirir
-func (r *postgresContentRepository) Search(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
-	// Arama kısmını oluştur (FROM + JOIN'ler)
-	fromParts := `
-		FROM contents c
-		LEFT JOIN content_stats cs ON c.id = cs.content_id
-		LEFT JOIN content_scores csc ON c.id = csc.content_id
-		WHERE c.deleted = 0
-	`
+// statusOrDefault içeriğin moderasyon durumunu döner; durum verilmemişse içerik onaylı kaydedilir
+// Upsert'lerde durum sadece yeni içeriklere uygulanır, mevcut içeriğin moderasyon kararı korunur
+func statusOrDefault(status entity.ContentStatus) entity.ContentStatus {
+	if status == "" {
+		return entity.ContentStatusApproved
+	}
+	return status
+}
 
-	// FTS (Full-Text Search) için vector ve query tanımla
-	var searchVector string
-	var searchQuery string
-
-	// Başlık (A) ve Tagler (B) ağırlıklı vector oluştur
-	searchVector = `(
-		setweight(to_tsvector('english', COALESCE(c.title, '')), 'A') ||
-		setweight(to_tsvector('english', COALESCE((
-			SELECT string_agg(t.name, ' ') 
-			FROM content_tags ct 
-			JOIN tags t ON ct.tag_id = t.id 
-			WHERE ct.content_id = c.id
-		), '')), 'B')
-	)`
+// authorIDOf içeriğin yazar ID'sini döner; yazarı olmayan içerikler için NULL
+func authorIDOf(content *entity.Content) sql.NullInt64 {
+	if content.Author == nil || content.Author.ID == 0 {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: content.Author.ID, Valid: true}
+}
 
-	var args []interface{}
-	argCount := 0
+// categoryIDOf içeriğin kategori ID'sini döner; kategorisi olmayan içerikler için NULL
+func categoryIDOf(content *entity.Content) sql.NullInt64 {
+	if content.Category == nil || content.Category.ID == 0 {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: content.Category.ID, Valid: true}
+}
 
-	// Arama sorgusunu FTS formatına getir (Prefix matching için :* ekle)
-	whereClause := ""
-	if params.Query != "" {
-		argCount++
+// ftsQuery $1'deki sorgu için tsquery ifadesini döner
+// Vektörler her içeriğin kendi diliyle oluşturulduğundan sorgu da aynı dille işlenmelidir. Dil verilmemişse
+// her desteklenen dilin tsquery'si OR (||) ile birleştirilir; ifade satırdan bağımsız olduğu için GIN indeksi kullanılır.
+// Vektörler gibi sorgunun da aksanları kaldırılır (bkz. 021_enable_unaccent)
+func ftsQuery(language string) string {
+	languages := entity.SupportedLanguages
+	if language != "" {
+		languages = []string{language}
+	}
+
+	parts := make([]string, len(languages))
+	for i, l := range languages {
+		parts[i] = fmt.Sprintf("to_tsquery(%s, immutable_unaccent($1))", pq.QuoteLiteral(l))
+	}
+	return "(" + strings.Join(parts, " || ") + ")"
+}
+
+// languageOrDefault dili belirlenmemiş içerikler için varsayılan dili (english) döner
+func languageOrDefault(language string) string {
+	if language == "" {
+		return entity.LanguageEnglish
+	}
+	return language
+}
+
+// searchFilter Search ve GetFacets tarafından paylaşılan WHERE koşullarını tutar
+type searchFilter struct {
+	vector    string        // Başlık ve tag'lerden oluşan ağırlıklı tsvector kolonu
+	where     string        // " AND ..." şeklinde eklenecek koşullar
+	args      []interface{} // Koşullara ait sorgu parametreleri
+	query     string        // Temizlenmiş arama sorgusu (boş ise metin araması yok)
+	relevance string        // Alakalılık skorunu hesaplayan SQL ifadesi
+
+	// similarityThreshold sıfırdan büyükse where'deki % operatörü için pg_trgm.similarity_threshold
+	// bu değere ayarlanmalıdır (bkz. withSimilarityThreshold)
+	similarityThreshold float64
+}
+
+// buildSearchFilter arama parametrelerinden ortak WHERE koşullarını oluşturur
+func buildSearchFilter(params port.SearchParams) searchFilter {
+	// Başlık (A) ve Tagler (B) ağırlıklı vector contents.search_vector kolonunda saklanır
+	// (trigger'larla güncellenir, GIN indeksli; bkz. 019_add_search_vector)
+	f := searchFilter{
+		vector:    "c.search_vector",
+		relevance: "0.0",
+	}
+
+	if isShortQuery(params.Query) {
+		// Kısa sorgu: "go:*" gibi prefix tsquery çok sayıda terime açılıp yavaşlar ve trigram benzerliği
+		// 1-2 karakterde anlamsızdır. Bunun yerine başlıkta kelime başı eşleşmesi (~*, pg_trgm GIN
+		// indeksini kullanabilir) veya tag prefix'i aranır; sıralama için başlık benzerliği kullanılır.
+		// Eşleşmeler aksan duyarsızdır ("tu" sorgusu "Türkiye" ile eşleşir)
+		f.query = strings.ToLower(strings.TrimSpace(params.Query))
+		f.args = append(f.args, f.query, `\m`+regexp.QuoteMeta(f.query), escapeLikePattern(f.query)+"%")
+		f.where += ` AND (immutable_unaccent(c.title) ~* immutable_unaccent($2) OR EXISTS (
+			SELECT 1 FROM content_tags cts
+			JOIN tags ts ON cts.tag_id = ts.id
+			WHERE cts.content_id = c.id AND immutable_unaccent(ts.name) LIKE immutable_unaccent($3)
+		))`
+		f.relevance = unaccentedTitleSimilarity
+	} else if params.FuzzyThreshold > 0 && strings.TrimSpace(params.Query) != "" {
+		// Fuzzy mod: tsquery yerine aksansız başlık üzerinde trigram benzerliği (pg_trgm)
+		// similarity() >= eşik karşılaştırması indeks kullanamaz; % operatörü idx_contents_title_unaccent_trgm
+		// GIN indeksini kullanır ve eşiği pg_trgm.similarity_threshold ayarından okur
+		f.query = strings.ToLower(strings.TrimSpace(params.Query))
+		f.args = append(f.args, f.query)
+		f.where += " AND immutable_unaccent(c.title) % immutable_unaccent($1)"
+		f.relevance = unaccentedTitleSimilarity
+		f.similarityThreshold = params.FuzzyThreshold
+	} else if params.Query != "" {
+		// Arama sorgusunu FTS formatına getir (Prefix matching için :* ekle)
 		// Özel karakterleri temizle (syntax hatasını önlemek için)
+		// Harfler her dilde korunur (ç, ğ, ş, ü, é...)
 		cleaner := func(r rune) rune {
-			if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
 				return r
 			}
 			return -1
 		}
 
-		words := strings.Fields(params.Query)
 		var ftsWords []string
-		for _, w := range words {
+		for _, w := range strings.Fields(params.Query) {
 			cleanWord := strings.Map(cleaner, w)
 			if cleanWord != "" {
 				ftsWords = append(ftsWords, cleanWord+":*")
 			}
 		}
 
+		// Tüm kelimeler temizlendiyse metin araması yapılmaz
 		if len(ftsWords) > 0 {
-			searchQuery = strings.Join(ftsWords, " & ")
-			args = append(args, searchQuery)
-			whereClause += fmt.Sprintf(" AND %s @@ to_tsquery('english', $%d)", searchVector, argCount)
-		} else {
-			// Eğer tüm kelimeler temizlendiyse query'yi boşalt
-			params.Query = ""
-			argCount--
+			f.query = strings.Join(ftsWords, " & ")
+			f.args = append(f.args, f.query)
+			tsquery := ftsQuery(params.Language)
+			f.where += fmt.Sprintf(" AND %s @@ %s", f.vector, tsquery)
+
+			// ts_rank_cd (Cover Density) kullanarak kelime yoğunluğuna göre puanlıyoruz
+			// {D-weight, C-weight, B-weight, A-weight} -> {0.1, 0.2, 0.5, 1.0}
+			// A (Title) = 1.0, B (Tags) = 0.2 olarak ağırlıklandırıyoruz
+			f.relevance = fmt.Sprintf("ts_rank_cd('{0.1, 0.2, 0.4, 1.0}', %s, %s)", f.vector, tsquery)
 		}
 	}
 
 	// İçerik türü filtresi
 	if params.ContentType != "" {
-		argCount++
-		whereClause += fmt.Sprintf(" AND c.content_type = $%d", argCount)
-		args = append(args, params.ContentType)
+		f.args = append(f.args, params.ContentType)
+		f.where += fmt.Sprintf(" AND c.content_type = $%d", len(f.args))
 	}
 
-	// Alakalılık (relevance) skorunu hesapla
-	relevanceExpr := "0.0"
-	if params.Query != "" {
-		// ts_rank_cd (Cover Density) kullanarak kelime yoğunluğuna göre puanlıyoruz
-		// {D-weight, C-weight, B-weight, A-weight} -> {0.1, 0.2, 0.5, 1.0}
-		// A (Title) = 1.0, B (Tags) = 0.2 olarak ağırlıklandırıyoruz
-		relevanceExpr = fmt.Sprintf("ts_rank_cd('{0.1, 0.2, 0.4, 1.0}', %s, to_tsquery('english', $1))", searchVector)
+	// Dil filtresi
+	if params.Language != "" {
+		f.args = append(f.args, params.Language)
+		f.where += fmt.Sprintf(" AND c.language = $%d", len(f.args))
 	}
 
-	// Toplam kayıt sayısını al
-	countQuery := "SELECT COUNT(*) " + fromParts + whereClause
-	var total int64
-	err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total)
+	// Provider filtresi (ID veya isim, isim büyük/küçük harf duyarsız)
+	if params.ProviderID > 0 {
+		f.args = append(f.args, params.ProviderID)
+		f.where += fmt.Sprintf(" AND c.provider_id = $%d", len(f.args))
+	}
+	if params.ProviderName != "" {
+		f.args = append(f.args, params.ProviderName)
+		f.where += fmt.Sprintf(" AND c.provider_id IN (SELECT pf.id FROM providers pf WHERE LOWER(pf.name) = LOWER($%d))", len(f.args))
+	}
+
+	// Yazar/kanal filtresi (isim, büyük/küçük harf duyarsız; aynı isimli farklı provider yazarları da eşleşir)
+	if params.AuthorName != "" {
+		f.args = append(f.args, params.AuthorName)
+		f.where += fmt.Sprintf(" AND c.author_id IN (SELECT af.id FROM authors af WHERE LOWER(af.name) = LOWER($%d))", len(f.args))
+	}
+
+	// Kategori filtresi alt kategorileri de kapsar (ör. "programming" -> "programming/go/...")
+	if params.Category != "" {
+		f.args = append(f.args, params.Category, escapeLikePattern(params.Category)+"/%")
+		f.where += fmt.Sprintf(" AND c.category_id IN (SELECT cf.id FROM categories cf WHERE cf.path = $%d OR cf.path LIKE $%d)", len(f.args)-1, len(f.args))
+	}
+
+	// Yayın tarihi aralığı filtresi
+	if params.PublishedAfter != nil {
+		f.args = append(f.args, *params.PublishedAfter)
+		f.where += fmt.Sprintf(" AND c.published_at >= $%d", len(f.args))
+	}
+	if params.PublishedBefore != nil {
+		f.args = append(f.args, *params.PublishedBefore)
+		f.where += fmt.Sprintf(" AND c.published_at <= $%d", len(f.args))
+	}
+
+	// Kopyaları gizle: sadece kanonik içerikler (veya kanoniği silinmiş kopyalar) döner
+	if params.CollapseDuplicates {
+		f.where += ` AND (c.canonical_content_id IS NULL OR NOT EXISTS (
+			SELECT 1 FROM contents cc WHERE cc.id = c.canonical_content_id AND cc.deleted = 0
+		))`
+	}
+
+	// Tag filtresi (any: en az bir tag, all: tüm tag'ler)
+	if len(params.Tags) > 0 {
+		f.args = append(f.args, pq.Array(params.Tags))
+		tagMatch := fmt.Sprintf(`
+			SELECT COUNT(DISTINCT tf.name)
+			FROM content_tags ctf
+			JOIN tags tf ON ctf.tag_id = tf.id
+			WHERE ctf.content_id = c.id AND tf.name = ANY($%d)`, len(f.args))
+		if params.TagMode == port.TagModeAll {
+			f.where += fmt.Sprintf(" AND (%s) = %d", tagMatch, len(params.Tags))
+		} else {
+			f.where += fmt.Sprintf(" AND (%s) > 0", tagMatch)
+		}
+	}
+
+	return f
+}
+
+// withSimilarityThreshold threshold sıfırdan büyükse fn'i pg_trgm.similarity_threshold'un threshold'a
+// ayarlandığı bir transaction içinde çalıştırır. Ayar transaction'a yereldir (SET LOCAL), havuzdaki
+// bağlantıya sızmaz. Context'te zaten bir transaction varsa ayar onun içinde yapılır ve fn'den sonra
+// önceki değere döndürülür
+func (r *postgresContentRepository) withSimilarityThreshold(ctx context.Context, threshold float64, fn func(ctx context.Context) error) error {
+	if threshold <= 0 {
+		return fn(ctx)
+	}
+	value := strconv.FormatFloat(threshold, 'f', -1, 64)
+
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		var previous string
+		if err := state.tx.QueryRowContext(ctx, "SELECT current_setting('pg_trgm.similarity_threshold')").Scan(&previous); err != nil {
+			return fmt.Errorf("benzerlik eşiği okunamadı: %w", err)
+		}
+		if _, err := state.tx.ExecContext(ctx, "SELECT set_config('pg_trgm.similarity_threshold', $1, true)", value); err != nil {
+			return fmt.Errorf("benzerlik eşiği ayarlanamadı: %w", err)
+		}
+		fnErr := fn(ctx)
+		if _, err := state.tx.ExecContext(ctx, "SELECT set_config('pg_trgm.similarity_threshold', $1, true)", previous); err != nil && fnErr == nil {
+			return fmt.Errorf("benzerlik eşiği geri alınamadı: %w", err)
+		}
+		return fnErr
+	}
+
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
 	if err != nil {
-		return nil, 0, err
+		return fmt.Errorf("transaction başlatılamadı: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "SELECT set_config('pg_trgm.similarity_threshold', $1, true)", value); err != nil {
+		return fmt.Errorf("benzerlik eşiği ayarlanamadı: %w", err)
+	}
+	if err := fn(context.WithValue(ctx, txKey{}, &txState{tx: tx})); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// unaccentedTitleSimilarity başlık ile $1'deki sorgunun aksanları kaldırılmış trigram benzerliği
+const unaccentedTitleSimilarity = "similarity(immutable_unaccent(c.title), immutable_unaccent($1))"
+
+// shortQueryMaxLength bu uzunluğa (karakter) kadar olan tek kelimelik sorgular kısa sorgu kabul edilir
+const shortQueryMaxLength = 2
+
+// isShortQuery sorgunun FTS yerine kelime başı/tag prefix eşleşmesiyle aranacak kadar kısa olup olmadığını döner
+func isShortQuery(query string) bool {
+	query = strings.TrimSpace(query)
+	return query != "" && !strings.ContainsAny(query, " \t") && utf8.RuneCountInString(query) <= shortQueryMaxLength
+}
+
+// escapeLikePattern LIKE desenindeki özel karakterleri (\, %, _) kaçışlar
+func escapeLikePattern(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
+
+// popularitySortKey popularity sıralamasında kullanılan skor ifadesi
+// Skoru olmayan içerikler en sona düşsün diye NULL değerler -1 kabul edilir
+const popularitySortKey = "COALESCE(csc.final_score, -1)"
+
+// hybridSortKey alakalılık ve popülerliği ağırlıklı olarak birleştiren sıralama ifadesini döner
+// İki skor farklı ölçeklerde olduğundan (ts_rank ~0-1, final_score sınırsız) her biri eşleşen
+// kayıtlar içindeki en yüksek değere bölünerek 0-1 aralığına getirilir; weight alakalılığın ağırlığıdır
+func hybridSortKey(relevanceExpr string, weight float64) string {
+	relevance := fmt.Sprintf("COALESCE((%s) / NULLIF(MAX(%s) OVER (), 0), 0)", relevanceExpr, relevanceExpr)
+	popularity := "COALESCE(COALESCE(csc.final_score, 0) / NULLIF(MAX(COALESCE(csc.final_score, 0)) OVER (), 0), 0)"
+	return fmt.Sprintf("(%g * %s + %g * %s)", weight, relevance, 1-weight, popularity)
+}
+
+// sortColumns çoklu sıralamada izin verilen alanların SQL karşılıkları (whitelist)
+var sortColumns = map[string]string{
+	"published_at": "c.published_at",
+	"created_at":   "c.created_at",
+	"title":        "c.title",
+	"views":        "cs.views",
+	"likes":        "cs.likes",
+	"reactions":    "cs.reactions",
+	"reading_time": "cs.reading_time",
+	"score":        "csc.final_score",
+	"relevance":    "relevance_score",
+}
+
+// buildSortClause sıralama alanlarını güvenli bir ORDER BY ifadesine çevirir
+// Whitelist dışındaki alanlar yok sayılır, sonuçların deterministik olması için c.id eklenir
+func buildSortClause(fields []port.SortField) string {
+	var parts []string
+	for _, f := range fields {
+		column, ok := sortColumns[f.Field]
+		if !ok {
+			continue
+		}
+		if f.Desc {
+			parts = append(parts, column+" DESC NULLS LAST")
+		} else {
+			parts = append(parts, column+" ASC NULLS LAST")
+		}
+	}
+	parts = append(parts, "c.id DESC")
+	return strings.Join(parts, ", ")
+}
+
+// BulkUpsert içerikleri unnest ile tek sorguda upsert eder
+// Girdideki tekrar eden içerikler sorgudan önce tekilleştirilir (son gelen kazanır)
+func (r *postgresContentRepository) BulkUpsert(ctx context.Context, contents []*entity.Content) error {
+	if len(contents) == 0 {
+		return nil
+	}
+
+	type contentKey struct {
+		providerID int64
+		externalID string
+	}
+
+	// ON CONFLICT aynı satırı iki kez güncelleyemez, bu yüzden tekilleştir
+	index := make(map[contentKey]int, len(contents))
+	var unique []*entity.Content
+	for _, c := range contents {
+		key := contentKey{c.ProviderID, c.ProviderContentID}
+		if i, ok := index[key]; ok {
+			unique[i] = c
+			continue
+		}
+		index[key] = len(unique)
+		unique = append(unique, c)
+	}
+
+	var (
+		providerIDs  = make([]int64, len(unique))
+		externalIDs  = make([]string, len(unique))
+		titles       = make([]string, len(unique))
+		descriptions = make([]string, len(unique))
+		types        = make([]string, len(unique))
+		publishedAts = make([]string, len(unique))
+		rawData      = make([]string, len(unique))
+		languages    = make([]string, len(unique))
+		authorIDs    = make([]sql.NullInt64, len(unique))
+		urls         = make([]string, len(unique))
+		thumbnails   = make([]string, len(unique))
+		categoryIDs  = make([]sql.NullInt64, len(unique))
+		statuses     = make([]string, len(unique))
+	)
+	for i, c := range unique {
+		providerIDs[i] = c.ProviderID
+		externalIDs[i] = c.ProviderContentID
+		titles[i] = c.Title
+		descriptions[i] = c.Description
+		types[i] = string(c.ContentType)
+		publishedAts[i] = string(pq.FormatTimestamp(c.PublishedAt))
+		rawData[i] = c.RawData
+		languages[i] = languageOrDefault(c.Language)
+		authorIDs[i] = authorIDOf(c)
+		urls[i] = c.URL
+		thumbnails[i] = c.ThumbnailURL
+		categoryIDs[i] = categoryIDOf(c)
+		statuses[i] = string(statusOrDefault(c.Status))
+	}
+
+	query := `
+		INSERT INTO contents (provider_id, provider_content_id, title, description, content_type, published_at, raw_data, language, author_id, url, thumbnail_url, category_id, status, deleted)
+		SELECT u.provider_id, u.provider_content_id, u.title, u.description, u.content_type, u.published_at, u.raw_data, u.language, u.author_id,
+			u.url, u.thumbnail_url, u.category_id, u.status, 0
+		FROM unnest($1::int[], $2::text[], $3::text[], $4::text[], $5::text[], $6::timestamp[], $7::text[], $8::text[], $9::int[], $10::text[], $11::text[], $12::int[], $13::text[])
+			AS u(provider_id, provider_content_id, title, description, content_type, published_at, raw_data, language, author_id, url, thumbnail_url, category_id, status)
+		ON CONFLICT (provider_id, provider_content_id)
+		DO UPDATE SET
+			title = EXCLUDED.title,
+			description = EXCLUDED.description,
+			content_type = EXCLUDED.content_type,
+			published_at = EXCLUDED.published_at,
+			raw_data = EXCLUDED.raw_data,
+			language = EXCLUDED.language,
+			author_id = EXCLUDED.author_id,
+			url = EXCLUDED.url,
+			thumbnail_url = EXCLUDED.thumbnail_url,
+			category_id = EXCLUDED.category_id,
+			deleted = 0
+		RETURNING id, provider_id, provider_content_id, status, created_at, updated_at
+	`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query,
+		pq.Array(providerIDs),
+		pq.Array(externalIDs),
+		pq.Array(titles),
+		pq.Array(descriptions),
+		pq.Array(types),
+		pq.Array(publishedAts),
+		pq.Array(rawData),
+		pq.Array(languages),
+		pq.Array(authorIDs),
+		pq.Array(urls),
+		pq.Array(thumbnails),
+		pq.Array(categoryIDs),
+		pq.Array(statuses),
+	)
+	if err != nil {
+		return fmt.Errorf("bulk upsert failed: %w", err)
+	}
+	defer rows.Close()
+
+	// RETURNING sırası garanti değil, anahtar üzerinden eşleştir
+	for rows.Next() {
+		var (
+			key                  contentKey
+			id                   int64
+			status               entity.ContentStatus
+			createdAt, updatedAt time.Time
+		)
+		if err := rows.Scan(&id, &key.providerID, &key.externalID, &status, &createdAt, &updatedAt); err != nil {
+			return fmt.Errorf("bulk upsert scan failed: %w", err)
+		}
+		if i, ok := index[key]; ok {
+			unique[i].ID = id
+			unique[i].Status = status
+			unique[i].CreatedAt = createdAt
+			unique[i].UpdatedAt = updatedAt
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	// Tekrar eden girdiler de aynı kaydın bilgilerini alır
+	for _, c := range contents {
+		winner := unique[index[contentKey{c.ProviderID, c.ProviderContentID}]]
+		c.ID, c.Status, c.CreatedAt, c.UpdatedAt = winner.ID, winner.Status, winner.CreatedAt, winner.UpdatedAt
+	}
+
+	return nil
+}
+
+// Search arama parametrelerine göre içerikleri getirir (read replica varsa oradan)
+func (r *postgresContentRepository) Search(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+	var contents []*entity.Content
+	var total int64
+	err := r.read(ctx, func(reader *postgresContentRepository) error {
+		var err error
+		contents, total, err = reader.search(ctx, params)
+		return err
+	})
+	return contents, total, err
+}
+
+// search Search'ün verilen bağlantı havuzunda çalışan gövdesi
+func (r *postgresContentRepository) search(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+	var contents []*entity.Content
+	var total int64
+	filter := buildSearchFilter(params)
+	err := r.withSimilarityThreshold(ctx, filter.similarityThreshold, func(ctx context.Context) error {
+		var err error
+		contents, total, err = r.searchWithFilter(ctx, params, filter)
+		return err
+	})
+	return contents, total, err
+}
+
+// searchWithFilter arama sorgusunu hazırlanmış filtre ile çalıştırır
+func (r *postgresContentRepository) searchWithFilter(ctx context.Context, params port.SearchParams, filter searchFilter) ([]*entity.Content, int64, error) {
+	// Arama kısmını oluştur (FROM + JOIN'ler)
+	fromParts := `
+		FROM contents c
+		JOIN providers p ON c.provider_id = p.id
+		LEFT JOIN authors a ON c.author_id = a.id
+		LEFT JOIN categories cat ON c.category_id = cat.id
+		LEFT JOIN content_stats cs ON c.id = cs.content_id
+		LEFT JOIN content_scores csc ON c.id = csc.content_id
+		WHERE c.deleted = 0 AND c.status = 'approved'
+	`
+
+	whereClause := filter.where
+	args := filter.args
+	argCount := len(args)
+	params.Query = filter.query
+
+	// Alakalılık (relevance) skoru ifadesi
+	relevanceExpr := filter.relevance
+
+	// Toplam kayıt sayısı: window stratejisinde ana sorguda COUNT(*) OVER () ile alınır.
+	// Cursor koşulu WHERE'e eklendiğinden ve tahmini sayım ayrı sorguda daha ucuz olduğundan
+	// bu durumlarda ayrı COUNT sorgusu kullanılır
+	windowCount := r.countStrategy == CountStrategyWindow && params.Cursor == nil && params.CountLimit == 0
+	countWhere := whereClause
+	countArgs := append([]interface{}{}, args...)
+
+	var total int64
+	if !windowCount {
+		var err error
+		if total, err = r.countSearch(ctx, fromParts+countWhere, countArgs, params.CountLimit); err != nil {
+			return nil, 0, err
+		}
 	}
 
 	// Sıralama
 	orderBy := " ORDER BY "
-	if params.SortBy == "relevance" && params.Query != "" {
+	if len(params.SortFields) > 0 {
+		orderBy += buildSortClause(params.SortFields)
+	} else if params.SortBy == "relevance" && params.Query != "" {
 		orderBy += "relevance_score DESC, c.published_at DESC"
+	} else if params.SortBy == "hybrid" {
+		orderBy += hybridSortKey(relevanceExpr, params.HybridRelevanceWeight) + " DESC, c.id DESC"
 	} else {
 		// Varsayılan: popularity
-		orderBy += "csc.final_score DESC NULLS LAST, c.published_at DESC"
+		// Keyset pagination ile tutarlı olması için (final_score, id) sırası kullanılır
+		orderBy += popularitySortKey + " DESC, c.id DESC"
 	}
 
 	// Pagination
-	argCount++
-	limit := params.PageSize
-	offset := (params.Page - 1) * params.PageSize
-	pagination := fmt.Sprintf(" LIMIT $%d OFFSET $%d", argCount, argCount+1)
-	args = append(args, limit, offset)
+	var pagination string
+	if params.Cursor != nil {
+		// Keyset pagination: son görülen kaydın (final_score, id) değerinden sonrası
+		args = append(args, params.Cursor.Score, params.Cursor.ID)
+		whereClause += fmt.Sprintf(" AND (%s, c.id) < ($%d, $%d)", popularitySortKey, argCount+1, argCount+2)
+		argCount += 2
+
+		args = append(args, params.PageSize)
+		pagination = fmt.Sprintf(" LIMIT $%d", argCount+1)
+	} else {
+		argCount++
+		limit := params.PageSize
+		offset := (params.Page - 1) * params.PageSize
+		pagination = fmt.Sprintf(" LIMIT $%d OFFSET $%d", argCount, argCount+1)
+		args = append(args, limit, offset)
+	}
 
 	// Ana query
+	totalColumn := ""
+	if windowCount {
+		totalColumn = ",\n\t\t\tCOUNT(*) OVER () as total_count"
+	}
 	selectQuery := fmt.Sprintf(`
-		SELECT 
-			c.id, c.provider_id, c.provider_content_id, c.title, c.description,
-			c.content_type, c.published_at, c.created_at, c.updated_at, c.raw_data,
-			cs.id, cs.views, cs.likes, cs.reading_time, cs.reactions, cs.updated_at,
-			csc.id, csc.base_score, csc.type_weight, csc.recency_score,
-			csc.engagement_score, csc.final_score, csc.calculated_at,
-			%s as relevance_score
-	`, relevanceExpr) + fromParts + whereClause + orderBy + pagination
+		SELECT %s,
+			%s as relevance_score%s
+	`, contentListColumns, relevanceExpr, totalColumn) + fromParts + whereClause + orderBy + pagination
 
 	// Arama logu (debug için)
-	log.Printf("Arama yapılıyor: Query=%s, Sort=%s, Page=%d", params.Query, params.SortBy, params.Page)
-	// log.Printf("SQL: %s", selectQuery)
+	logger.FromContext(ctx).Debug("searching contents",
+		zap.String("query", params.Query),
+		zap.String("sort", params.SortBy),
+		zap.Int("page", params.Page),
+	)
 
-	rows, err := r.db.QueryContext(ctx, selectQuery, args...)
+	rows, err := r.conn(ctx).QueryContext(ctx, selectQuery, args...)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -313,161 +846,659 @@ func (r *postgresContentRepository) Search(ctx context.Context, params port.Sear
 
 	var contents []*entity.Content
 	for rows.Next() {
-		content := &entity.Content{
-			Stats: &entity.ContentStats{},
-			Score: &entity.ContentScore{},
-		}
-
-		var statsID, scoreID sql.NullInt64
-		var statsUpdatedAt, scoreCalculatedAt sql.NullTime
-		var relevanceScore float64
-		var rawData sql.NullString
-
-		err := rows.Scan(
-			&content.ID, &content.ProviderID, &content.ProviderContentID,
-			&content.Title, &content.Description, &content.ContentType,
-			&content.PublishedAt, &content.CreatedAt, &content.UpdatedAt, &rawData,
-			&statsID, &content.Stats.Views, &content.Stats.Likes,
-			&content.Stats.ReadingTime, &content.Stats.Reactions, &statsUpdatedAt,
-			&scoreID, &content.Score.BaseScore, &content.Score.TypeWeight,
-			&content.Score.RecencyScore, &content.Score.EngagementScore,
-			&content.Score.FinalScore, &scoreCalculatedAt,
-			&relevanceScore,
-		)
+		var extra []interface{}
+		if windowCount {
+			extra = append(extra, &total)
+		}
+		content, err := scanContentRow(rows, extra...)
 		if err != nil {
 			return nil, 0, err
 		}
+		contents = append(contents, content)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
 
-		content.RelevanceScore = relevanceScore
-		if rawData.Valid {
-			content.RawData = rawData.String
+	// Sayfa sonuç kümesinin dışındaysa satır dönmez ve window toplamı okunamaz; ayrıca sayılır
+	if windowCount && len(contents) == 0 && params.Page > 1 {
+		if total, err = r.countSearch(ctx, fromParts+countWhere, countArgs, 0); err != nil {
+			return nil, 0, err
 		}
+	}
 
-		// Stats ve Score null kontrolü
-		if !statsID.Valid {
-			content.Stats = nil
-		} else {
-			content.Stats.ID = statsID.Int64
-			content.Stats.ContentID = content.ID
-			if statsUpdatedAt.Valid {
-				content.Stats.UpdatedAt = statsUpdatedAt.Time
-			}
+	// Tag'leri tüm sayfa için tek sorguda yükle
+	if err := r.loadTagsForContents(ctx, contents); err != nil {
+		logger.FromContext(ctx).Warn("content tags could not be loaded", zap.Error(err))
+	}
+
+	return contents, total, nil
+}
+
+// countSearch FROM/WHERE kısmına uyan kayıtları sayar
+// limit verilmişse en fazla limit+1 satır sayılır; büyük sonuç kümelerinde tam taramayı önler
+func (r *postgresContentRepository) countSearch(ctx context.Context, fromWhere string, args []interface{}, limit int) (int64, error) {
+	countQuery := "SELECT COUNT(*) " + fromWhere
+	if limit > 0 {
+		countQuery = fmt.Sprintf("SELECT COUNT(*) FROM (SELECT 1 %s LIMIT %d) capped", fromWhere, limit+1)
+	}
+
+	var total int64
+	err := r.conn(ctx).QueryRowContext(ctx, countQuery, args...).Scan(&total)
+	return total, err
+}
+
+// facetTagLimit facet'lerde döndürülecek en popüler tag sayısı
+const facetTagLimit = 10
+
+// GetFacets arama parametrelerine uyan içeriklerin facet sayımlarını getirir (read replica varsa oradan)
+func (r *postgresContentRepository) GetFacets(ctx context.Context, params port.SearchParams) (*entity.SearchFacets, error) {
+	var facets *entity.SearchFacets
+	err := r.read(ctx, func(reader *postgresContentRepository) error {
+		var err error
+		facets, err = reader.getFacets(ctx, params)
+		return err
+	})
+	return facets, err
+}
+
+// getFacets GetFacets'in verilen bağlantı havuzunda çalışan gövdesi
+func (r *postgresContentRepository) getFacets(ctx context.Context, params port.SearchParams) (*entity.SearchFacets, error) {
+	var facets *entity.SearchFacets
+	filter := buildSearchFilter(params)
+	err := r.withSimilarityThreshold(ctx, filter.similarityThreshold, func(ctx context.Context) error {
+		var err error
+		facets, err = r.getFacetsWithFilter(ctx, filter)
+		return err
+	})
+	return facets, err
+}
+
+// getFacetsWithFilter facet sorgularını hazırlanmış filtre ile çalıştırır
+func (r *postgresContentRepository) getFacetsWithFilter(ctx context.Context, filter searchFilter) (*entity.SearchFacets, error) {
+	baseWhere := " WHERE c.deleted = 0 AND c.status = 'approved'" + filter.where
+
+	facets := &entity.SearchFacets{
+		ContentTypes: []entity.FacetCount{},
+		Providers:    []entity.FacetCount{},
+		Tags:         []entity.FacetCount{},
+	}
+
+	// İçerik türü dağılımı
+	typeQuery := `
+		SELECT c.content_type, '', COUNT(*)
+		FROM contents c` + baseWhere + `
+		GROUP BY c.content_type
+		ORDER BY COUNT(*) DESC, c.content_type
+	`
+	if err := r.scanFacets(ctx, typeQuery, filter.args, &facets.ContentTypes); err != nil {
+		return nil, fmt.Errorf("content type facet hatası: %w", err)
+	}
+
+	// Provider dağılımı
+	providerQuery := `
+		SELECT c.provider_id::text, p.name, COUNT(*)
+		FROM contents c
+		JOIN providers p ON p.id = c.provider_id` + baseWhere + `
+		GROUP BY c.provider_id, p.name
+		ORDER BY COUNT(*) DESC, p.name
+	`
+	if err := r.scanFacets(ctx, providerQuery, filter.args, &facets.Providers); err != nil {
+		return nil, fmt.Errorf("provider facet hatası: %w", err)
+	}
+
+	// En çok kullanılan tag'ler
+	tagArgs := append(append([]interface{}{}, filter.args...), facetTagLimit)
+	tagQuery := fmt.Sprintf(`
+		SELECT tg.name, '', COUNT(*)
+		FROM contents c
+		JOIN content_tags ctg ON ctg.content_id = c.id
+		JOIN tags tg ON tg.id = ctg.tag_id`+baseWhere+`
+		GROUP BY tg.name
+		ORDER BY COUNT(*) DESC, tg.name
+		LIMIT $%d
+	`, len(tagArgs))
+	if err := r.scanFacets(ctx, tagQuery, tagArgs, &facets.Tags); err != nil {
+		return nil, fmt.Errorf("tag facet hatası: %w", err)
+	}
+
+	return facets, nil
+}
+
+// scanFacets (value, label, count) döndüren bir facet sorgusunu çalıştırır
+func (r *postgresContentRepository) scanFacets(ctx context.Context, query string, args []interface{}, dest *[]entity.FacetCount) error {
+	rows, err := r.conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var fc entity.FacetCount
+		if err := rows.Scan(&fc.Value, &fc.Label, &fc.Count); err != nil {
+			return err
 		}
+		*dest = append(*dest, fc)
+	}
 
-		if !scoreID.Valid {
-			content.Score = nil
-		} else {
-			content.Score.ID = scoreID.Int64
-			content.Score.ContentID = content.ID
-			if scoreCalculatedAt.Valid {
-				content.Score.CalculatedAt = scoreCalculatedAt.Time
-			}
+	return rows.Err()
+}
+
+// contentListColumns liste sorgularında (Search, FindSimilar) seçilen kolonlar
+// Sırası scanContentRow ile aynı olmalıdır; ardından relevance_score kolonu gelir
+const contentListColumns = `
+			c.id, c.provider_id, c.provider_content_id, c.title, c.description,
+			c.content_type, c.published_at, c.created_at, c.updated_at, c.raw_data,
+			p.name, p.format,
+			cs.id, cs.views, cs.likes, cs.reading_time, cs.reactions, cs.duration_seconds, cs.comments, cs.updated_at,
+			csc.id, csc.base_score, csc.type_weight, csc.recency_score,
+			csc.engagement_score, csc.final_score, csc.calculated_at, csc.ctr_score, csc.ctr_lift,
+			c.canonical_content_id, c.language, c.url, c.thumbnail_url, c.status,
+			a.id, a.name, a.url,
+			cat.id, cat.name, cat.path`
+
+// nullableAuthor LEFT JOIN authors ile okunan (yazarı olmayan içeriklerde NULL) yazar kolonları
+type nullableAuthor struct {
+	id   sql.NullInt64
+	name sql.NullString
+	url  sql.NullString
+}
+
+// nullableCategory LEFT JOIN categories ile okunan (kategorisi olmayan içeriklerde NULL) kategori kolonları
+type nullableCategory struct {
+	id   sql.NullInt64
+	name sql.NullString
+	path sql.NullString
+}
+
+// toEntity kategori varsa ContentCategory'ye çevirir, yoksa nil döner
+func (c nullableCategory) toEntity() *entity.ContentCategory {
+	if !c.id.Valid {
+		return nil
+	}
+	return &entity.ContentCategory{ID: c.id.Int64, Name: c.name.String, Path: c.path.String}
+}
+
+// toEntity yazar varsa ContentAuthor'a çevirir, yoksa nil döner
+func (a nullableAuthor) toEntity() *entity.ContentAuthor {
+	if !a.id.Valid {
+		return nil
+	}
+	return &entity.ContentAuthor{ID: a.id.Int64, Name: a.name.String, URL: a.url.String}
+}
+
+// scanContentRow contentListColumns + relevance_score içeren bir satırı Content'e çevirir
+// extra verilirse relevance_score'dan sonra gelen kolonlar bu hedeflere okunur
+func scanContentRow(rows *sql.Rows, extra ...interface{}) (*entity.Content, error) {
+	content := &entity.Content{
+		Provider: &entity.ContentProvider{},
+		Stats:    &entity.ContentStats{},
+		Score:    &entity.ContentScore{},
+	}
+
+	var statsID, scoreID sql.NullInt64
+	var statsUpdatedAt, scoreCalculatedAt sql.NullTime
+	var relevanceScore float64
+	var ctrScore, ctrLift sql.NullFloat64
+	var rawData sql.NullString
+	var canonicalID sql.NullInt64
+	var author nullableAuthor
+	var category nullableCategory
+
+	dest := []interface{}{
+		&content.ID, &content.ProviderID, &content.ProviderContentID,
+		&content.Title, &content.Description, &content.ContentType,
+		&content.PublishedAt, &content.CreatedAt, &content.UpdatedAt, &rawData,
+		&content.Provider.Name, &content.Provider.Format,
+		&statsID, &content.Stats.Views, &content.Stats.Likes,
+		&content.Stats.ReadingTime, &content.Stats.Reactions, &content.Stats.DurationSeconds, &content.Stats.Comments, &statsUpdatedAt,
+		&scoreID, &content.Score.BaseScore, &content.Score.TypeWeight,
+		&content.Score.RecencyScore, &content.Score.EngagementScore,
+		&content.Score.FinalScore, &scoreCalculatedAt, &ctrScore, &ctrLift,
+		&canonicalID, &content.Language, &content.URL, &content.ThumbnailURL, &content.Status,
+		&author.id, &author.name, &author.url,
+		&category.id, &category.name, &category.path,
+		&relevanceScore,
+	}
+	err := rows.Scan(append(dest, extra...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	content.RelevanceScore = relevanceScore
+	content.Provider.ID = content.ProviderID
+	if rawData.Valid {
+		content.RawData = rawData.String
+	}
+	if canonicalID.Valid {
+		content.CanonicalContentID = &canonicalID.Int64
+	}
+	content.Author = author.toEntity()
+	content.Category = category.toEntity()
+
+	// Stats ve Score null kontrolü
+	if !statsID.Valid {
+		content.Stats = nil
+	} else {
+		content.Stats.ID = statsID.Int64
+		content.Stats.ContentID = content.ID
+		if statsUpdatedAt.Valid {
+			content.Stats.UpdatedAt = statsUpdatedAt.Time
 		}
+	}
 
-		// Tag'leri yükle
-		tags, err := r.loadTags(ctx, content.ID)
-		if err == nil {
-			content.Tags = tags
+	if !scoreID.Valid {
+		content.Score = nil
+	} else {
+		content.Score.ID = scoreID.Int64
+		content.Score.ContentID = content.ID
+		content.Score.CTRScore = ctrScore.Float64
+		content.Score.CTRLift = ctrLift.Float64
+		if scoreCalculatedAt.Valid {
+			content.Score.CalculatedAt = scoreCalculatedAt.Time
 		}
+	}
+
+	return content, nil
+}
+
+// FindSimilar bir içeriğe ortak tag'ler ve başlık benzerliği üzerinden benzeyen içerikleri getirir
+// (read replica varsa oradan)
+func (r *postgresContentRepository) FindSimilar(ctx context.Context, contentID int64, limit int) ([]*entity.Content, error) {
+	var contents []*entity.Content
+	err := r.read(ctx, func(reader *postgresContentRepository) error {
+		var err error
+		contents, err = reader.findSimilar(ctx, contentID, limit)
+		return err
+	})
+	return contents, err
+}
+
+// findSimilar FindSimilar'ın verilen bağlantı havuzunda çalışan gövdesi
+func (r *postgresContentRepository) findSimilar(ctx context.Context, contentID int64, limit int) ([]*entity.Content, error) {
+	// Kaynak içerik mevcut mu?
+	var exists bool
+	err := r.conn(ctx).QueryRowContext(ctx,
+		"SELECT EXISTS(SELECT 1 FROM contents WHERE id = $1 AND deleted = 0)", contentID,
+	).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check content: %w", err)
+	}
+	if !exists {
+		return nil, port.ErrContentNotFound
+	}
 
+	// Benzerlik = ortak tag sayısı + başlık kelimelerinin (OR) ts_rank değeri
+	// Başlıklar kendi dillerinde, kaynak içeriğin başlığı kaynağın dilinde işlenir
+	similarityExpr := `(
+		(SELECT COUNT(*) FROM content_tags cts
+			WHERE cts.content_id = c.id
+			AND cts.tag_id IN (SELECT tag_id FROM content_tags WHERE content_id = $1))
+		+ COALESCE(ts_rank(to_tsvector(c.language::regconfig, c.title), src.title_query), 0)
+	)`
+
+	query := fmt.Sprintf(`
+		SELECT %s,
+			%s as relevance_score
+		FROM contents c
+		JOIN providers p ON c.provider_id = p.id
+		LEFT JOIN authors a ON c.author_id = a.id
+		LEFT JOIN categories cat ON c.category_id = cat.id
+		LEFT JOIN content_stats cs ON c.id = cs.content_id
+		LEFT JOIN content_scores csc ON c.id = csc.content_id
+		CROSS JOIN (
+			SELECT NULLIF(replace(plainto_tsquery(language::regconfig, title)::text, ' & ', ' | '), '')::tsquery AS title_query
+			FROM contents WHERE id = $1
+		) src
+		WHERE c.deleted = 0 AND c.status = 'approved' AND c.id <> $1 AND %s > 0
+		ORDER BY relevance_score DESC, %s DESC, c.id DESC
+		LIMIT $2
+	`, contentListColumns, similarityExpr, similarityExpr, popularitySortKey)
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, contentID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find similar contents: %w", err)
+	}
+	defer rows.Close()
+
+	var contents []*entity.Content
+	for rows.Next() {
+		content, err := scanContentRow(rows)
+		if err != nil {
+			return nil, err
+		}
 		contents = append(contents, content)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Tag'leri tüm sonuçlar için tek sorguda yükle
+	if err := r.loadTagsForContents(ctx, contents); err != nil {
+		logger.FromContext(ctx).Warn("content tags could not be loaded", zap.Error(err))
+	}
 
-	return contents, total, rows.Err()
+	return contents, nil
 }
 
 // CreateOrUpdateStats içerik istatistiklerini oluşturur veya günceller
 func (r *postgresContentRepository) CreateOrUpdateStats(ctx context.Context, stats *entity.ContentStats) error {
 	query := `
-		INSERT INTO content_stats (content_id, views, likes, reading_time, reactions)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO content_stats (content_id, views, likes, reading_time, reactions, duration_seconds, comments)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 		ON CONFLICT (content_id)
 		DO UPDATE SET
 			views = EXCLUDED.views,
 			likes = EXCLUDED.likes,
 			reading_time = EXCLUDED.reading_time,
-			reactions = EXCLUDED.reactions
+			reactions = EXCLUDED.reactions,
+			duration_seconds = EXCLUDED.duration_seconds,
+			comments = EXCLUDED.comments
 		RETURNING id, updated_at
 	`
 
-	err := r.db.QueryRowContext(
+	err := r.conn(ctx).QueryRowContext(
 		ctx, query,
 		stats.ContentID,
 		stats.Views,
 		stats.Likes,
 		stats.ReadingTime,
 		stats.Reactions,
+		stats.DurationSeconds,
+		stats.Comments,
 	).Scan(&stats.ID, &stats.UpdatedAt)
 
 	return err
 }
 
 // CreateOrUpdateScore içerik skorunu oluşturur veya günceller
+// ctr_lift sadece CTR işi tarafından yazılır, burada değişmez
 func (r *postgresContentRepository) CreateOrUpdateScore(ctx context.Context, score *entity.ContentScore) error {
 	query := `
-		INSERT INTO content_scores (content_id, base_score, type_weight, recency_score, engagement_score, final_score)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO content_scores (content_id, base_score, type_weight, recency_score, engagement_score, ctr_score, final_score)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 		ON CONFLICT (content_id)
 		DO UPDATE SET
 			base_score = EXCLUDED.base_score,
 			type_weight = EXCLUDED.type_weight,
 			recency_score = EXCLUDED.recency_score,
 			engagement_score = EXCLUDED.engagement_score,
+			ctr_score = EXCLUDED.ctr_score,
 			final_score = EXCLUDED.final_score,
 			calculated_at = CURRENT_TIMESTAMP
 		RETURNING id, calculated_at
 	`
 
-	err := r.db.QueryRowContext(
+	err := r.conn(ctx).QueryRowContext(
 		ctx, query,
 		score.ContentID,
 		score.BaseScore,
 		score.TypeWeight,
 		score.RecencyScore,
 		score.EngagementScore,
+		score.CTRScore,
 		score.FinalScore,
 	).Scan(&score.ID, &score.CalculatedAt)
 
 	return err
 }
 
-// AddTags içeriğe etiketler ekler
-func (r *postgresContentRepository) AddTags(ctx context.Context, contentID int64, tags []string) error {
-	if len(tags) == 0 {
+// BulkCreateOrUpdateStats istatistikleri unnest ile tek sorguda yazar
+func (r *postgresContentRepository) BulkCreateOrUpdateStats(ctx context.Context, stats []*entity.ContentStats) error {
+	if len(stats) == 0 {
 		return nil
 	}
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	// Aynı içerik birden fazla gelirse son gelen kazanır
+	index := make(map[int64]int, len(stats))
+	var unique []*entity.ContentStats
+	for _, st := range stats {
+		if i, ok := index[st.ContentID]; ok {
+			unique[i] = st
+			continue
+		}
+		index[st.ContentID] = len(unique)
+		unique = append(unique, st)
+	}
+
+	var (
+		contentIDs   = make([]int64, len(unique))
+		views        = make([]int64, len(unique))
+		likes        = make([]int64, len(unique))
+		readingTimes = make([]int64, len(unique))
+		reactions    = make([]int64, len(unique))
+		durations    = make([]int64, len(unique))
+		comments     = make([]int64, len(unique))
+	)
+	for i, st := range unique {
+		contentIDs[i] = st.ContentID
+		views[i] = st.Views
+		likes[i] = int64(st.Likes)
+		readingTimes[i] = int64(st.ReadingTime)
+		reactions[i] = int64(st.Reactions)
+		durations[i] = int64(st.DurationSeconds)
+		comments[i] = int64(st.Comments)
+	}
+
+	query := `
+		INSERT INTO content_stats (content_id, views, likes, reading_time, reactions, duration_seconds, comments)
+		SELECT * FROM unnest($1::int[], $2::bigint[], $3::int[], $4::int[], $5::int[], $6::int[], $7::int[])
+		ON CONFLICT (content_id)
+		DO UPDATE SET
+			views = EXCLUDED.views,
+			likes = EXCLUDED.likes,
+			reading_time = EXCLUDED.reading_time,
+			reactions = EXCLUDED.reactions,
+			duration_seconds = EXCLUDED.duration_seconds,
+			comments = EXCLUDED.comments
+		RETURNING id, content_id, updated_at
+	`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query,
+		pq.Array(contentIDs),
+		pq.Array(views),
+		pq.Array(likes),
+		pq.Array(readingTimes),
+		pq.Array(reactions),
+		pq.Array(durations),
+		pq.Array(comments),
+	)
 	if err != nil {
+		return fmt.Errorf("bulk stats upsert failed: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id, contentID int64
+		var updatedAt time.Time
+		if err := rows.Scan(&id, &contentID, &updatedAt); err != nil {
+			return fmt.Errorf("bulk stats scan failed: %w", err)
+		}
+		unique[index[contentID]].ID = id
+		unique[index[contentID]].UpdatedAt = updatedAt
+	}
+	if err := rows.Err(); err != nil {
 		return err
 	}
-	defer tx.Rollback()
 
-	// Her tag için
-	for _, tagName := range tags {
-		// Tag'i oluştur veya mevcut olanı al
-		var tagID int64
-		err := tx.QueryRowContext(ctx, `
-			INSERT INTO tags (name) VALUES ($1)
-			ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name
-			RETURNING id
-		`, strings.ToLower(strings.TrimSpace(tagName))).Scan(&tagID)
-		if err != nil {
-			return err
+	// Tekrar eden girdiler de aynı kaydın bilgilerini alır
+	for _, st := range stats {
+		winner := unique[index[st.ContentID]]
+		st.ID, st.UpdatedAt = winner.ID, winner.UpdatedAt
+	}
+
+	return nil
+}
+
+// BulkCreateOrUpdateScores skorları unnest ile tek sorguda yazar
+// ctr_lift sadece CTR işi tarafından yazılır, burada değişmez
+func (r *postgresContentRepository) BulkCreateOrUpdateScores(ctx context.Context, scores []*entity.ContentScore) error {
+	if len(scores) == 0 {
+		return nil
+	}
+
+	// Aynı içerik birden fazla gelirse son gelen kazanır
+	index := make(map[int64]int, len(scores))
+	var unique []*entity.ContentScore
+	for _, sc := range scores {
+		if i, ok := index[sc.ContentID]; ok {
+			unique[i] = sc
+			continue
 		}
+		index[sc.ContentID] = len(unique)
+		unique = append(unique, sc)
+	}
 
-		// Content-tag ilişkisini oluştur
-		_, err = tx.ExecContext(ctx, `
-			INSERT INTO content_tags (content_id, tag_id)
-			VALUES ($1, $2)
-			ON CONFLICT DO NOTHING
-		`, contentID, tagID)
-		if err != nil {
-			return err
+	var (
+		contentIDs       = make([]int64, len(unique))
+		baseScores       = make([]float64, len(unique))
+		typeWeights      = make([]float64, len(unique))
+		recencyScores    = make([]float64, len(unique))
+		engagementScores = make([]float64, len(unique))
+		ctrScores        = make([]float64, len(unique))
+		finalScores      = make([]float64, len(unique))
+	)
+	for i, sc := range unique {
+		contentIDs[i] = sc.ContentID
+		baseScores[i] = sc.BaseScore
+		typeWeights[i] = sc.TypeWeight
+		recencyScores[i] = sc.RecencyScore
+		engagementScores[i] = sc.EngagementScore
+		ctrScores[i] = sc.CTRScore
+		finalScores[i] = sc.FinalScore
+	}
+
+	query := `
+		INSERT INTO content_scores (content_id, base_score, type_weight, recency_score, engagement_score, ctr_score, final_score)
+		SELECT * FROM unnest($1::int[], $2::numeric[], $3::numeric[], $4::numeric[], $5::numeric[], $6::numeric[], $7::numeric[])
+		ON CONFLICT (content_id)
+		DO UPDATE SET
+			base_score = EXCLUDED.base_score,
+			type_weight = EXCLUDED.type_weight,
+			recency_score = EXCLUDED.recency_score,
+			engagement_score = EXCLUDED.engagement_score,
+			ctr_score = EXCLUDED.ctr_score,
+			final_score = EXCLUDED.final_score,
+			calculated_at = CURRENT_TIMESTAMP
+		RETURNING id, content_id, calculated_at
+	`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query,
+		pq.Array(contentIDs),
+		pq.Array(baseScores),
+		pq.Array(typeWeights),
+		pq.Array(recencyScores),
+		pq.Array(engagementScores),
+		pq.Array(ctrScores),
+		pq.Array(finalScores),
+	)
+	if err != nil {
+		return fmt.Errorf("bulk score upsert failed: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id, contentID int64
+		var calculatedAt time.Time
+		if err := rows.Scan(&id, &contentID, &calculatedAt); err != nil {
+			return fmt.Errorf("bulk score scan failed: %w", err)
 		}
+		unique[index[contentID]].ID = id
+		unique[index[contentID]].CalculatedAt = calculatedAt
+	}
+	if err := rows.Err(); err != nil {
+		return err
 	}
 
-	return tx.Commit()
+	// Tekrar eden girdiler de aynı kaydın bilgilerini alır
+	for _, sc := range scores {
+		winner := unique[index[sc.ContentID]]
+		sc.ID, sc.CalculatedAt = winner.ID, winner.CalculatedAt
+	}
+
+	return nil
+}
+
+// FindContentsForScoring skorlama için içerikleri keyset sayfalama ile getirir
+// Kayıtlı CTR sinyali Score.CTRLift'e okunur (skoru olmayan içeriklerde 0)
+func (r *postgresContentRepository) FindContentsForScoring(ctx context.Context, afterID int64, limit int) ([]*entity.Content, error) {
+	query := `
+		SELECT c.id, c.content_type, c.published_at,
+			cs.id, cs.views, cs.likes, cs.reading_time, cs.reactions, cs.duration_seconds, cs.comments, cs.updated_at,
+			COALESCE(csc.ctr_lift, 0)
+		FROM contents c
+		JOIN content_stats cs ON cs.content_id = c.id
+		LEFT JOIN content_scores csc ON csc.content_id = c.id
+		WHERE c.id > $1 AND c.deleted = 0
+		ORDER BY c.id
+		LIMIT $2
+	`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list contents for scoring: %w", err)
+	}
+	defer rows.Close()
+
+	var contents []*entity.Content
+	for rows.Next() {
+		content := &entity.Content{Stats: &entity.ContentStats{}, Score: &entity.ContentScore{}}
+		if err := rows.Scan(
+			&content.ID, &content.ContentType, &content.PublishedAt,
+			&content.Stats.ID, &content.Stats.Views, &content.Stats.Likes,
+			&content.Stats.ReadingTime, &content.Stats.Reactions, &content.Stats.DurationSeconds, &content.Stats.Comments, &content.Stats.UpdatedAt,
+			&content.Score.CTRLift,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan content for scoring: %w", err)
+		}
+		content.Stats.ContentID = content.ID
+		content.Score.ContentID = content.ID
+		contents = append(contents, content)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Tag boost'ları için tag'ler gerekli
+	if err := r.loadTagsForContents(ctx, contents); err != nil {
+		return nil, fmt.Errorf("failed to load tags for scoring: %w", err)
+	}
+
+	return contents, nil
+}
+
+// AddTags içeriğe etiketler ekler
+// Tag'ler ve content-tag ilişkileri tek sorguda (unnest ile) yazılır, tag sayısından bağımsızdır
+func (r *postgresContentRepository) AddTags(ctx context.Context, contentID int64, tags []string) error {
+	// Normalize et, boş kalanları ve tekrar edenleri çıkar (aynı satır tek sorguda iki kez güncellenemez)
+	names := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		name := strings.ToLower(strings.TrimSpace(tag))
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	query := `
+		WITH upserted AS (
+			INSERT INTO tags (name)
+			SELECT unnest($2::text[])
+			ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name
+			RETURNING id
+		)
+		INSERT INTO content_tags (content_id, tag_id)
+		SELECT $1, id FROM upserted
+		ON CONFLICT DO NOTHING
+	`
+
+	_, err := r.conn(ctx).ExecContext(ctx, query, contentID, pq.Array(names))
+	return err
 }
 
 // MarkStaleContentsAsDeleted güncellenmeyen içerikleri silinmiş olarak işaretler
@@ -477,17 +1508,126 @@ func (r *postgresContentRepository) MarkStaleContentsAsDeleted(ctx context.Conte
 		SET deleted = 1, updated_at = CURRENT_TIMESTAMP
 		WHERE provider_id = $1 AND updated_at < $2 AND deleted = 0
 	`
-	
-	result, err := r.db.ExecContext(ctx, query, providerID, threshold)
+
+	result, err := r.conn(ctx).ExecContext(ctx, query, providerID, threshold)
 	if err != nil {
 		return err
 	}
-	
+
 	rowsAffected, _ := result.RowsAffected()
 	if rowsAffected > 0 {
-		log.Printf("%d stale contents marked as deleted for provider %d", rowsAffected, providerID)
+		logger.FromContext(ctx).Info("stale contents marked as deleted",
+			zap.Int64("provider_id", providerID),
+			zap.Int64("count", rowsAffected),
+		)
+	}
+
+	return nil
+}
+
+// MarkContentDeleted provider'ın tek bir içeriğini silinmiş olarak işaretler
+func (r *postgresContentRepository) MarkContentDeleted(ctx context.Context, providerID int64, providerContentID string) error {
+	query := `
+		UPDATE contents
+		SET deleted = 1, updated_at = CURRENT_TIMESTAMP
+		WHERE provider_id = $1 AND provider_content_id = $2 AND deleted = 0
+	`
+
+	if _, err := r.conn(ctx).ExecContext(ctx, query, providerID, providerContentID); err != nil {
+		return fmt.Errorf("failed to mark content deleted: %w", err)
+	}
+
+	return nil
+}
+
+// FindProviderContentIDs provider'ın kayıtlı içeriklerini provider_content_id -> deleted olarak getirir
+func (r *postgresContentRepository) FindProviderContentIDs(ctx context.Context, providerID int64) (map[string]bool, error) {
+	rows, err := r.conn(ctx).QueryContext(ctx,
+		"SELECT provider_content_id, deleted = 1 FROM contents WHERE provider_id = $1",
+		providerID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make(map[string]bool)
+	for rows.Next() {
+		var id string
+		var deleted bool
+		if err := rows.Scan(&id, &deleted); err != nil {
+			return nil, err
+		}
+		ids[id] = deleted
+	}
+
+	return ids, rows.Err()
+}
+
+// FindDuplicateCandidates provider'ın since'ten sonra güncellenen içerikleri için diğer provider'lardaki olası kopyaları getirir
+// Adaylar yayın tarihi penceresi ve pg_trgm başlık benzerliği (% operatörü) ile ön elenir
+func (r *postgresContentRepository) FindDuplicateCandidates(ctx context.Context, providerID int64, since time.Time, window time.Duration) ([]*entity.DuplicateCandidate, error) {
+	query := `
+		SELECT s.id, s.title, s.published_at,
+		       COALESCE(o.id, 0), COALESCE(o.title, ''), COALESCE(o.published_at, s.published_at),
+		       COALESCE(o.canonical_content_id, o.id, 0)
+		FROM contents s
+		LEFT JOIN contents o ON o.provider_id <> s.provider_id
+			AND o.deleted = 0
+			AND o.published_at BETWEEN s.published_at - $3 * INTERVAL '1 second'
+				AND s.published_at + $3 * INTERVAL '1 second'
+			AND o.title % s.title
+		WHERE s.provider_id = $1 AND s.updated_at >= $2 AND s.deleted = 0
+		ORDER BY s.id, o.id
+	`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, providerID, since, window.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find duplicate candidates: %w", err)
+	}
+	defer rows.Close()
+
+	var candidates []*entity.DuplicateCandidate
+	for rows.Next() {
+		c := &entity.DuplicateCandidate{}
+		if err := rows.Scan(
+			&c.ContentID, &c.Title, &c.PublishedAt,
+			&c.CandidateID, &c.CandidateTitle, &c.CandidatePublishedAt, &c.CandidateCanonicalID,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan duplicate candidate: %w", err)
+		}
+		candidates = append(candidates, c)
+	}
+
+	return candidates, rows.Err()
+}
+
+// LinkDuplicates içerikleri kanonik içeriklerine tek sorguda bağlar
+// Kanonik içeriği değişen bir içeriğe bağlı kopyalar da yeni kanonik içeriğe taşınır (zincir oluşmaz)
+// Değeri değişmeyen satırlar güncellenmez
+func (r *postgresContentRepository) LinkDuplicates(ctx context.Context, links map[int64]int64) error {
+	if len(links) == 0 {
+		return nil
+	}
+
+	contentIDs := make([]int64, 0, len(links))
+	canonicalIDs := make([]int64, 0, len(links))
+	for contentID, canonicalID := range links {
+		contentIDs = append(contentIDs, contentID)
+		canonicalIDs = append(canonicalIDs, canonicalID)
+	}
+
+	query := `
+		UPDATE contents c
+		SET canonical_content_id = NULLIF(l.canonical_id, c.id)
+		FROM unnest($1::bigint[], $2::bigint[]) AS l(content_id, canonical_id)
+		WHERE (c.id = l.content_id OR c.canonical_content_id = l.content_id)
+			AND c.canonical_content_id IS DISTINCT FROM NULLIF(l.canonical_id, c.id)
+	`
+
+	if _, err := r.conn(ctx).ExecContext(ctx, query, pq.Array(contentIDs), pq.Array(canonicalIDs)); err != nil {
+		return fmt.Errorf("failed to link duplicates: %w", err)
 	}
-	
 	return nil
 }
 
@@ -501,7 +1641,7 @@ func (r *postgresContentRepository) loadTags(ctx context.Context, contentID int6
 		ORDER BY t.name
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, contentID)
+	rows, err := r.conn(ctx).QueryContext(ctx, query, contentID)
 	if err != nil {
 		return nil, err
 	}
@@ -518,3 +1658,44 @@ func (r *postgresContentRepository) loadTags(ctx context.Context, contentID int6
 
 	return tags, rows.Err()
 }
+
+// loadTagsForContents verilen içeriklerin tag'lerini tek sorguda yükler (N+1 yerine)
+func (r *postgresContentRepository) loadTagsForContents(ctx context.Context, contents []*entity.Content) error {
+	if len(contents) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(contents))
+	byID := make(map[int64][]*entity.Content, len(contents))
+	for i, c := range contents {
+		ids[i] = c.ID
+		byID[c.ID] = append(byID[c.ID], c)
+	}
+
+	query := `
+		SELECT ct.content_id, t.id, t.name, t.created_at
+		FROM tags t
+		INNER JOIN content_tags ct ON t.id = ct.tag_id
+		WHERE ct.content_id = ANY($1)
+		ORDER BY ct.content_id, t.name
+	`
+
+	rows, err := r.conn(ctx).QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var contentID int64
+		var tag entity.Tag
+		if err := rows.Scan(&contentID, &tag.ID, &tag.Name, &tag.CreatedAt); err != nil {
+			return err
+		}
+		for _, c := range byID[contentID] {
+			c.Tags = append(c.Tags, tag)
+		}
+	}
+
+	return rows.Err()
+}
@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/lib/pq"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/testutil"
+)
+
+// countingConnector pq bağlantılarını sarar ve veritabanına giden sorguları sayar
+type countingConnector struct {
+	driver.Connector
+	queries atomic.Int64
+}
+
+func (c *countingConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &countingConn{Conn: conn, queries: &c.queries}, nil
+}
+
+// countingConn QueryerContext/ExecerContext çağrılarını sayıp pq bağlantısına iletir
+type countingConn struct {
+	driver.Conn
+	queries *atomic.Int64
+}
+
+func (c *countingConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.queries.Add(1)
+	return c.Conn.(driver.QueryerContext).QueryContext(ctx, query, args)
+}
+
+func (c *countingConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.queries.Add(1)
+	return c.Conn.(driver.ExecerContext).ExecContext(ctx, query, args)
+}
+
+// BenchmarkSearch_TagLoading 50 içerikli bir sayfa için sorgu sayısını raporlar
+// "batched" Search'ün kendisidir: count + select + tek tag sorgusu, sayfa boyutundan bağımsız
+// "per_row" eski satır başı tag yüklemeyi taklit eder: sayfadaki her içerik için bir sorgu daha
+func BenchmarkSearch_TagLoading(b *testing.B) {
+	const pageSize = 50
+
+	seedDB := testutil.SetupTestDB(b)
+	defer testutil.TeardownTestDB(b, seedDB)
+
+	provider := testutil.CreateTestProvider(b, seedDB, "Bench Provider", "json")
+	tags := []*entity.Tag{
+		testutil.CreateTestTag(b, seedDB, "golang"),
+		testutil.CreateTestTag(b, seedDB, "database"),
+		testutil.CreateTestTag(b, seedDB, "performance"),
+	}
+	for i := 0; i < pageSize; i++ {
+		content := testutil.CreateTestContentWithScore(b, seedDB, provider.ID, float64(i))
+		for _, tag := range tags {
+			testutil.AddTagToContent(b, seedDB, content.ID, tag.ID)
+		}
+	}
+
+	pqConnector, err := pq.NewConnector(testutil.TestDatabaseURL())
+	if err != nil {
+		b.Fatalf("Failed to create connector: %v", err)
+	}
+	connector := &countingConnector{Connector: pqConnector}
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	repo := NewPostgresContentRepository(db).(*postgresContentRepository)
+	ctx := context.Background()
+	params := port.SearchParams{SortBy: "popularity", Page: 1, PageSize: pageSize}
+
+	run := func(b *testing.B, search func() ([]*entity.Content, error)) {
+		connector.queries.Store(0)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			contents, err := search()
+			if err != nil {
+				b.Fatalf("Search failed: %v", err)
+			}
+			if len(contents) != pageSize || len(contents[0].Tags) != len(tags) {
+				b.Fatalf("Unexpected page: %d contents", len(contents))
+			}
+		}
+		b.StopTimer()
+		b.ReportMetric(float64(connector.queries.Load())/float64(b.N), "queries/op")
+	}
+
+	b.Run("batched", func(b *testing.B) {
+		run(b, func() ([]*entity.Content, error) {
+			contents, _, err := repo.Search(ctx, params)
+			return contents, err
+		})
+	})
+
+	b.Run("per_row", func(b *testing.B) {
+		run(b, func() ([]*entity.Content, error) {
+			contents, _, err := repo.Search(ctx, params)
+			if err != nil {
+				return nil, err
+			}
+			// Search'ün tek sorguda yüklediği tag'leri satır başı tekrar yükle
+			for _, c := range contents {
+				if c.Tags, err = repo.loadTags(ctx, c.ID); err != nil {
+					return nil, fmt.Errorf("loadTags: %w", err)
+				}
+			}
+			return contents, nil
+		})
+	})
+}
+
+// BenchmarkSearch_CountStrategy toplamın ayrı COUNT sorgusuyla ve COUNT(*) OVER () ile alınmasını karşılaştırır
+// "separate" count + select + tag sorgusu, "window" select + tag sorgusu yapar (bir round-trip az)
+func BenchmarkSearch_CountStrategy(b *testing.B) {
+	const contentCount = 500
+
+	seedDB := testutil.SetupTestDB(b)
+	defer testutil.TeardownTestDB(b, seedDB)
+
+	provider := testutil.CreateTestProvider(b, seedDB, "Bench Provider", "json")
+	for i := 0; i < contentCount; i++ {
+		testutil.CreateTestContentWithScore(b, seedDB, provider.ID, float64(i))
+	}
+
+	pqConnector, err := pq.NewConnector(testutil.TestDatabaseURL())
+	if err != nil {
+		b.Fatalf("Failed to create connector: %v", err)
+	}
+	connector := &countingConnector{Connector: pqConnector}
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	ctx := context.Background()
+	params := port.SearchParams{SortBy: "popularity", Page: 2, PageSize: 20}
+
+	for _, strategy := range []string{CountStrategySeparate, CountStrategyWindow} {
+		repo := NewPostgresContentRepositoryWithOptions(db, PostgresContentRepositoryOptions{CountStrategy: strategy})
+
+		b.Run(strategy, func(b *testing.B) {
+			connector.queries.Store(0)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, total, err := repo.Search(ctx, params)
+				if err != nil {
+					b.Fatalf("Search failed: %v", err)
+				}
+				if total != contentCount {
+					b.Fatalf("Unexpected total: %d", total)
+				}
+			}
+			b.StopTimer()
+			b.ReportMetric(float64(connector.queries.Load())/float64(b.N), "queries/op")
+		})
+	}
+}
@@ -150,6 +150,42 @@ func TestPostgresContentRepository_Search(t *testing.T) {
 		assert.Len(t, results, 1)
 	})
 
+	t.Run("cursor pagination", func(t *testing.T) {
+		params := port.SearchParams{
+			SortBy:   "popularity",
+			Page:     1,
+			PageSize: 2,
+		}
+
+		firstPage, total, err := repo.Search(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		require.Len(t, firstPage, 2)
+
+		last := firstPage[len(firstPage)-1]
+		params.Cursor = &port.SearchCursor{Score: last.Score.FinalScore, ID: last.ID}
+		secondPage, total, err := repo.Search(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		require.Len(t, secondPage, 1)
+		assert.Equal(t, "Python Programming Guide", secondPage[0].Title)
+	})
+
+	t.Run("multi-field sort", func(t *testing.T) {
+		params := port.SearchParams{
+			SortBy:     "title:asc",
+			SortFields: []port.SortField{{Field: "title", Desc: false}},
+			Page:       1,
+			PageSize:   20,
+		}
+
+		results, _, err := repo.Search(context.Background(), params)
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+		assert.Equal(t, "Advanced Golang Patterns", results[0].Title)
+		assert.Equal(t, "Python Programming Guide", results[2].Title)
+	})
+
 	t.Run("sort by relevance", func(t *testing.T) {
 		params := port.SearchParams{
 			Query:    "golang",
@@ -166,6 +202,164 @@ func TestPostgresContentRepository_Search(t *testing.T) {
 		assert.Greater(t, results[0].RelevanceScore, 0.0)
 	})
 
+	t.Run("hybrid sort", func(t *testing.T) {
+		params := port.SearchParams{
+			Query:                 "golang",
+			SortBy:                "hybrid",
+			HybridRelevanceWeight: 0,
+			Page:                  1,
+			PageSize:              20,
+		}
+
+		// Ağırlık 0 iken sıralama popülerliğe göre olmalı
+		results, total, err := repo.Search(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		require.Len(t, results, 2)
+		assert.Equal(t, "Advanced Golang Patterns", results[0].Title)
+		assert.Greater(t, results[0].RelevanceScore, 0.0)
+
+		// Sorgu yoksa alakalılık katkısı sıfırdır, popülerlik sırası korunur
+		params.Query = ""
+		params.HybridRelevanceWeight = 0.7
+		results, total, err = repo.Search(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		require.Len(t, results, 3)
+		assert.Equal(t, "Advanced Golang Patterns", results[0].Title)
+		assert.Equal(t, "Python Programming Guide", results[2].Title)
+	})
+
+	t.Run("filter by published date range", func(t *testing.T) {
+		// Test içerikleri 24 saat önce yayınlanmış olarak oluşturulur
+		after := time.Now().Add(-48 * time.Hour)
+		before := time.Now().Add(-36 * time.Hour)
+		params := port.SearchParams{
+			SortBy:          "popularity",
+			Page:            1,
+			PageSize:        20,
+			PublishedAfter:  &after,
+			PublishedBefore: &before,
+		}
+
+		results, total, err := repo.Search(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), total)
+		assert.Len(t, results, 0)
+
+		params.PublishedBefore = nil
+		_, total, err = repo.Search(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+	})
+
+	t.Run("filter by tags", func(t *testing.T) {
+		params := port.SearchParams{
+			SortBy:   "popularity",
+			Page:     1,
+			PageSize: 20,
+			Tags:     []string{"golang", "python"},
+			TagMode:  port.TagModeAny,
+		}
+
+		_, total, err := repo.Search(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+
+		params.TagMode = port.TagModeAll
+		_, total, err = repo.Search(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), total)
+
+		params.Tags = []string{"golang"}
+		_, total, err = repo.Search(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+	})
+
+	t.Run("filter by provider", func(t *testing.T) {
+		otherProvider := testutil.CreateTestProvider(t, db, "Other Provider", "xml")
+		testutil.CreateTestContent(t, db, otherProvider.ID, entity.ContentTypeArticle)
+		defer db.Exec("DELETE FROM providers WHERE id = $1", otherProvider.ID)
+
+		params := port.SearchParams{
+			SortBy:     "popularity",
+			Page:       1,
+			PageSize:   20,
+			ProviderID: provider.ID,
+		}
+
+		results, total, err := repo.Search(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		require.NotNil(t, results[0].Provider)
+		assert.Equal(t, "Test Provider", results[0].Provider.Name)
+		assert.Equal(t, provider.ID, results[0].Provider.ID)
+
+		params.ProviderID = 0
+		params.ProviderName = "other provider"
+		results, total, err = repo.Search(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Equal(t, "xml", results[0].Provider.Format)
+	})
+
+	t.Run("fuzzy search tolerates typos", func(t *testing.T) {
+		params := port.SearchParams{
+			Query:          "Golang Tutoral",
+			SortBy:         "relevance",
+			Page:           1,
+			PageSize:       20,
+			FuzzyThreshold: 0.3,
+		}
+
+		results, total, err := repo.Search(context.Background(), params)
+		require.NoError(t, err)
+		require.Greater(t, total, int64(0))
+		assert.Equal(t, "Golang Tutorial for Beginners", results[0].Title)
+		assert.Greater(t, results[0].RelevanceScore, 0.0)
+
+		// Eşik pg_trgm.similarity_threshold ile % operatörüne uygulanır
+		params.FuzzyThreshold = 0.95
+		_, total, err = repo.Search(context.Background(), params)
+		require.NoError(t, err)
+		assert.Zero(t, total)
+	})
+
+	t.Run("short query matches word starts and tag prefixes", func(t *testing.T) {
+		results, total, err := repo.Search(context.Background(), port.SearchParams{
+			Query:    "Go",
+			SortBy:   "relevance",
+			Page:     1,
+			PageSize: 20,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		for _, r := range results {
+			assert.Contains(t, r.Title, "Golang")
+		}
+
+		// "Programming" içinde geçen "g" kelime başı olmadığından eşleşmez
+		_, total, err = repo.Search(context.Background(), port.SearchParams{Query: "gu", Page: 1, PageSize: 20})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+
+		_, total, err = repo.Search(context.Background(), port.SearchParams{Query: "py", Page: 1, PageSize: 20})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+	})
+
+	t.Run("count limit caps total", func(t *testing.T) {
+		results, total, err := repo.Search(context.Background(), port.SearchParams{
+			Page:       1,
+			PageSize:   20,
+			CountLimit: 1,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total) // En fazla CountLimit+1 sayılır
+		assert.Len(t, results, 3)
+	})
+
 	t.Run("empty query returns all", func(t *testing.T) {
 		params := port.SearchParams{
 			Query:    "",
@@ -179,6 +373,211 @@ func TestPostgresContentRepository_Search(t *testing.T) {
 		assert.Equal(t, int64(3), total)
 		assert.Len(t, results, 3)
 	})
+
+	t.Run("search vector follows title and tag changes", func(t *testing.T) {
+		ctx := context.Background()
+		search := func(query string) int64 {
+			_, total, err := repo.Search(ctx, port.SearchParams{Query: query, Page: 1, PageSize: 20})
+			require.NoError(t, err)
+			return total
+		}
+
+		require.NoError(t, repo.AddTags(ctx, content2.ID, []string{"concurrency"}))
+		assert.Equal(t, int64(1), search("concurrency"))
+
+		content2.Title = "Rust Guide"
+		require.NoError(t, repo.Upsert(ctx, content2))
+		assert.Equal(t, int64(1), search("rust"))
+		assert.Equal(t, int64(0), search("programming"))
+
+		_, err := db.Exec("DELETE FROM content_tags WHERE content_id = $1", content2.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), search("python"))
+	})
+
+	t.Run("contents are indexed and queried in their language", func(t *testing.T) {
+		ctx := context.Background()
+		turkish := &entity.Content{
+			ProviderID:        provider.ID,
+			ProviderContentID: "tr-1",
+			Title:             "Kitapların Dünyası",
+			ContentType:       entity.ContentTypeArticle,
+			Language:          entity.LanguageTurkish,
+			PublishedAt:       time.Now(),
+		}
+		require.NoError(t, repo.Upsert(ctx, turkish))
+
+		// Türkçe kök bulma: "kitaplar" ve "kitap" aynı köke iner
+		results, _, err := repo.Search(ctx, port.SearchParams{Query: "kitaplar", Page: 1, PageSize: 20})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, entity.LanguageTurkish, results[0].Language)
+
+		_, total, err := repo.Search(ctx, port.SearchParams{Query: "kitaplar", Language: entity.LanguageEnglish, Page: 1, PageSize: 20})
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), total)
+	})
+
+	t.Run("matching is accent insensitive", func(t *testing.T) {
+		ctx := context.Background()
+		content := &entity.Content{
+			ProviderID:        provider.ID,
+			ProviderContentID: "accent-1",
+			Title:             "Türkiye Gezi Rehberi",
+			ContentType:       entity.ContentTypeArticle,
+			Language:          entity.LanguageTurkish,
+			PublishedAt:       time.Now(),
+		}
+		require.NoError(t, repo.Upsert(ctx, content))
+
+		for _, query := range []string{"turkiye", "Türkiye", "TURKIYE"} {
+			results, _, err := repo.Search(ctx, port.SearchParams{Query: query, Page: 1, PageSize: 20})
+			require.NoError(t, err, query)
+			require.Len(t, results, 1, query)
+			assert.Equal(t, content.ID, results[0].ID, query)
+		}
+
+		// Kısa sorgu ve fuzzy mod da aksansız başlıkla eşleşir
+		for _, params := range []port.SearchParams{
+			{Query: "tü", Page: 1, PageSize: 20},
+			{Query: "turkye", FuzzyThreshold: 0.3, Page: 1, PageSize: 20},
+		} {
+			results, _, err := repo.Search(ctx, params)
+			require.NoError(t, err, params.Query)
+			ids := make([]int64, len(results))
+			for i, r := range results {
+				ids[i] = r.ID
+			}
+			assert.Contains(t, ids, content.ID, params.Query)
+		}
+	})
+}
+
+func TestPostgresContentRepository_SearchCountStrategy(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	separate := NewPostgresContentRepository(db)
+	window := NewPostgresContentRepositoryWithOptions(db, PostgresContentRepositoryOptions{CountStrategy: CountStrategyWindow})
+	provider := testutil.CreateTestProvider(t, db, "Test Provider", "json")
+	for i := 0; i < 5; i++ {
+		testutil.CreateTestContentWithScore(t, db, provider.ID, float64(i*10))
+	}
+
+	cases := map[string]port.SearchParams{
+		"first page":        {Page: 1, PageSize: 2},
+		"last partial page": {Page: 3, PageSize: 2},
+		"page beyond end":   {Page: 10, PageSize: 2},
+		"with cursor":       {Page: 1, PageSize: 2, Cursor: &port.SearchCursor{Score: 25, ID: 1 << 30}},
+		"no matches":        {Query: "nomatchwhatsoever", Page: 1, PageSize: 2},
+	}
+	for name, params := range cases {
+		t.Run(name, func(t *testing.T) {
+			wantContents, wantTotal, err := separate.Search(context.Background(), params)
+			require.NoError(t, err)
+			gotContents, gotTotal, err := window.Search(context.Background(), params)
+			require.NoError(t, err)
+
+			assert.Equal(t, wantTotal, gotTotal)
+			assert.Equal(t, len(wantContents), len(gotContents))
+		})
+	}
+}
+
+func TestPostgresContentRepository_GetFacets(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	repo := NewPostgresContentRepository(db)
+	provider := testutil.CreateTestProvider(t, db, "Test Provider", "json")
+
+	video := testutil.CreateTestContent(t, db, provider.ID, entity.ContentTypeVideo)
+	article := testutil.CreateTestContent(t, db, provider.ID, entity.ContentTypeArticle)
+	testutil.CreateTestContent(t, db, provider.ID, entity.ContentTypeVideo)
+
+	golangTag := testutil.CreateTestTag(t, db, "golang")
+	testutil.AddTagToContent(t, db, video.ID, golangTag.ID)
+	testutil.AddTagToContent(t, db, article.ID, golangTag.ID)
+
+	t.Run("all contents", func(t *testing.T) {
+		facets, err := repo.GetFacets(context.Background(), port.SearchParams{})
+		require.NoError(t, err)
+
+		require.Len(t, facets.ContentTypes, 2)
+		assert.Equal(t, "video", facets.ContentTypes[0].Value)
+		assert.Equal(t, int64(2), facets.ContentTypes[0].Count)
+
+		require.Len(t, facets.Providers, 1)
+		assert.Equal(t, "Test Provider", facets.Providers[0].Label)
+		assert.Equal(t, int64(3), facets.Providers[0].Count)
+
+		require.Len(t, facets.Tags, 1)
+		assert.Equal(t, "golang", facets.Tags[0].Value)
+		assert.Equal(t, int64(2), facets.Tags[0].Count)
+	})
+
+	t.Run("facets respect filters", func(t *testing.T) {
+		facets, err := repo.GetFacets(context.Background(), port.SearchParams{ContentType: entity.ContentTypeArticle})
+		require.NoError(t, err)
+
+		require.Len(t, facets.ContentTypes, 1)
+		assert.Equal(t, int64(1), facets.ContentTypes[0].Count)
+		assert.Equal(t, int64(1), facets.Tags[0].Count)
+	})
+}
+
+func TestPostgresContentRepository_FindSimilar(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	repo := NewPostgresContentRepository(db)
+	provider := testutil.CreateTestProvider(t, db, "Test Provider", "json")
+
+	source := testutil.CreateTestContentWithScore(t, db, provider.ID, 50.0)
+	sharedTag := testutil.CreateTestContentWithScore(t, db, provider.ID, 10.0)
+	sharedTitle := testutil.CreateTestContentWithScore(t, db, provider.ID, 20.0)
+	unrelated := testutil.CreateTestContentWithScore(t, db, provider.ID, 90.0)
+
+	titles := map[int64]string{
+		source.ID:      "Golang concurrency patterns",
+		sharedTag.ID:   "Building web servers",
+		sharedTitle.ID: "Concurrency in practice",
+		unrelated.ID:   "Cooking pasta at home",
+	}
+	for id, title := range titles {
+		_, err := db.Exec("UPDATE contents SET title = $1 WHERE id = $2", title, id)
+		require.NoError(t, err)
+	}
+
+	golangTag := testutil.CreateTestTag(t, db, "golang")
+	testutil.AddTagToContent(t, db, source.ID, golangTag.ID)
+	testutil.AddTagToContent(t, db, sharedTag.ID, golangTag.ID)
+
+	t.Run("returns related contents", func(t *testing.T) {
+		results, err := repo.FindSimilar(context.Background(), source.ID, 10)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+
+		// Ortak tag ağırlığı başlık benzerliğinden yüksek
+		assert.Equal(t, sharedTag.ID, results[0].ID)
+		assert.Equal(t, sharedTitle.ID, results[1].ID)
+		for _, result := range results {
+			assert.NotEqual(t, source.ID, result.ID)
+			assert.NotEqual(t, unrelated.ID, result.ID)
+			assert.Greater(t, result.RelevanceScore, 0.0)
+		}
+	})
+
+	t.Run("respects limit", func(t *testing.T) {
+		results, err := repo.FindSimilar(context.Background(), source.ID, 1)
+		require.NoError(t, err)
+		assert.Len(t, results, 1)
+	})
+
+	t.Run("unknown content", func(t *testing.T) {
+		_, err := repo.FindSimilar(context.Background(), 999999, 10)
+		assert.ErrorIs(t, err, port.ErrContentNotFound)
+	})
 }
 
 func TestPostgresContentRepository_CreateOrUpdateStats(t *testing.T) {
@@ -206,11 +605,13 @@ func TestPostgresContentRepository_CreateOrUpdateStats(t *testing.T) {
 
 	t.Run("update stats", func(t *testing.T) {
 		stats := &entity.ContentStats{
-			ContentID:   content.ID,
-			Views:       20000,
-			Likes:       1000,
-			ReadingTime: 0,
-			Reactions:   0,
+			ContentID:       content.ID,
+			Views:           20000,
+			Likes:           1000,
+			ReadingTime:     0,
+			Reactions:       0,
+			DurationSeconds: 1885,
+			Comments:        47,
 		}
 
 		err := repo.CreateOrUpdateStats(context.Background(), stats)
@@ -221,6 +622,8 @@ func TestPostgresContentRepository_CreateOrUpdateStats(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, int64(20000), found.Stats.Views)
 		assert.Equal(t, int32(1000), found.Stats.Likes)
+		assert.Equal(t, int32(1885), found.Stats.DurationSeconds)
+		assert.Equal(t, int32(47), found.Stats.Comments)
 	})
 }
 
@@ -299,6 +702,29 @@ func TestPostgresContentRepository_AddTags(t *testing.T) {
 		require.NoError(t, err)
 		assert.Len(t, found.Tags, 4)
 	})
+
+	t.Run("normalizes and dedupes tags within a call", func(t *testing.T) {
+		tags := []string{" Docker ", "docker", "DOCKER", "golang"}
+
+		err := repo.AddTags(context.Background(), content.ID, tags)
+		require.NoError(t, err)
+
+		found, err := repo.FindByID(context.Background(), content.ID)
+		require.NoError(t, err)
+		assert.Len(t, found.Tags, 5)
+	})
+
+	t.Run("skips blank tags", func(t *testing.T) {
+		err := repo.AddTags(context.Background(), content.ID, []string{"  ", "", "\t"})
+		require.NoError(t, err)
+
+		found, err := repo.FindByID(context.Background(), content.ID)
+		require.NoError(t, err)
+		assert.Len(t, found.Tags, 5)
+		for _, tag := range found.Tags {
+			assert.NotEmpty(t, tag.Name)
+		}
+	})
 }
 
 func TestPostgresContentRepository_MarkStaleContentsAsDeleted(t *testing.T) {
@@ -389,3 +815,274 @@ func TestPostgresContentRepository_FindByID(t *testing.T) {
 		assert.Nil(t, found)
 	})
 }
+
+func TestPostgresContentRepository_FindProviderContentIDs(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	repo := NewPostgresContentRepository(db)
+	provider := testutil.CreateTestProvider(t, db, "Test Provider", "json")
+	other := testutil.CreateTestProvider(t, db, "Other Provider", "xml")
+
+	active := testutil.CreateTestContent(t, db, provider.ID, entity.ContentTypeVideo)
+	deleted := testutil.CreateTestContent(t, db, provider.ID, entity.ContentTypeArticle)
+	testutil.CreateTestContent(t, db, other.ID, entity.ContentTypeVideo)
+
+	_, err := db.Exec("UPDATE contents SET deleted = 1 WHERE id = $1", deleted.ID)
+	require.NoError(t, err)
+
+	ids, err := repo.FindProviderContentIDs(context.Background(), provider.ID)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{
+		active.ProviderContentID:  false,
+		deleted.ProviderContentID: true,
+	}, ids)
+}
+
+func TestPostgresContentRepository_BulkUpsert(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	repo := NewPostgresContentRepository(db)
+	provider := testutil.CreateTestProvider(t, db, "Test Provider", "json")
+	ctx := context.Background()
+
+	existing := testutil.CreateTestContent(t, db, provider.ID, entity.ContentTypeVideo)
+
+	contents := []*entity.Content{
+		{
+			ProviderID:        provider.ID,
+			ProviderContentID: existing.ProviderContentID,
+			Title:             "Updated Title",
+			ContentType:       entity.ContentTypeVideo,
+			PublishedAt:       time.Now(),
+			URL:               "https://example.com/videos/1",
+			ThumbnailURL:      "https://example.com/thumbs/1.jpg",
+		},
+		{
+			ProviderID:        provider.ID,
+			ProviderContentID: "bulk-new",
+			Title:             "New Content",
+			ContentType:       entity.ContentTypeArticle,
+			PublishedAt:       time.Now(),
+		},
+		{
+			ProviderID:        provider.ID,
+			ProviderContentID: "bulk-new",
+			Title:             "New Content (duplicate)",
+			ContentType:       entity.ContentTypeArticle,
+			PublishedAt:       time.Now(),
+		},
+	}
+
+	require.NoError(t, repo.BulkUpsert(ctx, contents))
+	assert.Equal(t, existing.ID, contents[0].ID)
+	assert.NotZero(t, contents[1].ID)
+	assert.Equal(t, contents[1].ID, contents[2].ID)
+
+	found, err := repo.FindByID(ctx, existing.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Updated Title", found.Title)
+	assert.Equal(t, "https://example.com/videos/1", found.URL)
+	assert.Equal(t, "https://example.com/thumbs/1.jpg", found.ThumbnailURL)
+
+	found, err = repo.FindByID(ctx, contents[1].ID)
+	require.NoError(t, err)
+	assert.Equal(t, "New Content (duplicate)", found.Title)
+	assert.Empty(t, found.URL)
+
+	t.Run("bulk stats and scores", func(t *testing.T) {
+		stats := []*entity.ContentStats{
+			{ContentID: contents[0].ID, Views: 100, Likes: 10},
+			{ContentID: contents[1].ID, ReadingTime: 5, Reactions: 20},
+		}
+		require.NoError(t, repo.BulkCreateOrUpdateStats(ctx, stats))
+		assert.NotZero(t, stats[0].ID)
+		assert.NotZero(t, stats[1].ID)
+
+		scores := []*entity.ContentScore{
+			{ContentID: contents[0].ID, BaseScore: 10, FinalScore: 15.5},
+			{ContentID: contents[1].ID, BaseScore: 5, FinalScore: 7.25},
+		}
+		require.NoError(t, repo.BulkCreateOrUpdateScores(ctx, scores))
+		assert.NotZero(t, scores[0].ID)
+
+		// İkinci yazım mevcut kayıtları güncellemeli
+		stats[0].Views = 200
+		require.NoError(t, repo.BulkCreateOrUpdateStats(ctx, stats[:1]))
+
+		found, err := repo.FindByID(ctx, contents[0].ID)
+		require.NoError(t, err)
+		require.NotNil(t, found.Stats)
+		assert.Equal(t, int64(200), found.Stats.Views)
+		require.NotNil(t, found.Score)
+		assert.Equal(t, 15.5, found.Score.FinalScore)
+	})
+}
+
+func TestPostgresContentRepository_Duplicates(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	repo := NewPostgresContentRepository(db)
+	ctx := context.Background()
+	provider1 := testutil.CreateTestProvider(t, db, "Provider 1", "json")
+	provider2 := testutil.CreateTestProvider(t, db, "Provider 2", "xml")
+
+	original := testutil.CreateTestContentWithScore(t, db, provider1.ID, 50.0)
+	unrelated := testutil.CreateTestContentWithScore(t, db, provider1.ID, 40.0)
+	since := time.Now().Add(-time.Second)
+	duplicate := testutil.CreateTestContentWithScore(t, db, provider2.ID, 30.0)
+
+	titles := map[int64]string{
+		original.ID:  "Docker Basics for Beginners",
+		unrelated.ID: "Cooking pasta at home",
+		duplicate.ID: "Docker basics for beginners!",
+	}
+	for id, title := range titles {
+		_, err := db.Exec("UPDATE contents SET title = $1 WHERE id = $2", title, id)
+		require.NoError(t, err)
+	}
+
+	t.Run("finds candidates from other providers", func(t *testing.T) {
+		candidates, err := repo.FindDuplicateCandidates(ctx, provider2.ID, since, 48*time.Hour)
+		require.NoError(t, err)
+		require.Len(t, candidates, 1)
+		assert.Equal(t, duplicate.ID, candidates[0].ContentID)
+		assert.Equal(t, original.ID, candidates[0].CandidateID)
+		assert.Equal(t, original.ID, candidates[0].CandidateCanonicalID)
+	})
+
+	t.Run("links and collapses duplicates in search", func(t *testing.T) {
+		require.NoError(t, repo.LinkDuplicates(ctx, map[int64]int64{duplicate.ID: original.ID, original.ID: original.ID}))
+
+		found, err := repo.FindByID(ctx, duplicate.ID)
+		require.NoError(t, err)
+		require.NotNil(t, found.CanonicalContentID)
+		assert.Equal(t, original.ID, *found.CanonicalContentID)
+
+		params := port.SearchParams{Page: 1, PageSize: 10, SortBy: "popularity"}
+		_, total, err := repo.Search(ctx, params)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+
+		params.CollapseDuplicates = true
+		results, total, err := repo.Search(ctx, params)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		for _, result := range results {
+			assert.NotEqual(t, duplicate.ID, result.ID)
+		}
+	})
+
+	t.Run("shows duplicates whose canonical content is deleted", func(t *testing.T) {
+		_, err := db.Exec("UPDATE contents SET deleted = 1 WHERE id = $1", original.ID)
+		require.NoError(t, err)
+
+		_, total, err := repo.Search(ctx, port.SearchParams{Page: 1, PageSize: 10, SortBy: "popularity", CollapseDuplicates: true})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+	})
+
+	t.Run("re-roots members when canonical content changes", func(t *testing.T) {
+		require.NoError(t, repo.LinkDuplicates(ctx, map[int64]int64{original.ID: unrelated.ID}))
+
+		var canonicalID int64
+		require.NoError(t, db.QueryRow("SELECT canonical_content_id FROM contents WHERE id = $1", duplicate.ID).Scan(&canonicalID))
+		assert.Equal(t, unrelated.ID, canonicalID)
+	})
+}
+
+func TestPostgresContentRepository_FindContentsForScoring(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	repo := NewPostgresContentRepository(db)
+	ctx := context.Background()
+	provider := testutil.CreateTestProvider(t, db, "Provider 1", "json")
+
+	first := testutil.CreateTestContentWithStats(t, db, provider.ID, entity.ContentTypeVideo, 1000, 10)
+	second := testutil.CreateTestContentWithStats(t, db, provider.ID, entity.ContentTypeArticle, 0, 0)
+	testutil.CreateTestContent(t, db, provider.ID, entity.ContentTypeVideo) // istatistiği yok
+	deleted := testutil.CreateTestContentWithStats(t, db, provider.ID, entity.ContentTypeVideo, 10, 1)
+	_, err := db.Exec("UPDATE contents SET deleted = 1 WHERE id = $1", deleted.ID)
+	require.NoError(t, err)
+
+	t.Run("pages by id", func(t *testing.T) {
+		contents, err := repo.FindContentsForScoring(ctx, 0, 1)
+		require.NoError(t, err)
+		require.Len(t, contents, 1)
+		assert.Equal(t, first.ID, contents[0].ID)
+		assert.Equal(t, entity.ContentTypeVideo, contents[0].ContentType)
+		require.NotNil(t, contents[0].Stats)
+		assert.Equal(t, int64(1000), contents[0].Stats.Views)
+
+		contents, err = repo.FindContentsForScoring(ctx, first.ID, 10)
+		require.NoError(t, err)
+		require.Len(t, contents, 1, "contents without stats and deleted contents should be skipped")
+		assert.Equal(t, second.ID, contents[0].ID)
+	})
+
+	t.Run("loads CTR lift", func(t *testing.T) {
+		_, err := db.Exec("INSERT INTO content_scores (content_id, final_score, ctr_lift) VALUES ($1, 0, 1.25)", first.ID)
+		require.NoError(t, err)
+
+		contents, err := repo.FindContentsForScoring(ctx, 0, 1)
+		require.NoError(t, err)
+		require.Len(t, contents, 1)
+		require.NotNil(t, contents[0].Score)
+		assert.Equal(t, 1.25, contents[0].Score.CTRLift)
+	})
+}
+
+func TestIsShortQuery(t *testing.T) {
+	assert.True(t, isShortQuery("g"))
+	assert.True(t, isShortQuery(" go "))
+	assert.True(t, isShortQuery("çö"))
+	assert.False(t, isShortQuery(""))
+	assert.False(t, isShortQuery("go lang"))
+	assert.False(t, isShortQuery("a b"))
+	assert.False(t, isShortQuery("gol"))
+}
+
+func TestBuildSearchFilter_ShortQuery(t *testing.T) {
+	f := buildSearchFilter(port.SearchParams{Query: "C%", FuzzyThreshold: 0.3})
+
+	assert.Equal(t, "c%", f.query)
+	assert.Equal(t, []interface{}{"c%", `\mc%`, `c\%%`}, f.args)
+	assert.Contains(t, f.where, "immutable_unaccent(c.title) ~* immutable_unaccent($2)")
+	assert.NotContains(t, f.where, "to_tsquery")
+	assert.Equal(t, "similarity(immutable_unaccent(c.title), immutable_unaccent($1))", f.relevance)
+}
+
+func TestBuildSearchFilter_Fuzzy(t *testing.T) {
+	f := buildSearchFilter(port.SearchParams{Query: " Golang Tutoral ", FuzzyThreshold: 0.4})
+
+	assert.Equal(t, "golang tutoral", f.query)
+	assert.Equal(t, []interface{}{"golang tutoral"}, f.args)
+	assert.Contains(t, f.where, "immutable_unaccent(c.title) % immutable_unaccent($1)")
+	assert.NotContains(t, f.where, "similarity(")
+	assert.Equal(t, 0.4, f.similarityThreshold)
+	assert.Equal(t, "similarity(immutable_unaccent(c.title), immutable_unaccent($1))", f.relevance)
+}
+
+func TestBuildSearchFilter_Language(t *testing.T) {
+	t.Run("query is processed in every supported language", func(t *testing.T) {
+		f := buildSearchFilter(port.SearchParams{Query: "Eşzamanlı programlama"})
+
+		assert.Equal(t, "Eşzamanlı:* & programlama:*", f.query)
+		for _, language := range entity.SupportedLanguages {
+			assert.Contains(t, f.where, "to_tsquery('"+language+"', immutable_unaccent($1))")
+		}
+		assert.NotContains(t, f.where, "c.language")
+	})
+
+	t.Run("language restricts contents and query processing", func(t *testing.T) {
+		f := buildSearchFilter(port.SearchParams{Query: "kitap", Language: entity.LanguageTurkish})
+
+		assert.Contains(t, f.where, "c.search_vector @@ (to_tsquery('turkish', immutable_unaccent($1)))")
+		assert.NotContains(t, f.where, "'english'")
+		assert.Contains(t, f.where, "c.language = $2")
+		assert.Equal(t, []interface{}{"kitap:*", entity.LanguageTurkish}, f.args)
+	})
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// postgresContentVersionRepository PostgreSQL ile ContentVersionRepository implementasyonu
+// Versiyonlar content_versions trigger'larıyla yazılır (bkz. 023_create_content_versions)
+type postgresContentVersionRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresContentVersionRepository yeni bir PostgreSQL içerik versiyon repository oluşturur
+func NewPostgresContentVersionRepository(db *sql.DB) port.ContentVersionRepository {
+	return &postgresContentVersionRepository{db: db}
+}
+
+// ListContentVersions içeriğin önceki hallerini en yeniden eskiye sayfalı getirir
+func (r *postgresContentVersionRepository) ListContentVersions(ctx context.Context, contentID int64, limit, offset int) ([]*entity.ContentVersion, int64, error) {
+	var total int64
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM content_versions WHERE content_id = $1", contentID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count content versions: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, content_id, title, COALESCE(description, ''),
+		       views, likes, reading_time, reactions, replaced_at
+		FROM content_versions
+		WHERE content_id = $1
+		ORDER BY id DESC
+		LIMIT $2 OFFSET $3
+	`, contentID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list content versions: %w", err)
+	}
+	defer rows.Close()
+
+	var versions []*entity.ContentVersion
+	for rows.Next() {
+		v := &entity.ContentVersion{}
+		var views sql.NullInt64
+		var likes, readingTime, reactions sql.NullInt32
+		if err := rows.Scan(&v.ID, &v.ContentID, &v.Title, &v.Description,
+			&views, &likes, &readingTime, &reactions, &v.ReplacedAt); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan content version: %w", err)
+		}
+		if views.Valid {
+			v.Stats = &entity.ContentVersionStats{
+				Views:       views.Int64,
+				Likes:       likes.Int32,
+				ReadingTime: readingTime.Int32,
+				Reactions:   reactions.Int32,
+			}
+		}
+		versions = append(versions, v)
+	}
+
+	return versions, total, rows.Err()
+}
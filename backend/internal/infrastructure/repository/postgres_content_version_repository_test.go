@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/testutil"
+)
+
+func TestPostgresContentVersionRepository(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	contentRepo := NewPostgresContentRepository(db)
+	versionRepo := NewPostgresContentVersionRepository(db)
+	transactor := NewPostgresTransactor(db)
+	provider := testutil.CreateTestProvider(t, db, "Test Provider", "json")
+	ctx := context.Background()
+
+	content := &entity.Content{
+		ProviderID:        provider.ID,
+		ProviderContentID: "version-1",
+		Title:             "First Title",
+		Description:       "First description",
+		ContentType:       entity.ContentTypeVideo,
+		PublishedAt:       time.Now(),
+	}
+	require.NoError(t, contentRepo.Upsert(ctx, content))
+	require.NoError(t, contentRepo.CreateOrUpdateStats(ctx, &entity.ContentStats{ContentID: content.ID, Views: 100, Likes: 10}))
+
+	// sync gibi içerik ve istatistikleri tek transaction'da günceller
+	update := func(title string, views int64) {
+		t.Helper()
+		content.Title = title
+		require.NoError(t, transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			if err := contentRepo.Upsert(ctx, content); err != nil {
+				return err
+			}
+			return contentRepo.CreateOrUpdateStats(ctx, &entity.ContentStats{ContentID: content.ID, Views: views, Likes: 10})
+		}))
+	}
+
+	t.Run("creation and unchanged updates keep no versions", func(t *testing.T) {
+		update("First Title", 100)
+
+		versions, total, err := versionRepo.ListContentVersions(ctx, content.ID, 20, 0)
+		require.NoError(t, err)
+		assert.Zero(t, total)
+		assert.Empty(t, versions)
+	})
+
+	t.Run("one version per transaction with the previous title and stats", func(t *testing.T) {
+		update("Second Title", 5000)
+		update("Second Title", 9000)
+
+		versions, total, err := versionRepo.ListContentVersions(ctx, content.ID, 20, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		require.Len(t, versions, 2)
+
+		// En yeniden eskiye: stats sıçramasından önceki hal, ardından başlık değişikliğinden önceki hal
+		assert.Equal(t, "Second Title", versions[0].Title)
+		require.NotNil(t, versions[0].Stats)
+		assert.Equal(t, int64(5000), versions[0].Stats.Views)
+
+		assert.Equal(t, "First Title", versions[1].Title)
+		assert.Equal(t, "First description", versions[1].Description)
+		require.NotNil(t, versions[1].Stats)
+		assert.Equal(t, int64(100), versions[1].Stats.Views)
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		versions, total, err := versionRepo.ListContentVersions(ctx, content.ID, 1, 1)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		require.Len(t, versions, 1)
+		assert.Equal(t, "First Title", versions[0].Title)
+	})
+}
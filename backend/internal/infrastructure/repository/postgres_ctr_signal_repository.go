@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// postgresCTRSignalRepository PostgreSQL ile CTRSignalRepository implementasyonu
+// Olaylar search_events'ten okunur, sinyal content_scores.ctr_lift'te tutulur
+type postgresCTRSignalRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresCTRSignalRepository yeni bir PostgreSQL CTR sinyali repository oluşturur
+func NewPostgresCTRSignalRepository(db *sql.DB) port.CTRSignalRepository {
+	return &postgresCTRSignalRepository{db: db}
+}
+
+// AggregateContentCTR gösterimleri örnekleme ağırlıklarıyla, tıklamaları adetle toplar
+func (r *postgresCTRSignalRepository) AggregateContentCTR(ctx context.Context, since time.Time) ([]*entity.ContentCTR, error) {
+	query := `
+		SELECT content_id,
+			ROUND(COALESCE(SUM(sample_weight) FILTER (WHERE event_type = 'impression'), 0))::bigint AS impressions,
+			COUNT(*) FILTER (WHERE event_type = 'click') AS clicks
+		FROM search_events
+		WHERE created_at >= $1
+		GROUP BY content_id
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate content CTR: %w", err)
+	}
+	defer rows.Close()
+
+	stats := make([]*entity.ContentCTR, 0)
+	for rows.Next() {
+		s := &entity.ContentCTR{}
+		if err := rows.Scan(&s.ContentID, &s.Impressions, &s.Clicks); err != nil {
+			return nil, fmt.Errorf("failed to scan content CTR: %w", err)
+		}
+		if s.Impressions > 0 {
+			s.CTR = float64(s.Clicks) / float64(s.Impressions)
+		}
+		stats = append(stats, s)
+	}
+
+	return stats, rows.Err()
+}
+
+// ReplaceCTRLifts tüm sinyalleri tek sorguda günceller
+// Sadece değeri değişen satırlar yazılır; content_scores audit trigger'ı değişmeyen içerikler için kayıt üretmez
+func (r *postgresCTRSignalRepository) ReplaceCTRLifts(ctx context.Context, lifts map[int64]float64) (int, error) {
+	contentIDs := make([]int64, 0, len(lifts))
+	values := make([]float64, 0, len(lifts))
+	for id, lift := range lifts {
+		contentIDs = append(contentIDs, id)
+		values = append(values, lift)
+	}
+
+	query := `
+		UPDATE content_scores csc
+		SET ctr_lift = COALESCE(l.lift, 0)
+		FROM content_scores cur
+		LEFT JOIN unnest($1::int[], $2::float8[]) AS l(content_id, lift) ON l.content_id = cur.content_id
+		WHERE csc.content_id = cur.content_id
+			AND csc.ctr_lift IS DISTINCT FROM COALESCE(l.lift, 0)
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, pq.Array(contentIDs), pq.Array(values))
+	if err != nil {
+		return 0, fmt.Errorf("failed to replace CTR lifts: %w", err)
+	}
+
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(updated), nil
+}
+
+// FindCTRLifts sıfırdan farklı sinyalleri tek sorguda getirir
+func (r *postgresCTRSignalRepository) FindCTRLifts(ctx context.Context, contentIDs []int64) (map[int64]float64, error) {
+	lifts := make(map[int64]float64)
+	if len(contentIDs) == 0 {
+		return lifts, nil
+	}
+
+	query := `
+		SELECT content_id, ctr_lift
+		FROM content_scores
+		WHERE content_id = ANY($1) AND ctr_lift > 0
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, pq.Array(contentIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find CTR lifts: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int64
+		var lift float64
+		if err := rows.Scan(&id, &lift); err != nil {
+			return nil, fmt.Errorf("failed to scan CTR lift: %w", err)
+		}
+		lifts[id] = lift
+	}
+
+	return lifts, rows.Err()
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/testutil"
+)
+
+func TestPostgresCTRSignalRepository(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	contentRepo := NewPostgresContentRepository(db)
+	eventRepo := NewPostgresSearchEventRepository(db)
+	repo := NewPostgresCTRSignalRepository(db)
+	provider := testutil.CreateTestProvider(t, db, "CTR Provider", "json")
+	ctx := context.Background()
+
+	clicked := &entity.Content{ProviderID: provider.ID, ProviderContentID: "clicked", Title: "Go Concurrency", ContentType: entity.ContentTypeVideo, PublishedAt: time.Now()}
+	ignored := &entity.Content{ProviderID: provider.ID, ProviderContentID: "ignored", Title: "Rust Ownership", ContentType: entity.ContentTypeArticle, PublishedAt: time.Now()}
+	require.NoError(t, contentRepo.BulkUpsert(ctx, []*entity.Content{clicked, ignored}))
+	require.NoError(t, contentRepo.BulkCreateOrUpdateScores(ctx, []*entity.ContentScore{
+		{ContentID: clicked.ID, FinalScore: 10},
+		{ContentID: ignored.ID, FinalScore: 10},
+	}))
+
+	_, err := eventRepo.InsertSearchEvents(ctx, []*entity.SearchEvent{
+		{Type: entity.SearchEventImpression, ContentID: clicked.ID, SampleWeight: 4},
+		{Type: entity.SearchEventClick, ContentID: clicked.ID, SampleWeight: 1},
+		{Type: entity.SearchEventImpression, ContentID: ignored.ID, SampleWeight: 1},
+	})
+	require.NoError(t, err)
+
+	t.Run("aggregates all contents", func(t *testing.T) {
+		stats, err := repo.AggregateContentCTR(ctx, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		require.Len(t, stats, 2)
+
+		byID := make(map[int64]*entity.ContentCTR)
+		for _, s := range stats {
+			byID[s.ContentID] = s
+		}
+		assert.Equal(t, int64(4), byID[clicked.ID].Impressions)
+		assert.Equal(t, int64(1), byID[clicked.ID].Clicks)
+		assert.InDelta(t, 0.25, byID[clicked.ID].CTR, 0.0001)
+		assert.Zero(t, byID[ignored.ID].Clicks)
+	})
+
+	t.Run("replace sets and clears lifts", func(t *testing.T) {
+		updated, err := repo.ReplaceCTRLifts(ctx, map[int64]float64{clicked.ID: 1.5, ignored.ID: 0.5})
+		require.NoError(t, err)
+		assert.Equal(t, 2, updated)
+
+		// Değişmeyen sinyaller yeniden yazılmaz
+		updated, err = repo.ReplaceCTRLifts(ctx, map[int64]float64{clicked.ID: 1.5, ignored.ID: 0.5})
+		require.NoError(t, err)
+		assert.Zero(t, updated)
+
+		updated, err = repo.ReplaceCTRLifts(ctx, map[int64]float64{clicked.ID: 1.5})
+		require.NoError(t, err)
+		assert.Equal(t, 1, updated)
+
+		lifts, err := repo.FindCTRLifts(ctx, []int64{clicked.ID, ignored.ID})
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]float64{clicked.ID: 1.5}, lifts)
+	})
+
+	t.Run("score writes keep lift", func(t *testing.T) {
+		require.NoError(t, contentRepo.BulkCreateOrUpdateScores(ctx, []*entity.ContentScore{{ContentID: clicked.ID, CTRScore: 2, FinalScore: 12}}))
+
+		content, err := contentRepo.FindByID(ctx, clicked.ID)
+		require.NoError(t, err)
+		require.NotNil(t, content.Score)
+		assert.Equal(t, 1.5, content.Score.CTRLift)
+		assert.Equal(t, 2.0, content.Score.CTRScore)
+	})
+}
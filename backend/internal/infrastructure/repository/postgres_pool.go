@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// PostgresPoolOptions bağlantı havuzu, TLS ve oturum ayarları
+// Boş/sıfır bağlantı ayarları URL'de verilen değeri (veya sürücü varsayılanını) korur
+type PostgresPoolOptions struct {
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration // 0 ise boştaki bağlantılar ConnMaxLifetime'a kadar tutulur
+
+	SSLMode          string        // disable, require, verify-ca veya verify-full
+	SSLRootCert      string        // verify-ca/verify-full için CA dosyası
+	SSLCert          string        // Karşılıklı TLS için istemci sertifikası
+	SSLKey           string        // İstemci sertifikasının anahtarı
+	StatementTimeout time.Duration // Sunucu tarafında sorgu başına süre sınırı
+	ApplicationName  string        // pg_stat_activity'de görünen ad
+}
+
+// OpenPostgres ayarları bağlantı URL'ine ekleyip bağlantı havuzunu açar
+// Havuzdaki tüm sorgular database_queries_total ve database_query_duration_seconds metriklerine yazılır.
+// Bağlantı kurulmaz; erişilebilirlik Ping ile kontrol edilmelidir
+func OpenPostgres(rawURL string, opts PostgresPoolOptions) (*sql.DB, error) {
+	dsn, err := postgresDSN(rawURL, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	connector, err := pq.NewConnector(dsn)
+	if err != nil {
+		return nil, err
+	}
+	db := sql.OpenDB(withQueryMetrics(connector))
+
+	db.SetMaxOpenConns(opts.MaxOpenConns)
+	db.SetMaxIdleConns(opts.MaxIdleConns)
+	db.SetConnMaxLifetime(opts.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(opts.ConnMaxIdleTime)
+
+	return db, nil
+}
+
+// postgresDSN TLS ve oturum ayarlarını URL'in query parametrelerine yazar
+// lib/pq statement_timeout ve application_name'i bağlantı açılırken oturum parametresi olarak gönderir
+func postgresDSN(rawURL string, opts PostgresPoolOptions) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "postgres" && u.Scheme != "postgresql") {
+		return "", fmt.Errorf("geçersiz veritabanı URL'i (postgres:// bekleniyor)")
+	}
+
+	query := u.Query()
+	set := func(key, value string) {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+	set("sslmode", opts.SSLMode)
+	set("sslrootcert", opts.SSLRootCert)
+	set("sslcert", opts.SSLCert)
+	set("sslkey", opts.SSLKey)
+	set("application_name", opts.ApplicationName)
+	if opts.StatementTimeout > 0 {
+		set("statement_timeout", strconv.FormatInt(opts.StatementTimeout.Milliseconds(), 10))
+	}
+
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
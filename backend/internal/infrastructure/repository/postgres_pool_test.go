@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresDSN(t *testing.T) {
+	t.Run("options override URL parameters", func(t *testing.T) {
+		dsn, err := postgresDSN("postgres://app:secret@db:5432/search_engine?sslmode=disable&connect_timeout=5", PostgresPoolOptions{
+			SSLMode:          "verify-full",
+			SSLRootCert:      "/etc/ssl/ca.pem",
+			SSLCert:          "/etc/ssl/client.pem",
+			SSLKey:           "/etc/ssl/client.key",
+			StatementTimeout: 30 * time.Second,
+			ApplicationName:  "search-engine",
+		})
+		require.NoError(t, err)
+
+		u, err := url.Parse(dsn)
+		require.NoError(t, err)
+		assert.Equal(t, "app", u.User.Username())
+		assert.Equal(t, "/search_engine", u.Path)
+
+		query := u.Query()
+		assert.Equal(t, "verify-full", query.Get("sslmode"))
+		assert.Equal(t, "/etc/ssl/ca.pem", query.Get("sslrootcert"))
+		assert.Equal(t, "/etc/ssl/client.pem", query.Get("sslcert"))
+		assert.Equal(t, "/etc/ssl/client.key", query.Get("sslkey"))
+		assert.Equal(t, "30000", query.Get("statement_timeout"))
+		assert.Equal(t, "search-engine", query.Get("application_name"))
+		assert.Equal(t, "5", query.Get("connect_timeout"))
+	})
+
+	t.Run("empty options keep URL", func(t *testing.T) {
+		dsn, err := postgresDSN("postgres://localhost/search_engine?sslmode=require", PostgresPoolOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "postgres://localhost/search_engine?sslmode=require", dsn)
+	})
+
+	t.Run("invalid URL", func(t *testing.T) {
+		_, err := postgresDSN("host=localhost dbname=search_engine", PostgresPoolOptions{})
+		assert.Error(t, err)
+	})
+}
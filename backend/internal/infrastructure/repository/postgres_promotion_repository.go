@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// postgresPromotionRepository PostgreSQL ile PromotionRepository implementasyonu
+type postgresPromotionRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresPromotionRepository yeni bir PostgreSQL sabitleme repository oluşturur
+func NewPostgresPromotionRepository(db *sql.DB) port.PromotionRepository {
+	return &postgresPromotionRepository{db: db}
+}
+
+// FindPromotionByQuery sorgunun sabitlemesini getirir, yoksa nil döner
+func (r *postgresPromotionRepository) FindPromotionByQuery(ctx context.Context, query string) (*entity.Promotion, error) {
+	promotion, err := scanPromotion(r.db.QueryRowContext(ctx, `
+		SELECT id, query, content_ids, created_at, updated_at
+		FROM promotions
+		WHERE query = $1
+	`, query))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find promotion: %w", err)
+	}
+
+	return promotion, nil
+}
+
+// ListPromotions tüm sabitlemeleri sorgu sırasıyla getirir
+func (r *postgresPromotionRepository) ListPromotions(ctx context.Context) ([]*entity.Promotion, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, query, content_ids, created_at, updated_at
+		FROM promotions
+		ORDER BY query
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list promotions: %w", err)
+	}
+	defer rows.Close()
+
+	promotions := make([]*entity.Promotion, 0)
+	for rows.Next() {
+		promotion, err := scanPromotion(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan promotion: %w", err)
+		}
+		promotions = append(promotions, promotion)
+	}
+
+	return promotions, rows.Err()
+}
+
+// SavePromotion sorgu için sabitlemeyi oluşturur veya içerik listesini değiştirir
+func (r *postgresPromotionRepository) SavePromotion(ctx context.Context, promotion *entity.Promotion) error {
+	query := `
+		INSERT INTO promotions (query, content_ids, created_at, updated_at)
+		VALUES ($1, $2, NOW(), NOW())
+		ON CONFLICT (query) DO UPDATE SET
+			content_ids = EXCLUDED.content_ids,
+			updated_at = EXCLUDED.updated_at
+		RETURNING id, created_at, updated_at
+	`
+
+	if err := r.db.QueryRowContext(ctx, query, promotion.Query, pq.Array(promotion.ContentIDs)).Scan(
+		&promotion.ID, &promotion.CreatedAt, &promotion.UpdatedAt,
+	); err != nil {
+		return fmt.Errorf("failed to save promotion: %w", err)
+	}
+
+	return nil
+}
+
+// DeletePromotion sabitlemeyi siler
+func (r *postgresPromotionRepository) DeletePromotion(ctx context.Context, id int64) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM promotions WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete promotion: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete promotion: %w", err)
+	}
+	if affected == 0 {
+		return port.ErrPromotionNotFound
+	}
+
+	return nil
+}
+
+// scanPromotion tek bir sabitleme satırını okur
+func scanPromotion(row rowScanner) (*entity.Promotion, error) {
+	promotion := &entity.Promotion{}
+	if err := row.Scan(
+		&promotion.ID, &promotion.Query, pq.Array(&promotion.ContentIDs),
+		&promotion.CreatedAt, &promotion.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return promotion, nil
+}
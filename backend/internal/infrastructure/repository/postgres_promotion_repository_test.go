@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/testutil"
+)
+
+func TestPostgresPromotionRepository(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	repo := NewPostgresPromotionRepository(db)
+	ctx := context.Background()
+
+	t.Run("missing promotion", func(t *testing.T) {
+		promotion, err := repo.FindPromotionByQuery(ctx, "golang")
+		require.NoError(t, err)
+		assert.Nil(t, promotion)
+	})
+
+	t.Run("save, replace and find", func(t *testing.T) {
+		promotion := &entity.Promotion{Query: "golang", ContentIDs: []int64{3, 1}}
+		require.NoError(t, repo.SavePromotion(ctx, promotion))
+		assert.NotZero(t, promotion.ID)
+
+		replaced := &entity.Promotion{Query: "golang", ContentIDs: []int64{2}}
+		require.NoError(t, repo.SavePromotion(ctx, replaced))
+		assert.Equal(t, promotion.ID, replaced.ID)
+
+		found, err := repo.FindPromotionByQuery(ctx, "golang")
+		require.NoError(t, err)
+		require.NotNil(t, found)
+		assert.Equal(t, []int64{2}, found.ContentIDs)
+
+		promotions, err := repo.ListPromotions(ctx)
+		require.NoError(t, err)
+		assert.Len(t, promotions, 1)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		found, err := repo.FindPromotionByQuery(ctx, "golang")
+		require.NoError(t, err)
+		require.NoError(t, repo.DeletePromotion(ctx, found.ID))
+		assert.ErrorIs(t, repo.DeletePromotion(ctx, found.ID), port.ErrPromotionNotFound)
+	})
+}
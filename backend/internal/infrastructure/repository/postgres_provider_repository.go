@@ -0,0 +1,592 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// postgresProviderRepository PostgreSQL ile ProviderRepository implementasyonu
+type postgresProviderRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresProviderRepository yeni bir PostgreSQL provider repository oluşturur
+func NewPostgresProviderRepository(db *sql.DB) port.ProviderRepository {
+	return &postgresProviderRepository{db: db}
+}
+
+// FindByID ID'ye göre provider getirir
+func (r *postgresProviderRepository) FindByID(ctx context.Context, id int64) (*entity.Provider, error) {
+	query := `
+		SELECT id, name, url, format, mapping, auth, retry_policy, COALESCE(language, ''), is_active, auto_approve,
+			COALESCE(etag, ''), COALESCE(last_modified, ''), created_at, updated_at
+		FROM providers
+		WHERE id = $1
+	`
+
+	provider, err := scanProvider(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, port.ErrProviderNotFound
+		}
+		return nil, fmt.Errorf("failed to find provider: %w", err)
+	}
+
+	return provider, nil
+}
+
+// FindAll tüm aktif provider'ları getirir
+func (r *postgresProviderRepository) FindAll(ctx context.Context) ([]*entity.Provider, error) {
+	query := `
+		SELECT id, name, url, format, mapping, auth, retry_policy, COALESCE(language, ''), is_active, auto_approve,
+			COALESCE(etag, ''), COALESCE(last_modified, ''), created_at, updated_at
+		FROM providers
+		WHERE is_active = true
+		ORDER BY id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list providers: %w", err)
+	}
+	defer rows.Close()
+
+	var providers []*entity.Provider
+	for rows.Next() {
+		provider, err := scanProvider(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan provider: %w", err)
+		}
+		providers = append(providers, provider)
+	}
+
+	return providers, rows.Err()
+}
+
+// Create yeni bir provider kaydeder
+func (r *postgresProviderRepository) Create(ctx context.Context, provider *entity.Provider) error {
+	query := `
+		INSERT INTO providers (name, url, format, mapping, auth, retry_policy, language, is_active, auto_approve)
+		VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, ''), $8, $9)
+		RETURNING id, created_at, updated_at
+	`
+
+	mapping, err := encodeJSONB(provider.Mapping)
+	if err != nil {
+		return err
+	}
+	auth, err := encodeJSONB(provider.Auth)
+	if err != nil {
+		return err
+	}
+	retryPolicy, err := encodeJSONB(provider.Retry)
+	if err != nil {
+		return err
+	}
+
+	err = r.db.QueryRowContext(
+		ctx, query,
+		provider.Name,
+		provider.URL,
+		provider.Format,
+		mapping,
+		auth,
+		retryPolicy,
+		provider.Language,
+		provider.IsActive,
+		provider.AutoApprove,
+	).Scan(&provider.ID, &provider.CreatedAt, &provider.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create provider: %w", err)
+	}
+
+	return nil
+}
+
+// Update mevcut bir provider'ı günceller
+// URL veya format değişmiş olabileceğinden koşullu istek değerleri sıfırlanır
+func (r *postgresProviderRepository) Update(ctx context.Context, provider *entity.Provider) error {
+	query := `
+		UPDATE providers
+		SET name = $1, url = $2, format = $3, mapping = $4, auth = $5, retry_policy = $6, language = NULLIF($7, ''),
+			is_active = $8, auto_approve = $9, etag = NULL, last_modified = NULL, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $10
+		RETURNING created_at, updated_at
+	`
+
+	mapping, err := encodeJSONB(provider.Mapping)
+	if err != nil {
+		return err
+	}
+	auth, err := encodeJSONB(provider.Auth)
+	if err != nil {
+		return err
+	}
+	retryPolicy, err := encodeJSONB(provider.Retry)
+	if err != nil {
+		return err
+	}
+
+	err = r.db.QueryRowContext(
+		ctx, query,
+		provider.Name,
+		provider.URL,
+		provider.Format,
+		mapping,
+		auth,
+		retryPolicy,
+		provider.Language,
+		provider.IsActive,
+		provider.AutoApprove,
+		provider.ID,
+	).Scan(&provider.CreatedAt, &provider.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return port.ErrProviderNotFound
+		}
+		return fmt.Errorf("failed to update provider: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateFetchValidators son başarılı senkronizasyonun ETag / Last-Modified değerlerini kaydeder
+func (r *postgresProviderRepository) UpdateFetchValidators(ctx context.Context, providerID int64, etag, lastModified string) error {
+	_, err := r.db.ExecContext(ctx,
+		"UPDATE providers SET etag = NULLIF($1, ''), last_modified = NULLIF($2, '') WHERE id = $3",
+		etag, lastModified, providerID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update fetch validators: %w", err)
+	}
+	return nil
+}
+
+// Delete provider'ı siler (içerikler ve sync logları ON DELETE CASCADE ile silinir)
+func (r *postgresProviderRepository) Delete(ctx context.Context, id int64) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM providers WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete provider: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete provider: %w", err)
+	}
+	if affected == 0 {
+		return port.ErrProviderNotFound
+	}
+
+	return nil
+}
+
+// rowScanner *sql.Row ve *sql.Rows'un ortak Scan metodu
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanProvider provider satırını (mapping, auth, retry politikası, dil ve onay ayarı dahil) okur
+func scanProvider(row rowScanner) (*entity.Provider, error) {
+	provider := &entity.Provider{}
+	var mapping, auth, retryPolicy []byte
+	if err := row.Scan(
+		&provider.ID, &provider.Name, &provider.URL, &provider.Format, &mapping, &auth, &retryPolicy,
+		&provider.Language, &provider.IsActive, &provider.AutoApprove, &provider.ETag, &provider.LastModified, &provider.CreatedAt, &provider.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if mapping != nil {
+		provider.Mapping = &entity.ProviderMapping{}
+		if err := json.Unmarshal(mapping, provider.Mapping); err != nil {
+			return nil, fmt.Errorf("failed to decode provider mapping: %w", err)
+		}
+	}
+	if auth != nil {
+		provider.Auth = &entity.ProviderAuth{}
+		if err := json.Unmarshal(auth, provider.Auth); err != nil {
+			return nil, fmt.Errorf("failed to decode provider auth: %w", err)
+		}
+	}
+	if retryPolicy != nil {
+		provider.Retry = &entity.ProviderRetryPolicy{}
+		if err := json.Unmarshal(retryPolicy, provider.Retry); err != nil {
+			return nil, fmt.Errorf("failed to decode provider retry policy: %w", err)
+		}
+	}
+
+	return provider, nil
+}
+
+// encodeJSONB değeri JSONB kolonu için serialize eder (nil pointer ise NULL)
+func encodeJSONB[T any](value *T) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode jsonb value: %w", err)
+	}
+	// pq []byte'ı bytea olarak gönderir, JSONB için string verilmeli
+	return string(data), nil
+}
+
+// CreateSyncLog senkronizasyon logu oluşturur
+func (r *postgresProviderRepository) CreateSyncLog(ctx context.Context, log *entity.ProviderSyncLog) error {
+	query := `
+		INSERT INTO provider_sync_logs (provider_id, started_at, status, items_synced, error_message)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`
+
+	err := r.db.QueryRowContext(
+		ctx, query,
+		log.ProviderID,
+		log.StartedAt,
+		log.Status,
+		log.ItemsSynced,
+		sql.NullString{String: log.ErrorMessage, Valid: log.ErrorMessage != ""},
+	).Scan(&log.ID)
+	if err != nil {
+		return fmt.Errorf("failed to create sync log: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateSyncLog senkronizasyon logunu günceller
+func (r *postgresProviderRepository) UpdateSyncLog(ctx context.Context, log *entity.ProviderSyncLog) error {
+	query := `
+		UPDATE provider_sync_logs
+		SET completed_at = $1, status = $2, items_synced = $3, error_message = $4
+		WHERE id = $5
+	`
+
+	_, err := r.db.ExecContext(
+		ctx, query,
+		log.CompletedAt,
+		log.Status,
+		log.ItemsSynced,
+		sql.NullString{String: log.ErrorMessage, Valid: log.ErrorMessage != ""},
+		log.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update sync log: %w", err)
+	}
+
+	return nil
+}
+
+// ListSyncLogs senkronizasyon loglarını en yeniden eskiye sayfalı getirir
+func (r *postgresProviderRepository) ListSyncLogs(ctx context.Context, providerID int64, limit, offset int) ([]*entity.ProviderSyncLog, int64, error) {
+	where := ""
+	args := []interface{}{}
+	if providerID > 0 {
+		where = "WHERE l.provider_id = $1"
+		args = append(args, providerID)
+	}
+
+	var total int64
+	countQuery := "SELECT COUNT(*) FROM provider_sync_logs l " + where
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count sync logs: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT l.id, l.provider_id, p.name, l.started_at, l.completed_at,
+		       l.status, COALESCE(l.items_synced, 0), COALESCE(l.error_message, '')
+		FROM provider_sync_logs l
+		JOIN providers p ON p.id = l.provider_id
+		%s
+		ORDER BY l.started_at DESC, l.id DESC
+		LIMIT $%d OFFSET $%d
+	`, where, len(args)+1, len(args)+2)
+	args = append(args, limit, offset)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list sync logs: %w", err)
+	}
+	defer rows.Close()
+
+	var logs []*entity.ProviderSyncLog
+	for rows.Next() {
+		log := &entity.ProviderSyncLog{}
+		var completedAt sql.NullTime
+		if err := rows.Scan(
+			&log.ID, &log.ProviderID, &log.ProviderName, &log.StartedAt, &completedAt,
+			&log.Status, &log.ItemsSynced, &log.ErrorMessage,
+		); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan sync log: %w", err)
+		}
+		if completedAt.Valid {
+			log.CompletedAt = &completedAt.Time
+		}
+		logs = append(logs, log)
+	}
+
+	return logs, total, rows.Err()
+}
+
+// ReplaceSyncErrors provider'ın karantina kayıtlarını tek sorguda siler ve yenilerini ekler
+// Boş liste verilirse provider'ın karantinası temizlenir
+func (r *postgresProviderRepository) ReplaceSyncErrors(ctx context.Context, providerID int64, syncErrors []*entity.ProviderSyncError) error {
+	externalIDs := make([]string, len(syncErrors))
+	reasons := make([]string, len(syncErrors))
+	rawData := make([]string, len(syncErrors))
+	for i, e := range syncErrors {
+		externalIDs[i] = e.ExternalID
+		reasons[i] = e.Reason
+		rawData[i] = e.RawData
+	}
+
+	query := `
+		WITH cleared AS (
+			DELETE FROM provider_sync_errors WHERE provider_id = $1
+		)
+		INSERT INTO provider_sync_errors (provider_id, provider_content_id, reason, raw_data)
+		SELECT $1, e.external_id, e.reason, e.raw_data
+		FROM unnest($2::text[], $3::text[], $4::text[]) AS e(external_id, reason, raw_data)
+	`
+
+	_, err := r.db.ExecContext(ctx, query, providerID, pq.Array(externalIDs), pq.Array(reasons), pq.Array(rawData))
+	if err != nil {
+		return fmt.Errorf("failed to replace sync errors: %w", err)
+	}
+	return nil
+}
+
+// AddSyncError provider'ın karantinasına tek bir kayıt ekler
+func (r *postgresProviderRepository) AddSyncError(ctx context.Context, providerID int64, syncError *entity.ProviderSyncError) error {
+	query := `
+		INSERT INTO provider_sync_errors (provider_id, provider_content_id, reason, raw_data)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	_, err := r.db.ExecContext(ctx, query, providerID, syncError.ExternalID, syncError.Reason, syncError.RawData)
+	if err != nil {
+		return fmt.Errorf("failed to add sync error: %w", err)
+	}
+	return nil
+}
+
+// ListSyncErrors karantinadaki içerikleri en yeniden eskiye sayfalı getirir
+func (r *postgresProviderRepository) ListSyncErrors(ctx context.Context, providerID int64, limit, offset int) ([]*entity.ProviderSyncError, int64, error) {
+	where := ""
+	args := []interface{}{}
+	if providerID > 0 {
+		where = "WHERE e.provider_id = $1"
+		args = append(args, providerID)
+	}
+
+	var total int64
+	countQuery := "SELECT COUNT(*) FROM provider_sync_errors e " + where
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count sync errors: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT e.id, e.provider_id, p.name, e.provider_content_id, e.reason,
+		       COALESCE(e.raw_data, ''), e.created_at
+		FROM provider_sync_errors e
+		JOIN providers p ON p.id = e.provider_id
+		%s
+		ORDER BY e.created_at DESC, e.id DESC
+		LIMIT $%d OFFSET $%d
+	`, where, len(args)+1, len(args)+2)
+	args = append(args, limit, offset)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list sync errors: %w", err)
+	}
+	defer rows.Close()
+
+	var syncErrors []*entity.ProviderSyncError
+	for rows.Next() {
+		e := &entity.ProviderSyncError{}
+		if err := rows.Scan(&e.ID, &e.ProviderID, &e.ProviderName, &e.ExternalID, &e.Reason, &e.RawData, &e.CreatedAt); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan sync error: %w", err)
+		}
+		syncErrors = append(syncErrors, e)
+	}
+
+	return syncErrors, total, rows.Err()
+}
+
+// SaveHealth provider'ın son sağlık kontrolü sonucunu upsert eder
+// Ardışık hata sayısı ve son başarılı kontrol zamanı önceki kayda göre hesaplanır
+func (r *postgresProviderRepository) SaveHealth(ctx context.Context, health *entity.ProviderHealth) error {
+	query := `
+		INSERT INTO provider_health (
+			provider_id, status, status_code, latency_ms, error_message,
+			consecutive_failures, checked_at, last_up_at
+		)
+		VALUES (
+			$1, $2::text, $3, $4, NULLIF($5, ''),
+			CASE WHEN $2::text = 'down' THEN 1 ELSE 0 END,
+			$6::timestamp, CASE WHEN $2::text = 'up' THEN $6::timestamp END
+		)
+		ON CONFLICT (provider_id) DO UPDATE SET
+			status = EXCLUDED.status,
+			status_code = EXCLUDED.status_code,
+			latency_ms = EXCLUDED.latency_ms,
+			error_message = EXCLUDED.error_message,
+			consecutive_failures = CASE
+				WHEN EXCLUDED.status = 'down' THEN provider_health.consecutive_failures + 1
+				ELSE 0
+			END,
+			checked_at = EXCLUDED.checked_at,
+			last_up_at = COALESCE(EXCLUDED.last_up_at, provider_health.last_up_at)
+		RETURNING consecutive_failures, last_up_at
+	`
+
+	var checkedAt time.Time
+	if health.CheckedAt != nil {
+		checkedAt = *health.CheckedAt
+	}
+
+	var lastUpAt sql.NullTime
+	err := r.db.QueryRowContext(ctx, query,
+		health.ProviderID, string(health.Status), health.StatusCode, health.LatencyMs, health.Error, checkedAt,
+	).Scan(&health.ConsecutiveFailures, &lastUpAt)
+	if err != nil {
+		return fmt.Errorf("failed to save provider health: %w", err)
+	}
+
+	health.LastUpAt = nil
+	if lastUpAt.Valid {
+		health.LastUpAt = &lastUpAt.Time
+	}
+	return nil
+}
+
+// FindHealth provider'ın son sağlık kontrolü sonucunu getirir
+func (r *postgresProviderRepository) FindHealth(ctx context.Context, providerID int64) (*entity.ProviderHealth, error) {
+	query := `
+		SELECT h.provider_id, p.name, h.status, h.status_code, h.latency_ms,
+		       COALESCE(h.error_message, ''), h.consecutive_failures, h.checked_at, h.last_up_at
+		FROM provider_health h
+		JOIN providers p ON p.id = h.provider_id
+		WHERE h.provider_id = $1
+	`
+
+	health, err := scanProviderHealth(r.db.QueryRowContext(ctx, query, providerID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find provider health: %w", err)
+	}
+	return health, nil
+}
+
+// ListHealth aktif provider'ların son sağlık kontrolü sonuçlarını getirir
+func (r *postgresProviderRepository) ListHealth(ctx context.Context) ([]*entity.ProviderHealth, error) {
+	query := `
+		SELECT h.provider_id, p.name, h.status, h.status_code, h.latency_ms,
+		       COALESCE(h.error_message, ''), h.consecutive_failures, h.checked_at, h.last_up_at
+		FROM provider_health h
+		JOIN providers p ON p.id = h.provider_id
+		WHERE p.is_active = true
+		ORDER BY h.provider_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list provider health: %w", err)
+	}
+	defer rows.Close()
+
+	var results []*entity.ProviderHealth
+	for rows.Next() {
+		health, err := scanProviderHealth(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan provider health: %w", err)
+		}
+		results = append(results, health)
+	}
+
+	return results, rows.Err()
+}
+
+// ListSyncFreshness aktif provider'ların son başarılı ve son denenen senkronizasyonlarını getirir
+// Hiç senkronize edilmemiş provider'lar da boş zamanlarla listelenir
+func (r *postgresProviderRepository) ListSyncFreshness(ctx context.Context) ([]*entity.ProviderFreshness, error) {
+	query := `
+		SELECT p.id, p.name, s.completed_at, COALESCE(s.items_synced, 0), a.started_at, COALESCE(a.status, '')
+		FROM providers p
+		LEFT JOIN LATERAL (
+			SELECT completed_at, items_synced
+			FROM provider_sync_logs
+			WHERE provider_id = p.id AND status = 'success'
+			ORDER BY completed_at DESC NULLS LAST, id DESC
+			LIMIT 1
+		) s ON true
+		LEFT JOIN LATERAL (
+			SELECT started_at, status
+			FROM provider_sync_logs
+			WHERE provider_id = p.id
+			ORDER BY started_at DESC, id DESC
+			LIMIT 1
+		) a ON true
+		WHERE p.is_active = true
+		ORDER BY p.id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list provider freshness: %w", err)
+	}
+	defer rows.Close()
+
+	var results []*entity.ProviderFreshness
+	for rows.Next() {
+		freshness := &entity.ProviderFreshness{}
+		var lastSuccessAt, lastAttemptAt sql.NullTime
+		if err := rows.Scan(
+			&freshness.ProviderID, &freshness.ProviderName, &lastSuccessAt, &freshness.LastSuccessItems,
+			&lastAttemptAt, &freshness.LastAttemptStatus,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan provider freshness: %w", err)
+		}
+		if lastSuccessAt.Valid {
+			freshness.LastSuccessAt = &lastSuccessAt.Time
+		}
+		if lastAttemptAt.Valid {
+			freshness.LastAttemptAt = &lastAttemptAt.Time
+		}
+		results = append(results, freshness)
+	}
+
+	return results, rows.Err()
+}
+
+// scanProviderHealth provider_health satırını entity'ye dönüştürür
+func scanProviderHealth(row rowScanner) (*entity.ProviderHealth, error) {
+	health := &entity.ProviderHealth{}
+	var status string
+	var checkedAt time.Time
+	var lastUpAt sql.NullTime
+	if err := row.Scan(
+		&health.ProviderID, &health.ProviderName, &status, &health.StatusCode, &health.LatencyMs,
+		&health.Error, &health.ConsecutiveFailures, &checkedAt, &lastUpAt,
+	); err != nil {
+		return nil, err
+	}
+
+	health.Status = entity.ProviderHealthStatus(status)
+	health.CheckedAt = &checkedAt
+	if lastUpAt.Valid {
+		health.LastUpAt = &lastUpAt.Time
+	}
+	return health, nil
+}
@@ -0,0 +1,305 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/testutil"
+)
+
+func TestPostgresProviderRepository_CRUD(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	repo := NewPostgresProviderRepository(db)
+	ctx := context.Background()
+
+	provider := &entity.Provider{
+		Name:     "Runtime Provider",
+		URL:      "http://test-api:8081/runtime",
+		Format:   "json",
+		IsActive: true,
+	}
+
+	t.Run("create and find", func(t *testing.T) {
+		require.NoError(t, repo.Create(ctx, provider))
+		assert.NotZero(t, provider.ID)
+		assert.NotZero(t, provider.CreatedAt)
+
+		found, err := repo.FindByID(ctx, provider.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Runtime Provider", found.Name)
+	})
+
+	t.Run("update deactivates provider", func(t *testing.T) {
+		provider.Format = "xml"
+		provider.IsActive = false
+		require.NoError(t, repo.Update(ctx, provider))
+
+		found, err := repo.FindByID(ctx, provider.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "xml", found.Format)
+		assert.False(t, found.IsActive)
+
+		active, err := repo.FindAll(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, active)
+	})
+
+	t.Run("delete cascades to contents", func(t *testing.T) {
+		testutil.CreateTestContent(t, db, provider.ID, entity.ContentTypeVideo)
+
+		require.NoError(t, repo.Delete(ctx, provider.ID))
+
+		var count int
+		require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM contents WHERE provider_id = $1", provider.ID).Scan(&count))
+		assert.Zero(t, count)
+
+		_, err := repo.FindByID(ctx, provider.ID)
+		assert.ErrorIs(t, err, port.ErrProviderNotFound)
+	})
+
+	t.Run("mapping, auth and retry round trip", func(t *testing.T) {
+		restProvider := &entity.Provider{
+			Name:     "REST Provider",
+			URL:      "http://test-api:8081/rest",
+			Format:   "rest",
+			IsActive: true,
+			Mapping: &entity.ProviderMapping{
+				ItemsPath:   "data.items",
+				ID:          "id",
+				Title:       "title",
+				DefaultType: "article",
+				PublishedAt: "date",
+				Tags:        "tags",
+			},
+			Auth:  &entity.ProviderAuth{Type: entity.ProviderAuthBearer, SecretEnv: "PROVIDER_SECRET_REST"},
+			Retry: &entity.ProviderRetryPolicy{MaxAttempts: 5, InitialIntervalMs: 200},
+		}
+		require.NoError(t, repo.Create(ctx, restProvider))
+
+		found, err := repo.FindByID(ctx, restProvider.ID)
+		require.NoError(t, err)
+		assert.Equal(t, restProvider.Mapping, found.Mapping)
+		assert.Equal(t, restProvider.Auth, found.Auth)
+		assert.Equal(t, restProvider.Retry, found.Retry)
+
+		restProvider.Mapping = nil
+		require.NoError(t, repo.Update(ctx, restProvider))
+		found, err = repo.FindByID(ctx, restProvider.ID)
+		require.NoError(t, err)
+		assert.Nil(t, found.Mapping)
+	})
+
+	t.Run("fetch validators", func(t *testing.T) {
+		provider := &entity.Provider{Name: "Conditional Provider", URL: "http://test-api:8081/etag", Format: "json", IsActive: true}
+		require.NoError(t, repo.Create(ctx, provider))
+
+		require.NoError(t, repo.UpdateFetchValidators(ctx, provider.ID, `"v1"`, "Mon, 01 Jan 2024 12:00:00 GMT"))
+		found, err := repo.FindByID(ctx, provider.ID)
+		require.NoError(t, err)
+		assert.Equal(t, `"v1"`, found.ETag)
+		assert.Equal(t, "Mon, 01 Jan 2024 12:00:00 GMT", found.LastModified)
+
+		// Ayar değişikliği sonrası ilk sync tam çekim yapmalı
+		require.NoError(t, repo.Update(ctx, found))
+		found, err = repo.FindByID(ctx, provider.ID)
+		require.NoError(t, err)
+		assert.Empty(t, found.ETag)
+		assert.Empty(t, found.LastModified)
+	})
+
+	t.Run("missing provider", func(t *testing.T) {
+		err := repo.Update(ctx, &entity.Provider{ID: 999999, Name: "x", URL: "http://x", Format: "json"})
+		assert.ErrorIs(t, err, port.ErrProviderNotFound)
+
+		err = repo.Delete(ctx, 999999)
+		assert.ErrorIs(t, err, port.ErrProviderNotFound)
+	})
+}
+
+func TestPostgresProviderRepository_SyncLogs(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	repo := NewPostgresProviderRepository(db)
+	ctx := context.Background()
+	provider1 := testutil.CreateTestProvider(t, db, "Provider 1", "json")
+	provider2 := testutil.CreateTestProvider(t, db, "Provider 2", "xml")
+
+	syncLog := &entity.ProviderSyncLog{
+		ProviderID: provider1.ID,
+		StartedAt:  time.Now().Add(-time.Minute),
+		Status:     "running",
+	}
+	require.NoError(t, repo.CreateSyncLog(ctx, syncLog))
+	assert.NotZero(t, syncLog.ID)
+
+	completedAt := time.Now()
+	syncLog.CompletedAt = &completedAt
+	syncLog.Status = "success"
+	syncLog.ItemsSynced = 42
+	require.NoError(t, repo.UpdateSyncLog(ctx, syncLog))
+
+	require.NoError(t, repo.CreateSyncLog(ctx, &entity.ProviderSyncLog{
+		ProviderID: provider2.ID,
+		StartedAt:  time.Now(),
+		Status:     "running",
+	}))
+
+	t.Run("lists newest first", func(t *testing.T) {
+		logs, total, err := repo.ListSyncLogs(ctx, 0, 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		require.Len(t, logs, 2)
+		assert.Equal(t, provider2.ID, logs[0].ProviderID)
+		assert.Equal(t, "Provider 2", logs[0].ProviderName)
+		assert.Nil(t, logs[0].CompletedAt)
+	})
+
+	t.Run("filters by provider", func(t *testing.T) {
+		logs, total, err := repo.ListSyncLogs(ctx, provider1.ID, 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, logs, 1)
+		assert.Equal(t, "success", logs[0].Status)
+		assert.Equal(t, int32(42), logs[0].ItemsSynced)
+		assert.NotNil(t, logs[0].CompletedAt)
+	})
+
+	t.Run("freshness uses last successful sync", func(t *testing.T) {
+		freshness, err := repo.ListSyncFreshness(ctx)
+		require.NoError(t, err)
+		require.Len(t, freshness, 2)
+
+		assert.Equal(t, provider1.ID, freshness[0].ProviderID)
+		require.NotNil(t, freshness[0].LastSuccessAt)
+		assert.Equal(t, int32(42), freshness[0].LastSuccessItems)
+		assert.Equal(t, "success", freshness[0].LastAttemptStatus)
+
+		// Provider 2'nin sadece devam eden bir denemesi var
+		assert.Equal(t, "Provider 2", freshness[1].ProviderName)
+		assert.Nil(t, freshness[1].LastSuccessAt)
+		assert.Equal(t, "running", freshness[1].LastAttemptStatus)
+		assert.NotNil(t, freshness[1].LastAttemptAt)
+	})
+}
+
+func TestPostgresProviderRepository_SyncErrors(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	repo := NewPostgresProviderRepository(db)
+	ctx := context.Background()
+	provider1 := testutil.CreateTestProvider(t, db, "Provider 1", "json")
+	provider2 := testutil.CreateTestProvider(t, db, "Provider 2", "xml")
+
+	require.NoError(t, repo.ReplaceSyncErrors(ctx, provider1.ID, []*entity.ProviderSyncError{
+		{ExternalID: "a", Reason: "title is required", RawData: `{"id":"a"}`},
+		{ExternalID: "b", Reason: "published_at is in the future", RawData: `{"id":"b"}`},
+	}))
+	require.NoError(t, repo.ReplaceSyncErrors(ctx, provider2.ID, []*entity.ProviderSyncError{
+		{Reason: "tarih parse hatası", RawData: "<item/>"},
+	}))
+
+	t.Run("lists all providers", func(t *testing.T) {
+		syncErrors, total, err := repo.ListSyncErrors(ctx, 0, 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		assert.Len(t, syncErrors, 3)
+	})
+
+	t.Run("replaces previous sync's errors", func(t *testing.T) {
+		require.NoError(t, repo.ReplaceSyncErrors(ctx, provider1.ID, []*entity.ProviderSyncError{
+			{ExternalID: "c", Reason: "title is required", RawData: `{"id":"c"}`},
+		}))
+
+		syncErrors, total, err := repo.ListSyncErrors(ctx, provider1.ID, 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, syncErrors, 1)
+		assert.Equal(t, "c", syncErrors[0].ExternalID)
+		assert.Equal(t, "Provider 1", syncErrors[0].ProviderName)
+		assert.Equal(t, `{"id":"c"}`, syncErrors[0].RawData)
+	})
+
+	t.Run("adds without clearing previous errors", func(t *testing.T) {
+		require.NoError(t, repo.AddSyncError(ctx, provider1.ID, &entity.ProviderSyncError{
+			ExternalID: "d", Reason: "views must not be negative", RawData: `{"id":"d"}`,
+		}))
+
+		syncErrors, total, err := repo.ListSyncErrors(ctx, provider1.ID, 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		require.Len(t, syncErrors, 2)
+		assert.Equal(t, "d", syncErrors[0].ExternalID)
+	})
+
+	t.Run("clears with empty list", func(t *testing.T) {
+		require.NoError(t, repo.ReplaceSyncErrors(ctx, provider1.ID, nil))
+
+		_, total, err := repo.ListSyncErrors(ctx, provider1.ID, 10, 0)
+		require.NoError(t, err)
+		assert.Zero(t, total)
+	})
+}
+
+func TestPostgresProviderRepository_Health(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	repo := NewPostgresProviderRepository(db)
+	ctx := context.Background()
+	provider := testutil.CreateTestProvider(t, db, "Provider 1", "json")
+
+	save := func(status entity.ProviderHealthStatus) *entity.ProviderHealth {
+		checkedAt := time.Now().UTC().Truncate(time.Millisecond)
+		health := &entity.ProviderHealth{ProviderID: provider.ID, Status: status, StatusCode: 200, LatencyMs: 12, CheckedAt: &checkedAt}
+		if status == entity.ProviderHealthDown {
+			health.StatusCode, health.Error = 503, "provider hata döndü: 503"
+		}
+		require.NoError(t, repo.SaveHealth(ctx, health))
+		return health
+	}
+
+	t.Run("not checked yet", func(t *testing.T) {
+		health, err := repo.FindHealth(ctx, provider.ID)
+		require.NoError(t, err)
+		assert.Nil(t, health)
+	})
+
+	t.Run("tracks consecutive failures", func(t *testing.T) {
+		up := save(entity.ProviderHealthUp)
+		assert.Equal(t, int32(0), up.ConsecutiveFailures)
+		require.NotNil(t, up.LastUpAt)
+
+		save(entity.ProviderHealthDown)
+		down := save(entity.ProviderHealthDown)
+		assert.Equal(t, int32(2), down.ConsecutiveFailures)
+		require.NotNil(t, down.LastUpAt)
+		assert.True(t, up.LastUpAt.Equal(*down.LastUpAt), "last_up_at should be kept while down")
+
+		found, err := repo.FindHealth(ctx, provider.ID)
+		require.NoError(t, err)
+		assert.Equal(t, entity.ProviderHealthDown, found.Status)
+		assert.Equal(t, 503, found.StatusCode)
+		assert.Equal(t, "Provider 1", found.ProviderName)
+		assert.Equal(t, int32(2), found.ConsecutiveFailures)
+
+		assert.Equal(t, int32(0), save(entity.ProviderHealthUp).ConsecutiveFailures)
+	})
+
+	t.Run("lists active providers", func(t *testing.T) {
+		results, err := repo.ListHealth(ctx)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, provider.ID, results[0].ProviderID)
+	})
+}
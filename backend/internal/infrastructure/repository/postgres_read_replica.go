@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/logger"
+)
+
+// readReplicaCooldown replika bağlantı hatası verdikten sonra okumaların primary'den yapılacağı süre
+const readReplicaCooldown = 30 * time.Second
+
+// readReplica arama okumalarının yönlendirildiği replika bağlantı havuzu
+// Bağlantı hatasında bir süre devre dışı bırakılır; bu sürede okumalar primary'den yapılır
+type readReplica struct {
+	db        *sql.DB
+	mu        sync.Mutex
+	downUntil time.Time
+	now       func() time.Time
+}
+
+func newReadReplica(db *sql.DB) *readReplica {
+	return &readReplica{db: db, now: time.Now}
+}
+
+// available replikanın kullanılabilir olup olmadığını döner (bekleme süresi dolduysa tekrar denenir)
+func (r *readReplica) available() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return !r.now().Before(r.downUntil)
+}
+
+// markDown replikayı readReplicaCooldown süresince devre dışı bırakır
+func (r *readReplica) markDown() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.downUntil = r.now().Add(readReplicaCooldown)
+}
+
+// read fn'i replika varsa replikaya bağlı bir repository ile, yoksa primary ile çalıştırır
+// Replikada bağlantı hatası olursa replika bir süre devre dışı bırakılıp fn primary ile tekrarlanır.
+// Transaction içindeki okumalar (yazmalarla tutarlı olmaları için) her zaman primary'den yapılır
+func (r *postgresContentRepository) read(ctx context.Context, fn func(reader *postgresContentRepository) error) error {
+	if _, inTx := ctx.Value(txKey{}).(*txState); r.replica == nil || inTx {
+		return fn(r)
+	}
+
+	if r.replica.available() {
+		reader := &postgresContentRepository{db: r.replica.db, countStrategy: r.countStrategy, slowQuery: r.slowQuery}
+		err := fn(reader)
+		if err == nil || !isConnectionError(err) {
+			return err
+		}
+		r.replica.markDown()
+		logger.FromContext(ctx).Warn("read replica unavailable, reading from primary",
+			zap.Duration("cooldown", readReplicaCooldown),
+			zap.Error(err),
+		)
+	}
+
+	return fn(r)
+}
+
+// isConnectionError hatanın sorgudan değil veritabanına ulaşılamamasından kaynaklanıp kaynaklanmadığını döner
+func isConnectionError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, sql.ErrConnDone) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		// 08xxx: connection exception, 57P01-03: sunucu kapanıyor / henüz bağlantı kabul etmiyor
+		switch {
+		case pqErr.Code.Class() == "08":
+			return true
+		case pqErr.Code == "57P01", pqErr.Code == "57P02", pqErr.Code == "57P03":
+			return true
+		}
+	}
+	return false
+}
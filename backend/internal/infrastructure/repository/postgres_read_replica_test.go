@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsConnectionError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"network error", &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}, true},
+		{"bad connection", driver.ErrBadConn, true},
+		{"wrapped conn done", fmt.Errorf("failed to search contents: %w", sql.ErrConnDone), true},
+		{"connection failure", &pq.Error{Code: "08006"}, true},
+		{"cannot connect now", &pq.Error{Code: "57P03"}, true},
+		{"syntax error", &pq.Error{Code: "42601"}, false},
+		{"no rows", sql.ErrNoRows, false},
+		{"context canceled", context.Canceled, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isConnectionError(tt.err))
+		})
+	}
+}
+
+func TestReadReplica_Cooldown(t *testing.T) {
+	now := time.Now()
+	replica := newReadReplica(nil)
+	replica.now = func() time.Time { return now }
+
+	assert.True(t, replica.available())
+
+	replica.markDown()
+	assert.False(t, replica.available())
+
+	now = now.Add(readReplicaCooldown)
+	assert.True(t, replica.available())
+}
+
+func TestPostgresContentRepository_Read(t *testing.T) {
+	primaryDB, replicaDB := &sql.DB{}, &sql.DB{}
+
+	newRepo := func() *postgresContentRepository {
+		return NewPostgresContentRepositoryWithOptions(primaryDB, PostgresContentRepositoryOptions{
+			ReadReplica: replicaDB,
+		}).(*postgresContentRepository)
+	}
+
+	t.Run("reads from replica", func(t *testing.T) {
+		var used []*sql.DB
+		err := newRepo().read(context.Background(), func(reader *postgresContentRepository) error {
+			used = append(used, reader.db)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []*sql.DB{replicaDB}, used)
+	})
+
+	t.Run("falls back to primary on connection error", func(t *testing.T) {
+		repo := newRepo()
+		var used []*sql.DB
+		err := repo.read(context.Background(), func(reader *postgresContentRepository) error {
+			used = append(used, reader.db)
+			if reader.db == replicaDB {
+				return driver.ErrBadConn
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []*sql.DB{replicaDB, primaryDB}, used)
+		assert.False(t, repo.replica.available())
+
+		// Bekleme süresi boyunca replika denenmez
+		used = nil
+		_ = repo.read(context.Background(), func(reader *postgresContentRepository) error {
+			used = append(used, reader.db)
+			return nil
+		})
+		assert.Equal(t, []*sql.DB{primaryDB}, used)
+	})
+
+	t.Run("query errors are not retried", func(t *testing.T) {
+		repo := newRepo()
+		queryErr := &pq.Error{Code: "42601"}
+		calls := 0
+		err := repo.read(context.Background(), func(reader *postgresContentRepository) error {
+			calls++
+			return queryErr
+		})
+		assert.ErrorIs(t, err, queryErr)
+		assert.Equal(t, 1, calls)
+		assert.True(t, repo.replica.available())
+	})
+
+	t.Run("transaction reads use primary", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), txKey{}, &txState{})
+		var used *sql.DB
+		_ = newRepo().read(ctx, func(reader *postgresContentRepository) error {
+			used = reader.db
+			return nil
+		})
+		assert.Same(t, primaryDB, used)
+	})
+}
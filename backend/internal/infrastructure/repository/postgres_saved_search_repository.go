@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// postgresSavedSearchRepository PostgreSQL ile SavedSearchRepository implementasyonu
+type postgresSavedSearchRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresSavedSearchRepository yeni bir PostgreSQL kayıtlı arama repository oluşturur
+func NewPostgresSavedSearchRepository(db *sql.DB) port.SavedSearchRepository {
+	return &postgresSavedSearchRepository{db: db}
+}
+
+// savedSearchColumns kayıtlı arama sorgularında okunan kolonlar (scanSavedSearch ile aynı sırada)
+const savedSearchColumns = `s.id, s.api_key_id, s.name, s.query, s.tags, s.content_type, s.provider_id,
+	s.notify_email, s.notify_url, s.secret, s.last_notified_at, s.last_error, s.created_at`
+
+// CreateSavedSearch yeni bir kayıtlı arama ekler
+func (r *postgresSavedSearchRepository) CreateSavedSearch(ctx context.Context, search *entity.SavedSearch) error {
+	query := `
+		INSERT INTO saved_searches (api_key_id, name, query, tags, content_type, provider_id, notify_email, notify_url, secret, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW())
+		RETURNING id, created_at
+	`
+
+	if err := r.db.QueryRowContext(ctx, query,
+		search.APIKeyID, search.Name, search.Query, pq.Array(nonNilStrings(search.Tags)), string(search.ContentType),
+		nullableProviderID(search.ProviderID), search.NotifyEmail, search.NotifyURL, search.Secret,
+	).Scan(&search.ID, &search.CreatedAt); err != nil {
+		return fmt.Errorf("failed to create saved search: %w", err)
+	}
+
+	return nil
+}
+
+// ListSavedSearches anahtarın kayıtlı aramalarını getirir
+func (r *postgresSavedSearchRepository) ListSavedSearches(ctx context.Context, apiKeyID int64) ([]*entity.SavedSearch, error) {
+	query := `SELECT ` + savedSearchColumns + ` FROM saved_searches s WHERE s.api_key_id = $1 ORDER BY s.id`
+	return r.list(ctx, query, apiKeyID)
+}
+
+// DeleteSavedSearch anahtarın kayıtlı aramasını siler
+func (r *postgresSavedSearchRepository) DeleteSavedSearch(ctx context.Context, apiKeyID, id int64) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM saved_searches WHERE id = $1 AND api_key_id = $2`, id, apiKeyID)
+	if err != nil {
+		return fmt.Errorf("failed to delete saved search: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete saved search: %w", err)
+	}
+	if affected == 0 {
+		return port.ErrSavedSearchNotFound
+	}
+
+	return nil
+}
+
+// ListAlertableSavedSearches iptal edilmemiş anahtarların kayıtlı aramalarını getirir
+func (r *postgresSavedSearchRepository) ListAlertableSavedSearches(ctx context.Context) ([]*entity.SavedSearch, error) {
+	query := `SELECT ` + savedSearchColumns + `
+		FROM saved_searches s
+		JOIN api_keys k ON k.id = s.api_key_id
+		WHERE k.revoked_at IS NULL
+		ORDER BY s.id`
+	return r.list(ctx, query)
+}
+
+// RecordSavedSearchAlert bildirim sonucunu kaydeder
+func (r *postgresSavedSearchRepository) RecordSavedSearchAlert(ctx context.Context, id int64, notifiedAt time.Time, lastError string) error {
+	query := `
+		UPDATE saved_searches
+		SET last_notified_at = CASE WHEN $3 = '' THEN $2 ELSE last_notified_at END,
+			last_error = $3
+		WHERE id = $1
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, id, notifiedAt, lastError); err != nil {
+		return fmt.Errorf("failed to record saved search alert: %w", err)
+	}
+	return nil
+}
+
+// list sorgunun döndürdüğü kayıtlı aramaları okur
+func (r *postgresSavedSearchRepository) list(ctx context.Context, query string, args ...interface{}) ([]*entity.SavedSearch, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saved searches: %w", err)
+	}
+	defer rows.Close()
+
+	searches := make([]*entity.SavedSearch, 0)
+	for rows.Next() {
+		search, err := scanSavedSearch(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan saved search: %w", err)
+		}
+		searches = append(searches, search)
+	}
+
+	return searches, rows.Err()
+}
+
+// scanSavedSearch tek bir kayıtlı arama satırını okur
+func scanSavedSearch(row rowScanner) (*entity.SavedSearch, error) {
+	search := &entity.SavedSearch{}
+	var (
+		contentType    string
+		providerID     sql.NullInt64
+		lastNotifiedAt sql.NullTime
+	)
+	if err := row.Scan(
+		&search.ID, &search.APIKeyID, &search.Name, &search.Query, pq.Array(&search.Tags), &contentType, &providerID,
+		&search.NotifyEmail, &search.NotifyURL, &search.Secret, &lastNotifiedAt, &search.LastError, &search.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+	search.ContentType = entity.ContentType(contentType)
+	search.ProviderID = providerID.Int64
+	if lastNotifiedAt.Valid {
+		search.LastNotifiedAt = &lastNotifiedAt.Time
+	}
+	return search, nil
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/testutil"
+)
+
+func TestPostgresSavedSearchRepository(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	keyRepo := NewPostgresAPIKeyRepository(db)
+	repo := NewPostgresSavedSearchRepository(db)
+	ctx := context.Background()
+
+	owner := &entity.APIKey{Name: "owner", Prefix: "sek_owner000", KeyHash: "1111111111111111111111111111111111111111111111111111111111111111"}
+	other := &entity.APIKey{Name: "other", Prefix: "sek_other000", KeyHash: "2222222222222222222222222222222222222222222222222222222222222222"}
+	require.NoError(t, keyRepo.CreateAPIKey(ctx, owner))
+	require.NoError(t, keyRepo.CreateAPIKey(ctx, other))
+
+	search := &entity.SavedSearch{
+		APIKeyID:    owner.ID,
+		Name:        "go videos",
+		Query:       "golang",
+		Tags:        []string{"tutorial"},
+		ContentType: entity.ContentTypeVideo,
+		NotifyURL:   "https://owner.example.com/alerts",
+		Secret:      "whsec_test",
+	}
+
+	t.Run("create and list per key", func(t *testing.T) {
+		require.NoError(t, repo.CreateSavedSearch(ctx, search))
+		assert.NotZero(t, search.ID)
+
+		searches, err := repo.ListSavedSearches(ctx, owner.ID)
+		require.NoError(t, err)
+		require.Len(t, searches, 1)
+		assert.Equal(t, []string{"tutorial"}, searches[0].Tags)
+		assert.Equal(t, "whsec_test", searches[0].Secret)
+		assert.Nil(t, searches[0].LastNotifiedAt)
+
+		searches, err = repo.ListSavedSearches(ctx, other.ID)
+		require.NoError(t, err)
+		assert.Empty(t, searches)
+	})
+
+	t.Run("record alert results", func(t *testing.T) {
+		notifiedAt := time.Now().UTC().Truncate(time.Second)
+		require.NoError(t, repo.RecordSavedSearchAlert(ctx, search.ID, notifiedAt, ""))
+		require.NoError(t, repo.RecordSavedSearchAlert(ctx, search.ID, notifiedAt.Add(time.Hour), "connection refused"))
+
+		searches, err := repo.ListAlertableSavedSearches(ctx)
+		require.NoError(t, err)
+		require.Len(t, searches, 1)
+		require.NotNil(t, searches[0].LastNotifiedAt)
+		assert.Equal(t, notifiedAt.Unix(), searches[0].LastNotifiedAt.Unix())
+		assert.Equal(t, "connection refused", searches[0].LastError)
+	})
+
+	t.Run("revoked keys are not alerted", func(t *testing.T) {
+		_, err := keyRepo.RevokeAPIKey(ctx, owner.ID)
+		require.NoError(t, err)
+
+		searches, err := repo.ListAlertableSavedSearches(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, searches)
+	})
+
+	t.Run("delete only own searches", func(t *testing.T) {
+		assert.ErrorIs(t, repo.DeleteSavedSearch(ctx, other.ID, search.ID), port.ErrSavedSearchNotFound)
+		require.NoError(t, repo.DeleteSavedSearch(ctx, owner.ID, search.ID))
+		assert.ErrorIs(t, repo.DeleteSavedSearch(ctx, owner.ID, search.ID), port.ErrSavedSearchNotFound)
+	})
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// postgresScoringRulesRepository PostgreSQL ile ScoringRulesRepository implementasyonu
+// Kurallar scoring_rules tablosunda tek satır olarak tutulur
+type postgresScoringRulesRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresScoringRulesRepository yeni bir PostgreSQL skorlama kuralları repository oluşturur
+func NewPostgresScoringRulesRepository(db *sql.DB) port.ScoringRulesRepository {
+	return &postgresScoringRulesRepository{db: db}
+}
+
+// GetScoringRules kayıtlı skorlama kurallarını getirir, kayıt yoksa nil döner
+func (r *postgresScoringRulesRepository) GetScoringRules(ctx context.Context) (*entity.ScoringRules, error) {
+	query := `
+		SELECT video_type_weight, article_type_weight, recency_tiers,
+			video_engagement_multiplier, article_engagement_multiplier,
+			recency_decay, recency_half_life_days, recency_max_score,
+			engagement_scaling, engagement_cap, video_duration_weight, comment_weight, ctr_weight, updated_at
+		FROM scoring_rules
+		WHERE id = 1
+	`
+
+	rules := &entity.ScoringRules{}
+	var tiers []byte
+	var updatedAt time.Time
+	err := r.db.QueryRowContext(ctx, query).Scan(
+		&rules.VideoTypeWeight, &rules.ArticleTypeWeight, &tiers,
+		&rules.VideoEngagementMultiplier, &rules.ArticleEngagementMultiplier,
+		&rules.RecencyDecay, &rules.RecencyHalfLifeDays, &rules.RecencyMaxScore,
+		&rules.EngagementScaling, &rules.EngagementCap, &rules.VideoDurationWeight, &rules.CommentWeight, &rules.CTRWeight, &updatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find scoring rules: %w", err)
+	}
+
+	if err := json.Unmarshal(tiers, &rules.RecencyTiers); err != nil {
+		return nil, fmt.Errorf("failed to decode recency tiers: %w", err)
+	}
+	rules.UpdatedAt = &updatedAt
+
+	return rules, nil
+}
+
+// SaveScoringRules skorlama kurallarını kaydeder (satır yoksa oluşturur)
+func (r *postgresScoringRulesRepository) SaveScoringRules(ctx context.Context, rules *entity.ScoringRules) error {
+	query := `
+		INSERT INTO scoring_rules (id, video_type_weight, article_type_weight, recency_tiers,
+			video_engagement_multiplier, article_engagement_multiplier,
+			recency_decay, recency_half_life_days, recency_max_score,
+			engagement_scaling, engagement_cap, video_duration_weight, comment_weight, ctr_weight, updated_at)
+		VALUES (1, $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, NOW())
+		ON CONFLICT (id) DO UPDATE SET
+			video_type_weight = EXCLUDED.video_type_weight,
+			article_type_weight = EXCLUDED.article_type_weight,
+			recency_tiers = EXCLUDED.recency_tiers,
+			video_engagement_multiplier = EXCLUDED.video_engagement_multiplier,
+			article_engagement_multiplier = EXCLUDED.article_engagement_multiplier,
+			recency_decay = EXCLUDED.recency_decay,
+			recency_half_life_days = EXCLUDED.recency_half_life_days,
+			recency_max_score = EXCLUDED.recency_max_score,
+			engagement_scaling = EXCLUDED.engagement_scaling,
+			engagement_cap = EXCLUDED.engagement_cap,
+			video_duration_weight = EXCLUDED.video_duration_weight,
+			comment_weight = EXCLUDED.comment_weight,
+			ctr_weight = EXCLUDED.ctr_weight,
+			updated_at = EXCLUDED.updated_at
+		RETURNING updated_at
+	`
+
+	tiers := rules.RecencyTiers
+	if tiers == nil {
+		tiers = []entity.RecencyTier{}
+	}
+	encoded, err := encodeJSONB(&tiers)
+	if err != nil {
+		return err
+	}
+
+	var updatedAt time.Time
+	if err := r.db.QueryRowContext(ctx, query,
+		rules.VideoTypeWeight, rules.ArticleTypeWeight, encoded,
+		rules.VideoEngagementMultiplier, rules.ArticleEngagementMultiplier,
+		string(rules.RecencyDecay), rules.RecencyHalfLifeDays, rules.RecencyMaxScore,
+		string(rules.EngagementScaling), rules.EngagementCap, rules.VideoDurationWeight, rules.CommentWeight, rules.CTRWeight,
+	).Scan(&updatedAt); err != nil {
+		return fmt.Errorf("failed to save scoring rules: %w", err)
+	}
+	rules.UpdatedAt = &updatedAt
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/testutil"
+)
+
+func TestPostgresScoringRulesRepository(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	repo := NewPostgresScoringRulesRepository(db)
+	ctx := context.Background()
+
+	t.Run("no stored rules", func(t *testing.T) {
+		rules, err := repo.GetScoringRules(ctx)
+		require.NoError(t, err)
+		assert.Nil(t, rules)
+	})
+
+	t.Run("save and update", func(t *testing.T) {
+		rules := &entity.ScoringRules{
+			VideoTypeWeight:             2,
+			ArticleTypeWeight:           1.2,
+			RecencyTiers:                []entity.RecencyTier{{MaxAgeDays: 7, Score: 6}},
+			VideoEngagementMultiplier:   12,
+			ArticleEngagementMultiplier: 4,
+		}
+		require.NoError(t, repo.SaveScoringRules(ctx, rules))
+		require.NotNil(t, rules.UpdatedAt)
+
+		rules.VideoTypeWeight = 3
+		rules.RecencyDecay = entity.RecencyDecayExponential
+		rules.RecencyHalfLifeDays = 21
+		rules.EngagementScaling = entity.EngagementScalingLog
+		rules.EngagementCap = 25
+		rules.RecencyTiers = append(rules.RecencyTiers, entity.RecencyTier{MaxAgeDays: 60, Score: 2})
+		require.NoError(t, repo.SaveScoringRules(ctx, rules))
+
+		found, err := repo.GetScoringRules(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, found)
+		assert.Equal(t, 3.0, found.VideoTypeWeight)
+		assert.Equal(t, 1.2, found.ArticleTypeWeight)
+		assert.Equal(t, rules.RecencyTiers, found.RecencyTiers)
+		assert.Equal(t, 12.0, found.VideoEngagementMultiplier)
+		assert.Equal(t, entity.RecencyDecayExponential, found.RecencyDecay)
+		assert.Equal(t, 21.0, found.RecencyHalfLifeDays)
+		assert.Zero(t, found.RecencyMaxScore)
+		assert.Equal(t, entity.EngagementScalingLog, found.EngagementScaling)
+		assert.Equal(t, 25.0, found.EngagementCap)
+		assert.NotNil(t, found.UpdatedAt)
+	})
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// postgresSearchEventRepository PostgreSQL ile SearchEventRepository implementasyonu
+type postgresSearchEventRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresSearchEventRepository yeni bir PostgreSQL arama olayı repository oluşturur
+func NewPostgresSearchEventRepository(db *sql.DB) port.SearchEventRepository {
+	return &postgresSearchEventRepository{db: db}
+}
+
+// InsertSearchEvents olayları unnest ile tek sorguda ekler
+// contents ile join'lenir, böylece bilinmeyen content_id'ler hata yerine atlanır
+func (r *postgresSearchEventRepository) InsertSearchEvents(ctx context.Context, events []*entity.SearchEvent) (int, error) {
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	types := make([]string, len(events))
+	contentIDs := make([]int64, len(events))
+	queries := make([]string, len(events))
+	positions := make([]int64, len(events))
+	sessionIDs := make([]string, len(events))
+	apiKeyIDs := make([]int64, len(events))
+	weights := make([]float64, len(events))
+	for i, e := range events {
+		types[i] = e.Type
+		contentIDs[i] = e.ContentID
+		queries[i] = e.Query
+		positions[i] = int64(e.Position)
+		sessionIDs[i] = e.SessionID
+		apiKeyIDs[i] = e.APIKeyID
+		weights[i] = e.SampleWeight
+	}
+
+	query := `
+		INSERT INTO search_events (event_type, content_id, query, position, session_id, api_key_id, sample_weight, created_at)
+		SELECT u.event_type, u.content_id, u.query, NULLIF(u.position, 0), u.session_id, NULLIF(u.api_key_id, 0), u.sample_weight, NOW()
+		FROM unnest($1::text[], $2::int[], $3::text[], $4::int[], $5::text[], $6::int[], $7::real[])
+			AS u(event_type, content_id, query, position, session_id, api_key_id, sample_weight)
+		JOIN contents c ON c.id = u.content_id
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		pq.Array(types),
+		pq.Array(contentIDs),
+		pq.Array(queries),
+		pq.Array(positions),
+		pq.Array(sessionIDs),
+		pq.Array(apiKeyIDs),
+		pq.Array(weights),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert search events: %w", err)
+	}
+
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(inserted), nil
+}
+
+// ListContentCTR gösterimleri örnekleme ağırlıklarıyla toplar, en çok gösterilen içerikleri döner
+func (r *postgresSearchEventRepository) ListContentCTR(ctx context.Context, since time.Time, query string, limit int) ([]*entity.ContentCTR, error) {
+	sqlQuery := `
+		SELECT e.content_id, c.title, e.impressions, e.clicks
+		FROM (
+			SELECT content_id,
+				ROUND(COALESCE(SUM(sample_weight) FILTER (WHERE event_type = 'impression'), 0))::bigint AS impressions,
+				COUNT(*) FILTER (WHERE event_type = 'click') AS clicks
+			FROM search_events
+			WHERE created_at >= $1 AND ($2 = '' OR query = $2)
+			GROUP BY content_id
+		) e
+		JOIN contents c ON c.id = e.content_id
+		ORDER BY e.impressions DESC, e.clicks DESC, e.content_id
+		LIMIT $3
+	`
+
+	rows, err := r.db.QueryContext(ctx, sqlQuery, since, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list content CTR: %w", err)
+	}
+	defer rows.Close()
+
+	stats := make([]*entity.ContentCTR, 0)
+	for rows.Next() {
+		s := &entity.ContentCTR{}
+		if err := rows.Scan(&s.ContentID, &s.Title, &s.Impressions, &s.Clicks); err != nil {
+			return nil, fmt.Errorf("failed to scan content CTR: %w", err)
+		}
+		if s.Impressions > 0 {
+			s.CTR = float64(s.Clicks) / float64(s.Impressions)
+		}
+		stats = append(stats, s)
+	}
+
+	return stats, rows.Err()
+}
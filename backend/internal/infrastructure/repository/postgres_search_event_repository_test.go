@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/testutil"
+)
+
+func TestPostgresSearchEventRepository(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	contentRepo := NewPostgresContentRepository(db)
+	repo := NewPostgresSearchEventRepository(db)
+	provider := testutil.CreateTestProvider(t, db, "Events Provider", "json")
+	ctx := context.Background()
+
+	popular := &entity.Content{ProviderID: provider.ID, ProviderContentID: "popular", Title: "Go Concurrency", ContentType: entity.ContentTypeVideo, PublishedAt: time.Now()}
+	other := &entity.Content{ProviderID: provider.ID, ProviderContentID: "other", Title: "Rust Ownership", ContentType: entity.ContentTypeArticle, PublishedAt: time.Now()}
+	require.NoError(t, contentRepo.BulkUpsert(ctx, []*entity.Content{popular, other}))
+
+	t.Run("insert skips unknown contents", func(t *testing.T) {
+		inserted, err := repo.InsertSearchEvents(ctx, []*entity.SearchEvent{
+			{Type: entity.SearchEventImpression, ContentID: popular.ID, Query: "go", Position: 1, SampleWeight: 4},
+			{Type: entity.SearchEventImpression, ContentID: popular.ID, Query: "golang", SampleWeight: 1},
+			{Type: entity.SearchEventClick, ContentID: popular.ID, Query: "go", Position: 1, SessionID: "s1", SampleWeight: 1},
+			{Type: entity.SearchEventImpression, ContentID: other.ID, Query: "go", Position: 2, SampleWeight: 1},
+			{Type: entity.SearchEventClick, ContentID: 999999, Query: "go", SampleWeight: 1},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 4, inserted)
+	})
+
+	t.Run("CTR uses sample weights", func(t *testing.T) {
+		stats, err := repo.ListContentCTR(ctx, time.Now().Add(-time.Hour), "", 10)
+		require.NoError(t, err)
+		require.Len(t, stats, 2)
+
+		assert.Equal(t, popular.ID, stats[0].ContentID)
+		assert.Equal(t, "Go Concurrency", stats[0].Title)
+		assert.Equal(t, int64(5), stats[0].Impressions)
+		assert.Equal(t, int64(1), stats[0].Clicks)
+		assert.InDelta(t, 0.2, stats[0].CTR, 0.0001)
+
+		assert.Equal(t, int64(1), stats[1].Impressions)
+		assert.Zero(t, stats[1].CTR)
+	})
+
+	t.Run("CTR filtered by query", func(t *testing.T) {
+		stats, err := repo.ListContentCTR(ctx, time.Now().Add(-time.Hour), "golang", 10)
+		require.NoError(t, err)
+		require.Len(t, stats, 1)
+		assert.Equal(t, int64(1), stats[0].Impressions)
+		assert.Zero(t, stats[0].Clicks)
+
+		stats, err = repo.ListContentCTR(ctx, time.Now().Add(time.Hour), "", 10)
+		require.NoError(t, err)
+		assert.Empty(t, stats)
+	})
+}
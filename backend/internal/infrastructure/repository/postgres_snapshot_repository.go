@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// postgresSnapshotRepository PostgreSQL ile SnapshotRepository implementasyonu
+type postgresSnapshotRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresSnapshotRepository yeni bir PostgreSQL içerik yedeği repository oluşturur
+func NewPostgresSnapshotRepository(db *sql.DB) port.SnapshotRepository {
+	return &postgresSnapshotRepository{db: db}
+}
+
+// ListProviders pasif olanlar dahil tüm provider'ları getirir
+func (r *postgresSnapshotRepository) ListProviders(ctx context.Context) ([]*entity.Provider, error) {
+	query := `
+		SELECT id, name, url, format, mapping, auth, retry_policy, COALESCE(language, ''), is_active, auto_approve,
+			COALESCE(etag, ''), COALESCE(last_modified, ''), created_at, updated_at
+		FROM providers
+		ORDER BY id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list providers: %w", err)
+	}
+	defer rows.Close()
+
+	var providers []*entity.Provider
+	for rows.Next() {
+		provider, err := scanProvider(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan provider: %w", err)
+		}
+		providers = append(providers, provider)
+	}
+
+	return providers, rows.Err()
+}
+
+// ListCategories tüm kategorileri path sırasıyla (üst kategoriler alt kategorilerinden önce) getirir
+func (r *postgresSnapshotRepository) ListCategories(ctx context.Context) ([]*entity.Category, error) {
+	query := `
+		SELECT id, parent_id, name, slug, path, created_at, updated_at
+		FROM categories
+		ORDER BY path
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+	defer rows.Close()
+
+	var categories []*entity.Category
+	for rows.Next() {
+		c := &entity.Category{}
+		var parentID sql.NullInt64
+		if err := rows.Scan(&c.ID, &parentID, &c.Name, &c.Slug, &c.Path, &c.CreatedAt, &c.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan category: %w", err)
+		}
+		if parentID.Valid {
+			c.ParentID = &parentID.Int64
+		}
+		categories = append(categories, c)
+	}
+
+	return categories, rows.Err()
+}
+
+// ListAuthors tüm yazarları getirir
+func (r *postgresSnapshotRepository) ListAuthors(ctx context.Context) ([]*entity.Author, error) {
+	query := `
+		SELECT id, provider_id, external_id, name, COALESCE(url, ''), created_at, updated_at
+		FROM authors
+		ORDER BY id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list authors: %w", err)
+	}
+	defer rows.Close()
+
+	var authors []*entity.Author
+	for rows.Next() {
+		a := &entity.Author{}
+		if err := rows.Scan(&a.ID, &a.ProviderID, &a.ExternalID, &a.Name, &a.URL, &a.CreatedAt, &a.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan author: %w", err)
+		}
+		authors = append(authors, a)
+	}
+
+	return authors, rows.Err()
+}
+
+// ListContents silinmemiş içerikleri keyset sayfalama ile getirir
+// Arama indeksinin aksine onay bekleyen ve reddedilen içerikler de yedeğe dahildir
+func (r *postgresSnapshotRepository) ListContents(ctx context.Context, afterID int64, limit int) ([]*entity.Content, error) {
+	query := fmt.Sprintf(`
+		SELECT %s,
+			0.0 as relevance_score
+		FROM contents c
+		JOIN providers p ON c.provider_id = p.id
+		LEFT JOIN authors a ON c.author_id = a.id
+		LEFT JOIN categories cat ON c.category_id = cat.id
+		LEFT JOIN content_stats cs ON c.id = cs.content_id
+		LEFT JOIN content_scores csc ON c.id = csc.content_id
+		WHERE c.deleted = 0 AND c.id > $1
+		ORDER BY c.id
+		LIMIT $2
+	`, contentListColumns)
+
+	rows, err := r.db.QueryContext(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list contents for snapshot: %w", err)
+	}
+	defer rows.Close()
+
+	var contents []*entity.Content
+	for rows.Next() {
+		content, err := scanContentRow(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan content for snapshot: %w", err)
+		}
+		contents = append(contents, content)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	tags := &postgresContentRepository{db: r.db}
+	if err := tags.loadTagsForContents(ctx, contents); err != nil {
+		return nil, fmt.Errorf("failed to load tags for snapshot: %w", err)
+	}
+
+	return contents, nil
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/testutil"
+)
+
+func TestPostgresSnapshotRepository(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	repo := NewPostgresSnapshotRepository(db)
+	ctx := context.Background()
+
+	provider := testutil.CreateTestProvider(t, db, "Provider 1", "json")
+	inactive := testutil.CreateTestProvider(t, db, "Provider 2", "xml")
+	_, err := db.Exec("UPDATE providers SET is_active = false WHERE id = $1", inactive.ID)
+	require.NoError(t, err)
+
+	first := testutil.CreateTestContentWithScore(t, db, provider.ID, 7.5)
+	tag := testutil.CreateTestTag(t, db, "golang")
+	testutil.AddTagToContent(t, db, first.ID, tag.ID)
+	pending := testutil.CreateTestContent(t, db, provider.ID, entity.ContentTypeArticle)
+	_, err = db.Exec("UPDATE contents SET status = 'pending' WHERE id = $1", pending.ID)
+	require.NoError(t, err)
+	deleted := testutil.CreateTestContent(t, db, provider.ID, entity.ContentTypeVideo)
+	_, err = db.Exec("UPDATE contents SET deleted = 1 WHERE id = $1", deleted.ID)
+	require.NoError(t, err)
+
+	t.Run("lists inactive providers", func(t *testing.T) {
+		providers, err := repo.ListProviders(ctx)
+		require.NoError(t, err)
+		require.Len(t, providers, 2)
+		assert.False(t, providers[1].IsActive)
+	})
+
+	t.Run("lists non-deleted contents with scores and tags", func(t *testing.T) {
+		contents, err := repo.ListContents(ctx, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, contents, 2)
+
+		assert.Equal(t, first.ID, contents[0].ID)
+		require.NotNil(t, contents[0].Score)
+		assert.Equal(t, 7.5, contents[0].Score.FinalScore)
+		require.Len(t, contents[0].Tags, 1)
+		assert.Equal(t, "golang", contents[0].Tags[0].Name)
+		assert.Equal(t, entity.ContentStatusPending, contents[1].Status)
+
+		contents, err = repo.ListContents(ctx, first.ID, 10)
+		require.NoError(t, err)
+		require.Len(t, contents, 1)
+		assert.Equal(t, pending.ID, contents[0].ID)
+	})
+}
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// queryer *sql.DB ve *sql.Tx'in ortak sorgu metodları
+type queryer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// txKey context içinde aktif transaction'ı taşımak için kullanılan anahtar
+type txKey struct{}
+
+// txState aktif transaction ve iç içe savepoint derinliği
+// Aynı transaction eşzamanlı kullanılmamalıdır (database/sql.Tx de desteklemez)
+type txState struct {
+	tx    *sql.Tx
+	depth int
+}
+
+// postgresTransactor PostgreSQL ile Transactor implementasyonu
+type postgresTransactor struct {
+	db *sql.DB
+}
+
+// NewPostgresTransactor yeni bir PostgreSQL transactor oluşturur
+func NewPostgresTransactor(db *sql.DB) port.Transactor {
+	return &postgresTransactor{db: db}
+}
+
+// WithinTransaction fn'i transaction içinde çalıştırır
+// Context'te zaten transaction varsa savepoint açılır
+func (t *postgresTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		return t.withinSavepoint(ctx, state, fn)
+	}
+
+	tx, err := t.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("transaction başlatılamadı: %w", err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err := applyAuditInfo(ctx, tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := fn(context.WithValue(ctx, txKey{}, &txState{tx: tx})); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("transaction commit edilemedi: %w", err)
+	}
+	return nil
+}
+
+// applyAuditInfo context'teki audit bilgisini transaction'a yerel ayarlar olarak yazar
+// content_audit trigger'ları actor ve sync job ID'sini bu ayarlardan okur (bkz. 022_create_content_audit)
+func applyAuditInfo(ctx context.Context, tx *sql.Tx) error {
+	info, ok := port.AuditInfoFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	_, err := tx.ExecContext(ctx,
+		"SELECT set_config('search_engine.audit_actor', $1, true), set_config('search_engine.sync_job_id', $2, true)",
+		info.Actor, info.SyncJobID,
+	)
+	if err != nil {
+		return fmt.Errorf("audit bilgisi ayarlanamadı: %w", err)
+	}
+	return nil
+}
+
+// withinSavepoint fn'i mevcut transaction içinde bir savepoint ile çalıştırır
+func (t *postgresTransactor) withinSavepoint(ctx context.Context, state *txState, fn func(ctx context.Context) error) error {
+	state.depth++
+	defer func() { state.depth-- }()
+	name := fmt.Sprintf("sp_%d", state.depth)
+
+	if _, err := state.tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("savepoint oluşturulamadı: %w", err)
+	}
+
+	if err := fn(ctx); err != nil {
+		if _, rbErr := state.tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); rbErr != nil {
+			return fmt.Errorf("%w (savepoint geri alınamadı: %v)", err, rbErr)
+		}
+		return err
+	}
+
+	if _, err := state.tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("savepoint serbest bırakılamadı: %w", err)
+	}
+	return nil
+}
+
+// conn context'te transaction varsa onu, yoksa db'yi döner
+func conn(ctx context.Context, db *sql.DB) queryer {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		return state.tx
+	}
+	return db
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/testutil"
+)
+
+func TestPostgresTransactor_WithinTransaction(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	repo := NewPostgresContentRepository(db)
+	transactor := NewPostgresTransactor(db)
+	ctx := context.Background()
+
+	provider := testutil.CreateTestProvider(t, db, "Test Provider", "json")
+	newContent := func(id string) *entity.Content {
+		return &entity.Content{
+			ProviderID:        provider.ID,
+			ProviderContentID: id,
+			Title:             "Content " + id,
+			ContentType:       entity.ContentTypeVideo,
+		}
+	}
+
+	t.Run("rolls back all writes on error", func(t *testing.T) {
+		content := newContent("tx-rollback")
+		err := transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			require.NoError(t, repo.Upsert(ctx, content))
+			require.NoError(t, repo.AddTags(ctx, content.ID, []string{"tx"}))
+			return errors.New("boom")
+		})
+		require.Error(t, err)
+
+		_, err = repo.FindByID(ctx, content.ID)
+		assert.Error(t, err)
+	})
+
+	t.Run("nested failure only rolls back its savepoint", func(t *testing.T) {
+		kept, dropped := newContent("tx-kept"), newContent("tx-dropped")
+		err := transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			require.NoError(t, repo.Upsert(ctx, kept))
+			nestedErr := transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+				require.NoError(t, repo.Upsert(ctx, dropped))
+				return errors.New("boom")
+			})
+			assert.Error(t, nestedErr)
+			return nil
+		})
+		require.NoError(t, err)
+
+		_, err = repo.FindByID(ctx, kept.ID)
+		assert.NoError(t, err)
+		_, err = repo.FindByID(ctx, dropped.ID)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,292 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// postgresWebhookRepository PostgreSQL ile WebhookRepository implementasyonu
+type postgresWebhookRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresWebhookRepository yeni bir PostgreSQL webhook repository oluşturur
+func NewPostgresWebhookRepository(db *sql.DB) port.WebhookRepository {
+	return &postgresWebhookRepository{db: db}
+}
+
+// webhookColumns abonelik sorgularında okunan kolonlar (scanWebhook ile aynı sırada)
+const webhookColumns = `id, name, url, secret, query, tags, content_type, provider_id, changes, active, created_at, updated_at`
+
+// webhookDeliveryColumns gönderim sorgularında okunan kolonlar (scanWebhookDelivery ile aynı sırada)
+const webhookDeliveryColumns = `id, subscription_id, payload, item_count, status, attempts, next_attempt_at,
+	last_status_code, last_error, created_at, delivered_at`
+
+// CreateWebhook yeni bir abonelik kaydeder
+func (r *postgresWebhookRepository) CreateWebhook(ctx context.Context, sub *entity.WebhookSubscription) error {
+	query := `
+		INSERT INTO webhook_subscriptions (name, url, secret, query, tags, content_type, provider_id, changes, active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW())
+		RETURNING id, created_at, updated_at
+	`
+
+	if err := r.db.QueryRowContext(ctx, query,
+		sub.Name, sub.URL, sub.Secret, sub.Query, pq.Array(nonNilStrings(sub.Tags)), string(sub.ContentType),
+		nullableProviderID(sub.ProviderID), pq.Array(nonNilStrings(sub.Changes)), sub.Active,
+	).Scan(&sub.ID, &sub.CreatedAt, &sub.UpdatedAt); err != nil {
+		return fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateWebhook aboneliğin düzenlenebilir alanlarını günceller
+func (r *postgresWebhookRepository) UpdateWebhook(ctx context.Context, sub *entity.WebhookSubscription) error {
+	query := `
+		UPDATE webhook_subscriptions
+		SET name = $2, url = $3, query = $4, tags = $5, content_type = $6, provider_id = $7,
+			changes = $8, active = $9, updated_at = NOW()
+		WHERE id = $1
+		RETURNING secret, created_at, updated_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		sub.ID, sub.Name, sub.URL, sub.Query, pq.Array(nonNilStrings(sub.Tags)), string(sub.ContentType),
+		nullableProviderID(sub.ProviderID), pq.Array(nonNilStrings(sub.Changes)), sub.Active,
+	).Scan(&sub.Secret, &sub.CreatedAt, &sub.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return port.ErrWebhookNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update webhook: %w", err)
+	}
+
+	return nil
+}
+
+// GetWebhook aboneliği getirir
+func (r *postgresWebhookRepository) GetWebhook(ctx context.Context, id int64) (*entity.WebhookSubscription, error) {
+	query := `SELECT ` + webhookColumns + ` FROM webhook_subscriptions WHERE id = $1`
+
+	sub, err := scanWebhook(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, port.ErrWebhookNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook: %w", err)
+	}
+
+	return sub, nil
+}
+
+// ListWebhooks tüm abonelikleri oluşturulma sırasıyla getirir
+func (r *postgresWebhookRepository) ListWebhooks(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	query := `SELECT ` + webhookColumns + ` FROM webhook_subscriptions ORDER BY id`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+	defer rows.Close()
+
+	subs := make([]*entity.WebhookSubscription, 0)
+	for rows.Next() {
+		sub, err := scanWebhook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook: %w", err)
+		}
+		subs = append(subs, sub)
+	}
+
+	return subs, rows.Err()
+}
+
+// DeleteWebhook aboneliği siler; gönderimleri ON DELETE CASCADE ile silinir
+func (r *postgresWebhookRepository) DeleteWebhook(ctx context.Context, id int64) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM webhook_subscriptions WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+	if affected == 0 {
+		return port.ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+// EnqueueWebhookDeliveries gönderimleri tek transaction içinde kuyruğa ekler
+func (r *postgresWebhookRepository) EnqueueWebhookDeliveries(ctx context.Context, deliveries []*entity.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO webhook_deliveries (subscription_id, payload, item_count, status, next_attempt_at, created_at)
+		VALUES ($1, $2, $3, 'pending', NOW(), NOW())
+		RETURNING id, status, next_attempt_at, created_at
+	`
+	for _, delivery := range deliveries {
+		if err := tx.QueryRowContext(ctx, query, delivery.SubscriptionID, []byte(delivery.Payload), delivery.ItemCount).Scan(
+			&delivery.ID, &delivery.Status, &delivery.NextAttemptAt, &delivery.CreatedAt,
+		); err != nil {
+			return fmt.Errorf("failed to enqueue webhook delivery: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit webhook deliveries: %w", err)
+	}
+	return nil
+}
+
+// ClaimDueWebhookDeliveries zamanı gelmiş gönderimleri SKIP LOCKED ile sahiplenir
+func (r *postgresWebhookRepository) ClaimDueWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*entity.WebhookDelivery, error) {
+	query := `
+		UPDATE webhook_deliveries
+		SET next_attempt_at = NOW() + $2 * INTERVAL '1 millisecond'
+		WHERE id IN (
+			SELECT d.id
+			FROM webhook_deliveries d
+			JOIN webhook_subscriptions s ON s.id = d.subscription_id
+			WHERE d.status = 'pending' AND d.next_attempt_at <= NOW() AND s.active
+			ORDER BY d.next_attempt_at, d.id
+			LIMIT $1
+			FOR UPDATE OF d SKIP LOCKED
+		)
+		RETURNING ` + webhookDeliveryColumns
+
+	rows, err := r.db.QueryContext(ctx, query, limit, lease.Milliseconds())
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := make([]*entity.WebhookDelivery, 0)
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, rows.Err()
+}
+
+// UpdateWebhookDelivery deneme sonucunu kaydeder
+func (r *postgresWebhookRepository) UpdateWebhookDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = $2, attempts = $3, next_attempt_at = $4, last_status_code = $5, last_error = $6, delivered_at = $7
+		WHERE id = $1
+	`
+
+	var deliveredAt sql.NullTime
+	if delivery.DeliveredAt != nil {
+		deliveredAt = sql.NullTime{Time: *delivery.DeliveredAt, Valid: true}
+	}
+
+	if _, err := r.db.ExecContext(ctx, query,
+		delivery.ID, delivery.Status, delivery.Attempts, delivery.NextAttemptAt,
+		delivery.LastStatusCode, delivery.LastError, deliveredAt,
+	); err != nil {
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+// ListWebhookDeliveries aboneliğin son gönderimlerini getirir
+func (r *postgresWebhookRepository) ListWebhookDeliveries(ctx context.Context, subscriptionID int64, limit int) ([]*entity.WebhookDelivery, error) {
+	query := `SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries
+		WHERE subscription_id = $1
+		ORDER BY id DESC
+		LIMIT $2`
+
+	rows, err := r.db.QueryContext(ctx, query, subscriptionID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := make([]*entity.WebhookDelivery, 0)
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, rows.Err()
+}
+
+// scanWebhook tek bir abonelik satırını okur
+func scanWebhook(row rowScanner) (*entity.WebhookSubscription, error) {
+	sub := &entity.WebhookSubscription{}
+	var (
+		contentType string
+		providerID  sql.NullInt64
+	)
+	if err := row.Scan(
+		&sub.ID, &sub.Name, &sub.URL, &sub.Secret, &sub.Query, pq.Array(&sub.Tags), &contentType,
+		&providerID, pq.Array(&sub.Changes), &sub.Active, &sub.CreatedAt, &sub.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	sub.ContentType = entity.ContentType(contentType)
+	sub.ProviderID = providerID.Int64
+	return sub, nil
+}
+
+// scanWebhookDelivery tek bir gönderim satırını okur
+func scanWebhookDelivery(row rowScanner) (*entity.WebhookDelivery, error) {
+	delivery := &entity.WebhookDelivery{}
+	var (
+		payload     []byte
+		deliveredAt sql.NullTime
+	)
+	if err := row.Scan(
+		&delivery.ID, &delivery.SubscriptionID, &payload, &delivery.ItemCount, &delivery.Status, &delivery.Attempts,
+		&delivery.NextAttemptAt, &delivery.LastStatusCode, &delivery.LastError, &delivery.CreatedAt, &deliveredAt,
+	); err != nil {
+		return nil, err
+	}
+	delivery.Payload = payload
+	if deliveredAt.Valid {
+		delivery.DeliveredAt = &deliveredAt.Time
+	}
+	return delivery, nil
+}
+
+// nullableProviderID 0 (tüm provider'lar) için NULL döner
+func nullableProviderID(id int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: id, Valid: id > 0}
+}
+
+// nonNilStrings nil slice'ı boş diziye çevirir (NOT NULL TEXT[] kolonları için)
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/testutil"
+)
+
+func TestPostgresWebhookRepository(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	repo := NewPostgresWebhookRepository(db)
+	ctx := context.Background()
+
+	sub := &entity.WebhookSubscription{
+		Name:        "partner-a",
+		URL:         "https://partner.example.com/hooks",
+		Secret:      "whsec_test",
+		Query:       "golang",
+		Tags:        []string{"tutorial"},
+		ContentType: entity.ContentTypeVideo,
+		Changes:     []string{entity.ContentChangeCreated},
+		Active:      true,
+	}
+
+	t.Run("create, get and update", func(t *testing.T) {
+		require.NoError(t, repo.CreateWebhook(ctx, sub))
+		assert.NotZero(t, sub.ID)
+
+		found, err := repo.GetWebhook(ctx, sub.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "whsec_test", found.Secret)
+		assert.Equal(t, []string{"tutorial"}, found.Tags)
+		assert.Equal(t, entity.ContentTypeVideo, found.ContentType)
+		assert.Zero(t, found.ProviderID)
+
+		found.Query = "rust"
+		found.Active = false
+		require.NoError(t, repo.UpdateWebhook(ctx, found))
+
+		subs, err := repo.ListWebhooks(ctx)
+		require.NoError(t, err)
+		require.Len(t, subs, 1)
+		assert.Equal(t, "rust", subs[0].Query)
+		assert.False(t, subs[0].Active)
+
+		_, err = repo.GetWebhook(ctx, sub.ID+100)
+		assert.ErrorIs(t, err, port.ErrWebhookNotFound)
+	})
+
+	t.Run("claim only due deliveries of active subscriptions", func(t *testing.T) {
+		delivery := &entity.WebhookDelivery{SubscriptionID: sub.ID, Payload: []byte(`{"event":"content.matched"}`), ItemCount: 1}
+		require.NoError(t, repo.EnqueueWebhookDeliveries(ctx, []*entity.WebhookDelivery{delivery}))
+		assert.NotZero(t, delivery.ID)
+		assert.Equal(t, entity.WebhookDeliveryPending, delivery.Status)
+
+		// Abonelik pasif: gönderim sahiplenilmez
+		claimed, err := repo.ClaimDueWebhookDeliveries(ctx, 10, time.Minute)
+		require.NoError(t, err)
+		assert.Empty(t, claimed)
+
+		sub.Active = true
+		require.NoError(t, repo.UpdateWebhook(ctx, sub))
+
+		claimed, err = repo.ClaimDueWebhookDeliveries(ctx, 10, time.Minute)
+		require.NoError(t, err)
+		require.Len(t, claimed, 1)
+		assert.JSONEq(t, `{"event":"content.matched"}`, string(claimed[0].Payload))
+
+		// Lease süresince tekrar sahiplenilmez
+		again, err := repo.ClaimDueWebhookDeliveries(ctx, 10, time.Minute)
+		require.NoError(t, err)
+		assert.Empty(t, again)
+
+		now := time.Now()
+		claimed[0].Status = entity.WebhookDeliveryDelivered
+		claimed[0].Attempts = 1
+		claimed[0].LastStatusCode = 200
+		claimed[0].DeliveredAt = &now
+		require.NoError(t, repo.UpdateWebhookDelivery(ctx, claimed[0]))
+
+		deliveries, err := repo.ListWebhookDeliveries(ctx, sub.ID, 10)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, entity.WebhookDeliveryDelivered, deliveries[0].Status)
+		assert.Equal(t, 200, deliveries[0].LastStatusCode)
+		assert.NotNil(t, deliveries[0].DeliveredAt)
+	})
+
+	t.Run("delete cascades to deliveries", func(t *testing.T) {
+		require.NoError(t, repo.DeleteWebhook(ctx, sub.ID))
+		assert.ErrorIs(t, repo.DeleteWebhook(ctx, sub.ID), port.ErrWebhookNotFound)
+
+		deliveries, err := repo.ListWebhookDeliveries(ctx, sub.ID, 10)
+		require.NoError(t, err)
+		assert.Empty(t, deliveries)
+	})
+}
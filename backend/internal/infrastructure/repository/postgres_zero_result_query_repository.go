@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// postgresZeroResultQueryRepository PostgreSQL ile ZeroResultQueryRepository implementasyonu
+// Sayaçlar sorgu başına günlük satırlarda tutulur
+type postgresZeroResultQueryRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresZeroResultQueryRepository yeni bir PostgreSQL sonuçsuz sorgu repository oluşturur
+func NewPostgresZeroResultQueryRepository(db *sql.DB) port.ZeroResultQueryRepository {
+	return &postgresZeroResultQueryRepository{db: db}
+}
+
+// RecordZeroResultQuery bugünün satırını oluşturur veya sayacını artırır
+func (r *postgresZeroResultQueryRepository) RecordZeroResultQuery(ctx context.Context, query string) error {
+	sqlQuery := `
+		INSERT INTO zero_result_queries (query, day, search_count, last_seen_at)
+		VALUES ($1, CURRENT_DATE, 1, NOW())
+		ON CONFLICT (query, day) DO UPDATE SET
+			search_count = zero_result_queries.search_count + 1,
+			last_seen_at = EXCLUDED.last_seen_at
+	`
+
+	if _, err := r.db.ExecContext(ctx, sqlQuery, query); err != nil {
+		return fmt.Errorf("failed to record zero result query: %w", err)
+	}
+	return nil
+}
+
+// ListZeroResultQueries since'in gününden itibaren günlük sayaçları toplar
+func (r *postgresZeroResultQueryRepository) ListZeroResultQueries(ctx context.Context, since time.Time, limit int) ([]*entity.ZeroResultQuery, error) {
+	sqlQuery := `
+		SELECT query, SUM(search_count)::bigint AS total, MAX(last_seen_at) AS last_seen_at
+		FROM zero_result_queries
+		WHERE day >= $1::date
+		GROUP BY query
+		ORDER BY total DESC, last_seen_at DESC, query
+		LIMIT $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, sqlQuery, since, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list zero result queries: %w", err)
+	}
+	defer rows.Close()
+
+	queries := make([]*entity.ZeroResultQuery, 0)
+	for rows.Next() {
+		q := &entity.ZeroResultQuery{}
+		if err := rows.Scan(&q.Query, &q.Count, &q.LastSeenAt); err != nil {
+			return nil, fmt.Errorf("failed to scan zero result query: %w", err)
+		}
+		queries = append(queries, q)
+	}
+
+	return queries, rows.Err()
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/testutil"
+)
+
+func TestPostgresZeroResultQueryRepository(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	repo := NewPostgresZeroResultQueryRepository(db)
+	ctx := context.Background()
+
+	for _, query := range []string{"kubernets", "kubernets", "rust async", "kubernets"} {
+		require.NoError(t, repo.RecordZeroResultQuery(ctx, query))
+	}
+
+	// Pencere dışındaki eski gün sayılmaz
+	_, err := db.Exec(`INSERT INTO zero_result_queries (query, day, search_count, last_seen_at)
+		VALUES ('rust async', CURRENT_DATE - 30, 10, NOW() - INTERVAL '30 days')`)
+	require.NoError(t, err)
+
+	t.Run("sums daily counts within window", func(t *testing.T) {
+		queries, err := repo.ListZeroResultQueries(ctx, time.Now().AddDate(0, 0, -7), 10)
+		require.NoError(t, err)
+		require.Len(t, queries, 2)
+
+		assert.Equal(t, "kubernets", queries[0].Query)
+		assert.Equal(t, int64(3), queries[0].Count)
+		assert.WithinDuration(t, time.Now(), queries[0].LastSeenAt, time.Minute)
+		assert.Equal(t, "rust async", queries[1].Query)
+		assert.Equal(t, int64(1), queries[1].Count)
+	})
+
+	t.Run("wider window and limit", func(t *testing.T) {
+		queries, err := repo.ListZeroResultQueries(ctx, time.Now().AddDate(0, 0, -60), 1)
+		require.NoError(t, err)
+		require.Len(t, queries, 1)
+		assert.Equal(t, "rust async", queries[0].Query)
+		assert.Equal(t, int64(11), queries[0].Count)
+	})
+}
@@ -0,0 +1,206 @@
+package repository
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/metrics"
+)
+
+// tableNamePattern metrik etiketi olarak kabul edilen tablo adları (schema.tablo dahil)
+var tableNamePattern = regexp.MustCompile(`^[a-z_][a-z0-9_]*(\.[a-z_][a-z0-9_]*)?$`)
+
+// metricsConnector sürücü bağlantılarını database_queries_total ve
+// database_query_duration_seconds metriklerini yazan bağlantılarla sarar
+// Havuz, transaction ve tüm repository'lerin sorguları tek noktadan ölçülür
+type metricsConnector struct {
+	next driver.Connector
+}
+
+// withQueryMetrics c'nin açtığı bağlantılardaki sorguları operation/table etiketleriyle ölçer
+func withQueryMetrics(c driver.Connector) driver.Connector {
+	return &metricsConnector{next: c}
+}
+
+func (c *metricsConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.next.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &metricsConn{Conn: conn}, nil
+}
+
+func (c *metricsConnector) Driver() driver.Driver {
+	return c.next.Driver()
+}
+
+// metricsConn sorguları ölçüp asıl bağlantıya iletir
+// database/sql opsiyonel arayüzleri tip kontrolüyle bulduğundan sürücünün desteklediği
+// arayüzler burada açıkça iletilir. QueryContext için ölçülen süre ilk satırların dönmesine kadardır
+type metricsConn struct {
+	driver.Conn
+}
+
+func (c *metricsConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryer, ok := c.Conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	start := time.Now()
+	rows, err := queryer.QueryContext(ctx, query, args)
+	recordQuery(query, start, err)
+	return rows, err
+}
+
+func (c *metricsConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	execer, ok := c.Conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	start := time.Now()
+	result, err := execer.ExecContext(ctx, query, args)
+	recordQuery(query, start, err)
+	return result, err
+}
+
+func (c *metricsConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	if preparer, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		return preparer.PrepareContext(ctx, query)
+	}
+	return c.Conn.Prepare(query)
+}
+
+func (c *metricsConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if beginner, ok := c.Conn.(driver.ConnBeginTx); ok {
+		return beginner.BeginTx(ctx, opts)
+	}
+	return c.Conn.Begin()
+}
+
+func (c *metricsConn) Ping(ctx context.Context) error {
+	if pinger, ok := c.Conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+func (c *metricsConn) ResetSession(ctx context.Context) error {
+	if resetter, ok := c.Conn.(driver.SessionResetter); ok {
+		return resetter.ResetSession(ctx)
+	}
+	return nil
+}
+
+func (c *metricsConn) IsValid() bool {
+	if validator, ok := c.Conn.(driver.Validator); ok {
+		return validator.IsValid()
+	}
+	return true
+}
+
+// recordQuery sorguyu metriklere yazar; sürücünün ErrSkip ile reddettiği (database/sql'in
+// başka yoldan tekrar göndereceği) sorgular sayılmaz
+func recordQuery(query string, start time.Time, err error) {
+	if errors.Is(err, driver.ErrSkip) {
+		return
+	}
+	operation, table := queryLabels(query)
+	metrics.RecordDatabaseQuery(operation, table, time.Since(start).Seconds())
+}
+
+// queryLabels sorgunun ana komutunu (select, insert ...) ve hedef tablosunu döner
+// WITH ile başlayan sorgularda CTE'lerden sonraki ana komut esas alınır. FROM'un hedefi alt sorguysa
+// alt sorgunun tablosu kullanılır. Tablosu olmayan komutlar (SAVEPOINT, SELECT 1) "none",
+// fonksiyon gibi tablo adı olmayan hedefler "unknown" etiketini alır
+func queryLabels(query string) (operation, table string) {
+	tokens := sqlTokens(query)
+	if len(tokens) == 0 {
+		return "unknown", "none"
+	}
+
+	depth, verbAt := 0, -1
+	for i, token := range tokens {
+		switch token {
+		case "(":
+			depth++
+		case ")":
+			depth--
+		case "select", "insert", "update", "delete":
+			if depth == 0 && verbAt < 0 {
+				verbAt = i
+			}
+		}
+	}
+	if verbAt < 0 {
+		return tokens[0], "none"
+	}
+
+	operation = tokens[verbAt]
+	target := "from"
+	switch operation {
+	case "insert":
+		target = "into"
+	case "update":
+		target = "update"
+	}
+	return operation, queryTable(tokens[verbAt:], target)
+}
+
+// queryTable tokens içinde en dış seviyedeki target anahtar kelimesinden sonraki tabloyu döner
+func queryTable(tokens []string, target string) string {
+	depth := 0
+	for i, token := range tokens {
+		switch token {
+		case "(":
+			depth++
+		case ")":
+			depth--
+		}
+		if depth != 0 || token != target || i+1 >= len(tokens) {
+			continue
+		}
+
+		next := tokens[i+1]
+		if next == "(" {
+			// FROM (SELECT ... FROM tablo) alias
+			return queryTable(subquery(tokens[i+2:]), "from")
+		}
+		if i+2 < len(tokens) && tokens[i+2] == "(" && target == "from" {
+			// FROM unnest(...) gibi fonksiyon çağrıları
+			return "unknown"
+		}
+		name := strings.ReplaceAll(next, `"`, "")
+		if !tableNamePattern.MatchString(name) {
+			return "unknown"
+		}
+		return name
+	}
+	return "none"
+}
+
+// subquery açılmış bir parantezin kapanışına kadarki token'ları döner
+func subquery(tokens []string) []string {
+	depth := 1
+	for i, token := range tokens {
+		switch token {
+		case "(":
+			depth++
+		case ")":
+			depth--
+			if depth == 0 {
+				return tokens[:i]
+			}
+		}
+	}
+	return tokens
+}
+
+// sqlTokens sorguyu küçük harfli kelimelere ayırır; parantezler ayrı token olur, virgül ve noktalı virgül atılır
+func sqlTokens(query string) []string {
+	replacer := strings.NewReplacer("(", " ( ", ")", " ) ", ",", " ", ";", " ")
+	return strings.Fields(strings.ToLower(replacer.Replace(query)))
+}
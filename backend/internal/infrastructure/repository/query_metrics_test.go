@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/metrics"
+)
+
+// fakeConnector hiçbir satır dönmeyen, çalıştırdığı sorguları kaydeden sahte sürücü bağlantıları açar
+type fakeConnector struct {
+	queries []string
+}
+
+func (c *fakeConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	return &fakeConn{connector: c}, nil
+}
+
+func (c *fakeConnector) Driver() driver.Driver { return nil }
+
+type fakeConn struct {
+	connector *fakeConnector
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) { return nil, driver.ErrSkip }
+func (c *fakeConn) Close() error                              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)                 { return fakeTx{}, nil }
+
+func (c *fakeConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.connector.queries = append(c.connector.queries, query)
+	return fakeRows{}, nil
+}
+
+func (c *fakeConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.connector.queries = append(c.connector.queries, query)
+	return driver.RowsAffected(1), nil
+}
+
+type fakeTx struct{}
+
+func (fakeTx) Commit() error   { return nil }
+func (fakeTx) Rollback() error { return nil }
+
+type fakeRows struct{}
+
+func (fakeRows) Columns() []string              { return []string{"id"} }
+func (fakeRows) Close() error                   { return nil }
+func (fakeRows) Next(dest []driver.Value) error { return io.EOF }
+
+func TestQueryMetrics(t *testing.T) {
+	connector := &fakeConnector{}
+	db := sql.OpenDB(withQueryMetrics(connector))
+	defer db.Close()
+
+	selects := metrics.DatabaseQueriesTotal.WithLabelValues("select", "query_metrics_test")
+	updates := metrics.DatabaseQueriesTotal.WithLabelValues("update", "query_metrics_test")
+	beforeSelects, beforeUpdates := testutil.ToFloat64(selects), testutil.ToFloat64(updates)
+
+	ctx := context.Background()
+	rows, err := db.QueryContext(ctx, "SELECT id FROM query_metrics_test WHERE id = $1", 1)
+	require.NoError(t, err)
+	rows.Close()
+
+	tx, err := db.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	_, err = tx.ExecContext(ctx, "UPDATE query_metrics_test SET title = $1", "Go")
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+
+	assert.Len(t, connector.queries, 2)
+	assert.Equal(t, beforeSelects+1, testutil.ToFloat64(selects))
+	assert.Equal(t, beforeUpdates+1, testutil.ToFloat64(updates))
+}
+
+func TestQueryLabels(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		operation string
+		table     string
+	}{
+		{"select", "\n\t\tSELECT c.id, c.title\n\t\tFROM contents c\n\t\tWHERE c.id = $1", "select", "contents"},
+		{"function in select list", "SELECT EXTRACT(EPOCH FROM published_at) FROM contents", "select", "contents"},
+		{"insert", "INSERT INTO contents (provider_id, title) VALUES ($1, $2)", "insert", "contents"},
+		{"update", "UPDATE contents SET deleted = 1 WHERE provider_id = $1", "update", "contents"},
+		{"delete", "DELETE FROM boost_rules WHERE tag = $1", "delete", "boost_rules"},
+		{"quoted schema table", `SELECT id FROM public."contents"`, "select", "public.contents"},
+		{"count over subquery", "SELECT COUNT(*) FROM (SELECT 1 FROM contents c WHERE c.deleted = 0 LIMIT 1001) capped", "select", "contents"},
+		{"cte uses main statement", "WITH upserted AS (INSERT INTO tags (name) SELECT unnest($2::text[]) RETURNING id) INSERT INTO content_tags (content_id, tag_id) SELECT $1, id FROM upserted", "insert", "content_tags"},
+		{"table function", "SELECT e.reason FROM unnest($1::text[]) AS e(reason)", "select", "unknown"},
+		{"no table", "SELECT set_config('search_engine.audit_actor', $1, true)", "select", "none"},
+		{"savepoint", "SAVEPOINT sp_1", "savepoint", "none"},
+		{"empty", "  ", "unknown", "none"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operation, table := queryLabels(tt.query)
+			assert.Equal(t, tt.operation, operation)
+			assert.Equal(t, tt.table, table)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+)
+
+// loadIndexBatch ID'si afterID'den büyük en fazla limit silinmemiş içeriği tag'leriyle birlikte ID sırasıyla okur
+// Arama indeksleri (Elasticsearch, embedded) yeniden oluşturulurken kullanılır
+func (r *postgresContentRepository) loadIndexBatch(ctx context.Context, afterID int64, limit int) ([]*entity.Content, error) {
+	query := fmt.Sprintf(`
+		SELECT %s,
+			0.0 as relevance_score
+		FROM contents c
+		JOIN providers p ON c.provider_id = p.id
+		LEFT JOIN authors a ON c.author_id = a.id
+		LEFT JOIN categories cat ON c.category_id = cat.id
+		LEFT JOIN content_stats cs ON c.id = cs.content_id
+		LEFT JOIN content_scores csc ON c.id = csc.content_id
+		WHERE c.deleted = 0 AND c.status = 'approved' AND c.id > $1
+		ORDER BY c.id
+		LIMIT $2
+	`, contentListColumns)
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var contents []*entity.Content
+	for rows.Next() {
+		content, err := scanContentRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		contents = append(contents, content)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadTagsForContents(ctx, contents); err != nil {
+		return nil, err
+	}
+
+	return contents, nil
+}
+
+// findDuplicateIDs içerikler arasından kanoniği silinmemiş kopyaların ID'lerini döner
+// (Postgres aramasındaki collapse_duplicates koşuluyla aynı)
+func (r *postgresContentRepository) findDuplicateIDs(ctx context.Context, contents []*entity.Content) (map[int64]bool, error) {
+	ids := make([]int64, 0, len(contents))
+	for _, c := range contents {
+		if c.CanonicalContentID != nil {
+			ids = append(ids, c.ID)
+		}
+	}
+	duplicates := make(map[int64]bool, len(ids))
+	if len(ids) == 0 {
+		return duplicates, nil
+	}
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, `
+		SELECT c.id FROM contents c
+		WHERE c.id = ANY($1) AND EXISTS (
+			SELECT 1 FROM contents cc WHERE cc.id = c.canonical_content_id AND cc.deleted = 0
+		)
+	`, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		duplicates[id] = true
+	}
+	return duplicates, rows.Err()
+}
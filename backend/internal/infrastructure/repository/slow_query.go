@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/logger"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/metrics"
+)
+
+// maxLoggedParamLength log'a yazılan tek bir parametrenin azami uzunluğu (uzun metinler kısaltılır)
+const maxLoggedParamLength = 200
+
+// slowQueryLogger eşik süreyi aşan sorguları request ID ile log'layan queryer sarmalayıcısı
+// QueryContext için ölçülen süre ilk satırların dönmesine kadardır; satırların okunması dahil değildir
+type slowQueryLogger struct {
+	next       queryer
+	repository string
+	threshold  time.Duration
+}
+
+// withSlowQueryLog threshold sıfırdan büyükse q'yu slow query log'u ile sarar
+func withSlowQueryLog(q queryer, repository string, threshold time.Duration) queryer {
+	if threshold <= 0 {
+		return q
+	}
+	return &slowQueryLogger{next: q, repository: repository, threshold: threshold}
+}
+
+func (s *slowQueryLogger) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	start := time.Now()
+	result, err := s.next.ExecContext(ctx, query, args...)
+	s.observe(ctx, query, args, time.Since(start))
+	return result, err
+}
+
+func (s *slowQueryLogger) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	start := time.Now()
+	rows, err := s.next.QueryContext(ctx, query, args...)
+	s.observe(ctx, query, args, time.Since(start))
+	return rows, err
+}
+
+func (s *slowQueryLogger) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	start := time.Now()
+	row := s.next.QueryRowContext(ctx, query, args...)
+	s.observe(ctx, query, args, time.Since(start))
+	return row
+}
+
+// observe sorgu eşiği aştıysa log'lar ve slow_queries_total metriğini artırır
+func (s *slowQueryLogger) observe(ctx context.Context, query string, args []interface{}, elapsed time.Duration) {
+	if elapsed < s.threshold {
+		return
+	}
+
+	normalized := normalizeSQL(query)
+	operation := sqlOperation(normalized)
+	metrics.RecordSlowQuery(s.repository, operation)
+
+	logger.FromContext(ctx).Warn("slow query",
+		zap.String("repository", s.repository),
+		zap.String("operation", operation),
+		zap.String("sql", normalized),
+		zap.Strings("params", formatQueryParams(args)),
+		zap.Duration("duration", elapsed),
+		zap.Duration("threshold", s.threshold),
+	)
+}
+
+// normalizeSQL sorgudaki satır sonu ve girintileri tek boşluğa indirger
+func normalizeSQL(query string) string {
+	return strings.Join(strings.Fields(query), " ")
+}
+
+// sqlOperation normalize edilmiş sorgunun ilk anahtar kelimesini döner (select, insert, with ...)
+func sqlOperation(normalized string) string {
+	operation, _, _ := strings.Cut(normalized, " ")
+	if operation == "" {
+		return "unknown"
+	}
+	return strings.ToLower(operation)
+}
+
+// formatQueryParams parametreleri log için string'e çevirir; uzun değerler kısaltılır
+func formatQueryParams(args []interface{}) []string {
+	params := make([]string, len(args))
+	for i, arg := range args {
+		value := formatQueryParam(arg)
+		if len(value) > maxLoggedParamLength {
+			value = value[:maxLoggedParamLength] + "..."
+		}
+		params[i] = value
+	}
+	return params
+}
+
+// formatQueryParam tek bir parametreyi sürücünün göreceği değere göre yazar
+// (sql.NullInt64, pq.Array gibi driver.Valuer'lar önce Value() ile çözülür)
+func formatQueryParam(arg interface{}) string {
+	if valuer, ok := arg.(driver.Valuer); ok {
+		value, err := valuer.Value()
+		if err != nil {
+			return fmt.Sprintf("<%v>", err)
+		}
+		arg = value
+	}
+
+	switch v := arg.(type) {
+	case nil:
+		return "NULL"
+	case []byte:
+		return string(v)
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
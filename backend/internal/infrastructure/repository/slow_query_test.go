@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/metrics"
+)
+
+// sleepingQueryer her Exec çağrısında verilen süre kadar bekleyen sahte queryer
+type sleepingQueryer struct {
+	delay time.Duration
+	calls int
+}
+
+func (q *sleepingQueryer) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	q.calls++
+	time.Sleep(q.delay)
+	return nil, nil
+}
+
+func (q *sleepingQueryer) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return nil, nil
+}
+
+func (q *sleepingQueryer) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return nil
+}
+
+func TestSlowQueryLogger(t *testing.T) {
+	counter := metrics.SlowQueriesTotal.WithLabelValues("slow-query-test", "update")
+
+	t.Run("counts queries over the threshold", func(t *testing.T) {
+		next := &sleepingQueryer{delay: 5 * time.Millisecond}
+		q := withSlowQueryLog(next, "slow-query-test", time.Millisecond)
+		before := testutil.ToFloat64(counter)
+
+		_, err := q.ExecContext(context.Background(), "UPDATE contents\n\t\tSET title = $1\n\t\tWHERE id = $2", "Go", 1)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, next.calls)
+		assert.Equal(t, before+1, testutil.ToFloat64(counter))
+	})
+
+	t.Run("fast queries are not counted", func(t *testing.T) {
+		next := &sleepingQueryer{}
+		q := withSlowQueryLog(next, "slow-query-test", time.Hour)
+		before := testutil.ToFloat64(counter)
+
+		_, err := q.ExecContext(context.Background(), "UPDATE contents SET title = $1", "Go")
+		assert.NoError(t, err)
+		assert.Equal(t, before, testutil.ToFloat64(counter))
+	})
+
+	t.Run("zero threshold disables the wrapper", func(t *testing.T) {
+		next := &sleepingQueryer{}
+		assert.Same(t, next, withSlowQueryLog(next, "slow-query-test", 0))
+	})
+}
+
+func TestNormalizeSQL(t *testing.T) {
+	normalized := normalizeSQL("\n\t\tSELECT c.id\n\t\tFROM contents c\n\t\tWHERE c.id = $1\n\t")
+	assert.Equal(t, "SELECT c.id FROM contents c WHERE c.id = $1", normalized)
+	assert.Equal(t, "select", sqlOperation(normalized))
+	assert.Equal(t, "unknown", sqlOperation(""))
+}
+
+func TestFormatQueryParams(t *testing.T) {
+	publishedAt := time.Date(2024, 1, 20, 12, 0, 0, 0, time.UTC)
+	params := formatQueryParams([]interface{}{
+		42,
+		"golang",
+		nil,
+		sql.NullInt64{},
+		sql.NullInt64{Int64: 7, Valid: true},
+		pq.Array([]string{"go", "db"}),
+		publishedAt,
+		strings.Repeat("x", maxLoggedParamLength+50),
+	})
+
+	assert.Equal(t, "42", params[0])
+	assert.Equal(t, "golang", params[1])
+	assert.Equal(t, "NULL", params[2])
+	assert.Equal(t, "NULL", params[3])
+	assert.Equal(t, "7", params[4])
+	assert.Equal(t, `{"go","db"}`, params[5])
+	assert.Equal(t, "2024-01-20T12:00:00Z", params[6])
+	assert.Len(t, params[7], maxLoggedParamLength+3)
+}
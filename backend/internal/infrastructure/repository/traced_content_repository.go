@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/tracing"
+)
+
+// tracedContentRepository arama ve senkronizasyonun sıcak yolundaki sorgular için span üreten ContentRepository
+// Diğer işlemler span'siz olarak doğrudan kaynağa gider
+type tracedContentRepository struct {
+	port.ContentRepository
+}
+
+// NewTracedContentRepository source'u saran ve okuma/yazma sorgularını trace'leyen bir repository oluşturur
+func NewTracedContentRepository(source port.ContentRepository) port.ContentRepository {
+	return &tracedContentRepository{ContentRepository: source}
+}
+
+// startSpan repository işlemi için client span'i başlatır
+func (r *tracedContentRepository) startSpan(ctx context.Context, operation string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	attrs = append(attrs, attribute.String("db.system", "postgresql"), attribute.String("db.operation", operation))
+	return tracing.Tracer().Start(ctx, "ContentRepository."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+// Search arama sorgusunu trace'ler
+func (r *tracedContentRepository) Search(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+	ctx, span := r.startSpan(ctx, "Search",
+		attribute.String("search.sort", params.SortBy),
+		attribute.Int("search.page_size", params.PageSize),
+		attribute.Bool("search.fuzzy", params.FuzzyThreshold > 0),
+	)
+	contents, total, err := r.ContentRepository.Search(ctx, params)
+	span.SetAttributes(attribute.Int64("search.total", total))
+	tracing.End(span, err)
+	return contents, total, err
+}
+
+// GetFacets facet sorgusunu trace'ler
+func (r *tracedContentRepository) GetFacets(ctx context.Context, params port.SearchParams) (*entity.SearchFacets, error) {
+	ctx, span := r.startSpan(ctx, "GetFacets")
+	facets, err := r.ContentRepository.GetFacets(ctx, params)
+	tracing.End(span, err)
+	return facets, err
+}
+
+// FindByID tek içerik okumasını trace'ler
+func (r *tracedContentRepository) FindByID(ctx context.Context, id int64) (*entity.Content, error) {
+	ctx, span := r.startSpan(ctx, "FindByID", attribute.Int64("content.id", id))
+	content, err := r.ContentRepository.FindByID(ctx, id)
+	if err == port.ErrContentNotFound {
+		span.End()
+		return nil, err
+	}
+	tracing.End(span, err)
+	return content, err
+}
+
+// FindSimilar benzer içerik sorgusunu trace'ler
+func (r *tracedContentRepository) FindSimilar(ctx context.Context, contentID int64, limit int) ([]*entity.Content, error) {
+	ctx, span := r.startSpan(ctx, "FindSimilar", attribute.Int64("content.id", contentID))
+	contents, err := r.ContentRepository.FindSimilar(ctx, contentID, limit)
+	tracing.End(span, err)
+	return contents, err
+}
+
+// Upsert tek içerik yazımını trace'ler
+func (r *tracedContentRepository) Upsert(ctx context.Context, content *entity.Content) error {
+	ctx, span := r.startSpan(ctx, "Upsert", attribute.Int64("provider.id", content.ProviderID))
+	err := r.ContentRepository.Upsert(ctx, content)
+	tracing.End(span, err)
+	return err
+}
+
+// BulkUpsert toplu yazımı trace'ler
+func (r *tracedContentRepository) BulkUpsert(ctx context.Context, contents []*entity.Content) error {
+	ctx, span := r.startSpan(ctx, "BulkUpsert", attribute.Int("batch.size", len(contents)))
+	err := r.ContentRepository.BulkUpsert(ctx, contents)
+	tracing.End(span, err)
+	return err
+}
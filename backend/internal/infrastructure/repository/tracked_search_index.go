@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// TrackedSearchIndex son Reindex sonucunu hatırlayan SearchIndex sarmalayıcısı
+// Yönetim paneli özetinde indeks güncelliğini göstermek için kullanılır
+type TrackedSearchIndex struct {
+	port.SearchIndex
+	backend string
+	now     func() time.Time
+
+	mu            sync.RWMutex
+	lastReindexAt *time.Time
+	indexed       int
+	lastError     string
+}
+
+// NewTrackedSearchIndex index'i saran bir indeks oluşturur; backend durumda olduğu gibi raporlanır
+func NewTrackedSearchIndex(index port.SearchIndex, backend string) *TrackedSearchIndex {
+	return &TrackedSearchIndex{
+		SearchIndex: index,
+		backend:     backend,
+		now:         time.Now,
+	}
+}
+
+// Reindex indeksi yeniden oluşturur ve sonucu kaydeder
+// Başarısız denemeler son başarılı oluşturmanın zamanını ve sayısını değiştirmez
+func (ix *TrackedSearchIndex) Reindex(ctx context.Context) (int, error) {
+	indexed, err := ix.SearchIndex.Reindex(ctx)
+
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	if err != nil {
+		ix.lastError = err.Error()
+		return indexed, err
+	}
+	now := ix.now()
+	ix.lastReindexAt = &now
+	ix.indexed = indexed
+	ix.lastError = ""
+	return indexed, nil
+}
+
+// IndexStatus son Reindex sonucunu döner; LatestContentUpdateAt ve Stale doldurulmaz
+func (ix *TrackedSearchIndex) IndexStatus() entity.SearchIndexStatus {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+	return entity.SearchIndexStatus{
+		Backend:         ix.backend,
+		LastReindexAt:   ix.lastReindexAt,
+		IndexedContents: ix.indexed,
+		LastError:       ix.lastError,
+	}
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// fakeSearchIndex Reindex'te sırayla verilen sonuçları döner
+type fakeSearchIndex struct {
+	counts []int
+	errs   []error
+}
+
+func (f *fakeSearchIndex) Search(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+	return nil, 0, nil
+}
+
+func (f *fakeSearchIndex) Reindex(ctx context.Context) (int, error) {
+	count, err := f.counts[0], f.errs[0]
+	f.counts, f.errs = f.counts[1:], f.errs[1:]
+	return count, err
+}
+
+func TestTrackedSearchIndex(t *testing.T) {
+	index := &fakeSearchIndex{counts: []int{10, 0}, errs: []error{nil, errors.New("index unavailable")}}
+	tracked := NewTrackedSearchIndex(index, "elasticsearch")
+	now := time.Date(2024, 1, 20, 12, 0, 0, 0, time.UTC)
+	tracked.now = func() time.Time { return now }
+
+	status := tracked.IndexStatus()
+	assert.Equal(t, "elasticsearch", status.Backend)
+	assert.Nil(t, status.LastReindexAt)
+
+	_, err := tracked.Reindex(context.Background())
+	require.NoError(t, err)
+	_, err = tracked.Reindex(context.Background())
+	require.Error(t, err)
+
+	// Başarısız deneme son başarılı oluşturmayı silmez
+	status = tracked.IndexStatus()
+	require.NotNil(t, status.LastReindexAt)
+	assert.Equal(t, now, *status.LastReindexAt)
+	assert.Equal(t, 10, status.IndexedContents)
+	assert.Equal(t, "index unavailable", status.LastError)
+}
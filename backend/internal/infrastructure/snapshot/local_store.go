@@ -0,0 +1,115 @@
+// Package snapshot içerik yedeklerinin yazıldığı depolama alanlarını (yerel dizin, S3, GCS) sağlar
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// FileExtension yedek dosyalarının uzantısı; List sadece bu uzantıdaki dosyaları döner
+const FileExtension = ".ndjson.gz"
+
+// LocalStore yedekleri yerel (veya bağlanmış ağ) dizinde tutan port.SnapshotStore implementasyonu
+type LocalStore struct {
+	dir string
+}
+
+// NewLocalStore dir dizinine yazan bir store oluşturur; dizin yoksa ilk yazmada oluşturulur
+func NewLocalStore(dir string) *LocalStore {
+	return &LocalStore{dir: dir}
+}
+
+// Put yedeği önce geçici dosyaya yazar, tamamlanınca yerine taşır
+// Yarıda kalan bir yazma mevcut yedeği bozmaz ve List'te görünmez
+func (s *LocalStore) Put(ctx context.Context, name string, r io.Reader) error {
+	if err := validateName(name); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return fmt.Errorf("yedek dizini oluşturulamadı: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(s.dir, "."+name+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("geçici yedek dosyası oluşturulamadı: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return fmt.Errorf("yedek yazılamadı: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("yedek yazılamadı: %w", err)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), filepath.Join(s.dir, name)); err != nil {
+		return fmt.Errorf("yedek kaydedilemedi: %w", err)
+	}
+	return nil
+}
+
+// Get yedek dosyasını açar
+func (s *LocalStore) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(filepath.Join(s.dir, name))
+	if os.IsNotExist(err) {
+		return nil, port.ErrSnapshotNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("yedek açılamadı: %w", err)
+	}
+	return f, nil
+}
+
+// List dizindeki yedek dosyalarını ada göre sıralı döner; dizin yoksa boş liste döner
+func (s *LocalStore) List(ctx context.Context) ([]*entity.SnapshotInfo, error) {
+	entries, err := os.ReadDir(s.dir)
+	if os.IsNotExist(err) {
+		return []*entity.SnapshotInfo{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("yedek dizini okunamadı: %w", err)
+	}
+
+	snapshots := []*entity.SnapshotInfo{}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), FileExtension) || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, fmt.Errorf("yedek bilgisi okunamadı: %w", err)
+		}
+		snapshots = append(snapshots, &entity.SnapshotInfo{
+			Name:       entry.Name(),
+			SizeBytes:  info.Size(),
+			ModifiedAt: info.ModTime().UTC(),
+		})
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool { return snapshots[i].Name < snapshots[j].Name })
+	return snapshots, nil
+}
+
+// validateName yedek adının dizin dışına çıkmayan düz bir dosya adı olduğunu kontrol eder
+func validateName(name string) error {
+	if name == "" || strings.ContainsAny(name, `/\`) || strings.HasPrefix(name, ".") {
+		return fmt.Errorf("geçersiz yedek adı: %q", name)
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package snapshot
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// S3Config S3 uyumlu bucket ayarları
+// GCS, HMAC anahtarlarıyla XML API üzerinden (https://storage.googleapis.com, region "auto") aynı şekilde kullanılır
+type S3Config struct {
+	Endpoint  string // ör. https://s3.eu-central-1.amazonaws.com, https://storage.googleapis.com, http://minio:9000
+	Region    string
+	Bucket    string
+	Prefix    string // Nesne adlarının önüne eklenir (ör. "search-engine/")
+	AccessKey string
+	SecretKey string
+}
+
+// S3Store yedekleri S3 uyumlu bir bucket'ta tutan port.SnapshotStore implementasyonu
+// İstekler AWS Signature V4 ile imzalanır; bucket path-style adreslenir (endpoint/bucket/key)
+type S3Store struct {
+	cfg    S3Config
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewS3Store yeni bir S3 uyumlu store oluşturur
+func NewS3Store(cfg S3Config, client *http.Client) *S3Store {
+	cfg.Endpoint = strings.TrimRight(cfg.Endpoint, "/")
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &S3Store{cfg: cfg, client: client, now: time.Now}
+}
+
+// Put yedeği nesne olarak yükler
+// S3 PUT isteği Content-Length gerektirdiğinden akış önce geçici dosyaya yazılır
+func (s *S3Store) Put(ctx context.Context, name string, r io.Reader) error {
+	if err := validateName(name); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp("", "snapshot-*.tmp")
+	if err != nil {
+		return fmt.Errorf("geçici yedek dosyası oluşturulamadı: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	size, err := io.Copy(tmp, r)
+	if err != nil {
+		return fmt.Errorf("yedek yazılamadı: %w", err)
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("yedek okunamadı: %w", err)
+	}
+
+	req, err := s.newRequest(ctx, http.MethodPut, s.objectPath(name), nil, io.NopCloser(tmp))
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", "application/gzip")
+
+	resp, err := s.do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// Get nesneyi okumak için açar
+func (s *S3Store) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	req, err := s.newRequest(ctx, http.MethodGet, s.objectPath(name), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.do(req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// listBucketResult ListObjectsV2 yanıtının kullanılan alanları
+type listBucketResult struct {
+	Contents []struct {
+		Key          string    `xml:"Key"`
+		Size         int64     `xml:"Size"`
+		LastModified time.Time `xml:"LastModified"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// List prefix altındaki yedekleri sayfa sayfa (ListObjectsV2) listeler
+func (s *S3Store) List(ctx context.Context) ([]*entity.SnapshotInfo, error) {
+	snapshots := []*entity.SnapshotInfo{}
+	token := ""
+	for {
+		query := url.Values{"list-type": {"2"}, "prefix": {s.cfg.Prefix}}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+
+		req, err := s.newRequest(ctx, http.MethodGet, "/"+s.cfg.Bucket, query, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := s.do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		var result listBucketResult
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("bucket listesi çözümlenemedi: %w", err)
+		}
+
+		for _, obj := range result.Contents {
+			name := strings.TrimPrefix(obj.Key, s.cfg.Prefix)
+			if !strings.HasSuffix(name, FileExtension) || strings.Contains(name, "/") {
+				continue
+			}
+			snapshots = append(snapshots, &entity.SnapshotInfo{Name: name, SizeBytes: obj.Size, ModifiedAt: obj.LastModified.UTC()})
+		}
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			break
+		}
+		token = result.NextContinuationToken
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool { return snapshots[i].Name < snapshots[j].Name })
+	return snapshots, nil
+}
+
+// objectPath yedeğin bucket içindeki yolunu döner (/bucket/prefix+name)
+func (s *S3Store) objectPath(name string) string {
+	return "/" + s.cfg.Bucket + "/" + s.cfg.Prefix + name
+}
+
+// newRequest imzalı bir istek oluşturur; path kaçışsız (ham) nesne yoludur
+func (s *S3Store) newRequest(ctx context.Context, method, path string, query url.Values, body io.ReadCloser) (*http.Request, error) {
+	escapedPath := escapePath(path)
+	rawURL := s.cfg.Endpoint + escapedPath
+	if len(query) > 0 {
+		rawURL += "?" + canonicalQuery(query)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("yedek isteği oluşturulamadı: %w", err)
+	}
+	if body != nil {
+		req.Body = body
+	}
+
+	signV4(req, escapedPath, query, s.cfg.Region, s.cfg.AccessKey, s.cfg.SecretKey, s.now().UTC())
+	return req, nil
+}
+
+// do isteği gönderir; 2xx dışı yanıtları hataya çevirir (404 -> port.ErrSnapshotNotFound)
+func (s *S3Store) do(req *http.Request) (*http.Response, error) {
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("depolama isteği başarısız: %w", err)
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound && req.Method == http.MethodGet && !strings.Contains(req.URL.RawQuery, "list-type") {
+		return nil, port.ErrSnapshotNotFound
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return nil, fmt.Errorf("depolama %s %s isteğine %d döndü: %s", req.Method, req.URL.Path, resp.StatusCode, strings.TrimSpace(string(body)))
+}
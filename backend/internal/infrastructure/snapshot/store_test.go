@@ -0,0 +1,151 @@
+package snapshot
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+func TestLocalStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewLocalStore(t.TempDir() + "/snapshots")
+
+	t.Run("missing directory lists nothing", func(t *testing.T) {
+		snapshots, err := store.List(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, snapshots)
+	})
+
+	t.Run("put, list and get", func(t *testing.T) {
+		require.NoError(t, store.Put(ctx, "b"+FileExtension, strings.NewReader("second")))
+		require.NoError(t, store.Put(ctx, "a"+FileExtension, strings.NewReader("first")))
+
+		snapshots, err := store.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, snapshots, 2)
+		assert.Equal(t, "a"+FileExtension, snapshots[0].Name)
+		assert.Equal(t, int64(5), snapshots[0].SizeBytes)
+
+		r, err := store.Get(ctx, "b"+FileExtension)
+		require.NoError(t, err)
+		defer r.Close()
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, "second", string(data))
+	})
+
+	t.Run("unknown snapshot", func(t *testing.T) {
+		_, err := store.Get(ctx, "missing"+FileExtension)
+		assert.ErrorIs(t, err, port.ErrSnapshotNotFound)
+	})
+
+	t.Run("rejects path traversal", func(t *testing.T) {
+		assert.Error(t, store.Put(ctx, "../escape"+FileExtension, strings.NewReader("x")))
+		_, err := store.Get(ctx, "../escape"+FileExtension)
+		assert.Error(t, err)
+	})
+}
+
+// fakeS3 bucket'ı bellekte tutan ve imza header'larını kontrol eden minimal S3 sunucusu
+type fakeS3 struct {
+	t       *testing.T
+	mu      sync.Mutex
+	objects map[string]string
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	auth := r.Header.Get("Authorization")
+	assert.True(f.t, strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKID/20240120/auto/s3/aws4_request, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature="), auth)
+	assert.Equal(f.t, "20240120T103000Z", r.Header.Get("X-Amz-Date"))
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodPut:
+		data, _ := io.ReadAll(r.Body)
+		assert.Equal(f.t, int64(len(data)), r.ContentLength)
+		f.objects[r.URL.Path] = string(data)
+	case r.Method == http.MethodGet && r.URL.Path == "/backups":
+		assert.Equal(f.t, "2", r.URL.Query().Get("list-type"))
+		prefix := r.URL.Query().Get("prefix")
+		fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><ListBucketResult>`)
+		for key, data := range f.objects {
+			key = strings.TrimPrefix(key, "/backups/")
+			if strings.HasPrefix(key, prefix) {
+				fmt.Fprintf(w, `<Contents><Key>%s</Key><Size>%d</Size><LastModified>2024-01-20T10:30:00.000Z</LastModified></Contents>`, key, len(data))
+			}
+		}
+		fmt.Fprint(w, `<IsTruncated>false</IsTruncated></ListBucketResult>`)
+	case r.Method == http.MethodGet:
+		data, ok := f.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `<Error><Code>NoSuchKey</Code></Error>`)
+			return
+		}
+		fmt.Fprint(w, data)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestS3Store(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeS3{t: t, objects: make(map[string]string)}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	store := NewS3Store(S3Config{
+		Endpoint:  server.URL + "/",
+		Region:    "auto",
+		Bucket:    "backups",
+		Prefix:    "search-engine/",
+		AccessKey: "AKID",
+		SecretKey: "secret",
+	}, server.Client())
+	store.now = func() time.Time { return time.Date(2024, 1, 20, 10, 30, 0, 0, time.UTC) }
+
+	name := "contents-20240120T103000Z" + FileExtension
+	require.NoError(t, store.Put(ctx, name, strings.NewReader("payload")))
+	assert.Equal(t, "payload", fake.objects["/backups/search-engine/"+name])
+
+	snapshots, err := store.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	assert.Equal(t, name, snapshots[0].Name)
+	assert.Equal(t, int64(7), snapshots[0].SizeBytes)
+
+	r, err := store.Get(ctx, name)
+	require.NoError(t, err)
+	data, err := io.ReadAll(r)
+	r.Close()
+	require.NoError(t, err)
+	assert.Equal(t, "payload", string(data))
+
+	_, err = store.Get(ctx, "missing"+FileExtension)
+	assert.ErrorIs(t, err, port.ErrSnapshotNotFound)
+}
+
+func TestSigningKey(t *testing.T) {
+	// AWS Signature V4 dokümantasyonundaki örnek anahtar türetme
+	key := signingKey("wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", "20120215", "us-east-1", "iam")
+	assert.Equal(t, "f4780e2d9f65fa895f9c67b32ce1baf0b0d8a43505a000a1a9e090d414db404d", hex.EncodeToString(key))
+}
+
+func TestCanonicalQuery(t *testing.T) {
+	query := map[string][]string{"prefix": {"a b/"}, "list-type": {"2"}}
+	assert.Equal(t, "list-type=2&prefix=a%20b%2F", canonicalQuery(query))
+}
@@ -0,0 +1,105 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName is the tracer name used for spans created by this service
+const instrumentationName = "github.com/onurerdog4n/search-engine"
+
+// RequestIDAttribute is the span attribute and baggage member carrying the HTTP request ID
+const RequestIDAttribute = "request.id"
+
+// Config holds tracing configuration
+// Endpoint boşsa span'ler üretilmez (no-op tracer provider)
+type Config struct {
+	Endpoint    string  // OTLP/HTTP collector URL, e.g. http://otel-collector:4318
+	ServiceName string  // service.name resource attribute
+	SampleRatio float64 // 0-1, ratio of new traces that are recorded; incoming sampled parents are always honoured
+}
+
+// Setup global tracer provider'ı ve W3C trace context propagator'ını kurar
+// Dönen shutdown fonksiyonu kapanışta kuyruktaki span'leri gönderir
+func Setup(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if cfg.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(cfg.Endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSpanProcessor(requestIDProcessor{}),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", cfg.ServiceName))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// WithRequestID request ID'yi baggage'a ekler; bu context'ten başlatılan tüm span'ler
+// request.id attribute'unu taşır ve ID provider çağrılarına baggage header'ıyla iletilir
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	member, err := baggage.NewMember(RequestIDAttribute, requestID)
+	if err != nil {
+		return ctx
+	}
+	bag, err := baggage.FromContext(ctx).SetMember(member)
+	if err != nil {
+		return ctx
+	}
+	return baggage.ContextWithBaggage(ctx, bag)
+}
+
+// RequestID WithRequestID ile baggage'a eklenen request ID'yi döner; yoksa boş string
+// HTTP katmanına bağımlı olmayan altyapı kodu (ör. slow query log'ları) bu yolla isteği tanır
+func RequestID(ctx context.Context) string {
+	return baggage.FromContext(ctx).Member(RequestIDAttribute).Value()
+}
+
+// requestIDProcessor baggage'daki request ID'yi her span'e attribute olarak ekler
+type requestIDProcessor struct{}
+
+func (requestIDProcessor) OnStart(parent context.Context, span sdktrace.ReadWriteSpan) {
+	if requestID := RequestID(parent); requestID != "" {
+		span.SetAttributes(attribute.String(RequestIDAttribute, requestID))
+	}
+}
+
+func (requestIDProcessor) OnEnd(sdktrace.ReadOnlySpan)      {}
+func (requestIDProcessor) Shutdown(context.Context) error   { return nil }
+func (requestIDProcessor) ForceFlush(context.Context) error { return nil }
+
+// Tracer returns the tracer used by the infrastructure and transport layers
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// End span'i kapatır; err nil değilse span'e hata olarak kaydedilir
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,48 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestRequestIDPropagation(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(requestIDProcessor{}),
+		sdktrace.WithSpanProcessor(recorder),
+	)
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(previous)
+
+	ctx := WithRequestID(context.Background(), "req-123")
+	ctx, parent := Tracer().Start(ctx, "parent")
+	_, child := Tracer().Start(ctx, "child")
+	child.End()
+	parent.End()
+
+	_, orphan := Tracer().Start(context.Background(), "orphan")
+	orphan.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+	for _, span := range spans[:2] {
+		assert.Contains(t, span.Attributes(), attribute.String(RequestIDAttribute, "req-123"), span.Name())
+	}
+	for _, attr := range spans[2].Attributes() {
+		assert.NotEqual(t, attribute.Key(RequestIDAttribute), attr.Key)
+	}
+}
+
+func TestSetup_DisabledWithoutEndpoint(t *testing.T) {
+	shutdown, err := Setup(context.Background(), Config{ServiceName: "test"})
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+}
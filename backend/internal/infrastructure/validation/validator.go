@@ -2,6 +2,7 @@ package validation
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/go-playground/validator/v10"
 
@@ -46,15 +47,16 @@ func (v *Validator) ValidateSearchParams(params *port.SearchParams) error {
 	}
 
 	// Sort by check
-	if params.SortBy != "" && params.SortBy != "popularity" && params.SortBy != "relevance" {
-		return errors.NewValidationError("sort_by", "invalid sort_by (must be 'popularity' or 'relevance')", params.SortBy)
+	if params.SortBy != "" && params.SortBy != "popularity" && params.SortBy != "relevance" && params.SortBy != "hybrid" {
+		if _, err := port.ParseSortFields(params.SortBy); err != nil {
+			return errors.NewValidationError("sort_by", "invalid sort_by (must be 'popularity', 'relevance', 'hybrid' or a field:direction list)", params.SortBy)
+		}
 	}
 
 	// Content type check
-	if params.ContentType != "" &&
-		params.ContentType != entity.ContentTypeVideo &&
-		params.ContentType != entity.ContentTypeArticle {
-		return errors.NewValidationError("content_type", "invalid content_type (must be 'video' or 'article')", params.ContentType)
+	if params.ContentType != "" && !entity.IsValidContentType(params.ContentType) {
+		return errors.NewValidationError("content_type",
+			"invalid content_type (must be one of: "+strings.Join(entity.ContentTypeNames(), ", ")+")", params.ContentType)
 	}
 
 	return nil
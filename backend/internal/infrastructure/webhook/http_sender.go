@@ -0,0 +1,133 @@
+// Package webhook webhook isteklerinin HTTP üzerinden gönderilmesini sağlar
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/metrics"
+)
+
+// userAgent webhook isteklerinde gönderilen User-Agent
+const userAgent = "search-engine-webhooks/1.0"
+
+// maxResponseBody yanıt gövdesinden okunan en fazla byte; bağlantının tekrar kullanılabilmesi için okunur
+const maxResponseBody = 64 << 10
+
+// errBlockedAddress iç ağ adreslerine yapılan gönderimlerde döner
+var errBlockedAddress = errors.New("webhook hedefi iç ağ adresine çözümleniyor")
+
+// HTTPSender port.WebhookSender'ın net/http implementasyonu
+// Yönlendirmeler takip edilmez: 3xx yanıtlar kalıcı hata olarak değerlendirilir
+type HTTPSender struct {
+	client *http.Client
+}
+
+// NewHTTPSender yeni bir HTTP webhook gönderici oluşturur
+// Süre sınırı her istekte context ile verilir. allowPrivateNetworks false ise loopback, özel, link-local,
+// belirsiz (0.0.0.0) ve blockedPrefixes'teki özel amaçlı adreslere bağlantı kurulmaz; kontrol DNS çözümlemesinden sonra bağlanılan IP'de
+// yapıldığından DNS rebinding ile de aşılamaz. Proxy kullanılmaz, aksi halde kontrol proxy'nin adresine uygulanırdı
+func NewHTTPSender(allowPrivateNetworks bool) port.WebhookSender {
+	dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+	if !allowPrivateNetworks {
+		dialer.Control = rejectPrivateAddress
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &HTTPSender{
+		client: &http.Client{
+			Transport: transport,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// rejectPrivateAddress net.Dialer.Control olarak bağlanılacak IP'yi kontrol eder
+func rejectPrivateAddress(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errBlockedAddress, address)
+	}
+	if addr := addrPort.Addr(); !isPublicAddress(addr) {
+		return fmt.Errorf("%w: %s", errBlockedAddress, addr)
+	}
+	return nil
+}
+
+// blockedPrefixes net/netip'in sınıflandırmadığı, webhook gönderilmemesi gereken özel amaçlı aralıklar
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),       // "bu ağ"; Linux'ta 0.x adresleri loopback'e gider
+	netip.MustParsePrefix("100.64.0.0/10"),   // carrier-grade NAT (RFC 6598)
+	netip.MustParsePrefix("192.0.0.0/24"),    // IETF protokol atamaları
+	netip.MustParsePrefix("192.0.2.0/24"),    // TEST-NET-1
+	netip.MustParsePrefix("198.18.0.0/15"),   // benchmark ağları (RFC 2544)
+	netip.MustParsePrefix("198.51.100.0/24"), // TEST-NET-2
+	netip.MustParsePrefix("203.0.113.0/24"),  // TEST-NET-3
+	netip.MustParsePrefix("240.0.0.0/4"),     // ayrılmış aralık ve 255.255.255.255
+	netip.MustParsePrefix("64:ff9b::/96"),    // NAT64; içindeki IPv4 adresi iç ağ olabilir
+	netip.MustParsePrefix("64:ff9b:1::/48"),  // yerel NAT64
+	netip.MustParsePrefix("100::/64"),        // discard-only
+	netip.MustParsePrefix("2001:db8::/32"),   // dokümantasyon
+	netip.MustParsePrefix("2002::/16"),       // 6to4; içindeki IPv4 adresi iç ağ olabilir
+}
+
+// isPublicAddress addr'in webhook gönderilebilecek bir adres olup olmadığını döner
+// (169.254.169.254 gibi cloud metadata adresleri link-local olarak reddedilir). IPv4-mapped IPv6
+// adresleri (::ffff:10.0.0.1) IPv4 karşılıklarıyla aynı kontrollerden geçer
+func isPublicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() ||
+		addr.IsLoopback() ||
+		addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() ||
+		addr.IsUnspecified() {
+		return false
+	}
+	for _, prefix := range blockedPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// Send gövdeyi url'ye POST eder ve yanıtın durum kodunu döner
+func (s *HTTPSender) Send(ctx context.Context, url string, header http.Header, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("webhook isteği oluşturulamadı: %w", err)
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("User-Agent", userAgent)
+
+	start := time.Now()
+	resp, err := s.client.Do(req)
+	if err != nil {
+		metrics.RecordWebhookRequest("error", time.Since(start).Seconds())
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBody))
+
+	metrics.RecordWebhookRequest(fmt.Sprintf("%dxx", resp.StatusCode/100), time.Since(start).Seconds())
+	return resp.StatusCode, nil
+}
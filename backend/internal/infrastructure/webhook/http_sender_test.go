@@ -0,0 +1,139 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+)
+
+func TestHTTPSender_Send(t *testing.T) {
+	t.Run("posts body and headers", func(t *testing.T) {
+		var gotBody, gotSignature, gotUserAgent string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				t.Errorf("Expected POST, got %s", r.Method)
+			}
+			body, _ := io.ReadAll(r.Body)
+			gotBody = string(body)
+			gotSignature = r.Header.Get("X-Webhook-Signature")
+			gotUserAgent = r.Header.Get("User-Agent")
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+
+		header := http.Header{}
+		header.Set("X-Webhook-Signature", "t=1,v1=abc")
+		status, err := NewHTTPSender(true).Send(context.Background(), server.URL, header, []byte(`{"event":"content.matched"}`))
+		if err != nil {
+			t.Fatalf("Send failed: %v", err)
+		}
+		if status != http.StatusAccepted {
+			t.Errorf("Expected 202, got %d", status)
+		}
+		if gotBody != `{"event":"content.matched"}` {
+			t.Errorf("Unexpected body: %s", gotBody)
+		}
+		if gotSignature != "t=1,v1=abc" {
+			t.Errorf("Unexpected signature header: %s", gotSignature)
+		}
+		if gotUserAgent != userAgent {
+			t.Errorf("Unexpected user agent: %s", gotUserAgent)
+		}
+	})
+
+	t.Run("does not follow redirects", func(t *testing.T) {
+		target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Error("Redirect target must not be called")
+		}))
+		defer target.Close()
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, target.URL, http.StatusTemporaryRedirect)
+		}))
+		defer server.Close()
+
+		status, err := NewHTTPSender(true).Send(context.Background(), server.URL, http.Header{}, []byte(`{}`))
+		if err != nil {
+			t.Fatalf("Send failed: %v", err)
+		}
+		if status != http.StatusTemporaryRedirect {
+			t.Errorf("Expected 307, got %d", status)
+		}
+	})
+
+	t.Run("connection errors are returned", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		server.Close()
+
+		if _, err := NewHTTPSender(true).Send(context.Background(), server.URL, http.Header{}, []byte(`{}`)); err == nil {
+			t.Error("Expected an error for a closed server")
+		}
+	})
+	t.Run("private network targets are rejected by default", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Error("Loopback target must not be called")
+		}))
+		defer server.Close()
+
+		_, err := NewHTTPSender(false).Send(context.Background(), server.URL, http.Header{}, []byte(`{}`))
+		if !errors.Is(err, errBlockedAddress) {
+			t.Errorf("Expected errBlockedAddress, got %v", err)
+		}
+	})
+}
+
+func TestIsPublicAddress(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.10", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"fd00::1", false},
+		{"0.0.0.0", false},
+		{"::", false},
+		{"224.0.0.1", false},
+		{"0.1.2.3", false},
+		{"100.64.0.1", false},
+		{"100.127.255.254", false},
+		{"192.0.0.8", false},
+		{"192.0.2.1", false},
+		{"198.18.0.1", false},
+		{"198.19.255.255", false},
+		{"198.51.100.7", false},
+		{"203.0.113.9", false},
+		{"240.0.0.1", false},
+		{"255.255.255.255", false},
+		{"64:ff9b::a00:1", false},
+		{"64:ff9b::5db8:d822", false},
+		{"64:ff9b:1::1", false},
+		{"100::1", false},
+		{"2001:db8::1", false},
+		{"2002:a00:1::1", false},
+		{"::ffff:127.0.0.1", false},
+		{"::ffff:10.0.0.1", false},
+		{"::ffff:169.254.169.254", false},
+		{"::ffff:100.64.0.1", false},
+		{"::ffff:198.18.0.1", false},
+		{"::ffff:0.0.0.0", false},
+		{"93.184.216.34", true},
+		{"100.63.255.255", true},
+		{"100.128.0.1", true},
+		{"198.20.0.1", true},
+		{"::ffff:93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+	}
+	for _, tt := range tests {
+		if got := isPublicAddress(netip.MustParseAddr(tt.addr)); got != tt.want {
+			t.Errorf("isPublicAddress(%s) = %v, want %v", tt.addr, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+// Package apierror HTTP API'nin ortak hata zarfını ve makine tarafından okunabilir hata kodlarını tanımlar
+// Handler'lar ve middleware'ler hataları bu paket üzerinden yazar; istemciler mesaja değil koda göre karar verir
+package apierror
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/onurerdog4n/search-engine/internal/application/usecase"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/logger"
+)
+
+// Hata kodları; değerler API sözleşmesinin parçasıdır, değiştirilmemelidir
+const (
+	CodeInvalidRequest      = "invalid_request"
+	CodeInvalidBody         = "invalid_body"
+	CodeValidationFailed    = "validation_failed"
+	CodeUnauthorized        = "unauthorized"
+	CodeInvalidAPIKey       = "invalid_api_key"
+	CodeRateLimited         = "rate_limited"
+	CodePayloadTooLarge     = "payload_too_large"
+	CodeContentNotFound     = "content_not_found"
+	CodeProviderNotFound    = "provider_not_found"
+	CodeBoostRuleNotFound   = "boost_rule_not_found"
+	CodePromotionNotFound   = "promotion_not_found"
+	CodeAPIKeyNotFound      = "api_key_not_found"
+	CodeWebhookNotFound     = "webhook_not_found"
+	CodeSavedSearchNotFound = "saved_search_not_found"
+	CodeSyncJobNotFound     = "sync_job_not_found"
+	CodeSnapshotNotFound    = "snapshot_not_found"
+	CodeFeatureFlagNotFound = "feature_flag_not_found"
+	CodeSnapshotRunning     = "snapshot_in_progress"
+	CodeDuplicateContent    = "duplicate_content"
+	CodeProviderNotActive   = "provider_not_active"
+	CodeProviderError       = "provider_error"
+	CodeTimeout             = "timeout"
+	CodeShuttingDown        = "shutting_down"
+	CodeServiceUnavailable  = "service_unavailable"
+	CodeInternal            = "internal_error"
+)
+
+// Error istemciye dönecek hata: HTTP durumu, kod ve güvenli mesaj
+// cause sadece sunucu log'una yazılır, yanıta eklenmez
+type Error struct {
+	Status  int                    `json:"-"`
+	Code    string                 `json:"code"`
+	Message string                 `json:"message"`
+	Field   string                 `json:"field,omitempty"` // Validation hatalarında hatalı alan
+	Value   interface{}            `json:"value,omitempty"` // Validation hatalarında gönderilen değer
+	Details map[string]interface{} `json:"details,omitempty"`
+
+	cause error
+}
+
+// Response tüm hata yanıtlarının zarfı
+type Response struct {
+	Error     *Error `json:"error"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// New verilen durum, kod ve mesajla yeni bir hata oluşturur
+func New(status int, code, message string) *Error {
+	return &Error{Status: status, Code: code, Message: message}
+}
+
+// WithDetails hataya ek alanlar ekler (ör. limit değerleri)
+func (e *Error) WithDetails(details map[string]interface{}) *Error {
+	e.Details = details
+	return e
+}
+
+// sentinelMapping domain/port sentinel hatasının HTTP karşılığı
+type sentinelMapping struct {
+	target  error
+	status  int
+	code    string
+	message string
+}
+
+// sentinels errors.Is ile eşleştirilen bilinen hatalar (sıra önemlidir, ilk eşleşme kullanılır)
+var sentinels = []sentinelMapping{
+	{port.ErrContentNotFound, http.StatusNotFound, CodeContentNotFound, "İçerik bulunamadı"},
+	{apperrors.ErrContentNotFound, http.StatusNotFound, CodeContentNotFound, "İçerik bulunamadı"},
+	{port.ErrProviderNotFound, http.StatusNotFound, CodeProviderNotFound, "Provider bulunamadı"},
+	{port.ErrBoostRuleNotFound, http.StatusNotFound, CodeBoostRuleNotFound, "Boost kuralı bulunamadı"},
+	{port.ErrPromotionNotFound, http.StatusNotFound, CodePromotionNotFound, "Sabitleme bulunamadı"},
+	{port.ErrAPIKeyNotFound, http.StatusNotFound, CodeAPIKeyNotFound, "API anahtarı bulunamadı"},
+	{port.ErrWebhookNotFound, http.StatusNotFound, CodeWebhookNotFound, "Webhook aboneliği bulunamadı"},
+	{port.ErrSavedSearchNotFound, http.StatusNotFound, CodeSavedSearchNotFound, "Kayıtlı arama bulunamadı"},
+	{port.ErrSnapshotNotFound, http.StatusNotFound, CodeSnapshotNotFound, "Yedek bulunamadı"},
+	{port.ErrFeatureFlagNotFound, http.StatusNotFound, CodeFeatureFlagNotFound, "Feature flag bulunamadı"},
+	{usecase.ErrAPIKeyRequired, http.StatusUnauthorized, CodeUnauthorized, "Bu işlem için X-API-Key header'ı gerekli"},
+	{port.ErrDuplicateContent, http.StatusConflict, CodeDuplicateContent, "İçerik zaten mevcut"},
+	{apperrors.ErrDuplicateContent, http.StatusConflict, CodeDuplicateContent, "İçerik zaten mevcut"},
+	{apperrors.ErrProviderNotActive, http.StatusConflict, CodeProviderNotActive, "Provider aktif değil"},
+	{usecase.ErrSnapshotRunning, http.StatusConflict, CodeSnapshotRunning, "Başka bir yedek alma veya geri yükleme sürüyor"},
+	{apperrors.ErrInvalidSearchParams, http.StatusBadRequest, CodeInvalidRequest, "Geçersiz arama parametreleri"},
+	{apperrors.ErrRateLimitExceeded, http.StatusTooManyRequests, CodeRateLimited, "Rate limit aşıldı"},
+	{usecase.ErrSyncShutdown, http.StatusServiceUnavailable, CodeShuttingDown, "Sunucu kapanıyor, senkronizasyon başlatılamadı"},
+	{usecase.ErrStreamClosed, http.StatusServiceUnavailable, CodeShuttingDown, "Sunucu kapanıyor, yayın akışı açılamadı"},
+	{usecase.ErrStreamLimitReached, http.StatusServiceUnavailable, CodeServiceUnavailable, "Yayın akışı bağlantı limiti doldu, daha sonra tekrar deneyin"},
+	{context.DeadlineExceeded, http.StatusGatewayTimeout, CodeTimeout, "İstek zaman aşımına uğradı"},
+}
+
+// FromError hatayı türüne göre API hatasına çevirir
+// Tanınmayan hatalar iç detay sızdırılmadan 500 internal_error olarak döner
+func FromError(err error) *Error {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+
+	var validationErr *apperrors.ValidationError
+	if errors.As(err, &validationErr) {
+		return &Error{
+			Status:  http.StatusBadRequest,
+			Code:    CodeValidationFailed,
+			Message: validationErr.Message,
+			Field:   validationErr.Field,
+			Value:   validationErr.Value,
+		}
+	}
+
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return PayloadTooLarge(maxBytesErr.Limit)
+	}
+
+	for _, m := range sentinels {
+		if errors.Is(err, m.target) {
+			return &Error{Status: m.status, Code: m.code, Message: m.message, cause: err}
+		}
+	}
+
+	var providerErr *apperrors.ProviderError
+	if errors.As(err, &providerErr) {
+		return &Error{Status: http.StatusBadGateway, Code: CodeProviderError, Message: "Provider isteği başarısız oldu", cause: err}
+	}
+
+	return &Error{Status: http.StatusInternalServerError, Code: CodeInternal, Message: "Beklenmeyen bir hata oluştu", cause: err}
+}
+
+// PayloadTooLarge istek gövdesi limiti aşıldığında dönen 413 hatası
+func PayloadTooLarge(limit int64) *Error {
+	return New(http.StatusRequestEntityTooLarge, CodePayloadTooLarge, "İstek gövdesi çok büyük").
+		WithDetails(map[string]interface{}{"max_bytes": limit})
+}
+
+// Error error interface'ini sağlar; use case'ler hazır bir API hatası dönebilir
+func (e *Error) Error() string {
+	if e.cause != nil {
+		return e.Code + ": " + e.cause.Error()
+	}
+	return e.Code + ": " + e.Message
+}
+
+// Unwrap sarılan asıl hatayı döner
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// Write hatayı zarf içinde JSON olarak yazar
+// Request ID, RequestID middleware'inin yanıta koyduğu X-Request-ID header'ından okunur;
+// 5xx hatalarda asıl hata request ID ile log'lanır
+func Write(w http.ResponseWriter, apiErr *Error) {
+	requestID := w.Header().Get("X-Request-ID")
+
+	if apiErr.Status >= http.StatusInternalServerError && apiErr.cause != nil {
+		log := logger.GetLogger()
+		if requestID != "" {
+			log = log.WithRequestID(requestID)
+		}
+		log.Error("request failed",
+			zap.String("code", apiErr.Code),
+			zap.Int("status", apiErr.Status),
+			zap.Error(apiErr.cause),
+		)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(apiErr.Status)
+	json.NewEncoder(w).Encode(Response{Error: apiErr, RequestID: requestID})
+}
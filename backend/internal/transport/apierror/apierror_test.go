@@ -0,0 +1,84 @@
+package apierror
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/application/usecase"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+func TestFromError(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		expectedStatus int
+		expectedCode   string
+	}{
+		{"validation", apperrors.NewValidationError("page", "page must be positive", -1), http.StatusBadRequest, CodeValidationFailed},
+		{"wrapped validation", fmt.Errorf("arama hatası: %w", apperrors.NewValidationError("q", "too long", nil)), http.StatusBadRequest, CodeValidationFailed},
+		{"content not found", fmt.Errorf("içerik getirilemedi: %w", port.ErrContentNotFound), http.StatusNotFound, CodeContentNotFound},
+		{"provider not found", port.ErrProviderNotFound, http.StatusNotFound, CodeProviderNotFound},
+		{"api key not found", port.ErrAPIKeyNotFound, http.StatusNotFound, CodeAPIKeyNotFound},
+		{"webhook not found", port.ErrWebhookNotFound, http.StatusNotFound, CodeWebhookNotFound},
+		{"saved search not found", port.ErrSavedSearchNotFound, http.StatusNotFound, CodeSavedSearchNotFound},
+		{"api key required", usecase.ErrAPIKeyRequired, http.StatusUnauthorized, CodeUnauthorized},
+		{"sync shutdown", usecase.ErrSyncShutdown, http.StatusServiceUnavailable, CodeShuttingDown},
+		{"snapshot not found", fmt.Errorf("yedek açılamadı: %w", port.ErrSnapshotNotFound), http.StatusNotFound, CodeSnapshotNotFound},
+		{"snapshot running", usecase.ErrSnapshotRunning, http.StatusConflict, CodeSnapshotRunning},
+		{"deadline", fmt.Errorf("sorgu: %w", context.DeadlineExceeded), http.StatusGatewayTimeout, CodeTimeout},
+		{"provider error", apperrors.NewProviderError("JSON", "fetch", errors.New("503")), http.StatusBadGateway, CodeProviderError},
+		{"payload too large", &http.MaxBytesError{Limit: 1024}, http.StatusRequestEntityTooLarge, CodePayloadTooLarge},
+		{"unknown", errors.New(`pq: relation "contents" does not exist`), http.StatusInternalServerError, CodeInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiErr := FromError(tt.err)
+			assert.Equal(t, tt.expectedStatus, apiErr.Status)
+			assert.Equal(t, tt.expectedCode, apiErr.Code)
+			assert.NotEmpty(t, apiErr.Message)
+		})
+	}
+}
+
+func TestWrite(t *testing.T) {
+	t.Run("internal errors do not leak details", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		w.Header().Set("X-Request-ID", "req-123")
+
+		Write(w, FromError(errors.New(`pq: password authentication failed for user "postgres"`)))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		assert.NotContains(t, w.Body.String(), "pq:")
+
+		var response Response
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		assert.Equal(t, CodeInternal, response.Error.Code)
+		assert.Equal(t, "req-123", response.RequestID)
+	})
+
+	t.Run("validation errors keep field and value", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		Write(w, FromError(apperrors.NewValidationError("page_size", "page_size must be between 1 and 100", 500)))
+
+		var response struct {
+			Error map[string]interface{} `json:"error"`
+		}
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		assert.Equal(t, CodeValidationFailed, response.Error["code"])
+		assert.Equal(t, "page_size", response.Error["field"])
+		assert.Equal(t, 500.0, response.Error["value"])
+		assert.Equal(t, "page_size must be between 1 and 100", response.Error["message"])
+	})
+}
@@ -0,0 +1,199 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	searchv1 "github.com/onurerdog4n/search-engine/api/proto/search/v1"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/logger"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/metrics"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/tracing"
+	"github.com/onurerdog4n/search-engine/internal/transport/apierror"
+	"github.com/onurerdog4n/search-engine/internal/transport/middleware"
+)
+
+// requestIDMetadataKey HTTP'deki X-Request-ID header'ının gRPC metadata karşılığı
+const requestIDMetadataKey = "x-request-id"
+
+// errorDomain ErrorInfo detaylarındaki hata alanı
+const errorDomain = "search-engine"
+
+// adminMethods admin bearer token'ı gerektiren RPC'ler
+var adminMethods = map[string]bool{
+	searchv1.SearchService_TriggerSync_FullMethodName: true,
+}
+
+// NewServer arama servisini interceptor'larla birlikte kayıtlı bir gRPC sunucusu oluşturur
+// authenticator nil ise admin RPC'leri kimlik doğrulamasız çalışır (ADMIN_AUTH_DISABLED)
+func NewServer(search *SearchServer, authenticator *middleware.Authenticator) *grpc.Server {
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		observe,
+		recoverPanic,
+		authenticate(authenticator),
+	))
+	searchv1.RegisterSearchServiceServer(server, search)
+	return server
+}
+
+// observe request ID, span, log ve metrikleri HTTP middleware zinciriyle aynı şekilde üretir
+// Handler'ın döndüğü domain hataları burada gRPC status'üne çevrilir
+func observe(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+
+	requestID := incomingRequestID(ctx)
+	grpc.SetHeader(ctx, metadata.Pairs(requestIDMetadataKey, requestID))
+	ctx = tracing.WithRequestID(ctx, requestID)
+	log := logger.GetLogger().WithRequestID(requestID)
+	ctx = logger.NewContext(ctx, log)
+
+	ctx, span := tracing.Tracer().Start(ctx, info.FullMethod,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "grpc"),
+			attribute.String("rpc.method", info.FullMethod),
+			attribute.String(tracing.RequestIDAttribute, requestID),
+		),
+	)
+	defer span.End()
+
+	resp, err := handler(ctx, req)
+	code := codes.OK
+	if err != nil {
+		err = toStatusError(err)
+		code = status.Code(err)
+	}
+	duration := time.Since(start)
+
+	span.SetAttributes(attribute.String("rpc.grpc.status_code", code.String()))
+	metrics.RecordGRPCRequest(info.FullMethod, code.String(), duration.Seconds())
+
+	fields := []zap.Field{
+		zap.String("method", info.FullMethod),
+		zap.String("code", code.String()),
+		zap.Duration("duration", duration),
+	}
+	switch code {
+	case codes.OK:
+		log.Info("grpc request completed", fields...)
+	case codes.Internal, codes.Unknown, codes.Unavailable, codes.DeadlineExceeded:
+		span.SetStatus(otelcodes.Error, code.String())
+		log.Error("grpc request failed", append(fields, zap.Error(err))...)
+	default:
+		log.Warn("grpc request failed", append(fields, zap.Error(err))...)
+	}
+
+	return resp, err
+}
+
+// recoverPanic handler panic'lerini Internal hatasına çevirir, sunucu ayakta kalır
+func recoverPanic(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return handler(ctx, req)
+}
+
+// authenticate admin RPC'leri için "authorization: Bearer <token>" metadata'sını doğrular
+func authenticate(authenticator *middleware.Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if authenticator == nil || !adminMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		subject, err := authenticator.AuthenticateToken(bearerToken(ctx))
+		if err != nil {
+			return nil, apierror.New(http.StatusUnauthorized, apierror.CodeUnauthorized, "Yetkisiz istek: "+err.Error())
+		}
+
+		return handler(context.WithValue(ctx, middleware.AuthSubjectKey, subject), req)
+	}
+}
+
+// bearerToken authorization metadata'sındaki bearer token'ı okur
+func bearerToken(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get("authorization") {
+		scheme, token, found := strings.Cut(value, " ")
+		if found && strings.EqualFold(scheme, "Bearer") {
+			return strings.TrimSpace(token)
+		}
+	}
+	return ""
+}
+
+// incomingRequestID istemcinin gönderdiği request ID'yi okur, yoksa yenisini üretir
+func incomingRequestID(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(requestIDMetadataKey); len(values) > 0 && values[0] != "" {
+		return values[0]
+	}
+	return uuid.New().String()
+}
+
+// toStatusError hatayı HTTP API ile aynı kod ve güvenli mesajla gRPC status'üne çevirir
+// Makine tarafından okunabilir hata kodu (validation_failed, content_not_found ...) ErrorInfo.Reason'dadır
+func toStatusError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, "İstek iptal edildi")
+	}
+
+	apiErr := apierror.FromError(err)
+	st := status.New(grpcCode(apiErr), apiErr.Message)
+
+	info := &errdetails.ErrorInfo{Reason: apiErr.Code, Domain: errorDomain}
+	if apiErr.Field != "" {
+		info.Metadata = map[string]string{"field": apiErr.Field}
+	}
+	if detailed, detailErr := st.WithDetails(info); detailErr == nil {
+		st = detailed
+	}
+
+	return st.Err()
+}
+
+// grpcCode API hatasının HTTP durumunu gRPC koduna eşler
+func grpcCode(apiErr *apierror.Error) codes.Code {
+	switch apiErr.Status {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		if apiErr.Code == apierror.CodeDuplicateContent {
+			return codes.AlreadyExists
+		}
+		return codes.FailedPrecondition
+	case http.StatusRequestEntityTooLarge, http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusBadGateway, http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	default:
+		return codes.Internal
+	}
+}
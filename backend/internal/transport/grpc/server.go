@@ -0,0 +1,198 @@
+// Package grpc arama servisini iç tüketiciler için gRPC üzerinden sunar
+// HTTP handler'larıyla aynı use case'leri kullanır; sadece istek/yanıt dönüşümü bu pakettedir
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	searchv1 "github.com/onurerdog4n/search-engine/api/proto/search/v1"
+	"github.com/onurerdog4n/search-engine/internal/application/usecase"
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+// SearchServer searchv1.SearchServiceServer implementasyonu
+type SearchServer struct {
+	searchv1.UnimplementedSearchServiceServer
+
+	searchUseCase  *usecase.SearchContentsUseCase
+	contentUseCase *usecase.GetContentUseCase
+	syncUseCase    *usecase.SyncProviderContentsUseCase
+}
+
+// NewSearchServer yeni bir gRPC arama servisi oluşturur
+func NewSearchServer(
+	searchUseCase *usecase.SearchContentsUseCase,
+	contentUseCase *usecase.GetContentUseCase,
+	syncUseCase *usecase.SyncProviderContentsUseCase,
+) *SearchServer {
+	return &SearchServer{
+		searchUseCase:  searchUseCase,
+		contentUseCase: contentUseCase,
+		syncUseCase:    syncUseCase,
+	}
+}
+
+// Search içerikleri arar; varsayılanlar HTTP handler'ı ile aynıdır (popularity, sayfa 1, 20 sonuç)
+func (s *SearchServer) Search(ctx context.Context, req *searchv1.SearchRequest) (*searchv1.SearchResponse, error) {
+	sortBy := req.GetSort()
+	if sortBy == "" {
+		sortBy = "popularity"
+	}
+	page := int(req.GetPage())
+	if page < 1 {
+		page = 1
+	}
+	pageSize := int(req.GetPageSize())
+	if pageSize < 1 {
+		pageSize = 20
+	}
+
+	params := port.SearchParams{
+		Query:       req.GetQuery(),
+		ContentType: entity.ContentType(req.GetType()),
+		Language:    req.GetLanguage(),
+		SortBy:      sortBy,
+		Page:        page,
+		PageSize:    pageSize,
+
+		PublishedAfter:  optionalTime(req.GetPublishedAfter()),
+		PublishedBefore: optionalTime(req.GetPublishedBefore()),
+
+		ProviderID:   req.GetProviderId(),
+		ProviderName: req.GetProviderName(),
+
+		AuthorName: req.GetAuthor(),
+		Category:   req.GetCategory(),
+
+		Tags:    req.GetTags(),
+		TagMode: req.GetTagMode(),
+
+		CollapseDuplicates: req.GetCollapseDuplicates(),
+	}
+
+	if rawCursor := req.GetCursor(); rawCursor != "" {
+		cursor, err := port.DecodeSearchCursor(rawCursor)
+		if err != nil {
+			return nil, apperrors.NewValidationError("cursor", "invalid cursor", rawCursor)
+		}
+		params.Cursor = cursor
+	}
+
+	result, err := s.searchUseCase.Execute(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*searchv1.Content, len(result.Items))
+	for i, content := range result.Items {
+		items[i] = toProtoContent(content)
+	}
+
+	return &searchv1.SearchResponse{
+		Items: items,
+		Pagination: &searchv1.Pagination{
+			Page:       int32(result.Pagination.Page),
+			PageSize:   int32(result.Pagination.PageSize),
+			TotalItems: result.Pagination.TotalItems,
+			TotalPages: result.Pagination.TotalPages,
+			ExactTotal: result.Pagination.ExactTotal,
+			NextCursor: result.Pagination.NextCursor,
+		},
+		FuzzyFallback: result.Meta.FuzzyFallback,
+	}, nil
+}
+
+// GetContent tek bir içeriği döner
+func (s *SearchServer) GetContent(ctx context.Context, req *searchv1.GetContentRequest) (*searchv1.Content, error) {
+	if req.GetId() < 1 {
+		return nil, apperrors.NewValidationError("id", "id must be a positive integer", req.GetId())
+	}
+
+	content, err := s.contentUseCase.Execute(ctx, req.GetId(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoContent(content), nil
+}
+
+// TriggerSync arka planda tüm provider'lar veya tek bir provider için senkronizasyon başlatır
+func (s *SearchServer) TriggerSync(ctx context.Context, req *searchv1.TriggerSyncRequest) (*searchv1.TriggerSyncResponse, error) {
+	if req.GetProviderId() < 0 {
+		return nil, apperrors.NewValidationError("provider_id", "provider_id must be a positive integer", req.GetProviderId())
+	}
+
+	var jobID string
+	var err error
+	if req.GetProviderId() > 0 {
+		jobID, err = s.syncUseCase.ExecuteProviderAsync(req.GetProviderId())
+	} else {
+		jobID, err = s.syncUseCase.ExecuteAsync()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &searchv1.TriggerSyncResponse{JobId: jobID, Status: "running"}, nil
+}
+
+// toProtoContent içerik entity'sini proto mesajına çevirir
+func toProtoContent(c *entity.Content) *searchv1.Content {
+	msg := &searchv1.Content{
+		Id:                c.ID,
+		ProviderId:        c.ProviderID,
+		ProviderContentId: c.ProviderContentID,
+		Title:             c.Title,
+		Description:       c.Description,
+		ContentType:       string(c.ContentType),
+		Language:          c.Language,
+		Url:               c.URL,
+		ThumbnailUrl:      c.ThumbnailURL,
+		PublishedAt:       timestamppb.New(c.PublishedAt),
+		UpdatedAt:         timestamppb.New(c.UpdatedAt),
+		RelevanceScore:    c.RelevanceScore,
+		Pinned:            c.Pinned,
+	}
+
+	if c.Provider != nil {
+		msg.ProviderName = c.Provider.Name
+	}
+	if c.Author != nil {
+		msg.AuthorName = c.Author.Name
+	}
+	if c.Category != nil {
+		msg.CategoryPath = c.Category.Path
+	}
+	if c.Score != nil {
+		msg.FinalScore = c.Score.FinalScore
+	}
+	if c.Stats != nil {
+		msg.Stats = &searchv1.ContentStats{
+			Views:           c.Stats.Views,
+			Likes:           c.Stats.Likes,
+			ReadingTime:     c.Stats.ReadingTime,
+			Reactions:       c.Stats.Reactions,
+			DurationSeconds: c.Stats.DurationSeconds,
+			Comments:        c.Stats.Comments,
+		}
+	}
+	for _, tag := range c.Tags {
+		msg.Tags = append(msg.Tags, tag.Name)
+	}
+
+	return msg
+}
+
+// optionalTime verilmemiş timestamp'ler için nil döner
+func optionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
@@ -0,0 +1,624 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/onurerdog4n/search-engine/internal/application/usecase"
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/transport/apierror"
+	"github.com/onurerdog4n/search-engine/internal/transport/openapi"
+)
+
+// DocsHandler OpenAPI dokümanını ve Swagger UI'ı sunan HTTP handler'ı
+type DocsHandler struct {
+	spec    []byte
+	specURL string
+}
+
+// NewDocsHandler üretilmiş dokümanı bir kez serileştirip handler oluşturur
+// specURL Swagger UI'ın dokümanı çekeceği path'tir (ör. /api/v1/docs/openapi.json)
+func NewDocsHandler(doc *openapi.Document, specURL string) (*DocsHandler, error) {
+	spec, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("OpenAPI dokümanı serileştirilemedi: %w", err)
+	}
+	return &DocsHandler{spec: spec, specURL: specURL}, nil
+}
+
+// HandleSpec OpenAPI 3 dokümanını JSON olarak döner
+// GET /api/v1/docs/openapi.json
+func (h *DocsHandler) HandleSpec(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Write(h.spec)
+}
+
+// swaggerUIPage Swagger UI'ı CDN'den yükleyip dokümanı gösteren sayfa
+var swaggerUIPage = template.Must(template.New("swagger-ui").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>Search Engine API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js" crossorigin></script>
+  <script>
+    window.onload = function () {
+      window.ui = SwaggerUIBundle({ url: {{.}}, dom_id: "#swagger-ui", deepLinking: true });
+    };
+  </script>
+</body>
+</html>
+`))
+
+// HandleUI dokümanı gösteren Swagger UI sayfasını döner
+// GET /api/v1/docs
+func (h *DocsHandler) HandleUI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	swaggerUIPage.Execute(w, h.specURL)
+}
+
+// Güvenlik şeması isimleri
+const (
+	securityBearer = "bearerAuth"
+	securityAPIKey = "apiKey"
+)
+
+// NewAPIRegistry tüm route'ların tipli dokümanını içeren registry'yi oluşturur
+// Yeni bir route eklendiğinde operasyonu da buraya eklenmelidir; eklenmezse başlangıçta uyarı log'lanır
+func NewAPIRegistry() *openapi.Registry {
+	reg := openapi.NewRegistry()
+	s := reg.Schemas()
+
+	errorSchema := s.Of(apierror.Response{})
+	ok := func(status int, description string, body interface{}) map[string]*openapi.Response {
+		responses := map[string]*openapi.Response{
+			strconv.Itoa(status): {Description: description},
+			"default":            jsonResponse("Hata", errorSchema),
+		}
+		if body != nil {
+			responses[strconv.Itoa(status)] = jsonResponse(description, s.Of(body))
+		}
+		return responses
+	}
+	body := func(v interface{}) *openapi.RequestBody {
+		return &openapi.RequestBody{
+			Required: true,
+			Content:  map[string]openapi.MediaType{"application/json": {Schema: s.Of(v)}},
+		}
+	}
+	admin := []map[string][]string{{securityBearer: {}}}
+	optionalAPIKey := []map[string][]string{{}, {securityAPIKey: {}}}
+	apiKey := []map[string][]string{{securityAPIKey: {}}}
+	pagination := []openapi.Parameter{
+		queryParam("page", "integer", "Sayfa numarası (1'den başlar)"),
+		queryParam("page_size", "integer", "Sayfa boyutu"),
+	}
+	providerFilter := queryParam("provider_id", "integer", "Sadece bu provider")
+
+	// Health
+	reg.Add("GET", "/healthz", openapi.Operation{
+		Tags: []string{"health"}, Summary: "Liveness probe", OperationID: "liveness",
+		Responses: ok(http.StatusOK, "Process ayakta", map[string]string{}),
+	})
+	reg.Add("GET", "/readyz", openapi.Operation{
+		Tags: []string{"health"}, Summary: "Readiness probe", OperationID: "readiness",
+		Description: "DB, Redis ve ilk senkronizasyon hazır değilse 503 döner",
+		Responses:   ok(http.StatusOK, "Trafik almaya hazır", map[string]interface{}{}),
+	})
+	reg.Add("GET", "/api/v1/health", openapi.Operation{
+		Tags: []string{"health"}, Summary: "Servis ve provider sağlık durumu", OperationID: "health",
+		Responses: ok(http.StatusOK, "Sağlık raporu", map[string]interface{}{}),
+	})
+
+	// Arama ve içerikler
+	reg.Add("GET", "/api/v1/search", openapi.Operation{
+		Tags: []string{"search"}, Summary: "İçerik arama", OperationID: "search",
+		Parameters: append([]openapi.Parameter{
+			queryParam("query", "string", "Arama metni; boşsa tüm içerikler döner"),
+			queryParam("type", "string", "İçerik türü (video, article ...)"),
+			queryParam("sort", "string", "popularity (varsayılan), relevance, hybrid veya alan:yön listesi (ör. published_at:desc,views:desc)"),
+			queryParam("facets", "boolean", "Facet sayılarını ekler"),
+			queryParam("published_after", "string", "RFC3339 veya YYYY-MM-DD"),
+			queryParam("published_before", "string", "RFC3339 veya YYYY-MM-DD"),
+			queryParam("tags", "string", "Virgülle ayrılmış tag'ler"),
+			enumParam("tag_mode", "Tag eşleşme modu", "any", "all"),
+			providerFilter,
+			queryParam("provider_name", "string", "Provider adına göre filtre"),
+			queryParam("author", "string", "Yazar/kanal adı"),
+			queryParam("category", "string", "Kategori path'i"),
+			queryParam("cursor", "string", "Önceki yanıttaki pagination.next_cursor (keyset pagination)"),
+			queryParam("collapse_duplicates", "boolean", "Başka provider'daki kopyaları gizler"),
+			queryParam("explain", "boolean", "Skor açıklamasını ekler"),
+			enumParam("count", "Toplam sayım stratejisi", "exact", "estimate"),
+			queryParam("lang", "string", "Sadece bu dildeki içerikler"),
+		}, pagination...),
+		Responses: ok(http.StatusOK, "Arama sonuçları", usecase.SearchResult{}),
+		Security:  optionalAPIKey,
+	})
+	reg.Add("GET", "/api/v1/search/export", openapi.Operation{
+		Tags: []string{"search"}, Summary: "Arama sonuçlarının tamamını dışa aktar", OperationID: "exportSearch",
+		Description: "Sayfa yerine aramaya uyan tüm içerikleri popularity sırasıyla akış halinde döndürür. " +
+			"Satır sayısı SEARCH_EXPORT_MAX_ROWS ile sınırlıdır (X-Export-Row-Limit header'ı), istekler SEARCH_EXPORT_RATE_LIMIT_PER_MINUTE ile ayrıca sınırlanır.",
+		Parameters: []openapi.Parameter{
+			enumParam("format", "Çıktı biçimi (varsayılan ndjson)", "ndjson", "csv"),
+			queryParam("limit", "integer", "En fazla satır sayısı; verilmezse veya limitten büyükse SEARCH_EXPORT_MAX_ROWS"),
+			queryParam("query", "string", "Arama metni; boşsa tüm içerikler döner"),
+			queryParam("type", "string", "İçerik türü (video, article ...)"),
+			queryParam("published_after", "string", "RFC3339 veya YYYY-MM-DD"),
+			queryParam("published_before", "string", "RFC3339 veya YYYY-MM-DD"),
+			queryParam("tags", "string", "Virgülle ayrılmış tag'ler"),
+			enumParam("tag_mode", "Tag eşleşme modu", "any", "all"),
+			providerFilter,
+			queryParam("provider_name", "string", "Provider adına göre filtre"),
+			queryParam("author", "string", "Yazar/kanal adı"),
+			queryParam("category", "string", "Kategori path'i"),
+			queryParam("collapse_duplicates", "boolean", "Başka provider'daki kopyaları gizler"),
+			queryParam("lang", "string", "Sadece bu dildeki içerikler"),
+		},
+		Responses: map[string]*openapi.Response{
+			"200": {
+				Description: "NDJSON'da her satır bir Content; CSV kolonları: id, provider_id, title, content_type, language, url, published_at, final_score, tags",
+				Content: map[string]openapi.MediaType{
+					"application/x-ndjson": {Schema: s.Of(entity.Content{})},
+					"text/csv":             {Schema: &openapi.Schema{Type: "string"}},
+				},
+			},
+			"default": jsonResponse("Hata", errorSchema),
+		},
+		Security: optionalAPIKey,
+	})
+	reg.Add("GET", "/api/v1/contents/{id}", openapi.Operation{
+		Tags: []string{"contents"}, Summary: "İçerik detayı", OperationID: "getContent",
+		Parameters: []openapi.Parameter{idParam("İçerik ID"), queryParam("explain", "boolean", "Skor açıklamasını ekler")},
+		Responses:  ok(http.StatusOK, "İçerik", entity.Content{}),
+	})
+	reg.Add("GET", "/api/v1/contents/{id}/versions", openapi.Operation{
+		Tags: []string{"contents"}, Summary: "İçeriğin önceki halleri", OperationID: "listContentVersions",
+		Parameters: append([]openapi.Parameter{idParam("İçerik ID")}, pagination...),
+		Responses:  ok(http.StatusOK, "Versiyonlar", usecase.ContentVersionsResult{}),
+	})
+	reg.Add("GET", "/api/v1/contents/{id}/similar", openapi.Operation{
+		Tags: []string{"contents"}, Summary: "Benzer içerikler", OperationID: "listSimilarContents",
+		Parameters: []openapi.Parameter{idParam("İçerik ID"), queryParam("limit", "integer", "En fazla sonuç sayısı")},
+		Responses:  ok(http.StatusOK, "Benzer içerikler", usecase.SimilarResult{}),
+		Security:   optionalAPIKey,
+	})
+	reg.Add("GET", "/api/v1/authors", openapi.Operation{
+		Tags: []string{"contents"}, Summary: "Yazarlar/kanallar", OperationID: "listAuthors",
+		Parameters: append([]openapi.Parameter{providerFilter, queryParam("q", "string", "İsim araması")}, pagination...),
+		Responses:  ok(http.StatusOK, "Yazarlar", usecase.ListAuthorsResult{}),
+	})
+	reg.Add("GET", "/api/v1/categories", openapi.Operation{
+		Tags: []string{"contents"}, Summary: "Kategori ağacı", OperationID: "listCategories",
+		Responses: ok(http.StatusOK, "Kategoriler", usecase.ListCategoriesResult{}),
+	})
+
+	reg.Add("GET", "/api/v1/stream", openapi.Operation{
+		Tags: []string{"contents"}, Summary: "Yeni ve güncellenen içerik akışı (SSE)", OperationID: "streamContents",
+		Description: "Bağlantı açık kalır; her olay `event: created|updated` ve ContentChange JSON'ı içeren `data:` satırıyla gönderilir. " +
+			"Bağlantı öncesindeki değişiklikler tekrar gönderilmez.",
+		Parameters: []openapi.Parameter{
+			queryParam("query", "string", "Tüm terimler başlık, açıklama veya tag'lerde geçmeli"),
+			queryParam("tags", "string", "Virgülle ayrılmış tag'ler (en az biri)"),
+			queryParam("type", "string", "İçerik türü (video, article ...)"),
+			providerFilter,
+			queryParam("changes", "string", "Virgülle ayrılmış değişiklik türleri: created, updated (varsayılan ikisi)"),
+		},
+		Responses: map[string]*openapi.Response{
+			"200": {
+				Description: "text/event-stream; her olayın data alanı bir ContentChange",
+				Content:     map[string]openapi.MediaType{"text/event-stream": {Schema: s.Of(entity.ContentChange{})}},
+			},
+			"default": jsonResponse("Hata", errorSchema),
+		},
+		Security: optionalAPIKey,
+	})
+
+	reg.Add("POST", "/api/v1/events", openapi.Operation{
+		Tags: []string{"events"}, Summary: "Gösterim ve tıklama olaylarını kaydet", OperationID: "recordEvents",
+		Description: "En fazla 100 olay. Bot User-Agent'larının olayları yok sayılır, gösterimler EVENTS_IMPRESSION_SAMPLE_RATE ile örneklenebilir",
+		RequestBody: body(usecase.RecordEventsInput{}),
+		Responses:   ok(http.StatusAccepted, "Kaydedilen, örneklemeyle atlanan ve yok sayılan olay sayıları", usecase.RecordEventsResult{}),
+		Security:    optionalAPIKey,
+	})
+
+	// Kayıtlı aramalar (X-API-Key zorunlu, her anahtar kendi aramalarını görür)
+	reg.Add("GET", "/api/v1/saved-searches", openapi.Operation{
+		Tags: []string{"saved-searches"}, Summary: "Kayıtlı aramalar", OperationID: "listSavedSearches",
+		Responses: ok(http.StatusOK, "Anahtarın kayıtlı aramaları (secret'lar dönmez)", struct {
+			SavedSearches []*entity.SavedSearch `json:"saved_searches"`
+		}{}),
+		Security: apiKey,
+	})
+	reg.Add("POST", "/api/v1/saved-searches", openapi.Operation{
+		Tags: []string{"saved-searches"}, Summary: "Kayıtlı arama oluştur", OperationID: "createSavedSearch",
+		Description: "Sync'te eklenen ve sorguya uyan içerikler notify_email'e ve/veya notify_url'ye imzalı POST ile bildirilir. " +
+			"notify_url için imza secret'ı sadece bu yanıtta döner",
+		RequestBody: body(usecase.SavedSearchInput{}),
+		Responses:   ok(http.StatusCreated, "Oluşturulan kayıtlı arama", usecase.CreatedSavedSearch{}),
+		Security:    apiKey,
+	})
+	reg.Add("DELETE", "/api/v1/saved-searches/{id}", openapi.Operation{
+		Tags: []string{"saved-searches"}, Summary: "Kayıtlı aramayı sil", OperationID: "deleteSavedSearch",
+		Parameters: []openapi.Parameter{idParam("Kayıtlı arama ID")},
+		Responses:  ok(http.StatusNoContent, "Silindi", nil),
+		Security:   apiKey,
+	})
+
+	// Admin: senkronizasyon
+	dryRun := queryParam("dry_run", "boolean", "Veritabanına yazmadan değişiklik raporu döner")
+	reg.Add("POST", "/api/v1/admin/sync", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Tüm provider'ları senkronize et", OperationID: "triggerSync",
+		Description: "Arka planda job başlatır (202); dry_run=true ile senkron rapor döner (200)",
+		Parameters:  []openapi.Parameter{dryRun},
+		Responses:   withDryRun(ok(http.StatusAccepted, "Job başlatıldı", map[string]string{}), s.Of(usecase.SyncDryRunResult{})),
+		Security:    admin,
+	})
+	reg.Add("POST", "/api/v1/admin/sync/{providerID}", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Tek provider'ı senkronize et", OperationID: "triggerProviderSync",
+		Parameters: []openapi.Parameter{dryRun},
+		Responses:  withDryRun(ok(http.StatusAccepted, "Job başlatıldı", map[string]interface{}{}), s.Of(usecase.SyncDryRunResult{})),
+		Security:   admin,
+	})
+	reg.Add("POST", "/api/v1/admin/import", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "İçerikleri toplu içe aktar", OperationID: "importContents",
+		Description: "NDJSON (her satır bir normalize içerik) veya CSV gövdeyi akış halinde okuyup sync pipeline'ı ile yazar; " +
+			"geçersiz kayıtlar atlanır ve satır numaralarıyla raporlanır, dosyada olmayan içerikler silinmez",
+		Parameters: []openapi.Parameter{
+			queryParam("provider_id", "integer", "İçeriklerin aktarılacağı provider (zorunlu)"),
+			queryParam("format", "string", "ndjson veya csv (verilmezse Content-Type: text/csv ise csv, değilse ndjson)"),
+		},
+		RequestBody: &openapi.RequestBody{
+			Required: true,
+			Content: map[string]openapi.MediaType{
+				"application/x-ndjson": {Schema: s.Of(entity.NormalizedContent{})},
+				"text/csv":             {Schema: &openapi.Schema{Type: "string"}},
+			},
+		},
+		Responses: ok(http.StatusOK, "İçe aktarma sonucu", usecase.ContentImportResult{}),
+		Security:  admin,
+	})
+	reg.Add("GET", "/api/v1/admin/sync/{jobID}", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Sync job durumu", OperationID: "getSyncJob",
+		Responses: ok(http.StatusOK, "Job", usecase.SyncJob{}),
+		Security:  admin,
+	})
+	reg.Add("GET", "/api/v1/admin/sync/history", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Sync geçmişi", OperationID: "listSyncHistory",
+		Parameters: append([]openapi.Parameter{providerFilter}, pagination...),
+		Responses:  ok(http.StatusOK, "Sync logları", usecase.SyncHistoryResult{}),
+		Security:   admin,
+	})
+	reg.Add("GET", "/api/v1/admin/sync/errors", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Karantinaya alınan içerikler", OperationID: "listSyncErrors",
+		Parameters: append([]openapi.Parameter{providerFilter}, pagination...),
+		Responses:  ok(http.StatusOK, "Sync hataları", usecase.SyncErrorsResult{}),
+		Security:   admin,
+	})
+
+	// Admin: içerik ve moderasyon
+	reg.Add("GET", "/api/v1/admin/contents/{id}/audit", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "İçerik değişiklik geçmişi", OperationID: "listContentAudit",
+		Parameters: append([]openapi.Parameter{idParam("İçerik ID")}, pagination...),
+		Responses:  ok(http.StatusOK, "Değişiklikler", usecase.ContentAuditResult{}),
+		Security:   admin,
+	})
+	reg.Add("GET", "/api/v1/admin/moderation", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Moderasyon kuyruğu", OperationID: "listModeration",
+		Parameters: append([]openapi.Parameter{
+			enumParam("status", "Moderasyon durumu (varsayılan pending)", "pending", "approved", "rejected"),
+			providerFilter,
+		}, pagination...),
+		Responses: ok(http.StatusOK, "İçerikler", usecase.ContentModerationResult{}),
+		Security:  admin,
+	})
+	reg.Add("POST", "/api/v1/admin/moderation", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Moderasyon kararı", OperationID: "reviewContents",
+		RequestBody: body(usecase.ContentReviewInput{}),
+		Responses:   ok(http.StatusOK, "Sonuç", usecase.ContentReviewResult{}),
+		Security:    admin,
+	})
+
+	// Admin: provider'lar
+	reg.Add("GET", "/api/v1/admin/providers", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Aktif provider'lar", OperationID: "listProviders",
+		Responses: ok(http.StatusOK, "Provider'lar", struct {
+			Providers []*entity.Provider `json:"providers"`
+		}{}),
+		Security: admin,
+	})
+	reg.Add("POST", "/api/v1/admin/providers", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Provider ekle", OperationID: "createProvider",
+		RequestBody: body(usecase.ProviderInput{}),
+		Responses:   ok(http.StatusCreated, "Oluşturulan provider", entity.Provider{}),
+		Security:    admin,
+	})
+	reg.Add("PUT", "/api/v1/admin/providers/{id}", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Provider güncelle", OperationID: "updateProvider",
+		Parameters:  []openapi.Parameter{idParam("Provider ID")},
+		RequestBody: body(usecase.ProviderInput{}),
+		Responses:   ok(http.StatusOK, "Güncellenen provider", entity.Provider{}),
+		Security:    admin,
+	})
+	reg.Add("DELETE", "/api/v1/admin/providers/{id}", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Provider ve içeriklerini sil", OperationID: "deleteProvider",
+		Parameters: []openapi.Parameter{idParam("Provider ID")},
+		Responses:  ok(http.StatusNoContent, "Silindi", nil),
+		Security:   admin,
+	})
+	reg.Add("GET", "/api/v1/admin/providers/{id}/health", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Provider sağlık durumu", OperationID: "getProviderHealth",
+		Parameters: []openapi.Parameter{idParam("Provider ID"), queryParam("refresh", "boolean", "Provider'ı hemen probe eder")},
+		Responses:  ok(http.StatusOK, "Sağlık durumu", entity.ProviderHealth{}),
+		Security:   admin,
+	})
+
+	// Admin: sıralama
+	reg.Add("GET", "/api/v1/admin/scoring", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Skorlama kuralları", OperationID: "getScoringRules",
+		Responses: ok(http.StatusOK, "Kurallar", entity.ScoringRules{}),
+		Security:  admin,
+	})
+	reg.Add("PUT", "/api/v1/admin/scoring", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Skorlama kurallarını değiştir", OperationID: "updateScoringRules",
+		RequestBody: body(entity.ScoringRules{}),
+		Responses:   ok(http.StatusOK, "Kurallar", entity.ScoringRules{}),
+		Security:    admin,
+	})
+	reg.Add("POST", "/api/v1/admin/scoring/recalculate", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Skorları yeniden hesapla", OperationID: "recalculateScores",
+		Description: "Tüm içeriklerin skorlarını güncel kurallarla arka planda yeniden hesaplar; önceki elle başlatılmış hesaplama sürüyorsa yenisi başlatılmaz",
+		Responses:   ok(http.StatusAccepted, "Hesaplama başlatıldı", map[string]string{}),
+		Security:    admin,
+	})
+	reg.Add("GET", "/api/v1/admin/boosts", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Tag boost kuralları", OperationID: "listBoosts",
+		Responses: ok(http.StatusOK, "Kurallar", struct {
+			Boosts []*entity.BoostRule `json:"boosts"`
+		}{}),
+		Security: admin,
+	})
+	reg.Add("PUT", "/api/v1/admin/boosts/{tag}", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Tag boost kuralı oluştur/güncelle", OperationID: "setBoost",
+		RequestBody: body(struct {
+			Percent float64 `json:"percent"`
+		}{}),
+		Responses: ok(http.StatusOK, "Kural", entity.BoostRule{}),
+		Security:  admin,
+	})
+	reg.Add("DELETE", "/api/v1/admin/boosts/{tag}", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Tag boost kuralını sil", OperationID: "deleteBoost",
+		Responses: ok(http.StatusNoContent, "Silindi", nil),
+		Security:  admin,
+	})
+	reg.Add("GET", "/api/v1/admin/promotions", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Sorgu sabitlemeleri", OperationID: "listPromotions",
+		Responses: ok(http.StatusOK, "Sabitlemeler", struct {
+			Promotions []*entity.Promotion `json:"promotions"`
+		}{}),
+		Security: admin,
+	})
+	reg.Add("PUT", "/api/v1/admin/promotions", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Sabitleme oluştur/güncelle", OperationID: "savePromotion",
+		RequestBody: body(usecase.PromotionInput{}),
+		Responses:   ok(http.StatusOK, "Sabitleme", entity.Promotion{}),
+		Security:    admin,
+	})
+	reg.Add("DELETE", "/api/v1/admin/promotions/{id}", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Sabitlemeyi sil", OperationID: "deletePromotion",
+		Parameters: []openapi.Parameter{idParam("Sabitleme ID")},
+		Responses:  ok(http.StatusNoContent, "Silindi", nil),
+		Security:   admin,
+	})
+
+	// Admin: API anahtarları
+	reg.Add("GET", "/api/v1/admin/api-keys", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "API anahtarları", OperationID: "listAPIKeys",
+		Responses: ok(http.StatusOK, "Anahtarlar (anahtar değerleri dönmez)", struct {
+			APIKeys []*entity.APIKey `json:"api_keys"`
+		}{}),
+		Security: admin,
+	})
+	reg.Add("POST", "/api/v1/admin/api-keys", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "API anahtarı oluştur", OperationID: "createAPIKey",
+		Description: "Anahtar sadece bu yanıtta döner",
+		RequestBody: body(usecase.APIKeyInput{}),
+		Responses:   ok(http.StatusCreated, "Oluşturulan anahtar", usecase.CreatedAPIKey{}),
+		Security:    admin,
+	})
+	reg.Add("DELETE", "/api/v1/admin/api-keys/{id}", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "API anahtarını iptal et", OperationID: "revokeAPIKey",
+		Parameters: []openapi.Parameter{idParam("API anahtarı ID")},
+		Responses:  ok(http.StatusOK, "İptal edilen anahtar", entity.APIKey{}),
+		Security:   admin,
+	})
+
+	// Admin: cache
+	reg.Add("POST", "/api/v1/admin/cache/invalidate", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Arama cache'ini temizle", OperationID: "invalidateCache",
+		Description: "Arama ve benzer içerik sonuçlarının cache key'lerini siler; diğer key'lere dokunmaz",
+		Responses:   ok(http.StatusOK, "Temizlendi", map[string]string{}),
+		Security:    admin,
+	})
+	reg.Add("GET", "/api/v1/admin/flags", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Feature flag'ler", OperationID: "listFeatureFlags",
+		Responses: ok(http.StatusOK, "Bayraklar", struct {
+			Flags []entity.FeatureFlag `json:"flags"`
+		}{}),
+		Security: admin,
+	})
+	reg.Add("PUT", "/api/v1/admin/flags/{name}", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Feature flag aç/kapat", OperationID: "setFeatureFlag",
+		Description: "Config'teki varsayılanı ezer; değişiklik tüm instance'larda en geç FLAG_REFRESH_SECONDS içinde uygulanır ve arama cache'i temizlenir",
+		RequestBody: body(struct {
+			Enabled bool `json:"enabled"`
+		}{}),
+		Responses: ok(http.StatusOK, "Bayrak", entity.FeatureFlag{}),
+		Security:  admin,
+	})
+	reg.Add("DELETE", "/api/v1/admin/flags/{name}", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Feature flag'i varsayılana döndür", OperationID: "resetFeatureFlag",
+		Responses: ok(http.StatusOK, "Bayrak", entity.FeatureFlag{}),
+		Security:  admin,
+	})
+
+	// Admin: özet
+	reg.Add("GET", "/api/v1/admin/stats", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Yönetim paneli özeti", OperationID: "adminStats",
+		Description: "Provider ve türe göre içerik sayıları, son senkronizasyon süreleri, cache isabet oranı ve arama indeksi güncelliği",
+		Responses:   ok(http.StatusOK, "Sistem özeti", entity.AdminStats{}),
+		Security:    admin,
+	})
+
+	// Admin: yedekleme
+	reg.Add("GET", "/api/v1/admin/snapshots", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "İçerik yedeklerini listele", OperationID: "listSnapshots",
+		Description: "Yapılandırılmış depolama alanındaki (yerel dizin, S3, GCS) yedekler ve son yedek alma / geri yükleme çalışması",
+		Responses:   ok(http.StatusOK, "Yedekler ve son çalışma", map[string]interface{}{}),
+		Security:    admin,
+	})
+	reg.Add("POST", "/api/v1/admin/snapshots", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "İçerik yedeği al", OperationID: "createSnapshot",
+		Description: "Provider, kategori, yazar ve silinmemiş içerikleri (istatistik, skor ve tag'leriyle) gzip sıkıştırılmış NDJSON olarak arka planda yedekler",
+		Responses:   ok(http.StatusAccepted, "Yedek alma başlatıldı", entity.SnapshotRun{}),
+		Security:    admin,
+	})
+	reg.Add("POST", "/api/v1/admin/snapshots/{name}/restore", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Yedekten geri yükle", OperationID: "restoreSnapshot",
+		Description: "Yedeği arka planda upsert ile geri yükler; provider'lar ada göre eşlenir, olmayanlar oluşturulur",
+		Responses:   ok(http.StatusAccepted, "Geri yükleme başlatıldı", entity.SnapshotRun{}),
+		Security:    admin,
+	})
+
+	// Admin: analitik
+	reg.Add("GET", "/api/v1/admin/analytics/ctr", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "İçerik tıklanma oranları", OperationID: "contentCTR",
+		Description: "Son days gündeki en çok gösterilen içerikler; gösterimler örnekleme ağırlıklarıyla tahmin edilir",
+		Parameters: []openapi.Parameter{
+			queryParam("query", "string", "Sadece bu sorgunun olayları"),
+			queryParam("days", "integer", "Gün sayısı (varsayılan 7, en fazla 90)"),
+			queryParam("limit", "integer", "En fazla içerik sayısı (varsayılan 50, en fazla 500)"),
+		},
+		Responses: ok(http.StatusOK, "CTR raporu", usecase.ContentCTRResult{}),
+		Security:  admin,
+	})
+	reg.Add("GET", "/api/v1/admin/analytics/zero-results", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Sonuçsuz arama sorguları", OperationID: "zeroResultQueries",
+		Description: "Son days günde hiç sonuç döndürmeyen filtresiz aramalar; eş anlamlı kelime veya yeni provider adayları",
+		Parameters: []openapi.Parameter{
+			queryParam("days", "integer", "Gün sayısı (varsayılan 7, en fazla 90)"),
+			queryParam("limit", "integer", "En fazla sorgu sayısı (varsayılan 50, en fazla 500)"),
+		},
+		Responses: ok(http.StatusOK, "Sonuçsuz sorgu raporu", usecase.ZeroResultQueriesResult{}),
+		Security:  admin,
+	})
+
+	// Admin: webhook abonelikleri
+	reg.Add("GET", "/api/v1/admin/webhooks", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Webhook abonelikleri", OperationID: "listWebhooks",
+		Responses: ok(http.StatusOK, "Abonelikler (secret'lar dönmez)", struct {
+			Webhooks []*entity.WebhookSubscription `json:"webhooks"`
+		}{}),
+		Security: admin,
+	})
+	reg.Add("POST", "/api/v1/admin/webhooks", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Webhook aboneliği oluştur", OperationID: "createWebhook",
+		Description: "Sync sonrası filtreye uyan yeni/güncellenen içerikler url'ye imzalı POST edilir. İmza secret'ı sadece bu yanıtta döner",
+		RequestBody: body(usecase.WebhookInput{}),
+		Responses:   ok(http.StatusCreated, "Oluşturulan abonelik", usecase.CreatedWebhook{}),
+		Security:    admin,
+	})
+	reg.Add("PUT", "/api/v1/admin/webhooks/{id}", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Webhook aboneliğini güncelle", OperationID: "updateWebhook",
+		Description: "Secret değişmez",
+		Parameters:  []openapi.Parameter{idParam("Abonelik ID")},
+		RequestBody: body(usecase.WebhookInput{}),
+		Responses:   ok(http.StatusOK, "Güncellenen abonelik", entity.WebhookSubscription{}),
+		Security:    admin,
+	})
+	reg.Add("DELETE", "/api/v1/admin/webhooks/{id}", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Webhook aboneliğini sil", OperationID: "deleteWebhook",
+		Parameters: []openapi.Parameter{idParam("Abonelik ID")},
+		Responses:  ok(http.StatusNoContent, "Silindi", nil),
+		Security:   admin,
+	})
+	reg.Add("GET", "/api/v1/admin/webhooks/{id}/deliveries", openapi.Operation{
+		Tags: []string{"admin"}, Summary: "Webhook gönderimleri", OperationID: "listWebhookDeliveries",
+		Parameters: []openapi.Parameter{idParam("Abonelik ID"), queryParam("limit", "integer", "En fazla gönderim sayısı (varsayılan 50, en fazla 200)")},
+		Responses: ok(http.StatusOK, "Son gönderimler (yeniden eskiye)", struct {
+			Deliveries []*entity.WebhookDelivery `json:"deliveries"`
+		}{}),
+		Security: admin,
+	})
+
+	return reg
+}
+
+// BuildAPIDocs router'daki route'lardan OpenAPI dokümanını üretir
+// Registry'de karşılığı olmayan /api/v1 route'ları "METHOD /path" olarak undocumented listesinde döner
+func BuildAPIDocs(router *mux.Router) (doc *openapi.Document, undocumented []string, err error) {
+	info := openapi.Info{
+		Title:       "Search Engine API",
+		Description: "Farklı provider'lardan toplanan içerikler üzerinde arama ve yönetim API'si. Hatalar apierror zarfıyla döner.",
+		Version:     "1.0.0",
+	}
+
+	doc, undocumented, err = NewAPIRegistry().Build(router, info, "/api/v1")
+	if err != nil {
+		return nil, nil, err
+	}
+	doc.Components.SecuritySchemes = securitySchemes()
+	return doc, undocumented, nil
+}
+
+// securitySchemes dokümana eklenecek kimlik doğrulama yöntemleri
+func securitySchemes() map[string]*openapi.SecurityScheme {
+	return map[string]*openapi.SecurityScheme{
+		securityBearer: {
+			Type: "http", Scheme: "bearer", BearerFormat: "JWT",
+			Description: "Admin JWT (HS256) veya ADMIN_STATIC_TOKENS'taki sabit token",
+		},
+		securityAPIKey: {
+			Type: "apiKey", In: "header", Name: "X-API-Key",
+			Description: "Verilirse rate limit anahtar bazında uygulanır; kayıtlı arama endpoint'lerinde zorunludur",
+		},
+	}
+}
+
+// jsonResponse JSON gövdeli yanıt
+func jsonResponse(description string, schema *openapi.Schema) *openapi.Response {
+	return &openapi.Response{
+		Description: description,
+		Content:     map[string]openapi.MediaType{"application/json": {Schema: schema}},
+	}
+}
+
+// withDryRun dry_run=true ile dönen 200 yanıtını ekler
+func withDryRun(responses map[string]*openapi.Response, schema *openapi.Schema) map[string]*openapi.Response {
+	responses["200"] = jsonResponse("Dry-run raporu", schema)
+	return responses
+}
+
+// queryParam opsiyonel query parametresi
+func queryParam(name, typ, description string) openapi.Parameter {
+	return openapi.Parameter{Name: name, In: "query", Description: description, Schema: &openapi.Schema{Type: typ}}
+}
+
+// enumParam değerleri sınırlı opsiyonel query parametresi
+func enumParam(name, description string, values ...string) openapi.Parameter {
+	return openapi.Parameter{Name: name, In: "query", Description: description, Schema: &openapi.Schema{Type: "string", Enum: values}}
+}
+
+// idParam pozitif integer {id} path parametresi
+func idParam(description string) openapi.Parameter {
+	return openapi.Parameter{Name: "id", In: "path", Required: true, Description: description, Schema: &openapi.Schema{Type: "integer", Format: "int64"}}
+}
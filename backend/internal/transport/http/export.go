@@ -0,0 +1,166 @@
+package http
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/logger"
+)
+
+// exportCSVHeader CSV dışa aktarımının kolonları (searchctl export search ile aynı)
+var exportCSVHeader = []string{"id", "provider_id", "title", "content_type", "language", "url", "published_at", "final_score", "tags"}
+
+// HandleExport aramaya uyan tüm içerikleri NDJSON veya CSV olarak akış halinde döndürür
+// GET /api/v1/search/export?query=go&format=ndjson
+// Filtreler /search ile aynıdır; sıralama her zaman popularity'dir ve page/page_size kullanılmaz
+// Opsiyonel: format=csv (varsayılan ndjson), limit=1000 (en fazla SEARCH_EXPORT_MAX_ROWS)
+func (h *SearchHandler) HandleExport(w http.ResponseWriter, r *http.Request) {
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = "ndjson"
+	}
+	if format != "ndjson" && format != "csv" {
+		respondUseCaseError(w, apperrors.NewValidationError("format", "format must be 'ndjson' or 'csv'", format))
+		return
+	}
+
+	var limit int
+	if rawLimit := r.URL.Query().Get("limit"); rawLimit != "" {
+		parsed, err := strconv.Atoi(rawLimit)
+		if err != nil || parsed < 1 {
+			respondUseCaseError(w, apperrors.NewValidationError("limit", "limit must be a positive integer", rawLimit))
+			return
+		}
+		limit = parsed
+	}
+
+	params, err := parseSearchParams(r)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	// Header'lar ilk satır yazılırken gönderilir; use case'in doğrulama hataları JSON hata olarak dönebilsin
+	buf := bufio.NewWriterSize(w, 64<<10)
+	write := newContentExportWriter(format, buf)
+	started := false
+	start := func() {
+		started = true
+
+		// Sunucunun WriteTimeout'u büyük dışa aktarmaları kesmesin
+		_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+
+		rowLimit := h.searchUseCase.ExportMaxRows()
+		if limit > 0 && limit < rowLimit {
+			rowLimit = limit
+		}
+		contentType, ext := "application/x-ndjson", "ndjson"
+		if format == "csv" {
+			contentType, ext = "text/csv; charset=utf-8", "csv"
+		}
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Disposition", `attachment; filename="search-export.`+ext+`"`)
+		w.Header().Set("X-Export-Row-Limit", strconv.Itoa(rowLimit))
+		w.WriteHeader(http.StatusOK)
+	}
+
+	written, err := h.searchUseCase.Export(r.Context(), params, limit, func(content *entity.Content) error {
+		if !started {
+			start()
+		}
+		return write.content(content)
+	})
+	if err != nil && !started {
+		respondUseCaseError(w, err)
+		return
+	}
+	if err != nil {
+		// Yanıt başladıktan sonra durum kodu değiştirilemez; bağlantı kesilir ki istemci eksik dosyayı fark etsin
+		logger.Error("Search export aborted", zap.Int("written", written), zap.Error(err))
+		panic(http.ErrAbortHandler)
+	}
+
+	if !started {
+		start()
+	}
+	if err := write.flush(); err == nil {
+		_ = buf.Flush()
+	}
+}
+
+// contentExportWriter içerikleri seçilen formatta yazar
+type contentExportWriter struct {
+	content func(*entity.Content) error
+	flush   func() error // CSV'de hiç içerik yoksa da header yazılır
+}
+
+// newContentExportWriter formata göre NDJSON veya CSV yazıcı oluşturur
+func newContentExportWriter(format string, w io.Writer) contentExportWriter {
+	if format != "csv" {
+		enc := json.NewEncoder(w)
+		return contentExportWriter{
+			content: func(c *entity.Content) error { return enc.Encode(c) },
+			flush:   func() error { return nil },
+		}
+	}
+
+	cw := csv.NewWriter(w)
+	headerWritten := false
+	writeHeader := func() error {
+		if headerWritten {
+			return nil
+		}
+		headerWritten = true
+		return cw.Write(exportCSVHeader)
+	}
+	return contentExportWriter{
+		content: func(c *entity.Content) error {
+			if err := writeHeader(); err != nil {
+				return err
+			}
+			return cw.Write(exportCSVRecord(c))
+		},
+		flush: func() error {
+			if err := writeHeader(); err != nil {
+				return err
+			}
+			cw.Flush()
+			return cw.Error()
+		},
+	}
+}
+
+// exportCSVRecord içeriği exportCSVHeader sırasıyla tek satıra çevirir
+func exportCSVRecord(c *entity.Content) []string {
+	score := ""
+	if c.Score != nil {
+		score = strconv.FormatFloat(c.Score.FinalScore, 'f', -1, 64)
+	}
+
+	tags := make([]string, 0, len(c.Tags))
+	for _, tag := range c.Tags {
+		tags = append(tags, tag.Name)
+	}
+
+	return []string{
+		strconv.FormatInt(c.ID, 10),
+		strconv.FormatInt(c.ProviderID, 10),
+		c.Title,
+		string(c.ContentType),
+		c.Language,
+		c.URL,
+		c.PublishedAt.UTC().Format(time.RFC3339),
+		score,
+		strings.Join(tags, ","),
+	}
+}
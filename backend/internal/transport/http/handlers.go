@@ -1,17 +1,27 @@
 package http
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
+	"mime"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-redis/redis/v8"
+	"github.com/gorilla/mux"
 
 	"github.com/onurerdog4n/search-engine/internal/application/usecase"
 	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
 	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/metrics"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/tracing"
+	"github.com/onurerdog4n/search-engine/internal/transport/apierror"
+	"github.com/onurerdog4n/search-engine/internal/transport/middleware"
 )
 
 // SearchHandler arama HTTP handler'ı
@@ -27,79 +37,1382 @@ func NewSearchHandler(searchUseCase *usecase.SearchContentsUseCase) *SearchHandl
 }
 
 // HandleSearch arama isteğini işler
-// GET /api/v1/search?query=go&type=video&sort=popularity&page=1&page_size=20
+// GET /api/v1/search?query=go&type=video&sort=popularity&page=1&page_size=20&facets=true
+// Opsiyonel: published_after=2024-01-01&published_before=2024-06-30T23:59:59Z
+// Opsiyonel: tags=golang,tutorial&tag_mode=all
+// Opsiyonel: provider_id=1 veya provider_name=Provider%201%20(JSON)
+// Opsiyonel: cursor=<önceki yanıttaki pagination.next_cursor> (page yerine keyset pagination)
+// Opsiyonel: collapse_duplicates=true (başka provider'daki içeriğin kopyalarını gizler)
+// Opsiyonel: explain=true (her sonuca skor bileşenleri, formül girdileri ve sırası eklenir)
+// Opsiyonel: count=estimate (toplam 10000'e kadar sayılır, pagination.exact_total false ise toplam bir alt sınırdır)
+// Opsiyonel: lang=turkish (sadece bu dildeki içerikler, sorgu bu dilin kurallarıyla işlenir)
 func (h *SearchHandler) HandleSearch(w http.ResponseWriter, r *http.Request) {
 	// 1. Query parametrelerini al
+	params, err := parseSearchParams(r)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	// 2. Use case'i çalıştır
+	result, err := h.searchUseCase.Execute(r.Context(), params)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	// 3. Başarılı response döndür (serileştirme süresi ayrı span'de görünür)
+	_, span := tracing.Tracer().Start(r.Context(), "SearchHandler.encodeResponse")
+	respondJSON(w, http.StatusOK, result)
+	span.End()
+}
+
+// parseSearchParams arama ve dışa aktarma isteklerinin query parametrelerini SearchParams'a çevirir
+func parseSearchParams(r *http.Request) (port.SearchParams, error) {
 	query := r.URL.Query().Get("query")
 	// Query artık zorunlu değil, boş ise tüm sonuçlar döner
 
-	contentType := r.URL.Query().Get("type")
-	sortBy := r.URL.Query().Get("sort")
-	if sortBy == "" {
-		sortBy = "popularity"
+	contentType := r.URL.Query().Get("type")
+	sortBy := r.URL.Query().Get("sort")
+	if sortBy == "" {
+		sortBy = "popularity"
+	}
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+	if pageSize < 1 {
+		pageSize = 20
+	}
+
+	// Facet hesaplaması opsiyonel (ek sorgu maliyeti var)
+	includeFacets, _ := strconv.ParseBool(r.URL.Query().Get("facets"))
+
+	// Farklı provider'lardan gelen aynı içerikler tek sonuç olarak gösterilsin mi
+	collapseDuplicates, _ := strconv.ParseBool(r.URL.Query().Get("collapse_duplicates"))
+
+	// Sıralamanın nedenini görmek için skor açıklaması
+	explain, _ := strconv.ParseBool(r.URL.Query().Get("explain"))
+
+	// Yayın tarihi aralığı (RFC3339 veya YYYY-MM-DD)
+	publishedAfter, err := parseDateParam(r, "published_after", false)
+	if err != nil {
+		return port.SearchParams{}, err
+	}
+	publishedBefore, err := parseDateParam(r, "published_before", true)
+	if err != nil {
+		return port.SearchParams{}, err
+	}
+
+	// Provider filtresi
+	var providerID int64
+	if rawProviderID := r.URL.Query().Get("provider_id"); rawProviderID != "" {
+		providerID, err = strconv.ParseInt(rawProviderID, 10, 64)
+		if err != nil {
+			return port.SearchParams{}, apperrors.NewValidationError("provider_id", "provider_id must be an integer", rawProviderID)
+		}
+	}
+
+	// Keyset pagination cursor'ı
+	var cursor *port.SearchCursor
+	if rawCursor := r.URL.Query().Get("cursor"); rawCursor != "" {
+		cursor, err = port.DecodeSearchCursor(rawCursor)
+		if err != nil {
+			return port.SearchParams{}, apperrors.NewValidationError("cursor", "invalid cursor", rawCursor)
+		}
+	}
+
+	// Tag filtresi (virgülle ayrılmış)
+	var tags []string
+	if rawTags := r.URL.Query().Get("tags"); rawTags != "" {
+		tags = strings.Split(rawTags, ",")
+	}
+
+	return port.SearchParams{
+		Query:         query,
+		ContentType:   entity.ContentType(contentType),
+		Language:      r.URL.Query().Get("lang"),
+		SortBy:        sortBy,
+		Page:          page,
+		PageSize:      pageSize,
+		IncludeFacets: includeFacets,
+
+		PublishedAfter:  publishedAfter,
+		PublishedBefore: publishedBefore,
+
+		ProviderID:   providerID,
+		ProviderName: r.URL.Query().Get("provider_name"),
+
+		AuthorName: r.URL.Query().Get("author"),
+		Category:   r.URL.Query().Get("category"),
+
+		Tags:    tags,
+		TagMode: r.URL.Query().Get("tag_mode"),
+
+		Cursor: cursor,
+
+		CollapseDuplicates: collapseDuplicates,
+
+		CountMode: r.URL.Query().Get("count"),
+
+		Explain: explain,
+	}, nil
+}
+
+// ContentHandler içerik detayı HTTP handler'ı
+type ContentHandler struct {
+	contentUseCase *usecase.GetContentUseCase
+}
+
+// NewContentHandler yeni bir içerik detayı handler oluşturur
+func NewContentHandler(contentUseCase *usecase.GetContentUseCase) *ContentHandler {
+	return &ContentHandler{
+		contentUseCase: contentUseCase,
+	}
+}
+
+// HandleGet tek bir içeriği döndürür
+// GET /api/v1/contents/{id}
+// Opsiyonel: explain=true (skor bileşenleri ve formül girdileri eklenir)
+func (h *ContentHandler) HandleGet(w http.ResponseWriter, r *http.Request) {
+	rawID := mux.Vars(r)["id"]
+	contentID, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil || contentID < 1 {
+		respondUseCaseError(w, apperrors.NewValidationError("id", "id must be a positive integer", rawID))
+		return
+	}
+
+	explain, _ := strconv.ParseBool(r.URL.Query().Get("explain"))
+
+	content, err := h.contentUseCase.Execute(r.Context(), contentID, explain)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, content)
+}
+
+// ContentVersionsHandler içerik versiyonları HTTP handler'ı
+type ContentVersionsHandler struct {
+	versionsUseCase *usecase.ContentVersionsUseCase
+}
+
+// NewContentVersionsHandler yeni bir içerik versiyonları handler oluşturur
+func NewContentVersionsHandler(versionsUseCase *usecase.ContentVersionsUseCase) *ContentVersionsHandler {
+	return &ContentVersionsHandler{
+		versionsUseCase: versionsUseCase,
+	}
+}
+
+// HandleVersions içeriğin önceki hallerini (başlık, açıklama, istatistikler) en yeniden eskiye sayfalı döndürür
+// GET /api/v1/contents/{id}/versions?page=1&page_size=20
+func (h *ContentVersionsHandler) HandleVersions(w http.ResponseWriter, r *http.Request) {
+	rawID := mux.Vars(r)["id"]
+	contentID, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil || contentID < 1 {
+		respondUseCaseError(w, apperrors.NewValidationError("id", "id must be a positive integer", rawID))
+		return
+	}
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+
+	result, err := h.versionsUseCase.Execute(r.Context(), contentID, page, pageSize)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, result)
+}
+
+// AuthorsHandler yazar/kanal listeleme HTTP handler'ı
+type AuthorsHandler struct {
+	authorsUseCase *usecase.ListAuthorsUseCase
+}
+
+// NewAuthorsHandler yeni bir yazar listeleme handler oluşturur
+func NewAuthorsHandler(authorsUseCase *usecase.ListAuthorsUseCase) *AuthorsHandler {
+	return &AuthorsHandler{
+		authorsUseCase: authorsUseCase,
+	}
+}
+
+// HandleList yazarları içerik sayısına göre azalan sırada sayfalı döndürür
+// GET /api/v1/authors?page=1&page_size=20
+// Opsiyonel: provider_id=1, q=isim
+func (h *AuthorsHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+
+	providerID, ok := parseOptionalProviderID(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.authorsUseCase.Execute(r.Context(), providerID, r.URL.Query().Get("q"), page, pageSize)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, result)
+}
+
+// CategoriesHandler kategori listeleme HTTP handler'ı
+type CategoriesHandler struct {
+	categoriesUseCase *usecase.ListCategoriesUseCase
+}
+
+// NewCategoriesHandler yeni bir kategori listeleme handler oluşturur
+func NewCategoriesHandler(categoriesUseCase *usecase.ListCategoriesUseCase) *CategoriesHandler {
+	return &CategoriesHandler{
+		categoriesUseCase: categoriesUseCase,
+	}
+}
+
+// HandleList kategori ağacını path sırasıyla, alt kategoriler dahil içerik sayılarıyla döndürür
+// GET /api/v1/categories
+func (h *CategoriesHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	result, err := h.categoriesUseCase.Execute(r.Context())
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, result)
+}
+
+// SimilarHandler benzer içerik HTTP handler'ı
+type SimilarHandler struct {
+	similarUseCase *usecase.SimilarContentsUseCase
+}
+
+// NewSimilarHandler yeni bir similar handler oluşturur
+func NewSimilarHandler(similarUseCase *usecase.SimilarContentsUseCase) *SimilarHandler {
+	return &SimilarHandler{
+		similarUseCase: similarUseCase,
+	}
+}
+
+// HandleSimilar bir içeriğe benzeyen içerikleri döndürür
+// GET /api/v1/contents/{id}/similar?limit=10
+func (h *SimilarHandler) HandleSimilar(w http.ResponseWriter, r *http.Request) {
+	rawID := mux.Vars(r)["id"]
+	contentID, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil || contentID < 1 {
+		respondUseCaseError(w, apperrors.NewValidationError("id", "id must be a positive integer", rawID))
+		return
+	}
+
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	result, err := h.similarUseCase.Execute(r.Context(), contentID, limit)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, result)
+}
+
+// SyncHandler senkronizasyon HTTP handler'ı
+type SyncHandler struct {
+	syncUseCase *usecase.SyncProviderContentsUseCase
+}
+
+// NewSyncHandler yeni bir sync handler oluşturur
+func NewSyncHandler(syncUseCase *usecase.SyncProviderContentsUseCase) *SyncHandler {
+	return &SyncHandler{
+		syncUseCase: syncUseCase,
+	}
+}
+
+// HandleSync senkronizasyon isteğini işler
+// POST /api/v1/admin/sync
+// Opsiyonel: dry_run=true (veritabanına yazmadan değişiklik raporu döner)
+func (h *SyncHandler) HandleSync(w http.ResponseWriter, r *http.Request) {
+	if isDryRun(r) {
+		h.respondDryRun(w, r, 0)
+		return
+	}
+
+	// Arka planda senkronizasyonu başlat
+	jobID, err := h.syncUseCase.ExecuteAsync()
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	// Hemen response döndür (durum GET /api/v1/admin/sync/{jobID} ile takip edilir)
+	respondJSON(w, http.StatusAccepted, map[string]string{
+		"message": "Senkronizasyon başlatıldı",
+		"status":  "running",
+		"job_id":  jobID,
+	})
+}
+
+// HandleSyncStatus sync job'unun durumunu ve provider bazlı sonuçlarını döndürür
+// GET /api/v1/admin/sync/{jobID}
+func (h *SyncHandler) HandleSyncStatus(w http.ResponseWriter, r *http.Request) {
+	job, ok := h.syncUseCase.Job(mux.Vars(r)["jobID"])
+	if !ok {
+		respondError(w, http.StatusNotFound, apierror.CodeSyncJobNotFound, "Sync job bulunamadı")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, job)
+}
+
+// HandleSyncProvider tek bir provider için senkronizasyon başlatır
+// POST /api/v1/admin/sync/{providerID}
+// Opsiyonel: dry_run=true
+func (h *SyncHandler) HandleSyncProvider(w http.ResponseWriter, r *http.Request) {
+	rawID := mux.Vars(r)["providerID"]
+	providerID, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil || providerID < 1 {
+		respondUseCaseError(w, apperrors.NewValidationError("providerID", "providerID must be a positive integer", rawID))
+		return
+	}
+
+	if isDryRun(r) {
+		h.respondDryRun(w, r, providerID)
+		return
+	}
+
+	jobID, err := h.syncUseCase.ExecuteProviderAsync(providerID)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusAccepted, map[string]interface{}{
+		"message":     "Provider senkronizasyonu başlatıldı",
+		"status":      "running",
+		"provider_id": providerID,
+		"job_id":      jobID,
+	})
+}
+
+// respondDryRun dry-run senkronizasyonu senkron çalıştırıp raporu döndürür
+func (h *SyncHandler) respondDryRun(w http.ResponseWriter, r *http.Request, providerID int64) {
+	result, err := h.syncUseCase.DryRun(r.Context(), providerID)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, result)
+}
+
+// HandleImport gövdedeki NDJSON veya CSV içerikleri provider'a aktarır ve sonucu döner
+// POST /api/v1/admin/import?provider_id=1
+// Opsiyonel: format=ndjson|csv (verilmezse Content-Type'tan belirlenir, varsayılan ndjson)
+func (h *SyncHandler) HandleImport(w http.ResponseWriter, r *http.Request) {
+	providerID, ok := parseOptionalProviderID(w, r)
+	if !ok {
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = usecase.ContentImportFormatNDJSON
+		if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType == "text/csv" {
+			format = usecase.ContentImportFormatCSV
+		}
+	}
+
+	// Büyük dosyalar sunucunun okuma/yazma süre sınırlarına takılmasın; sınırı gövde limiti belirler
+	rc := http.NewResponseController(w)
+	_ = rc.SetReadDeadline(time.Time{})
+	_ = rc.SetWriteDeadline(time.Time{})
+
+	result, err := h.syncUseCase.ImportContents(r.Context(), providerID, format, r.Body)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, result)
+}
+
+// isDryRun isteğin dry_run=true parametresi taşıyıp taşımadığını kontrol eder
+func isDryRun(r *http.Request) bool {
+	dryRun, _ := strconv.ParseBool(r.URL.Query().Get("dry_run"))
+	return dryRun
+}
+
+// SyncHistoryHandler senkronizasyon geçmişi HTTP handler'ı
+type SyncHistoryHandler struct {
+	historyUseCase *usecase.SyncHistoryUseCase
+}
+
+// NewSyncHistoryHandler yeni bir sync geçmişi handler oluşturur
+func NewSyncHistoryHandler(historyUseCase *usecase.SyncHistoryUseCase) *SyncHistoryHandler {
+	return &SyncHistoryHandler{
+		historyUseCase: historyUseCase,
+	}
+}
+
+// HandleHistory provider sync loglarını sayfalı döndürür
+// GET /api/v1/admin/sync/history?page=1&page_size=20
+// Opsiyonel: provider_id=1
+func (h *SyncHistoryHandler) HandleHistory(w http.ResponseWriter, r *http.Request) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+
+	providerID, ok := parseOptionalProviderID(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.historyUseCase.Execute(r.Context(), providerID, page, pageSize)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, result)
+}
+
+// HandleErrors son senkronizasyonlarda karantinaya alınan içerikleri sayfalı döndürür
+// GET /api/v1/admin/sync/errors?page=1&page_size=20
+// Opsiyonel: provider_id=1
+func (h *SyncHistoryHandler) HandleErrors(w http.ResponseWriter, r *http.Request) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+
+	providerID, ok := parseOptionalProviderID(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.historyUseCase.ListErrors(r.Context(), providerID, page, pageSize)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, result)
+}
+
+// parseOptionalProviderID provider_id query parametresini okur, verilmemişse 0 döner
+// Geçersizse 400 yazar ve false döner
+func parseOptionalProviderID(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	rawProviderID := r.URL.Query().Get("provider_id")
+	if rawProviderID == "" {
+		return 0, true
+	}
+	providerID, err := strconv.ParseInt(rawProviderID, 10, 64)
+	if err != nil {
+		respondUseCaseError(w, apperrors.NewValidationError("provider_id", "provider_id must be an integer", rawProviderID))
+		return 0, false
+	}
+	return providerID, true
+}
+
+// ContentAuditHandler içerik değişiklik geçmişi (admin) HTTP handler'ı
+type ContentAuditHandler struct {
+	auditUseCase *usecase.ContentAuditUseCase
+}
+
+// NewContentAuditHandler yeni bir içerik değişiklik geçmişi handler oluşturur
+func NewContentAuditHandler(auditUseCase *usecase.ContentAuditUseCase) *ContentAuditHandler {
+	return &ContentAuditHandler{
+		auditUseCase: auditUseCase,
+	}
+}
+
+// HandleAudit içeriğin değişiklik geçmişini en yeniden eskiye sayfalı döndürür
+// GET /api/v1/admin/contents/{id}/audit?page=1&page_size=20
+func (h *ContentAuditHandler) HandleAudit(w http.ResponseWriter, r *http.Request) {
+	rawID := mux.Vars(r)["id"]
+	contentID, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil || contentID < 1 {
+		respondUseCaseError(w, apperrors.NewValidationError("id", "id must be a positive integer", rawID))
+		return
+	}
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+
+	result, err := h.auditUseCase.Execute(r.Context(), contentID, page, pageSize)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, result)
+}
+
+// ModerationHandler içerik moderasyonu (admin) HTTP handler'ı
+type ModerationHandler struct {
+	moderationUseCase *usecase.ContentModerationUseCase
+}
+
+// NewModerationHandler yeni bir içerik moderasyon handler oluşturur
+func NewModerationHandler(moderationUseCase *usecase.ContentModerationUseCase) *ModerationHandler {
+	return &ModerationHandler{
+		moderationUseCase: moderationUseCase,
+	}
+}
+
+// HandleList moderasyon kuyruğundaki içerikleri en eskiden yeniye sayfalı döndürür
+// GET /api/v1/admin/moderation?page=1&page_size=20
+// Opsiyonel: status=pending|approved|rejected (varsayılan pending), provider_id=1
+func (h *ModerationHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+
+	providerID, ok := parseOptionalProviderID(w, r)
+	if !ok {
+		return
+	}
+
+	status := entity.ContentStatus(r.URL.Query().Get("status"))
+	result, err := h.moderationUseCase.List(r.Context(), status, providerID, page, pageSize)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, result)
+}
+
+// HandleReview içeriklerin moderasyon durumunu değiştirir
+// POST /api/v1/admin/moderation
+// Body: {"content_ids": [42, 7], "status": "approved"}
+func (h *ModerationHandler) HandleReview(w http.ResponseWriter, r *http.Request) {
+	var input usecase.ContentReviewInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondBodyError(w, err)
+		return
+	}
+
+	result, err := h.moderationUseCase.Review(r.Context(), input)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, result)
+}
+
+// ProviderHandler provider yönetimi (admin) HTTP handler'ı
+type ProviderHandler struct {
+	providerUseCase *usecase.ManageProvidersUseCase
+}
+
+// NewProviderHandler yeni bir provider handler oluşturur
+func NewProviderHandler(providerUseCase *usecase.ManageProvidersUseCase) *ProviderHandler {
+	return &ProviderHandler{
+		providerUseCase: providerUseCase,
+	}
+}
+
+// HandleList aktif provider'ları listeler
+// GET /api/v1/admin/providers
+func (h *ProviderHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	providers, err := h.providerUseCase.List(r.Context())
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"providers": providers})
+}
+
+// HandleCreate yeni provider kaydeder
+// POST /api/v1/admin/providers
+// Body: {"name": "...", "url": "http://...", "format": "json", "is_active": true}
+func (h *ProviderHandler) HandleCreate(w http.ResponseWriter, r *http.Request) {
+	var input usecase.ProviderInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondBodyError(w, err)
+		return
+	}
+
+	provider, err := h.providerUseCase.Create(r.Context(), input)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, provider)
+}
+
+// HandleUpdate mevcut provider'ı günceller
+// PUT /api/v1/admin/providers/{id}
+func (h *ProviderHandler) HandleUpdate(w http.ResponseWriter, r *http.Request) {
+	providerID, ok := parseProviderID(w, r)
+	if !ok {
+		return
+	}
+
+	var input usecase.ProviderInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondBodyError(w, err)
+		return
+	}
+
+	provider, err := h.providerUseCase.Update(r.Context(), providerID, input)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, provider)
+}
+
+// HandleDelete provider'ı ve içeriklerini siler
+// DELETE /api/v1/admin/providers/{id}
+func (h *ProviderHandler) HandleDelete(w http.ResponseWriter, r *http.Request) {
+	providerID, ok := parseProviderID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.providerUseCase.Delete(r.Context(), providerID); err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseProviderID path'teki provider ID'sini okur, geçersizse 400 döner
+func parseProviderID(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	rawID := mux.Vars(r)["id"]
+	providerID, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil || providerID < 1 {
+		respondUseCaseError(w, apperrors.NewValidationError("id", "id must be a positive integer", rawID))
+		return 0, false
+	}
+	return providerID, true
+}
+
+// ProviderHealthHandler provider sağlık durumu HTTP handler'ı
+type ProviderHealthHandler struct {
+	healthUseCase *usecase.ProviderHealthUseCase
+}
+
+// NewProviderHealthHandler yeni bir provider sağlık durumu handler oluşturur
+func NewProviderHealthHandler(healthUseCase *usecase.ProviderHealthUseCase) *ProviderHealthHandler {
+	return &ProviderHealthHandler{
+		healthUseCase: healthUseCase,
+	}
+}
+
+// HandleProviderHealth provider'ın son sağlık kontrolü sonucunu döner
+// GET /api/v1/admin/providers/{id}/health
+// Opsiyonel: refresh=true (sonucu beklemeden provider'ı hemen probe eder)
+func (h *ProviderHealthHandler) HandleProviderHealth(w http.ResponseWriter, r *http.Request) {
+	providerID, ok := parseProviderID(w, r)
+	if !ok {
+		return
+	}
+
+	var health *entity.ProviderHealth
+	var err error
+	if refresh, _ := strconv.ParseBool(r.URL.Query().Get("refresh")); refresh {
+		health, err = h.healthUseCase.Check(r.Context(), providerID)
+	} else {
+		health, err = h.healthUseCase.Get(r.Context(), providerID)
+	}
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, health)
+}
+
+// ScoringHandler skorlama kuralları yönetimi (admin) HTTP handler'ı
+type ScoringHandler struct {
+	scoringUseCase *usecase.ManageScoringRulesUseCase
+	recalcUseCase  *usecase.RecalculateScoresUseCase // nil ise elle yeniden hesaplama 404 döner
+}
+
+// NewScoringHandler yeni bir skorlama kuralları handler oluşturur
+func NewScoringHandler(scoringUseCase *usecase.ManageScoringRulesUseCase) *ScoringHandler {
+	return &ScoringHandler{
+		scoringUseCase: scoringUseCase,
+	}
+}
+
+// SetRecalculator elle başlatılan skor yeniden hesaplamasını çalıştıracak use case'i ayarlar
+func (h *ScoringHandler) SetRecalculator(recalcUseCase *usecase.RecalculateScoresUseCase) {
+	h.recalcUseCase = recalcUseCase
+}
+
+// HandleRecalculate tüm içeriklerin skorlarını arka planda yeniden hesaplatır
+// POST /api/v1/admin/scoring/recalculate
+// Önceki elle başlatılmış hesaplama sürüyorsa yenisi başlatılmaz, yine 202 döner
+func (h *ScoringHandler) HandleRecalculate(w http.ResponseWriter, r *http.Request) {
+	if h.recalcUseCase == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	message := "Skor yeniden hesaplama başlatıldı"
+	if !h.recalcUseCase.ExecuteAsync() {
+		message = "Skor yeniden hesaplama zaten çalışıyor"
+	}
+
+	respondJSON(w, http.StatusAccepted, map[string]string{
+		"message": message,
+		"status":  "running",
+	})
+}
+
+// HandleGet kullanılan skorlama kurallarını döner
+// GET /api/v1/admin/scoring
+func (h *ScoringHandler) HandleGet(w http.ResponseWriter, r *http.Request) {
+	rules, err := h.scoringUseCase.Get(r.Context())
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, rules)
+}
+
+// HandleUpdate skorlama kurallarını değiştirir
+// PUT /api/v1/admin/scoring
+// Body: {"video_type_weight": 1.5, "article_type_weight": 1.0, "recency_tiers": [{"max_age_days": 7, "score": 5}], ...}
+func (h *ScoringHandler) HandleUpdate(w http.ResponseWriter, r *http.Request) {
+	var input entity.ScoringRules
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondBodyError(w, err)
+		return
+	}
+
+	rules, err := h.scoringUseCase.Update(r.Context(), input)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, rules)
+}
+
+// BoostHandler tag boost kuralları yönetimi (admin) HTTP handler'ı
+type BoostHandler struct {
+	boostUseCase *usecase.ManageBoostRulesUseCase
+}
+
+// NewBoostHandler yeni bir boost kuralları handler oluşturur
+func NewBoostHandler(boostUseCase *usecase.ManageBoostRulesUseCase) *BoostHandler {
+	return &BoostHandler{
+		boostUseCase: boostUseCase,
+	}
+}
+
+// HandleList boost kurallarını listeler
+// GET /api/v1/admin/boosts
+func (h *BoostHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	rules, err := h.boostUseCase.List(r.Context())
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"boosts": rules})
+}
+
+// HandleSet tag için boost kuralını oluşturur veya günceller
+// PUT /api/v1/admin/boosts/{tag}
+// Body: {"percent": 20}
+func (h *BoostHandler) HandleSet(w http.ResponseWriter, r *http.Request) {
+	var input struct {
+		Percent *float64 `json:"percent"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondBodyError(w, err)
+		return
+	}
+	if input.Percent == nil {
+		respondUseCaseError(w, apperrors.NewValidationError("percent", "percent is required", nil))
+		return
+	}
+
+	rule, err := h.boostUseCase.Set(r.Context(), mux.Vars(r)["tag"], *input.Percent)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, rule)
+}
+
+// HandleDelete tag'in boost kuralını siler
+// DELETE /api/v1/admin/boosts/{tag}
+func (h *BoostHandler) HandleDelete(w http.ResponseWriter, r *http.Request) {
+	if err := h.boostUseCase.Delete(r.Context(), mux.Vars(r)["tag"]); err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// APIKeyHandler public API anahtarları yönetimi (admin) HTTP handler'ı
+type APIKeyHandler struct {
+	apiKeyUseCase *usecase.ManageAPIKeysUseCase
+}
+
+// NewAPIKeyHandler yeni bir API anahtarı handler oluşturur
+func NewAPIKeyHandler(apiKeyUseCase *usecase.ManageAPIKeysUseCase) *APIKeyHandler {
+	return &APIKeyHandler{
+		apiKeyUseCase: apiKeyUseCase,
+	}
+}
+
+// HandleList API anahtarlarını listeler (anahtarların kendisi dönmez)
+// GET /api/v1/admin/api-keys
+func (h *APIKeyHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	keys, err := h.apiKeyUseCase.List(r.Context())
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"api_keys": keys})
+}
+
+// HandleCreate yeni bir API anahtarı oluşturur; anahtar sadece bu yanıtta döner
+// POST /api/v1/admin/api-keys
+// Body: {"name": "partner-a", "rate_limit_per_minute": 600}
+func (h *APIKeyHandler) HandleCreate(w http.ResponseWriter, r *http.Request) {
+	var input usecase.APIKeyInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondBodyError(w, err)
+		return
+	}
+
+	key, err := h.apiKeyUseCase.Create(r.Context(), input)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, key)
+}
+
+// HandleRevoke API anahtarını iptal eder
+// DELETE /api/v1/admin/api-keys/{id}
+func (h *APIKeyHandler) HandleRevoke(w http.ResponseWriter, r *http.Request) {
+	rawID := mux.Vars(r)["id"]
+	id, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil || id < 1 {
+		respondUseCaseError(w, apperrors.NewValidationError("id", "id must be a positive integer", rawID))
+		return
+	}
+
+	key, err := h.apiKeyUseCase.Revoke(r.Context(), id)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, key)
+}
+
+// WebhookHandler webhook abonelikleri yönetimi (admin) HTTP handler'ı
+type WebhookHandler struct {
+	webhookUseCase *usecase.ManageWebhooksUseCase
+}
+
+// NewWebhookHandler yeni bir webhook handler oluşturur
+func NewWebhookHandler(webhookUseCase *usecase.ManageWebhooksUseCase) *WebhookHandler {
+	return &WebhookHandler{
+		webhookUseCase: webhookUseCase,
+	}
+}
+
+// HandleList webhook aboneliklerini listeler (secret'lar dönmez)
+// GET /api/v1/admin/webhooks
+func (h *WebhookHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	subs, err := h.webhookUseCase.List(r.Context())
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"webhooks": subs})
+}
+
+// HandleCreate yeni bir webhook aboneliği oluşturur; imza secret'ı sadece bu yanıtta döner
+// POST /api/v1/admin/webhooks
+// Body: {"name": "partner-a", "url": "https://partner.example.com/hooks", "query": "golang", "tags": ["tutorial"]}
+func (h *WebhookHandler) HandleCreate(w http.ResponseWriter, r *http.Request) {
+	var input usecase.WebhookInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondBodyError(w, err)
+		return
+	}
+
+	sub, err := h.webhookUseCase.Create(r.Context(), input)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, sub)
+}
+
+// HandleUpdate aboneliğin URL, filtre ve aktiflik alanlarını günceller
+// PUT /api/v1/admin/webhooks/{id}
+func (h *WebhookHandler) HandleUpdate(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseWebhookID(w, r)
+	if !ok {
+		return
+	}
+
+	var input usecase.WebhookInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondBodyError(w, err)
+		return
 	}
 
-	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
-	if page < 1 {
-		page = 1
+	sub, err := h.webhookUseCase.Update(r.Context(), id, input)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
 	}
 
-	pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
-	if pageSize < 1 {
-		pageSize = 20
+	respondJSON(w, http.StatusOK, sub)
+}
+
+// HandleDelete aboneliği ve bekleyen gönderimlerini siler
+// DELETE /api/v1/admin/webhooks/{id}
+func (h *WebhookHandler) HandleDelete(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseWebhookID(w, r)
+	if !ok {
+		return
 	}
 
-	// 2. Search params oluştur
-	params := port.SearchParams{
-		Query:       query,
-		ContentType: entity.ContentType(contentType),
-		SortBy:      sortBy,
-		Page:        page,
-		PageSize:    pageSize,
+	if err := h.webhookUseCase.Delete(r.Context(), id); err != nil {
+		respondUseCaseError(w, err)
+		return
 	}
 
-	// 3. Use case'i çalıştır
-	result, err := h.searchUseCase.Execute(r.Context(), params)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleDeliveries aboneliğin son gönderimlerini ve deneme durumlarını döner
+// GET /api/v1/admin/webhooks/{id}/deliveries?limit=50
+func (h *WebhookHandler) HandleDeliveries(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseWebhookID(w, r)
+	if !ok {
+		return
+	}
+
+	limit := 0
+	if rawLimit := r.URL.Query().Get("limit"); rawLimit != "" {
+		var err error
+		if limit, err = strconv.Atoi(rawLimit); err != nil {
+			respondUseCaseError(w, apperrors.NewValidationError("limit", "limit must be an integer", rawLimit))
+			return
+		}
+	}
+
+	deliveries, err := h.webhookUseCase.Deliveries(r.Context(), id, limit)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"deliveries": deliveries})
+}
+
+// parseWebhookID path'teki abonelik ID'sini okur, geçersizse 400 döner
+func parseWebhookID(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	rawID := mux.Vars(r)["id"]
+	id, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil || id < 1 {
+		respondUseCaseError(w, apperrors.NewValidationError("id", "id must be a positive integer", rawID))
+		return 0, false
+	}
+	return id, true
+}
+
+// SavedSearchHandler API anahtarı sahiplerinin kayıtlı aramaları için HTTP handler'ı
+// Anahtar rate limiter middleware'inin çözdüğü X-API-Key'den okunur
+type SavedSearchHandler struct {
+	savedSearchUseCase *usecase.ManageSavedSearchesUseCase
+}
+
+// NewSavedSearchHandler yeni bir kayıtlı arama handler oluşturur
+func NewSavedSearchHandler(savedSearchUseCase *usecase.ManageSavedSearchesUseCase) *SavedSearchHandler {
+	return &SavedSearchHandler{
+		savedSearchUseCase: savedSearchUseCase,
+	}
+}
+
+// HandleList anahtarın kayıtlı aramalarını listeler
+// GET /api/v1/saved-searches
+func (h *SavedSearchHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	searches, err := h.savedSearchUseCase.List(r.Context(), middleware.GetAPIKeyID(r.Context()))
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"saved_searches": searches})
+}
+
+// HandleCreate yeni bir kayıtlı arama oluşturur; notify_url verildiyse imza secret'ı sadece bu yanıtta döner
+// POST /api/v1/saved-searches
+// Body: {"name": "go videoları", "query": "golang", "type": "video", "notify_email": "me@example.com"}
+func (h *SavedSearchHandler) HandleCreate(w http.ResponseWriter, r *http.Request) {
+	var input usecase.SavedSearchInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondBodyError(w, err)
+		return
+	}
+
+	search, err := h.savedSearchUseCase.Create(r.Context(), middleware.GetAPIKeyID(r.Context()), input)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, search)
+}
+
+// HandleDelete anahtarın kayıtlı aramasını siler
+// DELETE /api/v1/saved-searches/{id}
+func (h *SavedSearchHandler) HandleDelete(w http.ResponseWriter, r *http.Request) {
+	rawID := mux.Vars(r)["id"]
+	id, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil || id < 1 {
+		respondUseCaseError(w, apperrors.NewValidationError("id", "id must be a positive integer", rawID))
+		return
+	}
+
+	if err := h.savedSearchUseCase.Delete(r.Context(), middleware.GetAPIKeyID(r.Context()), id); err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SearchEventsHandler arama sonucu gösterim/tıklama olayları ve CTR raporu HTTP handler'ı
+type SearchEventsHandler struct {
+	eventsUseCase *usecase.SearchEventsUseCase
+}
+
+// NewSearchEventsHandler yeni bir arama olayı handler oluşturur
+func NewSearchEventsHandler(eventsUseCase *usecase.SearchEventsUseCase) *SearchEventsHandler {
+	return &SearchEventsHandler{
+		eventsUseCase: eventsUseCase,
+	}
+}
+
+// HandleRecord gösterim ve tıklama olaylarını kaydeder
+// POST /api/v1/events
+// Body: {"events": [{"type": "click", "content_id": 42, "query": "golang", "position": 3, "session_id": "abc"}]}
+func (h *SearchEventsHandler) HandleRecord(w http.ResponseWriter, r *http.Request) {
+	var input usecase.RecordEventsInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondBodyError(w, err)
+		return
+	}
+	if input.UserAgent == "" {
+		input.UserAgent = r.UserAgent()
+	}
+
+	result, err := h.eventsUseCase.Record(r.Context(), middleware.GetAPIKeyID(r.Context()), input)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+	metrics.RecordSearchEvents("accepted", result.Accepted)
+	metrics.RecordSearchEvents("sampled_out", result.SampledOut)
+	metrics.RecordSearchEvents("ignored", result.Ignored)
+
+	respondJSON(w, http.StatusAccepted, result)
+}
+
+// HandleCTR en çok gösterilen içeriklerin tıklanma oranlarını döner
+// GET /api/v1/admin/analytics/ctr?query=golang&days=7&limit=50
+func (h *SearchEventsHandler) HandleCTR(w http.ResponseWriter, r *http.Request) {
+	days, _ := strconv.Atoi(r.URL.Query().Get("days"))
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	result, err := h.eventsUseCase.ContentCTR(r.Context(), r.URL.Query().Get("query"), days, limit)
 	if err != nil {
-		respondError(w, http.StatusInternalServerError, err.Error())
+		respondUseCaseError(w, err)
 		return
 	}
 
-	// 4. Başarılı response döndür
 	respondJSON(w, http.StatusOK, result)
 }
 
-// SyncHandler senkronizasyon HTTP handler'ı
-type SyncHandler struct {
-	syncUseCase *usecase.SyncProviderContentsUseCase
+// ZeroResultQueriesHandler sonuçsuz arama sorguları raporu (admin) HTTP handler'ı
+type ZeroResultQueriesHandler struct {
+	zeroResultUseCase *usecase.ZeroResultQueriesUseCase
 }
 
-// NewSyncHandler yeni bir sync handler oluşturur
-func NewSyncHandler(syncUseCase *usecase.SyncProviderContentsUseCase) *SyncHandler {
-	return &SyncHandler{
-		syncUseCase: syncUseCase,
+// NewZeroResultQueriesHandler yeni bir sonuçsuz sorgu raporu handler oluşturur
+func NewZeroResultQueriesHandler(zeroResultUseCase *usecase.ZeroResultQueriesUseCase) *ZeroResultQueriesHandler {
+	return &ZeroResultQueriesHandler{
+		zeroResultUseCase: zeroResultUseCase,
 	}
 }
 
-// HandleSync senkronizasyon isteğini işler
-// POST /api/v1/admin/sync
-func (h *SyncHandler) HandleSync(w http.ResponseWriter, r *http.Request) {
-	// Arka planda senkronizasyonu başlat
-	h.syncUseCase.ExecuteAsync()
+// HandleList en çok sonuçsuz aranan sorguları sayı ve son görülme zamanıyla döner
+// GET /api/v1/admin/analytics/zero-results?days=7&limit=50
+func (h *ZeroResultQueriesHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	days, _ := strconv.Atoi(r.URL.Query().Get("days"))
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
 
-	// Hemen response döndür
-	respondJSON(w, http.StatusAccepted, map[string]string{
-		"message": "Senkronizasyon başlatıldı",
-		"status":  "running",
+	result, err := h.zeroResultUseCase.Report(r.Context(), days, limit)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, result)
+}
+
+// CacheHandler cache yönetimi (admin) HTTP handler'ı
+type CacheHandler struct {
+	invalidateUseCase *usecase.InvalidateCacheUseCase
+}
+
+// NewCacheHandler yeni bir cache yönetimi handler oluşturur
+func NewCacheHandler(invalidateUseCase *usecase.InvalidateCacheUseCase) *CacheHandler {
+	return &CacheHandler{
+		invalidateUseCase: invalidateUseCase,
+	}
+}
+
+// HandleInvalidate arama ve benzer içerik cache'ini temizler
+// POST /api/v1/admin/cache/invalidate
+func (h *CacheHandler) HandleInvalidate(w http.ResponseWriter, r *http.Request) {
+	if err := h.invalidateUseCase.Execute(r.Context()); err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]string{"message": "Cache temizlendi"})
+}
+
+// FeatureFlagHandler feature flag yönetimi (admin) HTTP handler'ı
+type FeatureFlagHandler struct {
+	flagsUseCase *usecase.ManageFeatureFlagsUseCase
+}
+
+// NewFeatureFlagHandler yeni bir feature flag handler oluşturur
+func NewFeatureFlagHandler(flagsUseCase *usecase.ManageFeatureFlagsUseCase) *FeatureFlagHandler {
+	return &FeatureFlagHandler{
+		flagsUseCase: flagsUseCase,
+	}
+}
+
+// HandleList feature flag'leri varsayılan ve geçerli değerleriyle listeler
+// GET /api/v1/admin/flags
+func (h *FeatureFlagHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	flags, err := h.flagsUseCase.List(r.Context())
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"flags": flags})
+}
+
+// HandleSet bayrağı açar veya kapatır
+// PUT /api/v1/admin/flags/{name}
+// Body: {"enabled": false}
+func (h *FeatureFlagHandler) HandleSet(w http.ResponseWriter, r *http.Request) {
+	var input struct {
+		Enabled *bool `json:"enabled"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondBodyError(w, err)
+		return
+	}
+	if input.Enabled == nil {
+		respondUseCaseError(w, apperrors.NewValidationError("enabled", "enabled is required", nil))
+		return
+	}
+
+	flag, err := h.flagsUseCase.Set(r.Context(), mux.Vars(r)["name"], *input.Enabled)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, flag)
+}
+
+// HandleReset bayrağın override'ını kaldırıp config'teki varsayılanına döndürür
+// DELETE /api/v1/admin/flags/{name}
+func (h *FeatureFlagHandler) HandleReset(w http.ResponseWriter, r *http.Request) {
+	flag, err := h.flagsUseCase.Reset(r.Context(), mux.Vars(r)["name"])
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, flag)
+}
+
+// AdminStatsHandler yönetim paneli özeti (admin) HTTP handler'ı
+type AdminStatsHandler struct {
+	statsUseCase *usecase.AdminStatsUseCase
+}
+
+// NewAdminStatsHandler yeni bir yönetim paneli özeti handler oluşturur
+func NewAdminStatsHandler(statsUseCase *usecase.AdminStatsUseCase) *AdminStatsHandler {
+	return &AdminStatsHandler{
+		statsUseCase: statsUseCase,
+	}
+}
+
+// HandleStats içerik sayıları, son senkronizasyon süreleri, cache isabet oranı ve indeks güncelliğini döner
+// GET /api/v1/admin/stats
+func (h *AdminStatsHandler) HandleStats(w http.ResponseWriter, r *http.Request) {
+	stats, err := h.statsUseCase.Execute(r.Context())
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, stats)
+}
+
+// SnapshotHandler içerik yedeği alma ve geri yükleme (admin) HTTP handler'ı
+type SnapshotHandler struct {
+	snapshotUseCase *usecase.SnapshotUseCase
+}
+
+// NewSnapshotHandler yeni bir yedek handler oluşturur
+func NewSnapshotHandler(snapshotUseCase *usecase.SnapshotUseCase) *SnapshotHandler {
+	return &SnapshotHandler{
+		snapshotUseCase: snapshotUseCase,
+	}
+}
+
+// HandleList depolama alanındaki yedekleri ve son çalışmayı döner
+// GET /api/v1/admin/snapshots
+func (h *SnapshotHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	snapshots, err := h.snapshotUseCase.List(r.Context())
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{
+		"snapshots": snapshots,
+		"last_run":  h.snapshotUseCase.LastRun(),
 	})
 }
 
+// HandleCreate yedek almayı arka planda başlatır
+// POST /api/v1/admin/snapshots
+func (h *SnapshotHandler) HandleCreate(w http.ResponseWriter, r *http.Request) {
+	run, err := h.snapshotUseCase.StartExport()
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusAccepted, run)
+}
+
+// HandleRestore yedeğin geri yüklenmesini arka planda başlatır
+// POST /api/v1/admin/snapshots/{name}/restore
+func (h *SnapshotHandler) HandleRestore(w http.ResponseWriter, r *http.Request) {
+	run, err := h.snapshotUseCase.StartImport(r.Context(), mux.Vars(r)["name"])
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusAccepted, run)
+}
+
+// PromotionHandler arama sorgusu sabitlemeleri yönetimi (admin) HTTP handler'ı
+type PromotionHandler struct {
+	promotionUseCase *usecase.ManagePromotionsUseCase
+}
+
+// NewPromotionHandler yeni bir sabitleme handler oluşturur
+func NewPromotionHandler(promotionUseCase *usecase.ManagePromotionsUseCase) *PromotionHandler {
+	return &PromotionHandler{
+		promotionUseCase: promotionUseCase,
+	}
+}
+
+// HandleList sabitlemeleri listeler
+// GET /api/v1/admin/promotions
+func (h *PromotionHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	promotions, err := h.promotionUseCase.List(r.Context())
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, map[string]interface{}{"promotions": promotions})
+}
+
+// HandleSave sorgu için sabitlemeyi oluşturur veya içerik listesini değiştirir
+// PUT /api/v1/admin/promotions
+// Body: {"query": "golang", "content_ids": [42, 7]}
+func (h *PromotionHandler) HandleSave(w http.ResponseWriter, r *http.Request) {
+	var input usecase.PromotionInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		respondBodyError(w, err)
+		return
+	}
+
+	promotion, err := h.promotionUseCase.Save(r.Context(), input)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, promotion)
+}
+
+// HandleDelete sabitlemeyi siler
+// DELETE /api/v1/admin/promotions/{id}
+func (h *PromotionHandler) HandleDelete(w http.ResponseWriter, r *http.Request) {
+	rawID := mux.Vars(r)["id"]
+	promotionID, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil || promotionID < 1 {
+		respondUseCaseError(w, apperrors.NewValidationError("id", "id must be a positive integer", rawID))
+		return
+	}
+
+	if err := h.promotionUseCase.Delete(r.Context(), promotionID); err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 // HealthHandler health check HTTP handler'ı
 type HealthHandler struct {
-	db    *sql.DB
-	redis *redis.Client
+	db             *sql.DB
+	redis          *redis.Client
+	providerHealth *usecase.ProviderHealthUseCase       // nil ise provider durumları yanıta eklenmez
+	syncUseCase    *usecase.SyncProviderContentsUseCase // nil ise readiness ilk senkronizasyonu beklemez
 }
 
+// readinessCheckTimeout readiness kontrolündeki her bağımlılık ping'inin azami süresi
+const readinessCheckTimeout = 2 * time.Second
+
 // NewHealthHandler yeni bir health handler oluşturur
 func NewHealthHandler(db *sql.DB, redis *redis.Client) *HealthHandler {
 	return &HealthHandler{
@@ -108,11 +1421,81 @@ func NewHealthHandler(db *sql.DB, redis *redis.Client) *HealthHandler {
 	}
 }
 
+// SetProviderHealth provider sağlık durumlarının yanıta eklenmesi için use case'i ayarlar
+func (h *HealthHandler) SetProviderHealth(providerHealth *usecase.ProviderHealthUseCase) {
+	h.providerHealth = providerHealth
+}
+
+// SetSyncUseCase readiness kontrolünün ilk senkronizasyon denemesini beklemesi için use case'i ayarlar
+func (h *HealthHandler) SetSyncUseCase(syncUseCase *usecase.SyncProviderContentsUseCase) {
+	h.syncUseCase = syncUseCase
+}
+
+// HandleLiveness process'in ayakta olduğunu bildirir (liveness probe)
+// GET /healthz
+// Bağımlılıklar kontrol edilmez: Redis/DB kesintisi pod'un yeniden başlatılmasına yol açmamalı
+func (h *HealthHandler) HandleLiveness(w http.ResponseWriter, r *http.Request) {
+	respondJSON(w, http.StatusOK, map[string]string{
+		"status":    "alive",
+		"timestamp": time.Now().Format(time.RFC3339),
+	})
+}
+
+// HandleReadiness instance'ın trafik almaya hazır olup olmadığını bildirir (readiness probe)
+// GET /readyz
+// DB ve Redis erişilebilir olmalı ve ilk senkronizasyon denenmiş olmalı; aksi halde 503 döner
+func (h *HealthHandler) HandleReadiness(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), readinessCheckTimeout)
+	defer cancel()
+
+	ready := true
+	checks := make(map[string]string)
+
+	if h.db != nil {
+		if err := h.db.PingContext(ctx); err != nil {
+			checks["database"] = "unavailable"
+			ready = false
+		} else {
+			checks["database"] = "ok"
+		}
+	}
+
+	if h.redis != nil {
+		if err := h.redis.Ping(ctx).Err(); err != nil {
+			checks["redis"] = "unavailable"
+			ready = false
+		} else {
+			checks["redis"] = "ok"
+		}
+	}
+
+	if h.syncUseCase != nil {
+		if h.syncUseCase.InitialSyncAttempted() {
+			checks["initial_sync"] = "done"
+		} else {
+			checks["initial_sync"] = "pending"
+			ready = false
+		}
+	}
+
+	status, statusCode := "ready", http.StatusOK
+	if !ready {
+		status, statusCode = "not_ready", http.StatusServiceUnavailable
+	}
+
+	respondJSON(w, statusCode, map[string]interface{}{
+		"status":    status,
+		"timestamp": time.Now().Format(time.RFC3339),
+		"checks":    checks,
+	})
+}
+
 // HandleHealth health check isteğini işler
 // GET /api/v1/health
+// Provider arızaları ve eski veri genel durumu etkilemez: arama mevcut veriyle çalışmaya devam eder
 func (h *HealthHandler) HandleHealth(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	
+
 	health := map[string]interface{}{
 		"status":    "healthy",
 		"timestamp": time.Now().Format(time.RFC3339),
@@ -142,6 +1525,17 @@ func (h *HealthHandler) HandleHealth(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// Provider durumları son arka plan kontrolünden okunur, istek sırasında probe yapılmaz
+	// Güncellik sync loglarından hesaplanır; eski veriye sahip provider'lar "degraded" işaretlenir
+	if h.providerHealth != nil {
+		if providers, err := h.providerHealth.List(ctx); err == nil {
+			health["providers"] = providers
+		}
+		if freshness, err := h.providerHealth.Freshness(ctx); err == nil {
+			health["provider_freshness"] = freshness
+		}
+	}
+
 	statusCode := http.StatusOK
 	if health["status"] == "degraded" {
 		statusCode = http.StatusServiceUnavailable
@@ -157,9 +1551,48 @@ func respondJSON(w http.ResponseWriter, status int, data interface{}) {
 	json.NewEncoder(w).Encode(data)
 }
 
-// respondError hata response döndürür
-func respondError(w http.ResponseWriter, status int, message string) {
-	respondJSON(w, status, map[string]string{
-		"error": message,
-	})
+// respondError verilen kod ve mesajla hata zarfı döndürür
+func respondError(w http.ResponseWriter, status int, code, message string) {
+	apierror.Write(w, apierror.New(status, code, message))
+}
+
+// respondBodyError istek gövdesi okuma/parse hatasını HTTP response'a çevirir
+// Gövde limiti (middleware.BodyLimiter) okurken aşıldıysa 413, diğer hatalarda 400 döner
+func respondBodyError(w http.ResponseWriter, err error) {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		apierror.Write(w, apierror.PayloadTooLarge(maxBytesErr.Limit))
+		return
+	}
+
+	respondError(w, http.StatusBadRequest, apierror.CodeInvalidBody, "Geçersiz istek gövdesi")
+}
+
+// respondUseCaseError hatayı apierror eşlemesiyle kod, HTTP durumu ve güvenli mesaja çevirir
+// Validation hataları alan bilgisiyle 400, bilinen sentinel hatalar kendi kodlarıyla döner;
+// tanınmayan hatalar iç detay sızdırılmadan 500 internal_error olarak yazılır
+func respondUseCaseError(w http.ResponseWriter, err error) {
+	apierror.Write(w, apierror.FromError(err))
+}
+
+// parseDateParam query parametresindeki tarihi parse eder (RFC3339 veya YYYY-MM-DD)
+// endOfDay true ise sadece tarih verildiğinde günün sonu kullanılır
+func parseDateParam(r *http.Request, name string, endOfDay bool) (*time.Time, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t, nil
+	}
+
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, apperrors.NewValidationError(name, "invalid date (expected RFC3339 or YYYY-MM-DD)", value)
+	}
+	if endOfDay {
+		t = t.Add(24*time.Hour - time.Nanosecond)
+	}
+	return &t, nil
 }
@@ -1,11 +1,15 @@
 package http
 
 import (
+	"bufio"
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -17,12 +21,19 @@ import (
 	"github.com/onurerdog4n/search-engine/internal/domain/entity"
 	"github.com/onurerdog4n/search-engine/internal/domain/port"
 	"github.com/onurerdog4n/search-engine/internal/domain/service"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/eventbus"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/flags"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/snapshot"
+	"github.com/onurerdog4n/search-engine/internal/transport/apierror"
 	"github.com/onurerdog4n/search-engine/internal/transport/middleware"
 )
 
 // Mock repository for testing
 type mockContentRepository struct {
-	searchFunc func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error)
+	searchFunc  func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error)
+	facetsFunc  func(ctx context.Context, params port.SearchParams) (*entity.SearchFacets, error)
+	similarFunc func(ctx context.Context, contentID int64, limit int) ([]*entity.Content, error)
+	findByID    func(ctx context.Context, id int64) (*entity.Content, error)
 }
 
 func (m *mockContentRepository) Search(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
@@ -32,10 +43,27 @@ func (m *mockContentRepository) Search(ctx context.Context, params port.SearchPa
 	return nil, 0, nil
 }
 
-func (m *mockContentRepository) FindByID(ctx context.Context, id int64) (*entity.Content, error) {
+func (m *mockContentRepository) GetFacets(ctx context.Context, params port.SearchParams) (*entity.SearchFacets, error) {
+	if m.facetsFunc != nil {
+		return m.facetsFunc(ctx, params)
+	}
+	return &entity.SearchFacets{}, nil
+}
+
+func (m *mockContentRepository) FindSimilar(ctx context.Context, contentID int64, limit int) ([]*entity.Content, error) {
+	if m.similarFunc != nil {
+		return m.similarFunc(ctx, contentID, limit)
+	}
 	return nil, nil
 }
 
+func (m *mockContentRepository) FindByID(ctx context.Context, id int64) (*entity.Content, error) {
+	if m.findByID != nil {
+		return m.findByID(ctx, id)
+	}
+	return nil, port.ErrContentNotFound
+}
+
 func (m *mockContentRepository) Create(ctx context.Context, content *entity.Content) error {
 	return nil
 }
@@ -64,6 +92,38 @@ func (m *mockContentRepository) MarkStaleContentsAsDeleted(ctx context.Context,
 	return nil
 }
 
+func (m *mockContentRepository) MarkContentDeleted(ctx context.Context, providerID int64, providerContentID string) error {
+	return nil
+}
+
+func (m *mockContentRepository) BulkUpsert(ctx context.Context, contents []*entity.Content) error {
+	return nil
+}
+
+func (m *mockContentRepository) BulkCreateOrUpdateStats(ctx context.Context, stats []*entity.ContentStats) error {
+	return nil
+}
+
+func (m *mockContentRepository) BulkCreateOrUpdateScores(ctx context.Context, scores []*entity.ContentScore) error {
+	return nil
+}
+
+func (m *mockContentRepository) FindProviderContentIDs(ctx context.Context, providerID int64) (map[string]bool, error) {
+	return map[string]bool{}, nil
+}
+
+func (m *mockContentRepository) FindDuplicateCandidates(ctx context.Context, providerID int64, since time.Time, window time.Duration) ([]*entity.DuplicateCandidate, error) {
+	return nil, nil
+}
+
+func (m *mockContentRepository) LinkDuplicates(ctx context.Context, links map[int64]int64) error {
+	return nil
+}
+
+func (m *mockContentRepository) FindContentsForScoring(ctx context.Context, afterID int64, limit int) ([]*entity.Content, error) {
+	return nil, nil
+}
+
 // Mock cache for testing
 type mockCache struct {
 	getFunc func(ctx context.Context, key string) ([]byte, error)
@@ -92,6 +152,354 @@ func (m *mockCache) InvalidatePattern(ctx context.Context, pattern string) error
 	return nil
 }
 
+func (m *mockCache) Clear(ctx context.Context) error {
+	return nil
+}
+
+// Mock provider repository for testing
+type mockProviderRepository struct {
+	port.ProviderRepository
+	createFunc         func(ctx context.Context, provider *entity.Provider) error
+	updateFunc         func(ctx context.Context, provider *entity.Provider) error
+	deleteFunc         func(ctx context.Context, id int64) error
+	listSyncLogsFunc   func(ctx context.Context, providerID int64, limit, offset int) ([]*entity.ProviderSyncLog, int64, error)
+	listSyncErrorsFunc func(ctx context.Context, providerID int64, limit, offset int) ([]*entity.ProviderSyncError, int64, error)
+	findByIDFunc       func(ctx context.Context, id int64) (*entity.Provider, error)
+	findHealthFunc     func(ctx context.Context, providerID int64) (*entity.ProviderHealth, error)
+	listHealthFunc     func(ctx context.Context) ([]*entity.ProviderHealth, error)
+	listFreshnessFunc  func(ctx context.Context) ([]*entity.ProviderFreshness, error)
+	findAllFunc        func(ctx context.Context) ([]*entity.Provider, error)
+}
+
+func (m *mockProviderRepository) FindAll(ctx context.Context) ([]*entity.Provider, error) {
+	if m.findAllFunc != nil {
+		return m.findAllFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *mockProviderRepository) Create(ctx context.Context, provider *entity.Provider) error {
+	if m.createFunc != nil {
+		return m.createFunc(ctx, provider)
+	}
+	return nil
+}
+
+func (m *mockProviderRepository) Update(ctx context.Context, provider *entity.Provider) error {
+	if m.updateFunc != nil {
+		return m.updateFunc(ctx, provider)
+	}
+	return nil
+}
+
+func (m *mockProviderRepository) Delete(ctx context.Context, id int64) error {
+	if m.deleteFunc != nil {
+		return m.deleteFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *mockProviderRepository) ListSyncLogs(ctx context.Context, providerID int64, limit, offset int) ([]*entity.ProviderSyncLog, int64, error) {
+	if m.listSyncLogsFunc != nil {
+		return m.listSyncLogsFunc(ctx, providerID, limit, offset)
+	}
+	return nil, 0, nil
+}
+
+func (m *mockProviderRepository) ListSyncErrors(ctx context.Context, providerID int64, limit, offset int) ([]*entity.ProviderSyncError, int64, error) {
+	if m.listSyncErrorsFunc != nil {
+		return m.listSyncErrorsFunc(ctx, providerID, limit, offset)
+	}
+	return nil, 0, nil
+}
+
+func (m *mockProviderRepository) FindByID(ctx context.Context, id int64) (*entity.Provider, error) {
+	if m.findByIDFunc != nil {
+		return m.findByIDFunc(ctx, id)
+	}
+	return nil, port.ErrProviderNotFound
+}
+
+func (m *mockProviderRepository) FindHealth(ctx context.Context, providerID int64) (*entity.ProviderHealth, error) {
+	if m.findHealthFunc != nil {
+		return m.findHealthFunc(ctx, providerID)
+	}
+	return nil, nil
+}
+
+func (m *mockProviderRepository) ListHealth(ctx context.Context) ([]*entity.ProviderHealth, error) {
+	if m.listHealthFunc != nil {
+		return m.listHealthFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *mockProviderRepository) ListSyncFreshness(ctx context.Context) ([]*entity.ProviderFreshness, error) {
+	if m.listFreshnessFunc != nil {
+		return m.listFreshnessFunc(ctx)
+	}
+	return nil, nil
+}
+
+// Mock scoring rules repository for testing
+type mockScoringRulesRepository struct {
+	rules *entity.ScoringRules
+}
+
+func (m *mockScoringRulesRepository) GetScoringRules(ctx context.Context) (*entity.ScoringRules, error) {
+	return m.rules, nil
+}
+
+func (m *mockScoringRulesRepository) SaveScoringRules(ctx context.Context, rules *entity.ScoringRules) error {
+	m.rules = rules
+	return nil
+}
+
+type mockBoostRuleRepository struct {
+	rules []*entity.BoostRule
+}
+
+func (m *mockBoostRuleRepository) ListBoostRules(ctx context.Context) ([]*entity.BoostRule, error) {
+	return m.rules, nil
+}
+
+func (m *mockBoostRuleRepository) SaveBoostRule(ctx context.Context, rule *entity.BoostRule) error {
+	rule.ID = int64(len(m.rules) + 1)
+	m.rules = append(m.rules, rule)
+	return nil
+}
+
+func (m *mockBoostRuleRepository) DeleteBoostRule(ctx context.Context, tag string) error {
+	for i, rule := range m.rules {
+		if rule.Tag == tag {
+			m.rules = append(m.rules[:i], m.rules[i+1:]...)
+			return nil
+		}
+	}
+	return port.ErrBoostRuleNotFound
+}
+
+type mockAPIKeyRepository struct {
+	keys []*entity.APIKey
+}
+
+func (m *mockAPIKeyRepository) CreateAPIKey(ctx context.Context, key *entity.APIKey) error {
+	key.ID = int64(len(m.keys) + 1)
+	m.keys = append(m.keys, key)
+	return nil
+}
+
+func (m *mockAPIKeyRepository) FindAPIKeyByHash(ctx context.Context, keyHash string) (*entity.APIKey, error) {
+	for _, key := range m.keys {
+		if key.KeyHash == keyHash {
+			return key, nil
+		}
+	}
+	return nil, port.ErrAPIKeyNotFound
+}
+
+func (m *mockAPIKeyRepository) ListAPIKeys(ctx context.Context) ([]*entity.APIKey, error) {
+	return m.keys, nil
+}
+
+func (m *mockAPIKeyRepository) RevokeAPIKey(ctx context.Context, id int64) (*entity.APIKey, error) {
+	for _, key := range m.keys {
+		if key.ID == id {
+			now := time.Now()
+			key.RevokedAt = &now
+			return key, nil
+		}
+	}
+	return nil, port.ErrAPIKeyNotFound
+}
+
+type mockWebhookRepository struct {
+	port.WebhookRepository
+	subs []*entity.WebhookSubscription
+}
+
+func (m *mockWebhookRepository) CreateWebhook(ctx context.Context, sub *entity.WebhookSubscription) error {
+	sub.ID = int64(len(m.subs) + 1)
+	m.subs = append(m.subs, sub)
+	return nil
+}
+
+func (m *mockWebhookRepository) GetWebhook(ctx context.Context, id int64) (*entity.WebhookSubscription, error) {
+	for _, sub := range m.subs {
+		if sub.ID == id {
+			return sub, nil
+		}
+	}
+	return nil, port.ErrWebhookNotFound
+}
+
+func (m *mockWebhookRepository) ListWebhooks(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	return m.subs, nil
+}
+
+func (m *mockWebhookRepository) DeleteWebhook(ctx context.Context, id int64) error {
+	return port.ErrWebhookNotFound
+}
+
+func (m *mockWebhookRepository) ListWebhookDeliveries(ctx context.Context, subscriptionID int64, limit int) ([]*entity.WebhookDelivery, error) {
+	return []*entity.WebhookDelivery{{ID: 1, SubscriptionID: subscriptionID, Status: entity.WebhookDeliveryPending, Payload: []byte(`{}`)}}, nil
+}
+
+type mockSavedSearchRepository struct {
+	port.SavedSearchRepository
+	searches []*entity.SavedSearch
+}
+
+func (m *mockSavedSearchRepository) CreateSavedSearch(ctx context.Context, search *entity.SavedSearch) error {
+	search.ID = int64(len(m.searches) + 1)
+	m.searches = append(m.searches, search)
+	return nil
+}
+
+func (m *mockSavedSearchRepository) ListSavedSearches(ctx context.Context, apiKeyID int64) ([]*entity.SavedSearch, error) {
+	searches := make([]*entity.SavedSearch, 0)
+	for _, search := range m.searches {
+		if search.APIKeyID == apiKeyID {
+			searches = append(searches, search)
+		}
+	}
+	return searches, nil
+}
+
+func (m *mockSavedSearchRepository) DeleteSavedSearch(ctx context.Context, apiKeyID, id int64) error {
+	for i, search := range m.searches {
+		if search.ID == id && search.APIKeyID == apiKeyID {
+			m.searches = append(m.searches[:i], m.searches[i+1:]...)
+			return nil
+		}
+	}
+	return port.ErrSavedSearchNotFound
+}
+
+type mockSearchEventRepository struct {
+	events []*entity.SearchEvent
+}
+
+func (m *mockSearchEventRepository) InsertSearchEvents(ctx context.Context, events []*entity.SearchEvent) (int, error) {
+	m.events = append(m.events, events...)
+	return len(events), nil
+}
+
+func (m *mockSearchEventRepository) ListContentCTR(ctx context.Context, since time.Time, query string, limit int) ([]*entity.ContentCTR, error) {
+	return []*entity.ContentCTR{{ContentID: 1, Title: "Go", Impressions: 10, Clicks: 2, CTR: 0.2}}, nil
+}
+
+type mockZeroResultQueryRepository struct{}
+
+func (m *mockZeroResultQueryRepository) RecordZeroResultQuery(ctx context.Context, query string) error {
+	return nil
+}
+
+func (m *mockZeroResultQueryRepository) ListZeroResultQueries(ctx context.Context, since time.Time, limit int) ([]*entity.ZeroResultQuery, error) {
+	return []*entity.ZeroResultQuery{{Query: "kubernets", Count: 3, LastSeenAt: time.Date(2024, 1, 20, 12, 0, 0, 0, time.UTC)}}, nil
+}
+
+type mockAdminStatsRepository struct{}
+
+func (m *mockAdminStatsRepository) ListProviderStats(ctx context.Context) ([]*entity.ProviderStats, error) {
+	return []*entity.ProviderStats{{
+		ProviderID: 1, ProviderName: "Provider 1", IsActive: true,
+		ContentCountStats: entity.ContentCountStats{Active: 2, Deleted: 1, ByType: map[string]int64{"video": 2}},
+	}}, nil
+}
+
+func (m *mockAdminStatsRepository) LatestContentUpdate(ctx context.Context) (*time.Time, error) {
+	return nil, nil
+}
+
+type mockContentAuditRepository struct {
+	entries []*entity.ContentAuditEntry
+}
+
+func (m *mockContentAuditRepository) ListContentAudit(ctx context.Context, contentID int64, limit, offset int) ([]*entity.ContentAuditEntry, int64, error) {
+	return m.entries, int64(len(m.entries)), nil
+}
+
+type mockContentModerationRepository struct {
+	contents []*entity.Content
+	filter   port.ContentModerationFilter
+	reviewed []int64
+}
+
+func (m *mockContentModerationRepository) ListContentsByStatus(ctx context.Context, filter port.ContentModerationFilter, limit, offset int) ([]*entity.Content, int64, error) {
+	m.filter = filter
+	return m.contents, int64(len(m.contents)), nil
+}
+
+func (m *mockContentModerationRepository) UpdateContentStatus(ctx context.Context, contentIDs []int64, status entity.ContentStatus) (int64, error) {
+	m.reviewed = contentIDs
+	return int64(len(contentIDs)), nil
+}
+
+type mockAuthorRepository struct {
+	port.AuthorRepository
+	authors []*entity.Author
+	filter  port.AuthorFilter
+	offset  int
+}
+
+func (m *mockAuthorRepository) ListAuthors(ctx context.Context, filter port.AuthorFilter, limit, offset int) ([]*entity.Author, int64, error) {
+	m.filter, m.offset = filter, offset
+	return m.authors, int64(len(m.authors)), nil
+}
+
+type mockCategoryRepository struct {
+	port.CategoryRepository
+	categories []*entity.Category
+}
+
+func (m *mockCategoryRepository) ListCategories(ctx context.Context) ([]*entity.Category, error) {
+	return m.categories, nil
+}
+
+type mockContentVersionRepository struct {
+	versions []*entity.ContentVersion
+}
+
+func (m *mockContentVersionRepository) ListContentVersions(ctx context.Context, contentID int64, limit, offset int) ([]*entity.ContentVersion, int64, error) {
+	return m.versions, int64(len(m.versions)), nil
+}
+
+type mockPromotionRepository struct {
+	promotions []*entity.Promotion
+}
+
+func (m *mockPromotionRepository) FindPromotionByQuery(ctx context.Context, query string) (*entity.Promotion, error) {
+	return nil, nil
+}
+
+func (m *mockPromotionRepository) ListPromotions(ctx context.Context) ([]*entity.Promotion, error) {
+	return m.promotions, nil
+}
+
+func (m *mockPromotionRepository) SavePromotion(ctx context.Context, promotion *entity.Promotion) error {
+	promotion.ID = int64(len(m.promotions) + 1)
+	m.promotions = append(m.promotions, promotion)
+	return nil
+}
+
+func (m *mockPromotionRepository) DeletePromotion(ctx context.Context, id int64) error {
+	return port.ErrPromotionNotFound
+}
+
+// Mock provider client for testing
+type mockProviderClient struct {
+	provider *entity.Provider
+}
+
+func (m *mockProviderClient) FetchContents(ctx context.Context) ([]*entity.NormalizedContent, error) {
+	return nil, nil
+}
+
+func (m *mockProviderClient) GetProviderInfo() *entity.Provider {
+	return m.provider
+}
+
 func TestSearchHandler_HandleSearch(t *testing.T) {
 	t.Run("successful search", func(t *testing.T) {
 		mockRepo := &mockContentRepository{
@@ -166,6 +574,37 @@ func TestSearchHandler_HandleSearch(t *testing.T) {
 		assert.Equal(t, http.StatusOK, w.Code)
 	})
 
+	t.Run("multi-field sort parameter", func(t *testing.T) {
+		mockRepo := &mockContentRepository{
+			searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+				assert.Len(t, params.SortFields, 2)
+				return []*entity.Content{}, 0, nil
+			},
+		}
+
+		searchUseCase := usecase.NewSearchContentsUseCase(mockRepo, &mockCache{}, 60*time.Second)
+		handler := NewSearchHandler(searchUseCase)
+
+		req := httptest.NewRequest("GET", "/api/v1/search?sort=published_at:desc,views:desc", nil)
+		w := httptest.NewRecorder()
+
+		handler.HandleSearch(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("invalid sort parameter", func(t *testing.T) {
+		searchUseCase := usecase.NewSearchContentsUseCase(&mockContentRepository{}, &mockCache{}, 60*time.Second)
+		handler := NewSearchHandler(searchUseCase)
+
+		req := httptest.NewRequest("GET", "/api/v1/search?sort=password:desc", nil)
+		w := httptest.NewRecorder()
+
+		handler.HandleSearch(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
 	t.Run("pagination parameters", func(t *testing.T) {
 		mockRepo := &mockContentRepository{
 			searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
@@ -208,41 +647,1477 @@ func TestSearchHandler_HandleSearch(t *testing.T) {
 
 		assert.Equal(t, http.StatusOK, w.Code)
 	})
-}
 
-func TestHealthHandler_HandleHealth(t *testing.T) {
-	handler := NewHealthHandler()
-
-	req := httptest.NewRequest("GET", "/api/v1/health", nil)
-	w := httptest.NewRecorder()
+	t.Run("date range parameters", func(t *testing.T) {
+		mockRepo := &mockContentRepository{
+			searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+				require.NotNil(t, params.PublishedAfter)
+				require.NotNil(t, params.PublishedBefore)
+				assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), *params.PublishedAfter)
+				assert.Equal(t, time.Date(2024, 6, 30, 12, 0, 0, 0, time.UTC), *params.PublishedBefore)
+				return []*entity.Content{}, 0, nil
+			},
+		}
 
-	handler.HandleHealth(w, req)
+		searchUseCase := usecase.NewSearchContentsUseCase(mockRepo, &mockCache{}, 60*time.Second)
+		handler := NewSearchHandler(searchUseCase)
 
-	assert.Equal(t, http.StatusOK, w.Code)
+		req := httptest.NewRequest("GET", "/api/v1/search?published_after=2024-01-01&published_before=2024-06-30T12:00:00Z", nil)
+		w := httptest.NewRecorder()
 
-	var response map[string]interface{}
-	err := json.NewDecoder(w.Body).Decode(&response)
-	require.NoError(t, err)
-	assert.Equal(t, "healthy", response["status"])
-	assert.NotEmpty(t, response["timestamp"])
-}
+		handler.HandleSearch(w, req)
 
-func TestSyncHandler_HandleSync(t *testing.T) {
-	// Mock sync use case
-	mockProviders := []port.ProviderClient{}
-	mockRepo := &mockContentRepository{}
-	mockCacheRepo := &mockCache{}
-	mockScoringService := service.NewScoringService(service.ScoringRules{
-		VideoTypeWeight:   1.5,
-		ArticleTypeWeight: 1.0,
+		assert.Equal(t, http.StatusOK, w.Code)
 	})
 
-	syncUseCase := usecase.NewSyncProviderContentsUseCase(
-		mockProviders,
-		mockRepo,
-		mockScoringService,
-		mockCacheRepo,
-	)
+	t.Run("malformed date returns structured error", func(t *testing.T) {
+		searchUseCase := usecase.NewSearchContentsUseCase(&mockContentRepository{}, &mockCache{}, 60*time.Second)
+		handler := NewSearchHandler(searchUseCase)
+
+		req := httptest.NewRequest("GET", "/api/v1/search?published_after=01/02/2024", nil)
+		w := httptest.NewRecorder()
+
+		handler.HandleSearch(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response apierror.Response
+		err := json.NewDecoder(w.Body).Decode(&response)
+		require.NoError(t, err)
+		assert.Equal(t, apierror.CodeValidationFailed, response.Error.Code)
+		assert.Equal(t, "published_after", response.Error.Field)
+		assert.Equal(t, "01/02/2024", response.Error.Value)
+	})
+
+	t.Run("tag filter parameters", func(t *testing.T) {
+		mockRepo := &mockContentRepository{
+			searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+				assert.Equal(t, []string{"golang", "tutorial"}, params.Tags)
+				assert.Equal(t, port.TagModeAll, params.TagMode)
+				return []*entity.Content{}, 0, nil
+			},
+		}
+
+		searchUseCase := usecase.NewSearchContentsUseCase(mockRepo, &mockCache{}, 60*time.Second)
+		handler := NewSearchHandler(searchUseCase)
+
+		req := httptest.NewRequest("GET", "/api/v1/search?tags=Tutorial,golang&tag_mode=all", nil)
+		w := httptest.NewRecorder()
+
+		handler.HandleSearch(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("provider filter parameters", func(t *testing.T) {
+		mockRepo := &mockContentRepository{
+			searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+				assert.Equal(t, int64(2), params.ProviderID)
+				assert.Equal(t, "Provider 2 (XML)", params.ProviderName)
+				return []*entity.Content{}, 0, nil
+			},
+		}
+
+		searchUseCase := usecase.NewSearchContentsUseCase(mockRepo, &mockCache{}, 60*time.Second)
+		handler := NewSearchHandler(searchUseCase)
+
+		req := httptest.NewRequest("GET", "/api/v1/search?provider_id=2&provider_name=Provider+2+(XML)", nil)
+		w := httptest.NewRecorder()
+
+		handler.HandleSearch(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("author filter parameter", func(t *testing.T) {
+		mockRepo := &mockContentRepository{
+			searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+				assert.Equal(t, "Rob Pike", params.AuthorName)
+				return []*entity.Content{}, 0, nil
+			},
+		}
+
+		searchUseCase := usecase.NewSearchContentsUseCase(mockRepo, &mockCache{}, 60*time.Second)
+		handler := NewSearchHandler(searchUseCase)
+
+		req := httptest.NewRequest("GET", "/api/v1/search?author=Rob+Pike", nil)
+		w := httptest.NewRecorder()
+
+		handler.HandleSearch(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("category filter parameter is normalized to a path", func(t *testing.T) {
+		mockRepo := &mockContentRepository{
+			searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+				assert.Equal(t, "programming/go", params.Category)
+				return []*entity.Content{}, 0, nil
+			},
+		}
+
+		searchUseCase := usecase.NewSearchContentsUseCase(mockRepo, &mockCache{}, 60*time.Second)
+		handler := NewSearchHandler(searchUseCase)
+
+		req := httptest.NewRequest("GET", "/api/v1/search?category=Programming+%3E+Go", nil)
+		w := httptest.NewRecorder()
+
+		handler.HandleSearch(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("invalid content type returns structured error", func(t *testing.T) {
+		searchUseCase := usecase.NewSearchContentsUseCase(&mockContentRepository{}, &mockCache{}, 60*time.Second)
+		handler := NewSearchHandler(searchUseCase)
+
+		req := httptest.NewRequest("GET", "/api/v1/search?type=bogus", nil)
+		w := httptest.NewRecorder()
+
+		handler.HandleSearch(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response apierror.Response
+		err := json.NewDecoder(w.Body).Decode(&response)
+		require.NoError(t, err)
+		assert.Equal(t, apierror.CodeValidationFailed, response.Error.Code)
+		assert.Equal(t, "type", response.Error.Field)
+		assert.Equal(t, "bogus", response.Error.Value)
+	})
+
+	t.Run("malformed provider id", func(t *testing.T) {
+		searchUseCase := usecase.NewSearchContentsUseCase(&mockContentRepository{}, &mockCache{}, 60*time.Second)
+		handler := NewSearchHandler(searchUseCase)
+
+		req := httptest.NewRequest("GET", "/api/v1/search?provider_id=abc", nil)
+		w := httptest.NewRecorder()
+
+		handler.HandleSearch(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("cursor parameter", func(t *testing.T) {
+		mockRepo := &mockContentRepository{
+			searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+				require.NotNil(t, params.Cursor)
+				assert.Equal(t, int64(42), params.Cursor.ID)
+				assert.Equal(t, 99.5, params.Cursor.Score)
+				return []*entity.Content{}, 0, nil
+			},
+		}
+
+		searchUseCase := usecase.NewSearchContentsUseCase(mockRepo, &mockCache{}, 60*time.Second)
+		handler := NewSearchHandler(searchUseCase)
+
+		cursor := port.SearchCursor{Score: 99.5, ID: 42}.Encode()
+		req := httptest.NewRequest("GET", "/api/v1/search?cursor="+cursor, nil)
+		w := httptest.NewRecorder()
+
+		handler.HandleSearch(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("malformed cursor", func(t *testing.T) {
+		searchUseCase := usecase.NewSearchContentsUseCase(&mockContentRepository{}, &mockCache{}, 60*time.Second)
+		handler := NewSearchHandler(searchUseCase)
+
+		req := httptest.NewRequest("GET", "/api/v1/search?cursor=not-a-cursor", nil)
+		w := httptest.NewRecorder()
+
+		handler.HandleSearch(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("facets parameter", func(t *testing.T) {
+		mockRepo := &mockContentRepository{
+			facetsFunc: func(ctx context.Context, params port.SearchParams) (*entity.SearchFacets, error) {
+				assert.True(t, params.IncludeFacets)
+				return &entity.SearchFacets{
+					ContentTypes: []entity.FacetCount{{Value: "video", Count: 3}},
+				}, nil
+			},
+		}
+
+		mockCacheRepo := &mockCache{}
+		searchUseCase := usecase.NewSearchContentsUseCase(mockRepo, mockCacheRepo, 60*time.Second)
+		handler := NewSearchHandler(searchUseCase)
+
+		req := httptest.NewRequest("GET", "/api/v1/search?query=test&facets=true", nil)
+		w := httptest.NewRecorder()
+
+		handler.HandleSearch(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var result usecase.SearchResult
+		err := json.NewDecoder(w.Body).Decode(&result)
+		require.NoError(t, err)
+		require.NotNil(t, result.Facets)
+		assert.Equal(t, int64(3), result.Facets.ContentTypes[0].Count)
+	})
+}
+
+func TestSearchHandler_Explain(t *testing.T) {
+	mockRepo := &mockContentRepository{
+		searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+			return []*entity.Content{
+				{ID: 1, ContentType: entity.ContentTypeVideo, PublishedAt: time.Now(), Stats: &entity.ContentStats{Views: 1000, Likes: 100}, RelevanceScore: 0.5},
+				{ID: 2, ContentType: entity.ContentTypeArticle, PublishedAt: time.Now()},
+			}, 12, nil
+		},
+	}
+	searchUseCase := usecase.NewSearchContentsUseCase(mockRepo, &mockCache{}, 60*time.Second)
+	searchUseCase.SetScoringService(service.NewScoringService(service.DefaultScoringRules()))
+	handler := NewSearchHandler(searchUseCase)
+
+	t.Run("adds explanation with rank", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/search?query=go&page=2&page_size=2&explain=true", nil)
+		w := httptest.NewRecorder()
+		handler.HandleSearch(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var result usecase.SearchResult
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&result))
+		require.Len(t, result.Items, 2)
+		explanation := result.Items[0].Explanation
+		require.NotNil(t, explanation)
+		assert.Equal(t, 3, explanation.Rank)
+		assert.Equal(t, 0.5, explanation.RelevanceScore)
+		assert.Equal(t, 2.0, explanation.Base.Value)
+		assert.Equal(t, 1000.0, explanation.Base.Inputs["views"])
+		assert.Equal(t, 4, result.Items[1].Explanation.Rank)
+	})
+
+	t.Run("no explanation by default", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/search?query=go", nil)
+		w := httptest.NewRecorder()
+		handler.HandleSearch(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotContains(t, w.Body.String(), "explanation")
+	})
+}
+
+func TestSearchHandler_HandleExport(t *testing.T) {
+	mockRepo := &mockContentRepository{
+		searchFunc: func(ctx context.Context, params port.SearchParams) ([]*entity.Content, int64, error) {
+			assert.Equal(t, "popularity", params.SortBy)
+			if params.Cursor != nil {
+				return nil, 2, nil
+			}
+			return []*entity.Content{
+				{ID: 2, ProviderID: 1, Title: "Go, Generics", ContentType: entity.ContentTypeArticle, Tags: []entity.Tag{{Name: "go"}, {Name: "generics"}}},
+				{ID: 1, ProviderID: 1, Title: "Go Concurrency", ContentType: entity.ContentTypeVideo},
+			}, 2, nil
+		},
+	}
+	searchUseCase := usecase.NewSearchContentsUseCase(mockRepo, &mockCache{}, 60*time.Second)
+	searchUseCase.SetExportMaxRows(1000)
+	handler := NewSearchHandler(searchUseCase)
+
+	t.Run("ndjson", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/search/export?query=go&limit=5", nil)
+		w := httptest.NewRecorder()
+		handler.HandleExport(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/x-ndjson", w.Header().Get("Content-Type"))
+		assert.Equal(t, "5", w.Header().Get("X-Export-Row-Limit"))
+
+		lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")
+		require.Len(t, lines, 2)
+		var content entity.Content
+		require.NoError(t, json.Unmarshal([]byte(lines[1]), &content))
+		assert.Equal(t, "Go Concurrency", content.Title)
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/search/export?format=csv", nil)
+		w := httptest.NewRecorder()
+		handler.HandleExport(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "1000", w.Header().Get("X-Export-Row-Limit"))
+
+		records, err := csv.NewReader(w.Body).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+		assert.Equal(t, "id", records[0][0])
+		assert.Equal(t, "Go, Generics", records[1][2])
+		assert.Equal(t, "go,generics", records[1][8])
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		for _, query := range []string{"format=xml", "limit=0", "sort=relevance"} {
+			req := httptest.NewRequest("GET", "/api/v1/search/export?"+query, nil)
+			w := httptest.NewRecorder()
+			handler.HandleExport(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code, query)
+			assert.Contains(t, w.Header().Get("Content-Type"), "application/json", query)
+		}
+	})
+}
+
+func TestContentHandler_HandleGet(t *testing.T) {
+	newRouter := func(repo *mockContentRepository) *mux.Router {
+		handler := NewContentHandler(usecase.NewGetContentUseCase(repo, service.NewScoringService(service.DefaultScoringRules())))
+
+		r := mux.NewRouter()
+		r.HandleFunc("/api/v1/contents/{id}", handler.HandleGet).Methods("GET")
+		return r
+	}
+
+	repo := &mockContentRepository{
+		findByID: func(ctx context.Context, id int64) (*entity.Content, error) {
+			if id == 9 {
+				return &entity.Content{ID: 9, Title: "Awaiting review", Status: entity.ContentStatusPending}, nil
+			}
+			if id != 7 {
+				return nil, port.ErrContentNotFound
+			}
+			return &entity.Content{
+				ID:          7,
+				Title:       "Go Tutorial",
+				ContentType: entity.ContentTypeArticle,
+				PublishedAt: time.Now().Add(-10 * 24 * time.Hour),
+				Stats:       &entity.ContentStats{ReadingTime: 10, Reactions: 100},
+				Score:       &entity.ContentScore{FinalScore: 17},
+			}, nil
+		},
+	}
+
+	t.Run("returns content", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/contents/7", nil)
+		w := httptest.NewRecorder()
+		newRouter(repo).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var content entity.Content
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&content))
+		assert.Equal(t, "Go Tutorial", content.Title)
+		assert.Nil(t, content.Explanation)
+	})
+
+	t.Run("returns explanation", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/contents/7?explain=true", nil)
+		w := httptest.NewRecorder()
+		newRouter(repo).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var content entity.Content
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&content))
+		require.NotNil(t, content.Explanation)
+		assert.Equal(t, "age_days <= 30", content.Explanation.Recency.Formula)
+		assert.Equal(t, 3.0, content.Explanation.Recency.Value)
+		require.NotNil(t, content.Explanation.StoredFinalScore)
+		assert.Equal(t, 17.0, *content.Explanation.StoredFinalScore)
+		assert.Zero(t, content.Explanation.Rank)
+	})
+
+	t.Run("content not found", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/contents/8", nil)
+		w := httptest.NewRecorder()
+		newRouter(repo).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("content awaiting moderation is not found", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/contents/9", nil)
+		w := httptest.NewRecorder()
+		newRouter(repo).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/contents/abc", nil)
+		w := httptest.NewRecorder()
+		newRouter(repo).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestAuthorsHandler_HandleList(t *testing.T) {
+	repo := &mockAuthorRepository{
+		authors: []*entity.Author{{ID: 1, ProviderID: 2, Name: "Go Channel", ContentCount: 12}},
+	}
+	handler := NewAuthorsHandler(usecase.NewListAuthorsUseCase(repo))
+
+	t.Run("lists authors with filters", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.HandleList(w, httptest.NewRequest("GET", "/api/v1/authors?provider_id=2&q=go&page=2&page_size=5", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, port.AuthorFilter{ProviderID: 2, Query: "go"}, repo.filter)
+		assert.Equal(t, 5, repo.offset)
+
+		var result usecase.ListAuthorsResult
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&result))
+		require.Len(t, result.Items, 1)
+		assert.Equal(t, "Go Channel", result.Items[0].Name)
+		assert.Equal(t, int64(12), result.Items[0].ContentCount)
+	})
+
+	t.Run("invalid provider id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.HandleList(w, httptest.NewRequest("GET", "/api/v1/authors?provider_id=abc", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestContentVersionsHandler_HandleVersions(t *testing.T) {
+	contentRepo := &mockContentRepository{
+		findByID: func(ctx context.Context, id int64) (*entity.Content, error) {
+			if id != 42 {
+				return nil, port.ErrContentNotFound
+			}
+			return &entity.Content{ID: id}, nil
+		},
+	}
+	versionRepo := &mockContentVersionRepository{
+		versions: []*entity.ContentVersion{{
+			ID:        1,
+			ContentID: 42,
+			Title:     "Old Title",
+			Stats:     &entity.ContentVersionStats{Views: 1000},
+		}},
+	}
+	handler := NewContentVersionsHandler(usecase.NewContentVersionsUseCase(contentRepo, versionRepo))
+
+	r := mux.NewRouter()
+	r.HandleFunc("/api/v1/contents/{id}/versions", handler.HandleVersions).Methods("GET")
+
+	t.Run("returns previous versions", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/contents/42/versions", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var result usecase.ContentVersionsResult
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&result))
+		require.Len(t, result.Items, 1)
+		assert.Equal(t, "Old Title", result.Items[0].Title)
+		assert.Equal(t, int64(1000), result.Items[0].Stats.Views)
+	})
+
+	t.Run("missing content", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/contents/7/versions", nil))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/contents/0/versions", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestSimilarHandler_HandleSimilar(t *testing.T) {
+	newRouter := func(repo *mockContentRepository) *mux.Router {
+		similarUseCase := usecase.NewSimilarContentsUseCase(repo, &mockCache{}, 60*time.Second)
+		handler := NewSimilarHandler(similarUseCase)
+
+		r := mux.NewRouter()
+		r.HandleFunc("/api/v1/contents/{id}/similar", handler.HandleSimilar).Methods("GET")
+		return r
+	}
+
+	t.Run("successful request", func(t *testing.T) {
+		mockRepo := &mockContentRepository{
+			similarFunc: func(ctx context.Context, contentID int64, limit int) ([]*entity.Content, error) {
+				assert.Equal(t, int64(7), contentID)
+				assert.Equal(t, 3, limit)
+				return []*entity.Content{{ID: 8, Title: "Related Content"}}, nil
+			},
+		}
+
+		req := httptest.NewRequest("GET", "/api/v1/contents/7/similar?limit=3", nil)
+		w := httptest.NewRecorder()
+		newRouter(mockRepo).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var result usecase.SimilarResult
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&result))
+		require.Len(t, result.Items, 1)
+		assert.Equal(t, "Related Content", result.Items[0].Title)
+	})
+
+	t.Run("content not found", func(t *testing.T) {
+		mockRepo := &mockContentRepository{
+			similarFunc: func(ctx context.Context, contentID int64, limit int) ([]*entity.Content, error) {
+				return nil, port.ErrContentNotFound
+			},
+		}
+
+		req := httptest.NewRequest("GET", "/api/v1/contents/7/similar", nil)
+		w := httptest.NewRecorder()
+		newRouter(mockRepo).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/contents/abc/similar", nil)
+		w := httptest.NewRecorder()
+		newRouter(&mockContentRepository{}).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response apierror.Response
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		assert.Equal(t, apierror.CodeValidationFailed, response.Error.Code)
+		assert.Equal(t, "id", response.Error.Field)
+	})
+}
+
+func TestProviderHandler(t *testing.T) {
+	newRouter := func(repo *mockProviderRepository) *mux.Router {
+		handler := NewProviderHandler(usecase.NewManageProvidersUseCase(repo, &mockCache{}))
+
+		r := mux.NewRouter()
+		r.HandleFunc("/api/v1/admin/providers", handler.HandleList).Methods("GET")
+		r.HandleFunc("/api/v1/admin/providers", handler.HandleCreate).Methods("POST")
+		r.HandleFunc("/api/v1/admin/providers/{id}", handler.HandleUpdate).Methods("PUT")
+		r.HandleFunc("/api/v1/admin/providers/{id}", handler.HandleDelete).Methods("DELETE")
+		return r
+	}
+
+	t.Run("create provider", func(t *testing.T) {
+		mockRepo := &mockProviderRepository{
+			createFunc: func(ctx context.Context, provider *entity.Provider) error {
+				provider.ID = 3
+				return nil
+			},
+		}
+
+		body := strings.NewReader(`{"name": "Provider 3", "url": "http://mock-api:8081/provider3", "format": "json"}`)
+		req := httptest.NewRequest("POST", "/api/v1/admin/providers", body)
+		w := httptest.NewRecorder()
+		newRouter(mockRepo).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var provider entity.Provider
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&provider))
+		assert.Equal(t, int64(3), provider.ID)
+		assert.True(t, provider.IsActive)
+	})
+
+	t.Run("list providers", func(t *testing.T) {
+		mockRepo := &mockProviderRepository{
+			findAllFunc: func(ctx context.Context) ([]*entity.Provider, error) {
+				return []*entity.Provider{
+					{ID: 1, Name: "Provider 1", Format: "json", IsActive: true},
+					{ID: 2, Name: "Provider 2", Format: "xml", IsActive: true},
+				}, nil
+			},
+		}
+
+		req := httptest.NewRequest("GET", "/api/v1/admin/providers", nil)
+		w := httptest.NewRecorder()
+		newRouter(mockRepo).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response struct {
+			Providers []entity.Provider `json:"providers"`
+		}
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		require.Len(t, response.Providers, 2)
+		assert.Equal(t, "Provider 2", response.Providers[1].Name)
+	})
+
+	t.Run("create with invalid format", func(t *testing.T) {
+		body := strings.NewReader(`{"name": "Provider 3", "url": "http://mock-api:8081/provider3", "format": "csv"}`)
+		req := httptest.NewRequest("POST", "/api/v1/admin/providers", body)
+		w := httptest.NewRecorder()
+		newRouter(&mockProviderRepository{}).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response apierror.Response
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		assert.Equal(t, apierror.CodeValidationFailed, response.Error.Code)
+		assert.Equal(t, "format", response.Error.Field)
+	})
+
+	t.Run("create with malformed body", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/admin/providers", strings.NewReader("{"))
+		w := httptest.NewRecorder()
+		newRouter(&mockProviderRepository{}).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("update provider", func(t *testing.T) {
+		mockRepo := &mockProviderRepository{
+			updateFunc: func(ctx context.Context, provider *entity.Provider) error {
+				assert.Equal(t, int64(2), provider.ID)
+				assert.False(t, provider.IsActive)
+				return nil
+			},
+		}
+
+		body := strings.NewReader(`{"name": "Provider 2", "url": "http://mock-api:8081/provider2", "format": "xml", "is_active": false}`)
+		req := httptest.NewRequest("PUT", "/api/v1/admin/providers/2", body)
+		w := httptest.NewRecorder()
+		newRouter(mockRepo).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("update unknown provider", func(t *testing.T) {
+		mockRepo := &mockProviderRepository{
+			updateFunc: func(ctx context.Context, provider *entity.Provider) error {
+				return port.ErrProviderNotFound
+			},
+		}
+
+		body := strings.NewReader(`{"name": "Provider 2", "url": "http://mock-api:8081/provider2", "format": "xml"}`)
+		req := httptest.NewRequest("PUT", "/api/v1/admin/providers/42", body)
+		w := httptest.NewRecorder()
+		newRouter(mockRepo).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("delete provider", func(t *testing.T) {
+		var deletedID int64
+		mockRepo := &mockProviderRepository{
+			deleteFunc: func(ctx context.Context, id int64) error {
+				deletedID = id
+				return nil
+			},
+		}
+
+		req := httptest.NewRequest("DELETE", "/api/v1/admin/providers/5", nil)
+		w := httptest.NewRecorder()
+		newRouter(mockRepo).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		assert.Equal(t, int64(5), deletedID)
+	})
+
+	t.Run("delete with invalid id", func(t *testing.T) {
+		req := httptest.NewRequest("DELETE", "/api/v1/admin/providers/abc", nil)
+		w := httptest.NewRecorder()
+		newRouter(&mockProviderRepository{}).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestHealthHandler_HandleHealth(t *testing.T) {
+	handler := NewHealthHandler(nil, nil)
+
+	req := httptest.NewRequest("GET", "/api/v1/health", nil)
+	w := httptest.NewRecorder()
+
+	handler.HandleHealth(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.NewDecoder(w.Body).Decode(&response)
+	require.NoError(t, err)
+	assert.Equal(t, "healthy", response["status"])
+	assert.NotEmpty(t, response["timestamp"])
+}
+
+func TestHealthHandler_HandleLiveness(t *testing.T) {
+	handler := NewHealthHandler(nil, nil)
+
+	req := httptest.NewRequest("GET", "/healthz", nil)
+	w := httptest.NewRecorder()
+	handler.HandleLiveness(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]string
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+	assert.Equal(t, "alive", response["status"])
+}
+
+func TestHealthHandler_HandleReadiness(t *testing.T) {
+	syncUseCase := usecase.NewSyncProviderContentsUseCase(nil, &mockContentRepository{}, service.NewScoringService(service.ScoringRules{}), &mockCache{})
+	handler := NewHealthHandler(nil, nil)
+	handler.SetSyncUseCase(syncUseCase)
+
+	readiness := func() (int, map[string]interface{}) {
+		req := httptest.NewRequest("GET", "/readyz", nil)
+		w := httptest.NewRecorder()
+		handler.HandleReadiness(w, req)
+
+		var response map[string]interface{}
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		return w.Code, response
+	}
+
+	t.Run("not ready before initial sync", func(t *testing.T) {
+		code, response := readiness()
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, "not_ready", response["status"])
+		assert.Equal(t, "pending", response["checks"].(map[string]interface{})["initial_sync"])
+	})
+
+	t.Run("ready after initial sync attempt", func(t *testing.T) {
+		require.NoError(t, syncUseCase.Execute(context.Background()))
+
+		code, response := readiness()
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "ready", response["status"])
+		assert.Equal(t, "done", response["checks"].(map[string]interface{})["initial_sync"])
+	})
+}
+
+func TestHealthHandler_IncludesProviderHealth(t *testing.T) {
+	mockRepo := &mockProviderRepository{
+		listHealthFunc: func(ctx context.Context) ([]*entity.ProviderHealth, error) {
+			return []*entity.ProviderHealth{{ProviderID: 1, ProviderName: "JSON", Status: entity.ProviderHealthDown, Error: "provider hata döndü: 503"}}, nil
+		},
+	}
+	handler := NewHealthHandler(nil, nil)
+	handler.SetProviderHealth(usecase.NewProviderHealthUseCase(usecase.NewSyncProviderContentsUseCase(nil, nil, nil, nil), mockRepo))
+
+	req := httptest.NewRequest("GET", "/api/v1/health", nil)
+	w := httptest.NewRecorder()
+	handler.HandleHealth(w, req)
+
+	// Provider arızası servisi unhealthy yapmaz
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		Status    string                   `json:"status"`
+		Providers []*entity.ProviderHealth `json:"providers"`
+	}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+	assert.Equal(t, "healthy", response.Status)
+	require.Len(t, response.Providers, 1)
+	assert.Equal(t, entity.ProviderHealthDown, response.Providers[0].Status)
+}
+
+func TestHealthHandler_IncludesProviderFreshness(t *testing.T) {
+	lastSuccess := time.Now().Add(-26 * time.Hour)
+	mockRepo := &mockProviderRepository{
+		listFreshnessFunc: func(ctx context.Context) ([]*entity.ProviderFreshness, error) {
+			return []*entity.ProviderFreshness{
+				{ProviderID: 1, ProviderName: "JSON", LastSuccessAt: &lastSuccess, LastSuccessItems: 25},
+			}, nil
+		},
+	}
+	handler := NewHealthHandler(nil, nil)
+	handler.SetProviderHealth(usecase.NewProviderHealthUseCase(usecase.NewSyncProviderContentsUseCase(nil, nil, nil, nil), mockRepo))
+
+	req := httptest.NewRequest("GET", "/api/v1/health", nil)
+	w := httptest.NewRecorder()
+	handler.HandleHealth(w, req)
+
+	// Eski veri servisi unhealthy yapmaz, sadece provider degraded işaretlenir
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response struct {
+		Status            string                      `json:"status"`
+		ProviderFreshness []*entity.ProviderFreshness `json:"provider_freshness"`
+	}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+	assert.Equal(t, "healthy", response.Status)
+	require.Len(t, response.ProviderFreshness, 1)
+	assert.Equal(t, entity.ProviderFreshnessDegraded, response.ProviderFreshness[0].Status)
+	assert.Equal(t, int32(25), response.ProviderFreshness[0].LastSuccessItems)
+	assert.NotNil(t, response.ProviderFreshness[0].LastSuccessAt)
+}
+
+func TestProviderHealthHandler_HandleProviderHealth(t *testing.T) {
+	newRouter := func(repo *mockProviderRepository) *mux.Router {
+		handler := NewProviderHealthHandler(usecase.NewProviderHealthUseCase(usecase.NewSyncProviderContentsUseCase(nil, nil, nil, nil), repo))
+
+		r := mux.NewRouter()
+		r.HandleFunc("/api/v1/admin/providers/{id}/health", handler.HandleProviderHealth).Methods("GET")
+		return r
+	}
+
+	t.Run("returns last check", func(t *testing.T) {
+		checkedAt := time.Now()
+		repo := &mockProviderRepository{
+			findByIDFunc: func(ctx context.Context, id int64) (*entity.Provider, error) {
+				return &entity.Provider{ID: id, Name: "JSON"}, nil
+			},
+			findHealthFunc: func(ctx context.Context, providerID int64) (*entity.ProviderHealth, error) {
+				return &entity.ProviderHealth{ProviderID: providerID, Status: entity.ProviderHealthUp, StatusCode: 200, CheckedAt: &checkedAt}, nil
+			},
+		}
+
+		req := httptest.NewRequest("GET", "/api/v1/admin/providers/1/health", nil)
+		w := httptest.NewRecorder()
+		newRouter(repo).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var health entity.ProviderHealth
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&health))
+		assert.Equal(t, entity.ProviderHealthUp, health.Status)
+		assert.Equal(t, "JSON", health.ProviderName)
+	})
+
+	t.Run("unknown provider", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/admin/providers/99/health", nil)
+		w := httptest.NewRecorder()
+		newRouter(&mockProviderRepository{}).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("refresh requires an active provider", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/admin/providers/1/health?refresh=true", nil)
+		w := httptest.NewRecorder()
+		newRouter(&mockProviderRepository{}).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/admin/providers/abc/health", nil)
+		w := httptest.NewRecorder()
+		newRouter(&mockProviderRepository{}).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestScoringHandler(t *testing.T) {
+	repo := &mockScoringRulesRepository{}
+	handler := NewScoringHandler(usecase.NewManageScoringRulesUseCase(repo, service.NewScoringService(service.DefaultScoringRules())))
+
+	r := mux.NewRouter()
+	r.HandleFunc("/api/v1/admin/scoring", handler.HandleGet).Methods("GET")
+	r.HandleFunc("/api/v1/admin/scoring", handler.HandleUpdate).Methods("PUT")
+
+	t.Run("get default rules", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/admin/scoring", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var rules entity.ScoringRules
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&rules))
+		assert.Equal(t, 1.5, rules.VideoTypeWeight)
+		assert.Len(t, rules.RecencyTiers, 3)
+	})
+
+	t.Run("update rules", func(t *testing.T) {
+		body := strings.NewReader(`{"video_type_weight": 2, "article_type_weight": 1, "recency_tiers": [{"max_age_days": 14, "score": 4}]}`)
+		req := httptest.NewRequest("PUT", "/api/v1/admin/scoring", body)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var rules entity.ScoringRules
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&rules))
+		assert.Equal(t, 2.0, rules.VideoTypeWeight)
+		assert.Equal(t, []entity.RecencyTier{{MaxAgeDays: 14, Score: 4}}, rules.RecencyTiers)
+		require.NotNil(t, repo.rules)
+		assert.Equal(t, 2.0, repo.rules.VideoTypeWeight)
+	})
+
+	t.Run("update with invalid rules", func(t *testing.T) {
+		body := strings.NewReader(`{"recency_tiers": [{"max_age_days": 30, "score": 3}, {"max_age_days": 7, "score": 5}]}`)
+		req := httptest.NewRequest("PUT", "/api/v1/admin/scoring", body)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response apierror.Response
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		assert.Equal(t, apierror.CodeValidationFailed, response.Error.Code)
+		assert.Equal(t, "recency_tiers[1].max_age_days", response.Error.Field)
+	})
+
+	t.Run("update with malformed body", func(t *testing.T) {
+		req := httptest.NewRequest("PUT", "/api/v1/admin/scoring", strings.NewReader("{"))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("recalculate without recalculator", func(t *testing.T) {
+		r.HandleFunc("/api/v1/admin/scoring/recalculate", handler.HandleRecalculate).Methods("POST")
+
+		req := httptest.NewRequest("POST", "/api/v1/admin/scoring/recalculate", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestCacheHandler(t *testing.T) {
+	handler := NewCacheHandler(usecase.NewInvalidateCacheUseCase(&mockCache{}))
+
+	r := mux.NewRouter()
+	r.HandleFunc("/api/v1/admin/cache/invalidate", handler.HandleInvalidate).Methods("POST")
+
+	req := httptest.NewRequest("POST", "/api/v1/admin/cache/invalidate", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]string
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+	assert.Equal(t, "Cache temizlendi", response["message"])
+}
+
+func TestFeatureFlagHandler(t *testing.T) {
+	featureFlags := flags.New(map[string]bool{entity.FlagFuzzyFallback: true}, flags.NewMemoryStore(), time.Minute)
+	handler := NewFeatureFlagHandler(usecase.NewManageFeatureFlagsUseCase(featureFlags, &mockCache{}))
+
+	r := mux.NewRouter()
+	r.HandleFunc("/api/v1/admin/flags", handler.HandleList).Methods("GET")
+	r.HandleFunc("/api/v1/admin/flags/{name}", handler.HandleSet).Methods("PUT")
+	r.HandleFunc("/api/v1/admin/flags/{name}", handler.HandleReset).Methods("DELETE")
+
+	t.Run("disable flag", func(t *testing.T) {
+		req := httptest.NewRequest("PUT", "/api/v1/admin/flags/fuzzy_fallback", strings.NewReader(`{"enabled": false}`))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var flag entity.FeatureFlag
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&flag))
+		assert.Equal(t, entity.FeatureFlag{Name: entity.FlagFuzzyFallback, Enabled: false, Default: true, Overridden: true}, flag)
+	})
+
+	t.Run("list flags", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/admin/flags", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response struct {
+			Flags []entity.FeatureFlag `json:"flags"`
+		}
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		require.Len(t, response.Flags, 1)
+		assert.False(t, response.Flags[0].Enabled)
+	})
+
+	t.Run("reset flag", func(t *testing.T) {
+		req := httptest.NewRequest("DELETE", "/api/v1/admin/flags/fuzzy_fallback", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var flag entity.FeatureFlag
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&flag))
+		assert.True(t, flag.Enabled)
+		assert.False(t, flag.Overridden)
+	})
+
+	t.Run("set without enabled", func(t *testing.T) {
+		req := httptest.NewRequest("PUT", "/api/v1/admin/flags/fuzzy_fallback", strings.NewReader(`{}`))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), apierror.CodeValidationFailed)
+	})
+
+	t.Run("unknown flag", func(t *testing.T) {
+		req := httptest.NewRequest("PUT", "/api/v1/admin/flags/teleport", strings.NewReader(`{"enabled": true}`))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), apierror.CodeFeatureFlagNotFound)
+	})
+}
+
+func TestSnapshotHandler(t *testing.T) {
+	store := snapshot.NewLocalStore(t.TempDir())
+	snapshotUseCase := usecase.NewSnapshotUseCase(nil, nil, nil, nil, nil, store, &mockCache{})
+	handler := NewSnapshotHandler(snapshotUseCase)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/api/v1/admin/snapshots", handler.HandleList).Methods("GET")
+	r.HandleFunc("/api/v1/admin/snapshots/{name}/restore", handler.HandleRestore).Methods("POST")
+
+	t.Run("list snapshots", func(t *testing.T) {
+		require.NoError(t, store.Put(context.Background(), "contents-20240120T030000Z"+snapshot.FileExtension, strings.NewReader("x")))
+
+		req := httptest.NewRequest("GET", "/api/v1/admin/snapshots", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response struct {
+			Snapshots []entity.SnapshotInfo `json:"snapshots"`
+			LastRun   *entity.SnapshotRun   `json:"last_run"`
+		}
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		require.Len(t, response.Snapshots, 1)
+		assert.Equal(t, int64(1), response.Snapshots[0].SizeBytes)
+		assert.Nil(t, response.LastRun)
+	})
+
+	t.Run("restore unknown snapshot", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/admin/snapshots/missing.ndjson.gz/restore", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), apierror.CodeSnapshotNotFound)
+	})
+
+	t.Run("restore invalid name", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/admin/snapshots/backup.zip/restore", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), apierror.CodeValidationFailed)
+	})
+}
+
+func TestBoostHandler(t *testing.T) {
+	repo := &mockBoostRuleRepository{}
+	boostUseCase := usecase.NewManageBoostRulesUseCase(repo, service.NewScoringService(service.DefaultScoringRules()), &mockCache{})
+	handler := NewBoostHandler(boostUseCase)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/api/v1/admin/boosts", handler.HandleList).Methods("GET")
+	r.HandleFunc("/api/v1/admin/boosts/{tag}", handler.HandleSet).Methods("PUT")
+	r.HandleFunc("/api/v1/admin/boosts/{tag}", handler.HandleDelete).Methods("DELETE")
+
+	t.Run("set boost", func(t *testing.T) {
+		req := httptest.NewRequest("PUT", "/api/v1/admin/boosts/Golang", strings.NewReader(`{"percent": 20}`))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var rule entity.BoostRule
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&rule))
+		assert.Equal(t, "golang", rule.Tag)
+		assert.Equal(t, 20.0, rule.Percent)
+	})
+
+	t.Run("list boosts", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/admin/boosts", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response struct {
+			Boosts []entity.BoostRule `json:"boosts"`
+		}
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		require.Len(t, response.Boosts, 1)
+		assert.Equal(t, "golang", response.Boosts[0].Tag)
+	})
+
+	t.Run("oversized body", func(t *testing.T) {
+		req := httptest.NewRequest("PUT", "/api/v1/admin/boosts/golang", strings.NewReader(`{"percent": 20}`))
+		w := httptest.NewRecorder()
+		req.Body = http.MaxBytesReader(w, req.Body, 4)
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+
+		var response apierror.Response
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		assert.Equal(t, apierror.CodePayloadTooLarge, response.Error.Code)
+		assert.Equal(t, 4.0, response.Error.Details["max_bytes"])
+	})
+
+	t.Run("set boost without percent", func(t *testing.T) {
+		req := httptest.NewRequest("PUT", "/api/v1/admin/boosts/clickbait", strings.NewReader(`{}`))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response apierror.Response
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		assert.Equal(t, apierror.CodeValidationFailed, response.Error.Code)
+		assert.Equal(t, "percent", response.Error.Field)
+	})
+
+	t.Run("delete boost", func(t *testing.T) {
+		req := httptest.NewRequest("DELETE", "/api/v1/admin/boosts/golang", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusNoContent, w.Code)
+
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("DELETE", "/api/v1/admin/boosts/golang", nil))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestAPIKeyHandler(t *testing.T) {
+	handler := NewAPIKeyHandler(usecase.NewManageAPIKeysUseCase(&mockAPIKeyRepository{}))
+
+	r := mux.NewRouter()
+	r.HandleFunc("/api/v1/admin/api-keys", handler.HandleList).Methods("GET")
+	r.HandleFunc("/api/v1/admin/api-keys", handler.HandleCreate).Methods("POST")
+	r.HandleFunc("/api/v1/admin/api-keys/{id}", handler.HandleRevoke).Methods("DELETE")
+
+	t.Run("create key", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/admin/api-keys", strings.NewReader(`{"name": "partner-a", "rate_limit_per_minute": 600}`))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var response map[string]interface{}
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		assert.Equal(t, "partner-a", response["name"])
+		assert.Equal(t, 600.0, response["rate_limit_per_minute"])
+		assert.True(t, strings.HasPrefix(response["key"].(string), response["prefix"].(string)))
+		assert.NotContains(t, response, "key_hash")
+	})
+
+	t.Run("list keys hides secrets", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/admin/api-keys", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotContains(t, w.Body.String(), `"key"`)
+		assert.Contains(t, w.Body.String(), "partner-a")
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("POST", "/api/v1/admin/api-keys", strings.NewReader(`{"name": " "}`)))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("revoke key", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("DELETE", "/api/v1/admin/api-keys/1", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "revoked_at")
+
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("DELETE", "/api/v1/admin/api-keys/99", nil))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestWebhookHandler(t *testing.T) {
+	handler := NewWebhookHandler(usecase.NewManageWebhooksUseCase(&mockWebhookRepository{}))
+
+	r := mux.NewRouter()
+	r.HandleFunc("/api/v1/admin/webhooks", handler.HandleList).Methods("GET")
+	r.HandleFunc("/api/v1/admin/webhooks", handler.HandleCreate).Methods("POST")
+	r.HandleFunc("/api/v1/admin/webhooks/{id}", handler.HandleDelete).Methods("DELETE")
+	r.HandleFunc("/api/v1/admin/webhooks/{id}/deliveries", handler.HandleDeliveries).Methods("GET")
+
+	t.Run("create returns the secret once", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/admin/webhooks",
+			strings.NewReader(`{"name": "partner-a", "url": "https://partner.example.com/hooks", "tags": ["GoLang"]}`))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var response map[string]interface{}
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		assert.Equal(t, "partner-a", response["name"])
+		assert.Equal(t, []interface{}{"golang"}, response["tags"])
+		assert.True(t, strings.HasPrefix(response["secret"].(string), "whsec_"))
+
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/admin/webhooks", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "partner-a")
+		assert.NotContains(t, w.Body.String(), "whsec_")
+	})
+
+	t.Run("invalid url", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("POST", "/api/v1/admin/webhooks", strings.NewReader(`{"name": "a", "url": "ftp://example.com"}`)))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), `"field":"url"`)
+	})
+
+	t.Run("deliveries", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/admin/webhooks/1/deliveries?limit=10", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"status":"pending"`)
+
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/admin/webhooks/1/deliveries?limit=x", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/admin/webhooks/9/deliveries", nil))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("delete unknown webhook", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("DELETE", "/api/v1/admin/webhooks/9", nil))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "webhook_not_found")
+	})
+}
+
+func TestSavedSearchHandler(t *testing.T) {
+	handler := NewSavedSearchHandler(usecase.NewManageSavedSearchesUseCase(&mockSavedSearchRepository{}))
+
+	r := mux.NewRouter()
+	r.HandleFunc("/api/v1/saved-searches", handler.HandleList).Methods("GET")
+	r.HandleFunc("/api/v1/saved-searches", handler.HandleCreate).Methods("POST")
+	r.HandleFunc("/api/v1/saved-searches/{id}", handler.HandleDelete).Methods("DELETE")
+
+	// withAPIKey rate limiter'ın çözdüğü anahtarı context'e ekler
+	withAPIKey := func(req *http.Request, apiKeyID int64) *http.Request {
+		return req.WithContext(context.WithValue(req.Context(), middleware.APIKeyIDKey, apiKeyID))
+	}
+
+	t.Run("requires an API key", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/saved-searches", nil))
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Contains(t, w.Body.String(), "unauthorized")
+	})
+
+	t.Run("create returns the secret once", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/saved-searches",
+			strings.NewReader(`{"name": "go", "query": "GoLang", "notify_url": "https://me.example.com/hooks"}`))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, withAPIKey(req, 7))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var response map[string]interface{}
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		assert.Equal(t, "golang", response["query"])
+		assert.True(t, strings.HasPrefix(response["secret"].(string), "whsec_"))
+
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, withAPIKey(httptest.NewRequest("GET", "/api/v1/saved-searches", nil), 7))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"query":"golang"`)
+		assert.NotContains(t, w.Body.String(), "whsec_")
+
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, withAPIKey(httptest.NewRequest("GET", "/api/v1/saved-searches", nil), 8))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotContains(t, w.Body.String(), "golang")
+	})
+
+	t.Run("email alerts disabled", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/saved-searches",
+			strings.NewReader(`{"name": "go", "query": "golang", "notify_email": "me@example.com"}`))
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, withAPIKey(req, 7))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), `"field":"notify_email"`)
+	})
+
+	t.Run("delete only own searches", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, withAPIKey(httptest.NewRequest("DELETE", "/api/v1/saved-searches/1", nil), 8))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "saved_search_not_found")
+
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, withAPIKey(httptest.NewRequest("DELETE", "/api/v1/saved-searches/1", nil), 7))
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+}
+
+func TestSearchEventsHandler(t *testing.T) {
+	repo := &mockSearchEventRepository{}
+	handler := NewSearchEventsHandler(usecase.NewSearchEventsUseCase(repo))
+
+	r := mux.NewRouter()
+	r.HandleFunc("/api/v1/events", handler.HandleRecord).Methods("POST")
+	r.HandleFunc("/api/v1/admin/analytics/ctr", handler.HandleCTR).Methods("GET")
+
+	t.Run("records events", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/events",
+			strings.NewReader(`{"events": [{"type": "click", "content_id": 1, "query": "GoLang", "position": 2}]}`))
+		req.Header.Set("User-Agent", "Mozilla/5.0 Firefox/120.0")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+		assert.JSONEq(t, `{"accepted": 1, "sampled_out": 0, "ignored": 0}`, w.Body.String())
+		require.Len(t, repo.events, 1)
+		assert.Equal(t, "golang", repo.events[0].Query)
+	})
+
+	t.Run("bot user agent is ignored", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/events", strings.NewReader(`{"events": [{"type": "click", "content_id": 1}]}`))
+		req.Header.Set("User-Agent", "Mozilla/5.0 (compatible; bingbot/2.0)")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+		assert.Contains(t, w.Body.String(), `"ignored":1`)
+	})
+
+	t.Run("forwarded user agent overrides the header", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/events",
+			strings.NewReader(`{"user_agent": "Googlebot/2.1", "events": [{"type": "click", "content_id": 1}]}`))
+		req.Header.Set("User-Agent", "Go-http-client/1.1")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Contains(t, w.Body.String(), `"ignored":1`)
+	})
+
+	t.Run("invalid event", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("POST", "/api/v1/events", strings.NewReader(`{"events": [{"type": "view", "content_id": 1}]}`)))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), `"field":"events[0].type"`)
+	})
+
+	t.Run("ctr report", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/admin/analytics/ctr?days=30", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"ctr":0.2`)
+
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/admin/analytics/ctr?limit=1000", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestZeroResultQueriesHandler(t *testing.T) {
+	handler := NewZeroResultQueriesHandler(usecase.NewZeroResultQueriesUseCase(&mockZeroResultQueryRepository{}))
+
+	r := mux.NewRouter()
+	r.HandleFunc("/api/v1/admin/analytics/zero-results", handler.HandleList).Methods("GET")
+
+	t.Run("lists queries", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/admin/analytics/zero-results?days=30", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `{"query":"kubernets","count":3,"last_seen_at":"2024-01-20T12:00:00Z"}`)
+	})
+
+	t.Run("invalid days", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/admin/analytics/zero-results?days=365", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), `"field":"days"`)
+	})
+}
+
+func TestAdminStatsHandler(t *testing.T) {
+	handler := NewAdminStatsHandler(usecase.NewAdminStatsUseCase(&mockAdminStatsRepository{}))
+
+	r := mux.NewRouter()
+	r.HandleFunc("/api/v1/admin/stats", handler.HandleStats).Methods("GET")
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/admin/stats", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var stats entity.AdminStats
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&stats))
+	assert.Equal(t, entity.ContentCountStats{Active: 2, Deleted: 1, ByType: map[string]int64{"video": 2}}, stats.Contents)
+	require.Len(t, stats.Providers, 1)
+	assert.Equal(t, "Provider 1", stats.Providers[0].ProviderName)
+	assert.Equal(t, "postgres", stats.Index.Backend)
+}
+
+func TestPromotionHandler(t *testing.T) {
+	contentRepo := &mockContentRepository{
+		findByID: func(ctx context.Context, id int64) (*entity.Content, error) {
+			return &entity.Content{ID: id}, nil
+		},
+	}
+	repo := &mockPromotionRepository{}
+	handler := NewPromotionHandler(usecase.NewManagePromotionsUseCase(repo, contentRepo, &mockCache{}))
+
+	r := mux.NewRouter()
+	r.HandleFunc("/api/v1/admin/promotions", handler.HandleList).Methods("GET")
+	r.HandleFunc("/api/v1/admin/promotions", handler.HandleSave).Methods("PUT")
+	r.HandleFunc("/api/v1/admin/promotions/{id}", handler.HandleDelete).Methods("DELETE")
+
+	t.Run("save promotion", func(t *testing.T) {
+		body := strings.NewReader(`{"query": "Black Friday", "content_ids": [42, 7]}`)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("PUT", "/api/v1/admin/promotions", body))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var promotion entity.Promotion
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&promotion))
+		assert.Equal(t, "black friday", promotion.Query)
+		assert.Equal(t, []int64{42, 7}, promotion.ContentIDs)
+	})
+
+	t.Run("list promotions", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/admin/promotions", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"black friday"`)
+	})
+
+	t.Run("save without contents", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("PUT", "/api/v1/admin/promotions", strings.NewReader(`{"query": "go"}`)))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("delete missing promotion", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("DELETE", "/api/v1/admin/promotions/5", nil))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		w = httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("DELETE", "/api/v1/admin/promotions/abc", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestSyncHandler_HandleSync(t *testing.T) {
+	// Mock sync use case
+	mockProviders := []port.ProviderClient{}
+	mockRepo := &mockContentRepository{}
+	mockCacheRepo := &mockCache{}
+	mockScoringService := service.NewScoringService(service.ScoringRules{
+		VideoTypeWeight:   1.5,
+		ArticleTypeWeight: 1.0,
+	})
+
+	syncUseCase := usecase.NewSyncProviderContentsUseCase(
+		mockProviders,
+		mockRepo,
+		mockScoringService,
+		mockCacheRepo,
+	)
 
 	handler := NewSyncHandler(syncUseCase)
 
@@ -258,6 +2133,295 @@ func TestSyncHandler_HandleSync(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "Senkronizasyon başlatıldı", response["message"])
 	assert.Equal(t, "running", response["status"])
+	require.NotEmpty(t, response["job_id"])
+
+	// Job durumu sorgulanabilmeli
+	r := mux.NewRouter()
+	r.HandleFunc("/api/v1/admin/sync/{jobID}", handler.HandleSyncStatus).Methods("GET")
+
+	req = httptest.NewRequest("GET", "/api/v1/admin/sync/"+response["job_id"], nil)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var job usecase.SyncJob
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&job))
+	assert.Equal(t, response["job_id"], job.ID)
+
+	req = httptest.NewRequest("GET", "/api/v1/admin/sync/unknown-job", nil)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestSyncHandler_HandleSyncProvider(t *testing.T) {
+	providerClient := &mockProviderClient{provider: &entity.Provider{ID: 1, Name: "Provider 1"}}
+	syncUseCase := usecase.NewSyncProviderContentsUseCase(
+		[]port.ProviderClient{providerClient},
+		&mockContentRepository{},
+		service.NewScoringService(service.ScoringRules{VideoTypeWeight: 1.5, ArticleTypeWeight: 1.0}),
+		&mockCache{},
+	)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/api/v1/admin/sync/{providerID}", NewSyncHandler(syncUseCase).HandleSyncProvider).Methods("POST")
+
+	t.Run("unknown provider", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/admin/sync/99", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("invalid provider id", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/admin/sync/abc", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("dry run returns report", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/admin/sync/1?dry_run=true", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var result usecase.SyncDryRunResult
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&result))
+		require.Len(t, result.Providers, 1)
+		assert.Equal(t, int64(1), result.Providers[0].ProviderID)
+	})
+
+	t.Run("starts provider sync", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/admin/sync/1", nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+
+		var response map[string]interface{}
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+		assert.Equal(t, float64(1), response["provider_id"])
+		assert.Equal(t, "running", response["status"])
+	})
+}
+
+func TestSyncHandler_HandleImport(t *testing.T) {
+	providerClient := &mockProviderClient{provider: &entity.Provider{ID: 1, Name: "Provider 1"}}
+	syncUseCase := usecase.NewSyncProviderContentsUseCase(
+		[]port.ProviderClient{providerClient},
+		&mockContentRepository{},
+		service.NewScoringService(service.ScoringRules{VideoTypeWeight: 1.5, ArticleTypeWeight: 1.0}),
+		&mockCache{},
+	)
+	handler := NewSyncHandler(syncUseCase)
+
+	t.Run("missing provider id", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/admin/import", strings.NewReader(""))
+		w := httptest.NewRecorder()
+		handler.HandleImport(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("unknown provider", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/admin/import?provider_id=99", strings.NewReader(""))
+		w := httptest.NewRecorder()
+		handler.HandleImport(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("csv detected from content type", func(t *testing.T) {
+		body := "external_id,title,content_type,published_at,views\n" +
+			"v1,Go Concurrency,video,2024-01-15,1000\n" +
+			"v2,,video,2024-01-15,10\n"
+		req := httptest.NewRequest("POST", "/api/v1/admin/import?provider_id=1", strings.NewReader(body))
+		req.Header.Set("Content-Type", "text/csv; charset=utf-8")
+		w := httptest.NewRecorder()
+		handler.HandleImport(w, req)
+
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		var result usecase.ContentImportResult
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&result))
+		assert.Equal(t, 2, result.Read)
+		assert.Equal(t, 1, result.Imported)
+		assert.Equal(t, 1, result.Rejected)
+		require.Len(t, result.Errors, 1)
+		assert.Equal(t, 3, result.Errors[0].Line)
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/admin/import?provider_id=1&format=xml", strings.NewReader(""))
+		w := httptest.NewRecorder()
+		handler.HandleImport(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestSyncHistoryHandler_HandleHistory(t *testing.T) {
+	t.Run("returns paginated sync logs", func(t *testing.T) {
+		mockRepo := &mockProviderRepository{
+			listSyncLogsFunc: func(ctx context.Context, providerID int64, limit, offset int) ([]*entity.ProviderSyncLog, int64, error) {
+				assert.Equal(t, int64(2), providerID)
+				assert.Equal(t, 10, limit)
+				assert.Equal(t, 10, offset)
+				return []*entity.ProviderSyncLog{{ID: 11, ProviderID: 2, Status: "failed", ErrorMessage: "timeout"}}, 11, nil
+			},
+		}
+		handler := NewSyncHistoryHandler(usecase.NewSyncHistoryUseCase(mockRepo))
+
+		req := httptest.NewRequest("GET", "/api/v1/admin/sync/history?provider_id=2&page=2&page_size=10", nil)
+		w := httptest.NewRecorder()
+		handler.HandleHistory(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var result usecase.SyncHistoryResult
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&result))
+		require.Len(t, result.Items, 1)
+		assert.Equal(t, "failed", result.Items[0].Status)
+		assert.Equal(t, int64(2), result.Pagination.TotalPages)
+	})
+
+	t.Run("invalid provider id", func(t *testing.T) {
+		handler := NewSyncHistoryHandler(usecase.NewSyncHistoryUseCase(&mockProviderRepository{}))
+
+		req := httptest.NewRequest("GET", "/api/v1/admin/sync/history?provider_id=abc", nil)
+		w := httptest.NewRecorder()
+		handler.HandleHistory(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestSyncHistoryHandler_HandleErrors(t *testing.T) {
+	t.Run("returns quarantined items", func(t *testing.T) {
+		mockRepo := &mockProviderRepository{
+			listSyncErrorsFunc: func(ctx context.Context, providerID int64, limit, offset int) ([]*entity.ProviderSyncError, int64, error) {
+				assert.Equal(t, int64(1), providerID)
+				assert.Equal(t, 20, limit)
+				assert.Equal(t, 0, offset)
+				return []*entity.ProviderSyncError{{ID: 1, ProviderID: 1, ExternalID: "v1", Reason: "title is required", RawData: `{"id":"v1"}`}}, 1, nil
+			},
+		}
+		handler := NewSyncHistoryHandler(usecase.NewSyncHistoryUseCase(mockRepo))
+
+		req := httptest.NewRequest("GET", "/api/v1/admin/sync/errors?provider_id=1", nil)
+		w := httptest.NewRecorder()
+		handler.HandleErrors(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var result usecase.SyncErrorsResult
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&result))
+		require.Len(t, result.Items, 1)
+		assert.Equal(t, `{"id":"v1"}`, result.Items[0].RawData)
+		assert.Equal(t, int64(1), result.Pagination.TotalPages)
+	})
+
+	t.Run("invalid provider id", func(t *testing.T) {
+		handler := NewSyncHistoryHandler(usecase.NewSyncHistoryUseCase(&mockProviderRepository{}))
+
+		req := httptest.NewRequest("GET", "/api/v1/admin/sync/errors?provider_id=abc", nil)
+		w := httptest.NewRecorder()
+		handler.HandleErrors(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestContentAuditHandler_HandleAudit(t *testing.T) {
+	repo := &mockContentAuditRepository{
+		entries: []*entity.ContentAuditEntry{{
+			ID:        3,
+			ContentID: 42,
+			Table:     "content_stats",
+			Operation: "update",
+			Changes: map[string]entity.ContentAuditChange{
+				"views": {Old: json.RawMessage(`100`), New: json.RawMessage(`250`)},
+			},
+			Actor:     "sync",
+			SyncJobID: "job-1",
+		}},
+	}
+	handler := NewContentAuditHandler(usecase.NewContentAuditUseCase(repo))
+
+	r := mux.NewRouter()
+	r.HandleFunc("/api/v1/admin/contents/{id}/audit", handler.HandleAudit).Methods("GET")
+
+	t.Run("returns content history", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/admin/contents/42/audit?page_size=10", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var result usecase.ContentAuditResult
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&result))
+		require.Len(t, result.Items, 1)
+		assert.Equal(t, "job-1", result.Items[0].SyncJobID)
+		assert.JSONEq(t, `250`, string(result.Items[0].Changes["views"].New))
+		assert.Equal(t, 10, result.Pagination.PageSize)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/admin/contents/abc/audit", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestModerationHandler(t *testing.T) {
+	repo := &mockContentModerationRepository{
+		contents: []*entity.Content{{ID: 5, Title: "Awaiting review", Status: entity.ContentStatusPending}},
+	}
+	handler := NewModerationHandler(usecase.NewContentModerationUseCase(repo, &mockCache{}))
+
+	t.Run("lists pending queue", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.HandleList(w, httptest.NewRequest("GET", "/api/v1/admin/moderation?provider_id=2", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, entity.ContentStatusPending, repo.filter.Status)
+		assert.Equal(t, int64(2), repo.filter.ProviderID)
+
+		var result usecase.ContentModerationResult
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&result))
+		require.Len(t, result.Items, 1)
+		assert.Equal(t, entity.ContentStatusPending, result.Items[0].Status)
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.HandleList(w, httptest.NewRequest("GET", "/api/v1/admin/moderation?status=unknown", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("approves contents", func(t *testing.T) {
+		body := strings.NewReader(`{"content_ids": [5, 6], "status": "approved"}`)
+		w := httptest.NewRecorder()
+		handler.HandleReview(w, httptest.NewRequest("POST", "/api/v1/admin/moderation", body))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, []int64{5, 6}, repo.reviewed)
+
+		var result usecase.ContentReviewResult
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&result))
+		assert.Equal(t, int64(2), result.Updated)
+	})
+
+	t.Run("malformed body", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.HandleReview(w, httptest.NewRequest("POST", "/api/v1/admin/moderation", strings.NewReader(`{`)))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
 }
 
 func TestCORSMiddleware(t *testing.T) {
@@ -274,3 +2438,142 @@ func TestCORSMiddleware(t *testing.T) {
 	assert.Contains(t, w.Header().Get("Access-Control-Allow-Methods"), "GET")
 	assert.Contains(t, w.Header().Get("Access-Control-Allow-Methods"), "POST")
 }
+
+func TestCategoriesHandler_HandleList(t *testing.T) {
+	parentID := int64(1)
+	repo := &mockCategoryRepository{
+		categories: []*entity.Category{
+			{ID: 1, Name: "Programming", Slug: "programming", Path: "programming", ContentCount: 5},
+			{ID: 2, ParentID: &parentID, Name: "Go", Slug: "go", Path: "programming/go", ContentCount: 3},
+		},
+	}
+	handler := NewCategoriesHandler(usecase.NewListCategoriesUseCase(repo))
+
+	w := httptest.NewRecorder()
+	handler.HandleList(w, httptest.NewRequest("GET", "/api/v1/categories", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var result usecase.ListCategoriesResult
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&result))
+	require.Len(t, result.Items, 2)
+	assert.Equal(t, "programming/go", result.Items[1].Path)
+	require.NotNil(t, result.Items[1].ParentID)
+	assert.Equal(t, int64(1), *result.Items[1].ParentID)
+}
+
+func TestBuildAPIDocs(t *testing.T) {
+	noop := func(w http.ResponseWriter, r *http.Request) {}
+	r := mux.NewRouter()
+	r.HandleFunc("/healthz", noop).Methods("GET")
+	api := r.PathPrefix("/api/v1").Subrouter()
+	api.HandleFunc("/search", noop).Methods("GET", "OPTIONS")
+	api.HandleFunc("/contents/{id}", noop).Methods("GET")
+	admin := api.PathPrefix("/admin").Subrouter()
+	admin.HandleFunc("/sync/{providerID:[0-9]+}", noop).Methods("POST", "OPTIONS")
+	admin.HandleFunc("/providers", noop).Methods("POST", "OPTIONS")
+	admin.HandleFunc("/api-keys", noop).Methods("POST", "OPTIONS")
+	admin.HandleFunc("/unknown", noop).Methods("GET")
+
+	doc, undocumented, err := BuildAPIDocs(r)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"GET /api/v1/admin/unknown"}, undocumented)
+
+	handler, err := NewDocsHandler(doc, "/api/v1/docs/openapi.json")
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	handler.HandleSpec(w, httptest.NewRequest("GET", "/api/v1/docs/openapi.json", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	spec := w.Body.String()
+
+	var decoded struct {
+		OpenAPI    string                            `json:"openapi"`
+		Paths      map[string]map[string]interface{} `json:"paths"`
+		Components struct {
+			Schemas         map[string]interface{} `json:"schemas"`
+			SecuritySchemes map[string]interface{} `json:"securitySchemes"`
+		} `json:"components"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(spec), &decoded))
+	assert.Equal(t, "3.0.3", decoded.OpenAPI)
+	assert.Contains(t, decoded.Paths["/api/v1/search"], "get")
+	assert.Contains(t, decoded.Paths["/api/v1/admin/sync/{providerID}"], "post")
+	assert.Contains(t, decoded.Paths["/api/v1/admin/providers"], "post")
+	assert.Contains(t, decoded.Components.SecuritySchemes, "bearerAuth")
+	assert.Contains(t, decoded.Components.Schemas, "SearchResult")
+	assert.Contains(t, decoded.Components.Schemas, "Response") // apierror zarfı
+
+	// Tüm $ref'ler components altında tanımlı olmalı
+	for _, ref := range regexp.MustCompile(`"\$ref":"#/components/schemas/([^"]+)"`).FindAllStringSubmatch(spec, -1) {
+		assert.Contains(t, decoded.Components.Schemas, ref[1])
+	}
+
+	w = httptest.NewRecorder()
+	handler.HandleUI(w, httptest.NewRequest("GET", "/api/v1/docs", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Header().Get("Content-Type"), "text/html")
+	assert.Contains(t, w.Body.String(), `"/api/v1/docs/openapi.json"`)
+}
+
+func TestStreamHandler(t *testing.T) {
+	bus := eventbus.NewContentBus()
+	streamUseCase := usecase.NewContentStreamUseCase(bus, 1)
+	defer streamUseCase.Close()
+
+	// Logging middleware'i de Flush'ın sarmalanmış writer üzerinden çalıştığını doğrular
+	handler := middleware.Logging(http.HandlerFunc(NewStreamHandler(streamUseCase, time.Hour).HandleStream))
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	t.Run("rejects invalid filters", func(t *testing.T) {
+		resp, err := http.Get(server.URL + "?changes=deleted")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	resp, err := http.Get(server.URL + "?tags=golang&changes=created")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	t.Run("limits concurrent clients", func(t *testing.T) {
+		resp, err := http.Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	})
+
+	reader := bufio.NewReader(resp.Body)
+	line, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, "retry: 5000\n", line)
+
+	content := func(title, tag string) *entity.Content {
+		return &entity.Content{ID: 7, ProviderID: 1, Title: title, ContentType: entity.ContentTypeVideo, Tags: []entity.Tag{{Name: tag}}}
+	}
+	bus.Publish([]entity.ContentChange{
+		{Type: entity.ContentChangeCreated, Content: content("Rust Ownership", "rust")},
+		{Type: entity.ContentChangeUpdated, Content: content("Go Channels", "golang")},
+		{Type: entity.ContentChangeCreated, Content: content("Go Generics", "golang")},
+	})
+
+	var event, data string
+	for event == "" || data == "" {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		switch {
+		case strings.HasPrefix(line, "event: "):
+			event = strings.TrimSpace(strings.TrimPrefix(line, "event: "))
+		case strings.HasPrefix(line, "data: "):
+			data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+	assert.Equal(t, entity.ContentChangeCreated, event)
+
+	var change entity.ContentChange
+	require.NoError(t, json.Unmarshal([]byte(data), &change))
+	assert.Equal(t, "Go Generics", change.Content.Title)
+}
@@ -0,0 +1,112 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/onurerdog4n/search-engine/internal/application/usecase"
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	apperrors "github.com/onurerdog4n/search-engine/internal/domain/errors"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/logger"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/metrics"
+)
+
+// streamRetryMillis bağlantı koptuğunda EventSource'un yeniden bağlanmadan önce beklediği süre
+const streamRetryMillis = 5000
+
+// StreamHandler yeni ve güncellenen içerikleri Server-Sent Events olarak yayınlayan HTTP handler'ı
+type StreamHandler struct {
+	streamUseCase *usecase.ContentStreamUseCase
+	heartbeat     time.Duration
+}
+
+// NewStreamHandler yeni bir stream handler oluşturur
+// heartbeat aralığında gönderilen yorum satırları proxy'lerin boştaki bağlantıyı kapatmasını önler
+func NewStreamHandler(streamUseCase *usecase.ContentStreamUseCase, heartbeat time.Duration) *StreamHandler {
+	return &StreamHandler{
+		streamUseCase: streamUseCase,
+		heartbeat:     heartbeat,
+	}
+}
+
+// HandleStream senkronizasyonda eklenen/güncellenen içerikleri text/event-stream olarak gönderir
+// GET /api/v1/stream
+// Opsiyonel: query=golang (tüm terimler başlık, açıklama veya tag'lerde geçmeli)
+// Opsiyonel: tags=golang,tutorial (en az biri), type=video, provider_id=1
+// Opsiyonel: changes=created (sadece yeni içerikler; varsayılan created ve updated)
+func (h *StreamHandler) HandleStream(w http.ResponseWriter, r *http.Request) {
+	filter := usecase.ContentStreamFilter{
+		Query:       r.URL.Query().Get("query"),
+		ContentType: entity.ContentType(r.URL.Query().Get("type")),
+	}
+	if rawTags := r.URL.Query().Get("tags"); rawTags != "" {
+		filter.Tags = strings.Split(rawTags, ",")
+	}
+	if rawChanges := r.URL.Query().Get("changes"); rawChanges != "" {
+		filter.Changes = strings.Split(rawChanges, ",")
+	}
+	if rawProviderID := r.URL.Query().Get("provider_id"); rawProviderID != "" {
+		providerID, err := strconv.ParseInt(rawProviderID, 10, 64)
+		if err != nil {
+			respondUseCaseError(w, apperrors.NewValidationError("provider_id", "provider_id must be an integer", rawProviderID))
+			return
+		}
+		filter.ProviderID = providerID
+	}
+
+	changes, err := h.streamUseCase.Subscribe(r.Context(), filter)
+	if err != nil {
+		respondUseCaseError(w, err)
+		return
+	}
+
+	metrics.RecordStreamClient(1)
+	defer metrics.RecordStreamClient(-1)
+
+	// Sunucunun WriteTimeout'u uzun süreli bağlantıyı kesmesin
+	rc := http.NewResponseController(w)
+	_ = rc.SetWriteDeadline(time.Time{})
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no") // nginx response buffering'i kapatır
+	w.WriteHeader(http.StatusOK)
+
+	fmt.Fprintf(w, "retry: %d\n\n", streamRetryMillis)
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(h.heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case change, ok := <-changes:
+			// Kanal istemci ayrıldığında veya sunucu kapanırken kapanır
+			if !ok {
+				return
+			}
+			data, err := json.Marshal(change)
+			if err != nil {
+				logger.Error("Stream event could not be encoded", zap.Error(err))
+				continue
+			}
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", change.Type, data)
+			metrics.RecordStreamEvent(change.Type)
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": keepalive\n\n")
+		}
+
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
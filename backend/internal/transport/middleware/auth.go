@@ -0,0 +1,232 @@
+package middleware
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/onurerdog4n/search-engine/internal/transport/apierror"
+)
+
+const (
+	// AuthSubjectKey is the context key for the authenticated subject
+	AuthSubjectKey ContextKey = "auth_subject"
+)
+
+// Kimlik doğrulama hataları
+var (
+	ErrMissingToken = errors.New("missing bearer token")
+	ErrInvalidToken = errors.New("invalid token")
+	ErrExpiredToken = errors.New("token expired")
+)
+
+// AuthConfig admin route'larının kimlik doğrulama ayarları
+type AuthConfig struct {
+	JWTSecrets   []string      // HS256 imza anahtarları; rotasyon sırasında eski ve yeni anahtar birlikte verilir
+	JWTIssuer    string        // Boş değilse token'ın "iss" claim'i eşleşmelidir
+	JWTAudience  string        // Boş değilse token'ın "aud" claim'i bu değeri içermelidir
+	StaticTokens []string      // Geliştirme için sabit bearer token'lar (JWT değil)
+	ClockSkew    time.Duration // exp/nbf kontrolünde tolere edilen saat farkı
+}
+
+// Authenticator bearer token (JWT veya sabit token) doğrulayan middleware
+// Anahtar tanımlanmamışsa tüm istekler reddedilir
+type Authenticator struct {
+	cfg AuthConfig
+	now func() time.Time
+}
+
+// NewAuthenticator yeni bir authenticator oluşturur
+func NewAuthenticator(cfg AuthConfig) *Authenticator {
+	return &Authenticator{
+		cfg: cfg,
+		now: time.Now,
+	}
+}
+
+// Configured en az bir JWT anahtarı veya sabit token tanımlı mı
+func (a *Authenticator) Configured() bool {
+	return len(a.cfg.JWTSecrets) > 0 || len(a.cfg.StaticTokens) > 0
+}
+
+// Middleware geçerli bir "Authorization: Bearer <token>" olmayan istekleri 401 ile reddeder
+// Doğrulanan kimlik (JWT "sub" claim'i veya sabit token etiketi) context'e eklenir
+func (a *Authenticator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		subject, err := a.Authenticate(r)
+		if err != nil {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="admin"`)
+			apierror.Write(w, apierror.New(http.StatusUnauthorized, apierror.CodeUnauthorized, "Yetkisiz istek: "+err.Error()))
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), AuthSubjectKey, subject)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// Authenticate isteğin bearer token'ını doğrular ve kimliği döner
+func (a *Authenticator) Authenticate(r *http.Request) (string, error) {
+	token, ok := bearerToken(r)
+	if !ok {
+		return "", ErrMissingToken
+	}
+	return a.AuthenticateToken(token)
+}
+
+// AuthenticateToken bearer token'ı (JWT veya sabit token) doğrular ve kimliği döner
+// HTTP dışındaki transport'lar (gRPC metadata) token'ı kendisi okuyup buraya verir
+func (a *Authenticator) AuthenticateToken(token string) (string, error) {
+	if token == "" {
+		return "", ErrMissingToken
+	}
+
+	for i, static := range a.cfg.StaticTokens {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(static)) == 1 {
+			return fmt.Sprintf("static-token-%d", i+1), nil
+		}
+	}
+
+	if len(a.cfg.JWTSecrets) == 0 {
+		return "", ErrInvalidToken
+	}
+	return a.verifyJWT(token)
+}
+
+// GetAuthSubject retrieves the authenticated subject from context
+func GetAuthSubject(ctx context.Context) string {
+	if subject, ok := ctx.Value(AuthSubjectKey).(string); ok {
+		return subject
+	}
+	return ""
+}
+
+// bearerToken Authorization header'ındaki bearer token'ı okur
+func bearerToken(r *http.Request) (string, bool) {
+	header := r.Header.Get("Authorization")
+	scheme, token, found := strings.Cut(header, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+// jwtHeader JWT header alanları
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ"`
+}
+
+// jwtClaims doğrulanan JWT claim'leri
+type jwtClaims struct {
+	Subject   string      `json:"sub"`
+	Issuer    string      `json:"iss"`
+	Audience  jwtAudience `json:"aud"`
+	ExpiresAt *int64      `json:"exp"`
+	NotBefore *int64      `json:"nbf"`
+}
+
+// jwtAudience tek string veya string dizisi olarak gelebilen "aud" claim'i
+type jwtAudience []string
+
+// UnmarshalJSON "aud" claim'ini string veya dizi olarak okur
+func (a *jwtAudience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = jwtAudience{single}
+		return nil
+	}
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return err
+	}
+	*a = multiple
+	return nil
+}
+
+// verifyJWT HS256 imzalı JWT'yi doğrular ve "sub" claim'ini döner
+// Süresi (exp) olmayan token'lar kabul edilmez
+func (a *Authenticator) verifyJWT(token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", ErrInvalidToken
+	}
+
+	var header jwtHeader
+	if err := decodeJWTPart(parts[0], &header); err != nil || header.Alg != "HS256" {
+		return "", ErrInvalidToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", ErrInvalidToken
+	}
+	signingInput := []byte(parts[0] + "." + parts[1])
+	if !a.validSignature(signingInput, signature) {
+		return "", ErrInvalidToken
+	}
+
+	var claims jwtClaims
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		return "", ErrInvalidToken
+	}
+
+	now := a.now()
+	if claims.ExpiresAt == nil || now.After(time.Unix(*claims.ExpiresAt, 0).Add(a.cfg.ClockSkew)) {
+		return "", ErrExpiredToken
+	}
+	if claims.NotBefore != nil && now.Add(a.cfg.ClockSkew).Before(time.Unix(*claims.NotBefore, 0)) {
+		return "", ErrInvalidToken
+	}
+	if a.cfg.JWTIssuer != "" && claims.Issuer != a.cfg.JWTIssuer {
+		return "", ErrInvalidToken
+	}
+	if a.cfg.JWTAudience != "" && !containsString(claims.Audience, a.cfg.JWTAudience) {
+		return "", ErrInvalidToken
+	}
+	if claims.Subject == "" {
+		return "", ErrInvalidToken
+	}
+
+	return claims.Subject, nil
+}
+
+// validSignature imzanın tanımlı anahtarlardan biriyle eşleşip eşleşmediğini kontrol eder
+func (a *Authenticator) validSignature(signingInput, signature []byte) bool {
+	for _, secret := range a.cfg.JWTSecrets {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(signingInput)
+		if hmac.Equal(mac.Sum(nil), signature) {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeJWTPart base64url kodlu JWT bölümünü JSON olarak okur
+func decodeJWTPart(part string, dest interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dest)
+}
+
+// containsString değerin listede olup olmadığını döner
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,143 @@
+package middleware
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testJWTSecret = "0123456789abcdef0123456789abcdef"
+
+// signTestJWT verilen header ve claim'lerle HS256 imzalı token üretir
+func signTestJWT(t *testing.T, secret string, header, claims map[string]interface{}) string {
+	t.Helper()
+	encode := func(v interface{}) string {
+		data, err := json.Marshal(v)
+		require.NoError(t, err)
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+	signingInput := encode(header) + "." + encode(claims)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(signingInput))
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestAuthenticator_Middleware(t *testing.T) {
+	now := time.Date(2024, 1, 20, 12, 0, 0, 0, time.UTC)
+	auth := NewAuthenticator(AuthConfig{
+		JWTSecrets:   []string{"old-secret-old-secret-old-secret-", testJWTSecret},
+		JWTIssuer:    "search-engine",
+		JWTAudience:  "admin",
+		StaticTokens: []string{"dev-admin-token-1234"},
+		ClockSkew:    30 * time.Second,
+	})
+	auth.now = func() time.Time { return now }
+
+	var subject string
+	handler := auth.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		subject = GetAuthSubject(r.Context())
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	hs256 := map[string]interface{}{"alg": "HS256", "typ": "JWT"}
+	validClaims := func() map[string]interface{} {
+		return map[string]interface{}{
+			"sub": "ops@example.com",
+			"iss": "search-engine",
+			"aud": []string{"admin", "dashboard"},
+			"exp": now.Add(time.Hour).Unix(),
+		}
+	}
+
+	tests := []struct {
+		name          string
+		authorization string
+		expectedCode  int
+		expectedSub   string
+	}{
+		{"valid jwt", "Bearer " + signTestJWT(t, testJWTSecret, hs256, validClaims()), http.StatusNoContent, "ops@example.com"},
+		{"lowercase scheme", "bearer " + signTestJWT(t, testJWTSecret, hs256, validClaims()), http.StatusNoContent, "ops@example.com"},
+		{"static token", "Bearer dev-admin-token-1234", http.StatusNoContent, "static-token-1"},
+		{"missing header", "", http.StatusUnauthorized, ""},
+		{"basic scheme", "Basic dXNlcjpwYXNz", http.StatusUnauthorized, ""},
+		{"wrong secret", "Bearer " + signTestJWT(t, "another-secret-another-secret-xx", hs256, validClaims()), http.StatusUnauthorized, ""},
+		{"alg none", "Bearer " + signTestJWT(t, testJWTSecret, map[string]interface{}{"alg": "none"}, validClaims()), http.StatusUnauthorized, ""},
+		{"malformed", "Bearer not.a.jwt", http.StatusUnauthorized, ""},
+	}
+
+	expired := validClaims()
+	expired["exp"] = now.Add(-time.Minute).Unix()
+	withinSkew := validClaims()
+	withinSkew["exp"] = now.Add(-10 * time.Second).Unix()
+	noExpiry := validClaims()
+	delete(noExpiry, "exp")
+	notYetValid := validClaims()
+	notYetValid["nbf"] = now.Add(time.Hour).Unix()
+	wrongIssuer := validClaims()
+	wrongIssuer["iss"] = "someone-else"
+	singleAudience := validClaims()
+	singleAudience["aud"] = "admin"
+	wrongAudience := validClaims()
+	wrongAudience["aud"] = "public"
+	noSubject := validClaims()
+	delete(noSubject, "sub")
+
+	tests = append(tests, []struct {
+		name          string
+		authorization string
+		expectedCode  int
+		expectedSub   string
+	}{
+		{"expired", "Bearer " + signTestJWT(t, testJWTSecret, hs256, expired), http.StatusUnauthorized, ""},
+		{"expired within clock skew", "Bearer " + signTestJWT(t, testJWTSecret, hs256, withinSkew), http.StatusNoContent, "ops@example.com"},
+		{"no expiry", "Bearer " + signTestJWT(t, testJWTSecret, hs256, noExpiry), http.StatusUnauthorized, ""},
+		{"not yet valid", "Bearer " + signTestJWT(t, testJWTSecret, hs256, notYetValid), http.StatusUnauthorized, ""},
+		{"wrong issuer", "Bearer " + signTestJWT(t, testJWTSecret, hs256, wrongIssuer), http.StatusUnauthorized, ""},
+		{"single audience string", "Bearer " + signTestJWT(t, testJWTSecret, hs256, singleAudience), http.StatusNoContent, "ops@example.com"},
+		{"wrong audience", "Bearer " + signTestJWT(t, testJWTSecret, hs256, wrongAudience), http.StatusUnauthorized, ""},
+		{"no subject", "Bearer " + signTestJWT(t, testJWTSecret, hs256, noSubject), http.StatusUnauthorized, ""},
+	}...)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subject = ""
+			req := httptest.NewRequest("POST", "/api/v1/admin/sync", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			assert.Equal(t, tt.expectedSub, subject)
+			if tt.expectedCode == http.StatusUnauthorized {
+				assert.Contains(t, w.Header().Get("WWW-Authenticate"), "Bearer")
+			}
+		})
+	}
+}
+
+func TestAuthenticator_NotConfigured(t *testing.T) {
+	auth := NewAuthenticator(AuthConfig{})
+	assert.False(t, auth.Configured())
+
+	handler := auth.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	req := httptest.NewRequest("POST", "/api/v1/admin/sync", nil)
+	req.Header.Set("Authorization", "Bearer anything")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/onurerdog4n/search-engine/internal/transport/apierror"
+)
+
+// BodyLimiter POST/PUT/PATCH isteklerinin gövdesini http.MaxBytesReader ile sınırlar
+// Limit route'un path template'ine göre seçilir, tanımlı değilse varsayılan limit uygulanır
+type BodyLimiter struct {
+	defaultLimit int64
+	routes       map[string]int64 // path template (örn. "/api/v1/admin/moderation") → byte limiti
+}
+
+// NewBodyLimiter yeni bir body limiter oluşturur
+func NewBodyLimiter(defaultLimit int64, routes map[string]int64) *BodyLimiter {
+	return &BodyLimiter{
+		defaultLimit: defaultLimit,
+		routes:       routes,
+	}
+}
+
+// Middleware gövde limitini uygular
+// Content-Length limiti aşıyorsa istek handler'a ulaşmadan 413 ile reddedilir;
+// chunked gövdeler okunurken limit aşılırsa handler *http.MaxBytesError alır
+func (b *BodyLimiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch:
+		default:
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		limit := b.limitFor(r)
+		if r.ContentLength > limit {
+			WriteBodyTooLarge(w, limit)
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, limit)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// limitFor isteğin eşleştiği route'un limitini döner
+func (b *BodyLimiter) limitFor(r *http.Request) int64 {
+	if limit, ok := b.routes[routeTemplate(r)]; ok {
+		return limit
+	}
+	return b.defaultLimit
+}
+
+// WriteBodyTooLarge writes the structured 413 response for an oversized request body
+func WriteBodyTooLarge(w http.ResponseWriter, limit int64) {
+	w.Header().Set("Connection", "close")
+	apierror.Write(w, apierror.PayloadTooLarge(limit))
+}
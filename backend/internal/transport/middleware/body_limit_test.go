@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onurerdog4n/search-engine/internal/transport/apierror"
+)
+
+func TestBodyLimiter_Middleware(t *testing.T) {
+	readBody := func(w http.ResponseWriter, r *http.Request) {
+		if _, err := io.ReadAll(r.Body); err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				WriteBodyTooLarge(w, maxBytesErr.Limit)
+				return
+			}
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+
+	r := mux.NewRouter()
+	r.Use(NewBodyLimiter(16, map[string]int64{"/upload/{id}": 64}).Middleware)
+	r.HandleFunc("/small", readBody).Methods("POST", "GET")
+	r.HandleFunc("/upload/{id}", readBody).Methods("PUT")
+
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		body         string
+		chunked      bool
+		expectedCode int
+		expectedMax  int64
+	}{
+		{"within default limit", "POST", "/small", strings.Repeat("a", 16), false, http.StatusNoContent, 0},
+		{"content-length over default limit", "POST", "/small", strings.Repeat("a", 17), false, http.StatusRequestEntityTooLarge, 16},
+		{"chunked body over default limit", "POST", "/small", strings.Repeat("a", 17), true, http.StatusRequestEntityTooLarge, 16},
+		{"get is not limited", "GET", "/small", strings.Repeat("a", 100), false, http.StatusNoContent, 0},
+		{"route override allows larger body", "PUT", "/upload/1", strings.Repeat("a", 64), false, http.StatusNoContent, 0},
+		{"route override still enforced", "PUT", "/upload/1", strings.Repeat("a", 65), true, http.StatusRequestEntityTooLarge, 64},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if tt.chunked {
+				req.ContentLength = -1
+			}
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			if tt.expectedCode == http.StatusRequestEntityTooLarge {
+				var response apierror.Response
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+				assert.Equal(t, apierror.CodePayloadTooLarge, response.Error.Code)
+				assert.Equal(t, float64(tt.expectedMax), response.Error.Details["max_bytes"])
+			}
+		})
+	}
+}
@@ -34,6 +34,11 @@ func (rw *responseWriter) Write(b []byte) (int, error) {
 	return n, err
 }
 
+// Unwrap lets http.ResponseController reach the underlying writer (Flush, SetWriteDeadline)
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
 // Logging middleware logs HTTP requests with structured logging
 func Logging(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -45,11 +50,17 @@ func Logging(next http.Handler) http.Handler {
 		// Wrap response writer
 		wrapped := newResponseWriter(w)
 
+		// path is the route template so logs aggregate per endpoint, raw_path keeps the concrete URL
+		route := routeTemplate(r)
+
 		// Log request
 		log := logger.GetLogger().WithRequestID(requestID)
+		// Repository, cache ve provider log'ları da request ID'yi taşısın
+		r = r.WithContext(logger.NewContext(r.Context(), log))
 		log.Info("incoming request",
 			zap.String("method", r.Method),
-			zap.String("path", r.URL.Path),
+			zap.String("path", route),
+			zap.String("raw_path", r.URL.Path),
 			zap.String("query", r.URL.RawQuery),
 			zap.String("remote_addr", r.RemoteAddr),
 			zap.String("user_agent", r.UserAgent()),
@@ -64,7 +75,8 @@ func Logging(next http.Handler) http.Handler {
 		// Log response
 		fields := []zap.Field{
 			zap.String("method", r.Method),
-			zap.String("path", r.URL.Path),
+			zap.String("path", route),
+			zap.String("raw_path", r.URL.Path),
 			zap.Int("status", wrapped.statusCode),
 			zap.Duration("duration", duration),
 			zap.Int("bytes", wrapped.written),
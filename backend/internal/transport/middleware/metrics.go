@@ -7,7 +7,12 @@ import (
 	"github.com/onurerdog4n/search-engine/internal/infrastructure/metrics"
 )
 
+// unmatchedRouteLabel is the path label for requests without a matched route,
+// so scanners probing random URLs cannot grow label cardinality
+const unmatchedRouteLabel = "unmatched"
+
 // Metrics middleware collects Prometheus metrics
+// The path label is the mux route template (/api/v1/contents/{id}), never the raw URL
 func Metrics(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
@@ -22,9 +27,17 @@ func Metrics(next http.Handler) http.Handler {
 		duration := time.Since(start).Seconds()
 		metrics.RecordHTTPRequest(
 			r.Method,
-			r.URL.Path,
+			metricsPathLabel(r),
 			wrapped.statusCode,
 			duration,
 		)
 	})
 }
+
+// metricsPathLabel returns the bounded path label for a request
+func metricsPathLabel(r *http.Request) string {
+	if template, ok := matchedRouteTemplate(r); ok {
+		return template
+	}
+	return unmatchedRouteLabel
+}
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/metrics"
+)
+
+func TestMetrics_RecordsStatusCode(t *testing.T) {
+	handler := Metrics(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	counter := metrics.HTTPRequestsTotal.WithLabelValues("GET", unmatchedRouteLabel, "404")
+	before := testutil.ToFloat64(counter)
+
+	req := httptest.NewRequest("GET", "/metrics-test", nil)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, before+1, testutil.ToFloat64(counter))
+}
+
+func TestMetrics_LabelsByRouteTemplate(t *testing.T) {
+	r := mux.NewRouter()
+	r.Use(Metrics)
+	api := r.PathPrefix("/api/v1").Subrouter()
+	api.HandleFunc("/metrics-test/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}).Methods("GET")
+
+	counter := metrics.HTTPRequestsTotal.WithLabelValues("GET", "/api/v1/metrics-test/{id}", "200")
+	before := testutil.ToFloat64(counter)
+
+	for _, id := range []string{"1", "2", "3"} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/metrics-test/"+id, nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+	}
+
+	assert.Equal(t, before+3, testutil.ToFloat64(counter))
+	assert.Equal(t, float64(0), testutil.ToFloat64(metrics.HTTPRequestsTotal.WithLabelValues("GET", "/api/v1/metrics-test/1", "200")))
+}
@@ -1,35 +1,82 @@
 package middleware
 
 import (
+	"context"
+	"errors"
 	"net/http"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"go.uber.org/zap"
 	"golang.org/x/time/rate"
 
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/logger"
 	"github.com/onurerdog4n/search-engine/internal/infrastructure/metrics"
+	"github.com/onurerdog4n/search-engine/internal/transport/apierror"
 )
 
+const (
+	// APIKeyHeader is the header carrying the client's public API key
+	APIKeyHeader = "X-API-Key"
+
+	// APIKeyIDKey is the context key for the resolved API key ID
+	APIKeyIDKey ContextKey = "api_key_id"
+)
+
+// APIKeyResolver istekte gönderilen API anahtarını kayda çözümler
+// Bilinmeyen veya iptal edilmiş anahtarlar için port.ErrAPIKeyNotFound dönmelidir
+type APIKeyResolver interface {
+	ResolveAPIKey(ctx context.Context, key string) (*entity.APIKey, error)
+}
+
 // RateLimiter rate limiting middleware'i
+// API anahtarı gönderen istemciler anahtar bazında, diğerleri IP bazında sınırlanır
 type RateLimiter struct {
-	limiters map[string]*rate.Limiter
-	mu       sync.RWMutex
-	rate     rate.Limit
-	burst    int
+	limiters    map[string]*clientLimiter
+	mu          sync.RWMutex
+	perMinute   int
+	keyResolver APIKeyResolver // nil ise sadece IP bazlı limit uygulanır
+}
+
+// clientLimiter bir istemcinin (IP veya API anahtarı) token bucket'ı
+type clientLimiter struct {
+	limiter   *rate.Limiter
+	perMinute int
 }
 
 // NewRateLimiter yeni bir rate limiter oluşturur
 // requestsPerMinute: dakikada izin verilen istek sayısı
 func NewRateLimiter(requestsPerMinute int) *RateLimiter {
-	r := rate.Limit(float64(requestsPerMinute) / 60.0) // Saniye başına rate
 	return &RateLimiter{
-		limiters: make(map[string]*rate.Limiter),
-		rate:     r,
-		burst:    requestsPerMinute,
+		limiters:  make(map[string]*clientLimiter),
+		perMinute: requestsPerMinute,
 	}
 }
 
+// SetLimit API anahtarına özel limiti olmayan istemcilerin dakikalık limitini çalışma anında değiştirir
+// Mevcut bucket'lar bir sonraki isteklerinde yeni limitle baştan oluşturulur
+func (rl *RateLimiter) SetLimit(requestsPerMinute int) {
+	rl.mu.Lock()
+	rl.perMinute = requestsPerMinute
+	rl.mu.Unlock()
+}
+
+// defaultLimit API anahtarına özel limiti olmayan istemcilerin dakikalık limitini döner
+func (rl *RateLimiter) defaultLimit() int {
+	rl.mu.RLock()
+	defer rl.mu.RUnlock()
+	return rl.perMinute
+}
+
+// SetKeyResolver X-API-Key header'ı ile gelen istekleri anahtar bazında sınırlamak için resolver'ı ayarlar
+func (rl *RateLimiter) SetKeyResolver(resolver APIKeyResolver) {
+	rl.keyResolver = resolver
+}
+
 // getRealIP gets the real IP address from request
 func getRealIP(r *http.Request) string {
 	// Check X-Forwarded-For header (proxy/load balancer)
@@ -51,29 +98,46 @@ func getRealIP(r *http.Request) string {
 // Middleware rate limiting middleware'ini döndürür
 func (rl *RateLimiter) Middleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// Get real IP address
-		ip := getRealIP(r)
+		// Bucket anahtarı: geçerli API anahtarı varsa anahtar, yoksa gerçek IP
+		bucket, perMinute := "ip:"+getRealIP(r), rl.defaultLimit()
+		if raw := strings.TrimSpace(r.Header.Get(APIKeyHeader)); raw != "" && rl.keyResolver != nil {
+			apiKey, err := rl.keyResolver.ResolveAPIKey(r.Context(), raw)
+			switch {
+			case errors.Is(err, port.ErrAPIKeyNotFound):
+				apierror.Write(w, apierror.New(http.StatusUnauthorized, apierror.CodeInvalidAPIKey, "Geçersiz veya iptal edilmiş API anahtarı"))
+				return
+			case err != nil:
+				// Anahtar doğrulanamıyorsa istek engellenmez, IP bazlı limit uygulanır
+				logger.FromContext(r.Context()).Warn("API key could not be resolved, falling back to IP rate limit", zap.Error(err))
+			default:
+				bucket = "key:" + strconv.FormatInt(apiKey.ID, 10)
+				if apiKey.RateLimitPerMinute > 0 {
+					perMinute = apiKey.RateLimitPerMinute
+				}
+				r = r.WithContext(context.WithValue(r.Context(), APIKeyIDKey, apiKey.ID))
+			}
+		}
 
 		// Limiter'ı al veya oluştur
-		limiter := rl.getLimiter(ip)
+		limiter := rl.getLimiter(bucket, perMinute)
+		limit := strconv.Itoa(perMinute)
 
 		// Rate limit kontrolü
 		if !limiter.Allow() {
 			// Record metrics
-			metrics.RecordRateLimitExceeded(r.URL.Path)
+			metrics.RecordRateLimitExceeded(metricsPathLabel(r))
 
 			// Add rate limit headers
-			w.Header().Set("X-RateLimit-Limit", string(rune(rl.burst)))
+			w.Header().Set("X-RateLimit-Limit", limit)
 			w.Header().Set("X-RateLimit-Remaining", "0")
 			w.Header().Set("Retry-After", "60")
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(http.StatusTooManyRequests)
-			w.Write([]byte(`{"error":"Rate limit aşıldı. Lütfen 60 saniye sonra tekrar deneyin."}`))
+			apierror.Write(w, apierror.New(http.StatusTooManyRequests, apierror.CodeRateLimited, "Rate limit aşıldı. Lütfen 60 saniye sonra tekrar deneyin.").
+				WithDetails(map[string]interface{}{"retry_after_seconds": 60}))
 			return
 		}
 
 		// Add rate limit headers
-		w.Header().Set("X-RateLimit-Limit", string(rune(rl.burst)))
+		w.Header().Set("X-RateLimit-Limit", limit)
 		// Note: Remaining count would require tracking, simplified here
 
 		// İsteği işle
@@ -81,20 +145,36 @@ func (rl *RateLimiter) Middleware(next http.Handler) http.Handler {
 	})
 }
 
-// getLimiter IP için limiter döndürür veya oluşturur
-func (rl *RateLimiter) getLimiter(ip string) *rate.Limiter {
+// GetAPIKeyID retrieves the resolved API key ID from context (0 if the request had no key)
+func GetAPIKeyID(ctx context.Context) int64 {
+	if id, ok := ctx.Value(APIKeyIDKey).(int64); ok {
+		return id
+	}
+	return 0
+}
+
+// getLimiter bucket için limiter döndürür veya oluşturur
+// Anahtarın limiti değiştiyse bucket yeni limitle baştan oluşturulur
+func (rl *RateLimiter) getLimiter(bucket string, perMinute int) *rate.Limiter {
 	rl.mu.RLock()
-	limiter, exists := rl.limiters[ip]
+	client, exists := rl.limiters[bucket]
 	rl.mu.RUnlock()
 
-	if !exists {
-		rl.mu.Lock()
-		limiter = rate.NewLimiter(rl.rate, rl.burst)
-		rl.limiters[ip] = limiter
-		rl.mu.Unlock()
+	if exists && client.perMinute == perMinute {
+		return client.limiter
+	}
+
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	client, exists = rl.limiters[bucket]
+	if !exists || client.perMinute != perMinute {
+		r := rate.Limit(float64(perMinute) / 60.0) // Saniye başına rate
+		client = &clientLimiter{limiter: rate.NewLimiter(r, perMinute), perMinute: perMinute}
+		rl.limiters[bucket] = client
 	}
 
-	return limiter
+	return client.limiter
 }
 
 // CleanupOldLimiters eski limiter'ları temizler (opsiyonel, memory leak önlemek için)
@@ -105,7 +185,7 @@ func (rl *RateLimiter) CleanupOldLimiters() {
 			rl.mu.Lock()
 			// Basit cleanup: tüm limiter'ları sıfırla
 			// Production'da daha sofistike bir cleanup yapılabilir
-			rl.limiters = make(map[string]*rate.Limiter)
+			rl.limiters = make(map[string]*clientLimiter)
 			rl.mu.Unlock()
 		}
 	}()
@@ -0,0 +1,149 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/onurerdog4n/search-engine/internal/domain/entity"
+	"github.com/onurerdog4n/search-engine/internal/domain/port"
+)
+
+type mockAPIKeyResolver struct {
+	keys map[string]*entity.APIKey
+	err  error
+}
+
+func (m *mockAPIKeyResolver) ResolveAPIKey(ctx context.Context, key string) (*entity.APIKey, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	if apiKey, ok := m.keys[key]; ok {
+		return apiKey, nil
+	}
+	return nil, port.ErrAPIKeyNotFound
+}
+
+func TestRateLimiter_PerAPIKey(t *testing.T) {
+	resolver := &mockAPIKeyResolver{keys: map[string]*entity.APIKey{
+		"sek_heavy":   {ID: 1, RateLimitPerMinute: 5},
+		"sek_default": {ID: 2},
+	}}
+	rl := NewRateLimiter(2)
+	rl.SetKeyResolver(resolver)
+
+	var keyID int64
+	handler := rl.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keyID = GetAPIKeyID(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	send := func(apiKey string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/api/v1/search", nil)
+		req.RemoteAddr = "10.0.0.1:1234" // Hepsi aynı NAT arkasında
+		if apiKey != "" {
+			req.Header.Set(APIKeyHeader, apiKey)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("key uses its own limit", func(t *testing.T) {
+		for i := 0; i < 5; i++ {
+			w := send("sek_heavy")
+			assert.Equal(t, http.StatusOK, w.Code, "request %d", i+1)
+			assert.Equal(t, "5", w.Header().Get("X-RateLimit-Limit"))
+			assert.Equal(t, int64(1), keyID)
+		}
+		w := send("sek_heavy")
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		assert.Equal(t, "5", w.Header().Get("X-RateLimit-Limit"))
+	})
+
+	t.Run("exhausted key does not affect the shared ip bucket", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			w := send("")
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, int64(0), keyID)
+		}
+		assert.Equal(t, http.StatusTooManyRequests, send("").Code)
+	})
+
+	t.Run("key without limit uses the default", func(t *testing.T) {
+		assert.Equal(t, "2", send("sek_default").Header().Get("X-RateLimit-Limit"))
+	})
+
+	t.Run("unknown key is rejected", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, send("sek_unknown").Code)
+	})
+}
+
+func TestRateLimiter_ResolverErrorFallsBackToIP(t *testing.T) {
+	rl := NewRateLimiter(1)
+	rl.SetKeyResolver(&mockAPIKeyResolver{err: errors.New("connection refused")})
+	handler := rl.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	for _, expected := range []int{http.StatusOK, http.StatusTooManyRequests} {
+		req := httptest.NewRequest("GET", "/api/v1/search", nil)
+		req.Header.Set(APIKeyHeader, "sek_any")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		assert.Equal(t, expected, w.Code)
+	}
+}
+
+func TestRateLimiter_KeyLimitChange(t *testing.T) {
+	key := &entity.APIKey{ID: 1, RateLimitPerMinute: 1}
+	rl := NewRateLimiter(60)
+	rl.SetKeyResolver(&mockAPIKeyResolver{keys: map[string]*entity.APIKey{"sek_a": key}})
+	handler := rl.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	send := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/api/v1/search", nil)
+		req.Header.Set(APIKeyHeader, "sek_a")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+
+	assert.Equal(t, http.StatusOK, send().Code)
+	assert.Equal(t, http.StatusTooManyRequests, send().Code)
+
+	// Limit değiştiğinde bucket yeni limitle baştan oluşturulur
+	key.RateLimitPerMinute = 600
+	w := send()
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "600", w.Header().Get("X-RateLimit-Limit"))
+}
+
+func TestRateLimiter_SetLimit(t *testing.T) {
+	rl := NewRateLimiter(1)
+	handler := rl.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	send := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/api/v1/search", nil)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+
+	assert.Equal(t, http.StatusOK, send().Code)
+	assert.Equal(t, http.StatusTooManyRequests, send().Code)
+
+	// Config yeniden yüklendiğinde mevcut IP bucket'ları yeni limitle baştan oluşturulur
+	rl.SetLimit(120)
+	w := send()
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "120", w.Header().Get("X-RateLimit-Limit"))
+}
@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/onurerdog4n/search-engine/internal/infrastructure/tracing"
+)
+
+// Tracing starts a server span for each request, continuing an incoming W3C traceparent
+// The request ID is attached to the span and propagated to all child spans via baggage
+func Tracing(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+
+		route := routeTemplate(r)
+		attrs := []attribute.KeyValue{
+			attribute.String("http.request.method", r.Method),
+			attribute.String("http.route", route),
+			attribute.String("url.path", r.URL.Path),
+		}
+		if requestID := GetRequestID(r.Context()); requestID != "" {
+			ctx = tracing.WithRequestID(ctx, requestID)
+			attrs = append(attrs, attribute.String(tracing.RequestIDAttribute, requestID))
+		}
+
+		ctx, span := tracing.Tracer().Start(ctx, r.Method+" "+route,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(attrs...),
+		)
+		defer span.End()
+
+		// Yavaş istekleri istemci tarafında trace'le eşleştirebilmek için
+		if spanContext := span.SpanContext(); spanContext.HasTraceID() {
+			w.Header().Set("X-Trace-ID", spanContext.TraceID().String())
+		}
+
+		wrapped := newResponseWriter(w)
+		next.ServeHTTP(wrapped, r.WithContext(ctx))
+
+		span.SetAttributes(attribute.Int("http.response.status_code", wrapped.statusCode))
+		if wrapped.statusCode >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(wrapped.statusCode))
+		}
+	})
+}
+
+// routeTemplate returns the matched mux path template (e.g. /api/v1/contents/{id}), falling back to the raw path
+func routeTemplate(r *http.Request) string {
+	if template, ok := matchedRouteTemplate(r); ok {
+		return template
+	}
+	return r.URL.Path
+}
+
+// matchedRouteTemplate returns the mux path template of the matched route, if any
+func matchedRouteTemplate(r *http.Request) (string, bool) {
+	if route := mux.CurrentRoute(r); route != nil {
+		if template, err := route.GetPathTemplate(); err == nil {
+			return template, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,265 @@
+// Package openapi HTTP API'nin OpenAPI 3 dokümanını üretir
+// Path ve method'lar mux router'ından, açıklama, parametre ve şemalar tipli bir registry'den okunur;
+// böylece doküman router'a eklenen her route'u kapsar, yeni route dokümante edilmemişse uyarı üretilir
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// Version üretilen dokümanın OpenAPI sürümü
+const Version = "3.0.3"
+
+// Document OpenAPI 3 kök nesnesi (kullanılan alanlar)
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       Info                 `json:"info"`
+	Servers    []Server             `json:"servers,omitempty"`
+	Tags       []Tag                `json:"tags,omitempty"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components Components           `json:"components"`
+}
+
+// Info API başlığı ve sürümü
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// Server API'nin sunulduğu adres
+type Server struct {
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+}
+
+// Tag operasyon grubu
+type Tag struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// PathItem tek bir path'in method bazında operasyonları
+type PathItem struct {
+	Get    *Operation `json:"get,omitempty"`
+	Put    *Operation `json:"put,omitempty"`
+	Post   *Operation `json:"post,omitempty"`
+	Delete *Operation `json:"delete,omitempty"`
+	Patch  *Operation `json:"patch,omitempty"`
+}
+
+// Operation tek bir method + path'in dokümanı
+type Operation struct {
+	Tags        []string              `json:"tags,omitempty"`
+	Summary     string                `json:"summary,omitempty"`
+	Description string                `json:"description,omitempty"`
+	OperationID string                `json:"operationId,omitempty"`
+	Parameters  []Parameter           `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]*Response  `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+}
+
+// Parameter path veya query parametresi
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// RequestBody istek gövdesi
+type RequestBody struct {
+	Required bool                 `json:"required,omitempty"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+// Response tek bir durum kodunun yanıtı
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// MediaType içerik tipi ve şeması
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components paylaşılan şemalar ve güvenlik tanımları
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas,omitempty"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+// SecurityScheme kimlik doğrulama yöntemi
+type SecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme,omitempty"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+	Name         string `json:"name,omitempty"`
+	In           string `json:"in,omitempty"`
+	Description  string `json:"description,omitempty"`
+}
+
+// Registry route'ların tipli doküman kaydı
+// Anahtar "METHOD /path" biçimindedir; path'ler mux şablonuyla aynı yazılır (regex kısıtları hariç)
+type Registry struct {
+	operations map[string]*Operation
+	schemas    *SchemaGenerator
+}
+
+// NewRegistry boş bir registry oluşturur
+func NewRegistry() *Registry {
+	return &Registry{
+		operations: make(map[string]*Operation),
+		schemas:    NewSchemaGenerator(),
+	}
+}
+
+// Schemas registry'nin şema üreticisini döner (request/response tiplerini component olarak kaydetmek için)
+func (reg *Registry) Schemas() *SchemaGenerator {
+	return reg.schemas
+}
+
+// Add method + path için operasyonu kaydeder
+// Path parametreleri verilmemişse Build sırasında şablondan string olarak eklenir
+func (reg *Registry) Add(method, path string, op Operation) {
+	reg.operations[operationKey(method, path)] = &op
+}
+
+// Build router'daki route'ları dolaşarak dokümanı üretir
+// prefix ile başlamayan route'lar sadece registry'de kayıtlıysa eklenir;
+// registry'de karşılığı olmayan route'lar özetsiz eklenir ve "METHOD /path" olarak undocumented listesinde döner
+func (reg *Registry) Build(router *mux.Router, info Info, prefix string) (doc *Document, undocumented []string, err error) {
+	doc = &Document{
+		OpenAPI: Version,
+		Info:    info,
+		Paths:   make(map[string]*PathItem),
+		Components: Components{
+			Schemas: reg.schemas.Components(),
+		},
+	}
+
+	tags := make(map[string]bool)
+	err = router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		template, err := route.GetPathTemplate()
+		if err != nil {
+			return nil // PathPrefix'siz alt router'lar
+		}
+		methods, err := route.GetMethods()
+		if err != nil {
+			return nil // Method'suz route'lar (alt router kökleri)
+		}
+
+		path := normalizePath(template)
+		for _, method := range methods {
+			if method == http.MethodOptions {
+				continue
+			}
+
+			op, documented := reg.operations[operationKey(method, path)]
+			if !documented {
+				if !strings.HasPrefix(path, prefix) {
+					continue
+				}
+				undocumented = append(undocumented, method+" "+path)
+				op = &Operation{Responses: map[string]*Response{"default": {Description: "Dokümante edilmemiş yanıt"}}}
+			}
+
+			item := doc.Paths[path]
+			if item == nil {
+				item = &PathItem{}
+				doc.Paths[path] = item
+			}
+			if !item.set(method, withPathParams(*op, template)) {
+				// Aynı method + path ikinci kez eklenmiş (ör. middleware'li yeniden kayıt); ilk kayıt geçerli
+				continue
+			}
+			for _, tag := range op.Tags {
+				tags[tag] = true
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("router dolaşılamadı: %w", err)
+	}
+
+	for tag := range tags {
+		doc.Tags = append(doc.Tags, Tag{Name: tag})
+	}
+	sort.Slice(doc.Tags, func(i, j int) bool { return doc.Tags[i].Name < doc.Tags[j].Name })
+	sort.Strings(undocumented)
+
+	return doc, undocumented, nil
+}
+
+// set method'un operasyonunu ayarlar; zaten varsa false döner
+func (p *PathItem) set(method string, op *Operation) bool {
+	var slot **Operation
+	switch method {
+	case http.MethodGet:
+		slot = &p.Get
+	case http.MethodPut:
+		slot = &p.Put
+	case http.MethodPost:
+		slot = &p.Post
+	case http.MethodDelete:
+		slot = &p.Delete
+	case http.MethodPatch:
+		slot = &p.Patch
+	default:
+		return false
+	}
+	if *slot != nil {
+		return false
+	}
+	*slot = op
+	return true
+}
+
+// pathParamPattern mux şablonundaki {name} veya {name:regex} parametreleri
+var pathParamPattern = regexp.MustCompile(`\{([^}:]+)(?::[^}]*)?\}`)
+
+// normalizePath mux şablonundaki regex kısıtlarını kaldırır ({providerID:[0-9]+} -> {providerID})
+func normalizePath(template string) string {
+	return pathParamPattern.ReplaceAllString(template, "{$1}")
+}
+
+// withPathParams operasyonda tanımlanmamış path parametrelerini şablondan ekler
+// Regex kısıtı sadece rakam kabul ediyorsa parametre integer, aksi halde string olarak yazılır
+func withPathParams(op Operation, template string) *Operation {
+	defined := make(map[string]bool)
+	for _, p := range op.Parameters {
+		if p.In == "path" {
+			defined[p.Name] = true
+		}
+	}
+
+	var params []Parameter
+	for _, match := range pathParamPattern.FindAllStringSubmatch(template, -1) {
+		name := match[1]
+		if defined[name] {
+			continue
+		}
+		schema := &Schema{Type: "string"}
+		if strings.Contains(match[0], ":[0-9]+") {
+			schema = &Schema{Type: "integer", Format: "int64"}
+		}
+		params = append(params, Parameter{Name: name, In: "path", Required: true, Schema: schema})
+	}
+	op.Parameters = append(params, op.Parameters...)
+	return &op
+}
+
+// operationKey registry anahtarını üretir
+func operationKey(method, path string) string {
+	return strings.ToUpper(method) + " " + normalizePath(path)
+}
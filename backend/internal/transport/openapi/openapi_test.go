@@ -0,0 +1,105 @@
+package openapi
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func noop(w http.ResponseWriter, r *http.Request) {}
+
+func TestRegistry_Build(t *testing.T) {
+	r := mux.NewRouter()
+	r.HandleFunc("/healthz", noop).Methods("GET")
+	r.HandleFunc("/metrics", noop).Methods("GET")
+	api := r.PathPrefix("/api/v1").Subrouter()
+	api.HandleFunc("/search", noop).Methods("GET", "OPTIONS")
+	api.HandleFunc("/search", noop).Methods("GET") // middleware'li yeniden kayıt
+	api.HandleFunc("/sync/{providerID:[0-9]+}", noop).Methods("POST")
+	api.HandleFunc("/boosts/{tag}", noop).Methods("DELETE")
+
+	reg := NewRegistry()
+	reg.Add("GET", "/healthz", Operation{Summary: "liveness", Tags: []string{"health"}})
+	reg.Add("GET", "/api/v1/search", Operation{Summary: "search", Tags: []string{"search"}})
+	reg.Add("POST", "/api/v1/sync/{providerID}", Operation{Summary: "sync"})
+
+	doc, undocumented, err := reg.Build(r, Info{Title: "test", Version: "1"}, "/api/v1")
+	require.NoError(t, err)
+
+	assert.Equal(t, Version, doc.OpenAPI)
+	assert.Equal(t, []string{"DELETE /api/v1/boosts/{tag}"}, undocumented)
+
+	// Prefix dışındaki kayıtsız route'lar (metrics) eklenmez, kayıtlı olanlar eklenir
+	assert.Contains(t, doc.Paths, "/healthz")
+	assert.NotContains(t, doc.Paths, "/metrics")
+
+	require.Contains(t, doc.Paths, "/api/v1/search")
+	assert.Equal(t, "search", doc.Paths["/api/v1/search"].Get.Summary)
+
+	// Regex kısıtı path'ten kaldırılır, parametre integer olarak eklenir
+	require.Contains(t, doc.Paths, "/api/v1/sync/{providerID}")
+	params := doc.Paths["/api/v1/sync/{providerID}"].Post.Parameters
+	require.Len(t, params, 1)
+	assert.Equal(t, "providerID", params[0].Name)
+	assert.Equal(t, "path", params[0].In)
+	assert.True(t, params[0].Required)
+	assert.Equal(t, "integer", params[0].Schema.Type)
+
+	// Dokümante edilmemiş route yine de string parametresiyle eklenir
+	deleteBoost := doc.Paths["/api/v1/boosts/{tag}"].Delete
+	require.NotNil(t, deleteBoost)
+	assert.Equal(t, "string", deleteBoost.Parameters[0].Schema.Type)
+
+	assert.Equal(t, []Tag{{Name: "health"}, {Name: "search"}}, doc.Tags)
+}
+
+type testAuthor struct {
+	Name string `json:"name"`
+}
+
+type testItem struct {
+	ID        int64             `json:"id"`
+	Title     string            `json:"title"`
+	Score     float64           `json:"score,omitempty"`
+	Published *time.Time        `json:"published_at"`
+	Author    *testAuthor       `json:"author,omitempty"`
+	Tags      []string          `json:"tags"`
+	Meta      map[string]string `json:"meta,omitempty"`
+	Parent    *testItem         `json:"parent,omitempty"`
+	internal  string
+	Ignored   string `json:"-"`
+}
+
+type testEnvelope struct {
+	testAuthor
+	Count int `json:"count,string"`
+}
+
+func TestSchemaGenerator(t *testing.T) {
+	g := NewSchemaGenerator()
+
+	ref := g.Of([]*testItem{})
+	assert.Equal(t, "array", ref.Type)
+	assert.Equal(t, "#/components/schemas/testItem", ref.Items.Ref)
+
+	item := g.Components()["testItem"]
+	require.NotNil(t, item)
+	assert.Equal(t, []string{"id", "title", "tags"}, item.Required) // pointer ve omitempty alanlar opsiyonel
+	assert.Equal(t, Schema{Type: "integer", Format: "int64"}, *item.Properties["id"])
+	assert.Equal(t, Schema{Type: "string", Format: "date-time", Nullable: true}, *item.Properties["published_at"])
+	assert.Equal(t, "#/components/schemas/testAuthor", item.Properties["author"].Ref)
+	assert.Equal(t, "#/components/schemas/testItem", item.Properties["parent"].Ref) // özyinelemeli tip
+	assert.Equal(t, "string", item.Properties["meta"].AdditionalProperties.Type)
+	assert.NotContains(t, item.Properties, "internal")
+	assert.NotContains(t, item.Properties, "Ignored")
+
+	g.Of(testEnvelope{})
+	envelope := g.Components()["testEnvelope"]
+	require.NotNil(t, envelope)
+	assert.Contains(t, envelope.Properties, "name") // gömülü alanlar üst seviyeye açılır
+	assert.Equal(t, "string", envelope.Properties["count"].Type)
+}
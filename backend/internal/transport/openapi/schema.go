@@ -0,0 +1,185 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Schema OpenAPI şema nesnesi (kullanılan alt küme)
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+}
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+	marshalerType  = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+// SchemaGenerator Go tiplerinden JSON tag'lerine göre şema üretir
+// İsimli struct'lar components/schemas altına bir kez yazılır ve $ref ile referans verilir
+type SchemaGenerator struct {
+	components map[string]*Schema
+	names      map[reflect.Type]string
+}
+
+// NewSchemaGenerator boş bir şema üreticisi oluşturur
+func NewSchemaGenerator() *SchemaGenerator {
+	return &SchemaGenerator{
+		components: make(map[string]*Schema),
+		names:      make(map[reflect.Type]string),
+	}
+}
+
+// Components şimdiye kadar üretilen isimli şemaları döner
+func (g *SchemaGenerator) Components() map[string]*Schema {
+	return g.components
+}
+
+// Of v'nin tipine karşılık gelen şemayı döner (v sadece tip bilgisi için kullanılır)
+func (g *SchemaGenerator) Of(v interface{}) *Schema {
+	return g.schemaFor(reflect.TypeOf(v))
+}
+
+func (g *SchemaGenerator) schemaFor(t reflect.Type) *Schema {
+	if t == nil {
+		return &Schema{}
+	}
+
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch {
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case t == rawMessageType:
+		return &Schema{}
+	case t.Kind() != reflect.Struct && reflect.PtrTo(t).Implements(marshalerType):
+		// Özel serileştirilen isimsiz tipler hakkında bilgi yok
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: g.schemaFor(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.schemaFor(t.Elem())}
+	case reflect.Struct:
+		return g.structRef(t)
+	default:
+		// interface{} ve diğerleri: herhangi bir değer
+		return &Schema{}
+	}
+}
+
+// structRef isimli struct'ı component olarak kaydedip $ref döner; isimsiz struct'lar satır içi yazılır
+func (g *SchemaGenerator) structRef(t reflect.Type) *Schema {
+	if t.Name() == "" {
+		return g.structSchema(t)
+	}
+
+	if name, ok := g.names[t]; ok {
+		return &Schema{Ref: "#/components/schemas/" + name}
+	}
+
+	name := g.componentName(t)
+	g.names[t] = name
+	// Özyinelemeli tipler için önce yer tutucu yazılır
+	g.components[name] = &Schema{}
+	*g.components[name] = *g.structSchema(t)
+
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// componentName tip adını döner; farklı paketlerde aynı isim varsa paket adı önek olarak eklenir
+func (g *SchemaGenerator) componentName(t reflect.Type) string {
+	name := t.Name()
+	if _, taken := g.components[name]; !taken {
+		return name
+	}
+	pkg := t.PkgPath()
+	if i := strings.LastIndex(pkg, "/"); i >= 0 {
+		pkg = pkg[i+1:]
+	}
+	return strings.ToUpper(pkg[:1]) + pkg[1:] + name
+}
+
+// structSchema struct alanlarını json tag'lerine göre property'lere çevirir
+// omitempty olmayan ve pointer olmayan alanlar required kabul edilir
+func (g *SchemaGenerator) structSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+
+		// Gömülü struct alanları (tip export edilmemiş olsa da) encoding/json gibi üst seviyeye açılır
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			for embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				inner := g.structSchema(embedded)
+				for prop, s := range inner.Properties {
+					schema.Properties[prop] = s
+				}
+				schema.Required = append(schema.Required, inner.Required...)
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		prop := g.schemaFor(field.Type)
+		if strings.Contains(opts, "string") && (prop.Type == "integer" || prop.Type == "number" || prop.Type == "boolean") {
+			prop = &Schema{Type: "string", Format: prop.Format}
+		}
+		if field.Type.Kind() == reflect.Ptr && prop.Ref == "" {
+			prop.Nullable = true
+		}
+		schema.Properties[name] = prop
+
+		if !strings.Contains(opts, "omitempty") && field.Type.Kind() != reflect.Ptr {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+
+	return schema
+}
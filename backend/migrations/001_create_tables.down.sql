@@ -21,7 +21,6 @@ DROP INDEX IF EXISTS idx_contents_type;
 DROP INDEX IF EXISTS idx_contents_title_pattern;
 DROP INDEX IF EXISTS idx_contents_title;
 
-DROP TABLE IF EXISTS scoring_rules;
 DROP TABLE IF EXISTS provider_sync_logs;
 DROP TABLE IF EXISTS content_tags;
 DROP TABLE IF EXISTS tags;
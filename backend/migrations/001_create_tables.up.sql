@@ -75,15 +75,6 @@ CREATE TABLE IF NOT EXISTS provider_sync_logs (
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
 
--- Scoring rules tablosu: Skorlama kurallarını JSON formatında tutar
-CREATE TABLE IF NOT EXISTS scoring_rules (
-    id SERIAL PRIMARY KEY,
-    rule_name VARCHAR(100) NOT NULL UNIQUE,
-    rule_value JSONB NOT NULL,
-    description TEXT,
-    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
-);
-
 -- İndeksler: Performans optimizasyonu için
 
 -- Contents tablosu indeksleri
@@ -135,9 +126,3 @@ INSERT INTO providers (name, url, format, is_active) VALUES
     ('Provider 1 (JSON)', 'http://mock-api:8081/provider-1', 'json', true),
     ('Provider 2 (XML)', 'http://mock-api:8081/provider-2', 'xml', true)
 ON CONFLICT DO NOTHING;
-
--- Başlangıç verileri: Skorlama kuralları
-INSERT INTO scoring_rules (rule_name, rule_value, description) VALUES
-    ('video_type_weight', '1.5', 'Video içerikler için tür katsayısı'),
-    ('article_type_weight', '1.0', 'Makale içerikler için tür katsayısı')
-ON CONFLICT (rule_name) DO NOTHING;
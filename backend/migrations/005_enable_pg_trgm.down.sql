@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_contents_title_trgm;
+DROP EXTENSION IF EXISTS pg_trgm;
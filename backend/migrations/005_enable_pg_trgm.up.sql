@@ -0,0 +1,5 @@
+-- Fuzzy arama (yazım hatası toleransı) için trigram eklentisi
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Başlık üzerinde trigram benzerlik sorguları için indeks
+CREATE INDEX IF NOT EXISTS idx_contents_title_trgm ON contents USING GIN (title gin_trgm_ops);
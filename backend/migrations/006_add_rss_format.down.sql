@@ -0,0 +1,4 @@
+-- Eski kısıt rss provider'ları kabul etmediğinden önce onlar silinir
+DELETE FROM providers WHERE format = 'rss';
+ALTER TABLE providers DROP CONSTRAINT IF EXISTS providers_format_check;
+ALTER TABLE providers ADD CONSTRAINT providers_format_check CHECK (format IN ('json', 'xml'));
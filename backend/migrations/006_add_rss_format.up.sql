@@ -0,0 +1,3 @@
+-- RSS/Atom feed provider'ları için format kısıtını genişlet
+ALTER TABLE providers DROP CONSTRAINT IF EXISTS providers_format_check;
+ALTER TABLE providers ADD CONSTRAINT providers_format_check CHECK (format IN ('json', 'xml', 'rss'));
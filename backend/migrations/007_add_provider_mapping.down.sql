@@ -0,0 +1,6 @@
+-- Eski kısıt rest provider'ları kabul etmediğinden önce onlar silinir
+DELETE FROM providers WHERE format = 'rest';
+ALTER TABLE providers DROP CONSTRAINT IF EXISTS providers_format_check;
+ALTER TABLE providers ADD CONSTRAINT providers_format_check CHECK (format IN ('json', 'xml', 'rss'));
+
+ALTER TABLE providers DROP COLUMN IF EXISTS mapping;
@@ -0,0 +1,5 @@
+-- Generic REST provider'ları için JSON alan eşlemesi
+ALTER TABLE providers ADD COLUMN IF NOT EXISTS mapping JSONB;
+
+ALTER TABLE providers DROP CONSTRAINT IF EXISTS providers_format_check;
+ALTER TABLE providers ADD CONSTRAINT providers_format_check CHECK (format IN ('json', 'xml', 'rss', 'rest'));
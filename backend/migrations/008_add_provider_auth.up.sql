@@ -0,0 +1,2 @@
+-- Provider API kimlik doğrulama ayarı (secret'lar burada değil, environment değişkenlerinde tutulur)
+ALTER TABLE providers ADD COLUMN IF NOT EXISTS auth JSONB;
@@ -0,0 +1,3 @@
+-- Koşullu istekler için son başarılı senkronizasyonun ETag / Last-Modified değerleri
+ALTER TABLE providers ADD COLUMN IF NOT EXISTS etag TEXT;
+ALTER TABLE providers ADD COLUMN IF NOT EXISTS last_modified TEXT;
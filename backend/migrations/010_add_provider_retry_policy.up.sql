@@ -0,0 +1,2 @@
+-- Provider isteklerinin retry/backoff ayarı (NULL ise varsayılan politika kullanılır)
+ALTER TABLE providers ADD COLUMN IF NOT EXISTS retry_policy JSONB;
@@ -0,0 +1,12 @@
+-- Senkronizasyonda reddedilen (karantinaya alınan) içerikler
+-- Her provider için sadece son senkronizasyonun reddettiği içerikler tutulur
+CREATE TABLE IF NOT EXISTS provider_sync_errors (
+    id BIGSERIAL PRIMARY KEY,
+    provider_id INTEGER NOT NULL REFERENCES providers(id) ON DELETE CASCADE,
+    provider_content_id TEXT NOT NULL DEFAULT '',
+    reason TEXT NOT NULL,
+    raw_data TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_sync_errors_provider ON provider_sync_errors(provider_id, created_at DESC);
@@ -0,0 +1,11 @@
+-- Provider sağlık kontrollerinin son sonucu (provider başına tek satır)
+CREATE TABLE IF NOT EXISTS provider_health (
+    provider_id INTEGER PRIMARY KEY REFERENCES providers(id) ON DELETE CASCADE,
+    status VARCHAR(10) NOT NULL CHECK (status IN ('up', 'down')),
+    status_code INTEGER NOT NULL DEFAULT 0,
+    latency_ms BIGINT NOT NULL DEFAULT 0,
+    error_message TEXT,
+    consecutive_failures INTEGER NOT NULL DEFAULT 0,
+    checked_at TIMESTAMP NOT NULL,
+    last_up_at TIMESTAMP
+);
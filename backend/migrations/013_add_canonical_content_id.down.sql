@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_contents_canonical;
+ALTER TABLE contents DROP COLUMN IF EXISTS canonical_content_id;
@@ -0,0 +1,5 @@
+-- Farklı provider'lardan gelen aynı içerikler kanonik içeriğe bağlanır
+-- Kanonik (veya kopyası olmayan) içeriklerde NULL
+ALTER TABLE contents ADD COLUMN IF NOT EXISTS canonical_content_id INTEGER REFERENCES contents(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_contents_canonical ON contents(canonical_content_id) WHERE canonical_content_id IS NOT NULL;
@@ -0,0 +1,45 @@
+-- 001'in eski sürümleri scoring_rules'u anahtar/değer tablosu (rule_name, rule_value) olarak oluşturuyordu.
+-- Bu tabloya sahip veritabanlarında aşağıdaki CREATE TABLE IF NOT EXISTS hiçbir şey yapmayacağından eski tablo
+-- önce kaldırılır; tür katsayıları oturumluk geçici tabloya alınıp yeni tabloya taşınır
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_schema = current_schema() AND table_name = 'scoring_rules' AND column_name = 'rule_name'
+    ) THEN
+        CREATE TEMP TABLE scoring_rules_legacy AS SELECT rule_name, rule_value FROM scoring_rules;
+        DROP TABLE scoring_rules;
+    END IF;
+END $$;
+
+-- Skorlama kuralları (tek satır); admin API üzerinden deploy gerektirmeden değiştirilebilir
+CREATE TABLE IF NOT EXISTS scoring_rules (
+    id SMALLINT PRIMARY KEY DEFAULT 1 CHECK (id = 1),
+    video_type_weight DOUBLE PRECISION NOT NULL,
+    article_type_weight DOUBLE PRECISION NOT NULL,
+    recency_tiers JSONB NOT NULL,
+    video_engagement_multiplier DOUBLE PRECISION NOT NULL,
+    article_engagement_multiplier DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Mevcut sabit kurallar başlangıç değeri olarak yüklenir
+INSERT INTO scoring_rules (id, video_type_weight, article_type_weight, recency_tiers, video_engagement_multiplier, article_engagement_multiplier)
+VALUES (1, 1.5, 1.0, '[{"max_age_days": 7, "score": 5}, {"max_age_days": 30, "score": 3}, {"max_age_days": 90, "score": 1}]', 10, 5)
+ON CONFLICT (id) DO NOTHING;
+
+-- Eski tablodaki tür katsayıları korunur
+DO $$
+BEGIN
+    IF to_regclass('pg_temp.scoring_rules_legacy') IS NOT NULL THEN
+        UPDATE scoring_rules SET
+            video_type_weight = COALESCE((
+                SELECT (rule_value #>> '{}')::DOUBLE PRECISION FROM pg_temp.scoring_rules_legacy WHERE rule_name = 'video_type_weight'
+            ), video_type_weight),
+            article_type_weight = COALESCE((
+                SELECT (rule_value #>> '{}')::DOUBLE PRECISION FROM pg_temp.scoring_rules_legacy WHERE rule_name = 'article_type_weight'
+            ), article_type_weight)
+        WHERE id = 1;
+        DROP TABLE pg_temp.scoring_rules_legacy;
+    END IF;
+END $$;
@@ -0,0 +1,4 @@
+ALTER TABLE scoring_rules
+    DROP COLUMN IF EXISTS recency_max_score,
+    DROP COLUMN IF EXISTS recency_half_life_days,
+    DROP COLUMN IF EXISTS recency_decay;
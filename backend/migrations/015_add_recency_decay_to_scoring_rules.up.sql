@@ -0,0 +1,5 @@
+-- Güncellik skor fonksiyonu; boş bırakılırsa config'deki fonksiyon (varsayılan: step) kullanılır
+ALTER TABLE scoring_rules
+    ADD COLUMN IF NOT EXISTS recency_decay TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS recency_half_life_days DOUBLE PRECISION NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS recency_max_score DOUBLE PRECISION NOT NULL DEFAULT 0;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS boost_rules;
@@ -0,0 +1,8 @@
+-- Tag boost kuralları; eşleşen içeriklerin final skoru (1 + percent / 100) ile çarpılır
+CREATE TABLE IF NOT EXISTS boost_rules (
+    id SERIAL PRIMARY KEY,
+    tag VARCHAR(100) NOT NULL UNIQUE,
+    percent DOUBLE PRECISION NOT NULL CHECK (percent >= -100),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
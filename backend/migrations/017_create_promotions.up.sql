@@ -0,0 +1,8 @@
+-- Arama sorgusu sabitlemeleri; sorgu normalize edilmiş haliyle (küçük harf, tek boşluk) saklanır
+CREATE TABLE IF NOT EXISTS promotions (
+    id SERIAL PRIMARY KEY,
+    query VARCHAR(200) NOT NULL UNIQUE,
+    content_ids BIGINT[] NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
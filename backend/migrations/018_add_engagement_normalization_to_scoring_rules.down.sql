@@ -0,0 +1,3 @@
+ALTER TABLE scoring_rules
+    DROP COLUMN IF EXISTS engagement_cap,
+    DROP COLUMN IF EXISTS engagement_scaling;
@@ -0,0 +1,4 @@
+-- Etkileşim skoru normalizasyonu; boş/0 bırakılırsa config'deki değerler (varsayılan: linear, sınırsız) kullanılır
+ALTER TABLE scoring_rules
+    ADD COLUMN IF NOT EXISTS engagement_scaling TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS engagement_cap DOUBLE PRECISION NOT NULL DEFAULT 0;
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_contents_search_vector;
+DROP TRIGGER IF EXISTS refresh_search_vector_on_tag_delete ON content_tags;
+DROP TRIGGER IF EXISTS refresh_search_vector_on_tag_insert ON content_tags;
+DROP TRIGGER IF EXISTS update_contents_search_vector ON contents;
+DROP FUNCTION IF EXISTS refresh_tagged_contents_search_vector();
+DROP FUNCTION IF EXISTS update_contents_search_vector();
+DROP FUNCTION IF EXISTS content_search_vector(TEXT, INTEGER);
+ALTER TABLE contents DROP COLUMN IF EXISTS search_vector;
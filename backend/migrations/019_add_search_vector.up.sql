@@ -0,0 +1,58 @@
+-- Arama vektörü her istekte satır satır hesaplanmak yerine contents tablosunda saklanır
+-- Başlık (A) ve tag'ler (B) ağırlıklıdır; başlık veya tag'ler değişince trigger'lar günceller
+ALTER TABLE contents ADD COLUMN IF NOT EXISTS search_vector tsvector;
+
+-- content_search_vector bir içeriğin başlığı ve mevcut tag'lerinden ağırlıklı tsvector oluşturur
+CREATE OR REPLACE FUNCTION content_search_vector(content_title TEXT, target_content_id INTEGER)
+RETURNS tsvector AS $$
+    SELECT setweight(to_tsvector('english', COALESCE(content_title, '')), 'A') ||
+        setweight(to_tsvector('english', COALESCE((
+            SELECT string_agg(t.name, ' ')
+            FROM content_tags ct
+            JOIN tags t ON ct.tag_id = t.id
+            WHERE ct.content_id = target_content_id
+        ), '')), 'B')
+$$ LANGUAGE sql STABLE;
+
+-- Trigger: başlık yazıldığında vektörü yeniden hesapla
+CREATE OR REPLACE FUNCTION update_contents_search_vector()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.search_vector = content_search_vector(NEW.title, NEW.id);
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_contents_search_vector BEFORE INSERT OR UPDATE OF title ON contents
+    FOR EACH ROW EXECUTE FUNCTION update_contents_search_vector();
+
+-- Trigger: tag eklenip çıkarıldığında etkilenen içeriklerin vektörünü yeniden hesapla
+-- Statement seviyesinde çalışır, böylece toplu tag yazımında her içerik bir kez güncellenir
+CREATE OR REPLACE FUNCTION refresh_tagged_contents_search_vector()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        UPDATE contents c SET search_vector = content_search_vector(c.title, c.id)
+        WHERE c.id IN (SELECT DISTINCT content_id FROM changed_tags);
+    ELSE
+        UPDATE contents c SET search_vector = content_search_vector(c.title, c.id)
+        WHERE c.id IN (SELECT DISTINCT content_id FROM removed_tags);
+    END IF;
+    RETURN NULL;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER refresh_search_vector_on_tag_insert AFTER INSERT ON content_tags
+    REFERENCING NEW TABLE AS changed_tags
+    FOR EACH STATEMENT EXECUTE FUNCTION refresh_tagged_contents_search_vector();
+
+CREATE TRIGGER refresh_search_vector_on_tag_delete AFTER DELETE ON content_tags
+    REFERENCING OLD TABLE AS removed_tags
+    FOR EACH STATEMENT EXECUTE FUNCTION refresh_tagged_contents_search_vector();
+
+-- Mevcut içerikler için doldur (updated_at değişmesin diye trigger kapatılır)
+ALTER TABLE contents DISABLE TRIGGER update_contents_updated_at;
+UPDATE contents SET search_vector = content_search_vector(title, id);
+ALTER TABLE contents ENABLE TRIGGER update_contents_updated_at;
+
+CREATE INDEX IF NOT EXISTS idx_contents_search_vector ON contents USING GIN (search_vector);
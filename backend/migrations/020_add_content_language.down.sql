@@ -0,0 +1,54 @@
+DROP TRIGGER IF EXISTS update_contents_search_vector ON contents;
+
+CREATE OR REPLACE FUNCTION content_search_vector(content_title TEXT, target_content_id INTEGER)
+RETURNS tsvector AS $$
+    SELECT setweight(to_tsvector('english', COALESCE(content_title, '')), 'A') ||
+        setweight(to_tsvector('english', COALESCE((
+            SELECT string_agg(t.name, ' ')
+            FROM content_tags ct
+            JOIN tags t ON ct.tag_id = t.id
+            WHERE ct.content_id = target_content_id
+        ), '')), 'B')
+$$ LANGUAGE sql STABLE;
+
+CREATE OR REPLACE FUNCTION update_contents_search_vector()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.search_vector = content_search_vector(NEW.title, NEW.id);
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE OR REPLACE FUNCTION refresh_tagged_contents_search_vector()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        UPDATE contents c SET search_vector = content_search_vector(c.title, c.id)
+        WHERE c.id IN (SELECT DISTINCT content_id FROM changed_tags);
+    ELSE
+        UPDATE contents c SET search_vector = content_search_vector(c.title, c.id)
+        WHERE c.id IN (SELECT DISTINCT content_id FROM removed_tags);
+    END IF;
+    RETURN NULL;
+END;
+$$ language 'plpgsql';
+
+DROP FUNCTION IF EXISTS content_search_vector(TEXT, INTEGER, regconfig);
+
+CREATE TRIGGER update_contents_search_vector BEFORE INSERT OR UPDATE OF title ON contents
+    FOR EACH ROW EXECUTE FUNCTION update_contents_search_vector();
+
+-- english dışındaki içeriklerin vektörlerini english ile yeniden hesapla
+-- (kolon yoksa, ör. up'tan önce çalıştırıldığında, atlanır)
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'contents' AND column_name = 'language') THEN
+        ALTER TABLE contents DISABLE TRIGGER update_contents_updated_at;
+        UPDATE contents SET search_vector = content_search_vector(title, id) WHERE language <> 'english';
+        ALTER TABLE contents ENABLE TRIGGER update_contents_updated_at;
+    END IF;
+END;
+$$;
+
+ALTER TABLE providers DROP COLUMN IF EXISTS language;
+ALTER TABLE contents DROP COLUMN IF EXISTS language;
@@ -0,0 +1,49 @@
+-- İçerik dili: arama vektörü ve sorgular içeriğin diline uygun text search configuration ile oluşturulur
+-- Değerler PostgreSQL configuration adlarıdır (english, turkish, german, french, spanish)
+ALTER TABLE contents ADD COLUMN IF NOT EXISTS language VARCHAR(20) NOT NULL DEFAULT 'english';
+
+-- Provider'ın içerik dili; NULL ise dil sync sırasında her içerik için tespit edilir
+ALTER TABLE providers ADD COLUMN IF NOT EXISTS language VARCHAR(20);
+
+-- content_search_vector artık içeriğin diliyle çalışır
+CREATE OR REPLACE FUNCTION content_search_vector(content_title TEXT, target_content_id INTEGER, content_language regconfig)
+RETURNS tsvector AS $$
+    SELECT setweight(to_tsvector(content_language, COALESCE(content_title, '')), 'A') ||
+        setweight(to_tsvector(content_language, COALESCE((
+            SELECT string_agg(t.name, ' ')
+            FROM content_tags ct
+            JOIN tags t ON ct.tag_id = t.id
+            WHERE ct.content_id = target_content_id
+        ), '')), 'B')
+$$ LANGUAGE sql STABLE;
+
+CREATE OR REPLACE FUNCTION update_contents_search_vector()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.search_vector = content_search_vector(NEW.title, NEW.id, NEW.language::regconfig);
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE OR REPLACE FUNCTION refresh_tagged_contents_search_vector()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        UPDATE contents c SET search_vector = content_search_vector(c.title, c.id, c.language::regconfig)
+        WHERE c.id IN (SELECT DISTINCT content_id FROM changed_tags);
+    ELSE
+        UPDATE contents c SET search_vector = content_search_vector(c.title, c.id, c.language::regconfig)
+        WHERE c.id IN (SELECT DISTINCT content_id FROM removed_tags);
+    END IF;
+    RETURN NULL;
+END;
+$$ language 'plpgsql';
+
+DROP FUNCTION IF EXISTS content_search_vector(TEXT, INTEGER);
+
+-- Dil değiştiğinde de vektör yeniden hesaplanmalı
+DROP TRIGGER IF EXISTS update_contents_search_vector ON contents;
+CREATE TRIGGER update_contents_search_vector BEFORE INSERT OR UPDATE OF title, language ON contents
+    FOR EACH ROW EXECUTE FUNCTION update_contents_search_vector();
+
+-- Mevcut içerikler 'english' olarak kalır; vektörleri zaten english ile oluşturulduğundan yeniden hesaplanmaz
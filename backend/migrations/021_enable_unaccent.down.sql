@@ -0,0 +1,19 @@
+-- Arama vektörü tekrar aksanlı başlık ve tag'lerden oluşturulur
+CREATE OR REPLACE FUNCTION content_search_vector(content_title TEXT, target_content_id INTEGER, content_language regconfig)
+RETURNS tsvector AS $$
+    SELECT setweight(to_tsvector(content_language, COALESCE(content_title, '')), 'A') ||
+        setweight(to_tsvector(content_language, COALESCE((
+            SELECT string_agg(t.name, ' ')
+            FROM content_tags ct
+            JOIN tags t ON ct.tag_id = t.id
+            WHERE ct.content_id = target_content_id
+        ), '')), 'B')
+$$ LANGUAGE sql STABLE;
+
+ALTER TABLE contents DISABLE TRIGGER update_contents_updated_at;
+UPDATE contents SET search_vector = content_search_vector(title, id, language::regconfig);
+ALTER TABLE contents ENABLE TRIGGER update_contents_updated_at;
+
+DROP INDEX IF EXISTS idx_contents_title_unaccent_trgm;
+DROP FUNCTION IF EXISTS immutable_unaccent(TEXT);
+DROP EXTENSION IF EXISTS unaccent;
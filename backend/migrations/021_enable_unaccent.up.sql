@@ -0,0 +1,28 @@
+-- Aksan/diakritik duyarsız arama: "turkiye" sorgusu "Türkiye" başlığıyla eşleşir
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+-- unaccent() STABLE olduğundan indeks ifadelerinde kullanılamaz; sözlük açıkça verilerek IMMUTABLE sarmalanır
+CREATE OR REPLACE FUNCTION immutable_unaccent(value TEXT)
+RETURNS TEXT AS $$
+    SELECT public.unaccent('public.unaccent'::regdictionary, value)
+$$ LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT;
+
+-- Arama vektörü aksanları kaldırılmış başlık ve tag'lerden oluşturulur; sorgular da aynı şekilde işlenir
+CREATE OR REPLACE FUNCTION content_search_vector(content_title TEXT, target_content_id INTEGER, content_language regconfig)
+RETURNS tsvector AS $$
+    SELECT setweight(to_tsvector(content_language, immutable_unaccent(COALESCE(content_title, ''))), 'A') ||
+        setweight(to_tsvector(content_language, immutable_unaccent(COALESCE((
+            SELECT string_agg(t.name, ' ')
+            FROM content_tags ct
+            JOIN tags t ON ct.tag_id = t.id
+            WHERE ct.content_id = target_content_id
+        ), ''))), 'B')
+$$ LANGUAGE sql STABLE;
+
+-- Kısa sorgu (kelime başı) ve fuzzy aramalar için aksansız başlık üzerinde trigram indeksi
+CREATE INDEX IF NOT EXISTS idx_contents_title_unaccent_trgm ON contents USING GIN (immutable_unaccent(title) gin_trgm_ops);
+
+-- Mevcut içeriklerin vektörlerini yeniden hesapla (updated_at değişmesin diye trigger kapatılır)
+ALTER TABLE contents DISABLE TRIGGER update_contents_updated_at;
+UPDATE contents SET search_vector = content_search_vector(title, id, language::regconfig);
+ALTER TABLE contents ENABLE TRIGGER update_contents_updated_at;
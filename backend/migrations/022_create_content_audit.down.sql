@@ -0,0 +1,7 @@
+DROP TRIGGER IF EXISTS audit_content_tags ON content_tags;
+DROP TRIGGER IF EXISTS audit_content_scores ON content_scores;
+DROP TRIGGER IF EXISTS audit_content_stats ON content_stats;
+DROP TRIGGER IF EXISTS audit_contents ON contents;
+DROP FUNCTION IF EXISTS audit_content_change();
+DROP FUNCTION IF EXISTS content_audit_diff(JSONB, JSONB);
+DROP TABLE IF EXISTS content_audit;
@@ -0,0 +1,108 @@
+-- İçerik değişiklik geçmişi: contents, content_stats, content_scores ve content_tags üzerindeki her değişiklik
+-- trigger'larla kaydedilir. Provider verisiyle ilgili anlaşmazlıklarda bir içeriğin ne zaman, hangi
+-- senkronizasyonda ve nasıl değiştiği buradan izlenir
+CREATE TABLE IF NOT EXISTS content_audit (
+    id BIGSERIAL PRIMARY KEY,
+    -- İçerik fiziksel olarak silinse de (provider silme) geçmiş korunur, bu yüzden foreign key yok
+    content_id INTEGER NOT NULL,
+    table_name VARCHAR(50) NOT NULL,
+    operation VARCHAR(10) NOT NULL CHECK (operation IN ('insert', 'update', 'delete')),
+    -- Değişen kolonlar: {"kolon": {"old": ..., "new": ...}}
+    changes JSONB NOT NULL,
+    -- Değişikliği yapan taraf (sync, ingest, scoring) ve senkronizasyon job ID'si;
+    -- transaction başında search_engine.audit_actor / search_engine.sync_job_id ayarlarından okunur
+    actor VARCHAR(50),
+    sync_job_id VARCHAR(64),
+    changed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_content_audit_content ON content_audit(content_id, id DESC);
+
+-- content_audit_diff iki satır arasında değişen kolonları döner (insert'te old_row, delete'te new_row NULL)
+-- Kimlik, zaman damgası ve türetilmiş kolonlar (search_vector) kaydedilmez
+CREATE OR REPLACE FUNCTION content_audit_diff(old_row JSONB, new_row JSONB)
+RETURNS JSONB AS $$
+    SELECT COALESCE(jsonb_object_agg(k, jsonb_build_object('old', old_row -> k, 'new', new_row -> k)), '{}'::jsonb)
+    FROM jsonb_object_keys(COALESCE(new_row, old_row)) AS k
+    WHERE k NOT IN ('id', 'content_id', 'created_at', 'updated_at', 'calculated_at', 'search_vector')
+        AND (old_row -> k) IS DISTINCT FROM (new_row -> k)
+$$ LANGUAGE sql IMMUTABLE;
+
+-- Trigger: değişikliği content_audit'e yaz; hiçbir kolon değişmediyse (örn. aynı veriyle upsert) kayıt oluşmaz
+-- Tablo adı argüman olarak verilir (TG_TABLE_NAME partition'lı tablolarda partition adını döner)
+CREATE OR REPLACE FUNCTION audit_content_change()
+RETURNS TRIGGER AS $$
+DECLARE
+    old_row JSONB;
+    new_row JSONB;
+    row_data JSONB;
+    target_content_id INTEGER;
+    audit_table TEXT := TG_ARGV[0];
+    audit_operation TEXT := lower(TG_OP);
+    audit_changes JSONB;
+    tag_name TEXT;
+BEGIN
+    -- Satırları olduğu gibi taşıyan bakım işlemleri (ör. partition taşıma) kaydı kapatabilir
+    IF current_setting('search_engine.audit_disabled', true) = 'on' THEN
+        RETURN NULL;
+    END IF;
+
+    IF TG_OP <> 'INSERT' THEN
+        old_row := to_jsonb(OLD);
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        new_row := to_jsonb(NEW);
+    END IF;
+    row_data := COALESCE(new_row, old_row);
+
+    IF audit_table = 'contents' THEN
+        target_content_id := (row_data ->> 'id')::INTEGER;
+    ELSE
+        target_content_id := (row_data ->> 'content_id')::INTEGER;
+    END IF;
+
+    IF audit_table = 'content_tags' THEN
+        -- Tag ilişkileri isimle kaydedilir; tag'in kendisi silindiyse ID'si yazılır
+        SELECT t.name INTO tag_name FROM tags t WHERE t.id = (row_data ->> 'tag_id')::INTEGER;
+        tag_name := COALESCE(tag_name, '#' || (row_data ->> 'tag_id'));
+        audit_changes := content_audit_diff(
+            CASE WHEN old_row IS NULL THEN NULL ELSE jsonb_build_object('tag', tag_name) END,
+            CASE WHEN new_row IS NULL THEN NULL ELSE jsonb_build_object('tag', tag_name) END
+        );
+    ELSE
+        audit_changes := content_audit_diff(old_row, new_row);
+    END IF;
+
+    IF audit_changes = '{}'::jsonb THEN
+        RETURN NULL;
+    END IF;
+
+    -- Soft delete içerik için silme işlemi olarak kaydedilir
+    IF audit_table = 'contents' AND TG_OP = 'UPDATE' AND (new_row ->> 'deleted') = '1' AND audit_changes ? 'deleted' THEN
+        audit_operation := 'delete';
+    END IF;
+
+    INSERT INTO content_audit (content_id, table_name, operation, changes, actor, sync_job_id)
+    VALUES (
+        target_content_id,
+        audit_table,
+        audit_operation,
+        audit_changes,
+        NULLIF(current_setting('search_engine.audit_actor', true), ''),
+        NULLIF(current_setting('search_engine.sync_job_id', true), '')
+    );
+    RETURN NULL;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER audit_contents AFTER INSERT OR UPDATE OR DELETE ON contents
+    FOR EACH ROW EXECUTE FUNCTION audit_content_change('contents');
+
+CREATE TRIGGER audit_content_stats AFTER INSERT OR UPDATE OR DELETE ON content_stats
+    FOR EACH ROW EXECUTE FUNCTION audit_content_change('content_stats');
+
+CREATE TRIGGER audit_content_scores AFTER INSERT OR UPDATE OR DELETE ON content_scores
+    FOR EACH ROW EXECUTE FUNCTION audit_content_change('content_scores');
+
+CREATE TRIGGER audit_content_tags AFTER INSERT OR DELETE ON content_tags
+    FOR EACH ROW EXECUTE FUNCTION audit_content_change('content_tags');
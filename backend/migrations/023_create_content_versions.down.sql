@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS record_content_stats_version ON content_stats;
+DROP TRIGGER IF EXISTS delete_contents_versions ON contents;
+DROP TRIGGER IF EXISTS record_contents_version ON contents;
+DROP FUNCTION IF EXISTS record_content_version();
+DROP TABLE IF EXISTS content_versions;
@@ -0,0 +1,61 @@
+-- İçerik versiyonları: provider güncellemesi başlık, açıklama veya istatistikleri değiştirdiğinde içeriğin önceki
+-- hali saklanır; metriklerdeki açıklanamayan sıçramaları incelemek için kullanılır.
+-- Aynı transaction'daki değişiklikler (sync'te içerik ve istatistiklerin ayrı yazılması) tek versiyon oluşturur
+CREATE TABLE IF NOT EXISTS content_versions (
+    id BIGSERIAL PRIMARY KEY,
+    content_id INTEGER NOT NULL,
+    title TEXT NOT NULL,
+    description TEXT,
+    -- İçeriğin o andaki istatistikleri; istatistiği yoksa NULL
+    views BIGINT,
+    likes INTEGER,
+    reading_time INTEGER,
+    reactions INTEGER,
+    -- Bu halin yerini yenisinin aldığı zaman
+    replaced_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    transaction_id BIGINT NOT NULL DEFAULT txid_current(),
+    UNIQUE (content_id, transaction_id)
+);
+
+-- Trigger: değişiklikten önceki hali versiyon olarak sakla
+-- Değişen tablonun eski satırı (OLD), diğer tablonun güncel satırıyla birleştirilir. Transaction'da diğer tablo
+-- daha önce değiştiyse o değişiklik versiyonu zaten oluşturmuştur (ON CONFLICT DO NOTHING)
+CREATE OR REPLACE FUNCTION record_content_version()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_ARGV[0] = 'contents' THEN
+        -- Fiziksel olarak silinen içeriğin (provider silme) versiyonları da silinir
+        IF TG_OP = 'DELETE' THEN
+            DELETE FROM content_versions WHERE content_id = OLD.id;
+            RETURN NULL;
+        END IF;
+
+        INSERT INTO content_versions (content_id, title, description, views, likes, reading_time, reactions)
+        SELECT OLD.id, OLD.title, OLD.description, s.views, s.likes, s.reading_time, s.reactions
+        FROM (SELECT 1) AS one
+        LEFT JOIN content_stats s ON s.content_id = OLD.id
+        ON CONFLICT (content_id, transaction_id) DO NOTHING;
+    ELSE
+        INSERT INTO content_versions (content_id, title, description, views, likes, reading_time, reactions)
+        SELECT c.id, c.title, c.description, OLD.views, OLD.likes, OLD.reading_time, OLD.reactions
+        FROM contents c
+        WHERE c.id = OLD.content_id
+        ON CONFLICT (content_id, transaction_id) DO NOTHING;
+    END IF;
+    RETURN NULL;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER record_contents_version AFTER UPDATE OF title, description ON contents
+    FOR EACH ROW
+    WHEN (OLD.title IS DISTINCT FROM NEW.title OR OLD.description IS DISTINCT FROM NEW.description)
+    EXECUTE FUNCTION record_content_version('contents');
+
+CREATE TRIGGER delete_contents_versions AFTER DELETE ON contents
+    FOR EACH ROW EXECUTE FUNCTION record_content_version('contents');
+
+CREATE TRIGGER record_content_stats_version AFTER UPDATE ON content_stats
+    FOR EACH ROW
+    WHEN (OLD.views IS DISTINCT FROM NEW.views OR OLD.likes IS DISTINCT FROM NEW.likes
+        OR OLD.reading_time IS DISTINCT FROM NEW.reading_time OR OLD.reactions IS DISTINCT FROM NEW.reactions)
+    EXECUTE FUNCTION record_content_version('content_stats');
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_contents_author;
+ALTER TABLE IF EXISTS contents DROP COLUMN IF EXISTS author_id;
+DROP TABLE IF EXISTS authors;
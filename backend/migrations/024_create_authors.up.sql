@@ -0,0 +1,22 @@
+-- Authors tablosu: Provider'ların içerikle birlikte gönderdiği yazar/kanal bilgilerini tutar
+-- external_id provider'daki yazar ID'sidir; provider ID göndermiyorsa yazar adı kullanılır
+CREATE TABLE IF NOT EXISTS authors (
+    id SERIAL PRIMARY KEY,
+    provider_id INTEGER NOT NULL REFERENCES providers(id) ON DELETE CASCADE,
+    external_id VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    url TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(provider_id, external_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_authors_name ON authors (LOWER(name));
+
+CREATE TRIGGER update_authors_updated_at BEFORE UPDATE ON authors
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- İçeriğin yazarı; yazar bilgisi gelmeyen içeriklerde NULL
+ALTER TABLE contents ADD COLUMN IF NOT EXISTS author_id INTEGER REFERENCES authors(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_contents_author ON contents(author_id) WHERE author_id IS NOT NULL;
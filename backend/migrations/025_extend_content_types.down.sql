@@ -0,0 +1,5 @@
+-- Eski kısıt sadece video ve article'ı kabul ettiğinden önce diğer türlerdeki içerikler silinir
+DELETE FROM contents WHERE content_type NOT IN ('video', 'article');
+ALTER TABLE contents DROP CONSTRAINT IF EXISTS contents_content_type_format;
+ALTER TABLE contents DROP CONSTRAINT IF EXISTS contents_content_type_check;
+ALTER TABLE contents ADD CONSTRAINT contents_content_type_check CHECK (content_type IN ('video', 'article'));
@@ -0,0 +1,5 @@
+-- İçerik türleri uygulamada kayıtlı türlerle (video, article, podcast, course, image ve sonradan eklenenler)
+-- doğrulandığından sabit liste kısıtı kaldırılır, sadece tür adının biçimi kontrol edilir
+ALTER TABLE contents DROP CONSTRAINT IF EXISTS contents_content_type_check;
+ALTER TABLE contents DROP CONSTRAINT IF EXISTS contents_content_type_format;
+ALTER TABLE contents ADD CONSTRAINT contents_content_type_format CHECK (content_type ~ '^[a-z][a-z0-9_-]*$');
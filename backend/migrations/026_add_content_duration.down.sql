@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS scoring_rules DROP COLUMN IF EXISTS video_duration_weight;
+ALTER TABLE IF EXISTS content_stats DROP COLUMN IF EXISTS duration_seconds;
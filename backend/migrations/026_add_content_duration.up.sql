@@ -0,0 +1,7 @@
+-- Video/podcast süresi saniye cinsinden; provider süre vermediyse 0
+ALTER TABLE content_stats
+    ADD COLUMN IF NOT EXISTS duration_seconds INTEGER NOT NULL DEFAULT 0 CHECK (duration_seconds >= 0);
+
+-- Skorlamada video süresinin base skora katkısı (dakika başına); 0 bırakılırsa config'deki değer kullanılır
+ALTER TABLE scoring_rules
+    ADD COLUMN IF NOT EXISTS video_duration_weight DOUBLE PRECISION NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS scoring_rules DROP COLUMN IF EXISTS comment_weight;
+ALTER TABLE IF EXISTS content_stats DROP COLUMN IF EXISTS comments;
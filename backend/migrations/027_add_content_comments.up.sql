@@ -0,0 +1,7 @@
+-- Yorum sayısı (XML provider stats.comments); provider vermediyse 0
+ALTER TABLE content_stats
+    ADD COLUMN IF NOT EXISTS comments INTEGER NOT NULL DEFAULT 0 CHECK (comments >= 0);
+
+-- Etkileşim oranında bir yorumun kaç beğeni/tepki sayılacağı; 0 bırakılırsa config'deki değer kullanılır
+ALTER TABLE scoring_rules
+    ADD COLUMN IF NOT EXISTS comment_weight DOUBLE PRECISION NOT NULL DEFAULT 0;
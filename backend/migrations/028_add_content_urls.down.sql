@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS contents DROP COLUMN IF EXISTS thumbnail_url;
+ALTER TABLE IF EXISTS contents DROP COLUMN IF EXISTS url;
@@ -0,0 +1,3 @@
+-- İçeriğin kaynak sayfası ve küçük resmi (og:image, thumbnail); provider vermediyse boş
+ALTER TABLE contents ADD COLUMN IF NOT EXISTS url TEXT NOT NULL DEFAULT '';
+ALTER TABLE contents ADD COLUMN IF NOT EXISTS thumbnail_url TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_contents_category;
+ALTER TABLE IF EXISTS contents DROP COLUMN IF EXISTS category_id;
+DROP TABLE IF EXISTS categories;
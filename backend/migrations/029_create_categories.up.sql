@@ -0,0 +1,23 @@
+-- Categories tablosu: Tag'lerden farklı olarak hiyerarşik kategoriler (ör. programming > go > concurrency)
+-- path kökten yaprağa slug'ların "/" ile birleşimidir ve kategoriyi tekil olarak belirler; kategoriler provider'lar arasında ortaktır
+CREATE TABLE IF NOT EXISTS categories (
+    id SERIAL PRIMARY KEY,
+    parent_id INTEGER REFERENCES categories(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    slug VARCHAR(100) NOT NULL,
+    path TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- category=programming filtresi alt kategorileri path öneki ile (LIKE 'programming/%') bulur
+CREATE INDEX IF NOT EXISTS idx_categories_path_prefix ON categories (path text_pattern_ops);
+CREATE INDEX IF NOT EXISTS idx_categories_parent ON categories (parent_id);
+
+CREATE TRIGGER update_categories_updated_at BEFORE UPDATE ON categories
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- İçeriğin kategorisi; kategori bilgisi gelmeyen içeriklerde NULL
+ALTER TABLE contents ADD COLUMN IF NOT EXISTS category_id INTEGER REFERENCES categories(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_contents_category ON contents(category_id) WHERE category_id IS NOT NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE IF EXISTS providers DROP COLUMN IF EXISTS auto_approve;
+DROP INDEX IF EXISTS idx_contents_status;
+ALTER TABLE IF EXISTS contents DROP COLUMN IF EXISTS status;
@@ -0,0 +1,10 @@
+-- İçerik moderasyonu: güvenilmeyen provider'ların yeni içerikleri admin onaylayana kadar aramada görünmez
+-- Mevcut içerikler ve provider'lar onaylı/güvenilir kabul edilir
+ALTER TABLE contents ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'approved'
+    CONSTRAINT contents_status_check CHECK (status IN ('pending', 'approved', 'rejected'));
+
+-- Moderasyon kuyruğu (pending/rejected) küçük tutulduğundan sadece onaysız içerikler indekslenir
+CREATE INDEX IF NOT EXISTS idx_contents_status ON contents(status, created_at) WHERE status <> 'approved';
+
+-- false ise provider'ın yeni içerikleri 'pending' olarak kaydedilir
+ALTER TABLE providers ADD COLUMN IF NOT EXISTS auto_approve BOOLEAN NOT NULL DEFAULT TRUE;
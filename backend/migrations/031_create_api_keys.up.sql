@@ -0,0 +1,11 @@
+-- Public API anahtarları; anahtarın kendisi değil SHA-256 hash'i saklanır
+-- rate_limit_per_minute 0 ise sunucunun varsayılan (RATE_LIMIT_PER_MINUTE) limiti uygulanır
+CREATE TABLE IF NOT EXISTS api_keys (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    prefix VARCHAR(16) NOT NULL,
+    key_hash CHAR(64) NOT NULL UNIQUE,
+    rate_limit_per_minute INTEGER NOT NULL DEFAULT 0 CHECK (rate_limit_per_minute >= 0),
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,20 @@
+-- API anahtarı sahiplerinin kayıtlı aramaları; sync'te sorguya uyan yeni içerik geldiğinde
+-- notify_email ve/veya notify_url'ye bildirim gönderilir. secret webhook bildirimlerinin imzası içindir
+CREATE TABLE IF NOT EXISTS saved_searches (
+    id SERIAL PRIMARY KEY,
+    api_key_id INTEGER NOT NULL REFERENCES api_keys(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    query TEXT NOT NULL,
+    tags TEXT[] NOT NULL DEFAULT '{}',
+    content_type VARCHAR(20) NOT NULL DEFAULT '',
+    provider_id INTEGER REFERENCES providers(id) ON DELETE CASCADE,
+    notify_email VARCHAR(254) NOT NULL DEFAULT '',
+    notify_url TEXT NOT NULL DEFAULT '',
+    secret VARCHAR(100) NOT NULL DEFAULT '',
+    last_notified_at TIMESTAMP,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK (notify_email <> '' OR notify_url <> '')
+);
+
+CREATE INDEX IF NOT EXISTS idx_saved_searches_api_key ON saved_searches(api_key_id);
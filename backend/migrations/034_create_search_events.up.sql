@@ -0,0 +1,22 @@
+-- Arama sonuçlarının gösterim (impression) ve tıklama (click) olayları; CTR analizi ve sıralama sinyali için
+-- Gösterimler örneklenebilir (EVENTS_IMPRESSION_SAMPLE_RATE); sample_weight örnekleme oranının tersidir,
+-- toplam gösterim SUM(sample_weight) ile tahmin edilir. Tıklamalar her zaman 1 ağırlıkla kaydedilir
+--
+-- content_id'de foreign key yoktur (content_versions gibi): contents partition'landığında (migrations/partitioning)
+-- contents(id)'ye referans verilemez. Bilinmeyen içeriklerin olayları insert sırasında contents ile join'lenerek elenir
+CREATE TABLE IF NOT EXISTS search_events (
+    id BIGSERIAL PRIMARY KEY,
+    event_type VARCHAR(20) NOT NULL CHECK (event_type IN ('impression', 'click')),
+    content_id INTEGER NOT NULL,
+    -- Normalize edilmiş arama sorgusu; arama dışı gösterimlerde (ör. benzer içerikler) boş
+    query TEXT NOT NULL DEFAULT '',
+    -- Sonuç listesindeki 1 tabanlı sıra; bilinmiyorsa NULL
+    position INTEGER CHECK (position > 0),
+    session_id VARCHAR(64) NOT NULL DEFAULT '',
+    api_key_id INTEGER REFERENCES api_keys(id) ON DELETE SET NULL,
+    sample_weight REAL NOT NULL DEFAULT 1 CHECK (sample_weight >= 1),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_search_events_content_created ON search_events(content_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_search_events_created ON search_events(created_at);
@@ -0,0 +1,4 @@
+ALTER TABLE IF EXISTS scoring_rules DROP COLUMN IF EXISTS ctr_weight;
+ALTER TABLE IF EXISTS content_scores
+    DROP COLUMN IF EXISTS ctr_score,
+    DROP COLUMN IF EXISTS ctr_lift;
@@ -0,0 +1,11 @@
+-- Tıklama oranı (CTR) sıralama sinyali; search_events'ten gecelik iş (RefreshCTRSignalsUseCase) ile hesaplanır
+-- ctr_lift: global CTR'a doğru yumuşatılmış içerik CTR'ının global CTR'a oranı (1 ortalama),
+--           yeterli gösterimi olmayan içerikler için 0 (sinyal yok). Sadece gecelik iş yazar, sync değiştirmez
+-- ctr_score: ctr_lift'ten hesaplanan ve final_score'a eklenen bileşen
+ALTER TABLE content_scores
+    ADD COLUMN IF NOT EXISTS ctr_lift DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (ctr_lift >= 0),
+    ADD COLUMN IF NOT EXISTS ctr_score DECIMAL(10,2) NOT NULL DEFAULT 0;
+
+-- CTR bileşeninin ağırlığı; 0 bırakılırsa config'deki değer (varsayılan: 0, kapalı) kullanılır
+ALTER TABLE scoring_rules
+    ADD COLUMN IF NOT EXISTS ctr_weight DOUBLE PRECISION NOT NULL DEFAULT 0;
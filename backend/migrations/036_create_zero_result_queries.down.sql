@@ -0,0 +1 @@
+DROP TABLE IF EXISTS zero_result_queries;
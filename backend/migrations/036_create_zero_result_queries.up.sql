@@ -0,0 +1,12 @@
+-- Sonuç döndürmeyen arama sorguları; eş anlamlı veya yeni provider adaylarını bulmak için
+-- Sadece filtresiz aramaların ilk sayfası sayılır, sorgular sabitlemelerle aynı şekilde normalize edilir
+-- Günlük satırlar raporun gün penceresine göre toplanabilmesi içindir; eski günler gerekirse day ile silinebilir
+CREATE TABLE IF NOT EXISTS zero_result_queries (
+    query TEXT NOT NULL,
+    day DATE NOT NULL,
+    search_count BIGINT NOT NULL DEFAULT 1 CHECK (search_count > 0),
+    last_seen_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (query, day)
+);
+
+CREATE INDEX IF NOT EXISTS idx_zero_result_queries_day ON zero_result_queries(day);
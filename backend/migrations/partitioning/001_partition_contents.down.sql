@@ -0,0 +1,117 @@
+-- Partition'lı contents ve alt tablolarını tekrar tek tablolu yapıya (numaralı migration'ların şeması) döndürür
+
+BEGIN;
+
+LOCK TABLE contents, content_stats, content_scores, content_tags IN ACCESS EXCLUSIVE MODE;
+
+ALTER SEQUENCE contents_id_seq OWNED BY NONE;
+ALTER SEQUENCE content_stats_id_seq OWNED BY NONE;
+ALTER SEQUENCE content_scores_id_seq OWNED BY NONE;
+
+ALTER TABLE contents RENAME TO contents_partitioned;
+ALTER TABLE content_stats RENAME TO content_stats_partitioned;
+ALTER TABLE content_scores RENAME TO content_scores_partitioned;
+ALTER TABLE content_tags RENAME TO content_tags_partitioned;
+
+CREATE TABLE contents (LIKE contents_partitioned INCLUDING DEFAULTS INCLUDING CONSTRAINTS);
+CREATE TABLE content_stats (LIKE content_stats_partitioned INCLUDING DEFAULTS INCLUDING CONSTRAINTS);
+CREATE TABLE content_scores (LIKE content_scores_partitioned INCLUDING DEFAULTS INCLUDING CONSTRAINTS);
+CREATE TABLE content_tags (LIKE content_tags_partitioned INCLUDING DEFAULTS INCLUDING CONSTRAINTS);
+
+INSERT INTO contents SELECT * FROM contents_partitioned;
+INSERT INTO content_stats SELECT * FROM content_stats_partitioned;
+INSERT INTO content_scores SELECT * FROM content_scores_partitioned;
+INSERT INTO content_tags SELECT * FROM content_tags_partitioned;
+
+-- Partition'lar üst tabloyla birlikte silinir
+DROP TABLE content_tags_partitioned, content_scores_partitioned, content_stats_partitioned, contents_partitioned;
+
+DROP FUNCTION IF EXISTS maintain_contents_partitions();
+DROP FUNCTION IF EXISTS ensure_contents_partition(INTEGER);
+DROP FUNCTION IF EXISTS delete_removed_contents_children();
+DROP FUNCTION IF EXISTS check_content_references();
+
+ALTER SEQUENCE contents_id_seq OWNED BY contents.id;
+ALTER SEQUENCE content_stats_id_seq OWNED BY content_stats.id;
+ALTER SEQUENCE content_scores_id_seq OWNED BY content_scores.id;
+
+ALTER TABLE contents ADD PRIMARY KEY (id);
+ALTER TABLE contents ADD UNIQUE (provider_id, provider_content_id);
+ALTER TABLE contents ADD FOREIGN KEY (provider_id) REFERENCES providers(id) ON DELETE CASCADE;
+ALTER TABLE contents ADD FOREIGN KEY (canonical_content_id) REFERENCES contents(id) ON DELETE SET NULL;
+ALTER TABLE contents ADD FOREIGN KEY (author_id) REFERENCES authors(id) ON DELETE SET NULL;
+ALTER TABLE contents ADD FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE SET NULL;
+ALTER TABLE content_stats ADD PRIMARY KEY (id);
+ALTER TABLE content_stats ADD UNIQUE (content_id);
+ALTER TABLE content_stats ADD FOREIGN KEY (content_id) REFERENCES contents(id) ON DELETE CASCADE;
+ALTER TABLE content_scores ADD PRIMARY KEY (id);
+ALTER TABLE content_scores ADD UNIQUE (content_id);
+ALTER TABLE content_scores ADD FOREIGN KEY (content_id) REFERENCES contents(id) ON DELETE CASCADE;
+ALTER TABLE content_tags ADD PRIMARY KEY (content_id, tag_id);
+ALTER TABLE content_tags ADD FOREIGN KEY (content_id) REFERENCES contents(id) ON DELETE CASCADE;
+ALTER TABLE content_tags ADD FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_contents_title ON contents USING GIN (to_tsvector('english', title));
+CREATE INDEX idx_contents_title_pattern ON contents (title text_pattern_ops);
+CREATE INDEX idx_contents_title_trgm ON contents USING GIN (title gin_trgm_ops);
+CREATE INDEX idx_contents_title_unaccent_trgm ON contents USING GIN (immutable_unaccent(title) gin_trgm_ops);
+CREATE INDEX idx_contents_search_vector ON contents USING GIN (search_vector);
+CREATE INDEX idx_contents_type ON contents(content_type);
+CREATE INDEX idx_contents_published ON contents(published_at DESC);
+CREATE INDEX idx_contents_provider ON contents(provider_id);
+CREATE INDEX idx_contents_deleted ON contents(deleted);
+CREATE INDEX idx_contents_canonical ON contents(canonical_content_id) WHERE canonical_content_id IS NOT NULL;
+CREATE INDEX idx_contents_author ON contents(author_id) WHERE author_id IS NOT NULL;
+CREATE INDEX idx_contents_category ON contents(category_id) WHERE category_id IS NOT NULL;
+CREATE INDEX idx_contents_status ON contents(status, created_at) WHERE status <> 'approved';
+CREATE INDEX idx_stats_views ON content_stats(views DESC);
+CREATE INDEX idx_stats_content_id ON content_stats(content_id);
+CREATE INDEX idx_scores_final ON content_scores(final_score DESC);
+CREATE INDEX idx_scores_content_id ON content_scores(content_id);
+CREATE INDEX idx_content_tags_content ON content_tags(content_id);
+CREATE INDEX idx_content_tags_tag ON content_tags(tag_id);
+
+CREATE TRIGGER update_contents_updated_at BEFORE UPDATE ON contents
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_content_stats_updated_at BEFORE UPDATE ON content_stats
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_contents_search_vector BEFORE INSERT OR UPDATE OF title, language ON contents
+    FOR EACH ROW EXECUTE FUNCTION update_contents_search_vector();
+
+CREATE TRIGGER refresh_search_vector_on_tag_insert AFTER INSERT ON content_tags
+    REFERENCING NEW TABLE AS changed_tags
+    FOR EACH STATEMENT EXECUTE FUNCTION refresh_tagged_contents_search_vector();
+
+CREATE TRIGGER refresh_search_vector_on_tag_delete AFTER DELETE ON content_tags
+    REFERENCING OLD TABLE AS removed_tags
+    FOR EACH STATEMENT EXECUTE FUNCTION refresh_tagged_contents_search_vector();
+
+CREATE TRIGGER audit_contents AFTER INSERT OR UPDATE OR DELETE ON contents
+    FOR EACH ROW EXECUTE FUNCTION audit_content_change('contents');
+
+CREATE TRIGGER audit_content_stats AFTER INSERT OR UPDATE OR DELETE ON content_stats
+    FOR EACH ROW EXECUTE FUNCTION audit_content_change('content_stats');
+
+CREATE TRIGGER audit_content_scores AFTER INSERT OR UPDATE OR DELETE ON content_scores
+    FOR EACH ROW EXECUTE FUNCTION audit_content_change('content_scores');
+
+CREATE TRIGGER audit_content_tags AFTER INSERT OR DELETE ON content_tags
+    FOR EACH ROW EXECUTE FUNCTION audit_content_change('content_tags');
+
+CREATE TRIGGER record_contents_version AFTER UPDATE OF title, description ON contents
+    FOR EACH ROW
+    WHEN (OLD.title IS DISTINCT FROM NEW.title OR OLD.description IS DISTINCT FROM NEW.description)
+    EXECUTE FUNCTION record_content_version('contents');
+
+CREATE TRIGGER delete_contents_versions AFTER DELETE ON contents
+    FOR EACH ROW EXECUTE FUNCTION record_content_version('contents');
+
+CREATE TRIGGER record_content_stats_version AFTER UPDATE ON content_stats
+    FOR EACH ROW
+    WHEN (OLD.views IS DISTINCT FROM NEW.views OR OLD.likes IS DISTINCT FROM NEW.likes
+        OR OLD.reading_time IS DISTINCT FROM NEW.reading_time OR OLD.reactions IS DISTINCT FROM NEW.reactions)
+    EXECUTE FUNCTION record_content_version('content_stats');
+
+COMMIT;
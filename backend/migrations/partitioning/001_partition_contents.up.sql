@@ -0,0 +1,277 @@
+-- contents tablosunu provider_id'ye göre LIST, alt tablolarını (content_stats, content_scores,
+-- content_tags) content_id'ye göre HASH partition'lı tablolara dönüştürür.
+--
+-- Bu migration opsiyoneldir ve otomatik uygulanmaz (docker-entrypoint-initdb.d alt dizinleri çalıştırmaz).
+-- Provider başına milyonlarca içerik olan kurulumlar için, tüm numaralı migration'lardan sonra elle çalıştırılır:
+--
+--   psql "$DATABASE_URL" -v ON_ERROR_STOP=1 -f migrations/partitioning/001_partition_contents.up.sql
+--
+-- Tablolar kopyalanırken ACCESS EXCLUSIVE kilit alınır; büyük tablolarda bakım penceresinde çalıştırılmalıdır.
+-- Repository sorguları değişmez: tablo ve sütun adları, ON CONFLICT hedefleri ve sequence'ler aynı kalır.
+--
+-- Partition'lı tablolarda unique kısıtlar partition anahtarını içermek zorunda olduğundan contents.id tek
+-- başına unique olamaz ve contents(id)'ye foreign key tanımlanamaz. Bu yüzden:
+--   * contents birincil anahtarı (provider_id, id) olur; id hâlâ contents_id_seq'ten gelir
+--   * alt tablolardaki ON DELETE CASCADE ve canonical_content_id ON DELETE SET NULL trigger ile yapılır
+--   * alt tablolara yazılan content_id'lerin varlığı trigger ile kontrol edilir (foreign_key_violation)
+
+BEGIN;
+
+LOCK TABLE contents, content_stats, content_scores, content_tags IN ACCESS EXCLUSIVE MODE;
+
+-- Sequence'ler eski tablolarla birlikte silinmesin
+ALTER SEQUENCE contents_id_seq OWNED BY NONE;
+ALTER SEQUENCE content_stats_id_seq OWNED BY NONE;
+ALTER SEQUENCE content_scores_id_seq OWNED BY NONE;
+
+ALTER TABLE contents RENAME TO contents_unpartitioned;
+ALTER TABLE content_stats RENAME TO content_stats_unpartitioned;
+ALTER TABLE content_scores RENAME TO content_scores_unpartitioned;
+ALTER TABLE content_tags RENAME TO content_tags_unpartitioned;
+
+-- Yeni tablolar sütunları, varsayılanları ve CHECK kısıtlarını eskilerinden alır
+CREATE TABLE contents (LIKE contents_unpartitioned INCLUDING DEFAULTS INCLUDING CONSTRAINTS)
+    PARTITION BY LIST (provider_id);
+CREATE TABLE content_stats (LIKE content_stats_unpartitioned INCLUDING DEFAULTS INCLUDING CONSTRAINTS)
+    PARTITION BY HASH (content_id);
+CREATE TABLE content_scores (LIKE content_scores_unpartitioned INCLUDING DEFAULTS INCLUDING CONSTRAINTS)
+    PARTITION BY HASH (content_id);
+CREATE TABLE content_tags (LIKE content_tags_unpartitioned INCLUDING DEFAULTS INCLUDING CONSTRAINTS)
+    PARTITION BY HASH (content_id);
+
+-- Henüz partition'ı olmayan provider'ların içerikleri default partition'a düşer
+CREATE TABLE contents_default PARTITION OF contents DEFAULT;
+
+-- ensure_contents_partition bir provider için contents partition'ını (contents_p<provider_id>) oluşturur.
+-- Provider'ın default partition'da biriken satırları varsa yeni partition'a taşınır; taşıma sırasında
+-- default partition ayrılır, yani contents kısa süreliğine kilitlenir
+CREATE OR REPLACE FUNCTION ensure_contents_partition(target_provider_id INTEGER)
+RETURNS BOOLEAN AS $$
+DECLARE
+    partition_name TEXT := format('contents_p%s', target_provider_id);
+BEGIN
+    IF to_regclass(partition_name) IS NOT NULL THEN
+        RETURN false;
+    END IF;
+
+    IF NOT EXISTS (SELECT 1 FROM contents_default WHERE provider_id = target_provider_id) THEN
+        EXECUTE format('CREATE TABLE %I PARTITION OF contents FOR VALUES IN (%s)', partition_name, target_provider_id);
+        RETURN true;
+    END IF;
+
+    -- Default partition eşleşen satır içerirken yeni partition oluşturulamaz: ayır, taşı, geri bağla.
+    -- Ayrılmış tablodan silme contents üzerindeki trigger'ları tetiklemez, alt kayıtlar korunur.
+    -- Taşınan satırlar yeni içerik değildir, audit kaydı oluşturmaz
+    ALTER TABLE contents DETACH PARTITION contents_default;
+    EXECUTE format('CREATE TABLE %I PARTITION OF contents FOR VALUES IN (%s)', partition_name, target_provider_id);
+    PERFORM set_config('search_engine.audit_disabled', 'on', true);
+    INSERT INTO contents SELECT * FROM contents_default WHERE provider_id = target_provider_id;
+    PERFORM set_config('search_engine.audit_disabled', '', true);
+    DELETE FROM contents_default WHERE provider_id = target_provider_id;
+    ALTER TABLE contents ATTACH PARTITION contents_default DEFAULT;
+    RETURN true;
+END;
+$$ language 'plpgsql';
+
+-- maintain_contents_partitions partition bakım işidir; periyodik olarak (ör. günde bir, pg_cron veya cron ile)
+-- ve yeni provider eklendikten sonra çalıştırılır:
+--
+--   SELECT * FROM maintain_contents_partitions();
+--
+--   * partition'ı olmayan her provider için partition oluşturur (default partition'daki satırlarını taşıyarak)
+--   * silinmiş provider'ların boş kalan partition'larını kaldırır
+--
+-- Yapılan işlemleri (action, partition_name) olarak döner. Partition oluşturma/kaldırma contents üzerinde kısa
+-- süreli ACCESS EXCLUSIVE kilit alır; uzun süren sorgular bitene kadar bekler ve bu sürede aramaları bekletir
+CREATE OR REPLACE FUNCTION maintain_contents_partitions()
+RETURNS TABLE (action TEXT, partition_name TEXT) AS $$
+DECLARE
+    provider RECORD;
+    orphan RECORD;
+    has_rows BOOLEAN;
+BEGIN
+    FOR provider IN SELECT id FROM providers ORDER BY id LOOP
+        IF ensure_contents_partition(provider.id) THEN
+            action := 'created';
+            partition_name := format('contents_p%s', provider.id);
+            RETURN NEXT;
+        END IF;
+    END LOOP;
+
+    FOR orphan IN
+        SELECT child.relname
+        FROM pg_inherits i
+        JOIN pg_class child ON child.oid = i.inhrelid
+        WHERE i.inhparent = 'contents'::regclass
+          AND child.relname ~ '^contents_p[0-9]+$'
+          AND NOT EXISTS (SELECT 1 FROM providers p WHERE format('contents_p%s', p.id) = child.relname)
+    LOOP
+        EXECUTE format('SELECT EXISTS (SELECT 1 FROM %I)', orphan.relname) INTO has_rows;
+        IF NOT has_rows THEN
+            EXECUTE format('DROP TABLE %I', orphan.relname);
+            action := 'dropped';
+            partition_name := orphan.relname;
+            RETURN NEXT;
+        END IF;
+    END LOOP;
+END;
+$$ language 'plpgsql';
+
+-- Mevcut provider'lar için partition'lar veri kopyalanmadan önce oluşturulur (taşıma gerekmez)
+SELECT ensure_contents_partition(id) FROM providers;
+
+-- Alt tablolar sabit sayıda hash partition'a bölünür; sayı sonradan değiştirilecekse tablo yeniden oluşturulmalıdır
+DO $$
+BEGIN
+    FOR i IN 0..7 LOOP
+        EXECUTE format('CREATE TABLE content_stats_p%s PARTITION OF content_stats FOR VALUES WITH (MODULUS 8, REMAINDER %s)', i, i);
+        EXECUTE format('CREATE TABLE content_scores_p%s PARTITION OF content_scores FOR VALUES WITH (MODULUS 8, REMAINDER %s)', i, i);
+        EXECUTE format('CREATE TABLE content_tags_p%s PARTITION OF content_tags FOR VALUES WITH (MODULUS 8, REMAINDER %s)', i, i);
+    END LOOP;
+END;
+$$;
+
+-- Veriyi kopyala (trigger'lar henüz yok; search_vector olduğu gibi taşınır)
+INSERT INTO contents SELECT * FROM contents_unpartitioned;
+INSERT INTO content_stats SELECT * FROM content_stats_unpartitioned;
+INSERT INTO content_scores SELECT * FROM content_scores_unpartitioned;
+INSERT INTO content_tags SELECT * FROM content_tags_unpartitioned;
+
+DROP TABLE content_tags_unpartitioned, content_scores_unpartitioned, content_stats_unpartitioned, contents_unpartitioned;
+
+ALTER SEQUENCE contents_id_seq OWNED BY contents.id;
+ALTER SEQUENCE content_stats_id_seq OWNED BY content_stats.id;
+ALTER SEQUENCE content_scores_id_seq OWNED BY content_scores.id;
+
+-- Kısıtlar: ON CONFLICT (provider_id, provider_content_id) ve ON CONFLICT (content_id) hedefleri korunur
+ALTER TABLE contents ADD PRIMARY KEY (provider_id, id);
+ALTER TABLE contents ADD UNIQUE (provider_id, provider_content_id);
+ALTER TABLE contents ADD FOREIGN KEY (provider_id) REFERENCES providers(id) ON DELETE CASCADE;
+ALTER TABLE contents ADD FOREIGN KEY (author_id) REFERENCES authors(id) ON DELETE SET NULL;
+ALTER TABLE contents ADD FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE SET NULL;
+ALTER TABLE content_stats ADD PRIMARY KEY (content_id);
+ALTER TABLE content_scores ADD PRIMARY KEY (content_id);
+ALTER TABLE content_tags ADD PRIMARY KEY (content_id, tag_id);
+ALTER TABLE content_tags ADD FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE;
+
+-- İndeksler (partition'lara otomatik uygulanır). idx_contents_id id ile erişimleri (FindByID, join'ler) karşılar;
+-- provider_id indeksi birincil anahtarın ilk sütunu olduğu için ayrıca oluşturulmaz
+CREATE INDEX idx_contents_id ON contents(id);
+CREATE INDEX idx_contents_title ON contents USING GIN (to_tsvector('english', title));
+CREATE INDEX idx_contents_title_pattern ON contents (title text_pattern_ops);
+CREATE INDEX idx_contents_title_trgm ON contents USING GIN (title gin_trgm_ops);
+CREATE INDEX idx_contents_title_unaccent_trgm ON contents USING GIN (immutable_unaccent(title) gin_trgm_ops);
+CREATE INDEX idx_contents_search_vector ON contents USING GIN (search_vector);
+CREATE INDEX idx_contents_type ON contents(content_type);
+CREATE INDEX idx_contents_published ON contents(published_at DESC);
+CREATE INDEX idx_contents_deleted ON contents(deleted);
+CREATE INDEX idx_contents_canonical ON contents(canonical_content_id) WHERE canonical_content_id IS NOT NULL;
+CREATE INDEX idx_contents_author ON contents(author_id) WHERE author_id IS NOT NULL;
+CREATE INDEX idx_contents_category ON contents(category_id) WHERE category_id IS NOT NULL;
+CREATE INDEX idx_contents_status ON contents(status, created_at) WHERE status <> 'approved';
+CREATE INDEX idx_stats_views ON content_stats(views DESC);
+CREATE INDEX idx_scores_final ON content_scores(final_score DESC);
+CREATE INDEX idx_content_tags_tag ON content_tags(tag_id);
+
+-- Mevcut trigger'ları yeni tablolarda yeniden oluştur
+CREATE TRIGGER update_contents_updated_at BEFORE UPDATE ON contents
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_content_stats_updated_at BEFORE UPDATE ON content_stats
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_contents_search_vector BEFORE INSERT OR UPDATE OF title, language ON contents
+    FOR EACH ROW EXECUTE FUNCTION update_contents_search_vector();
+
+CREATE TRIGGER refresh_search_vector_on_tag_insert AFTER INSERT ON content_tags
+    REFERENCING NEW TABLE AS changed_tags
+    FOR EACH STATEMENT EXECUTE FUNCTION refresh_tagged_contents_search_vector();
+
+CREATE TRIGGER refresh_search_vector_on_tag_delete AFTER DELETE ON content_tags
+    REFERENCING OLD TABLE AS removed_tags
+    FOR EACH STATEMENT EXECUTE FUNCTION refresh_tagged_contents_search_vector();
+
+CREATE TRIGGER audit_contents AFTER INSERT OR UPDATE OR DELETE ON contents
+    FOR EACH ROW EXECUTE FUNCTION audit_content_change('contents');
+
+CREATE TRIGGER audit_content_stats AFTER INSERT OR UPDATE OR DELETE ON content_stats
+    FOR EACH ROW EXECUTE FUNCTION audit_content_change('content_stats');
+
+CREATE TRIGGER audit_content_scores AFTER INSERT OR UPDATE OR DELETE ON content_scores
+    FOR EACH ROW EXECUTE FUNCTION audit_content_change('content_scores');
+
+CREATE TRIGGER audit_content_tags AFTER INSERT OR DELETE ON content_tags
+    FOR EACH ROW EXECUTE FUNCTION audit_content_change('content_tags');
+
+CREATE TRIGGER record_contents_version AFTER UPDATE OF title, description ON contents
+    FOR EACH ROW
+    WHEN (OLD.title IS DISTINCT FROM NEW.title OR OLD.description IS DISTINCT FROM NEW.description)
+    EXECUTE FUNCTION record_content_version('contents');
+
+CREATE TRIGGER delete_contents_versions AFTER DELETE ON contents
+    FOR EACH ROW EXECUTE FUNCTION record_content_version('contents');
+
+CREATE TRIGGER record_content_stats_version AFTER UPDATE ON content_stats
+    FOR EACH ROW
+    WHEN (OLD.views IS DISTINCT FROM NEW.views OR OLD.likes IS DISTINCT FROM NEW.likes
+        OR OLD.reading_time IS DISTINCT FROM NEW.reading_time OR OLD.reactions IS DISTINCT FROM NEW.reactions)
+    EXECUTE FUNCTION record_content_version('content_stats');
+
+-- Trigger: silinen içeriklerin alt kayıtlarını sil, kopyalarının kanonik referansını temizle (foreign key yerine)
+-- Statement seviyesinde çalışır; provider silinince cascade ile silinen içerikler de toplu işlenir.
+-- Aynı id hâlâ contents'te varsa (satır partition'lar arasında taşındıysa) alt kayıtlara dokunulmaz
+CREATE OR REPLACE FUNCTION delete_removed_contents_children()
+RETURNS TRIGGER AS $$
+BEGIN
+    DELETE FROM content_stats s USING removed_contents r
+    WHERE s.content_id = r.id AND NOT EXISTS (SELECT 1 FROM contents c WHERE c.id = r.id);
+
+    DELETE FROM content_scores s USING removed_contents r
+    WHERE s.content_id = r.id AND NOT EXISTS (SELECT 1 FROM contents c WHERE c.id = r.id);
+
+    DELETE FROM content_tags t USING removed_contents r
+    WHERE t.content_id = r.id AND NOT EXISTS (SELECT 1 FROM contents c WHERE c.id = r.id);
+
+    UPDATE contents d SET canonical_content_id = NULL
+    FROM removed_contents r
+    WHERE d.canonical_content_id = r.id AND NOT EXISTS (SELECT 1 FROM contents c WHERE c.id = r.id);
+    RETURN NULL;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER delete_contents_children AFTER DELETE ON contents
+    REFERENCING OLD TABLE AS removed_contents
+    FOR EACH STATEMENT EXECUTE FUNCTION delete_removed_contents_children();
+
+-- Trigger: alt tablolara yazılan content_id'lerin contents'te var olduğunu kontrol et (foreign key yerine)
+CREATE OR REPLACE FUNCTION check_content_references()
+RETURNS TRIGGER AS $$
+DECLARE
+    missing_id INTEGER;
+BEGIN
+    SELECT n.content_id INTO missing_id
+    FROM new_rows n
+    WHERE NOT EXISTS (SELECT 1 FROM contents c WHERE c.id = n.content_id)
+    LIMIT 1;
+
+    IF missing_id IS NOT NULL THEN
+        RAISE EXCEPTION 'insert on table "%" violates reference to contents: content_id=% does not exist', TG_TABLE_NAME, missing_id
+            USING ERRCODE = 'foreign_key_violation';
+    END IF;
+    RETURN NULL;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER check_content_stats_content AFTER INSERT ON content_stats
+    REFERENCING NEW TABLE AS new_rows
+    FOR EACH STATEMENT EXECUTE FUNCTION check_content_references();
+
+CREATE TRIGGER check_content_scores_content AFTER INSERT ON content_scores
+    REFERENCING NEW TABLE AS new_rows
+    FOR EACH STATEMENT EXECUTE FUNCTION check_content_references();
+
+CREATE TRIGGER check_content_tags_content AFTER INSERT ON content_tags
+    REFERENCING NEW TABLE AS new_rows
+    FOR EACH STATEMENT EXECUTE FUNCTION check_content_references();
+
+COMMIT;
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// apiKeyAuth gates provider endpoints behind an API key. Keys come from
+// MOCK_API_KEYS (comma separated) and are read from MOCK_API_KEY_HEADER
+// (default X-API-Key) or an "Authorization: Bearer <key>" header.
+// Auth is disabled when MOCK_API_KEYS is empty.
+type apiKeyAuth struct {
+	header string
+	keys   []string
+}
+
+var providerAuth = loadAPIKeyAuth()
+
+func loadAPIKeyAuth() apiKeyAuth {
+	auth := apiKeyAuth{header: os.Getenv("MOCK_API_KEY_HEADER")}
+	if auth.header == "" {
+		auth.header = "X-API-Key"
+	}
+	for _, key := range strings.Split(os.Getenv("MOCK_API_KEYS"), ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			auth.keys = append(auth.keys, key)
+		}
+	}
+	return auth
+}
+
+func (a apiKeyAuth) enabled() bool {
+	return len(a.keys) > 0
+}
+
+// presentedKey returns the key sent by the client, or "" if none was sent
+func (a apiKeyAuth) presentedKey(r *http.Request) string {
+	if key := r.Header.Get(a.header); key != "" {
+		return key
+	}
+	if bearer, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+		return strings.TrimSpace(bearer)
+	}
+	return ""
+}
+
+func (a apiKeyAuth) valid(key string) bool {
+	for _, k := range a.keys {
+		if subtle.ConstantTimeCompare([]byte(k), []byte(key)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// requireAPIKey returns 401 when no key is sent and 403 when the key is unknown
+func requireAPIKey(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !providerAuth.enabled() {
+			next(w, r)
+			return
+		}
+
+		key := providerAuth.presentedKey(r)
+		if key == "" {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="mock-api"`)
+			http.Error(w, "Missing API key ("+providerAuth.header+" header)", http.StatusUnauthorized)
+			return
+		}
+		if !providerAuth.valid(key) {
+			http.Error(w, "Invalid API key", http.StatusForbidden)
+			return
+		}
+
+		next(w, r)
+	}
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/rand"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// FaultConfig controls failure, latency and rate limit injection on the provider endpoints.
+// Defaults come from MOCK_FAIL_RATE, MOCK_FAIL_STATUS, MOCK_LATENCY_MS and
+// MOCK_RATE_LIMIT, can be changed at runtime via PUT /faults and (except the
+// rate limit) overridden per request with the fail_rate, status and latency_ms
+// query params.
+type FaultConfig struct {
+	FailRate   float64 `json:"fail_rate"`   // 0..1, share of requests that fail
+	FailStatus int     `json:"fail_status"` // HTTP status returned for failed requests
+	LatencyMs  int     `json:"latency_ms"`  // Delay added before every response
+	RateLimit  int     `json:"rate_limit"`  // Requests per second per client, 0 disables
+}
+
+var (
+	faultsMu sync.RWMutex
+	faults   = loadFaultConfig()
+)
+
+func loadFaultConfig() FaultConfig {
+	cfg := FaultConfig{FailStatus: http.StatusServiceUnavailable}
+	if v, err := strconv.ParseFloat(os.Getenv("MOCK_FAIL_RATE"), 64); err == nil {
+		cfg.FailRate = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("MOCK_FAIL_STATUS")); err == nil {
+		cfg.FailStatus = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("MOCK_LATENCY_MS")); err == nil {
+		cfg.LatencyMs = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("MOCK_RATE_LIMIT")); err == nil {
+		cfg.RateLimit = v
+	}
+	if err := cfg.validate(); err != nil {
+		log.Fatalf("Invalid fault config: %v", err)
+	}
+	return cfg
+}
+
+func (c FaultConfig) validate() error {
+	if c.FailRate < 0 || c.FailRate > 1 {
+		return fmt.Errorf("fail_rate must be between 0 and 1, got %v", c.FailRate)
+	}
+	if c.FailStatus < 400 || c.FailStatus > 599 {
+		return fmt.Errorf("fail_status must be a 4xx or 5xx code, got %d", c.FailStatus)
+	}
+	if c.LatencyMs < 0 {
+		return fmt.Errorf("latency_ms must not be negative, got %d", c.LatencyMs)
+	}
+	if c.RateLimit < 0 {
+		return fmt.Errorf("rate_limit must not be negative, got %d", c.RateLimit)
+	}
+	return nil
+}
+
+// requestFaults applies the query param overrides on top of the current config
+func requestFaults(r *http.Request) (FaultConfig, error) {
+	faultsMu.RLock()
+	cfg := faults
+	faultsMu.RUnlock()
+
+	q := r.URL.Query()
+	if raw := q.Get("fail_rate"); raw != "" {
+		v, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid fail_rate: %q", raw)
+		}
+		cfg.FailRate = v
+	}
+	if raw := q.Get("status"); raw != "" {
+		v, err := strconv.Atoi(raw)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid status: %q", raw)
+		}
+		cfg.FailStatus = v
+		// status alone means "always fail with this status"
+		if q.Get("fail_rate") == "" {
+			cfg.FailRate = 1
+		}
+	}
+	if raw := q.Get("latency_ms"); raw != "" {
+		v, err := strconv.Atoi(raw)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid latency_ms: %q", raw)
+		}
+		cfg.LatencyMs = v
+	}
+	return cfg, cfg.validate()
+}
+
+// injectFaults rate limits, delays and randomly fails requests according to the fault config
+func injectFaults(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cfg, err := requestFaults(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if !allowRequest(w, r, cfg.RateLimit) {
+			return
+		}
+
+		if cfg.LatencyMs > 0 {
+			select {
+			case <-time.After(time.Duration(cfg.LatencyMs) * time.Millisecond):
+			case <-r.Context().Done():
+				// Client gave up (e.g. its timeout fired); nothing to respond to
+				return
+			}
+		}
+
+		if cfg.FailRate > 0 && rand.Float64() < cfg.FailRate {
+			http.Error(w, fmt.Sprintf("injected failure (%d)", cfg.FailStatus), cfg.FailStatus)
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+// handleFaults shows (GET) or replaces (PUT) the fault config
+func handleFaults(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		var cfg FaultConfig
+		if err := json.NewDecoder(r.Body).Decode(&cfg); err != nil {
+			http.Error(w, "Invalid request", http.StatusBadRequest)
+			return
+		}
+		if cfg.FailStatus == 0 {
+			cfg.FailStatus = http.StatusServiceUnavailable
+		}
+		if err := cfg.validate(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		faultsMu.Lock()
+		faults = cfg
+		faultsMu.Unlock()
+		log.Printf("Fault config updated: fail_rate=%v fail_status=%d latency_ms=%d rate_limit=%d", cfg.FailRate, cfg.FailStatus, cfg.LatencyMs, cfg.RateLimit)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	faultsMu.RLock()
+	cfg := faults
+	faultsMu.RUnlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(cfg)
+}
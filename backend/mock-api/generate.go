@@ -0,0 +1,213 @@
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"log"
+	"math/rand"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const maxGenerateCount = 100000
+
+var (
+	jsonTagsPool = []string{"programming", "tutorial", "go", "docker", "cloud", "backend", "concurrency", "performance", "testing"}
+	xmlTagsPool  = []string{"devops", "kubernetes", "ci-cd", "cloud", "security", "monitoring", "architecture", "programming"}
+
+	titleTemplates = []string{
+		"Learning %s from Scratch",
+		"%s in Practice",
+		"Advanced %s Techniques",
+		"A Beginner's Guide to %s",
+		"%s Best Practices",
+		"Debugging %s in Production",
+		"Modern %s Guide",
+		"10 Things I Wish I Knew About %s",
+	}
+)
+
+// handleGenerate replaces the provider fixtures with randomized items
+// POST /generate?count=5000[&provider=provider-1|provider-2|provider-3][&seed=42][&days=365]
+// count is per provider; dates fall within the last `days` days and the same seed
+// produces the same dataset on the same day
+func handleGenerate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	q := r.URL.Query()
+	count, err := strconv.Atoi(q.Get("count"))
+	if err != nil || count < 0 || count > maxGenerateCount {
+		http.Error(w, fmt.Sprintf("count must be between 0 and %d", maxGenerateCount), http.StatusBadRequest)
+		return
+	}
+
+	days := 365
+	if raw := q.Get("days"); raw != "" {
+		if days, err = strconv.Atoi(raw); err != nil || days < 1 {
+			http.Error(w, "days must be a positive integer", http.StatusBadRequest)
+			return
+		}
+	}
+
+	seed := time.Now().UnixNano()
+	if raw := q.Get("seed"); raw != "" {
+		if seed, err = strconv.ParseInt(raw, 10, 64); err != nil {
+			http.Error(w, "Invalid seed", http.StatusBadRequest)
+			return
+		}
+	}
+
+	provider := q.Get("provider")
+	if provider != "" && provider != "provider-1" && provider != "provider-2" && provider != "provider-3" {
+		http.Error(w, "Invalid provider", http.StatusBadRequest)
+		return
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	since := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -days)
+	result := map[string]any{"status": "success", "seed": seed}
+
+	if provider == "" || provider == "provider-1" {
+		data, _ := json.MarshalIndent(JSONResponse{Contents: generateJSONContents(rng, count, since, days)}, "", "  ")
+		if err := writeFileAtomic(jsonFixturePath, data); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		result["provider-1"] = count
+	}
+
+	if provider == "" || provider == "provider-2" {
+		var feed XMLRoot
+		feed.Items.Items = generateXMLContents(rng, count, since, days)
+		data, _ := xml.MarshalIndent(feed, "", "  ")
+		if err := writeFileAtomic(xmlFixturePath, []byte(xml.Header+string(data))); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		result["provider-2"] = count
+	}
+
+	if provider == "" || provider == "provider-3" {
+		data := marshalProvider3Items(generateProvider3Items(rng, count, since, days))
+		if err := writeFileAtomic(provider3FixturePath, data); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		result["provider-3"] = count
+	}
+
+	log.Printf("Generated %d items per provider (provider=%q seed=%d)", count, provider, seed)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+func generateJSONContents(rng *rand.Rand, count int, since time.Time, days int) []JSONContent {
+	contents := make([]JSONContent, count)
+	for i := range contents {
+		n := i + 1
+		item := JSONContent{
+			Title:       fmt.Sprintf("JSON Content %d: %s", n, randomTitle(rng, jsonTagsPool)),
+			PublishedAt: randomDate(rng, since, days).Format(time.RFC3339),
+			Tags:        randomSample(rng, jsonTagsPool, 1+rng.Intn(3)),
+		}
+
+		if n%3 != 0 {
+			item.ID = fmt.Sprintf("json-v%d", n)
+			item.Type = "video"
+			item.Metrics.Views = ptr(int64(1000 + rng.Intn(49000)))
+			item.Metrics.Likes = ptr(int32(100 + rng.Intn(4900)))
+			item.Metrics.Duration = randomDuration(rng, 5, 45)
+		} else {
+			item.ID = fmt.Sprintf("json-a%d", n)
+			item.Type = "article"
+			item.Metrics.ReadingTime = ptr(int32(5 + rng.Intn(16)))
+			item.Metrics.Reactions = ptr(int32(50 + rng.Intn(551)))
+		}
+		contents[i] = item
+	}
+	return contents
+}
+
+func generateXMLContents(rng *rand.Rand, count int, since time.Time, days int) []XMLContent {
+	contents := make([]XMLContent, count)
+	for i := range contents {
+		n := i + 1
+		item := XMLContent{
+			Headline:        fmt.Sprintf("XML Content %d: %s", n, randomTitle(rng, xmlTagsPool)),
+			PublicationDate: randomDate(rng, since, days).Format("2006-01-02"),
+		}
+		item.Categories.Categories = randomSample(rng, xmlTagsPool, 1+rng.Intn(2))
+
+		if n%4 != 0 {
+			item.ID = fmt.Sprintf("xml-v%d", n)
+			item.Type = "video"
+			item.Stats.Views = ptr(int64(5000 + rng.Intn(25000)))
+			item.Stats.Likes = ptr(int32(200 + rng.Intn(1800)))
+			item.Stats.Duration = randomDuration(rng, 10, 60)
+		} else {
+			item.ID = fmt.Sprintf("xml-a%d", n)
+			item.Type = "article"
+			item.Stats.ReadingTime = ptr(int32(5 + rng.Intn(16)))
+			item.Stats.Reactions = ptr(int32(50 + rng.Intn(551)))
+			item.Stats.Comments = ptr(int32(5 + rng.Intn(96)))
+		}
+		contents[i] = item
+	}
+	return contents
+}
+
+func randomTitle(rng *rand.Rand, topics []string) string {
+	topic := topics[rng.Intn(len(topics))]
+	topic = strings.ToUpper(topic[:1]) + topic[1:]
+	return fmt.Sprintf(titleTemplates[rng.Intn(len(titleTemplates))], topic)
+}
+
+func randomDate(rng *rand.Rand, since time.Time, days int) time.Time {
+	return since.Add(time.Duration(rng.Int63n(int64(days) * int64(24*time.Hour))))
+}
+
+// randomDuration returns an "mm:ss" duration between minMinutes and maxMinutes
+func randomDuration(rng *rand.Rand, minMinutes, maxMinutes int) string {
+	return fmt.Sprintf("%d:%02d", minMinutes+rng.Intn(maxMinutes-minMinutes+1), rng.Intn(60))
+}
+
+func randomSample(rng *rand.Rand, pool []string, k int) []string {
+	picked := make([]string, 0, k)
+	for _, i := range rng.Perm(len(pool))[:k] {
+		picked = append(picked, pool[i])
+	}
+	return picked
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// writeFileAtomic writes via a temp file and rename so provider requests never read a partial fixture
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -7,9 +7,23 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strconv"
 )
 
+// mocksDir holds the provider fixtures; MOCK_DATA_DIR overrides it for local runs
+var mocksDir = func() string {
+	if dir := os.Getenv("MOCK_DATA_DIR"); dir != "" {
+		return dir
+	}
+	return "/app/mocks"
+}()
+
+var (
+	jsonFixturePath = filepath.Join(mocksDir, "provider1.json")
+	xmlFixturePath  = filepath.Join(mocksDir, "provider2.xml")
+)
+
 type JSONMetrics struct {
 	Views       *int64  `json:"views,omitempty"`
 	Likes       *int32  `json:"likes,omitempty"`
@@ -78,9 +92,13 @@ type UpdateItemRequest struct {
 }
 
 func main() {
-	http.HandleFunc("/provider-1", enableCORS(handleJSON))
-	http.HandleFunc("/provider-2", enableCORS(handleXML))
+	http.HandleFunc("/provider-1", enableCORS(requireAPIKey(injectFaults(handleJSON))))
+	http.HandleFunc("/provider-2", enableCORS(requireAPIKey(injectFaults(handleXML))))
+	http.HandleFunc("/provider-3", enableCORS(injectFaults(handleProvider3)))
 	http.HandleFunc("/update-item", enableCORS(handleUpdateItem))
+	http.HandleFunc("/faults", enableCORS(handleFaults))
+	http.HandleFunc("/generate", enableCORS(handleGenerate))
+	http.HandleFunc("/pagination", enableCORS(handlePagination))
 
 	port := ":8081"
 	fmt.Printf("Mock API server starting on %s...\n", port)
@@ -91,7 +109,7 @@ func enableCORS(next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "POST, GET, OPTIONS, PUT, DELETE")
-		w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization")
+		w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, "+providerAuth.header)
 
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusOK)
@@ -115,7 +133,7 @@ func handleUpdateItem(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if req.Provider == "provider-1" {
-		data, _ := os.ReadFile("/app/mocks/provider1.json")
+		data, _ := os.ReadFile(jsonFixturePath)
 		var resp JSONResponse
 		json.Unmarshal(data, &resp)
 
@@ -152,10 +170,10 @@ func handleUpdateItem(w http.ResponseWriter, r *http.Request) {
 		}
 
 		newData, _ := json.MarshalIndent(resp, "", "  ")
-		os.WriteFile("/app/mocks/provider1.json", newData, 0644)
+		os.WriteFile(jsonFixturePath, newData, 0644)
 
 	} else if req.Provider == "provider-2" {
-		data, _ := os.ReadFile("/app/mocks/provider2.xml")
+		data, _ := os.ReadFile(xmlFixturePath)
 		var resp XMLRoot
 		xml.Unmarshal(data, &resp)
 
@@ -193,7 +211,7 @@ func handleUpdateItem(w http.ResponseWriter, r *http.Request) {
 		}
 
 		newData, _ := xml.MarshalIndent(resp, "", "  ")
-		os.WriteFile("/app/mocks/provider2.xml", []byte(xml.Header+string(newData)), 0644)
+		os.WriteFile(xmlFixturePath, []byte(xml.Header+string(newData)), 0644)
 	} else {
 		http.Error(w, "Invalid provider", http.StatusBadRequest)
 		return
@@ -204,7 +222,7 @@ func handleUpdateItem(w http.ResponseWriter, r *http.Request) {
 }
 
 func handleJSON(w http.ResponseWriter, r *http.Request) {
-	data, err := os.ReadFile("/app/mocks/provider1.json")
+	data, err := os.ReadFile(jsonFixturePath)
 	if err != nil {
 		http.Error(w, "File not found", 500)
 		return
@@ -213,6 +231,11 @@ func handleJSON(w http.ResponseWriter, r *http.Request) {
 	var fullResponse JSONResponse
 	json.Unmarshal(data, &fullResponse)
 
+	if cursorPagination("provider-1") {
+		writeJSONCursorPage(w, r, fullResponse.Contents)
+		return
+	}
+
 	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
 	if page < 1 {
 		page = 1
@@ -241,7 +264,7 @@ func handleJSON(w http.ResponseWriter, r *http.Request) {
 }
 
 func handleXML(w http.ResponseWriter, r *http.Request) {
-	data, err := os.ReadFile("/app/mocks/provider2.xml")
+	data, err := os.ReadFile(xmlFixturePath)
 	if err != nil {
 		http.Error(w, "File not found", 500)
 		return
@@ -250,6 +273,11 @@ func handleXML(w http.ResponseWriter, r *http.Request) {
 	var fullResponse XMLRoot
 	xml.Unmarshal(data, &fullResponse)
 
+	if cursorPagination("provider-2") {
+		writeXMLCursorPage(w, r, fullResponse.Items.Items)
+		return
+	}
+
 	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
 	if page < 1 {
 		page = 1
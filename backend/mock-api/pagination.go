@@ -0,0 +1,184 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"log"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Providers use page numbers by default. In cursor mode a provider returns an
+// opaque next_cursor token (the last returned item ID) instead, and the next
+// page is requested with ?cursor=<token>. Cursor mode is enabled per provider
+// with MOCK_CURSOR_PAGINATION (comma separated, e.g. "provider-1,provider-3")
+// or at runtime via PUT /pagination.
+
+const cursorPageSize = 10
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+var paginationProviders = []string{"provider-1", "provider-2", "provider-3"}
+
+var (
+	paginationMu sync.RWMutex
+	cursorModes  = loadCursorModes()
+)
+
+func loadCursorModes() map[string]bool {
+	modes := make(map[string]bool)
+	for _, provider := range strings.Split(os.Getenv("MOCK_CURSOR_PAGINATION"), ",") {
+		if provider = strings.TrimSpace(provider); provider != "" {
+			modes[provider] = true
+		}
+	}
+	return modes
+}
+
+func cursorPagination(provider string) bool {
+	paginationMu.RLock()
+	defer paginationMu.RUnlock()
+	return cursorModes[provider]
+}
+
+func encodeCursor(afterID string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte("after:" + afterID))
+}
+
+// cursorPage returns the [start, end) range for the ?cursor param and the
+// cursor of the following page ("" on the last page)
+func cursorPage(r *http.Request, n, perPage int, idAt func(int) string) (start, end int, next string, err error) {
+	if token := r.URL.Query().Get("cursor"); token != "" {
+		raw, decodeErr := base64.RawURLEncoding.DecodeString(token)
+		afterID, ok := strings.CutPrefix(string(raw), "after:")
+		if decodeErr != nil || !ok {
+			return 0, 0, "", errInvalidCursor
+		}
+
+		start = -1
+		for i := 0; i < n; i++ {
+			if idAt(i) == afterID {
+				start = i + 1
+				break
+			}
+		}
+		// The item the cursor points at is gone (e.g. fixtures were regenerated)
+		if start < 0 {
+			return 0, 0, "", errInvalidCursor
+		}
+	}
+
+	end = min(start+perPage, n)
+	if end < n {
+		next = encodeCursor(idAt(end - 1))
+	}
+	return start, end, next, nil
+}
+
+type JSONCursorResponse struct {
+	Contents   []JSONContent `json:"contents"`
+	Pagination struct {
+		NextCursor string `json:"next_cursor,omitempty"`
+		HasMore    bool   `json:"has_more"`
+		PerPage    int    `json:"per_page"`
+	} `json:"pagination"`
+}
+
+func writeJSONCursorPage(w http.ResponseWriter, r *http.Request, contents []JSONContent) {
+	start, end, next, err := cursorPage(r, len(contents), cursorPageSize, func(i int) string { return contents[i].ID })
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp := JSONCursorResponse{Contents: contents[start:end]}
+	resp.Pagination.NextCursor = next
+	resp.Pagination.HasMore = next != ""
+	resp.Pagination.PerPage = cursorPageSize
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+type XMLCursorRoot struct {
+	XMLName xml.Name `xml:"feed"`
+	Items   struct {
+		Items []XMLContent `xml:"item"`
+	} `xml:"items"`
+	Meta struct {
+		NextCursor   string `xml:"next_cursor,omitempty"`
+		ItemsPerPage int    `xml:"items_per_page"`
+	} `xml:"meta"`
+}
+
+func writeXMLCursorPage(w http.ResponseWriter, r *http.Request, items []XMLContent) {
+	start, end, next, err := cursorPage(r, len(items), cursorPageSize, func(i int) string { return items[i].ID })
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var resp XMLCursorRoot
+	resp.Items.Items = items[start:end]
+	resp.Meta.NextCursor = next
+	resp.Meta.ItemsPerPage = cursorPageSize
+
+	w.Header().Set("Content-Type", "application/xml")
+	xml.NewEncoder(w).Encode(resp)
+}
+
+// handlePagination shows (GET) or changes (PUT) the pagination mode per provider
+// PUT body: {"provider-1": "cursor", "provider-2": "page"}; omitted providers keep their mode
+func handlePagination(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		var req map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "Invalid request", http.StatusBadRequest)
+			return
+		}
+		for provider, mode := range req {
+			if !slices.Contains(paginationProviders, provider) || (mode != "cursor" && mode != "page") {
+				http.Error(w, "Invalid provider or mode (use \"page\" or \"cursor\")", http.StatusBadRequest)
+				return
+			}
+		}
+
+		paginationMu.Lock()
+		for provider, mode := range req {
+			cursorModes[provider] = mode == "cursor"
+			log.Printf("Pagination mode for %s set to %s", provider, mode)
+		}
+		paginationMu.Unlock()
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	modes := make(map[string]string, len(paginationProviders))
+	for _, provider := range paginationProviders {
+		modes[provider] = "page"
+		if cursorPagination(provider) {
+			modes[provider] = "cursor"
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(modes)
+}
+
+// perPageParam parses ?per_page, falling back to def when missing or out of range
+func perPageParam(r *http.Request, def, maxPerPage int) int {
+	perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+	if perPage < 1 || perPage > maxPerPage {
+		return def
+	}
+	return perPage
+}
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// provider-3 deliberately differs from the other providers: JSON Lines or CSV
+// instead of a wrapped document, its own field names, unix timestamps (CSV uses
+// DD/MM/YYYY HH:MM), ISO 8601 durations and header based pagination.
+
+var provider3FixturePath = filepath.Join(mocksDir, "provider3.jsonl")
+
+const provider3CSVDateLayout = "02/01/2006 15:04"
+
+var provider3CSVHeader = []string{"uid", "name", "kind", "watch_count", "thumbs_up", "length", "read_minutes", "claps", "created", "labels"}
+
+var provider3LabelsPool = []string{"golang", "rust", "databases", "postgres", "redis", "search", "distributed-systems", "observability", "api-design"}
+
+type P3Item struct {
+	UID         string   `json:"uid"`
+	Name        string   `json:"name"`
+	Kind        string   `json:"kind"` // "clip" or "story"
+	WatchCount  *int64   `json:"watch_count,omitempty"`
+	ThumbsUp    *int32   `json:"thumbs_up,omitempty"`
+	Length      string   `json:"length,omitempty"` // ISO 8601 duration, e.g. PT12M30S
+	ReadMinutes *int32   `json:"read_minutes,omitempty"`
+	Claps       *int32   `json:"claps,omitempty"`
+	Created     int64    `json:"created"` // Unix seconds
+	Labels      []string `json:"labels"`
+}
+
+func (i P3Item) csvRecord() []string {
+	optional := func(v any) string {
+		switch n := v.(type) {
+		case *int64:
+			if n != nil {
+				return strconv.FormatInt(*n, 10)
+			}
+		case *int32:
+			if n != nil {
+				return strconv.FormatInt(int64(*n), 10)
+			}
+		}
+		return ""
+	}
+
+	return []string{
+		i.UID,
+		i.Name,
+		i.Kind,
+		optional(i.WatchCount),
+		optional(i.ThumbsUp),
+		i.Length,
+		optional(i.ReadMinutes),
+		optional(i.Claps),
+		time.Unix(i.Created, 0).UTC().Format(provider3CSVDateLayout),
+		strings.Join(i.Labels, ";"),
+	}
+}
+
+func loadProvider3Items() ([]P3Item, error) {
+	data, err := os.ReadFile(provider3FixturePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []P3Item
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var item P3Item
+		if err := json.Unmarshal(scanner.Bytes(), &item); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		items = append(items, item)
+	}
+	return items, scanner.Err()
+}
+
+// handleProvider3 serves provider-3 items as JSON Lines (default) or CSV
+// GET /provider-3?format=jsonl|csv&page=1&per_page=25
+// Pagination metadata is returned in the X-Total-Count and X-Next-Page headers
+// (X-Next-Cursor and ?cursor= in cursor mode)
+func handleProvider3(w http.ResponseWriter, r *http.Request) {
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = "jsonl"
+	}
+	if format != "jsonl" && format != "csv" {
+		http.Error(w, "format must be jsonl or csv", http.StatusBadRequest)
+		return
+	}
+
+	items, err := loadProvider3Items()
+	if err != nil {
+		http.Error(w, "File not found", 500)
+		return
+	}
+
+	perPage := perPageParam(r, 25, 100)
+	var start, end int
+	if cursorPagination("provider-3") {
+		var next string
+		start, end, next, err = cursorPage(r, len(items), perPage, func(i int) string { return items[i].UID })
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if next != "" {
+			w.Header().Set("X-Next-Cursor", next)
+		}
+	} else {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page < 1 {
+			page = 1
+		}
+		start = min((page-1)*perPage, len(items))
+		end = min(start+perPage, len(items))
+		if end < len(items) {
+			w.Header().Set("X-Next-Page", strconv.Itoa(page+1))
+		}
+	}
+	w.Header().Set("X-Total-Count", strconv.Itoa(len(items)))
+
+	if format == "csv" {
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		cw := csv.NewWriter(w)
+		cw.Write(provider3CSVHeader)
+		for _, item := range items[start:end] {
+			cw.Write(item.csvRecord())
+		}
+		cw.Flush()
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	enc := json.NewEncoder(w)
+	for _, item := range items[start:end] {
+		enc.Encode(item)
+	}
+}
+
+func generateProvider3Items(rng *rand.Rand, count int, since time.Time, days int) []P3Item {
+	items := make([]P3Item, count)
+	for i := range items {
+		n := i + 1
+		item := P3Item{
+			Name:    fmt.Sprintf("P3 Item %d: %s", n, randomTitle(rng, provider3LabelsPool)),
+			Created: randomDate(rng, since, days).Unix(),
+			Labels:  randomSample(rng, provider3LabelsPool, 1+rng.Intn(3)),
+		}
+
+		if n%2 != 0 {
+			item.UID = fmt.Sprintf("p3-clip-%d", n)
+			item.Kind = "clip"
+			item.WatchCount = ptr(int64(500 + rng.Intn(99500)))
+			item.ThumbsUp = ptr(int32(20 + rng.Intn(8000)))
+			item.Length = isoDuration(time.Duration(60+rng.Intn(90*60)) * time.Second)
+		} else {
+			item.UID = fmt.Sprintf("p3-story-%d", n)
+			item.Kind = "story"
+			item.ReadMinutes = ptr(int32(2 + rng.Intn(29)))
+			item.Claps = ptr(int32(rng.Intn(2000)))
+		}
+		items[i] = item
+	}
+	return items
+}
+
+// isoDuration formats d as an ISO 8601 duration, e.g. PT1H5M30S
+func isoDuration(d time.Duration) string {
+	h, m, sec := int(d.Hours()), int(d.Minutes())%60, int(d.Seconds())%60
+	out := "PT"
+	if h > 0 {
+		out += fmt.Sprintf("%dH", h)
+	}
+	if m > 0 {
+		out += fmt.Sprintf("%dM", m)
+	}
+	if sec > 0 || out == "PT" {
+		out += fmt.Sprintf("%dS", sec)
+	}
+	return out
+}
+
+func marshalProvider3Items(items []P3Item) []byte {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, item := range items {
+		enc.Encode(item)
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// rateWindow counts a client's requests in the current one second window
+type rateWindow struct {
+	start time.Time
+	count int
+}
+
+var (
+	rateMu      sync.Mutex
+	rateWindows = make(map[string]*rateWindow)
+)
+
+// allowRequest enforces limit requests per second per client IP. Over the
+// limit it writes 429 with Retry-After (whole seconds until the window resets)
+// and returns false. X-RateLimit-* headers are set on every limited request.
+func allowRequest(w http.ResponseWriter, r *http.Request, limit int) bool {
+	if limit <= 0 {
+		return true
+	}
+
+	client, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		client = r.RemoteAddr
+	}
+
+	now := time.Now()
+	rateMu.Lock()
+	window, ok := rateWindows[client]
+	if !ok || now.Sub(window.start) >= time.Second {
+		if len(rateWindows) > 1024 {
+			for key, wnd := range rateWindows {
+				if now.Sub(wnd.start) >= time.Second {
+					delete(rateWindows, key)
+				}
+			}
+		}
+		window = &rateWindow{start: now}
+		rateWindows[client] = window
+	}
+	window.count++
+	count, reset := window.count, window.start.Add(time.Second)
+	rateMu.Unlock()
+
+	w.Header().Set("X-RateLimit-Limit", strconv.Itoa(limit))
+	w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(max(limit-count, 0)))
+	w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+
+	if count <= limit {
+		return true
+	}
+
+	retryAfter := int((reset.Sub(now) + time.Second - 1) / time.Second)
+	w.Header().Set("Retry-After", strconv.Itoa(max(retryAfter, 1)))
+	http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+	return false
+}
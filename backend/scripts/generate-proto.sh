@@ -0,0 +1,20 @@
+#!/bin/bash
+
+# Proto Generation Script
+# Regenerates Go code for the gRPC API under api/proto
+# Requires protoc, protoc-gen-go and protoc-gen-go-grpc on PATH:
+#   go install google.golang.org/protobuf/cmd/protoc-gen-go@v1.34.2
+#   go install google.golang.org/grpc/cmd/protoc-gen-go-grpc@v1.5.1
+
+set -e
+
+cd "$(dirname "$0")/.."
+
+echo "🔧 Generating Go code from proto files..."
+protoc \
+  --proto_path=api/proto \
+  --go_out=api/proto --go_opt=paths=source_relative \
+  --go-grpc_out=api/proto --go-grpc_opt=paths=source_relative \
+  search/v1/search.proto
+
+echo "✅ Done"
@@ -26,7 +26,7 @@ export const useSearch = () => {
             })
             return response
         } catch (e: any) {
-            error.value = e.data?.error || e.message || 'Bir hata oluştu'
+            error.value = e.data?.error?.message || e.message || 'Bir hata oluştu'
             throw e
         } finally {
             loading.value = false
@@ -4,7 +4,8 @@ export default defineNuxtConfig({
 
     runtimeConfig: {
         public: {
-            apiBase: 'http://localhost:8080'
+            apiBase: 'http://localhost:8080',
+            adminToken: '' // Admin endpoint'leri için bearer token (sadece lokal geliştirme)
         }
     },
 